@@ -4,7 +4,9 @@ import (
 	"context"
 	"database/sql"
 	"encoding/json"
+	"fmt"
 	"log/slog"
+	"math"
 	"net/http"
 	"time"
 
@@ -17,6 +19,10 @@ import (
 	"sortedstartup.com/stream/commentservice/db"
 	"sortedstartup.com/stream/commentservice/proto"
 	"sortedstartup.com/stream/common/interceptors"
+	"sortedstartup.com/stream/common/pagination"
+	notificationProto "sortedstartup.com/stream/notificationservice/proto"
+	userProto "sortedstartup.com/stream/userservice/proto"
+	videoProto "sortedstartup.com/stream/videoservice/proto"
 )
 
 type CommentAPI struct {
@@ -27,6 +33,11 @@ type CommentAPI struct {
 	log       *slog.Logger
 	dbQueries db.Querier
 
+	videoServiceClient   videoProto.VideoServiceClient
+	channelServiceClient videoProto.ChannelServiceClient
+	tenantServiceClient  userProto.TenantServiceClient
+	notificationClient   notificationProto.NotificationPublisherServiceClient
+
 	//implemented proto server
 	proto.UnimplementedCommentServiceServer
 }
@@ -39,7 +50,7 @@ func NewCommentAPITest(mockDB db.Querier, logger *slog.Logger) *CommentAPI {
 	}
 }
 
-func NewCommentAPIProduction(config config.CommentServiceConfig) (*CommentAPI, error) {
+func NewCommentAPIProduction(config config.CommentServiceConfig, videoServiceClient videoProto.VideoServiceClient, channelServiceClient videoProto.ChannelServiceClient, tenantServiceClient userProto.TenantServiceClient, notificationClient notificationProto.NotificationPublisherServiceClient) (*CommentAPI, *TenantCleanupAPI, error) {
 	slog.Info("NewCommentAPIProduction")
 
 	// fbAuth, err := auth.NewFirebase()
@@ -51,7 +62,7 @@ func NewCommentAPIProduction(config config.CommentServiceConfig) (*CommentAPI, e
 
 	_db, err := sql.Open(config.DB.Driver, config.DB.Url)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	dbQueries := db.New(_db)
@@ -61,9 +72,19 @@ func NewCommentAPIProduction(config config.CommentServiceConfig) (*CommentAPI, e
 		db:        _db,
 		log:       childLogger,
 		dbQueries: dbQueries,
+
+		videoServiceClient:   videoServiceClient,
+		channelServiceClient: channelServiceClient,
+		tenantServiceClient:  tenantServiceClient,
+		notificationClient:   notificationClient,
 	}
 
-	return commentAPI, nil
+	tenantCleanupAPI := &TenantCleanupAPI{
+		log:       childLogger,
+		dbQueries: dbQueries,
+	}
+
+	return commentAPI, tenantCleanupAPI, nil
 }
 
 func (s *CommentAPI) Start() error {
@@ -86,6 +107,12 @@ func (s *CommentAPI) CreateComment(ctx context.Context, req *proto.CreateComment
 		return nil, status.Error(codes.Unauthenticated, "unauthenticated")
 	}
 
+	// Only viewers of the video can comment on it
+	tenantID, err := s.authorizeVideoAccess(ctx, req.VideoId)
+	if err != nil {
+		return nil, err
+	}
+
 	commentID := generateUUID()
 
 	// Check if ParentCommentId is nil
@@ -94,47 +121,159 @@ func (s *CommentAPI) CreateComment(ctx context.Context, req *proto.CreateComment
 		parentCommentID = sql.NullString{String: *req.ParentCommentId, Valid: *req.ParentCommentId != ""}
 	}
 
+	// Only top-level comments can be anchored to a moment in the video
+	var timestampSeconds sql.NullFloat64
+	if req.TimestampSeconds != nil {
+		if parentCommentID.Valid {
+			return nil, status.Error(codes.InvalidArgument, "replies cannot have a timestamp")
+		}
+		if !isValidTimestamp(*req.TimestampSeconds) {
+			return nil, status.Error(codes.InvalidArgument, "timestamp must be a non-negative number of seconds")
+		}
+		timestampSeconds = sql.NullFloat64{Float64: *req.TimestampSeconds, Valid: true}
+	}
+
+	// A reply must stay on the same video as its parent
+	var parent db.CommentserviceComment
+	if parentCommentID.Valid {
+		parent, err = s.getActiveComment(ctx, parentCommentID.String)
+		if err != nil {
+			return nil, err
+		}
+		if parent.VideoID != req.VideoId {
+			return nil, status.Error(codes.InvalidArgument, "parent comment belongs to a different video")
+		}
+	}
+
+	// Mentions of people who can't see the video are kept as plain text
+	mentions, err := s.resolveMentions(ctx, tenantID, req.VideoId, req.Content)
+	if err != nil {
+		return nil, err
+	}
+
 	err = s.dbQueries.CreateComment(ctx, db.CreateCommentParams{
-		ID:              commentID,
-		Content:         req.Content,
-		VideoID:         req.VideoId,
-		UserID:          authContext.User.ID,
-		Username:        sql.NullString{String: authContext.User.Name, Valid: true},
-		ParentCommentID: parentCommentID,
+		ID:               commentID,
+		TenantID:         sql.NullString{String: tenantID, Valid: true},
+		Content:          req.Content,
+		VideoID:          req.VideoId,
+		UserID:           authContext.User.ID,
+		Username:         sql.NullString{String: authContext.User.Name, Valid: true},
+		ParentCommentID:  parentCommentID,
+		TimestampSeconds: timestampSeconds,
 	})
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to create comment: %v", err)
 	}
 
+	if err := s.saveMentions(ctx, tenantID, commentID, mentions); err != nil {
+		return nil, err
+	}
+
+	// The parent's author gets the reply notification, no need to also tell them about a mention
+	skip := map[string]bool{}
+	if parentCommentID.Valid {
+		s.notifyReply(ctx, tenantID, authContext.User, parent, commentID)
+		skip[parent.UserID] = true
+	}
+	s.notifyMentions(ctx, tenantID, authContext.User, req.VideoId, commentID, mentions, skip)
+	s.publishCommentCreated(ctx, tenantID, authContext.User, req.VideoId, commentID, parentCommentID.String, req.Content)
+
 	return &proto.Comment{
-		Id:       commentID,
-		Content:  req.Content,
-		VideoId:  req.VideoId,
-		UserId:   authContext.User.ID,
-		Username: authContext.User.Name,
+		Id:               commentID,
+		Content:          req.Content,
+		VideoId:          req.VideoId,
+		UserId:           authContext.User.ID,
+		Username:         authContext.User.Name,
+		TimestampSeconds: req.TimestampSeconds,
+		Mentions:         mentions,
 	}, nil
 }
 
 func (s *CommentAPI) ListComments(ctx context.Context, req *proto.ListCommentsRequest) (*proto.ListCommentsResponse, error) {
+	authContext, err := interceptors.AuthFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "unauthenticated")
+	}
+
+	// Only viewers of the video can read its comments
+	tenantID, err := s.authorizeVideoAccess(ctx, req.VideoId)
+	if err != nil {
+		return nil, err
+	}
+
+	params := db.GetComentsAndRepliesForVideoIDParams{
+		UserID:   authContext.User.ID, // for liked_by_me
+		VideoID:  req.VideoId,
+		TenantID: sql.NullString{String: tenantID, Valid: true},
+		SortBy:   commentSortOrderToDB(req.SortBy),
+	}
+
+	// Optional time range for timeline markers
+	if req.FromSeconds != nil {
+		if !isValidTimestamp(*req.FromSeconds) {
+			return nil, status.Error(codes.InvalidArgument, "from_seconds must be a non-negative number of seconds")
+		}
+		params.FromSeconds = sql.NullFloat64{Float64: *req.FromSeconds, Valid: true}
+	}
+	if req.ToSeconds != nil {
+		if !isValidTimestamp(*req.ToSeconds) {
+			return nil, status.Error(codes.InvalidArgument, "to_seconds must be a non-negative number of seconds")
+		}
+		params.ToSeconds = sql.NullFloat64{Float64: *req.ToSeconds, Valid: true}
+	}
+	if params.FromSeconds.Valid && params.ToSeconds.Valid && params.FromSeconds.Float64 > params.ToSeconds.Float64 {
+		return nil, status.Error(codes.InvalidArgument, "from_seconds must not be after to_seconds")
+	}
+
+	pageSize, _, err := normalizePagination(req.PageSize, 0)
+	if err != nil {
+		return nil, err
+	}
+	scope := commentPageScope(req.VideoId, params)
+	params.AfterID, err = pagination.DecodeToken(scope, req.PageToken)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid page token")
+	}
+	// Fetch one extra row to know whether there is a next page
+	params.PageSize = int64(pageSize + 1)
+
 	// Fetch comments and their replies for the given video ID
-	commentsWithReplies, err := s.dbQueries.GetComentsAndRepliesForVideoID(ctx, req.VideoId)
+	commentsWithReplies, err := s.dbQueries.GetComentsAndRepliesForVideoID(ctx, params)
 	if err != nil {
 		s.log.Error("Error fetching comments and replies", "err", err)
 		return nil, status.Errorf(codes.Internal, "failed to fetch comments: %v", err)
 	}
 
+	totalCount, err := s.dbQueries.CountCommentsForVideoID(ctx, db.CountCommentsForVideoIDParams{
+		VideoID:     params.VideoID,
+		TenantID:    params.TenantID,
+		FromSeconds: params.FromSeconds,
+		ToSeconds:   params.ToSeconds,
+	})
+	if err != nil {
+		s.log.Error("Error counting comments", "err", err, "videoID", req.VideoId)
+		return nil, status.Error(codes.Internal, "failed to fetch comments")
+	}
+
+	commentsWithReplies, nextPageToken := pagination.TrimPage(commentsWithReplies, int(pageSize), scope, func(comment db.GetComentsAndRepliesForVideoIDRow) string {
+		return comment.ID
+	})
+
 	// Convert database model to proto response
 	var protoComments []*proto.Comment
 	for _, comment := range commentsWithReplies {
 		var replies []struct {
-			ID              string    `json:"id"`
-			Content         string    `json:"content"`
-			UserID          string    `json:"user_id"`
-			Username        string    `json:"username"`
-			VideoID         string    `json:"video_id"`
-			ParentCommentID string    `json:"parent_comment_id"`
-			CreatedAt       time.Time `json:"created_at"`
-			UpdatedAt       time.Time `json:"updated_at"`
+			ID              string        `json:"id"`
+			Content         string        `json:"content"`
+			UserID          string        `json:"user_id"`
+			Username        string        `json:"username"`
+			VideoID         string        `json:"video_id"`
+			ParentCommentID string        `json:"parent_comment_id"`
+			CreatedAt       time.Time     `json:"created_at"`
+			UpdatedAt       time.Time     `json:"updated_at"`
+			LikeCount       int32         `json:"like_count"`
+			LikedByMe       bool          `json:"liked_by_me"`
+			Mentions        []mentionJSON `json:"mentions"`
 		}
 
 		if repliesJSON, ok := comment.Replies.(string); ok && repliesJSON != "" {
@@ -145,6 +284,15 @@ func (s *CommentAPI) ListComments(ctx context.Context, req *proto.ListCommentsRe
 			}
 		}
 
+		var mentions []mentionJSON
+		if mentionsJSON, ok := comment.Mentions.(string); ok && mentionsJSON != "" {
+			err := json.Unmarshal([]byte(mentionsJSON), &mentions)
+			if err != nil {
+				s.log.Error("Error unmarshalling mentions JSON", "err", err)
+				return nil, status.Errorf(codes.Internal, "failed to parse mentions: %v", err)
+			}
+		}
+
 		createdAtProto := timestamppb.New(comment.CreatedAt)
 		updatedAtProto := timestamppb.New(comment.UpdatedAt)
 
@@ -159,10 +307,13 @@ func (s *CommentAPI) ListComments(ctx context.Context, req *proto.ListCommentsRe
 				ParentCommentId: r.ParentCommentID,
 				CreatedAt:       timestamppb.New(r.CreatedAt),
 				UpdatedAt:       timestamppb.New(r.UpdatedAt),
+				LikeCount:       r.LikeCount,
+				LikedByMe:       r.LikedByMe,
+				Mentions:        mentionsFromJSON(r.Mentions),
 			})
 		}
 
-		protoComments = append(protoComments, &proto.Comment{
+		protoComment := &proto.Comment{
 			Id:              comment.ID,
 			Content:         comment.Content,
 			VideoId:         comment.VideoID,
@@ -172,11 +323,23 @@ func (s *CommentAPI) ListComments(ctx context.Context, req *proto.ListCommentsRe
 			CreatedAt:       createdAtProto,
 			UpdatedAt:       updatedAtProto,
 			Replies:         protoReplies,
-		})
+			LikeCount:       int32(comment.LikeCount),
+			LikedByMe:       comment.LikedByMe,
+			IsDeleted:       comment.IsDeleted,
+			Mentions:        mentionsFromJSON(mentions),
+		}
+		if comment.TimestampSeconds.Valid {
+			timestampSeconds := comment.TimestampSeconds.Float64
+			protoComment.TimestampSeconds = &timestampSeconds
+		}
+
+		protoComments = append(protoComments, protoComment)
 	}
 
 	return &proto.ListCommentsResponse{
-		Comments: protoComments,
+		Comments:      protoComments,
+		NextPageToken: nextPageToken,
+		TotalCount:    int32(totalCount),
 	}, nil
 }
 
@@ -201,22 +364,64 @@ func (s *CommentAPI) GetComment(ctx context.Context, req *proto.GetCommentReques
 		return nil, status.Error(codes.Internal, "internal error")
 	}
 
+	if comment.IsDeleted {
+		return nil, status.Error(codes.NotFound, "comment not found")
+	}
+
+	if err := s.authorizeCommentAccess(ctx, comment); err != nil {
+		return nil, err
+	}
+
 	// Verify user has access to this comment
 	if comment.UserID != authContext.User.ID {
 		return nil, status.Error(codes.PermissionDenied, "permission denied")
 	}
 
+	mentions, err := s.getMentionsByCommentID(ctx, []string{comment.ID})
+	if err != nil {
+		return nil, err
+	}
+
 	// Return GetCommentResponse instead of just Comment
 	return &proto.GetCommentResponse{
 		Comment: &proto.Comment{
-			Id:      comment.ID,
-			Content: comment.Content,
-			VideoId: comment.VideoID,
-			UserId:  comment.UserID,
+			Id:       comment.ID,
+			Content:  comment.Content,
+			VideoId:  comment.VideoID,
+			UserId:   comment.UserID,
+			Mentions: mentions[comment.ID],
 		},
 	}, nil
 }
 
+// commentSortOrderToDB maps the proto sort order to the value understood by GetComentsAndRepliesForVideoID
+func commentSortOrderToDB(sortBy proto.CommentSortOrder) string {
+	switch sortBy {
+	case proto.CommentSortOrder_COMMENT_SORT_OLDEST:
+		return "oldest"
+	case proto.CommentSortOrder_COMMENT_SORT_TIMESTAMP:
+		return "timestamp"
+	default:
+		return "newest"
+	}
+}
+
+// commentPageScope ties a ListComments page token to the video, sort order and time range it was issued for
+func commentPageScope(videoID string, params db.GetComentsAndRepliesForVideoIDParams) string {
+	scope := fmt.Sprintf("comments:%s:%s", videoID, params.SortBy)
+	if params.FromSeconds.Valid {
+		scope += fmt.Sprintf(":from=%g", params.FromSeconds.Float64)
+	}
+	if params.ToSeconds.Valid {
+		scope += fmt.Sprintf(":to=%g", params.ToSeconds.Float64)
+	}
+	return scope
+}
+
+func isValidTimestamp(seconds float64) bool {
+	return !math.IsNaN(seconds) && !math.IsInf(seconds, 0) && seconds >= 0
+}
+
 func generateUUID() string {
 	return uuid.New().String()
 }
@@ -11,12 +11,19 @@ import (
 
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
 
 	"sortedstartup.com/stream/commentservice/db"
 	mockdb "sortedstartup.com/stream/commentservice/db/mocks"
 	"sortedstartup.com/stream/commentservice/proto"
 	"sortedstartup.com/stream/common/auth"
+	"sortedstartup.com/stream/common/interceptors"
+	"sortedstartup.com/stream/common/pagination"
+	notificationProto "sortedstartup.com/stream/notificationservice/proto"
+	videoProto "sortedstartup.com/stream/videoservice/proto"
 )
 
 // Helper to build an auth context
@@ -31,12 +38,42 @@ func buildAuthContext() context.Context {
 	}
 
 	ctx := context.WithValue(context.Background(), auth.AUTH_CONTEXT_KEY, user)
-	md := metadata.Pairs("authorization", "Bearer test-token")
-	ctx = metadata.NewOutgoingContext(ctx, md)
+	md := metadata.Pairs("authorization", "Bearer test-token", interceptors.TENANT_ID_HEADER, "test-tenant")
+	ctx = metadata.NewIncomingContext(ctx, md)
+
+	// Run the tenant interceptor so the tenant ID ends up in the context like in production
+	_, _ = interceptors.TenantInterceptor()(ctx, nil, nil, func(tenantCtx context.Context, req interface{}) (interface{}, error) {
+		ctx = tenantCtx
+		return nil, nil
+	})
 
 	return ctx
 }
 
+// Helper to let the caller view every video, videoservice is the one enforcing access
+func allowVideoAccess(ctrl *gomock.Controller, commentAPI *CommentAPI) *videoProto.MockVideoServiceClient {
+	mockVideo := videoProto.NewMockVideoServiceClient(ctrl)
+	mockVideo.EXPECT().
+		GetVideo(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, req *videoProto.GetVideoRequest, opts ...grpc.CallOption) (*videoProto.Video, error) {
+			return &videoProto.Video{Id: req.VideoId}, nil
+		}).
+		AnyTimes()
+	commentAPI.videoServiceClient = mockVideo
+	return mockVideo
+}
+
+// Helper to accept webhook events, returns the mock so tests can expect notifications too
+func allowEvents(ctrl *gomock.Controller, commentAPI *CommentAPI) *notificationProto.MockNotificationPublisherServiceClient {
+	mockNotifications := notificationProto.NewMockNotificationPublisherServiceClient(ctrl)
+	mockNotifications.EXPECT().
+		PublishEvent(gomock.Any(), gomock.Any()).
+		Return(&notificationProto.PublishEventResponse{}, nil).
+		AnyTimes()
+	commentAPI.notificationClient = mockNotifications
+	return mockNotifications
+}
+
 // Test CreateComment
 func TestCreateComment(t *testing.T) {
 	ctrl := gomock.NewController(t)
@@ -46,6 +83,7 @@ func TestCreateComment(t *testing.T) {
 	logger := slog.Default()
 
 	commentAPI := NewCommentAPITest(mockDB, logger)
+	allowVideoAccess(ctrl, commentAPI)
 	assert.NotNil(t, commentAPI, "commentAPI should not be nil")
 
 	ctx := buildAuthContext()
@@ -61,6 +99,20 @@ func TestCreateComment(t *testing.T) {
 		}).
 		Times(1)
 
+	mockNotifications := notificationProto.NewMockNotificationPublisherServiceClient(ctrl)
+	mockNotifications.EXPECT().
+		PublishEvent(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, req *notificationProto.PublishEventRequest, _ ...grpc.CallOption) (*notificationProto.PublishEventResponse, error) {
+			assert.Equal(t, notificationProto.EventType_EVENT_TYPE_COMMENT_CREATED, req.Type)
+			assert.Equal(t, "test-tenant", req.TenantId)
+			assert.Equal(t, "test-video-id", req.Data["video_id"])
+			assert.Equal(t, "This is a test comment", req.Data["content"])
+			assert.NotContains(t, req.Data, "parent_comment_id")
+			return &notificationProto.PublishEventResponse{QueuedDeliveries: 1}, nil
+		}).
+		Times(1)
+	commentAPI.notificationClient = mockNotifications
+
 	comment, err := commentAPI.CreateComment(ctx, &proto.CreateCommentRequest{
 		Content: "This is a test comment",
 		VideoId: "test-video-id",
@@ -88,10 +140,11 @@ func TestListComments(t *testing.T) {
 	logger := slog.Default()
 
 	commentAPI := NewCommentAPITest(mockDB, logger)
+	allowVideoAccess(ctrl, commentAPI)
 	ctx := buildAuthContext()
 
 	mockDB.EXPECT().
-		GetComentsAndRepliesForVideoID(gomock.Any(), "test-video-id").
+		GetComentsAndRepliesForVideoID(gomock.Any(), db.GetComentsAndRepliesForVideoIDParams{UserID: "test-user-id", VideoID: "test-video-id", TenantID: sqlNullString("test-tenant"), SortBy: "newest", PageSize: 21}).
 		Return([]db.GetComentsAndRepliesForVideoIDRow{
 			{
 				ID:        "comment-1",
@@ -106,6 +159,11 @@ func TestListComments(t *testing.T) {
 		}, nil).
 		Times(1)
 
+	mockDB.EXPECT().
+		CountCommentsForVideoID(gomock.Any(), gomock.Any()).
+		Return(int64(1), nil).
+		Times(1)
+
 	resp, err := commentAPI.ListComments(ctx, &proto.ListCommentsRequest{
 		VideoId: "test-video-id",
 	})
@@ -132,6 +190,7 @@ func TestGetComment(t *testing.T) {
 	logger := slog.Default()
 
 	commentAPI := NewCommentAPITest(mockDB, logger)
+	allowVideoAccess(ctrl, commentAPI)
 	ctx := buildAuthContext()
 
 	mockComment := db.CommentserviceComment{
@@ -146,6 +205,11 @@ func TestGetComment(t *testing.T) {
 		Return(mockComment, nil).
 		Times(1)
 
+	mockDB.EXPECT().
+		GetMentionsByCommentIDs(gomock.Any(), []string{"mock-id"}).
+		Return(nil, nil).
+		Times(1)
+
 	resp, err := commentAPI.GetComment(ctx, &proto.GetCommentRequest{
 		CommentId: "mock-id",
 	})
@@ -168,6 +232,7 @@ func TestCreateComment_DBError(t *testing.T) {
 	mockDB := mockdb.NewMockQuerier(ctrl)
 	logger := slog.Default()
 	commentAPI := NewCommentAPITest(mockDB, logger)
+	allowVideoAccess(ctrl, commentAPI)
 	ctx := buildAuthContext()
 
 	mockDB.EXPECT().
@@ -192,10 +257,11 @@ func TestListComments_DBError(t *testing.T) {
 	mockDB := mockdb.NewMockQuerier(ctrl)
 	logger := slog.Default()
 	commentAPI := NewCommentAPITest(mockDB, logger)
+	allowVideoAccess(ctrl, commentAPI)
 	ctx := buildAuthContext()
 
 	mockDB.EXPECT().
-		GetComentsAndRepliesForVideoID(gomock.Any(), "test-video-id").
+		GetComentsAndRepliesForVideoID(gomock.Any(), db.GetComentsAndRepliesForVideoIDParams{UserID: "test-user-id", VideoID: "test-video-id", TenantID: sqlNullString("test-tenant"), SortBy: "newest", PageSize: 21}).
 		Return(nil, fmt.Errorf("db failure")).
 		Times(1)
 
@@ -215,6 +281,7 @@ func TestGetComment_NotFound(t *testing.T) {
 	mockDB := mockdb.NewMockQuerier(ctrl)
 	logger := slog.Default()
 	commentAPI := NewCommentAPITest(mockDB, logger)
+	allowVideoAccess(ctrl, commentAPI)
 	ctx := buildAuthContext()
 
 	mockDB.EXPECT().
@@ -238,6 +305,7 @@ func TestGetComment_PermissionDenied(t *testing.T) {
 	mockDB := mockdb.NewMockQuerier(ctrl)
 	logger := slog.Default()
 	commentAPI := NewCommentAPITest(mockDB, logger)
+	allowVideoAccess(ctrl, commentAPI)
 
 	ctx := buildAuthContext()
 
@@ -270,8 +338,26 @@ func TestCreateComment_WithParentID(t *testing.T) {
 	logger := slog.Default()
 
 	commentAPI := NewCommentAPITest(mockDB, logger)
+	allowVideoAccess(ctrl, commentAPI)
 	ctx := buildAuthContext()
 
+	mockDB.EXPECT().
+		GetCommentByCommentID(gomock.Any(), "parent-comment-id").
+		Return(db.CommentserviceComment{ID: "parent-comment-id", VideoID: "test-video-id", UserID: "parent-author"}, nil).
+		Times(1)
+
+	// The parent's author is notified about the reply
+	mockNotifications := allowEvents(ctrl, commentAPI)
+	mockNotifications.EXPECT().
+		Publish(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, req *notificationProto.PublishRequest, opts ...grpc.CallOption) (*notificationProto.PublishResponse, error) {
+			assert.Equal(t, notificationProto.NotificationType_NOTIFICATION_TYPE_REPLY, req.Type)
+			assert.Equal(t, []string{"parent-author"}, req.RecipientIds)
+			assert.Equal(t, "test-tenant", req.TenantId)
+			return &notificationProto.PublishResponse{DeliveredCount: 1}, nil
+		}).
+		Times(1)
+
 	mockDB.EXPECT().
 		CreateComment(gomock.Any(), gomock.Any()).
 		DoAndReturn(func(ctx context.Context, params db.CreateCommentParams) error {
@@ -315,10 +401,11 @@ func TestListComments_MalformedRepliesJSON(t *testing.T) {
 	logger := slog.Default()
 
 	commentAPI := NewCommentAPITest(mockDB, logger)
+	allowVideoAccess(ctrl, commentAPI)
 	ctx := buildAuthContext()
 
 	mockDB.EXPECT().
-		GetComentsAndRepliesForVideoID(gomock.Any(), "test-video-id").
+		GetComentsAndRepliesForVideoID(gomock.Any(), db.GetComentsAndRepliesForVideoIDParams{UserID: "test-user-id", VideoID: "test-video-id", TenantID: sqlNullString("test-tenant"), SortBy: "newest", PageSize: 21}).
 		Return([]db.GetComentsAndRepliesForVideoIDRow{
 			{
 				ID:        "comment-1",
@@ -333,6 +420,11 @@ func TestListComments_MalformedRepliesJSON(t *testing.T) {
 		}, nil).
 		Times(1)
 
+	mockDB.EXPECT().
+		CountCommentsForVideoID(gomock.Any(), gomock.Any()).
+		Return(int64(1), nil).
+		Times(1)
+
 	resp, err := commentAPI.ListComments(ctx, &proto.ListCommentsRequest{
 		VideoId: "test-video-id",
 	})
@@ -355,3 +447,274 @@ func getNow() sql.NullTime {
 		Valid: true,
 	}
 }
+
+func TestCreateComment_WithTimestamp(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockDB := mockdb.NewMockQuerier(ctrl)
+	logger := slog.Default()
+	commentAPI := NewCommentAPITest(mockDB, logger)
+	allowVideoAccess(ctrl, commentAPI)
+	allowEvents(ctrl, commentAPI)
+	ctx := buildAuthContext()
+
+	timestamp := 192.5
+
+	mockDB.EXPECT().
+		CreateComment(gomock.Any(), gomock.AssignableToTypeOf(db.CreateCommentParams{})).
+		DoAndReturn(func(ctx context.Context, params db.CreateCommentParams) error {
+			assert.True(t, params.TimestampSeconds.Valid)
+			assert.Equal(t, timestamp, params.TimestampSeconds.Float64)
+			return nil
+		}).
+		Times(1)
+
+	comment, err := commentAPI.CreateComment(ctx, &proto.CreateCommentRequest{
+		Content:          "At 3:12 this is wrong",
+		VideoId:          "test-video-id",
+		TimestampSeconds: &timestamp,
+	})
+
+	assert.NoError(t, err)
+	assert.NotNil(t, comment)
+	assert.Equal(t, timestamp, comment.GetTimestampSeconds())
+}
+
+func TestCreateComment_ReplyWithTimestamp(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockDB := mockdb.NewMockQuerier(ctrl)
+	logger := slog.Default()
+	commentAPI := NewCommentAPITest(mockDB, logger)
+	allowVideoAccess(ctrl, commentAPI)
+	ctx := buildAuthContext()
+
+	parentID := "parent-comment-id"
+	timestamp := 10.0
+
+	resp, err := commentAPI.CreateComment(ctx, &proto.CreateCommentRequest{
+		Content:          "Reply",
+		VideoId:          "test-video-id",
+		ParentCommentId:  &parentID,
+		TimestampSeconds: &timestamp,
+	})
+
+	assert.Error(t, err)
+	assert.Nil(t, resp)
+	assert.Contains(t, err.Error(), "replies cannot have a timestamp")
+}
+
+func TestListComments_TimestampRange(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockDB := mockdb.NewMockQuerier(ctrl)
+	logger := slog.Default()
+	commentAPI := NewCommentAPITest(mockDB, logger)
+	allowVideoAccess(ctrl, commentAPI)
+	ctx := buildAuthContext()
+
+	from, to := 60.0, 120.0
+
+	mockDB.EXPECT().
+		GetComentsAndRepliesForVideoID(gomock.Any(), db.GetComentsAndRepliesForVideoIDParams{
+			UserID:      "test-user-id",
+			VideoID:     "test-video-id",
+			TenantID:    sqlNullString("test-tenant"),
+			FromSeconds: sql.NullFloat64{Float64: from, Valid: true},
+			ToSeconds:   sql.NullFloat64{Float64: to, Valid: true},
+			SortBy:      "timestamp",
+			PageSize:    21,
+		}).
+		Return([]db.GetComentsAndRepliesForVideoIDRow{
+			{
+				ID:               "comment-1",
+				Content:          "Test comment 1",
+				VideoID:          "test-video-id",
+				UserID:           "user-1",
+				TimestampSeconds: sql.NullFloat64{Float64: 90, Valid: true},
+				Replies:          `[]`,
+			},
+		}, nil).
+		Times(1)
+
+	mockDB.EXPECT().
+		CountCommentsForVideoID(gomock.Any(), gomock.Any()).
+		Return(int64(1), nil).
+		Times(1)
+
+	resp, err := commentAPI.ListComments(ctx, &proto.ListCommentsRequest{
+		VideoId:     "test-video-id",
+		SortBy:      proto.CommentSortOrder_COMMENT_SORT_TIMESTAMP,
+		FromSeconds: &from,
+		ToSeconds:   &to,
+	})
+
+	assert.NoError(t, err)
+	assert.Len(t, resp.Comments, 1)
+	assert.Equal(t, 90.0, resp.Comments[0].GetTimestampSeconds())
+}
+
+func TestListComments_InvalidTimestampRange(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockDB := mockdb.NewMockQuerier(ctrl)
+	logger := slog.Default()
+	commentAPI := NewCommentAPITest(mockDB, logger)
+	allowVideoAccess(ctrl, commentAPI)
+	ctx := buildAuthContext()
+
+	from, to := 120.0, 60.0
+
+	resp, err := commentAPI.ListComments(ctx, &proto.ListCommentsRequest{
+		VideoId:     "test-video-id",
+		FromSeconds: &from,
+		ToSeconds:   &to,
+	})
+
+	assert.Error(t, err)
+	assert.Nil(t, resp)
+}
+
+func TestListComments_Pagination(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockDB := mockdb.NewMockQuerier(ctrl)
+	commentAPI := NewCommentAPITest(mockDB, slog.Default())
+	allowVideoAccess(ctrl, commentAPI)
+	ctx := buildAuthContext()
+
+	mockDB.EXPECT().
+		CountCommentsForVideoID(gomock.Any(), gomock.Any()).
+		Return(int64(3), nil).
+		Times(2)
+
+	// First page: the extra row means there is a next page
+	mockDB.EXPECT().
+		GetComentsAndRepliesForVideoID(gomock.Any(), db.GetComentsAndRepliesForVideoIDParams{UserID: "test-user-id", VideoID: "test-video-id", TenantID: sqlNullString("test-tenant"), SortBy: "oldest", PageSize: 3}).
+		Return([]db.GetComentsAndRepliesForVideoIDRow{
+			{ID: "comment-1", Replies: `[]`},
+			{ID: "comment-2", Replies: `[]`},
+			{ID: "comment-3", Replies: `[]`},
+		}, nil).
+		Times(1)
+
+	resp, err := commentAPI.ListComments(ctx, &proto.ListCommentsRequest{
+		VideoId:  "test-video-id",
+		SortBy:   proto.CommentSortOrder_COMMENT_SORT_OLDEST,
+		PageSize: 2,
+	})
+	assert.NoError(t, err)
+	assert.Len(t, resp.Comments, 2)
+	assert.Equal(t, int32(3), resp.TotalCount)
+	assert.NotEmpty(t, resp.NextPageToken)
+
+	// Second page resumes after the last comment of the first one
+	mockDB.EXPECT().
+		GetComentsAndRepliesForVideoID(gomock.Any(), db.GetComentsAndRepliesForVideoIDParams{UserID: "test-user-id", VideoID: "test-video-id", TenantID: sqlNullString("test-tenant"), SortBy: "oldest", AfterID: "comment-2", PageSize: 3}).
+		Return([]db.GetComentsAndRepliesForVideoIDRow{
+			{ID: "comment-3", Replies: `[]`},
+		}, nil).
+		Times(1)
+
+	resp, err = commentAPI.ListComments(ctx, &proto.ListCommentsRequest{
+		VideoId:   "test-video-id",
+		SortBy:    proto.CommentSortOrder_COMMENT_SORT_OLDEST,
+		PageSize:  2,
+		PageToken: resp.NextPageToken,
+	})
+	assert.NoError(t, err)
+	assert.Len(t, resp.Comments, 1)
+	assert.Empty(t, resp.NextPageToken)
+}
+
+func TestListComments_TokenFromOtherSortOrder(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockDB := mockdb.NewMockQuerier(ctrl)
+	commentAPI := NewCommentAPITest(mockDB, slog.Default())
+	allowVideoAccess(ctrl, commentAPI)
+
+	_, err := commentAPI.ListComments(buildAuthContext(), &proto.ListCommentsRequest{
+		VideoId:   "test-video-id",
+		SortBy:    proto.CommentSortOrder_COMMENT_SORT_NEWEST,
+		PageToken: pagination.EncodeToken("comments:test-video-id:oldest", "comment-2"),
+	})
+
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestListComments_NoVideoAccess(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockDB := mockdb.NewMockQuerier(ctrl)
+	mockVideo := videoProto.NewMockVideoServiceClient(ctrl)
+	commentAPI := NewCommentAPITest(mockDB, slog.Default())
+	commentAPI.videoServiceClient = mockVideo
+	ctx := buildAuthContext()
+
+	mockVideo.EXPECT().
+		GetVideo(gomock.Any(), gomock.Any()).
+		Return(nil, status.Error(codes.PermissionDenied, "access denied")).
+		Times(1)
+
+	resp, err := commentAPI.ListComments(ctx, &proto.ListCommentsRequest{
+		VideoId: "other-tenant-video",
+	})
+
+	assert.Nil(t, resp)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+}
+
+func TestCreateComment_MissingTenant(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockDB := mockdb.NewMockQuerier(ctrl)
+	commentAPI := NewCommentAPITest(mockDB, slog.Default())
+	allowVideoAccess(ctrl, commentAPI)
+
+	// Authenticated but without the x-tenant-id header
+	ctx := context.WithValue(context.Background(), auth.AUTH_CONTEXT_KEY, &auth.AuthContext{
+		User: &auth.User{ID: "test-user-id"},
+	})
+
+	resp, err := commentAPI.CreateComment(ctx, &proto.CreateCommentRequest{
+		Content: "Hello",
+		VideoId: "test-video-id",
+	})
+
+	assert.Nil(t, resp)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestGetComment_OtherTenant(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockDB := mockdb.NewMockQuerier(ctrl)
+	commentAPI := NewCommentAPITest(mockDB, slog.Default())
+	allowVideoAccess(ctrl, commentAPI)
+	ctx := buildAuthContext()
+
+	mockDB.EXPECT().
+		GetCommentByID(gomock.Any(), gomock.Any()).
+		Return(db.CommentserviceComment{
+			ID:       "comment-1",
+			VideoID:  "test-video-id",
+			UserID:   "test-user-id",
+			TenantID: sqlNullString("other-tenant"),
+		}, nil).
+		Times(1)
+
+	resp, err := commentAPI.GetComment(ctx, &proto.GetCommentRequest{CommentId: "comment-1"})
+
+	assert.Nil(t, resp)
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
@@ -0,0 +1,282 @@
+package api
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"sortedstartup.com/stream/commentservice/db"
+	"sortedstartup.com/stream/commentservice/proto"
+	"sortedstartup.com/stream/common/auth"
+	"sortedstartup.com/stream/common/constants"
+	"sortedstartup.com/stream/common/interceptors"
+	videoProto "sortedstartup.com/stream/videoservice/proto"
+)
+
+// UpdateComment edits a top-level comment. Only the author can edit it.
+func (s *CommentAPI) UpdateComment(ctx context.Context, req *proto.UpdateCommentRequest) (*proto.Comment, error) {
+	authContext, err := interceptors.AuthFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "unauthenticated")
+	}
+
+	comment, err := s.getActiveComment(ctx, req.CommentId)
+	if err != nil {
+		return nil, err
+	}
+	if comment.ParentCommentID.Valid {
+		return nil, status.Error(codes.InvalidArgument, "comment is a reply, use UpdateReply instead")
+	}
+
+	updated, mentions, err := s.updateCommentContent(ctx, authContext, comment, req.Content)
+	if err != nil {
+		return nil, err
+	}
+
+	protoComment := commentToProto(updated)
+	protoComment.Mentions = mentions
+	return protoComment, nil
+}
+
+// DeleteComment soft deletes a top-level comment. The author and the owner of the
+// video's channel can delete it. Replies stay attached to the deleted comment.
+func (s *CommentAPI) DeleteComment(ctx context.Context, req *proto.DeleteCommentRequest) (*proto.Empty, error) {
+	authContext, err := interceptors.AuthFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "unauthenticated")
+	}
+
+	comment, err := s.getActiveComment(ctx, req.CommentId)
+	if err != nil {
+		return nil, err
+	}
+	if comment.ParentCommentID.Valid {
+		return nil, status.Error(codes.InvalidArgument, "comment is a reply, use DeleteReply instead")
+	}
+
+	if err := s.deleteComment(ctx, authContext, comment); err != nil {
+		return nil, err
+	}
+
+	return &proto.Empty{}, nil
+}
+
+// getActiveComment loads a comment or reply that has not been deleted
+func (s *CommentAPI) getActiveComment(ctx context.Context, commentID string) (db.CommentserviceComment, error) {
+	comment, err := s.getCommentIncludingDeleted(ctx, commentID)
+	if err != nil {
+		return comment, err
+	}
+	if comment.IsDeleted {
+		return comment, status.Error(codes.NotFound, "comment not found")
+	}
+	return comment, nil
+}
+
+// getCommentIncludingDeleted loads a comment or reply the caller is allowed to see
+func (s *CommentAPI) getCommentIncludingDeleted(ctx context.Context, commentID string) (db.CommentserviceComment, error) {
+	if commentID == "" {
+		return db.CommentserviceComment{}, status.Error(codes.InvalidArgument, "comment ID is required")
+	}
+
+	comment, err := s.dbQueries.GetCommentByCommentID(ctx, commentID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return comment, status.Error(codes.NotFound, "comment not found")
+		}
+		s.log.Error("Error getting comment", "err", err, "commentID", commentID)
+		return comment, status.Error(codes.Internal, "internal error")
+	}
+
+	if err := s.authorizeCommentAccess(ctx, comment); err != nil {
+		return comment, err
+	}
+	return comment, nil
+}
+
+// authorizeCommentAccess checks the comment belongs to the caller's tenant and the caller can view its video
+func (s *CommentAPI) authorizeCommentAccess(ctx context.Context, comment db.CommentserviceComment) error {
+	tenantID, err := s.authorizeVideoAccess(ctx, comment.VideoID)
+	if err != nil {
+		return err
+	}
+
+	// Comments created before tenant_id was recorded are scoped by their video alone
+	if comment.TenantID.Valid && comment.TenantID.String != tenantID {
+		return status.Error(codes.NotFound, "comment not found")
+	}
+	return nil
+}
+
+// authorizeVideoAccess checks the caller can view the video in the x-tenant-id tenant and returns that tenant.
+// videoservice owns the tenant membership and channel access rules, so GetVideo is the source of truth.
+func (s *CommentAPI) authorizeVideoAccess(ctx context.Context, videoID string) (string, error) {
+	tenantID, err := interceptors.GetTenantIDFromContext(ctx)
+	if err != nil {
+		return "", status.Error(codes.InvalidArgument, "tenant ID is required")
+	}
+
+	if videoID == "" {
+		return "", status.Error(codes.InvalidArgument, "video ID is required")
+	}
+
+	_, err = s.videoServiceClient.GetVideo(ctx, &videoProto.GetVideoRequest{VideoId: videoID})
+	if err != nil {
+		// NotFound, PermissionDenied etc. from videoservice are passed through as is
+		if _, ok := status.FromError(err); ok {
+			return "", err
+		}
+		s.log.Error("Error checking video access", "err", err, "videoID", videoID)
+		return "", status.Error(codes.Internal, "failed to check video access")
+	}
+
+	return tenantID, nil
+}
+
+// updateCommentContent is shared by UpdateComment and UpdateReply.
+// Mentions are resolved again from the new content.
+func (s *CommentAPI) updateCommentContent(ctx context.Context, authContext *auth.AuthContext, comment db.CommentserviceComment, content string) (db.CommentserviceComment, []*proto.Mention, error) {
+	if strings.TrimSpace(content) == "" {
+		return comment, nil, status.Error(codes.InvalidArgument, "content is required")
+	}
+
+	if comment.UserID != authContext.User.ID {
+		return comment, nil, status.Error(codes.PermissionDenied, "only the author can edit a comment")
+	}
+
+	// The caller's tenant was already matched against the comment's by authorizeCommentAccess
+	tenantID, err := interceptors.GetTenantIDFromContext(ctx)
+	if err != nil {
+		return comment, nil, status.Error(codes.InvalidArgument, "tenant ID is required")
+	}
+
+	mentions, err := s.resolveMentions(ctx, tenantID, comment.VideoID, content)
+	if err != nil {
+		return comment, nil, err
+	}
+
+	// People who were already mentioned have been notified before
+	alreadyMentioned := map[string]bool{}
+	if len(mentions) > 0 {
+		previous, err := s.getMentionsByCommentID(ctx, []string{comment.ID})
+		if err != nil {
+			return comment, nil, err
+		}
+		for _, mention := range previous[comment.ID] {
+			alreadyMentioned[mention.UserId] = true
+		}
+	}
+
+	updated, err := s.dbQueries.UpdateComment(ctx, db.UpdateCommentParams{
+		Content: content,
+		ID:      comment.ID,
+		UserID:  authContext.User.ID,
+	})
+	if err != nil {
+		s.log.Error("Error updating comment", "err", err, "commentID", comment.ID)
+		return comment, nil, status.Error(codes.Internal, "failed to update comment")
+	}
+	if updated == 0 {
+		// Deleted between the lookup and the update
+		return comment, nil, status.Error(codes.NotFound, "comment not found")
+	}
+
+	if err := s.replaceMentions(ctx, tenantID, comment.ID, mentions); err != nil {
+		return comment, nil, err
+	}
+	s.notifyMentions(ctx, tenantID, authContext.User, comment.VideoID, comment.ID, mentions, alreadyMentioned)
+
+	// Re-read to pick up the new updated_at, access was already checked
+	reloaded, err := s.reloadComment(ctx, comment.ID)
+	if err != nil {
+		return comment, nil, err
+	}
+	return reloaded, mentions, nil
+}
+
+// reloadComment reads a comment back after a write, without repeating the access checks
+func (s *CommentAPI) reloadComment(ctx context.Context, commentID string) (db.CommentserviceComment, error) {
+	comment, err := s.dbQueries.GetCommentByCommentID(ctx, commentID)
+	if err != nil {
+		s.log.Error("Error reloading comment", "err", err, "commentID", commentID)
+		return comment, status.Error(codes.Internal, "internal error")
+	}
+	return comment, nil
+}
+
+// deleteComment is shared by DeleteComment and DeleteReply
+func (s *CommentAPI) deleteComment(ctx context.Context, authContext *auth.AuthContext, comment db.CommentserviceComment) error {
+	if comment.UserID != authContext.User.ID {
+		isOwner, err := s.isChannelOwnerOfVideo(ctx, comment.VideoID)
+		if err != nil {
+			return err
+		}
+		if !isOwner {
+			return status.Error(codes.PermissionDenied, "only the author or the channel owner can delete a comment")
+		}
+	}
+
+	deleted, err := s.dbQueries.SoftDeleteComment(ctx, comment.ID)
+	if err != nil {
+		s.log.Error("Error deleting comment", "err", err, "commentID", comment.ID)
+		return status.Error(codes.Internal, "failed to delete comment")
+	}
+	if deleted == 0 {
+		return status.Error(codes.NotFound, "comment not found")
+	}
+
+	// The content is gone, so nobody is mentioned by it anymore
+	err = s.dbQueries.DeleteCommentMentions(ctx, comment.ID)
+	if err != nil {
+		s.log.Error("Error deleting comment mentions", "err", err, "commentID", comment.ID)
+		return status.Error(codes.Internal, "failed to delete comment")
+	}
+	return nil
+}
+
+// isChannelOwnerOfVideo reports whether the caller owns the channel the video belongs to.
+// Videos outside of a channel have no owner besides their uploader.
+func (s *CommentAPI) isChannelOwnerOfVideo(ctx context.Context, videoID string) (bool, error) {
+	video, err := s.videoServiceClient.GetVideo(ctx, &videoProto.GetVideoRequest{VideoId: videoID})
+	if err != nil {
+		s.log.Error("Error getting video", "err", err, "videoID", videoID)
+		return false, err
+	}
+	if video.ChannelId == "" {
+		return false, nil
+	}
+
+	// Owners of archived channels still moderate the comments of their videos
+	channel, err := s.channelServiceClient.GetChannel(ctx, &videoProto.GetChannelRequest{ChannelId: video.ChannelId})
+	if status.Code(err) == codes.PermissionDenied {
+		// Not a member of the channel
+		return false, nil
+	}
+	if err != nil {
+		s.log.Error("Error getting channel", "err", err, "channelID", video.ChannelId)
+		return false, err
+	}
+	return channel.Channel.UserRole == constants.ChannelRoleOwner, nil
+}
+
+func commentToProto(comment db.CommentserviceComment) *proto.Comment {
+	protoComment := &proto.Comment{
+		Id:              comment.ID,
+		Content:         comment.Content,
+		VideoId:         comment.VideoID,
+		UserId:          comment.UserID,
+		Username:        comment.Username.String,
+		ParentCommentId: comment.ParentCommentID.String,
+		CreatedAt:       timestamppb.New(comment.CreatedAt),
+		UpdatedAt:       timestamppb.New(comment.UpdatedAt),
+		IsDeleted:       comment.IsDeleted,
+	}
+	if comment.TimestampSeconds.Valid {
+		timestampSeconds := comment.TimestampSeconds.Float64
+		protoComment.TimestampSeconds = &timestampSeconds
+	}
+	return protoComment
+}
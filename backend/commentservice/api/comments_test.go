@@ -0,0 +1,295 @@
+package api
+
+import (
+	"database/sql"
+	"log/slog"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"sortedstartup.com/stream/commentservice/db"
+	mockdb "sortedstartup.com/stream/commentservice/db/mocks"
+	"sortedstartup.com/stream/commentservice/proto"
+	videoProto "sortedstartup.com/stream/videoservice/proto"
+)
+
+func TestUpdateComment_NotAuthor(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockDB := mockdb.NewMockQuerier(ctrl)
+	commentAPI := NewCommentAPITest(mockDB, slog.Default())
+	allowVideoAccess(ctrl, commentAPI)
+	ctx := buildAuthContext()
+
+	mockDB.EXPECT().
+		GetCommentByCommentID(gomock.Any(), "comment-1").
+		Return(db.CommentserviceComment{ID: "comment-1", UserID: "someone-else", VideoID: "video-1"}, nil).
+		Times(1)
+
+	resp, err := commentAPI.UpdateComment(ctx, &proto.UpdateCommentRequest{
+		CommentId: "comment-1",
+		Content:   "Edited",
+	})
+
+	assert.Nil(t, resp)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+}
+
+func TestUpdateComment(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockDB := mockdb.NewMockQuerier(ctrl)
+	commentAPI := NewCommentAPITest(mockDB, slog.Default())
+	allowVideoAccess(ctrl, commentAPI)
+	ctx := buildAuthContext()
+
+	gomock.InOrder(
+		mockDB.EXPECT().
+			GetCommentByCommentID(gomock.Any(), "comment-1").
+			Return(db.CommentserviceComment{ID: "comment-1", UserID: "test-user-id", VideoID: "video-1", Content: "Typo"}, nil),
+		mockDB.EXPECT().
+			UpdateComment(gomock.Any(), db.UpdateCommentParams{Content: "Fixed", ID: "comment-1", UserID: "test-user-id"}).
+			Return(int64(1), nil),
+		mockDB.EXPECT().
+			DeleteCommentMentions(gomock.Any(), "comment-1").
+			Return(nil),
+		mockDB.EXPECT().
+			GetCommentByCommentID(gomock.Any(), "comment-1").
+			Return(db.CommentserviceComment{ID: "comment-1", UserID: "test-user-id", Content: "Fixed"}, nil),
+	)
+
+	resp, err := commentAPI.UpdateComment(ctx, &proto.UpdateCommentRequest{
+		CommentId: "comment-1",
+		Content:   "Fixed",
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "Fixed", resp.Content)
+}
+
+func TestDeleteComment_ChannelOwner(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockDB := mockdb.NewMockQuerier(ctrl)
+	mockVideo := videoProto.NewMockVideoServiceClient(ctrl)
+	mockChannel := videoProto.NewMockChannelServiceClient(ctrl)
+	commentAPI := NewCommentAPITest(mockDB, slog.Default())
+	commentAPI.videoServiceClient = mockVideo
+	commentAPI.channelServiceClient = mockChannel
+	ctx := buildAuthContext()
+
+	mockDB.EXPECT().
+		GetCommentByCommentID(gomock.Any(), "comment-1").
+		Return(db.CommentserviceComment{ID: "comment-1", UserID: "someone-else", VideoID: "video-1"}, nil).
+		Times(1)
+
+	mockVideo.EXPECT().
+		GetVideo(gomock.Any(), gomock.Any()).
+		Return(&videoProto.Video{Id: "video-1", ChannelId: "channel-1"}, nil).
+		Times(2) // access check, then channel lookup
+
+	mockChannel.EXPECT().
+		GetChannel(gomock.Any(), &videoProto.GetChannelRequest{ChannelId: "channel-1"}).
+		Return(&videoProto.GetChannelResponse{
+			Channel: &videoProto.Channel{Id: "channel-1", UserRole: "owner"},
+		}, nil).
+		Times(1)
+
+	mockDB.EXPECT().
+		SoftDeleteComment(gomock.Any(), "comment-1").
+		Return(int64(1), nil).
+		Times(1)
+
+	mockDB.EXPECT().
+		DeleteCommentMentions(gomock.Any(), "comment-1").
+		Return(nil).
+		Times(1)
+
+	_, err := commentAPI.DeleteComment(ctx, &proto.DeleteCommentRequest{CommentId: "comment-1"})
+
+	assert.NoError(t, err)
+}
+
+func TestDeleteComment_NotAuthorOrOwner(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockDB := mockdb.NewMockQuerier(ctrl)
+	mockVideo := videoProto.NewMockVideoServiceClient(ctrl)
+	mockChannel := videoProto.NewMockChannelServiceClient(ctrl)
+	commentAPI := NewCommentAPITest(mockDB, slog.Default())
+	commentAPI.videoServiceClient = mockVideo
+	commentAPI.channelServiceClient = mockChannel
+	ctx := buildAuthContext()
+
+	mockDB.EXPECT().
+		GetCommentByCommentID(gomock.Any(), "comment-1").
+		Return(db.CommentserviceComment{ID: "comment-1", UserID: "someone-else", VideoID: "video-1"}, nil).
+		Times(1)
+
+	mockVideo.EXPECT().
+		GetVideo(gomock.Any(), gomock.Any()).
+		Return(&videoProto.Video{Id: "video-1", ChannelId: "channel-1"}, nil).
+		Times(2) // access check, then channel lookup
+
+	mockChannel.EXPECT().
+		GetChannel(gomock.Any(), &videoProto.GetChannelRequest{ChannelId: "channel-1"}).
+		Return(&videoProto.GetChannelResponse{
+			Channel: &videoProto.Channel{Id: "channel-1", UserRole: "viewer"},
+		}, nil).
+		Times(1)
+
+	_, err := commentAPI.DeleteComment(ctx, &proto.DeleteCommentRequest{CommentId: "comment-1"})
+
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+}
+
+// Channel owners moderate replies too, also once the channel is archived
+func TestDeleteReply_ArchivedChannelOwner(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockDB := mockdb.NewMockQuerier(ctrl)
+	mockVideo := videoProto.NewMockVideoServiceClient(ctrl)
+	mockChannel := videoProto.NewMockChannelServiceClient(ctrl)
+	commentAPI := NewCommentAPITest(mockDB, slog.Default())
+	commentAPI.videoServiceClient = mockVideo
+	commentAPI.channelServiceClient = mockChannel
+	ctx := buildAuthContext()
+
+	mockDB.EXPECT().
+		GetCommentByCommentID(gomock.Any(), "reply-1").
+		Return(db.CommentserviceComment{
+			ID:              "reply-1",
+			UserID:          "someone-else",
+			VideoID:         "video-1",
+			ParentCommentID: sql.NullString{String: "comment-1", Valid: true},
+		}, nil)
+
+	mockVideo.EXPECT().
+		GetVideo(gomock.Any(), gomock.Any()).
+		Return(&videoProto.Video{Id: "video-1", ChannelId: "channel-1"}, nil).
+		Times(2) // access check, then channel lookup
+
+	mockChannel.EXPECT().
+		GetChannel(gomock.Any(), &videoProto.GetChannelRequest{ChannelId: "channel-1"}).
+		Return(&videoProto.GetChannelResponse{
+			Channel: &videoProto.Channel{Id: "channel-1", UserRole: "owner", ArchivedAt: timestamppb.Now()},
+		}, nil)
+
+	mockDB.EXPECT().SoftDeleteComment(gomock.Any(), "reply-1").Return(int64(1), nil)
+	mockDB.EXPECT().DeleteCommentMentions(gomock.Any(), "reply-1").Return(nil)
+
+	_, err := commentAPI.DeleteReply(ctx, &proto.DeleteReplyRequest{ReplyId: "reply-1"})
+
+	assert.NoError(t, err)
+}
+
+// GetChannel refuses callers who aren't members of the channel, they can't moderate
+func TestDeleteComment_NotChannelMember(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockDB := mockdb.NewMockQuerier(ctrl)
+	mockVideo := videoProto.NewMockVideoServiceClient(ctrl)
+	mockChannel := videoProto.NewMockChannelServiceClient(ctrl)
+	commentAPI := NewCommentAPITest(mockDB, slog.Default())
+	commentAPI.videoServiceClient = mockVideo
+	commentAPI.channelServiceClient = mockChannel
+	ctx := buildAuthContext()
+
+	mockDB.EXPECT().
+		GetCommentByCommentID(gomock.Any(), "comment-1").
+		Return(db.CommentserviceComment{ID: "comment-1", UserID: "someone-else", VideoID: "video-1"}, nil)
+
+	mockVideo.EXPECT().
+		GetVideo(gomock.Any(), gomock.Any()).
+		Return(&videoProto.Video{Id: "video-1", ChannelId: "channel-1"}, nil).
+		Times(2)
+
+	mockChannel.EXPECT().
+		GetChannel(gomock.Any(), &videoProto.GetChannelRequest{ChannelId: "channel-1"}).
+		Return(nil, status.Error(codes.PermissionDenied, "access denied"))
+
+	_, err := commentAPI.DeleteComment(ctx, &proto.DeleteCommentRequest{CommentId: "comment-1"})
+
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	assert.Contains(t, err.Error(), "only the author or the channel owner")
+}
+
+func TestCreateReply_ToReply(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockDB := mockdb.NewMockQuerier(ctrl)
+	commentAPI := NewCommentAPITest(mockDB, slog.Default())
+	allowVideoAccess(ctrl, commentAPI)
+	ctx := buildAuthContext()
+
+	mockDB.EXPECT().
+		GetCommentByCommentID(gomock.Any(), "reply-1").
+		Return(db.CommentserviceComment{ID: "reply-1", VideoID: "video-1", ParentCommentID: sqlNullString("comment-1")}, nil).
+		Times(1)
+
+	resp, err := commentAPI.CreateReply(ctx, &proto.CreateReplyRequest{
+		CommentId: "reply-1",
+		Content:   "Nested",
+	})
+
+	assert.Nil(t, resp)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestGetReplies_Pagination(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockDB := mockdb.NewMockQuerier(ctrl)
+	commentAPI := NewCommentAPITest(mockDB, slog.Default())
+	allowVideoAccess(ctrl, commentAPI)
+	ctx := buildAuthContext()
+
+	// Replies of a deleted comment are still listed
+	mockDB.EXPECT().
+		GetCommentByCommentID(gomock.Any(), "comment-1").
+		Return(db.CommentserviceComment{ID: "comment-1", VideoID: "video-1", IsDeleted: true}, nil).
+		Times(1)
+
+	mockDB.EXPECT().
+		GetRepliesByCommentIDPaginated(gomock.Any(), db.GetRepliesByCommentIDPaginatedParams{
+			CommentID:  sql.NullString{String: "comment-1", Valid: true},
+			PageSize:   2,
+			PageNumber: int64(1),
+		}).
+		Return([]db.CommentserviceComment{
+			{ID: "reply-3", ParentCommentID: sqlNullString("comment-1")},
+		}, nil).
+		Times(1)
+
+	mockDB.EXPECT().
+		GetMentionsByCommentIDs(gomock.Any(), []string{"reply-3"}).
+		Return([]db.CommentserviceCommentMention{
+			{CommentID: "reply-3", MentionedUserID: "user-2", MentionedUsername: "bob@example.com", StartOffset: 0, EndOffset: 4},
+		}, nil).
+		Times(1)
+
+	resp, err := commentAPI.GetReplies(ctx, &proto.GetRepliesRequest{
+		CommentId:  "comment-1",
+		PageSize:   1,
+		PageNumber: 1,
+	})
+
+	assert.NoError(t, err)
+	assert.Len(t, resp.Replies, 1)
+	assert.Equal(t, "comment-1", resp.Replies[0].CommentId)
+	assert.Len(t, resp.Replies[0].Mentions, 1)
+	assert.Equal(t, "user-2", resp.Replies[0].Mentions[0].UserId)
+	assert.Equal(t, int32(0), resp.NextPageNumber)
+}
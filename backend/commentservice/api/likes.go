@@ -0,0 +1,138 @@
+package api
+
+import (
+	"context"
+	"database/sql"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"sortedstartup.com/stream/commentservice/db"
+	"sortedstartup.com/stream/commentservice/proto"
+	"sortedstartup.com/stream/common/interceptors"
+)
+
+const (
+	defaultPageSize = 20
+	maxPageSize     = 100
+)
+
+// LikeComment likes a comment or reply. Liking an already liked comment is a no-op.
+func (s *CommentAPI) LikeComment(ctx context.Context, req *proto.LikeCommentRequest) (*proto.CommentLikeStatus, error) {
+	authContext, err := interceptors.AuthFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "unauthenticated")
+	}
+
+	if _, err := s.getActiveComment(ctx, req.CommentId); err != nil {
+		return nil, err
+	}
+
+	err = s.dbQueries.LikeComment(ctx, db.LikeCommentParams{
+		ID:        generateUUID(),
+		UserID:    authContext.User.ID,
+		Username:  sql.NullString{String: authContext.User.Name, Valid: true},
+		CommentID: req.CommentId,
+	})
+	if err != nil {
+		s.log.Error("Error liking comment", "err", err, "commentID", req.CommentId)
+		return nil, status.Error(codes.Internal, "failed to like comment")
+	}
+
+	return s.commentLikeStatus(ctx, req.CommentId, true)
+}
+
+// UnlikeComment removes the caller's like. Unliking a comment that was not liked is a no-op.
+func (s *CommentAPI) UnlikeComment(ctx context.Context, req *proto.UnlikeCommentRequest) (*proto.CommentLikeStatus, error) {
+	authContext, err := interceptors.AuthFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "unauthenticated")
+	}
+
+	if _, err := s.getActiveComment(ctx, req.CommentId); err != nil {
+		return nil, err
+	}
+
+	err = s.dbQueries.UnlikeComment(ctx, db.UnlikeCommentParams{
+		UserID:    authContext.User.ID,
+		CommentID: req.CommentId,
+	})
+	if err != nil {
+		s.log.Error("Error unliking comment", "err", err, "commentID", req.CommentId)
+		return nil, status.Error(codes.Internal, "failed to unlike comment")
+	}
+
+	return s.commentLikeStatus(ctx, req.CommentId, false)
+}
+
+// ListCommentLikes returns who liked a comment, most recent first
+func (s *CommentAPI) ListCommentLikes(ctx context.Context, req *proto.ListCommentLikesRequest) (*proto.ListCommentLikesResponse, error) {
+	_, err := interceptors.AuthFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "unauthenticated")
+	}
+
+	if _, err := s.getActiveComment(ctx, req.CommentId); err != nil {
+		return nil, err
+	}
+
+	pageSize, pageNumber, err := normalizePagination(req.PageSize, req.PageNumber)
+	if err != nil {
+		return nil, err
+	}
+
+	// Fetch one extra row to know whether there is a next page
+	likes, err := s.dbQueries.GetCommentLikesPaginated(ctx, db.GetCommentLikesPaginatedParams{
+		CommentID:  req.CommentId,
+		PageSize:   int64(pageSize + 1),
+		PageNumber: int64(pageNumber),
+	})
+	if err != nil {
+		s.log.Error("Error listing comment likes", "err", err, "commentID", req.CommentId)
+		return nil, status.Error(codes.Internal, "failed to list comment likes")
+	}
+
+	response := &proto.ListCommentLikesResponse{}
+	if len(likes) > int(pageSize) {
+		likes = likes[:pageSize]
+		response.NextPageNumber = pageNumber + 1
+	}
+	for _, like := range likes {
+		response.Likes = append(response.Likes, &proto.CommentLike{
+			UserId:    like.UserID,
+			Username:  like.Username.String,
+			CreatedAt: timestamppb.New(like.CreatedAt),
+		})
+	}
+
+	return response, nil
+}
+
+func (s *CommentAPI) commentLikeStatus(ctx context.Context, commentID string, likedByMe bool) (*proto.CommentLikeStatus, error) {
+	count, err := s.dbQueries.GetCommentLikesCount(ctx, commentID)
+	if err != nil {
+		s.log.Error("Error counting comment likes", "err", err, "commentID", commentID)
+		return nil, status.Error(codes.Internal, "failed to count comment likes")
+	}
+
+	return &proto.CommentLikeStatus{
+		CommentId: commentID,
+		LikeCount: int32(count),
+		LikedByMe: likedByMe,
+	}, nil
+}
+
+// normalizePagination applies the default page size and rejects out of range values.
+// Page numbers are zero-based.
+func normalizePagination(pageSize, pageNumber int32) (int32, int32, error) {
+	if pageSize < 0 || pageNumber < 0 {
+		return 0, 0, status.Error(codes.InvalidArgument, "page size and page number must not be negative")
+	}
+	if pageSize == 0 {
+		pageSize = defaultPageSize
+	}
+	if pageSize > maxPageSize {
+		pageSize = maxPageSize
+	}
+	return pageSize, pageNumber, nil
+}
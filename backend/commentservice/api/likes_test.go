@@ -0,0 +1,143 @@
+package api
+
+import (
+	"context"
+	"database/sql"
+	"log/slog"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"sortedstartup.com/stream/commentservice/db"
+	mockdb "sortedstartup.com/stream/commentservice/db/mocks"
+	"sortedstartup.com/stream/commentservice/proto"
+)
+
+func TestLikeComment(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockDB := mockdb.NewMockQuerier(ctrl)
+	commentAPI := NewCommentAPITest(mockDB, slog.Default())
+	allowVideoAccess(ctrl, commentAPI)
+	ctx := buildAuthContext()
+
+	mockDB.EXPECT().
+		GetCommentByCommentID(gomock.Any(), "comment-1").
+		Return(db.CommentserviceComment{ID: "comment-1", VideoID: "video-1"}, nil).
+		Times(1)
+
+	mockDB.EXPECT().
+		LikeComment(gomock.Any(), gomock.AssignableToTypeOf(db.LikeCommentParams{})).
+		DoAndReturn(func(ctx context.Context, params db.LikeCommentParams) error {
+			assert.Equal(t, "test-user-id", params.UserID)
+			assert.Equal(t, "Test User", params.Username.String)
+			assert.Equal(t, "comment-1", params.CommentID)
+			return nil
+		}).
+		Times(1)
+
+	mockDB.EXPECT().
+		GetCommentLikesCount(gomock.Any(), "comment-1").
+		Return(int64(3), nil).
+		Times(1)
+
+	resp, err := commentAPI.LikeComment(ctx, &proto.LikeCommentRequest{CommentId: "comment-1"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, int32(3), resp.LikeCount)
+	assert.True(t, resp.LikedByMe)
+}
+
+func TestLikeComment_NotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockDB := mockdb.NewMockQuerier(ctrl)
+	commentAPI := NewCommentAPITest(mockDB, slog.Default())
+	allowVideoAccess(ctrl, commentAPI)
+	ctx := buildAuthContext()
+
+	mockDB.EXPECT().
+		GetCommentByCommentID(gomock.Any(), "missing").
+		Return(db.CommentserviceComment{}, sql.ErrNoRows).
+		Times(1)
+
+	resp, err := commentAPI.LikeComment(ctx, &proto.LikeCommentRequest{CommentId: "missing"})
+
+	assert.Nil(t, resp)
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestUnlikeComment(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockDB := mockdb.NewMockQuerier(ctrl)
+	commentAPI := NewCommentAPITest(mockDB, slog.Default())
+	allowVideoAccess(ctrl, commentAPI)
+	ctx := buildAuthContext()
+
+	mockDB.EXPECT().
+		GetCommentByCommentID(gomock.Any(), "comment-1").
+		Return(db.CommentserviceComment{ID: "comment-1", VideoID: "video-1"}, nil).
+		Times(1)
+
+	mockDB.EXPECT().
+		UnlikeComment(gomock.Any(), db.UnlikeCommentParams{UserID: "test-user-id", CommentID: "comment-1"}).
+		Return(nil).
+		Times(1)
+
+	mockDB.EXPECT().
+		GetCommentLikesCount(gomock.Any(), "comment-1").
+		Return(int64(0), nil).
+		Times(1)
+
+	resp, err := commentAPI.UnlikeComment(ctx, &proto.UnlikeCommentRequest{CommentId: "comment-1"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, int32(0), resp.LikeCount)
+	assert.False(t, resp.LikedByMe)
+}
+
+func TestListCommentLikes_Pagination(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockDB := mockdb.NewMockQuerier(ctrl)
+	commentAPI := NewCommentAPITest(mockDB, slog.Default())
+	allowVideoAccess(ctrl, commentAPI)
+	ctx := buildAuthContext()
+
+	mockDB.EXPECT().
+		GetCommentByCommentID(gomock.Any(), "comment-1").
+		Return(db.CommentserviceComment{ID: "comment-1", VideoID: "video-1"}, nil).
+		Times(1)
+
+	// Page size 2 fetches 3 rows to detect the next page
+	mockDB.EXPECT().
+		GetCommentLikesPaginated(gomock.Any(), db.GetCommentLikesPaginatedParams{
+			CommentID:  "comment-1",
+			PageSize:   3,
+			PageNumber: int64(0),
+		}).
+		Return([]db.CommentserviceCommentLike{
+			{UserID: "user-1", Username: sqlNullString("User1")},
+			{UserID: "user-2", Username: sqlNullString("User2")},
+			{UserID: "user-3", Username: sqlNullString("User3")},
+		}, nil).
+		Times(1)
+
+	resp, err := commentAPI.ListCommentLikes(ctx, &proto.ListCommentLikesRequest{
+		CommentId: "comment-1",
+		PageSize:  2,
+	})
+
+	assert.NoError(t, err)
+	assert.Len(t, resp.Likes, 2)
+	assert.Equal(t, "User1", resp.Likes[0].Username)
+	assert.Equal(t, int32(1), resp.NextPageNumber)
+}
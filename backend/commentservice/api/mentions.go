@@ -0,0 +1,222 @@
+package api
+
+import (
+	"context"
+	"regexp"
+	"sort"
+	"strings"
+	"unicode/utf16"
+	"unicode/utf8"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"sortedstartup.com/stream/commentservice/db"
+	"sortedstartup.com/stream/commentservice/proto"
+	userProto "sortedstartup.com/stream/userservice/proto"
+	videoProto "sortedstartup.com/stream/videoservice/proto"
+)
+
+// maxMentionsPerComment caps how many distinct people one comment can mention
+const maxMentionsPerComment = 20
+
+// mentionPattern matches "@alice" or "@alice@example.com". The character before the @ must not
+// be part of a word, so email addresses written in plain text are not treated as mentions.
+var mentionPattern = regexp.MustCompile(`(?:^|[^A-Za-z0-9_.@])(@([A-Za-z0-9_][A-Za-z0-9._%+\-]*(?:@[A-Za-z0-9\-]+(?:\.[A-Za-z0-9\-]+)+)?))`)
+
+// mentionCandidate is an @handle found in the content, before it is resolved to a user.
+// Offsets are byte offsets into the content.
+type mentionCandidate struct {
+	handle string
+	start  int
+	end    int
+}
+
+// parseMentions finds the @handles in a comment's content, in order of appearance
+func parseMentions(content string) []mentionCandidate {
+	var candidates []mentionCandidate
+	for _, match := range mentionPattern.FindAllStringSubmatchIndex(content, -1) {
+		start, end := match[2], match[3]
+		handle := content[match[4]:match[5]]
+
+		// "thanks @alice." - a sentence ending right after the handle is not part of it
+		trimmed := strings.TrimRight(handle, ".")
+		end -= len(handle) - len(trimmed)
+		if trimmed == "" {
+			continue
+		}
+
+		candidates = append(candidates, mentionCandidate{handle: trimmed, start: start, end: end})
+	}
+	return candidates
+}
+
+// resolveMentions turns the @handles in content into mentions of tenant members who can view the video.
+// Handles that don't match a member, and members who can't see the video, are left as plain text.
+func (s *CommentAPI) resolveMentions(ctx context.Context, tenantID, videoID, content string) ([]*proto.Mention, error) {
+	candidates := parseMentions(content)
+	if len(candidates) == 0 {
+		return nil, nil
+	}
+
+	// Deduplicate handles, case-insensitively like userservice matches them
+	var handles []string
+	seenHandles := map[string]bool{}
+	for _, candidate := range candidates {
+		key := strings.ToLower(candidate.handle)
+		if !seenHandles[key] && len(handles) < maxMentionsPerComment {
+			seenHandles[key] = true
+			handles = append(handles, candidate.handle)
+		}
+	}
+
+	lookup, err := s.tenantServiceClient.LookupUsers(ctx, &userProto.LookupUsersRequest{
+		TenantId: tenantID,
+		Handles:  handles,
+	})
+	if err != nil {
+		s.log.Error("Error looking up mentioned users", "err", err, "tenantID", tenantID)
+		return nil, status.Error(codes.Internal, "failed to resolve mentions")
+	}
+	if len(lookup.Users) == 0 {
+		return nil, nil
+	}
+
+	usersByHandle := make(map[string]*userProto.User, len(lookup.Users))
+	var userIDs []string
+	for _, resolved := range lookup.Users {
+		usersByHandle[strings.ToLower(resolved.Handle)] = resolved.User
+		userIDs = append(userIDs, resolved.User.Id)
+	}
+
+	// Mentioning someone who can't see the video would leak it to them through notifications
+	viewers, err := s.videoServiceClient.FilterVideoViewers(ctx, &videoProto.FilterVideoViewersRequest{
+		VideoId: videoID,
+		UserIds: userIDs,
+	})
+	if err != nil {
+		s.log.Error("Error filtering mentioned users by video access", "err", err, "videoID", videoID)
+		return nil, status.Error(codes.Internal, "failed to resolve mentions")
+	}
+	canView := make(map[string]bool, len(viewers.UserIds))
+	for _, userID := range viewers.UserIds {
+		canView[userID] = true
+	}
+
+	offsets := newUTF16Offsets(content)
+	var mentions []*proto.Mention
+	for _, candidate := range candidates {
+		user, found := usersByHandle[strings.ToLower(candidate.handle)]
+		if !found || !canView[user.Id] {
+			continue
+		}
+		mentions = append(mentions, &proto.Mention{
+			UserId:   user.Id,
+			Username: user.Username,
+			Start:    offsets.at(candidate.start),
+			End:      offsets.at(candidate.end),
+		})
+	}
+	return mentions, nil
+}
+
+// replaceMentions swaps the stored mentions of an edited or deleted comment
+func (s *CommentAPI) replaceMentions(ctx context.Context, tenantID, commentID string, mentions []*proto.Mention) error {
+	err := s.dbQueries.DeleteCommentMentions(ctx, commentID)
+	if err != nil {
+		s.log.Error("Error deleting comment mentions", "err", err, "commentID", commentID)
+		return status.Error(codes.Internal, "failed to save mentions")
+	}
+	return s.saveMentions(ctx, tenantID, commentID, mentions)
+}
+
+// saveMentions stores the mentions of a new comment
+func (s *CommentAPI) saveMentions(ctx context.Context, tenantID, commentID string, mentions []*proto.Mention) error {
+	for _, mention := range mentions {
+		err := s.dbQueries.CreateCommentMention(ctx, db.CreateCommentMentionParams{
+			ID:                generateUUID(),
+			CommentID:         commentID,
+			TenantID:          tenantID,
+			MentionedUserID:   mention.UserId,
+			MentionedUsername: mention.Username,
+			StartOffset:       int64(mention.Start),
+			EndOffset:         int64(mention.End),
+		})
+		if err != nil {
+			s.log.Error("Error saving comment mention", "err", err, "commentID", commentID)
+			return status.Error(codes.Internal, "failed to save mentions")
+		}
+	}
+	return nil
+}
+
+// getMentionsByCommentID loads the mentions of several comments with a single query
+func (s *CommentAPI) getMentionsByCommentID(ctx context.Context, commentIDs []string) (map[string][]*proto.Mention, error) {
+	mentionsByComment := map[string][]*proto.Mention{}
+	if len(commentIDs) == 0 {
+		return mentionsByComment, nil
+	}
+
+	rows, err := s.dbQueries.GetMentionsByCommentIDs(ctx, commentIDs)
+	if err != nil {
+		s.log.Error("Error getting comment mentions", "err", err)
+		return nil, status.Error(codes.Internal, "failed to get mentions")
+	}
+
+	for _, row := range rows {
+		mentionsByComment[row.CommentID] = append(mentionsByComment[row.CommentID], &proto.Mention{
+			UserId:   row.MentionedUserID,
+			Username: row.MentionedUsername,
+			Start:    int32(row.StartOffset),
+			End:      int32(row.EndOffset),
+		})
+	}
+	return mentionsByComment, nil
+}
+
+// mentionJSON is a mention as aggregated by GetComentsAndRepliesForVideoID
+type mentionJSON struct {
+	UserID   string `json:"user_id"`
+	Username string `json:"username"`
+	Start    int32  `json:"start"`
+	End      int32  `json:"end"`
+}
+
+func mentionsFromJSON(mentions []mentionJSON) []*proto.Mention {
+	// json_group_array has no defined order
+	sort.Slice(mentions, func(i, j int) bool { return mentions[i].Start < mentions[j].Start })
+
+	var protoMentions []*proto.Mention
+	for _, m := range mentions {
+		protoMentions = append(protoMentions, &proto.Mention{
+			UserId:   m.UserID,
+			Username: m.Username,
+			Start:    m.Start,
+			End:      m.End,
+		})
+	}
+	return protoMentions
+}
+
+// utf16Offsets converts byte offsets of a string to UTF-16 code unit offsets
+type utf16Offsets struct {
+	byByte map[int]int32
+}
+
+func newUTF16Offsets(content string) utf16Offsets {
+	offsets := utf16Offsets{byByte: make(map[int]int32, utf8.RuneCountInString(content)+1)}
+	var units int32
+	for i, r := range content {
+		offsets.byByte[i] = units
+		if n := utf16.RuneLen(r); n > 0 {
+			units += int32(n)
+		} else {
+			units++ // invalid UTF-8 is decoded as U+FFFD
+		}
+	}
+	offsets.byByte[len(content)] = units
+	return offsets
+}
+
+func (o utf16Offsets) at(byteOffset int) int32 {
+	return o.byByte[byteOffset]
+}
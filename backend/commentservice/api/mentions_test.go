@@ -0,0 +1,167 @@
+package api
+
+import (
+	"log/slog"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+
+	"sortedstartup.com/stream/commentservice/db"
+	mockdb "sortedstartup.com/stream/commentservice/db/mocks"
+	"sortedstartup.com/stream/commentservice/proto"
+	notificationProto "sortedstartup.com/stream/notificationservice/proto"
+	userProto "sortedstartup.com/stream/userservice/proto"
+	videoProto "sortedstartup.com/stream/videoservice/proto"
+)
+
+func TestParseMentions(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		want    []mentionCandidate
+	}{
+		{
+			name:    "no mentions",
+			content: "great video",
+		},
+		{
+			name:    "short handle",
+			content: "@alice look at this",
+			want:    []mentionCandidate{{handle: "alice", start: 0, end: 6}},
+		},
+		{
+			name:    "full email handle",
+			content: "cc @bob@example.com",
+			want:    []mentionCandidate{{handle: "bob@example.com", start: 3, end: 19}},
+		},
+		{
+			name:    "trailing dot is not part of the handle",
+			content: "thanks @alice.",
+			want:    []mentionCandidate{{handle: "alice", start: 7, end: 13}},
+		},
+		{
+			name:    "email address in plain text",
+			content: "write to support@example.com",
+		},
+		{
+			name:    "several mentions",
+			content: "@alice and @bob",
+			want: []mentionCandidate{
+				{handle: "alice", start: 0, end: 6},
+				{handle: "bob", start: 11, end: 15},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, parseMentions(tt.content))
+		})
+	}
+}
+
+func TestUTF16Offsets(t *testing.T) {
+	// "é" is 2 bytes but 1 UTF-16 unit, "😀" is 4 bytes and 2 UTF-16 units
+	content := "é😀 @alice"
+	candidates := parseMentions(content)
+	assert.Len(t, candidates, 1)
+
+	offsets := newUTF16Offsets(content)
+	assert.Equal(t, int32(4), offsets.at(candidates[0].start))
+	assert.Equal(t, int32(10), offsets.at(candidates[0].end))
+}
+
+func TestCreateComment_Mentions(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockDB := mockdb.NewMockQuerier(ctrl)
+	mockTenant := userProto.NewMockTenantServiceClient(ctrl)
+	commentAPI := NewCommentAPITest(mockDB, slog.Default())
+	mockVideo := allowVideoAccess(ctrl, commentAPI)
+	commentAPI.tenantServiceClient = mockTenant
+	ctx := buildAuthContext()
+
+	// @nobody isn't a member, carol is a member who can't see the video
+	mockTenant.EXPECT().
+		LookupUsers(gomock.Any(), &userProto.LookupUsersRequest{
+			TenantId: "test-tenant",
+			Handles:  []string{"alice", "nobody", "carol"},
+		}).
+		Return(&userProto.LookupUsersResponse{
+			Users: []*userProto.ResolvedUser{
+				{Handle: "alice", User: &userProto.User{Id: "user-alice", Username: "alice@example.com"}},
+				{Handle: "carol", User: &userProto.User{Id: "user-carol", Username: "carol@example.com"}},
+			},
+		}, nil).
+		Times(1)
+
+	mockVideo.EXPECT().
+		FilterVideoViewers(gomock.Any(), &videoProto.FilterVideoViewersRequest{
+			VideoId: "video-1",
+			UserIds: []string{"user-alice", "user-carol"},
+		}).
+		Return(&videoProto.FilterVideoViewersResponse{UserIds: []string{"user-alice"}}, nil).
+		Times(1)
+
+	mockDB.EXPECT().
+		CreateComment(gomock.Any(), gomock.Any()).
+		Return(nil).
+		Times(1)
+
+	mockDB.EXPECT().
+		CreateCommentMention(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ interface{}, params db.CreateCommentMentionParams) error {
+			assert.Equal(t, "test-tenant", params.TenantID)
+			assert.Equal(t, "user-alice", params.MentionedUserID)
+			return nil
+		}).
+		Times(1)
+
+	mockNotifications := allowEvents(ctrl, commentAPI)
+	mockNotifications.EXPECT().
+		Publish(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ interface{}, req *notificationProto.PublishRequest, _ ...grpc.CallOption) (*notificationProto.PublishResponse, error) {
+			assert.Equal(t, notificationProto.NotificationType_NOTIFICATION_TYPE_MENTION, req.Type)
+			assert.Equal(t, []string{"user-alice"}, req.RecipientIds)
+			return &notificationProto.PublishResponse{DeliveredCount: 1}, nil
+		}).
+		Times(1)
+
+	resp, err := commentAPI.CreateComment(ctx, &proto.CreateCommentRequest{
+		Content: "@alice @nobody @carol see 0:42",
+		VideoId: "video-1",
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, []*proto.Mention{
+		{UserId: "user-alice", Username: "alice@example.com", Start: 0, End: 6},
+	}, resp.Mentions)
+}
+
+func TestCreateComment_NoMentionsSkipsLookup(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockDB := mockdb.NewMockQuerier(ctrl)
+	commentAPI := NewCommentAPITest(mockDB, slog.Default())
+	allowVideoAccess(ctrl, commentAPI)
+	allowEvents(ctrl, commentAPI)
+	// No tenant client: a lookup would panic
+	ctx := buildAuthContext()
+
+	mockDB.EXPECT().
+		CreateComment(gomock.Any(), gomock.Any()).
+		Return(nil).
+		Times(1)
+
+	resp, err := commentAPI.CreateComment(ctx, &proto.CreateCommentRequest{
+		Content: "mail me at test@example.com",
+		VideoId: "video-1",
+	})
+
+	assert.NoError(t, err)
+	assert.Empty(t, resp.Mentions)
+}
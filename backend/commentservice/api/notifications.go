@@ -0,0 +1,83 @@
+package api
+
+import (
+	"context"
+	"fmt"
+
+	"sortedstartup.com/stream/commentservice/db"
+	"sortedstartup.com/stream/commentservice/proto"
+	"sortedstartup.com/stream/common/auth"
+	notificationProto "sortedstartup.com/stream/notificationservice/proto"
+)
+
+// notifyReply tells the author of a comment that someone replied to it
+func (s *CommentAPI) notifyReply(ctx context.Context, tenantID string, actor *auth.User, parent db.CommentserviceComment, replyID string) {
+	s.publish(ctx, &notificationProto.PublishRequest{
+		TenantId:     tenantID,
+		RecipientIds: []string{parent.UserID},
+		Type:         notificationProto.NotificationType_NOTIFICATION_TYPE_REPLY,
+		ActorId:      actor.ID,
+		ActorName:    actor.Name,
+		Message:      fmt.Sprintf("%s replied to your comment", actor.Name),
+		VideoId:      parent.VideoID,
+		CommentId:    replyID,
+	})
+}
+
+// notifyMentions tells mentioned users about a comment, skipping the ones in skip.
+// Mentions are already limited to people who can view the video.
+func (s *CommentAPI) notifyMentions(ctx context.Context, tenantID string, actor *auth.User, videoID, commentID string, mentions []*proto.Mention, skip map[string]bool) {
+	var recipientIDs []string
+	for _, mention := range mentions {
+		if !skip[mention.UserId] {
+			recipientIDs = append(recipientIDs, mention.UserId)
+		}
+	}
+	if len(recipientIDs) == 0 {
+		return
+	}
+
+	s.publish(ctx, &notificationProto.PublishRequest{
+		TenantId:     tenantID,
+		RecipientIds: recipientIDs,
+		Type:         notificationProto.NotificationType_NOTIFICATION_TYPE_MENTION,
+		ActorId:      actor.ID,
+		ActorName:    actor.Name,
+		Message:      fmt.Sprintf("%s mentioned you in a comment", actor.Name),
+		VideoId:      videoID,
+		CommentId:    commentID,
+	})
+}
+
+// publishCommentCreated tells the tenant's webhooks about a new comment or reply
+func (s *CommentAPI) publishCommentCreated(ctx context.Context, tenantID string, actor *auth.User, videoID, commentID, parentCommentID, content string) {
+	data := map[string]string{
+		"comment_id": commentID,
+		"video_id":   videoID,
+		"user_id":    actor.ID,
+		"username":   actor.Name,
+		"content":    content,
+	}
+	if parentCommentID != "" {
+		data["parent_comment_id"] = parentCommentID
+	}
+
+	_, err := s.notificationClient.PublishEvent(ctx, &notificationProto.PublishEventRequest{
+		TenantId: tenantID,
+		Type:     notificationProto.EventType_EVENT_TYPE_COMMENT_CREATED,
+		ActorId:  actor.ID,
+		Data:     data,
+	})
+	if err != nil {
+		s.log.Error("Error publishing comment event", "err", err, "commentID", commentID)
+	}
+}
+
+// publish sends a notification. The comment is already saved at this point,
+// so a failure is logged instead of failing the request.
+func (s *CommentAPI) publish(ctx context.Context, req *notificationProto.PublishRequest) {
+	_, err := s.notificationClient.Publish(ctx, req)
+	if err != nil {
+		s.log.Error("Error publishing notification", "err", err, "type", req.Type, "commentID", req.CommentId)
+	}
+}
@@ -0,0 +1,198 @@
+package api
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"sortedstartup.com/stream/commentservice/db"
+	"sortedstartup.com/stream/commentservice/proto"
+	"sortedstartup.com/stream/common/interceptors"
+)
+
+// CreateReply replies to a top-level comment. Replies are one level deep.
+func (s *CommentAPI) CreateReply(ctx context.Context, req *proto.CreateReplyRequest) (*proto.Reply, error) {
+	authContext, err := interceptors.AuthFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "unauthenticated")
+	}
+
+	if strings.TrimSpace(req.Content) == "" {
+		return nil, status.Error(codes.InvalidArgument, "content is required")
+	}
+
+	parent, err := s.getActiveComment(ctx, req.CommentId)
+	if err != nil {
+		return nil, err
+	}
+	if parent.ParentCommentID.Valid {
+		return nil, status.Error(codes.InvalidArgument, "cannot reply to a reply")
+	}
+
+	// getActiveComment already checked the caller can view the parent's video in this tenant
+	tenantID, err := interceptors.GetTenantIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "tenant ID is required")
+	}
+
+	mentions, err := s.resolveMentions(ctx, tenantID, parent.VideoID, req.Content)
+	if err != nil {
+		return nil, err
+	}
+
+	replyID := generateUUID()
+	err = s.dbQueries.CreateComment(ctx, db.CreateCommentParams{
+		ID:              replyID,
+		TenantID:        sql.NullString{String: tenantID, Valid: true},
+		Content:         req.Content,
+		VideoID:         parent.VideoID,
+		UserID:          authContext.User.ID,
+		Username:        sql.NullString{String: authContext.User.Name, Valid: true},
+		ParentCommentID: sql.NullString{String: parent.ID, Valid: true},
+	})
+	if err != nil {
+		s.log.Error("Error creating reply", "err", err, "commentID", parent.ID)
+		return nil, status.Error(codes.Internal, "failed to create reply")
+	}
+
+	if err := s.saveMentions(ctx, tenantID, replyID, mentions); err != nil {
+		return nil, err
+	}
+
+	// The parent's author gets the reply notification, no need to also tell them about a mention
+	s.notifyReply(ctx, tenantID, authContext.User, parent, replyID)
+	s.notifyMentions(ctx, tenantID, authContext.User, parent.VideoID, replyID, mentions, map[string]bool{parent.UserID: true})
+	s.publishCommentCreated(ctx, tenantID, authContext.User, parent.VideoID, replyID, parent.ID, req.Content)
+
+	reply, err := s.reloadComment(ctx, replyID)
+	if err != nil {
+		return nil, err
+	}
+
+	protoReply := replyToProto(reply)
+	protoReply.Mentions = mentions
+	return protoReply, nil
+}
+
+// GetReplies pages through the replies of a comment, oldest first.
+// Replies of a deleted comment are still listed.
+func (s *CommentAPI) GetReplies(ctx context.Context, req *proto.GetRepliesRequest) (*proto.ListRepliesResponse, error) {
+	_, err := interceptors.AuthFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "unauthenticated")
+	}
+
+	if _, err := s.getCommentIncludingDeleted(ctx, req.CommentId); err != nil {
+		return nil, err
+	}
+
+	pageSize, pageNumber, err := normalizePagination(req.PageSize, req.PageNumber)
+	if err != nil {
+		return nil, err
+	}
+
+	// Fetch one extra row to know whether there is a next page
+	replies, err := s.dbQueries.GetRepliesByCommentIDPaginated(ctx, db.GetRepliesByCommentIDPaginatedParams{
+		CommentID:  sql.NullString{String: req.CommentId, Valid: true},
+		PageSize:   int64(pageSize + 1),
+		PageNumber: int64(pageNumber),
+	})
+	if err != nil {
+		s.log.Error("Error getting replies", "err", err, "commentID", req.CommentId)
+		return nil, status.Error(codes.Internal, "failed to get replies")
+	}
+
+	response := &proto.ListRepliesResponse{}
+	if len(replies) > int(pageSize) {
+		replies = replies[:pageSize]
+		response.NextPageNumber = pageNumber + 1
+	}
+
+	replyIDs := make([]string, 0, len(replies))
+	for _, reply := range replies {
+		replyIDs = append(replyIDs, reply.ID)
+	}
+	mentions, err := s.getMentionsByCommentID(ctx, replyIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, reply := range replies {
+		protoReply := replyToProto(reply)
+		protoReply.Mentions = mentions[reply.ID]
+		response.Replies = append(response.Replies, protoReply)
+	}
+
+	return response, nil
+}
+
+// UpdateReply edits a reply. Only the author can edit it.
+func (s *CommentAPI) UpdateReply(ctx context.Context, req *proto.UpdateReplyRequest) (*proto.Reply, error) {
+	authContext, err := interceptors.AuthFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "unauthenticated")
+	}
+
+	reply, err := s.getActiveReply(ctx, req.ReplyId)
+	if err != nil {
+		return nil, err
+	}
+
+	updated, mentions, err := s.updateCommentContent(ctx, authContext, reply, req.Content)
+	if err != nil {
+		return nil, err
+	}
+
+	protoReply := replyToProto(updated)
+	protoReply.Mentions = mentions
+	return protoReply, nil
+}
+
+// DeleteReply soft deletes a reply. The author and the owner of the video's channel can delete it.
+func (s *CommentAPI) DeleteReply(ctx context.Context, req *proto.DeleteReplyRequest) (*proto.Empty, error) {
+	authContext, err := interceptors.AuthFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "unauthenticated")
+	}
+
+	reply, err := s.getActiveReply(ctx, req.ReplyId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.deleteComment(ctx, authContext, reply); err != nil {
+		return nil, err
+	}
+
+	return &proto.Empty{}, nil
+}
+
+func (s *CommentAPI) getActiveReply(ctx context.Context, replyID string) (db.CommentserviceComment, error) {
+	if replyID == "" {
+		return db.CommentserviceComment{}, status.Error(codes.InvalidArgument, "reply ID is required")
+	}
+
+	reply, err := s.getActiveComment(ctx, replyID)
+	if err != nil {
+		return reply, err
+	}
+	if !reply.ParentCommentID.Valid {
+		return reply, status.Error(codes.InvalidArgument, "comment is not a reply, use the comment RPCs instead")
+	}
+	return reply, nil
+}
+
+func replyToProto(reply db.CommentserviceComment) *proto.Reply {
+	return &proto.Reply{
+		Id:        reply.ID,
+		Content:   reply.Content,
+		CommentId: reply.ParentCommentID.String,
+		UserId:    reply.UserID,
+		Username:  reply.Username.String,
+		CreatedAt: timestamppb.New(reply.CreatedAt),
+		UpdatedAt: timestamppb.New(reply.UpdatedAt),
+	}
+}
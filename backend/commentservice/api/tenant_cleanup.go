@@ -0,0 +1,67 @@
+package api
+
+import (
+	"context"
+	"database/sql"
+	"log/slog"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"sortedstartup.com/stream/commentservice/db"
+	"sortedstartup.com/stream/commentservice/proto"
+)
+
+// TenantCleanupAPI deletes the comments of tenants deleted in userservice.
+// It is internal, userservice checks the caller's permissions before calling it.
+type TenantCleanupAPI struct {
+	log       *slog.Logger
+	dbQueries db.Querier
+
+	//implemented proto server
+	proto.UnimplementedTenantCleanupServiceServer
+}
+
+func NewTenantCleanupAPITest(mockDB db.Querier, logger *slog.Logger) *TenantCleanupAPI {
+	return &TenantCleanupAPI{
+		log:       logger,
+		dbQueries: mockDB,
+	}
+}
+
+// DeleteTenantData deletes the comments, replies, likes and mentions of a tenant.
+// Deleting again is harmless, so a failed purge can be retried.
+func (s *TenantCleanupAPI) DeleteTenantData(ctx context.Context, req *proto.DeleteTenantDataRequest) (*proto.DeleteTenantDataResponse, error) {
+	if req.TenantId == "" {
+		return nil, status.Error(codes.InvalidArgument, "tenant ID is required")
+	}
+	tenantID := sql.NullString{String: req.TenantId, Valid: true}
+
+	// Likes and mentions go first, they are found through their comment
+	err := s.dbQueries.DeleteCommentLikesByTenant(ctx, db.DeleteCommentLikesByTenantParams{
+		TenantID: tenantID,
+		VideoIds: req.VideoIds,
+	})
+	if err != nil {
+		s.log.Error("Failed to delete comment likes", "error", err, "tenantID", req.TenantId)
+		return nil, status.Error(codes.Internal, "failed to delete tenant comments")
+	}
+	err = s.dbQueries.DeleteCommentMentionsByTenant(ctx, db.DeleteCommentMentionsByTenantParams{
+		TenantID: tenantID,
+		VideoIds: req.VideoIds,
+	})
+	if err != nil {
+		s.log.Error("Failed to delete comment mentions", "error", err, "tenantID", req.TenantId)
+		return nil, status.Error(codes.Internal, "failed to delete tenant comments")
+	}
+	deleted, err := s.dbQueries.DeleteCommentsByTenant(ctx, db.DeleteCommentsByTenantParams{
+		TenantID: tenantID,
+		VideoIds: req.VideoIds,
+	})
+	if err != nil {
+		s.log.Error("Failed to delete comments", "error", err, "tenantID", req.TenantId)
+		return nil, status.Error(codes.Internal, "failed to delete tenant comments")
+	}
+
+	s.log.Info("Deleted tenant comments", "tenantID", req.TenantId, "comments", deleted)
+	return &proto.DeleteTenantDataResponse{DeletedComments: int32(deleted)}, nil
+}
@@ -0,0 +1,49 @@
+package api
+
+import (
+	"context"
+	"database/sql"
+	"log/slog"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"sortedstartup.com/stream/commentservice/db"
+	mockdb "sortedstartup.com/stream/commentservice/db/mocks"
+	"sortedstartup.com/stream/commentservice/proto"
+)
+
+func TestDeleteTenantData(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockDB := mockdb.NewMockQuerier(ctrl)
+	cleanupAPI := NewTenantCleanupAPITest(mockDB, slog.Default())
+
+	tenantID := sql.NullString{String: "tenant-1", Valid: true}
+	videoIDs := []string{"video-1", "video-2"}
+
+	// Likes and mentions are deleted while their comments can still be found
+	gomock.InOrder(
+		mockDB.EXPECT().
+			DeleteCommentLikesByTenant(gomock.Any(), db.DeleteCommentLikesByTenantParams{TenantID: tenantID, VideoIds: videoIDs}).
+			Return(nil),
+		mockDB.EXPECT().
+			DeleteCommentMentionsByTenant(gomock.Any(), db.DeleteCommentMentionsByTenantParams{TenantID: tenantID, VideoIds: videoIDs}).
+			Return(nil),
+		mockDB.EXPECT().
+			DeleteCommentsByTenant(gomock.Any(), db.DeleteCommentsByTenantParams{TenantID: tenantID, VideoIds: videoIDs}).
+			Return(int64(5), nil),
+	)
+
+	resp, err := cleanupAPI.DeleteTenantData(context.Background(), &proto.DeleteTenantDataRequest{TenantId: "tenant-1", VideoIds: videoIDs})
+	require.NoError(t, err)
+	assert.Equal(t, int32(5), resp.DeletedComments)
+
+	_, err = cleanupAPI.DeleteTenantData(context.Background(), &proto.DeleteTenantDataRequest{})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
@@ -0,0 +1,17 @@
+-- @mentions resolved when a comment is written
+-- Offsets are in UTF-16 code units so clients can slice the content directly, end is exclusive
+
+CREATE TABLE commentservice_comment_mentions (
+    id TEXT PRIMARY KEY,
+    comment_id TEXT NOT NULL REFERENCES commentservice_comments(id) ON DELETE CASCADE,
+    tenant_id TEXT NOT NULL, -- References userservice_tenants(id) but no FK constraint
+    mentioned_user_id TEXT NOT NULL, -- References userservice_users(id) but no FK constraint
+    mentioned_username TEXT NOT NULL,
+    start_offset INTEGER NOT NULL,
+    end_offset INTEGER NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_commentservice_comment_mentions_comment_id ON commentservice_comment_mentions(comment_id);
+-- "Where was I mentioned" lookups
+CREATE INDEX idx_commentservice_comment_mentions_tenant_user ON commentservice_comment_mentions(tenant_id, mentioned_user_id);
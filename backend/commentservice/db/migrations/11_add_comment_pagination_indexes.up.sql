@@ -0,0 +1,2 @@
+-- ListComments pages through the top level comments of a video with the id as tie breaker
+CREATE INDEX idx_commentservice_comments_video_parent_created ON commentservice_comments(video_id, parent_comment_id, created_at, id);
@@ -0,0 +1,7 @@
+-- Anchor comments to a moment in the video
+-- NULL means a general comment that is not tied to a playback time
+
+ALTER TABLE commentservice_comments ADD COLUMN timestamp_seconds REAL;
+
+-- Timeline markers query comments of a video by time range
+CREATE INDEX idx_commentservice_comments_video_timestamp ON commentservice_comments(video_id, timestamp_seconds);
@@ -0,0 +1,4 @@
+-- Store the display name on likes, the same way comments store it,
+-- so listing who liked a comment doesn't need the userservice
+
+ALTER TABLE commentservice_comment_likes ADD COLUMN username TEXT;
@@ -0,0 +1,4 @@
+-- Soft delete comments so replies keep their parent and threads stay intact
+-- Deleted comments have their content cleared
+
+ALTER TABLE commentservice_comments ADD COLUMN is_deleted BOOLEAN NOT NULL DEFAULT 0;
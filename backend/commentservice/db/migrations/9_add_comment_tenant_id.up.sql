@@ -0,0 +1,7 @@
+-- Record the tenant a comment was written in
+-- Comments created before this migration have no tenant. They are still scoped by their video,
+-- which only exists in one tenant, so they stay visible to viewers of that video.
+
+ALTER TABLE commentservice_comments ADD COLUMN tenant_id TEXT; -- References userservice_tenants(id) but no FK constraint
+
+CREATE INDEX idx_commentservice_comments_tenant_video ON commentservice_comments(tenant_id, video_id);
@@ -51,6 +51,21 @@ func (mr *MockQuerierMockRecorder) CheckUserLikedComment(ctx, arg interface{}) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckUserLikedComment", reflect.TypeOf((*MockQuerier)(nil).CheckUserLikedComment), ctx, arg)
 }
 
+// CountCommentsForVideoID mocks base method.
+func (m *MockQuerier) CountCommentsForVideoID(ctx context.Context, arg db.CountCommentsForVideoIDParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountCommentsForVideoID", ctx, arg)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountCommentsForVideoID indicates an expected call of CountCommentsForVideoID.
+func (mr *MockQuerierMockRecorder) CountCommentsForVideoID(ctx, arg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountCommentsForVideoID", reflect.TypeOf((*MockQuerier)(nil).CountCommentsForVideoID), ctx, arg)
+}
+
 // CreateComment mocks base method.
 func (m *MockQuerier) CreateComment(ctx context.Context, arg db.CreateCommentParams) error {
 	m.ctrl.T.Helper()
@@ -65,18 +80,75 @@ func (mr *MockQuerierMockRecorder) CreateComment(ctx, arg interface{}) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateComment", reflect.TypeOf((*MockQuerier)(nil).CreateComment), ctx, arg)
 }
 
-// DeleteComment mocks base method.
-func (m *MockQuerier) DeleteComment(ctx context.Context, arg db.DeleteCommentParams) error {
+// CreateCommentMention mocks base method.
+func (m *MockQuerier) CreateCommentMention(ctx context.Context, arg db.CreateCommentMentionParams) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteComment", ctx, arg)
+	ret := m.ctrl.Call(m, "CreateCommentMention", ctx, arg)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// DeleteComment indicates an expected call of DeleteComment.
-func (mr *MockQuerierMockRecorder) DeleteComment(ctx, arg interface{}) *gomock.Call {
+// CreateCommentMention indicates an expected call of CreateCommentMention.
+func (mr *MockQuerierMockRecorder) CreateCommentMention(ctx, arg interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteComment", reflect.TypeOf((*MockQuerier)(nil).DeleteComment), ctx, arg)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCommentMention", reflect.TypeOf((*MockQuerier)(nil).CreateCommentMention), ctx, arg)
+}
+
+// DeleteCommentLikesByTenant mocks base method.
+func (m *MockQuerier) DeleteCommentLikesByTenant(ctx context.Context, arg db.DeleteCommentLikesByTenantParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteCommentLikesByTenant", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteCommentLikesByTenant indicates an expected call of DeleteCommentLikesByTenant.
+func (mr *MockQuerierMockRecorder) DeleteCommentLikesByTenant(ctx, arg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCommentLikesByTenant", reflect.TypeOf((*MockQuerier)(nil).DeleteCommentLikesByTenant), ctx, arg)
+}
+
+// DeleteCommentMentions mocks base method.
+func (m *MockQuerier) DeleteCommentMentions(ctx context.Context, commentID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteCommentMentions", ctx, commentID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteCommentMentions indicates an expected call of DeleteCommentMentions.
+func (mr *MockQuerierMockRecorder) DeleteCommentMentions(ctx, commentID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCommentMentions", reflect.TypeOf((*MockQuerier)(nil).DeleteCommentMentions), ctx, commentID)
+}
+
+// DeleteCommentMentionsByTenant mocks base method.
+func (m *MockQuerier) DeleteCommentMentionsByTenant(ctx context.Context, arg db.DeleteCommentMentionsByTenantParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteCommentMentionsByTenant", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteCommentMentionsByTenant indicates an expected call of DeleteCommentMentionsByTenant.
+func (mr *MockQuerierMockRecorder) DeleteCommentMentionsByTenant(ctx, arg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCommentMentionsByTenant", reflect.TypeOf((*MockQuerier)(nil).DeleteCommentMentionsByTenant), ctx, arg)
+}
+
+// DeleteCommentsByTenant mocks base method.
+func (m *MockQuerier) DeleteCommentsByTenant(ctx context.Context, arg db.DeleteCommentsByTenantParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteCommentsByTenant", ctx, arg)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteCommentsByTenant indicates an expected call of DeleteCommentsByTenant.
+func (mr *MockQuerierMockRecorder) DeleteCommentsByTenant(ctx, arg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCommentsByTenant", reflect.TypeOf((*MockQuerier)(nil).DeleteCommentsByTenant), ctx, arg)
 }
 
 // GetAllCommentsByUserPaginated mocks base method.
@@ -95,18 +167,33 @@ func (mr *MockQuerierMockRecorder) GetAllCommentsByUserPaginated(ctx, arg interf
 }
 
 // GetComentsAndRepliesForVideoID mocks base method.
-func (m *MockQuerier) GetComentsAndRepliesForVideoID(ctx context.Context, videoID string) ([]db.GetComentsAndRepliesForVideoIDRow, error) {
+func (m *MockQuerier) GetComentsAndRepliesForVideoID(ctx context.Context, arg db.GetComentsAndRepliesForVideoIDParams) ([]db.GetComentsAndRepliesForVideoIDRow, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetComentsAndRepliesForVideoID", ctx, videoID)
+	ret := m.ctrl.Call(m, "GetComentsAndRepliesForVideoID", ctx, arg)
 	ret0, _ := ret[0].([]db.GetComentsAndRepliesForVideoIDRow)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetComentsAndRepliesForVideoID indicates an expected call of GetComentsAndRepliesForVideoID.
-func (mr *MockQuerierMockRecorder) GetComentsAndRepliesForVideoID(ctx, videoID interface{}) *gomock.Call {
+func (mr *MockQuerierMockRecorder) GetComentsAndRepliesForVideoID(ctx, arg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetComentsAndRepliesForVideoID", reflect.TypeOf((*MockQuerier)(nil).GetComentsAndRepliesForVideoID), ctx, arg)
+}
+
+// GetCommentByCommentID mocks base method.
+func (m *MockQuerier) GetCommentByCommentID(ctx context.Context, id string) (db.CommentserviceComment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCommentByCommentID", ctx, id)
+	ret0, _ := ret[0].(db.CommentserviceComment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCommentByCommentID indicates an expected call of GetCommentByCommentID.
+func (mr *MockQuerierMockRecorder) GetCommentByCommentID(ctx, id interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetComentsAndRepliesForVideoID", reflect.TypeOf((*MockQuerier)(nil).GetComentsAndRepliesForVideoID), ctx, videoID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCommentByCommentID", reflect.TypeOf((*MockQuerier)(nil).GetCommentByCommentID), ctx, id)
 }
 
 // GetCommentByID mocks base method.
@@ -154,6 +241,21 @@ func (mr *MockQuerierMockRecorder) GetCommentLikesCount(ctx, commentID interface
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCommentLikesCount", reflect.TypeOf((*MockQuerier)(nil).GetCommentLikesCount), ctx, commentID)
 }
 
+// GetCommentLikesPaginated mocks base method.
+func (m *MockQuerier) GetCommentLikesPaginated(ctx context.Context, arg db.GetCommentLikesPaginatedParams) ([]db.CommentserviceCommentLike, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCommentLikesPaginated", ctx, arg)
+	ret0, _ := ret[0].([]db.CommentserviceCommentLike)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCommentLikesPaginated indicates an expected call of GetCommentLikesPaginated.
+func (mr *MockQuerierMockRecorder) GetCommentLikesPaginated(ctx, arg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCommentLikesPaginated", reflect.TypeOf((*MockQuerier)(nil).GetCommentLikesPaginated), ctx, arg)
+}
+
 // GetCommentsByVideo mocks base method.
 func (m *MockQuerier) GetCommentsByVideo(ctx context.Context, videoID string) ([]db.CommentserviceComment, error) {
 	m.ctrl.T.Helper()
@@ -184,6 +286,21 @@ func (mr *MockQuerierMockRecorder) GetCommentsByVideoPaginated(ctx, arg interfac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCommentsByVideoPaginated", reflect.TypeOf((*MockQuerier)(nil).GetCommentsByVideoPaginated), ctx, arg)
 }
 
+// GetMentionsByCommentIDs mocks base method.
+func (m *MockQuerier) GetMentionsByCommentIDs(ctx context.Context, commentIds []string) ([]db.CommentserviceCommentMention, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMentionsByCommentIDs", ctx, commentIds)
+	ret0, _ := ret[0].([]db.CommentserviceCommentMention)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMentionsByCommentIDs indicates an expected call of GetMentionsByCommentIDs.
+func (mr *MockQuerierMockRecorder) GetMentionsByCommentIDs(ctx, commentIds interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMentionsByCommentIDs", reflect.TypeOf((*MockQuerier)(nil).GetMentionsByCommentIDs), ctx, commentIds)
+}
+
 // GetRepliesByCommentID mocks base method.
 func (m *MockQuerier) GetRepliesByCommentID(ctx context.Context, commentID sql.NullString) ([]db.CommentserviceComment, error) {
 	m.ctrl.T.Helper()
@@ -199,6 +316,21 @@ func (mr *MockQuerierMockRecorder) GetRepliesByCommentID(ctx, commentID interfac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRepliesByCommentID", reflect.TypeOf((*MockQuerier)(nil).GetRepliesByCommentID), ctx, commentID)
 }
 
+// GetRepliesByCommentIDPaginated mocks base method.
+func (m *MockQuerier) GetRepliesByCommentIDPaginated(ctx context.Context, arg db.GetRepliesByCommentIDPaginatedParams) ([]db.CommentserviceComment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRepliesByCommentIDPaginated", ctx, arg)
+	ret0, _ := ret[0].([]db.CommentserviceComment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRepliesByCommentIDPaginated indicates an expected call of GetRepliesByCommentIDPaginated.
+func (mr *MockQuerierMockRecorder) GetRepliesByCommentIDPaginated(ctx, arg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRepliesByCommentIDPaginated", reflect.TypeOf((*MockQuerier)(nil).GetRepliesByCommentIDPaginated), ctx, arg)
+}
+
 // LikeComment mocks base method.
 func (m *MockQuerier) LikeComment(ctx context.Context, arg db.LikeCommentParams) error {
 	m.ctrl.T.Helper()
@@ -228,6 +360,21 @@ func (mr *MockQuerierMockRecorder) ListComments(ctx interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListComments", reflect.TypeOf((*MockQuerier)(nil).ListComments), ctx)
 }
 
+// SoftDeleteComment mocks base method.
+func (m *MockQuerier) SoftDeleteComment(ctx context.Context, id string) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SoftDeleteComment", ctx, id)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SoftDeleteComment indicates an expected call of SoftDeleteComment.
+func (mr *MockQuerierMockRecorder) SoftDeleteComment(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SoftDeleteComment", reflect.TypeOf((*MockQuerier)(nil).SoftDeleteComment), ctx, id)
+}
+
 // UnlikeComment mocks base method.
 func (m *MockQuerier) UnlikeComment(ctx context.Context, arg db.UnlikeCommentParams) error {
 	m.ctrl.T.Helper()
@@ -243,11 +390,12 @@ func (mr *MockQuerierMockRecorder) UnlikeComment(ctx, arg interface{}) *gomock.C
 }
 
 // UpdateComment mocks base method.
-func (m *MockQuerier) UpdateComment(ctx context.Context, arg db.UpdateCommentParams) error {
+func (m *MockQuerier) UpdateComment(ctx context.Context, arg db.UpdateCommentParams) (int64, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "UpdateComment", ctx, arg)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
 // UpdateComment indicates an expected call of UpdateComment.
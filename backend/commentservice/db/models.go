@@ -10,14 +10,17 @@ import (
 )
 
 type CommentserviceComment struct {
-	ID              string
-	Content         string
-	VideoID         string
-	UserID          string
-	ParentCommentID sql.NullString
-	CreatedAt       time.Time
-	UpdatedAt       time.Time
-	Username        sql.NullString
+	ID               string
+	Content          string
+	VideoID          string
+	UserID           string
+	ParentCommentID  sql.NullString
+	CreatedAt        time.Time
+	UpdatedAt        time.Time
+	Username         sql.NullString
+	TimestampSeconds sql.NullFloat64
+	IsDeleted        bool
+	TenantID         sql.NullString
 }
 
 type CommentserviceCommentLike struct {
@@ -25,4 +28,16 @@ type CommentserviceCommentLike struct {
 	CommentID string
 	UserID    string
 	CreatedAt time.Time
+	Username  sql.NullString
+}
+
+type CommentserviceCommentMention struct {
+	ID                string
+	CommentID         string
+	TenantID          string
+	MentionedUserID   string
+	MentionedUsername string
+	StartOffset       int64
+	EndOffset         int64
+	CreatedAt         time.Time
 }
@@ -11,20 +11,37 @@ import (
 
 type Querier interface {
 	CheckUserLikedComment(ctx context.Context, arg CheckUserLikedCommentParams) (int64, error)
+	// Same filters as GetComentsAndRepliesForVideoID, for the total count
+	CountCommentsForVideoID(ctx context.Context, arg CountCommentsForVideoIDParams) (int64, error)
 	CreateComment(ctx context.Context, arg CreateCommentParams) error
-	DeleteComment(ctx context.Context, arg DeleteCommentParams) error
+	// Mention queries
+	CreateCommentMention(ctx context.Context, arg CreateCommentMentionParams) error
+	// Tenant deletion. Comments written before comments recorded their tenant are found by video.
+	DeleteCommentLikesByTenant(ctx context.Context, arg DeleteCommentLikesByTenantParams) error
+	DeleteCommentMentions(ctx context.Context, commentID string) error
+	DeleteCommentMentionsByTenant(ctx context.Context, arg DeleteCommentMentionsByTenantParams) error
+	DeleteCommentsByTenant(ctx context.Context, arg DeleteCommentsByTenantParams) (int64, error)
 	GetAllCommentsByUserPaginated(ctx context.Context, arg GetAllCommentsByUserPaginatedParams) ([]CommentserviceComment, error)
-	GetComentsAndRepliesForVideoID(ctx context.Context, videoID string) ([]GetComentsAndRepliesForVideoIDRow, error)
+	// Deleted comments are only kept as placeholders while they still have replies
+	// Keyset pagination: after_id is the last comment of the previous page, '' for the first page.
+	// Each sort order resumes after that comment's stored values, the id breaks ties.
+	GetComentsAndRepliesForVideoID(ctx context.Context, arg GetComentsAndRepliesForVideoIDParams) ([]GetComentsAndRepliesForVideoIDRow, error)
+	GetCommentByCommentID(ctx context.Context, id string) (CommentserviceComment, error)
 	GetCommentByID(ctx context.Context, arg GetCommentByIDParams) (CommentserviceComment, error)
 	GetCommentCount(ctx context.Context, videoID string) (int64, error)
 	GetCommentLikesCount(ctx context.Context, commentID string) (int64, error)
+	GetCommentLikesPaginated(ctx context.Context, arg GetCommentLikesPaginatedParams) ([]CommentserviceCommentLike, error)
 	GetCommentsByVideo(ctx context.Context, videoID string) ([]CommentserviceComment, error)
 	GetCommentsByVideoPaginated(ctx context.Context, arg GetCommentsByVideoPaginatedParams) ([]CommentserviceComment, error)
+	GetMentionsByCommentIDs(ctx context.Context, commentIds []string) ([]CommentserviceCommentMention, error)
 	GetRepliesByCommentID(ctx context.Context, commentID sql.NullString) ([]CommentserviceComment, error)
+	GetRepliesByCommentIDPaginated(ctx context.Context, arg GetRepliesByCommentIDPaginatedParams) ([]CommentserviceComment, error)
+	// Liking twice is a no-op
 	LikeComment(ctx context.Context, arg LikeCommentParams) error
 	ListComments(ctx context.Context) ([]CommentserviceComment, error)
+	SoftDeleteComment(ctx context.Context, id string) (int64, error)
 	UnlikeComment(ctx context.Context, arg UnlikeCommentParams) error
-	UpdateComment(ctx context.Context, arg UpdateCommentParams) error
+	UpdateComment(ctx context.Context, arg UpdateCommentParams) (int64, error)
 }
 
 var _ Querier = (*Queries)(nil)
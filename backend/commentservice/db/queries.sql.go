@@ -8,6 +8,7 @@ package db
 import (
 	"context"
 	"database/sql"
+	"strings"
 	"time"
 )
 
@@ -28,14 +29,48 @@ func (q *Queries) CheckUserLikedComment(ctx context.Context, arg CheckUserLikedC
 	return count, err
 }
 
+const countCommentsForVideoID = `-- name: CountCommentsForVideoID :one
+SELECT COUNT(*) FROM commentservice_comments c1
+WHERE c1.video_id = ?1
+AND (c1.tenant_id = ?2 OR c1.tenant_id IS NULL)
+AND c1.parent_comment_id IS NULL
+AND (c1.is_deleted = 0 OR EXISTS(
+    SELECT 1 FROM commentservice_comments r WHERE r.parent_comment_id = c1.id AND r.is_deleted = 0
+))
+AND (CAST(?3 AS REAL) IS NULL OR c1.timestamp_seconds >= CAST(?3 AS REAL))
+AND (CAST(?4 AS REAL) IS NULL OR c1.timestamp_seconds <= CAST(?4 AS REAL))
+`
+
+type CountCommentsForVideoIDParams struct {
+	VideoID     string
+	TenantID    sql.NullString
+	FromSeconds sql.NullFloat64
+	ToSeconds   sql.NullFloat64
+}
+
+// Same filters as GetComentsAndRepliesForVideoID, for the total count
+func (q *Queries) CountCommentsForVideoID(ctx context.Context, arg CountCommentsForVideoIDParams) (int64, error) {
+	row := q.db.QueryRowContext(ctx, countCommentsForVideoID,
+		arg.VideoID,
+		arg.TenantID,
+		arg.FromSeconds,
+		arg.ToSeconds,
+	)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
 const createComment = `-- name: CreateComment :exec
 INSERT INTO commentservice_comments (
     id,
+    tenant_id,
     content,
     video_id,
     user_id,
     username,             
     parent_comment_id,
+    timestamp_seconds,
     created_at,
     updated_at
 ) VALUES (
@@ -43,51 +78,186 @@ INSERT INTO commentservice_comments (
     ?2,
     ?3,
     ?4,
-    ?5,           
-    ?6,
+    ?5,
+    ?6,           
+    ?7,
+    ?8,
     CURRENT_TIMESTAMP,
     CURRENT_TIMESTAMP
 )
 `
 
 type CreateCommentParams struct {
-	ID              string
-	Content         string
-	VideoID         string
-	UserID          string
-	Username        sql.NullString
-	ParentCommentID sql.NullString
+	ID               string
+	TenantID         sql.NullString
+	Content          string
+	VideoID          string
+	UserID           string
+	Username         sql.NullString
+	ParentCommentID  sql.NullString
+	TimestampSeconds sql.NullFloat64
 }
 
 func (q *Queries) CreateComment(ctx context.Context, arg CreateCommentParams) error {
 	_, err := q.db.ExecContext(ctx, createComment,
 		arg.ID,
+		arg.TenantID,
 		arg.Content,
 		arg.VideoID,
 		arg.UserID,
 		arg.Username,
 		arg.ParentCommentID,
+		arg.TimestampSeconds,
 	)
 	return err
 }
 
-const deleteComment = `-- name: DeleteComment :exec
-DELETE FROM commentservice_comments 
-WHERE id = ?1 AND user_id = ?2
+const createCommentMention = `-- name: CreateCommentMention :exec
+INSERT INTO commentservice_comment_mentions (
+    id,
+    comment_id,
+    tenant_id,
+    mentioned_user_id,
+    mentioned_username,
+    start_offset,
+    end_offset,
+    created_at
+) VALUES (
+    ?1,
+    ?2,
+    ?3,
+    ?4,
+    ?5,
+    ?6,
+    ?7,
+    CURRENT_TIMESTAMP
+)
 `
 
-type DeleteCommentParams struct {
-	ID     string
-	UserID string
+type CreateCommentMentionParams struct {
+	ID                string
+	CommentID         string
+	TenantID          string
+	MentionedUserID   string
+	MentionedUsername string
+	StartOffset       int64
+	EndOffset         int64
 }
 
-func (q *Queries) DeleteComment(ctx context.Context, arg DeleteCommentParams) error {
-	_, err := q.db.ExecContext(ctx, deleteComment, arg.ID, arg.UserID)
+// Mention queries
+func (q *Queries) CreateCommentMention(ctx context.Context, arg CreateCommentMentionParams) error {
+	_, err := q.db.ExecContext(ctx, createCommentMention,
+		arg.ID,
+		arg.CommentID,
+		arg.TenantID,
+		arg.MentionedUserID,
+		arg.MentionedUsername,
+		arg.StartOffset,
+		arg.EndOffset,
+	)
 	return err
 }
 
+const deleteCommentLikesByTenant = `-- name: DeleteCommentLikesByTenant :exec
+DELETE FROM commentservice_comment_likes
+WHERE comment_id IN (
+    SELECT c.id FROM commentservice_comments c
+    WHERE c.tenant_id = ?1 OR c.video_id IN (/*SLICE:video_ids*/?)
+)
+`
+
+type DeleteCommentLikesByTenantParams struct {
+	TenantID sql.NullString
+	VideoIds []string
+}
+
+// Tenant deletion. Comments written before comments recorded their tenant are found by video.
+func (q *Queries) DeleteCommentLikesByTenant(ctx context.Context, arg DeleteCommentLikesByTenantParams) error {
+	query := deleteCommentLikesByTenant
+	var queryParams []interface{}
+	queryParams = append(queryParams, arg.TenantID)
+	if len(arg.VideoIds) > 0 {
+		for _, v := range arg.VideoIds {
+			queryParams = append(queryParams, v)
+		}
+		query = strings.Replace(query, "/*SLICE:video_ids*/?", strings.Repeat(",?", len(arg.VideoIds))[1:], 1)
+	} else {
+		query = strings.Replace(query, "/*SLICE:video_ids*/?", "NULL", 1)
+	}
+	_, err := q.db.ExecContext(ctx, query, queryParams...)
+	return err
+}
+
+const deleteCommentMentions = `-- name: DeleteCommentMentions :exec
+DELETE FROM commentservice_comment_mentions
+WHERE comment_id = ?1
+`
+
+func (q *Queries) DeleteCommentMentions(ctx context.Context, commentID string) error {
+	_, err := q.db.ExecContext(ctx, deleteCommentMentions, commentID)
+	return err
+}
+
+const deleteCommentMentionsByTenant = `-- name: DeleteCommentMentionsByTenant :exec
+DELETE FROM commentservice_comment_mentions
+WHERE comment_id IN (
+    SELECT c.id FROM commentservice_comments c
+    WHERE c.tenant_id = ?1 OR c.video_id IN (/*SLICE:video_ids*/?)
+)
+`
+
+type DeleteCommentMentionsByTenantParams struct {
+	TenantID sql.NullString
+	VideoIds []string
+}
+
+func (q *Queries) DeleteCommentMentionsByTenant(ctx context.Context, arg DeleteCommentMentionsByTenantParams) error {
+	query := deleteCommentMentionsByTenant
+	var queryParams []interface{}
+	queryParams = append(queryParams, arg.TenantID)
+	if len(arg.VideoIds) > 0 {
+		for _, v := range arg.VideoIds {
+			queryParams = append(queryParams, v)
+		}
+		query = strings.Replace(query, "/*SLICE:video_ids*/?", strings.Repeat(",?", len(arg.VideoIds))[1:], 1)
+	} else {
+		query = strings.Replace(query, "/*SLICE:video_ids*/?", "NULL", 1)
+	}
+	_, err := q.db.ExecContext(ctx, query, queryParams...)
+	return err
+}
+
+const deleteCommentsByTenant = `-- name: DeleteCommentsByTenant :execrows
+DELETE FROM commentservice_comments
+WHERE tenant_id = ?1 OR video_id IN (/*SLICE:video_ids*/?)
+`
+
+type DeleteCommentsByTenantParams struct {
+	TenantID sql.NullString
+	VideoIds []string
+}
+
+func (q *Queries) DeleteCommentsByTenant(ctx context.Context, arg DeleteCommentsByTenantParams) (int64, error) {
+	query := deleteCommentsByTenant
+	var queryParams []interface{}
+	queryParams = append(queryParams, arg.TenantID)
+	if len(arg.VideoIds) > 0 {
+		for _, v := range arg.VideoIds {
+			queryParams = append(queryParams, v)
+		}
+		query = strings.Replace(query, "/*SLICE:video_ids*/?", strings.Repeat(",?", len(arg.VideoIds))[1:], 1)
+	} else {
+		query = strings.Replace(query, "/*SLICE:video_ids*/?", "NULL", 1)
+	}
+	result, err := q.db.ExecContext(ctx, query, queryParams...)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
 const getAllCommentsByUserPaginated = `-- name: GetAllCommentsByUserPaginated :many
-SELECT id, content, video_id, user_id, parent_comment_id, created_at, updated_at, username FROM commentservice_comments 
+SELECT id, content, video_id, user_id, parent_comment_id, created_at, updated_at, username, timestamp_seconds, is_deleted, tenant_id FROM commentservice_comments 
 WHERE user_id = ?1
 ORDER BY created_at DESC
 LIMIT ?3 OFFSET (?2 * ?3)
@@ -117,6 +287,9 @@ func (q *Queries) GetAllCommentsByUserPaginated(ctx context.Context, arg GetAllC
 			&i.CreatedAt,
 			&i.UpdatedAt,
 			&i.Username,
+			&i.TimestampSeconds,
+			&i.IsDeleted,
+			&i.TenantID,
 		); err != nil {
 			return nil, err
 		}
@@ -132,6 +305,7 @@ func (q *Queries) GetAllCommentsByUserPaginated(ctx context.Context, arg GetAllC
 }
 
 const getComentsAndRepliesForVideoID = `-- name: GetComentsAndRepliesForVideoID :many
+WITH sort_params AS (SELECT CAST(?8 AS TEXT) AS sort_by)
 SELECT 
     c1.id, 
     c1.content, 
@@ -139,8 +313,20 @@ SELECT
     c1.user_id,
     c1.username,  
     c1.parent_comment_id,
+    c1.timestamp_seconds,
+    c1.is_deleted,
     c1.created_at,  
     c1.updated_at,  
+    (SELECT COUNT(*) FROM commentservice_comment_likes l WHERE l.comment_id = c1.id) AS like_count,
+    CAST(EXISTS(
+        SELECT 1 FROM commentservice_comment_likes l WHERE l.comment_id = c1.id AND l.user_id = ?1
+    ) AS BOOLEAN) AS liked_by_me,
+    (SELECT json_group_array(json_object(
+        'user_id', m.mentioned_user_id,
+        'username', m.mentioned_username,
+        'start', m.start_offset,
+        'end', m.end_offset
+    )) FROM commentservice_comment_mentions m WHERE m.comment_id = c1.id) AS mentions,
     COALESCE(
         json_group_array(
             json_object(
@@ -150,34 +336,100 @@ SELECT
                 'username', c2.username,  
                 'video_id', c2.video_id,
                 'parent_comment_id', c2.parent_comment_id,
-                'created_at', datetime(c2.created_at, 'unixepoch'), 
-                'updated_at', datetime(c2.updated_at, 'unixepoch')   
+                'created_at', strftime('%Y-%m-%dT%H:%M:%SZ', c2.created_at),
+                'updated_at', strftime('%Y-%m-%dT%H:%M:%SZ', c2.updated_at),
+                'like_count', (SELECT COUNT(*) FROM commentservice_comment_likes l WHERE l.comment_id = c2.id),
+                'liked_by_me', json(CASE WHEN EXISTS(
+                    SELECT 1 FROM commentservice_comment_likes l WHERE l.comment_id = c2.id AND l.user_id = ?1
+                ) THEN 'true' ELSE 'false' END),
+                'mentions', json((SELECT json_group_array(json_object(
+                    'user_id', m.mentioned_user_id,
+                    'username', m.mentioned_username,
+                    'start', m.start_offset,
+                    'end', m.end_offset
+                )) FROM commentservice_comment_mentions m WHERE m.comment_id = c2.id))
             )
         ) FILTER (WHERE c2.id IS NOT NULL), 
         '[]'
     ) AS replies
 FROM commentservice_comments c1
-LEFT JOIN commentservice_comments c2 ON c1.id = c2.parent_comment_id
-WHERE c1.video_id = ?1 
+LEFT JOIN commentservice_comments c2 ON c1.id = c2.parent_comment_id AND c2.is_deleted = 0
+WHERE c1.video_id = ?2 
+AND (c1.tenant_id = ?3 OR c1.tenant_id IS NULL)
 AND c1.parent_comment_id IS NULL
+AND (c1.is_deleted = 0 OR EXISTS(
+    SELECT 1 FROM commentservice_comments r WHERE r.parent_comment_id = c1.id AND r.is_deleted = 0
+))
+AND (CAST(?4 AS REAL) IS NULL OR c1.timestamp_seconds >= CAST(?4 AS REAL))
+AND (CAST(?5 AS REAL) IS NULL OR c1.timestamp_seconds <= CAST(?5 AS REAL))
+AND (CAST(?6 AS TEXT) = '' OR CASE (SELECT sort_by FROM sort_params)
+    WHEN 'timestamp' THEN (c1.timestamp_seconds IS NULL, COALESCE(c1.timestamp_seconds, 0), c1.created_at, c1.id) > (
+        SELECT a.timestamp_seconds IS NULL, COALESCE(a.timestamp_seconds, 0), a.created_at, a.id
+        FROM commentservice_comments a WHERE a.id = CAST(?6 AS TEXT)
+    )
+    WHEN 'oldest' THEN (c1.created_at, c1.id) > (
+        SELECT a.created_at, a.id FROM commentservice_comments a WHERE a.id = CAST(?6 AS TEXT)
+    )
+    ELSE (c1.created_at, c1.id) < (
+        SELECT a.created_at, a.id FROM commentservice_comments a WHERE a.id = CAST(?6 AS TEXT)
+    )
+END)
 GROUP BY c1.id
-ORDER BY c1.created_at DESC
+ORDER BY
+    -- 'timestamp': playback order, comments without a timestamp last
+    CASE WHEN (SELECT sort_by FROM sort_params) = 'timestamp' THEN c1.timestamp_seconds IS NULL END ASC,
+    CASE WHEN (SELECT sort_by FROM sort_params) = 'timestamp' THEN c1.timestamp_seconds END ASC,
+    -- 'newest': reverse creation order
+    CASE WHEN (SELECT sort_by FROM sort_params) = 'newest' THEN c1.created_at END DESC,
+    CASE WHEN (SELECT sort_by FROM sort_params) = 'newest' THEN c1.id END DESC,
+    -- 'oldest', and the tie breaker of 'timestamp'
+    c1.created_at ASC,
+    c1.id ASC
+LIMIT ?7
 `
 
+type GetComentsAndRepliesForVideoIDParams struct {
+	UserID      string
+	VideoID     string
+	TenantID    sql.NullString
+	FromSeconds sql.NullFloat64
+	ToSeconds   sql.NullFloat64
+	AfterID     string
+	PageSize    int64
+	SortBy      string
+}
+
 type GetComentsAndRepliesForVideoIDRow struct {
-	ID              string
-	Content         string
-	VideoID         string
-	UserID          string
-	Username        sql.NullString
-	ParentCommentID sql.NullString
-	CreatedAt       time.Time
-	UpdatedAt       time.Time
-	Replies         interface{}
-}
-
-func (q *Queries) GetComentsAndRepliesForVideoID(ctx context.Context, videoID string) ([]GetComentsAndRepliesForVideoIDRow, error) {
-	rows, err := q.db.QueryContext(ctx, getComentsAndRepliesForVideoID, videoID)
+	ID               string
+	Content          string
+	VideoID          string
+	UserID           string
+	Username         sql.NullString
+	ParentCommentID  sql.NullString
+	TimestampSeconds sql.NullFloat64
+	IsDeleted        bool
+	CreatedAt        time.Time
+	UpdatedAt        time.Time
+	LikeCount        int64
+	LikedByMe        bool
+	Mentions         interface{}
+	Replies          interface{}
+}
+
+// Deleted comments are only kept as placeholders while they still have replies
+// Keyset pagination: after_id is the last comment of the previous page, ” for the first page.
+// Each sort order resumes after that comment's stored values, the id breaks ties.
+func (q *Queries) GetComentsAndRepliesForVideoID(ctx context.Context, arg GetComentsAndRepliesForVideoIDParams) ([]GetComentsAndRepliesForVideoIDRow, error) {
+	rows, err := q.db.QueryContext(ctx, getComentsAndRepliesForVideoID,
+		arg.UserID,
+		arg.VideoID,
+		arg.TenantID,
+		arg.FromSeconds,
+		arg.ToSeconds,
+		arg.AfterID,
+		arg.PageSize,
+		arg.SortBy,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -192,8 +444,13 @@ func (q *Queries) GetComentsAndRepliesForVideoID(ctx context.Context, videoID st
 			&i.UserID,
 			&i.Username,
 			&i.ParentCommentID,
+			&i.TimestampSeconds,
+			&i.IsDeleted,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.LikeCount,
+			&i.LikedByMe,
+			&i.Mentions,
 			&i.Replies,
 		); err != nil {
 			return nil, err
@@ -209,8 +466,33 @@ func (q *Queries) GetComentsAndRepliesForVideoID(ctx context.Context, videoID st
 	return items, nil
 }
 
+const getCommentByCommentID = `-- name: GetCommentByCommentID :one
+SELECT id, content, video_id, user_id, parent_comment_id, created_at, updated_at, username, timestamp_seconds, is_deleted, tenant_id FROM commentservice_comments
+WHERE id = ?1
+LIMIT 1
+`
+
+func (q *Queries) GetCommentByCommentID(ctx context.Context, id string) (CommentserviceComment, error) {
+	row := q.db.QueryRowContext(ctx, getCommentByCommentID, id)
+	var i CommentserviceComment
+	err := row.Scan(
+		&i.ID,
+		&i.Content,
+		&i.VideoID,
+		&i.UserID,
+		&i.ParentCommentID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.Username,
+		&i.TimestampSeconds,
+		&i.IsDeleted,
+		&i.TenantID,
+	)
+	return i, err
+}
+
 const getCommentByID = `-- name: GetCommentByID :one
-SELECT id, content, video_id, user_id, parent_comment_id, created_at, updated_at, username FROM commentservice_comments 
+SELECT id, content, video_id, user_id, parent_comment_id, created_at, updated_at, username, timestamp_seconds, is_deleted, tenant_id FROM commentservice_comments 
 WHERE id = ?1 AND user_id = ?2
 LIMIT 1
 `
@@ -232,6 +514,9 @@ func (q *Queries) GetCommentByID(ctx context.Context, arg GetCommentByIDParams)
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.Username,
+		&i.TimestampSeconds,
+		&i.IsDeleted,
+		&i.TenantID,
 	)
 	return i, err
 }
@@ -259,8 +544,50 @@ func (q *Queries) GetCommentLikesCount(ctx context.Context, commentID string) (i
 	return count, err
 }
 
+const getCommentLikesPaginated = `-- name: GetCommentLikesPaginated :many
+SELECT id, comment_id, user_id, created_at, username FROM commentservice_comment_likes
+WHERE comment_id = ?1
+ORDER BY created_at DESC, id ASC
+LIMIT ?3 OFFSET (?2 * ?3)
+`
+
+type GetCommentLikesPaginatedParams struct {
+	CommentID  string
+	PageNumber interface{}
+	PageSize   int64
+}
+
+func (q *Queries) GetCommentLikesPaginated(ctx context.Context, arg GetCommentLikesPaginatedParams) ([]CommentserviceCommentLike, error) {
+	rows, err := q.db.QueryContext(ctx, getCommentLikesPaginated, arg.CommentID, arg.PageNumber, arg.PageSize)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []CommentserviceCommentLike
+	for rows.Next() {
+		var i CommentserviceCommentLike
+		if err := rows.Scan(
+			&i.ID,
+			&i.CommentID,
+			&i.UserID,
+			&i.CreatedAt,
+			&i.Username,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getCommentsByVideo = `-- name: GetCommentsByVideo :many
-SELECT id, content, video_id, user_id, parent_comment_id, created_at, updated_at, username FROM commentservice_comments 
+SELECT id, content, video_id, user_id, parent_comment_id, created_at, updated_at, username, timestamp_seconds, is_deleted, tenant_id FROM commentservice_comments 
 WHERE video_id = ?1
 ORDER BY created_at DESC
 `
@@ -283,6 +610,9 @@ func (q *Queries) GetCommentsByVideo(ctx context.Context, videoID string) ([]Com
 			&i.CreatedAt,
 			&i.UpdatedAt,
 			&i.Username,
+			&i.TimestampSeconds,
+			&i.IsDeleted,
+			&i.TenantID,
 		); err != nil {
 			return nil, err
 		}
@@ -298,7 +628,7 @@ func (q *Queries) GetCommentsByVideo(ctx context.Context, videoID string) ([]Com
 }
 
 const getCommentsByVideoPaginated = `-- name: GetCommentsByVideoPaginated :many
-SELECT id, content, video_id, user_id, parent_comment_id, created_at, updated_at, username FROM commentservice_comments 
+SELECT id, content, video_id, user_id, parent_comment_id, created_at, updated_at, username, timestamp_seconds, is_deleted, tenant_id FROM commentservice_comments 
 WHERE video_id = ?1
 ORDER BY created_at DESC
 LIMIT ?3 OFFSET (?2 * ?3)
@@ -328,6 +658,57 @@ func (q *Queries) GetCommentsByVideoPaginated(ctx context.Context, arg GetCommen
 			&i.CreatedAt,
 			&i.UpdatedAt,
 			&i.Username,
+			&i.TimestampSeconds,
+			&i.IsDeleted,
+			&i.TenantID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getMentionsByCommentIDs = `-- name: GetMentionsByCommentIDs :many
+SELECT id, comment_id, tenant_id, mentioned_user_id, mentioned_username, start_offset, end_offset, created_at FROM commentservice_comment_mentions
+WHERE comment_id IN (/*SLICE:comment_ids*/?)
+ORDER BY comment_id, start_offset
+`
+
+func (q *Queries) GetMentionsByCommentIDs(ctx context.Context, commentIds []string) ([]CommentserviceCommentMention, error) {
+	query := getMentionsByCommentIDs
+	var queryParams []interface{}
+	if len(commentIds) > 0 {
+		for _, v := range commentIds {
+			queryParams = append(queryParams, v)
+		}
+		query = strings.Replace(query, "/*SLICE:comment_ids*/?", strings.Repeat(",?", len(commentIds))[1:], 1)
+	} else {
+		query = strings.Replace(query, "/*SLICE:comment_ids*/?", "NULL", 1)
+	}
+	rows, err := q.db.QueryContext(ctx, query, queryParams...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []CommentserviceCommentMention
+	for rows.Next() {
+		var i CommentserviceCommentMention
+		if err := rows.Scan(
+			&i.ID,
+			&i.CommentID,
+			&i.TenantID,
+			&i.MentionedUserID,
+			&i.MentionedUsername,
+			&i.StartOffset,
+			&i.EndOffset,
+			&i.CreatedAt,
 		); err != nil {
 			return nil, err
 		}
@@ -343,7 +724,7 @@ func (q *Queries) GetCommentsByVideoPaginated(ctx context.Context, arg GetCommen
 }
 
 const getRepliesByCommentID = `-- name: GetRepliesByCommentID :many
-SELECT id, content, video_id, user_id, parent_comment_id, created_at, updated_at, username FROM commentservice_comments 
+SELECT id, content, video_id, user_id, parent_comment_id, created_at, updated_at, username, timestamp_seconds, is_deleted, tenant_id FROM commentservice_comments 
 WHERE parent_comment_id = ?1
 ORDER BY created_at ASC
 `
@@ -366,6 +747,57 @@ func (q *Queries) GetRepliesByCommentID(ctx context.Context, commentID sql.NullS
 			&i.CreatedAt,
 			&i.UpdatedAt,
 			&i.Username,
+			&i.TimestampSeconds,
+			&i.IsDeleted,
+			&i.TenantID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getRepliesByCommentIDPaginated = `-- name: GetRepliesByCommentIDPaginated :many
+SELECT id, content, video_id, user_id, parent_comment_id, created_at, updated_at, username, timestamp_seconds, is_deleted, tenant_id FROM commentservice_comments
+WHERE parent_comment_id = ?1 AND is_deleted = 0
+ORDER BY created_at ASC, id ASC
+LIMIT ?3 OFFSET (?2 * ?3)
+`
+
+type GetRepliesByCommentIDPaginatedParams struct {
+	CommentID  sql.NullString
+	PageNumber interface{}
+	PageSize   int64
+}
+
+func (q *Queries) GetRepliesByCommentIDPaginated(ctx context.Context, arg GetRepliesByCommentIDPaginatedParams) ([]CommentserviceComment, error) {
+	rows, err := q.db.QueryContext(ctx, getRepliesByCommentIDPaginated, arg.CommentID, arg.PageNumber, arg.PageSize)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []CommentserviceComment
+	for rows.Next() {
+		var i CommentserviceComment
+		if err := rows.Scan(
+			&i.ID,
+			&i.Content,
+			&i.VideoID,
+			&i.UserID,
+			&i.ParentCommentID,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.Username,
+			&i.TimestampSeconds,
+			&i.IsDeleted,
+			&i.TenantID,
 		); err != nil {
 			return nil, err
 		}
@@ -384,29 +816,39 @@ const likeComment = `-- name: LikeComment :exec
 INSERT INTO commentservice_comment_likes (
     id,
     user_id,
+    username,
     comment_id,
     created_at
 ) VALUES (
     ?1,
     ?2,
     ?3,
+    ?4,
     CURRENT_TIMESTAMP
 )
+ON CONFLICT(comment_id, user_id) DO NOTHING
 `
 
 type LikeCommentParams struct {
 	ID        string
 	UserID    string
+	Username  sql.NullString
 	CommentID string
 }
 
+// Liking twice is a no-op
 func (q *Queries) LikeComment(ctx context.Context, arg LikeCommentParams) error {
-	_, err := q.db.ExecContext(ctx, likeComment, arg.ID, arg.UserID, arg.CommentID)
+	_, err := q.db.ExecContext(ctx, likeComment,
+		arg.ID,
+		arg.UserID,
+		arg.Username,
+		arg.CommentID,
+	)
 	return err
 }
 
 const listComments = `-- name: ListComments :many
-select id, content, video_id, user_id, parent_comment_id, created_at, updated_at, username from commentservice_comments
+select id, content, video_id, user_id, parent_comment_id, created_at, updated_at, username, timestamp_seconds, is_deleted, tenant_id from commentservice_comments
 `
 
 func (q *Queries) ListComments(ctx context.Context) ([]CommentserviceComment, error) {
@@ -427,6 +869,9 @@ func (q *Queries) ListComments(ctx context.Context) ([]CommentserviceComment, er
 			&i.CreatedAt,
 			&i.UpdatedAt,
 			&i.Username,
+			&i.TimestampSeconds,
+			&i.IsDeleted,
+			&i.TenantID,
 		); err != nil {
 			return nil, err
 		}
@@ -441,6 +886,20 @@ func (q *Queries) ListComments(ctx context.Context) ([]CommentserviceComment, er
 	return items, nil
 }
 
+const softDeleteComment = `-- name: SoftDeleteComment :execrows
+UPDATE commentservice_comments
+SET is_deleted = 1, content = '', updated_at = CURRENT_TIMESTAMP
+WHERE id = ?1 AND is_deleted = 0
+`
+
+func (q *Queries) SoftDeleteComment(ctx context.Context, id string) (int64, error) {
+	result, err := q.db.ExecContext(ctx, softDeleteComment, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
 const unlikeComment = `-- name: UnlikeComment :exec
 DELETE FROM commentservice_comment_likes 
 WHERE user_id = ?1 AND comment_id = ?2
@@ -456,10 +915,10 @@ func (q *Queries) UnlikeComment(ctx context.Context, arg UnlikeCommentParams) er
 	return err
 }
 
-const updateComment = `-- name: UpdateComment :exec
+const updateComment = `-- name: UpdateComment :execrows
 UPDATE commentservice_comments 
 SET content = ?1, updated_at = CURRENT_TIMESTAMP
-WHERE id = ?2 AND user_id = ?3
+WHERE id = ?2 AND user_id = ?3 AND is_deleted = 0
 `
 
 type UpdateCommentParams struct {
@@ -468,7 +927,10 @@ type UpdateCommentParams struct {
 	UserID  string
 }
 
-func (q *Queries) UpdateComment(ctx context.Context, arg UpdateCommentParams) error {
-	_, err := q.db.ExecContext(ctx, updateComment, arg.Content, arg.ID, arg.UserID)
-	return err
+func (q *Queries) UpdateComment(ctx context.Context, arg UpdateCommentParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, updateComment, arg.Content, arg.ID, arg.UserID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
 }
@@ -4,20 +4,24 @@ select * from commentservice_comments;
 -- name: CreateComment :exec
 INSERT INTO commentservice_comments (
     id,
+    tenant_id,
     content,
     video_id,
     user_id,
     username,             
     parent_comment_id,
+    timestamp_seconds,
     created_at,
     updated_at
 ) VALUES (
     @id,
+    @tenant_id,
     @content,
     @video_id,
     @user_id,
     @username,           
     @parent_comment_id,
+    @timestamp_seconds,
     CURRENT_TIMESTAMP,
     CURRENT_TIMESTAMP
 );
@@ -29,6 +33,7 @@ WHERE id = @id AND user_id = @user_id
 LIMIT 1;
 
 -- name: GetComentsAndRepliesForVideoID :many
+WITH sort_params AS (SELECT CAST(@sort_by AS TEXT) AS sort_by)
 SELECT 
     c1.id, 
     c1.content, 
@@ -36,8 +41,20 @@ SELECT
     c1.user_id,
     c1.username,  
     c1.parent_comment_id,
+    c1.timestamp_seconds,
+    c1.is_deleted,
     c1.created_at,  
     c1.updated_at,  
+    (SELECT COUNT(*) FROM commentservice_comment_likes l WHERE l.comment_id = c1.id) AS like_count,
+    CAST(EXISTS(
+        SELECT 1 FROM commentservice_comment_likes l WHERE l.comment_id = c1.id AND l.user_id = @user_id
+    ) AS BOOLEAN) AS liked_by_me,
+    (SELECT json_group_array(json_object(
+        'user_id', m.mentioned_user_id,
+        'username', m.mentioned_username,
+        'start', m.start_offset,
+        'end', m.end_offset
+    )) FROM commentservice_comment_mentions m WHERE m.comment_id = c1.id) AS mentions,
     COALESCE(
         json_group_array(
             json_object(
@@ -47,18 +64,71 @@ SELECT
                 'username', c2.username,  
                 'video_id', c2.video_id,
                 'parent_comment_id', c2.parent_comment_id,
-                'created_at', datetime(c2.created_at, 'unixepoch'), 
-                'updated_at', datetime(c2.updated_at, 'unixepoch')   
+                'created_at', strftime('%Y-%m-%dT%H:%M:%SZ', c2.created_at),
+                'updated_at', strftime('%Y-%m-%dT%H:%M:%SZ', c2.updated_at),
+                'like_count', (SELECT COUNT(*) FROM commentservice_comment_likes l WHERE l.comment_id = c2.id),
+                'liked_by_me', json(CASE WHEN EXISTS(
+                    SELECT 1 FROM commentservice_comment_likes l WHERE l.comment_id = c2.id AND l.user_id = @user_id
+                ) THEN 'true' ELSE 'false' END),
+                'mentions', json((SELECT json_group_array(json_object(
+                    'user_id', m.mentioned_user_id,
+                    'username', m.mentioned_username,
+                    'start', m.start_offset,
+                    'end', m.end_offset
+                )) FROM commentservice_comment_mentions m WHERE m.comment_id = c2.id))
             )
         ) FILTER (WHERE c2.id IS NOT NULL), 
         '[]'
     ) AS replies
 FROM commentservice_comments c1
-LEFT JOIN commentservice_comments c2 ON c1.id = c2.parent_comment_id
+LEFT JOIN commentservice_comments c2 ON c1.id = c2.parent_comment_id AND c2.is_deleted = 0
 WHERE c1.video_id = @video_id 
+AND (c1.tenant_id = @tenant_id OR c1.tenant_id IS NULL)
 AND c1.parent_comment_id IS NULL
+-- Deleted comments are only kept as placeholders while they still have replies
+AND (c1.is_deleted = 0 OR EXISTS(
+    SELECT 1 FROM commentservice_comments r WHERE r.parent_comment_id = c1.id AND r.is_deleted = 0
+))
+AND (CAST(sqlc.narg(from_seconds) AS REAL) IS NULL OR c1.timestamp_seconds >= CAST(sqlc.narg(from_seconds) AS REAL))
+AND (CAST(sqlc.narg(to_seconds) AS REAL) IS NULL OR c1.timestamp_seconds <= CAST(sqlc.narg(to_seconds) AS REAL))
+-- Keyset pagination: after_id is the last comment of the previous page, '' for the first page.
+-- Each sort order resumes after that comment's stored values, the id breaks ties.
+AND (CAST(@after_id AS TEXT) = '' OR CASE (SELECT sort_by FROM sort_params)
+    WHEN 'timestamp' THEN (c1.timestamp_seconds IS NULL, COALESCE(c1.timestamp_seconds, 0), c1.created_at, c1.id) > (
+        SELECT a.timestamp_seconds IS NULL, COALESCE(a.timestamp_seconds, 0), a.created_at, a.id
+        FROM commentservice_comments a WHERE a.id = CAST(@after_id AS TEXT)
+    )
+    WHEN 'oldest' THEN (c1.created_at, c1.id) > (
+        SELECT a.created_at, a.id FROM commentservice_comments a WHERE a.id = CAST(@after_id AS TEXT)
+    )
+    ELSE (c1.created_at, c1.id) < (
+        SELECT a.created_at, a.id FROM commentservice_comments a WHERE a.id = CAST(@after_id AS TEXT)
+    )
+END)
 GROUP BY c1.id
-ORDER BY c1.created_at DESC;
+ORDER BY
+    -- 'timestamp': playback order, comments without a timestamp last
+    CASE WHEN (SELECT sort_by FROM sort_params) = 'timestamp' THEN c1.timestamp_seconds IS NULL END ASC,
+    CASE WHEN (SELECT sort_by FROM sort_params) = 'timestamp' THEN c1.timestamp_seconds END ASC,
+    -- 'newest': reverse creation order
+    CASE WHEN (SELECT sort_by FROM sort_params) = 'newest' THEN c1.created_at END DESC,
+    CASE WHEN (SELECT sort_by FROM sort_params) = 'newest' THEN c1.id END DESC,
+    -- 'oldest', and the tie breaker of 'timestamp'
+    c1.created_at ASC,
+    c1.id ASC
+LIMIT @page_size;
+
+-- Same filters as GetComentsAndRepliesForVideoID, for the total count
+-- name: CountCommentsForVideoID :one
+SELECT COUNT(*) FROM commentservice_comments c1
+WHERE c1.video_id = @video_id
+AND (c1.tenant_id = @tenant_id OR c1.tenant_id IS NULL)
+AND c1.parent_comment_id IS NULL
+AND (c1.is_deleted = 0 OR EXISTS(
+    SELECT 1 FROM commentservice_comments r WHERE r.parent_comment_id = c1.id AND r.is_deleted = 0
+))
+AND (CAST(sqlc.narg(from_seconds) AS REAL) IS NULL OR c1.timestamp_seconds >= CAST(sqlc.narg(from_seconds) AS REAL))
+AND (CAST(sqlc.narg(to_seconds) AS REAL) IS NULL OR c1.timestamp_seconds <= CAST(sqlc.narg(to_seconds) AS REAL));
 
 -- name: GetAllCommentsByUserPaginated :many
 SELECT * FROM commentservice_comments 
@@ -82,14 +152,21 @@ SELECT * FROM commentservice_comments
 WHERE parent_comment_id = @comment_id
 ORDER BY created_at ASC;
 
--- name: UpdateComment :exec
+-- name: UpdateComment :execrows
 UPDATE commentservice_comments 
 SET content = @content, updated_at = CURRENT_TIMESTAMP
-WHERE id = @id AND user_id = @user_id;
+WHERE id = @id AND user_id = @user_id AND is_deleted = 0;
 
--- name: DeleteComment :exec
-DELETE FROM commentservice_comments 
-WHERE id = @id AND user_id = @user_id;
+-- name: SoftDeleteComment :execrows
+UPDATE commentservice_comments
+SET is_deleted = 1, content = '', updated_at = CURRENT_TIMESTAMP
+WHERE id = @id AND is_deleted = 0;
+
+-- name: GetRepliesByCommentIDPaginated :many
+SELECT * FROM commentservice_comments
+WHERE parent_comment_id = @comment_id AND is_deleted = 0
+ORDER BY created_at ASC, id ASC
+LIMIT @page_size OFFSET (@page_number * @page_size);
 
 -- name: GetCommentCount :one
 SELECT COUNT(*) FROM commentservice_comments WHERE video_id = @video_id;
@@ -98,14 +175,18 @@ SELECT COUNT(*) FROM commentservice_comments WHERE video_id = @video_id;
 INSERT INTO commentservice_comment_likes (
     id,
     user_id,
+    username,
     comment_id,
     created_at
 ) VALUES (
     @id,
     @user_id,
+    @username,
     @comment_id,
     CURRENT_TIMESTAMP
-);
+)
+-- Liking twice is a no-op
+ON CONFLICT(comment_id, user_id) DO NOTHING;
 
 -- name: UnlikeComment :exec
 DELETE FROM commentservice_comment_likes 
@@ -117,4 +198,65 @@ WHERE comment_id = @comment_id;
 
 -- name: CheckUserLikedComment :one
 SELECT COUNT(*) FROM commentservice_comment_likes 
-WHERE user_id = @user_id AND comment_id = @comment_id;
\ No newline at end of file
+WHERE user_id = @user_id AND comment_id = @comment_id;
+
+-- name: GetCommentByCommentID :one
+SELECT * FROM commentservice_comments
+WHERE id = @id
+LIMIT 1;
+
+-- name: GetCommentLikesPaginated :many
+SELECT * FROM commentservice_comment_likes
+WHERE comment_id = @comment_id
+ORDER BY created_at DESC, id ASC
+LIMIT @page_size OFFSET (@page_number * @page_size);
+
+-- Mention queries
+-- name: CreateCommentMention :exec
+INSERT INTO commentservice_comment_mentions (
+    id,
+    comment_id,
+    tenant_id,
+    mentioned_user_id,
+    mentioned_username,
+    start_offset,
+    end_offset,
+    created_at
+) VALUES (
+    @id,
+    @comment_id,
+    @tenant_id,
+    @mentioned_user_id,
+    @mentioned_username,
+    @start_offset,
+    @end_offset,
+    CURRENT_TIMESTAMP
+);
+
+-- name: DeleteCommentMentions :exec
+DELETE FROM commentservice_comment_mentions
+WHERE comment_id = @comment_id;
+
+-- name: GetMentionsByCommentIDs :many
+SELECT * FROM commentservice_comment_mentions
+WHERE comment_id IN (sqlc.slice(comment_ids))
+ORDER BY comment_id, start_offset;
+
+-- Tenant deletion. Comments written before comments recorded their tenant are found by video.
+-- name: DeleteCommentLikesByTenant :exec
+DELETE FROM commentservice_comment_likes
+WHERE comment_id IN (
+    SELECT c.id FROM commentservice_comments c
+    WHERE c.tenant_id = @tenant_id OR c.video_id IN (sqlc.slice(video_ids))
+);
+
+-- name: DeleteCommentMentionsByTenant :exec
+DELETE FROM commentservice_comment_mentions
+WHERE comment_id IN (
+    SELECT c.id FROM commentservice_comments c
+    WHERE c.tenant_id = @tenant_id OR c.video_id IN (sqlc.slice(video_ids))
+);
+
+-- name: DeleteCommentsByTenant :execrows
+DELETE FROM commentservice_comments
+WHERE tenant_id = @tenant_id OR video_id IN (sqlc.slice(video_ids));
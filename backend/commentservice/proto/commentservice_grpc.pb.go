@@ -19,15 +19,18 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	CommentService_CreateComment_FullMethodName = "/commentservice.CommentService/CreateComment"
-	CommentService_GetComment_FullMethodName    = "/commentservice.CommentService/GetComment"
-	CommentService_ListComments_FullMethodName  = "/commentservice.CommentService/ListComments"
-	CommentService_UpdateComment_FullMethodName = "/commentservice.CommentService/UpdateComment"
-	CommentService_DeleteComment_FullMethodName = "/commentservice.CommentService/DeleteComment"
-	CommentService_CreateReply_FullMethodName   = "/commentservice.CommentService/CreateReply"
-	CommentService_GetReplies_FullMethodName    = "/commentservice.CommentService/GetReplies"
-	CommentService_UpdateReply_FullMethodName   = "/commentservice.CommentService/UpdateReply"
-	CommentService_DeleteReply_FullMethodName   = "/commentservice.CommentService/DeleteReply"
+	CommentService_CreateComment_FullMethodName    = "/commentservice.CommentService/CreateComment"
+	CommentService_GetComment_FullMethodName       = "/commentservice.CommentService/GetComment"
+	CommentService_ListComments_FullMethodName     = "/commentservice.CommentService/ListComments"
+	CommentService_UpdateComment_FullMethodName    = "/commentservice.CommentService/UpdateComment"
+	CommentService_DeleteComment_FullMethodName    = "/commentservice.CommentService/DeleteComment"
+	CommentService_CreateReply_FullMethodName      = "/commentservice.CommentService/CreateReply"
+	CommentService_GetReplies_FullMethodName       = "/commentservice.CommentService/GetReplies"
+	CommentService_UpdateReply_FullMethodName      = "/commentservice.CommentService/UpdateReply"
+	CommentService_DeleteReply_FullMethodName      = "/commentservice.CommentService/DeleteReply"
+	CommentService_LikeComment_FullMethodName      = "/commentservice.CommentService/LikeComment"
+	CommentService_UnlikeComment_FullMethodName    = "/commentservice.CommentService/UnlikeComment"
+	CommentService_ListCommentLikes_FullMethodName = "/commentservice.CommentService/ListCommentLikes"
 )
 
 // CommentServiceClient is the client API for CommentService service.
@@ -43,6 +46,9 @@ type CommentServiceClient interface {
 	GetReplies(ctx context.Context, in *GetRepliesRequest, opts ...grpc.CallOption) (*ListRepliesResponse, error)
 	UpdateReply(ctx context.Context, in *UpdateReplyRequest, opts ...grpc.CallOption) (*Reply, error)
 	DeleteReply(ctx context.Context, in *DeleteReplyRequest, opts ...grpc.CallOption) (*Empty, error)
+	LikeComment(ctx context.Context, in *LikeCommentRequest, opts ...grpc.CallOption) (*CommentLikeStatus, error)
+	UnlikeComment(ctx context.Context, in *UnlikeCommentRequest, opts ...grpc.CallOption) (*CommentLikeStatus, error)
+	ListCommentLikes(ctx context.Context, in *ListCommentLikesRequest, opts ...grpc.CallOption) (*ListCommentLikesResponse, error)
 }
 
 type commentServiceClient struct {
@@ -143,6 +149,36 @@ func (c *commentServiceClient) DeleteReply(ctx context.Context, in *DeleteReplyR
 	return out, nil
 }
 
+func (c *commentServiceClient) LikeComment(ctx context.Context, in *LikeCommentRequest, opts ...grpc.CallOption) (*CommentLikeStatus, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CommentLikeStatus)
+	err := c.cc.Invoke(ctx, CommentService_LikeComment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *commentServiceClient) UnlikeComment(ctx context.Context, in *UnlikeCommentRequest, opts ...grpc.CallOption) (*CommentLikeStatus, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CommentLikeStatus)
+	err := c.cc.Invoke(ctx, CommentService_UnlikeComment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *commentServiceClient) ListCommentLikes(ctx context.Context, in *ListCommentLikesRequest, opts ...grpc.CallOption) (*ListCommentLikesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCommentLikesResponse)
+	err := c.cc.Invoke(ctx, CommentService_ListCommentLikes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // CommentServiceServer is the server API for CommentService service.
 // All implementations must embed UnimplementedCommentServiceServer
 // for forward compatibility.
@@ -156,6 +192,9 @@ type CommentServiceServer interface {
 	GetReplies(context.Context, *GetRepliesRequest) (*ListRepliesResponse, error)
 	UpdateReply(context.Context, *UpdateReplyRequest) (*Reply, error)
 	DeleteReply(context.Context, *DeleteReplyRequest) (*Empty, error)
+	LikeComment(context.Context, *LikeCommentRequest) (*CommentLikeStatus, error)
+	UnlikeComment(context.Context, *UnlikeCommentRequest) (*CommentLikeStatus, error)
+	ListCommentLikes(context.Context, *ListCommentLikesRequest) (*ListCommentLikesResponse, error)
 	mustEmbedUnimplementedCommentServiceServer()
 }
 
@@ -193,6 +232,15 @@ func (UnimplementedCommentServiceServer) UpdateReply(context.Context, *UpdateRep
 func (UnimplementedCommentServiceServer) DeleteReply(context.Context, *DeleteReplyRequest) (*Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteReply not implemented")
 }
+func (UnimplementedCommentServiceServer) LikeComment(context.Context, *LikeCommentRequest) (*CommentLikeStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LikeComment not implemented")
+}
+func (UnimplementedCommentServiceServer) UnlikeComment(context.Context, *UnlikeCommentRequest) (*CommentLikeStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnlikeComment not implemented")
+}
+func (UnimplementedCommentServiceServer) ListCommentLikes(context.Context, *ListCommentLikesRequest) (*ListCommentLikesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCommentLikes not implemented")
+}
 func (UnimplementedCommentServiceServer) mustEmbedUnimplementedCommentServiceServer() {}
 func (UnimplementedCommentServiceServer) testEmbeddedByValue()                        {}
 
@@ -376,6 +424,60 @@ func _CommentService_DeleteReply_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CommentService_LikeComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LikeCommentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommentServiceServer).LikeComment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CommentService_LikeComment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommentServiceServer).LikeComment(ctx, req.(*LikeCommentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CommentService_UnlikeComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnlikeCommentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommentServiceServer).UnlikeComment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CommentService_UnlikeComment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommentServiceServer).UnlikeComment(ctx, req.(*UnlikeCommentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CommentService_ListCommentLikes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCommentLikesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommentServiceServer).ListCommentLikes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CommentService_ListCommentLikes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommentServiceServer).ListCommentLikes(ctx, req.(*ListCommentLikesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // CommentService_ServiceDesc is the grpc.ServiceDesc for CommentService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -419,6 +521,126 @@ var CommentService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteReply",
 			Handler:    _CommentService_DeleteReply_Handler,
 		},
+		{
+			MethodName: "LikeComment",
+			Handler:    _CommentService_LikeComment_Handler,
+		},
+		{
+			MethodName: "UnlikeComment",
+			Handler:    _CommentService_UnlikeComment_Handler,
+		},
+		{
+			MethodName: "ListCommentLikes",
+			Handler:    _CommentService_ListCommentLikes_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "commentservice.proto",
+}
+
+const (
+	TenantCleanupService_DeleteTenantData_FullMethodName = "/commentservice.TenantCleanupService/DeleteTenantData"
+)
+
+// TenantCleanupServiceClient is the client API for TenantCleanupService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Used by userservice to delete the comments of a deleted tenant.
+// Internal only, it is not registered on the public gRPC server.
+type TenantCleanupServiceClient interface {
+	DeleteTenantData(ctx context.Context, in *DeleteTenantDataRequest, opts ...grpc.CallOption) (*DeleteTenantDataResponse, error)
+}
+
+type tenantCleanupServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewTenantCleanupServiceClient(cc grpc.ClientConnInterface) TenantCleanupServiceClient {
+	return &tenantCleanupServiceClient{cc}
+}
+
+func (c *tenantCleanupServiceClient) DeleteTenantData(ctx context.Context, in *DeleteTenantDataRequest, opts ...grpc.CallOption) (*DeleteTenantDataResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteTenantDataResponse)
+	err := c.cc.Invoke(ctx, TenantCleanupService_DeleteTenantData_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// TenantCleanupServiceServer is the server API for TenantCleanupService service.
+// All implementations must embed UnimplementedTenantCleanupServiceServer
+// for forward compatibility.
+//
+// Used by userservice to delete the comments of a deleted tenant.
+// Internal only, it is not registered on the public gRPC server.
+type TenantCleanupServiceServer interface {
+	DeleteTenantData(context.Context, *DeleteTenantDataRequest) (*DeleteTenantDataResponse, error)
+	mustEmbedUnimplementedTenantCleanupServiceServer()
+}
+
+// UnimplementedTenantCleanupServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedTenantCleanupServiceServer struct{}
+
+func (UnimplementedTenantCleanupServiceServer) DeleteTenantData(context.Context, *DeleteTenantDataRequest) (*DeleteTenantDataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteTenantData not implemented")
+}
+func (UnimplementedTenantCleanupServiceServer) mustEmbedUnimplementedTenantCleanupServiceServer() {}
+func (UnimplementedTenantCleanupServiceServer) testEmbeddedByValue()                              {}
+
+// UnsafeTenantCleanupServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to TenantCleanupServiceServer will
+// result in compilation errors.
+type UnsafeTenantCleanupServiceServer interface {
+	mustEmbedUnimplementedTenantCleanupServiceServer()
+}
+
+func RegisterTenantCleanupServiceServer(s grpc.ServiceRegistrar, srv TenantCleanupServiceServer) {
+	// If the following call pancis, it indicates UnimplementedTenantCleanupServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&TenantCleanupService_ServiceDesc, srv)
+}
+
+func _TenantCleanupService_DeleteTenantData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTenantDataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TenantCleanupServiceServer).DeleteTenantData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TenantCleanupService_DeleteTenantData_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TenantCleanupServiceServer).DeleteTenantData(ctx, req.(*DeleteTenantDataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// TenantCleanupService_ServiceDesc is the grpc.ServiceDesc for TenantCleanupService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var TenantCleanupService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "commentservice.TenantCleanupService",
+	HandlerType: (*TenantCleanupServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "DeleteTenantData",
+			Handler:    _TenantCleanupService_DeleteTenantData_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "commentservice.proto",
@@ -0,0 +1,56 @@
+package auth
+
+import "testing"
+
+func TestMethodScope(t *testing.T) {
+	tests := []struct {
+		method    string
+		wantScope Scope
+		wantOK    bool
+	}{
+		{"/videoservice.VideoService/ListVideos", ScopeVideosRead, true},
+		{"/videoservice.VideoService/GetVideo", ScopeVideosRead, true},
+		{"/videoservice.VideoService/CreateVideo", ScopeVideosWrite, true},
+		{"/videoservice.ChannelService/GetMembers", ScopeChannelsRead, true},
+		{"/videoservice.ChannelService/AddMember", ScopeChannelsWrite, true},
+		{"/videoservice.ChannelService/DiscoverChannels", ScopeChannelsRead, true},
+		{"/videoservice.ChannelService/JoinChannel", ScopeChannelsWrite, true},
+		{"/videoservice.ChannelService/NewMethod", "", false},
+		{"/videoservice.TenantCleanupService/DeleteTenantData", "", false},
+		{"/commentservice.CommentService/CreateComment", ScopeCommentsWrite, true},
+		{"/notificationservice.NotificationService/Subscribe", ScopeNotificationsRead, true},
+		{"/notificationservice.NotificationService/MarkAllRead", ScopeNotificationsWrite, true},
+		{"/userservice.AccessTokenService/CreateAccessToken", "", false},
+		{"/userservice.TenantService/AddUser", "", false},
+		{"/notificationservice.WebhookService/ListWebhooks", "", false},
+		{"not a method", "", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.method, func(t *testing.T) {
+			scope, ok := MethodScope(tt.method)
+			if scope != tt.wantScope || ok != tt.wantOK {
+				t.Errorf("MethodScope() = %q, %v, want %q, %v", scope, ok, tt.wantScope, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestAuthContext_AccessToken(t *testing.T) {
+	interactive := &AuthContext{User: &User{ID: "user-1"}, IsAuthenticated: true}
+	if !interactive.HasScope(ScopeVideosWrite) || !interactive.AllowsTenant("tenant-2") {
+		t.Error("interactive logins should not be restricted")
+	}
+
+	token := &AuthContext{
+		User:            &User{ID: "user-1"},
+		IsAuthenticated: true,
+		AccessToken:     &AccessTokenGrant{TokenID: "token-1", TenantID: "tenant-1", Scopes: []Scope{ScopeVideosRead}},
+	}
+	if !token.HasScope(ScopeVideosRead) || token.HasScope(ScopeVideosWrite) {
+		t.Error("access tokens should only have their scopes")
+	}
+	if !token.AllowsTenant("tenant-1") || token.AllowsTenant("tenant-2") {
+		t.Error("access tokens should only be allowed in their tenant")
+	}
+}
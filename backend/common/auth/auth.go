@@ -28,6 +28,8 @@ const AUTH_CONTEXT_KEY AuthContextKey = "auth"
 type AuthContext struct {
 	User            *User
 	IsAuthenticated bool
+	AccessToken     *AccessTokenGrant // Set when authenticated with a personal access token
+	SessionID       string            // Set when authenticated with a session cookie
 }
 
 var ANONYMOUS_AUTH_CTX AuthContext = AuthContext{User: &ANONYMOUS, IsAuthenticated: false}
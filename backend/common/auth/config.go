@@ -0,0 +1,43 @@
+package auth
+
+import "fmt"
+
+// Identity providers for Config.Provider
+const (
+	ProviderFirebase = "firebase"
+	ProviderOIDC     = "oidc"
+	ProviderLocal    = "local"
+)
+
+// Config selects the identity provider that issues the tokens clients send
+type Config struct {
+	Provider string      `json:"provider" mapstructure:"provider"` // firebase (default), oidc or local
+	OIDC     OIDCConfig  `json:"oidc" mapstructure:"oidc"`
+	Local    LocalConfig `json:"local" mapstructure:"local"`
+}
+
+// New creates the configured identity provider
+func New(config Config) (Auth, error) {
+	switch config.Provider {
+	case "", ProviderFirebase:
+		firebase, err := NewFirebase()
+		if err != nil {
+			return nil, err
+		}
+		return firebase, nil
+	case ProviderOIDC:
+		oidc, err := NewOIDC(config.OIDC)
+		if err != nil {
+			return nil, err
+		}
+		return oidc, nil
+	case ProviderLocal:
+		local, err := NewLocal(config.Local)
+		if err != nil {
+			return nil, err
+		}
+		return local, nil
+	default:
+		return nil, fmt.Errorf("unknown auth provider %q, expected %q, %q or %q", config.Provider, ProviderFirebase, ProviderOIDC, ProviderLocal)
+	}
+}
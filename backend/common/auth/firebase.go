@@ -74,8 +74,8 @@ func initializeFirebaseWithBase64Creds() (*firebase.App, *auth.Client, error) {
 	return app, authClient, nil
 }
 
-// isEmailAllowed checks if the email is in the allowed list
-func isEmailAllowed(email string) bool {
+// IsEmailAllowed checks if the email is in the ALLOWED_EMAILS list, all emails are allowed when it is not set
+func IsEmailAllowed(email string) bool {
 	allowedEmails := os.Getenv("ALLOWED_EMAILS")
 	if allowedEmails == "" {
 		// If no whitelist is set, allow all emails (default behavior)
@@ -105,7 +105,7 @@ func (f *Firebase) VerifyIDToken(token string) (*AuthContext, error) {
 	email := tok.Claims["email"].(string)
 
 	// Check if email is in the allowed list
-	if !isEmailAllowed(email) {
+	if !IsEmailAllowed(email) {
 		return &AuthContext{User: &ANONYMOUS, IsAuthenticated: false}, fmt.Errorf("email not in allowed list: %s", email)
 	}
 
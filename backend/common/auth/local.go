@@ -0,0 +1,141 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+)
+
+const (
+	defaultLocalTokenTTL = 24 * time.Hour
+
+	// localTokenIssuer tells our own tokens apart, they are never accepted from anybody else
+	localTokenIssuer = "stream-local"
+
+	minLocalTokenSecretLength = 32
+)
+
+// LocalConfig configures the tokens of the built-in email/password accounts of userservice
+type LocalConfig struct {
+	// HMAC key the tokens are signed with, at least 32 bytes. Changing it signs everybody out.
+	TokenSecret string        `json:"tokenSecret" mapstructure:"tokenSecret"`
+	TokenTTL    time.Duration `json:"tokenTTL" mapstructure:"tokenTTL"` // Defaults to 24 hours
+}
+
+// Local issues and verifies the tokens of local accounts. Both happen in this process,
+// so a shared secret is enough and no external service is involved.
+type Local struct {
+	secret   []byte
+	ttl      time.Duration
+	versions LocalTokenVersions
+}
+
+// LocalTokenVersions returns the current token version of a user. userservice bumps it when the password
+// changes, tokens issued with an older version are refused.
+type LocalTokenVersions interface {
+	LocalTokenVersion(ctx context.Context, userID string) (int64, error)
+}
+
+// localClaims are the claims of a local token, the user is carried in the token so verifying only looks up its version
+type localClaims struct {
+	Name         string   `json:"name"`
+	Email        string   `json:"email"`
+	Roles        []string `json:"roles,omitempty"`
+	TokenVersion int64    `json:"ver,omitempty"`
+	jwt.RegisteredClaims
+}
+
+func NewLocal(config LocalConfig) (*Local, error) {
+	if len(config.TokenSecret) < minLocalTokenSecretLength {
+		return nil, fmt.Errorf("local auth tokenSecret must be at least %d bytes", minLocalTokenSecretLength)
+	}
+	if config.TokenTTL == 0 {
+		config.TokenTTL = defaultLocalTokenTTL
+	}
+	return &Local{secret: []byte(config.TokenSecret), ttl: config.TokenTTL}, nil
+}
+
+// SetTokenVersions makes VerifyIDToken refuse tokens of an outdated version, without it every version is accepted
+func (l *Local) SetTokenVersions(versions LocalTokenVersions) {
+	l.versions = versions
+}
+
+// IssueToken signs a token for the user that expires after the configured TTL.
+// tokenVersion is the user's current version from LocalTokenVersions.
+func (l *Local) IssueToken(user *User, tokenVersion int64) (string, time.Time, error) {
+	now := time.Now()
+	expiresAt := now.Add(l.ttl)
+
+	roles := make([]string, 0, len(user.Roles))
+	for _, role := range user.Roles {
+		roles = append(roles, string(role))
+	}
+
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, localClaims{
+		Name:         user.Name,
+		Email:        user.Email,
+		Roles:        roles,
+		TokenVersion: tokenVersion,
+		RegisteredClaims: jwt.RegisteredClaims{
+			Issuer:    localTokenIssuer,
+			Subject:   user.ID,
+			IssuedAt:  jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(expiresAt),
+		},
+	})
+
+	signed, err := token.SignedString(l.secret)
+	if err != nil {
+		return "", time.Time{}, err
+	}
+	return signed, expiresAt, nil
+}
+
+// VerifyIDToken verifies a token issued by IssueToken
+func (l *Local) VerifyIDToken(token string) (*AuthContext, error) {
+	claims := &localClaims{}
+	_, err := jwt.ParseWithClaims(token, claims, func(*jwt.Token) (interface{}, error) {
+		return l.secret, nil
+	}, jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}))
+	if err != nil {
+		return &AuthContext{User: &ANONYMOUS, IsAuthenticated: false}, err
+	}
+
+	if claims.Issuer != localTokenIssuer || claims.Subject == "" || claims.ExpiresAt == nil {
+		return &AuthContext{User: &ANONYMOUS, IsAuthenticated: false}, errors.New("not a local token")
+	}
+
+	// Changing the password signs out the tokens issued before
+	if l.versions != nil {
+		version, err := l.versions.LocalTokenVersion(context.Background(), claims.Subject)
+		if err != nil {
+			return &AuthContext{User: &ANONYMOUS, IsAuthenticated: false}, fmt.Errorf("failed to check token version: %w", err)
+		}
+		if claims.TokenVersion != version {
+			return &AuthContext{User: &ANONYMOUS, IsAuthenticated: false}, errors.New("token was issued before the last password change")
+		}
+	}
+
+	// Check if email is in the allowed list
+	if !IsEmailAllowed(claims.Email) {
+		return &AuthContext{User: &ANONYMOUS, IsAuthenticated: false}, fmt.Errorf("email not in allowed list: %s", claims.Email)
+	}
+
+	user := &User{
+		ID:    claims.Subject,
+		Name:  claims.Name,
+		Email: claims.Email,
+		Roles: []Role{},
+	}
+	for _, role := range claims.Roles {
+		user.Roles = append(user.Roles, Role(role))
+	}
+
+	return &AuthContext{
+		User:            user,
+		IsAuthenticated: true,
+	}, nil
+}
@@ -0,0 +1,76 @@
+package auth
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestLocal_IssueAndVerifyToken(t *testing.T) {
+	local, err := NewLocal(LocalConfig{TokenSecret: "0123456789abcdef0123456789abcdef"})
+	if err != nil {
+		t.Fatalf("NewLocal() error = %v", err)
+	}
+
+	token, expiresAt, err := local.IssueToken(&User{ID: "user-1", Name: "Priya", Email: "priya@example.com", Roles: []Role{Admin}}, 0)
+	if err != nil {
+		t.Fatalf("IssueToken() error = %v", err)
+	}
+	if expiresAt.Before(time.Now().Add(23 * time.Hour)) {
+		t.Errorf("IssueToken() expiresAt = %v, want the 24 hour default", expiresAt)
+	}
+
+	authContext, err := local.VerifyIDToken(token)
+	if err != nil {
+		t.Fatalf("VerifyIDToken() error = %v", err)
+	}
+	user := authContext.User
+	if !authContext.IsAuthenticated || user.ID != "user-1" || user.Email != "priya@example.com" || user.Name != "Priya" {
+		t.Errorf("VerifyIDToken() user = %+v", user)
+	}
+	if len(user.Roles) != 1 || user.Roles[0] != Admin {
+		t.Errorf("VerifyIDToken() roles = %v, want [admin]", user.Roles)
+	}
+
+	other, _ := NewLocal(LocalConfig{TokenSecret: "another secret that is long enough"})
+	if _, err := other.VerifyIDToken(token); err == nil {
+		t.Error("VerifyIDToken() accepted a token signed with another secret")
+	}
+
+	expired, _ := NewLocal(LocalConfig{TokenSecret: "0123456789abcdef0123456789abcdef", TokenTTL: -time.Minute})
+	token, _, _ = expired.IssueToken(&User{ID: "user-1", Email: "priya@example.com"}, 0)
+	if _, err := local.VerifyIDToken(token); err == nil {
+		t.Error("VerifyIDToken() accepted an expired token")
+	}
+}
+
+// tokenVersions keeps the token version of every user in memory
+type tokenVersions map[string]int64
+
+func (v tokenVersions) LocalTokenVersion(ctx context.Context, userID string) (int64, error) {
+	return v[userID], nil
+}
+
+func TestLocal_TokenVersion(t *testing.T) {
+	local, _ := NewLocal(LocalConfig{TokenSecret: "0123456789abcdef0123456789abcdef"})
+	versions := tokenVersions{"user-1": 1}
+	local.SetTokenVersions(versions)
+
+	token, _, _ := local.IssueToken(&User{ID: "user-1", Email: "priya@example.com"}, 1)
+	if _, err := local.VerifyIDToken(token); err != nil {
+		t.Fatalf("VerifyIDToken() error = %v", err)
+	}
+
+	// A password change bumps the version
+	versions["user-1"] = 2
+	if _, err := local.VerifyIDToken(token); err == nil {
+		t.Error("VerifyIDToken() accepted a token issued before the password change")
+	}
+}
+
+func TestNewLocal_ShortSecret(t *testing.T) {
+	_, err := NewLocal(LocalConfig{TokenSecret: "too short"})
+	if err == nil {
+		t.Error("NewLocal() should reject secrets shorter than 32 bytes")
+	}
+}
@@ -0,0 +1,224 @@
+package auth
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/MicahParks/keyfunc"
+	"github.com/golang-jwt/jwt/v4"
+)
+
+const (
+	defaultJWKSRefreshInterval = time.Hour
+
+	// jwksRefreshRateLimit bounds the refreshes triggered by tokens signed with an unknown key,
+	// so forged tokens can't make us hammer the provider
+	jwksRefreshRateLimit = 5 * time.Minute
+
+	oidcHTTPTimeout = 10 * time.Second
+)
+
+// oidcSigningMethods are the asymmetric algorithms accepted for ID tokens.
+// HS* and "none" are never accepted, anybody knowing the client secret could forge those.
+var oidcSigningMethods = []string{"RS256", "RS384", "RS512", "PS256", "PS384", "PS512", "ES256", "ES384", "ES512", "EdDSA"}
+
+// OIDCConfig configures an OpenID Connect provider such as Keycloak, Authentik or Google Workspace
+type OIDCConfig struct {
+	IssuerURL string `json:"issuerUrl" mapstructure:"issuerUrl"` // Must match the iss claim, e.g. https://keycloak.example.com/realms/stream
+	ClientID  string `json:"clientId" mapstructure:"clientId"`   // Must be in the aud claim
+	// Optional, discovered from the issuer's /.well-known/openid-configuration when empty
+	JWKSURL string `json:"jwksUrl" mapstructure:"jwksUrl"`
+	// How often the signing keys are reloaded, defaults to an hour.
+	// Keys are also reloaded when a token is signed with a key we don't know yet.
+	JWKSRefreshInterval time.Duration    `json:"jwksRefreshInterval" mapstructure:"jwksRefreshInterval"`
+	Claims              OIDCClaimsConfig `json:"claims" mapstructure:"claims"`
+}
+
+// OIDCClaimsConfig maps token claims to User. Nested claims are separated by dots, e.g. realm_access.roles.
+type OIDCClaimsConfig struct {
+	UserID string `json:"userId" mapstructure:"userId"` // Defaults to sub
+	Name   string `json:"name" mapstructure:"name"`     // Defaults to name, with preferred_username and the email as fallbacks
+	Email  string `json:"email" mapstructure:"email"`   // Defaults to email
+	Roles  string `json:"roles" mapstructure:"roles"`   // Optional, a string or list of strings e.g. groups
+}
+
+type OIDC struct {
+	config OIDCConfig
+	jwks   *keyfunc.JWKS
+}
+
+// NewOIDC loads the provider's signing keys. The keys are cached and refreshed in the background.
+func NewOIDC(config OIDCConfig) (*OIDC, error) {
+	if config.IssuerURL == "" || config.ClientID == "" {
+		return nil, errors.New("oidc issuerUrl and clientId are required")
+	}
+	if config.Claims.UserID == "" {
+		config.Claims.UserID = "sub"
+	}
+	if config.Claims.Name == "" {
+		config.Claims.Name = "name"
+	}
+	if config.Claims.Email == "" {
+		config.Claims.Email = "email"
+	}
+	if config.JWKSRefreshInterval == 0 {
+		config.JWKSRefreshInterval = defaultJWKSRefreshInterval
+	}
+
+	client := &http.Client{Timeout: oidcHTTPTimeout}
+
+	if config.JWKSURL == "" {
+		jwksURL, err := discoverJWKSURL(client, config.IssuerURL)
+		if err != nil {
+			return nil, err
+		}
+		config.JWKSURL = jwksURL
+	}
+
+	jwks, err := keyfunc.Get(config.JWKSURL, keyfunc.Options{
+		Client:            client,
+		RefreshInterval:   config.JWKSRefreshInterval,
+		RefreshRateLimit:  jwksRefreshRateLimit,
+		RefreshTimeout:    oidcHTTPTimeout,
+		RefreshUnknownKID: true,
+		RefreshErrorHandler: func(err error) {
+			slog.Error("failed to refresh OIDC signing keys", "err", err, "jwksURL", config.JWKSURL)
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to load OIDC signing keys from %s: %w", config.JWKSURL, err)
+	}
+
+	slog.Info("Using OIDC authentication", "issuer", config.IssuerURL, "jwksURL", config.JWKSURL)
+	return &OIDC{config: config, jwks: jwks}, nil
+}
+
+// discoverJWKSURL reads the jwks_uri from the issuer's discovery document
+func discoverJWKSURL(client *http.Client, issuerURL string) (string, error) {
+	discoveryURL := strings.TrimSuffix(issuerURL, "/") + "/.well-known/openid-configuration"
+	resp, err := client.Get(discoveryURL)
+	if err != nil {
+		return "", fmt.Errorf("failed to get OIDC discovery document: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("failed to get OIDC discovery document from %s: status %d", discoveryURL, resp.StatusCode)
+	}
+
+	var discovery struct {
+		Issuer  string `json:"issuer"`
+		JWKSURI string `json:"jwks_uri"`
+	}
+	err = json.NewDecoder(resp.Body).Decode(&discovery)
+	if err != nil {
+		return "", fmt.Errorf("invalid OIDC discovery document: %w", err)
+	}
+	if discovery.Issuer != issuerURL {
+		return "", fmt.Errorf("OIDC discovery document is for issuer %q, expected %q", discovery.Issuer, issuerURL)
+	}
+	if discovery.JWKSURI == "" {
+		return "", errors.New("OIDC discovery document has no jwks_uri")
+	}
+	return discovery.JWKSURI, nil
+}
+
+// VerifyIDToken checks the token's signature, issuer, audience and expiry and maps its claims to the user.
+// It only makes network calls when the token is signed with a key that is not cached yet.
+func (o *OIDC) VerifyIDToken(token string) (*AuthContext, error) {
+	claims := jwt.MapClaims{}
+	_, err := jwt.ParseWithClaims(token, claims, o.jwks.Keyfunc, jwt.WithValidMethods(oidcSigningMethods))
+	if err != nil {
+		return &AuthContext{User: &ANONYMOUS, IsAuthenticated: false}, err
+	}
+
+	// Valid() above only checks exp when it is present, ID tokens must have one
+	if !claims.VerifyExpiresAt(time.Now().Unix(), true) {
+		return &AuthContext{User: &ANONYMOUS, IsAuthenticated: false}, errors.New("token has no expiry")
+	}
+	if !claims.VerifyIssuer(o.config.IssuerURL, true) {
+		return &AuthContext{User: &ANONYMOUS, IsAuthenticated: false}, fmt.Errorf("unexpected token issuer: %v", claims["iss"])
+	}
+	if !claims.VerifyAudience(o.config.ClientID, true) {
+		return &AuthContext{User: &ANONYMOUS, IsAuthenticated: false}, fmt.Errorf("token is not for client %s", o.config.ClientID)
+	}
+
+	user, err := o.userFromClaims(claims)
+	if err != nil {
+		return &AuthContext{User: &ANONYMOUS, IsAuthenticated: false}, err
+	}
+
+	// Check if email is in the allowed list
+	if !IsEmailAllowed(user.Email) {
+		return &AuthContext{User: &ANONYMOUS, IsAuthenticated: false}, fmt.Errorf("email not in allowed list: %s", user.Email)
+	}
+
+	return &AuthContext{
+		User:            user,
+		IsAuthenticated: true,
+	}, nil
+}
+
+func (o *OIDC) userFromClaims(claims jwt.MapClaims) (*User, error) {
+	userID, _ := claimValue(claims, o.config.Claims.UserID).(string)
+	if userID == "" {
+		return nil, fmt.Errorf("token has no %s claim", o.config.Claims.UserID)
+	}
+
+	email, _ := claimValue(claims, o.config.Claims.Email).(string)
+	if email == "" {
+		return nil, fmt.Errorf("token has no %s claim", o.config.Claims.Email)
+	}
+	// The email allow list is only meaningful for addresses the provider verified
+	if verified, ok := claims["email_verified"].(bool); ok && !verified {
+		return nil, fmt.Errorf("email is not verified: %s", email)
+	}
+
+	name, _ := claimValue(claims, o.config.Claims.Name).(string)
+	if name == "" {
+		name, _ = claims["preferred_username"].(string)
+	}
+	if name == "" {
+		name = email
+	}
+
+	user := &User{
+		ID:    userID,
+		Name:  name,
+		Email: email,
+		Roles: []Role{},
+	}
+
+	if o.config.Claims.Roles != "" {
+		switch roles := claimValue(claims, o.config.Claims.Roles).(type) {
+		case string:
+			user.Roles = append(user.Roles, Role(roles))
+		case []interface{}:
+			for _, role := range roles {
+				if role, ok := role.(string); ok {
+					user.Roles = append(user.Roles, Role(role))
+				}
+			}
+		}
+	}
+
+	return user, nil
+}
+
+// claimValue looks up a claim by its dot separated path, nil if it doesn't exist
+func claimValue(claims jwt.MapClaims, path string) interface{} {
+	var value interface{} = map[string]interface{}(claims)
+	for _, key := range strings.Split(path, ".") {
+		object, ok := value.(map[string]interface{})
+		if !ok {
+			return nil
+		}
+		value = object[key]
+	}
+	return value
+}
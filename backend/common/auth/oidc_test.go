@@ -0,0 +1,146 @@
+package auth
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+)
+
+// testProvider serves a discovery document and a JWKS with a single RSA key
+func testProvider(t *testing.T) (*httptest.Server, *rsa.PrivateKey) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var server *httptest.Server
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(map[string]string{"issuer": server.URL, "jwks_uri": server.URL + "/keys"})
+	})
+	mux.HandleFunc("/keys", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(map[string]interface{}{"keys": []map[string]string{{
+			"kty": "RSA",
+			"kid": "key-1",
+			"alg": "RS256",
+			"use": "sig",
+			"n":   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+			"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+		}}})
+	})
+	server = httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+	return server, key
+}
+
+func signToken(t *testing.T, key *rsa.PrivateKey, claims jwt.MapClaims) string {
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+	token.Header["kid"] = "key-1"
+	signed, err := token.SignedString(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return signed
+}
+
+func TestOIDC_VerifyIDToken(t *testing.T) {
+	server, key := testProvider(t)
+	oidc, err := NewOIDC(OIDCConfig{
+		IssuerURL: server.URL,
+		ClientID:  "stream",
+		Claims:    OIDCClaimsConfig{Roles: "realm_access.roles"},
+	})
+	if err != nil {
+		t.Fatalf("NewOIDC() error = %v", err)
+	}
+
+	validClaims := func() jwt.MapClaims {
+		return jwt.MapClaims{
+			"iss":                server.URL,
+			"aud":                "stream",
+			"sub":                "user-1",
+			"exp":                time.Now().Add(time.Hour).Unix(),
+			"email":              "priya@example.com",
+			"email_verified":     true,
+			"preferred_username": "priya",
+			"realm_access":       map[string]interface{}{"roles": []string{"admin", "editor"}},
+		}
+	}
+
+	authContext, err := oidc.VerifyIDToken(signToken(t, key, validClaims()))
+	if err != nil {
+		t.Fatalf("VerifyIDToken() error = %v", err)
+	}
+	user := authContext.User
+	if !authContext.IsAuthenticated || user.ID != "user-1" || user.Email != "priya@example.com" || user.Name != "priya" {
+		t.Errorf("VerifyIDToken() user = %+v", user)
+	}
+	if len(user.Roles) != 2 || user.Roles[0] != Admin {
+		t.Errorf("VerifyIDToken() roles = %v, want [admin editor]", user.Roles)
+	}
+
+	otherKey, _ := rsa.GenerateKey(rand.Reader, 2048)
+	tests := []struct {
+		name  string
+		token func() string
+	}{
+		{"Other audience", func() string {
+			claims := validClaims()
+			claims["aud"] = "other-client"
+			return signToken(t, key, claims)
+		}},
+		{"Other issuer", func() string {
+			claims := validClaims()
+			claims["iss"] = "https://evil.example.com"
+			return signToken(t, key, claims)
+		}},
+		{"Expired", func() string {
+			claims := validClaims()
+			claims["exp"] = time.Now().Add(-time.Minute).Unix()
+			return signToken(t, key, claims)
+		}},
+		{"No expiry", func() string {
+			claims := validClaims()
+			delete(claims, "exp")
+			return signToken(t, key, claims)
+		}},
+		{"Unverified email", func() string {
+			claims := validClaims()
+			claims["email_verified"] = false
+			return signToken(t, key, claims)
+		}},
+		{"Unknown key", func() string {
+			return signToken(t, otherKey, validClaims())
+		}},
+		{"Symmetric algorithm", func() string {
+			token := jwt.NewWithClaims(jwt.SigningMethodHS256, validClaims())
+			token.Header["kid"] = "key-1"
+			signed, _ := token.SignedString([]byte("secret"))
+			return signed
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			authContext, err := oidc.VerifyIDToken(tt.token())
+			if err == nil || authContext.IsAuthenticated {
+				t.Errorf("VerifyIDToken() = %+v, want an error", authContext)
+			}
+		})
+	}
+}
+
+func TestNew_UnknownProvider(t *testing.T) {
+	_, err := New(Config{Provider: "saml"})
+	if err == nil {
+		t.Error("New() should reject unknown providers")
+	}
+}
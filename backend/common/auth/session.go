@@ -0,0 +1,20 @@
+package auth
+
+import (
+	"context"
+	"time"
+)
+
+// SessionCookieName is the cookie of browser sessions. It holds an opaque session token, never an ID token.
+const SessionCookieName = "session"
+
+// SessionStore keeps the browser sessions behind the session cookie. Sessions outlive the ID token
+// they were created with, so video playback keeps working when the ID token expires.
+type SessionStore interface {
+	// CreateSession starts a session for the authenticated user and returns its token for the cookie
+	CreateSession(ctx context.Context, authContext *AuthContext, userAgent string) (sessionToken string, expiresAt time.Time, err error)
+	// GetSession resolves the session token to its user and extends the session, it fails for expired and revoked sessions
+	GetSession(ctx context.Context, sessionToken string) (*AuthContext, error)
+	// DeleteSession ends the session, e.g. on logout
+	DeleteSession(ctx context.Context, sessionToken string) error
+}
@@ -7,6 +7,12 @@ const (
 	ChannelRoleViewer   = "viewer"
 )
 
+// Channel privacy
+const (
+	ChannelPrivacyClosed = "closed" // Members are added by owners or after an approved access request
+	ChannelPrivacyOpen   = "open"   // Any tenant member can join with the channel's default role
+)
+
 // Tenant roles
 const (
 	TenantRoleMember     = "member"
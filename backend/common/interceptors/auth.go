@@ -5,6 +5,8 @@ import (
 	"fmt"
 	"log/slog"
 	"net/http"
+	"slices"
+	"strings"
 
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
@@ -17,9 +19,11 @@ const AUTH_HEADER = "authorization"
 
 type AuthInterceptor func(auth.Auth) grpc.UnaryServerInterceptor
 
-// Shared Firebase token verification logic
-func verifyFirebaseToken(fbauth *auth.Firebase, token string) (*auth.AuthContext, error) {
-	authContext, verificationErr := fbauth.VerifyIDToken(token)
+// Shared token verification logic, for whichever identity provider is configured
+func verifyToken(authProvider auth.Auth, token string) (*auth.AuthContext, error) {
+	// OIDC clients usually send a bearer token, Firebase clients the bare token
+	token = strings.TrimPrefix(token, "Bearer ")
+	authContext, verificationErr := authProvider.VerifyIDToken(token)
 	if verificationErr != nil {
 		slog.Info("error verifying ID token", "err", verificationErr)
 		return nil, fmt.Errorf("invalid authentication token")
@@ -27,11 +31,13 @@ func verifyFirebaseToken(fbauth *auth.Firebase, token string) (*auth.AuthContext
 	return authContext, nil
 }
 
-func FirebaseAuthInterceptor(fbauth *auth.Firebase) grpc.UnaryServerInterceptor {
+// TokenAuthInterceptor requires a valid token on every call except the public methods
+// (full method names, e.g. /userservice.LocalAuthService/Login), which are how clients get a token
+func TokenAuthInterceptor(authProvider auth.Auth, publicMethods ...string) grpc.UnaryServerInterceptor {
 
 	// The client (browser+JS or language SDK) send the auth token (a string) in the headers of each request
 	// We read this auth token from the headers
-	// We verify if this auth token in valid using firebase APIs
+	// We verify if this auth token in valid using the identity provider (Firebase or OIDC)
 	// We also decode the token to get the user ID and other details/"claims"
 	// We add this user id to the context object
 	// This context object is passed to the API handler functions and hence the API know the user ID
@@ -39,28 +45,64 @@ func FirebaseAuthInterceptor(fbauth *auth.Firebase) grpc.UnaryServerInterceptor
 	// The Auth Token verification is fast
 	// -----------------------------------
 	// The Auth Token is "digital signed" using a private key and the "signature" verified using a public key by anybody
-	// Therefore during verification, we don't need to make any network calls (RPC) to firebase
+	// Therefore during verification, we don't need to make any network calls (RPC) to the identity provider
 	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp interface{}, err error) {
-		// metadata
-		authToken, err := getAuthHeader(ctx)
+		if slices.Contains(publicMethods, info.FullMethod) {
+			return handler(ctx, req)
+		}
+
+		newctx, err := authenticate(ctx, authProvider, info.FullMethod)
 		if err != nil {
-			// TODO: This should be printed at trace level since it will be very verbose in logs
-			// slog.Debug("No Auth token in request", "err", err)
-			return nil, status.Errorf(codes.Unauthenticated, err.Error())
+			return nil, err
 		}
 
-		authContext, verificationErr := verifyFirebaseToken(fbauth, authToken)
-		if verificationErr != nil {
-			return nil, status.Errorf(codes.Unauthenticated, verificationErr.Error())
+		return handler(newctx, req)
+	}
+}
+
+// TokenAuthStreamInterceptor is the streaming counterpart of TokenAuthInterceptor.
+// The token is verified once, when the stream is opened.
+func TokenAuthStreamInterceptor(authProvider auth.Auth) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		newctx, err := authenticate(ss.Context(), authProvider, info.FullMethod)
+		if err != nil {
+			return err
 		}
 
-		newctx := context.WithValue(ctx, auth.AUTH_CONTEXT_KEY, authContext)
+		return handler(srv, &wrappedServerStream{ServerStream: ss, ctx: newctx})
+	}
+}
 
-		return handler(newctx, req)
+// authenticate verifies the token in the request metadata and adds the user to the context.
+// Personal access tokens must also have the scope of the method.
+func authenticate(ctx context.Context, authProvider auth.Auth, fullMethod string) (context.Context, error) {
+	// metadata
+	authToken, err := getAuthHeader(ctx)
+	if err != nil {
+		// TODO: This should be printed at trace level since it will be very verbose in logs
+		// slog.Debug("No Auth token in request", "err", err)
+		return nil, status.Errorf(codes.Unauthenticated, err.Error())
+	}
+
+	authContext, verificationErr := verifyToken(authProvider, authToken)
+	if verificationErr != nil {
+		return nil, status.Errorf(codes.Unauthenticated, verificationErr.Error())
+	}
+
+	if authContext.AccessToken != nil {
+		scope, ok := auth.MethodScope(fullMethod)
+		if !ok {
+			return nil, status.Error(codes.PermissionDenied, "this method can't be called with an access token")
+		}
+		if !authContext.HasScope(scope) {
+			return nil, status.Errorf(codes.PermissionDenied, "access token is missing the %s scope", scope)
+		}
 	}
+
+	return context.WithValue(ctx, auth.AUTH_CONTEXT_KEY, authContext), nil
 }
 
-func FirebaseHTTPHeaderAuthMiddleware(fbauth *auth.Firebase, next http.Handler) http.Handler {
+func HTTPHeaderAuthMiddleware(authProvider auth.Auth, next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 
 		authHeader := r.Header.Get("authorization")
@@ -70,72 +112,99 @@ func FirebaseHTTPHeaderAuthMiddleware(fbauth *auth.Firebase, next http.Handler)
 			return
 		}
 
-		authContext, verificationErr := verifyFirebaseToken(fbauth, authHeader)
+		authContext, verificationErr := verifyToken(authProvider, authHeader)
 		if verificationErr != nil {
 			http.Error(w, "Unauthorized", http.StatusUnauthorized)
 			return
 		}
 
-		// After successful authorization header verification, manage the authorization cookie
-		const authCookieName = "authorization"
+		newctx := context.WithValue(r.Context(), auth.AUTH_CONTEXT_KEY, authContext)
+		r = r.WithContext(newctx)
+		next.ServeHTTP(w, r)
+	})
+}
 
-		// Check if existing cookie is present
-		existingCookie, err := r.Cookie(authCookieName)
+// legacyAuthCookieName is the cookie that used to hold the raw ID token, it is cleared when a session starts
+const legacyAuthCookieName = "authorization"
 
-		shouldSetCookie := false
+// SessionCookieMiddleware starts a browser session for requests authenticated by HTTPHeaderAuthMiddleware,
+// so that requests which can only send cookies (video playback, EventSource) keep working after the ID token expires.
+// Only grpc-web requests from a browser get a session. It goes after HTTPHeaderAuthMiddleware.
+func SessionCookieMiddleware(sessions auth.SessionStore, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		authContext, err := AuthFromContext(r.Context())
+		// Access tokens are for scripts, they don't get browser sessions
+		if err != nil || authContext.AccessToken != nil || !isBrowserGrpcWebRequest(r) {
+			next.ServeHTTP(w, r)
+			return
+		}
 
-		if err != nil {
-			// Cookie doesn't exist, set it
-			shouldSetCookie = true
-			slog.Debug("Authorization cookie not present, will set it")
-		} else if existingCookie.Value != authHeader {
-			// Cookie exists but has different value, update it
-			shouldSetCookie = true
-			slog.Debug("Authorization cookie has different value, will update it")
-		}
-
-		if shouldSetCookie {
-			authCookie := &http.Cookie{
-				Name:     authCookieName,
-				Value:    authHeader,
-				Path:     "/",
-				MaxAge:   3600 * 24, // 1 day
-				HttpOnly: true,
-				Secure:   true,
-				SameSite: http.SameSiteStrictMode,
+		// Keep the current session as long as it is valid and of the same user
+		if sessionCookie, err := r.Cookie(auth.SessionCookieName); err == nil && sessionCookie.Value != "" {
+			session, err := sessions.GetSession(r.Context(), sessionCookie.Value)
+			if err == nil && session.User.ID == authContext.User.ID {
+				next.ServeHTTP(w, r)
+				return
 			}
-			http.SetCookie(w, authCookie)
-			slog.Debug("Authorization cookie set/updated")
 		}
 
-		newctx := context.WithValue(r.Context(), auth.AUTH_CONTEXT_KEY, authContext)
-		r = r.WithContext(newctx)
+		sessionToken, expiresAt, err := sessions.CreateSession(r.Context(), authContext, r.UserAgent())
+		if err != nil {
+			// The request itself is authenticated, only cookie based requests will fail
+			slog.Error("Failed to create session", "err", err, "userID", authContext.User.ID)
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		http.SetCookie(w, &http.Cookie{
+			Name:     auth.SessionCookieName,
+			Value:    sessionToken,
+			Path:     "/",
+			Expires:  expiresAt,
+			HttpOnly: true,
+			Secure:   true,
+			SameSite: http.SameSiteStrictMode,
+		})
+		if _, err := r.Cookie(legacyAuthCookieName); err == nil {
+			clearCookie(w, legacyAuthCookieName)
+		}
+		slog.Debug("Session cookie set", "userID", authContext.User.ID)
+
 		next.ServeHTTP(w, r)
 	})
 }
 
-func FirebaseCookieAuthMiddleware(fbauth *auth.Firebase, next http.Handler) http.Handler {
-	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		const authCookieName = "authorization"
+// isBrowserGrpcWebRequest reports whether r is a grpc-web call made by a browser, which sends an Origin with every POST.
+// Other clients would create a session on every call, as they never send the cookie back.
+func isBrowserGrpcWebRequest(r *http.Request) bool {
+	return strings.HasPrefix(r.Header.Get("Content-Type"), "application/grpc-web") && r.Header.Get("Origin") != ""
+}
 
-		// Get authorization cookie
-		authCookie, err := r.Cookie(authCookieName)
-		if err != nil {
-			slog.Debug("Authorization cookie not found", "err", err, "url", r.URL.Path)
+// RequireScope refuses personal access tokens without the scope, it goes after one of the auth middlewares
+func RequireScope(scope auth.Scope, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		authContext, err := AuthFromContext(r.Context())
+		if err != nil || !authContext.HasScope(scope) {
 			http.Error(w, "Forbidden", http.StatusForbidden)
 			return
 		}
+		next.ServeHTTP(w, r)
+	})
+}
 
-		if authCookie.Value == "" {
-			slog.Debug("Authorization cookie is empty", "url", r.URL.Path)
+// CookieAuthMiddleware authenticates requests with the session cookie set by SessionCookieMiddleware
+func CookieAuthMiddleware(sessions auth.SessionStore, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		sessionCookie, err := r.Cookie(auth.SessionCookieName)
+		if err != nil || sessionCookie.Value == "" {
+			slog.Debug("Session cookie not found", "err", err, "url", r.URL.Path)
 			http.Error(w, "Forbidden", http.StatusForbidden)
 			return
 		}
 
-		// Verify the token from cookie using shared logic
-		authContext, verificationErr := verifyFirebaseToken(fbauth, authCookie.Value)
-		if verificationErr != nil {
-			slog.Info("Invalid authorization cookie", "err", verificationErr, "url", r.URL.Path)
+		authContext, err := sessions.GetSession(r.Context(), sessionCookie.Value)
+		if err != nil {
+			slog.Info("Invalid session cookie", "err", err, "url", r.URL.Path)
 			http.Error(w, "Unauthorized", http.StatusUnauthorized)
 			return
 		}
@@ -146,6 +215,41 @@ func FirebaseCookieAuthMiddleware(fbauth *auth.Firebase, next http.Handler) http
 	})
 }
 
+// LogoutHandler ends the session of the session cookie and clears the cookie
+func LogoutHandler(sessions auth.SessionStore) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+
+		if sessionCookie, err := r.Cookie(auth.SessionCookieName); err == nil && sessionCookie.Value != "" {
+			err = sessions.DeleteSession(r.Context(), sessionCookie.Value)
+			if err != nil {
+				slog.Error("Failed to delete session", "err", err)
+				http.Error(w, "Failed to log out", http.StatusInternalServerError)
+				return
+			}
+		}
+
+		clearCookie(w, auth.SessionCookieName)
+		clearCookie(w, legacyAuthCookieName)
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
+
+func clearCookie(w http.ResponseWriter, name string) {
+	http.SetCookie(w, &http.Cookie{
+		Name:     name,
+		Value:    "",
+		Path:     "/",
+		MaxAge:   -1,
+		HttpOnly: true,
+		Secure:   true,
+		SameSite: http.SameSiteStrictMode,
+	})
+}
+
 func getAuthHeader(ctx context.Context) (string, error) {
 	md, ok := metadata.FromIncomingContext(ctx)
 	if !ok {
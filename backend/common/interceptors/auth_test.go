@@ -0,0 +1,116 @@
+package interceptors
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"sortedstartup.com/stream/common/auth"
+)
+
+// fakeAuth accepts the tokens it knows
+type fakeAuth map[string]*auth.AuthContext
+
+func (f fakeAuth) VerifyIDToken(token string) (*auth.AuthContext, error) {
+	authContext, ok := f[token]
+	if !ok {
+		return &auth.ANONYMOUS_AUTH_CTX, errors.New("unknown token")
+	}
+	return authContext, nil
+}
+
+var testAuth = fakeAuth{
+	"id-token": {User: &auth.User{ID: "user-1"}, IsAuthenticated: true},
+	auth.AccessTokenPrefix + "read": {
+		User:            &auth.User{ID: "user-1"},
+		IsAuthenticated: true,
+		AccessToken:     &auth.AccessTokenGrant{TokenID: "token-1", TenantID: "tenant-1", Scopes: []auth.Scope{auth.ScopeVideosRead}},
+	},
+}
+
+func TestTokenAuthInterceptor(t *testing.T) {
+	tests := []struct {
+		name   string
+		token  string
+		method string
+		want   codes.Code
+	}{
+		{"ID token", "id-token", "/userservice.AccessTokenService/CreateAccessToken", codes.OK},
+		{"Access token with scope", auth.AccessTokenPrefix + "read", "/videoservice.VideoService/ListVideos", codes.OK},
+		{"Access token without scope", auth.AccessTokenPrefix + "read", "/videoservice.VideoService/CreateVideo", codes.PermissionDenied},
+		{"Access token on a method tokens can't call", auth.AccessTokenPrefix + "read", "/userservice.AccessTokenService/CreateAccessToken", codes.PermissionDenied},
+		{"Unknown token", "forged", "/videoservice.VideoService/ListVideos", codes.Unauthenticated},
+		{"Public method", "", "/userservice.LocalAuthService/Login", codes.OK},
+	}
+
+	interceptor := TokenAuthInterceptor(testAuth, "/userservice.LocalAuthService/Login")
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(AUTH_HEADER, tt.token))
+			_, err := interceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: tt.method}, func(ctx context.Context, req interface{}) (interface{}, error) {
+				return nil, nil
+			})
+			if status.Code(err) != tt.want {
+				t.Errorf("TokenAuthInterceptor() error = %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestTenantInterceptor_AccessToken(t *testing.T) {
+	tokenCtx := context.WithValue(context.Background(), auth.AUTH_CONTEXT_KEY, testAuth[auth.AccessTokenPrefix+"read"])
+
+	tests := []struct {
+		name       string
+		md         metadata.MD
+		wantTenant string
+		wantCode   codes.Code
+	}{
+		{"Token tenant", metadata.Pairs(TENANT_ID_HEADER, "tenant-1"), "tenant-1", codes.OK},
+		{"Defaults to the token tenant", metadata.Pairs("other", "value"), "tenant-1", codes.OK},
+		{"Other tenant", metadata.Pairs(TENANT_ID_HEADER, "tenant-2"), "", codes.PermissionDenied},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var tenantID string
+			_, err := TenantInterceptor()(metadata.NewIncomingContext(tokenCtx, tt.md), nil, &grpc.UnaryServerInfo{}, func(ctx context.Context, req interface{}) (interface{}, error) {
+				tenantID, _ = GetTenantIDFromContext(ctx)
+				return nil, nil
+			})
+			if status.Code(err) != tt.wantCode || tenantID != tt.wantTenant {
+				t.Errorf("TenantInterceptor() = %q, %v, want %q, %v", tenantID, err, tt.wantTenant, tt.wantCode)
+			}
+		})
+	}
+}
+
+func TestRequireScope(t *testing.T) {
+	handler := HTTPHeaderAuthMiddleware(testAuth, RequireScope(auth.ScopeVideosWrite, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})))
+
+	tests := []struct {
+		token string
+		want  int
+	}{
+		{"id-token", http.StatusOK},
+		{auth.AccessTokenPrefix + "read", http.StatusForbidden},
+	}
+
+	for _, tt := range tests {
+		req := httptest.NewRequest(http.MethodPost, "/upload", nil)
+		req.Header.Set("authorization", tt.token)
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		if rec.Code != tt.want {
+			t.Errorf("token %q: status = %d, want %d", tt.token, rec.Code, tt.want)
+		}
+	}
+}
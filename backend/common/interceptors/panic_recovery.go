@@ -24,6 +24,18 @@ func PanicRecoveryInterceptor() grpc.UnaryServerInterceptor {
 	}
 }
 
+// PanicRecoveryStreamInterceptor returns a new stream server interceptor that recovers from panics.
+func PanicRecoveryStreamInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) (err error) {
+		defer func() {
+			if p := recover(); p != nil {
+				err = panicRecoveryHandler(p)
+			}
+		}()
+		return handler(srv, ss)
+	}
+}
+
 // panicRecoveryHandler handles the panic for both unary and stream interceptors.
 func panicRecoveryHandler(p interface{}) error {
 	stack := make([]byte, 4096)
@@ -0,0 +1,156 @@
+package interceptors
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"sortedstartup.com/stream/common/auth"
+)
+
+// fakeSessions keeps sessions in memory
+type fakeSessions struct {
+	sessions map[string]*auth.AuthContext
+	created  int
+}
+
+func (f *fakeSessions) CreateSession(ctx context.Context, authContext *auth.AuthContext, userAgent string) (string, time.Time, error) {
+	f.created++
+	token := fmt.Sprintf("session-%d", f.created)
+	f.sessions[token] = authContext
+	return token, time.Now().Add(time.Hour), nil
+}
+
+func (f *fakeSessions) GetSession(ctx context.Context, sessionToken string) (*auth.AuthContext, error) {
+	authContext, ok := f.sessions[sessionToken]
+	if !ok {
+		return nil, errors.New("invalid session")
+	}
+	return authContext, nil
+}
+
+func (f *fakeSessions) DeleteSession(ctx context.Context, sessionToken string) error {
+	delete(f.sessions, sessionToken)
+	return nil
+}
+
+func okHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+}
+
+func sessionCookie(rec *httptest.ResponseRecorder) *http.Cookie {
+	for _, cookie := range rec.Result().Cookies() {
+		if cookie.Name == auth.SessionCookieName {
+			return cookie
+		}
+	}
+	return nil
+}
+
+// browserRequest is a grpc-web call as sent by the webapp
+func browserRequest() *http.Request {
+	req := httptest.NewRequest(http.MethodPost, "/videoservice.VideoService/ListVideos", nil)
+	req.Header.Set("Content-Type", "application/grpc-web+proto")
+	req.Header.Set("Origin", "https://stream.example.com")
+	return req
+}
+
+func TestSessionCookieMiddleware(t *testing.T) {
+	sessions := &fakeSessions{sessions: map[string]*auth.AuthContext{}}
+	handler := HTTPHeaderAuthMiddleware(testAuth, SessionCookieMiddleware(sessions, okHandler()))
+
+	// The first request starts a session, the ID token never ends up in a cookie
+	req := browserRequest()
+	req.Header.Set("authorization", "id-token")
+	req.AddCookie(&http.Cookie{Name: legacyAuthCookieName, Value: "id-token"})
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+
+	cookie := sessionCookie(rec)
+	if cookie == nil || cookie.Value != "session-1" || !cookie.HttpOnly {
+		t.Fatalf("session cookie = %+v, want an HttpOnly session-1", cookie)
+	}
+	for _, c := range rec.Result().Cookies() {
+		if c.Name == legacyAuthCookieName && c.MaxAge >= 0 {
+			t.Errorf("legacy authorization cookie should be cleared, got %+v", c)
+		}
+	}
+
+	// Later requests keep the session
+	req = browserRequest()
+	req.Header.Set("authorization", "id-token")
+	req.AddCookie(cookie)
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	if sessionCookie(rec) != nil || sessions.created != 1 {
+		t.Errorf("the existing session should be kept, %d sessions created", sessions.created)
+	}
+
+	// Access tokens don't get sessions
+	req = browserRequest()
+	req.Header.Set("authorization", auth.AccessTokenPrefix+"read")
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	if sessionCookie(rec) != nil {
+		t.Error("access tokens should not get a session cookie")
+	}
+
+	// Nor do clients other than browsers, they never send the cookie back
+	req = httptest.NewRequest(http.MethodPost, "/videoservice.VideoService/ListVideos", nil)
+	req.Header.Set("Content-Type", "application/grpc-web+proto")
+	req.Header.Set("authorization", "id-token")
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	if sessionCookie(rec) != nil || sessions.created != 1 {
+		t.Errorf("only browser grpc-web requests should get a session, %d sessions created", sessions.created)
+	}
+}
+
+func TestCookieAuthMiddleware_AndLogout(t *testing.T) {
+	sessions := &fakeSessions{sessions: map[string]*auth.AuthContext{
+		"session-1": {User: &auth.User{ID: "user-1"}, IsAuthenticated: true, SessionID: "id-1"},
+	}}
+	handler := CookieAuthMiddleware(sessions, okHandler())
+
+	serve := func(cookie *http.Cookie) int {
+		req := httptest.NewRequest(http.MethodGet, "/video/video-1", nil)
+		if cookie != nil {
+			req.AddCookie(cookie)
+		}
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		return rec.Code
+	}
+
+	if code := serve(&http.Cookie{Name: auth.SessionCookieName, Value: "session-1"}); code != http.StatusOK {
+		t.Errorf("valid session: status = %d, want 200", code)
+	}
+	if code := serve(nil); code != http.StatusForbidden {
+		t.Errorf("no cookie: status = %d, want 403", code)
+	}
+	// Raw ID tokens are no longer accepted as cookies
+	if code := serve(&http.Cookie{Name: auth.SessionCookieName, Value: "id-token"}); code != http.StatusUnauthorized {
+		t.Errorf("ID token cookie: status = %d, want 401", code)
+	}
+
+	req := httptest.NewRequest(http.MethodPost, "/api/auth/logout", nil)
+	req.AddCookie(&http.Cookie{Name: auth.SessionCookieName, Value: "session-1"})
+	rec := httptest.NewRecorder()
+	LogoutHandler(sessions).ServeHTTP(rec, req)
+	if rec.Code != http.StatusNoContent {
+		t.Errorf("logout: status = %d, want 204", rec.Code)
+	}
+	if cookie := sessionCookie(rec); cookie == nil || cookie.MaxAge >= 0 {
+		t.Errorf("logout should clear the session cookie, got %+v", cookie)
+	}
+
+	if code := serve(&http.Cookie{Name: auth.SessionCookieName, Value: "session-1"}); code != http.StatusUnauthorized {
+		t.Errorf("after logout: status = %d, want 401", code)
+	}
+}
@@ -0,0 +1,76 @@
+package interceptors
+
+import (
+	"context"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// fakeServerStream only provides a context, which is all the interceptors use
+type fakeServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (f *fakeServerStream) Context() context.Context {
+	return f.ctx
+}
+
+func TestTenantStreamInterceptor(t *testing.T) {
+	tests := []struct {
+		name     string
+		md       metadata.MD
+		wantID   string
+		wantSeen bool
+	}{
+		{"Tenant header", metadata.Pairs(TENANT_ID_HEADER, "tenant-1"), "tenant-1", true},
+		{"No tenant header", metadata.Pairs("other", "value"), "", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stream := &fakeServerStream{ctx: metadata.NewIncomingContext(context.Background(), tt.md)}
+
+			var handlerCtx context.Context
+			err := TenantStreamInterceptor()(nil, stream, &grpc.StreamServerInfo{}, func(srv interface{}, ss grpc.ServerStream) error {
+				handlerCtx = ss.Context()
+				return nil
+			})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			tenantID, err := GetTenantIDFromContext(handlerCtx)
+			if (err == nil) != tt.wantSeen || tenantID != tt.wantID {
+				t.Errorf("GetTenantIDFromContext() = %q, %v, want %q", tenantID, err, tt.wantID)
+			}
+		})
+	}
+}
+
+func TestTenantStreamInterceptor_MissingMetadata(t *testing.T) {
+	stream := &fakeServerStream{ctx: context.Background()}
+
+	err := TenantStreamInterceptor()(nil, stream, &grpc.StreamServerInfo{}, func(srv interface{}, ss grpc.ServerStream) error {
+		t.Error("handler should not be called without metadata")
+		return nil
+	})
+	if err == nil {
+		t.Error("expected an error for a stream without metadata")
+	}
+}
+
+func TestPanicRecoveryStreamInterceptor(t *testing.T) {
+	stream := &fakeServerStream{ctx: context.Background()}
+
+	err := PanicRecoveryStreamInterceptor()(nil, stream, &grpc.StreamServerInfo{}, func(srv interface{}, ss grpc.ServerStream) error {
+		panic("boom")
+	})
+	if status.Code(err) != codes.Internal {
+		t.Errorf("expected Internal, got %v", err)
+	}
+}
@@ -5,7 +5,9 @@ import (
 	"fmt"
 
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
 )
 
 const TENANT_ID_HEADER = "x-tenant-id"
@@ -18,33 +20,70 @@ const tenantIDKey contextKey = "tenant-id"
 // TenantInterceptor extracts the x-tenant-id header from gRPC metadata and puts it in context
 func TenantInterceptor() grpc.UnaryServerInterceptor {
 	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
-		// Extract metadata from context
-		md, ok := metadata.FromIncomingContext(ctx)
-		if !ok {
-			// Handle error: metadata is not provided
-			return "", fmt.Errorf("missing metadata")
+		ctx, err := tenantContext(ctx)
+		if err != nil {
+			return "", err
 		}
+		return handler(ctx, req)
+	}
+}
 
-		// Look for x-tenant-id header (case-insensitive)
-		// NOTE: The key is case-insensitive !!
-		// 'x-tenant-id' --received-as-> x-tenant-id
-		tenantIDHeaders, ok := md[TENANT_ID_HEADER]
-
-		// we did not find the tenant ID header
-		if !ok || len(tenantIDHeaders) == 0 {
-			// No tenant ID found, continue without tenant ID
-			return handler(ctx, req)
+// TenantStreamInterceptor is the streaming counterpart of TenantInterceptor
+func TenantStreamInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, err := tenantContext(ss.Context())
+		if err != nil {
+			return err
 		}
+		return handler(srv, &wrappedServerStream{ServerStream: ss, ctx: ctx})
+	}
+}
+
+// tenantContext adds the tenant ID from the x-tenant-id header to the context, if there is one
+func tenantContext(ctx context.Context) (context.Context, error) {
+	// Extract metadata from context
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		// Handle error: metadata is not provided
+		return nil, fmt.Errorf("missing metadata")
+	}
+
+	// Look for x-tenant-id header (case-insensitive)
+	// NOTE: The key is case-insensitive !!
+	// 'x-tenant-id' --received-as-> x-tenant-id
+	tenantIDHeaders, ok := md[TENANT_ID_HEADER]
 
-		tenantID := tenantIDHeaders[0]
-		// If tenant ID found, add it to context
-		if tenantID != "" {
-			ctx = context.WithValue(ctx, tenantIDKey, tenantID)
+	// Access tokens are bound to a tenant, which is also the default when the header is missing
+	tokenTenantID := ""
+	if authContext, err := AuthFromContext(ctx); err == nil && authContext.AccessToken != nil {
+		tokenTenantID = authContext.AccessToken.TenantID
+	}
+
+	// we did not find the tenant ID header
+	if !ok || len(tenantIDHeaders) == 0 {
+		if tokenTenantID != "" {
+			return ContextWithTenantID(ctx, tokenTenantID), nil
 		}
+		// No tenant ID found, continue without tenant ID
+		return ctx, nil
+	}
 
-		// Typically, tenantID is a slice of strings. Use the first value.
-		return handler(ctx, req)
+	// Typically, tenantID is a slice of strings. Use the first value.
+	tenantID := tenantIDHeaders[0]
+	if tokenTenantID != "" && tenantID != tokenTenantID {
+		return nil, status.Error(codes.PermissionDenied, "access token is not valid for this tenant")
 	}
+	// If tenant ID found, add it to context
+	if tenantID != "" {
+		ctx = ContextWithTenantID(ctx, tenantID)
+	}
+
+	return ctx, nil
+}
+
+// ContextWithTenantID puts the tenant ID in context, for HTTP handlers that don't go through the gRPC interceptor
+func ContextWithTenantID(ctx context.Context, tenantID string) context.Context {
+	return context.WithValue(ctx, tenantIDKey, tenantID)
 }
 
 // GetTenantIDFromContext retrieves the tenant ID from the context
@@ -0,0 +1,108 @@
+// Package pagination encodes keyset pagination cursors as opaque page tokens
+package pagination
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+)
+
+// ErrInvalidToken is returned for tokens that are malformed or were issued for another query
+var ErrInvalidToken = errors.New("invalid page token")
+
+// cursor is the content of a page token. Clients must treat tokens as opaque,
+// the format can change between releases.
+type cursor struct {
+	Scope string          `json:"s"` // Hash of the scope, which can be as long as the filters
+	ID    string          `json:"i"`
+	Key   json.RawMessage `json:"k,omitempty"` // Sort values of the row, see EncodeKeyedToken
+}
+
+func scopeHash(scope string) string {
+	sum := sha256.Sum256([]byte(scope))
+	return base64.RawURLEncoding.EncodeToString(sum[:12])
+}
+
+// EncodeToken returns a page token that resumes after the row with the given ID.
+// The scope identifies the query the token belongs to (e.g. the sort order and filters),
+// so that a token can't be replayed against a different query.
+func EncodeToken(scope, afterID string) string {
+	return encodeCursor(cursor{Scope: scopeHash(scope), ID: afterID})
+}
+
+// EncodeKeyedToken is EncodeToken for queries that resume after the row's sort values rather than
+// looking the row up, so the next page still works when the row was deleted in between.
+// key holds the sort values and is marshaled to JSON.
+func EncodeKeyedToken(scope, afterID string, key any) string {
+	data, _ := json.Marshal(key)
+	return encodeCursor(cursor{Scope: scopeHash(scope), ID: afterID, Key: data})
+}
+
+func encodeCursor(c cursor) string {
+	data, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// DecodeToken returns the ID of the row to resume after. An empty token is the first page
+// and decodes to an empty ID.
+func DecodeToken(scope, token string) (string, error) {
+	if token == "" {
+		return "", nil
+	}
+	c, err := decodeCursor(scope, token)
+	if err != nil {
+		return "", err
+	}
+	return c.ID, nil
+}
+
+// DecodeKeyedToken decodes a token of EncodeKeyedToken, the sort values are unmarshaled into key.
+// An empty token is the first page and leaves key alone.
+func DecodeKeyedToken(scope, token string, key any) (string, error) {
+	if token == "" {
+		return "", nil
+	}
+	c, err := decodeCursor(scope, token)
+	if err != nil {
+		return "", err
+	}
+	if len(c.Key) == 0 || json.Unmarshal(c.Key, key) != nil {
+		return "", ErrInvalidToken
+	}
+	return c.ID, nil
+}
+
+func decodeCursor(scope, token string) (cursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return cursor{}, ErrInvalidToken
+	}
+
+	var c cursor
+	err = json.Unmarshal(data, &c)
+	if err != nil || c.ID == "" || c.Scope != scopeHash(scope) {
+		return cursor{}, ErrInvalidToken
+	}
+	return c, nil
+}
+
+// TrimPage cuts a page fetched with one extra row back to pageSize. The extra row
+// tells that there is a next page, its token resumes after the last row kept.
+func TrimPage[T any](rows []T, pageSize int, scope string, id func(T) string) ([]T, string) {
+	if len(rows) <= pageSize {
+		return rows, ""
+	}
+	rows = rows[:pageSize]
+	return rows, EncodeToken(scope, id(rows[len(rows)-1]))
+}
+
+// TrimKeyedPage is TrimPage for EncodeKeyedToken tokens, key returns the sort values of a row
+func TrimKeyedPage[T any](rows []T, pageSize int, scope string, id func(T) string, key func(T) any) ([]T, string) {
+	if len(rows) <= pageSize {
+		return rows, ""
+	}
+	rows = rows[:pageSize]
+	last := rows[len(rows)-1]
+	return rows, EncodeKeyedToken(scope, id(last), key(last))
+}
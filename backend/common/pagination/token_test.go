@@ -0,0 +1,70 @@
+package pagination
+
+import "testing"
+
+func TestTokenRoundTrip(t *testing.T) {
+	token := EncodeToken("videos:channel-1", "video-42")
+
+	id, err := DecodeToken("videos:channel-1", token)
+	if err != nil || id != "video-42" {
+		t.Errorf("DecodeToken() = %q, %v, want video-42", id, err)
+	}
+}
+
+func TestDecodeToken(t *testing.T) {
+	tests := []struct {
+		name    string
+		scope   string
+		token   string
+		wantID  string
+		wantErr bool
+	}{
+		{"Empty token is the first page", "videos", "", "", false},
+		{"Other scope", "videos:channel-2", EncodeToken("videos:channel-1", "video-42"), "", true},
+		{"Not base64", "videos", "%%%", "", true},
+		{"Not JSON", "videos", "bm90LWpzb24", "", true},
+		{"No ID", "videos", EncodeToken("videos", ""), "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			id, err := DecodeToken(tt.scope, tt.token)
+			if (err != nil) != tt.wantErr || id != tt.wantID {
+				t.Errorf("DecodeToken() = %q, %v, want %q (error: %v)", id, err, tt.wantID, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestKeyedTokenRoundTrip(t *testing.T) {
+	type key struct {
+		Title string `json:"t"`
+	}
+	token := EncodeKeyedToken("videos", "video-42", key{Title: "Intro"})
+
+	var got key
+	id, err := DecodeKeyedToken("videos", token, &got)
+	if err != nil || id != "video-42" || got.Title != "Intro" {
+		t.Errorf("DecodeKeyedToken() = %q, %+v, %v, want video-42 and its title", id, got, err)
+	}
+
+	// Tokens without sort values can't resume a keyed query
+	_, err = DecodeKeyedToken("videos", EncodeToken("videos", "video-42"), &got)
+	if err != ErrInvalidToken {
+		t.Errorf("DecodeKeyedToken() error = %v, want ErrInvalidToken", err)
+	}
+}
+
+func TestTrimPage(t *testing.T) {
+	id := func(s string) string { return s }
+
+	rows, next := TrimPage([]string{"a", "b", "c"}, 2, "scope", id)
+	if len(rows) != 2 || next != EncodeToken("scope", "b") {
+		t.Errorf("TrimPage() = %v, %q, want [a b] and a token after b", rows, next)
+	}
+
+	rows, next = TrimPage([]string{"a", "b"}, 2, "scope", id)
+	if len(rows) != 2 || next != "" {
+		t.Errorf("TrimPage() = %v, %q, want the last page", rows, next)
+	}
+}
@@ -8,6 +8,8 @@ import (
 
 	"github.com/spf13/viper"
 	c "sortedstartup.com/stream/commentservice/config"
+	"sortedstartup.com/stream/common/auth"
+	n "sortedstartup.com/stream/notificationservice/config"
 	u "sortedstartup.com/stream/userservice/config"
 	s "sortedstartup.com/stream/videoservice/config"
 )
@@ -15,9 +17,12 @@ import (
 type MonolithConfig struct {
 	Server         ServerConfig           `json:"server" mapstructure:"server"`
 	LogLevel       string                 `json:"logLevel" mapstructure:"logLevel"`
+	Auth           auth.Config            `json:"auth" mapstructure:"auth"`
 	VideoService   s.VideoServiceConfig   `json:"videoService" mapstructure:"videoService"`
 	CommentService c.CommentServiceConfig `json:"commentService" mapstructure:"commentService"`
 	UserService    u.UserServiceConfig    `json:"userService" mapstructure:"userService"`
+
+	NotificationService n.NotificationServiceConfig `json:"notificationService" mapstructure:"notificationService"`
 }
 
 type ServerConfig struct {
@@ -34,6 +39,28 @@ func (c *ServerConfig) GrpcWebAddrPortString() string {
 	return fmt.Sprintf("%s:%d", c.Host, c.GrpcWebPort)
 }
 
+// maskedSecret replaces a configured secret in the logs, an empty secret is left empty so a missing one is still visible
+const maskedSecret = "********"
+
+// LogValue masks the secrets so the configuration can be logged,
+// e.g. the local token secret is enough to sign a token for any user
+func (c MonolithConfig) LogValue() slog.Value {
+	// plain has no LogValue method, otherwise slog would keep resolving it
+	type plain MonolithConfig
+	masked := plain(c)
+	masked.Auth.Local.TokenSecret = mask(c.Auth.Local.TokenSecret)
+	masked.VideoService.Playback.SigningKey = mask(c.VideoService.Playback.SigningKey)
+	masked.NotificationService.SMTP.Password = mask(c.NotificationService.SMTP.Password)
+	return slog.AnyValue(masked)
+}
+
+func mask(secret string) string {
+	if secret == "" {
+		return ""
+	}
+	return maskedSecret
+}
+
 func New() (MonolithConfig, error) {
 	// Because of config below config.yaml is read first, then environment variables are read
 	// environment variables have precendence over config.yaml
@@ -51,9 +78,27 @@ func New() (MonolithConfig, error) {
 	viper.SetDefault("server.grpcPort", 50051)
 	viper.SetDefault("server.grpcWebPort", 8080)
 
+	// Firebase unless an OIDC provider is configured, see auth.OIDCConfig
+	viper.SetDefault("auth.provider", "firebase")
+	viper.SetDefault("auth.oidc.issuerUrl", "")
+	viper.SetDefault("auth.oidc.clientId", "")
+	viper.SetDefault("auth.oidc.jwksUrl", "")
+	viper.SetDefault("auth.oidc.jwksRefreshInterval", "1h")
+	viper.SetDefault("auth.oidc.claims.userId", "sub")
+	viper.SetDefault("auth.oidc.claims.name", "name")
+	viper.SetDefault("auth.oidc.claims.email", "email")
+	viper.SetDefault("auth.oidc.claims.roles", "")
+	// Only used by the local provider, see auth.LocalConfig
+	viper.SetDefault("auth.local.tokenSecret", "")
+	viper.SetDefault("auth.local.tokenTTL", "24h")
+
 	viper.SetDefault("videoService.db.driver", "sqlite")
 	viper.SetDefault("videoService.db.url", "db.sqlite")
 	viper.SetDefault("videoService.fileStoreDir", "")
+	// Set a signing key when running more than one instance, see videoservice config.PlaybackConfig
+	viper.SetDefault("videoService.playback.signingKey", "")
+	viper.SetDefault("videoService.playback.urlTTL", "2h")
+	viper.SetDefault("videoService.playback.baseUrl", "/api/videoservice")
 
 	viper.SetDefault("commentService.db.driver", "sqlite")
 	viper.SetDefault("commentService.db.url", "db.sqlite")
@@ -61,6 +106,21 @@ func New() (MonolithConfig, error) {
 	viper.SetDefault("userService.db.driver", "sqlite")
 	viper.SetDefault("userService.db.url", "db.sqlite")
 	viper.SetDefault("userService.cacheSize", 10000)
+	viper.SetDefault("userService.localAuth.signupEnabled", true)
+	viper.SetDefault("userService.localAuth.adminEmails", []string{})
+	viper.SetDefault("userService.sessions.idleTimeout", "168h")
+	viper.SetDefault("userService.sessions.maxAge", "720h")
+	viper.SetDefault("userService.tenantDeletion.gracePeriod", "168h")
+
+	viper.SetDefault("notificationService.db.driver", "sqlite")
+	viper.SetDefault("notificationService.db.url", "db.sqlite")
+	viper.SetDefault("notificationService.appUrl", "http://localhost:5173")
+	// Email is disabled until an SMTP host is configured
+	viper.SetDefault("notificationService.smtp.host", "")
+	viper.SetDefault("notificationService.smtp.port", 587)
+	viper.SetDefault("notificationService.smtp.username", "")
+	viper.SetDefault("notificationService.smtp.password", "")
+	viper.SetDefault("notificationService.smtp.from", "Stream <no-reply@localhost>")
 
 	err := viper.ReadInConfig() // Find and read the config file
 	if err != nil {
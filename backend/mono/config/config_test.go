@@ -0,0 +1,34 @@
+package config
+
+import (
+	"bytes"
+	"log/slog"
+	"strings"
+	"testing"
+)
+
+func TestMonolithConfig_LogValueMasksSecrets(t *testing.T) {
+	var config MonolithConfig
+	config.Auth.Local.TokenSecret = "local-token-secret-0123456789abcdef"
+	config.VideoService.Playback.SigningKey = "playback-signing-key"
+	config.NotificationService.SMTP.Password = "smtp-password"
+	config.NotificationService.SMTP.Username = "smtp-user"
+
+	for _, handler := range []func(*bytes.Buffer) slog.Handler{
+		func(b *bytes.Buffer) slog.Handler { return slog.NewTextHandler(b, nil) },
+		func(b *bytes.Buffer) slog.Handler { return slog.NewJSONHandler(b, nil) },
+	} {
+		var out bytes.Buffer
+		slog.New(handler(&out)).Info("Using monolith configuration", "config", config)
+
+		logged := out.String()
+		for _, secret := range []string{config.Auth.Local.TokenSecret, config.VideoService.Playback.SigningKey, config.NotificationService.SMTP.Password} {
+			if strings.Contains(logged, secret) {
+				t.Errorf("secret %q was logged: %s", secret, logged)
+			}
+		}
+		if !strings.Contains(logged, maskedSecret) || !strings.Contains(logged, "smtp-user") {
+			t.Errorf("expected the masked config to be logged, got %s", logged)
+		}
+	}
+}
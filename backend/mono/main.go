@@ -10,6 +10,7 @@ import (
 	"log/slog"
 	"net"
 	"net/http"
+	"slices"
 	"time"
 
 	"github.com/improbable-eng/grpc-web/go/grpcweb"
@@ -28,6 +29,9 @@ import (
 
 	userAPI "sortedstartup.com/stream/userservice/api"
 	userProto "sortedstartup.com/stream/userservice/proto"
+
+	notificationAPI "sortedstartup.com/stream/notificationservice/api"
+	notificationProto "sortedstartup.com/stream/notificationservice/proto"
 )
 
 //go:embed webapp/dist
@@ -69,17 +73,287 @@ func (w *TenantServiceClientWrapper) GetUsers(ctx context.Context, req *userProt
 	return w.tenantAPI.GetUsers(ctx, req)
 }
 
-type Monolith struct {
-	Config   *config.MonolithConfig
-	Firebase *auth.Firebase
+func (w *TenantServiceClientWrapper) LookupUsers(ctx context.Context, req *userProto.LookupUsersRequest, opts ...grpc.CallOption) (*userProto.LookupUsersResponse, error) {
+	return w.tenantAPI.LookupUsers(ctx, req)
+}
+
+func (w *TenantServiceClientWrapper) InviteUser(ctx context.Context, req *userProto.InviteUserRequest, opts ...grpc.CallOption) (*userProto.InviteUserResponse, error) {
+	return w.tenantAPI.InviteUser(ctx, req)
+}
+
+func (w *TenantServiceClientWrapper) ListInvitations(ctx context.Context, req *userProto.ListInvitationsRequest, opts ...grpc.CallOption) (*userProto.ListInvitationsResponse, error) {
+	return w.tenantAPI.ListInvitations(ctx, req)
+}
+
+func (w *TenantServiceClientWrapper) RevokeInvitation(ctx context.Context, req *userProto.RevokeInvitationRequest, opts ...grpc.CallOption) (*userProto.RevokeInvitationResponse, error) {
+	return w.tenantAPI.RevokeInvitation(ctx, req)
+}
+
+func (w *TenantServiceClientWrapper) AcceptInvitation(ctx context.Context, req *userProto.AcceptInvitationRequest, opts ...grpc.CallOption) (*userProto.AcceptInvitationResponse, error) {
+	return w.tenantAPI.AcceptInvitation(ctx, req)
+}
+
+func (w *TenantServiceClientWrapper) DeclineInvitation(ctx context.Context, req *userProto.DeclineInvitationRequest, opts ...grpc.CallOption) (*userProto.DeclineInvitationResponse, error) {
+	return w.tenantAPI.DeclineInvitation(ctx, req)
+}
+
+func (w *TenantServiceClientWrapper) UpdateUserRole(ctx context.Context, req *userProto.UpdateUserRoleRequest, opts ...grpc.CallOption) (*userProto.UpdateUserRoleResponse, error) {
+	return w.tenantAPI.UpdateUserRole(ctx, req)
+}
+
+func (w *TenantServiceClientWrapper) RemoveUser(ctx context.Context, req *userProto.RemoveUserRequest, opts ...grpc.CallOption) (*userProto.RemoveUserResponse, error) {
+	return w.tenantAPI.RemoveUser(ctx, req)
+}
+
+func (w *TenantServiceClientWrapper) LeaveTenant(ctx context.Context, req *userProto.LeaveTenantRequest, opts ...grpc.CallOption) (*userProto.LeaveTenantResponse, error) {
+	return w.tenantAPI.LeaveTenant(ctx, req)
+}
+
+func (w *TenantServiceClientWrapper) TransferOwnership(ctx context.Context, req *userProto.TransferOwnershipRequest, opts ...grpc.CallOption) (*userProto.TransferOwnershipResponse, error) {
+	return w.tenantAPI.TransferOwnership(ctx, req)
+}
+
+func (w *TenantServiceClientWrapper) UpdateTenant(ctx context.Context, req *userProto.UpdateTenantRequest, opts ...grpc.CallOption) (*userProto.UpdateTenantResponse, error) {
+	return w.tenantAPI.UpdateTenant(ctx, req)
+}
+
+func (w *TenantServiceClientWrapper) DeleteTenant(ctx context.Context, req *userProto.DeleteTenantRequest, opts ...grpc.CallOption) (*userProto.DeleteTenantResponse, error) {
+	return w.tenantAPI.DeleteTenant(ctx, req)
+}
+
+func (w *TenantServiceClientWrapper) RestoreTenant(ctx context.Context, req *userProto.RestoreTenantRequest, opts ...grpc.CallOption) (*userProto.RestoreTenantResponse, error) {
+	return w.tenantAPI.RestoreTenant(ctx, req)
+}
+
+// VideoServiceClientWrapper wraps the VideoAPI to implement the VideoServiceClient interface
+type VideoServiceClientWrapper struct {
+	videoAPI *videoAPI.VideoAPI
+}
+
+func (w *VideoServiceClientWrapper) CreateVideo(ctx context.Context, req *videoProto.CreateVideoRequest, opts ...grpc.CallOption) (*videoProto.Video, error) {
+	return w.videoAPI.CreateVideo(ctx, req)
+}
+
+func (w *VideoServiceClientWrapper) GetVideo(ctx context.Context, req *videoProto.GetVideoRequest, opts ...grpc.CallOption) (*videoProto.Video, error) {
+	return w.videoAPI.GetVideo(ctx, req)
+}
+
+func (w *VideoServiceClientWrapper) ListVideos(ctx context.Context, req *videoProto.ListVideosRequest, opts ...grpc.CallOption) (*videoProto.ListVideosResponse, error) {
+	return w.videoAPI.ListVideos(ctx, req)
+}
+
+func (w *VideoServiceClientWrapper) UpdateVideo(ctx context.Context, req *videoProto.UpdateVideoRequest, opts ...grpc.CallOption) (*videoProto.Video, error) {
+	return w.videoAPI.UpdateVideo(ctx, req)
+}
+
+func (w *VideoServiceClientWrapper) DeleteVideo(ctx context.Context, req *videoProto.DeleteVideoRequest, opts ...grpc.CallOption) (*videoProto.DeleteVideoResponse, error) {
+	return w.videoAPI.DeleteVideo(ctx, req)
+}
+
+func (w *VideoServiceClientWrapper) MoveVideoToChannel(ctx context.Context, req *videoProto.MoveVideoToChannelRequest, opts ...grpc.CallOption) (*videoProto.MoveVideoToChannelResponse, error) {
+	return w.videoAPI.MoveVideoToChannel(ctx, req)
+}
+
+func (w *VideoServiceClientWrapper) RemoveVideoFromChannel(ctx context.Context, req *videoProto.RemoveVideoFromChannelRequest, opts ...grpc.CallOption) (*videoProto.RemoveVideoFromChannelResponse, error) {
+	return w.videoAPI.RemoveVideoFromChannel(ctx, req)
+}
+
+func (w *VideoServiceClientWrapper) ShareVideo(ctx context.Context, req *videoProto.ShareVideoRequest, opts ...grpc.CallOption) (*videoProto.ShareLink, error) {
+	return w.videoAPI.ShareVideo(ctx, req)
+}
+
+func (w *VideoServiceClientWrapper) SaveWatchProgress(ctx context.Context, req *videoProto.SaveWatchProgressRequest, opts ...grpc.CallOption) (*videoProto.WatchProgress, error) {
+	return w.videoAPI.SaveWatchProgress(ctx, req)
+}
+
+func (w *VideoServiceClientWrapper) GetWatchProgress(ctx context.Context, req *videoProto.GetWatchProgressRequest, opts ...grpc.CallOption) (*videoProto.WatchProgress, error) {
+	return w.videoAPI.GetWatchProgress(ctx, req)
+}
+
+func (w *VideoServiceClientWrapper) AddReaction(ctx context.Context, req *videoProto.AddReactionRequest, opts ...grpc.CallOption) (*videoProto.Reaction, error) {
+	return w.videoAPI.AddReaction(ctx, req)
+}
+
+func (w *VideoServiceClientWrapper) RemoveReaction(ctx context.Context, req *videoProto.RemoveReactionRequest, opts ...grpc.CallOption) (*videoProto.RemoveReactionResponse, error) {
+	return w.videoAPI.RemoveReaction(ctx, req)
+}
+
+func (w *VideoServiceClientWrapper) ListReactions(ctx context.Context, req *videoProto.ListReactionsRequest, opts ...grpc.CallOption) (*videoProto.ListReactionsResponse, error) {
+	return w.videoAPI.ListReactions(ctx, req)
+}
+
+func (w *VideoServiceClientWrapper) FilterVideoViewers(ctx context.Context, req *videoProto.FilterVideoViewersRequest, opts ...grpc.CallOption) (*videoProto.FilterVideoViewersResponse, error) {
+	return w.videoAPI.FilterVideoViewers(ctx, req)
+}
+
+// ChannelServiceClientWrapper wraps the ChannelAPI to implement the ChannelServiceClient interface
+type ChannelServiceClientWrapper struct {
+	channelAPI *videoAPI.ChannelAPI
+}
+
+func (w *ChannelServiceClientWrapper) CreateChannel(ctx context.Context, req *videoProto.CreateChannelRequest, opts ...grpc.CallOption) (*videoProto.CreateChannelResponse, error) {
+	return w.channelAPI.CreateChannel(ctx, req)
+}
+
+func (w *ChannelServiceClientWrapper) UpdateChannel(ctx context.Context, req *videoProto.UpdateChannelRequest, opts ...grpc.CallOption) (*videoProto.UpdateChannelResponse, error) {
+	return w.channelAPI.UpdateChannel(ctx, req)
+}
+
+func (w *ChannelServiceClientWrapper) GetChannels(ctx context.Context, req *videoProto.GetChannelsRequest, opts ...grpc.CallOption) (*videoProto.GetChannelsResponse, error) {
+	return w.channelAPI.GetChannels(ctx, req)
+}
+
+func (w *ChannelServiceClientWrapper) GetChannel(ctx context.Context, req *videoProto.GetChannelRequest, opts ...grpc.CallOption) (*videoProto.GetChannelResponse, error) {
+	return w.channelAPI.GetChannel(ctx, req)
+}
+
+func (w *ChannelServiceClientWrapper) GetMembers(ctx context.Context, req *videoProto.GetChannelMembersRequest, opts ...grpc.CallOption) (*videoProto.GetChannelMembersResponse, error) {
+	return w.channelAPI.GetMembers(ctx, req)
+}
+
+func (w *ChannelServiceClientWrapper) AddMember(ctx context.Context, req *videoProto.AddChannelMemberRequest, opts ...grpc.CallOption) (*videoProto.AddChannelMemberResponse, error) {
+	return w.channelAPI.AddMember(ctx, req)
+}
+
+func (w *ChannelServiceClientWrapper) RemoveMember(ctx context.Context, req *videoProto.RemoveChannelMemberRequest, opts ...grpc.CallOption) (*videoProto.RemoveChannelMemberResponse, error) {
+	return w.channelAPI.RemoveMember(ctx, req)
+}
+
+func (w *ChannelServiceClientWrapper) UpdateMemberRole(ctx context.Context, req *videoProto.UpdateChannelMemberRoleRequest, opts ...grpc.CallOption) (*videoProto.UpdateChannelMemberRoleResponse, error) {
+	return w.channelAPI.UpdateMemberRole(ctx, req)
+}
+
+func (w *ChannelServiceClientWrapper) DiscoverChannels(ctx context.Context, req *videoProto.DiscoverChannelsRequest, opts ...grpc.CallOption) (*videoProto.DiscoverChannelsResponse, error) {
+	return w.channelAPI.DiscoverChannels(ctx, req)
+}
 
-	VideoAPI      *videoAPI.VideoAPI
-	CommentAPI    *commentAPI.CommentAPI
-	UserAPI       *userAPI.UserAPI
-	TenantAPI     *userAPI.TenantAPI
-	ChannelAPI    *videoAPI.ChannelAPI
-	GRPCServer    *grpc.Server
-	GRPCWebServer *http.Server
+func (w *ChannelServiceClientWrapper) JoinChannel(ctx context.Context, req *videoProto.JoinChannelRequest, opts ...grpc.CallOption) (*videoProto.JoinChannelResponse, error) {
+	return w.channelAPI.JoinChannel(ctx, req)
+}
+
+func (w *ChannelServiceClientWrapper) RequestAccess(ctx context.Context, req *videoProto.RequestChannelAccessRequest, opts ...grpc.CallOption) (*videoProto.RequestChannelAccessResponse, error) {
+	return w.channelAPI.RequestAccess(ctx, req)
+}
+
+func (w *ChannelServiceClientWrapper) GetAccessRequests(ctx context.Context, req *videoProto.GetChannelAccessRequestsRequest, opts ...grpc.CallOption) (*videoProto.GetChannelAccessRequestsResponse, error) {
+	return w.channelAPI.GetAccessRequests(ctx, req)
+}
+
+func (w *ChannelServiceClientWrapper) ApproveAccessRequest(ctx context.Context, req *videoProto.ApproveChannelAccessRequest, opts ...grpc.CallOption) (*videoProto.ApproveChannelAccessResponse, error) {
+	return w.channelAPI.ApproveAccessRequest(ctx, req)
+}
+
+func (w *ChannelServiceClientWrapper) DenyAccessRequest(ctx context.Context, req *videoProto.DenyChannelAccessRequest, opts ...grpc.CallOption) (*videoProto.DenyChannelAccessResponse, error) {
+	return w.channelAPI.DenyAccessRequest(ctx, req)
+}
+
+func (w *ChannelServiceClientWrapper) ArchiveChannel(ctx context.Context, req *videoProto.ArchiveChannelRequest, opts ...grpc.CallOption) (*videoProto.ArchiveChannelResponse, error) {
+	return w.channelAPI.ArchiveChannel(ctx, req)
+}
+
+func (w *ChannelServiceClientWrapper) UnarchiveChannel(ctx context.Context, req *videoProto.UnarchiveChannelRequest, opts ...grpc.CallOption) (*videoProto.UnarchiveChannelResponse, error) {
+	return w.channelAPI.UnarchiveChannel(ctx, req)
+}
+
+func (w *ChannelServiceClientWrapper) DeleteChannel(ctx context.Context, req *videoProto.DeleteChannelRequest, opts ...grpc.CallOption) (*videoProto.DeleteChannelResponse, error) {
+	return w.channelAPI.DeleteChannel(ctx, req)
+}
+
+// VideoCleanupClientWrapper wraps the videoservice TenantCleanupAPI to implement the TenantCleanupServiceClient interface
+type VideoCleanupClientWrapper struct {
+	tenantCleanupAPI *videoAPI.TenantCleanupAPI
+}
+
+func (w *VideoCleanupClientWrapper) RemoveTenantMember(ctx context.Context, req *videoProto.RemoveTenantMemberRequest, opts ...grpc.CallOption) (*videoProto.RemoveTenantMemberResponse, error) {
+	return w.tenantCleanupAPI.RemoveTenantMember(ctx, req)
+}
+
+func (w *VideoCleanupClientWrapper) DeleteTenantData(ctx context.Context, req *videoProto.DeleteTenantDataRequest, opts ...grpc.CallOption) (*videoProto.DeleteTenantDataResponse, error) {
+	return w.tenantCleanupAPI.DeleteTenantData(ctx, req)
+}
+
+// CommentCleanupClientWrapper wraps the commentservice TenantCleanupAPI to implement the TenantCleanupServiceClient interface
+type CommentCleanupClientWrapper struct {
+	tenantCleanupAPI *commentAPI.TenantCleanupAPI
+}
+
+func (w *CommentCleanupClientWrapper) DeleteTenantData(ctx context.Context, req *commentProto.DeleteTenantDataRequest, opts ...grpc.CallOption) (*commentProto.DeleteTenantDataResponse, error) {
+	return w.tenantCleanupAPI.DeleteTenantData(ctx, req)
+}
+
+// UserDirectoryClientWrapper wraps the UserAPI to implement the UserDirectoryServiceClient interface
+type UserDirectoryClientWrapper struct {
+	userAPI *userAPI.UserAPI
+}
+
+func (w *UserDirectoryClientWrapper) GetUsersByIDs(ctx context.Context, req *userProto.GetUsersByIDsRequest, opts ...grpc.CallOption) (*userProto.GetUsersByIDsResponse, error) {
+	return w.userAPI.GetUsersByIDs(ctx, req)
+}
+
+// NotificationPublisherClientWrapper wraps the PublisherAPI to implement the NotificationPublisherServiceClient interface
+type NotificationPublisherClientWrapper struct {
+	publisherAPI *notificationAPI.PublisherAPI
+}
+
+func (w *NotificationPublisherClientWrapper) Publish(ctx context.Context, req *notificationProto.PublishRequest, opts ...grpc.CallOption) (*notificationProto.PublishResponse, error) {
+	return w.publisherAPI.Publish(ctx, req)
+}
+
+func (w *NotificationPublisherClientWrapper) PublishEvent(ctx context.Context, req *notificationProto.PublishEventRequest, opts ...grpc.CallOption) (*notificationProto.PublishEventResponse, error) {
+	return w.publisherAPI.PublishEvent(ctx, req)
+}
+
+func (w *NotificationPublisherClientWrapper) SendInvitation(ctx context.Context, req *notificationProto.SendInvitationRequest, opts ...grpc.CallOption) (*notificationProto.SendInvitationResponse, error) {
+	return w.publisherAPI.SendInvitation(ctx, req)
+}
+
+// AccessTokenAuthWrapper accepts personal access tokens besides the ID tokens of the identity provider.
+// The access tokens are stored by userservice, which is created after the APIs that need the wrapper.
+type AccessTokenAuthWrapper struct {
+	idTokens       auth.Auth
+	accessTokenAPI *userAPI.AccessTokenAPI
+}
+
+func (w *AccessTokenAuthWrapper) VerifyIDToken(token string) (*auth.AuthContext, error) {
+	if auth.IsAccessToken(token) {
+		return w.accessTokenAPI.VerifyAccessToken(token)
+	}
+	return w.idTokens.VerifyIDToken(token)
+}
+
+// SessionStoreWrapper is the session store of the HTTP middlewares, the sessions are stored by userservice
+type SessionStoreWrapper struct {
+	sessionAPI *userAPI.SessionAPI
+}
+
+func (w *SessionStoreWrapper) CreateSession(ctx context.Context, authContext *auth.AuthContext, userAgent string) (string, time.Time, error) {
+	return w.sessionAPI.CreateSession(ctx, authContext, userAgent)
+}
+
+func (w *SessionStoreWrapper) GetSession(ctx context.Context, sessionToken string) (*auth.AuthContext, error) {
+	return w.sessionAPI.GetSession(ctx, sessionToken)
+}
+
+func (w *SessionStoreWrapper) DeleteSession(ctx context.Context, sessionToken string) error {
+	return w.sessionAPI.DeleteSession(ctx, sessionToken)
+}
+
+type Monolith struct {
+	Config *config.MonolithConfig
+	Auth   auth.Auth
+
+	VideoAPI        *videoAPI.VideoAPI
+	CommentAPI      *commentAPI.CommentAPI
+	UserAPI         *userAPI.UserAPI
+	TenantAPI       *userAPI.TenantAPI
+	LocalAuthAPI    *userAPI.LocalAuthAPI // Only with the local auth provider
+	AccessTokenAPI  *userAPI.AccessTokenAPI
+	SessionAPI      *userAPI.SessionAPI
+	ChannelAPI      *videoAPI.ChannelAPI
+	NotificationAPI *notificationAPI.NotificationAPI
+	WebhookAPI      *notificationAPI.WebhookAPI
+	GRPCServer      *grpc.Server
+	GRPCWebServer   *http.Server
 
 	log *slog.Logger
 }
@@ -131,47 +405,83 @@ func NewMonolith() (*Monolith, error) {
 		return nil, err
 	}
 
-	//TODO: config may have secrets, it may not be a good idea to print it
-	//or we could have secret config, or we could have a way to mask secrets
+	// Secrets are masked by MonolithConfig.LogValue
 	log.Info("Using monolith configuration", "config", config)
 
 	log.Info("Creating monolith components")
 
-	log.Info("Creating firebase")
-	firebase, err := auth.NewFirebase()
+	log.Info("Creating auth provider", "provider", config.Auth.Provider)
+	idTokenProvider, err := auth.New(config.Auth)
 	if err != nil {
 		return nil, err
 	}
+	accessTokenAuthWrapper := &AccessTokenAuthWrapper{idTokens: idTokenProvider}
+	var authProvider auth.Auth = accessTokenAuthWrapper
+	sessionStoreWrapper := &SessionStoreWrapper{}
+
+	// Notifications are published by the other services, so this is created first.
+	// It calls back into userservice and videoservice, those wrappers are filled in once they exist.
+	log.Info("Creating notificationservice API")
+	userServiceClientWrapper := &UserServiceClientWrapper{}
+	userDirectoryClientWrapper := &UserDirectoryClientWrapper{}
+	videoServiceClientWrapper := &VideoServiceClientWrapper{}
+	notificationAPI, publisherAPI, webhookAPI, err := notificationAPI.NewNotificationAPIProduction(config.NotificationService, sessionStoreWrapper, userServiceClientWrapper, userDirectoryClientWrapper, videoServiceClientWrapper)
+	if err != nil {
+		log.Error("Could not create notificationservice API", "err", err)
+		return nil, err
+	}
+	notificationPublisherClientWrapper := &NotificationPublisherClientWrapper{publisherAPI: publisherAPI}
 
 	log.Info("Creating userservice API")
-	userAPI, tenantAPI, err := userAPI.NewUserAPI(config.UserService)
+	// Membership changes and tenant deletion clean up videoservice and commentservice, the wrappers are filled in once they exist
+	videoCleanupClientWrapper := &VideoCleanupClientWrapper{}
+	commentCleanupClientWrapper := &CommentCleanupClientWrapper{}
+	userAPI, tenantAPI, err := userAPI.NewUserAPI(config.UserService, notificationPublisherClientWrapper, videoCleanupClientWrapper, commentCleanupClientWrapper)
 	if err != nil {
 		log.Error("Could not create userservice API", "err", err)
 		return nil, err
 	}
+	userServiceClientWrapper.userAPI = userAPI
+	userDirectoryClientWrapper.userAPI = userAPI
+
+	accessTokenAPI, sessionAPI, localAuthAPI, publicMethods := newUserAuthAPIs(idTokenProvider, userAPI)
+	accessTokenAuthWrapper.accessTokenAPI = accessTokenAPI
+	sessionStoreWrapper.sessionAPI = sessionAPI
 
 	log.Info("Creating videoservice API")
 	// Create wrapper to avoid circular dependency
-	userServiceClientWrapper := &UserServiceClientWrapper{userAPI: userAPI}
 	tenantServiceClientWrapper := &TenantServiceClientWrapper{tenantAPI: tenantAPI}
-	videoAPI, channelAPI, err := videoAPI.NewVideoAPIProduction(config.VideoService, userServiceClientWrapper, tenantServiceClientWrapper)
+	videoAPI, channelAPI, videoCleanupAPI, err := videoAPI.NewVideoAPIProduction(config.VideoService, authProvider, sessionStoreWrapper, userServiceClientWrapper, tenantServiceClientWrapper, userDirectoryClientWrapper, notificationPublisherClientWrapper)
 	if err != nil {
 		log.Error("Could not create videoservice API", "err", err)
 		return nil, err
 	}
 
+	videoServiceClientWrapper.videoAPI = videoAPI
+	videoCleanupClientWrapper.tenantCleanupAPI = videoCleanupAPI
+
 	log.Info("Creating commentservice API")
-	commentAPI, err := commentAPI.NewCommentAPIProduction(config.CommentService)
+	channelServiceClientWrapper := &ChannelServiceClientWrapper{channelAPI: channelAPI}
+	commentAPI, commentCleanupAPI, err := commentAPI.NewCommentAPIProduction(config.CommentService, videoServiceClientWrapper, channelServiceClientWrapper, tenantServiceClientWrapper, notificationPublisherClientWrapper)
 	if err != nil {
 		log.Error("Could not create commentservice API", "err", err)
 		return nil, err
 	}
-
-	grpcServer := grpc.NewServer(grpc.ChainUnaryInterceptor(
-		interceptors.PanicRecoveryInterceptor(),
-		interceptors.FirebaseAuthInterceptor(firebase),
-		interceptors.TenantInterceptor(),
-	))
+	commentCleanupClientWrapper.tenantCleanupAPI = commentCleanupAPI
+
+	grpcServer := grpc.NewServer(
+		grpc.ChainUnaryInterceptor(
+			interceptors.PanicRecoveryInterceptor(),
+			interceptors.TokenAuthInterceptor(authProvider, publicMethods...),
+			interceptors.TenantInterceptor(),
+		),
+		// Streaming RPCs (e.g. NotificationService.Subscribe) get the same auth, tenant and panic handling
+		grpc.ChainStreamInterceptor(
+			interceptors.PanicRecoveryStreamInterceptor(),
+			interceptors.TokenAuthStreamInterceptor(authProvider),
+			interceptors.TenantStreamInterceptor(),
+		),
+	)
 
 	// GRPC Web is a http server 1.0 server that wraps a grpc server
 	// Browsers JS clients can only talk to GRPC web for now
@@ -189,16 +499,22 @@ func NewMonolith() (*Monolith, error) {
 
 	parentMux := http.NewServeMux()
 
-	// Create a handler for gRPC web requests with Firebase auth
+	// Create a handler for gRPC web requests with auth
 	grpcWebHandler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		wrappedGrpc.ServeHTTP(w, r)
 	})
 
-	// Wrap the gRPC web handler with Firebase auth middleware
-	authenticatedGrpcWebHandler := interceptors.FirebaseHTTPHeaderAuthMiddleware(firebase, grpcWebHandler)
+	// Wrap the gRPC web handler with the auth middleware, it also starts the browser session
+	// that video playback and live events authenticate with
+	authenticatedGrpcWebHandler := interceptors.HTTPHeaderAuthMiddleware(authProvider, interceptors.SessionCookieMiddleware(sessionStoreWrapper, grpcWebHandler))
 
 	parentMux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
 		if wrappedGrpc.IsGrpcWebRequest(r) || wrappedGrpc.IsAcceptableGrpcCorsRequest(r) {
+			// Login and signup are how the browser gets a token, they can't require one
+			if slices.Contains(publicMethods, r.URL.Path) {
+				grpcWebHandler.ServeHTTP(w, r)
+				return
+			}
 			authenticatedGrpcWebHandler.ServeHTTP(w, r)
 			return
 		}
@@ -249,7 +565,9 @@ func NewMonolith() (*Monolith, error) {
 		staticFileServer.ServeHTTP(w, r)
 	})
 
+	parentMux.Handle("/api/auth/logout", interceptors.LogoutHandler(sessionStoreWrapper))
 	parentMux.Handle("/api/videoservice/", http.StripPrefix("/api/videoservice", videoAPI.HTTPServerMux))
+	parentMux.Handle("/api/notificationservice/", http.StripPrefix("/api/notificationservice", notificationAPI.HTTPServerMux))
 
 	httpServer := &http.Server{
 		Addr:    config.Server.GrpcWebAddrPortString(),
@@ -257,19 +575,41 @@ func NewMonolith() (*Monolith, error) {
 	}
 
 	return &Monolith{
-		Config:        &config,
-		VideoAPI:      videoAPI,
-		ChannelAPI:    channelAPI,
-		CommentAPI:    commentAPI,
-		UserAPI:       userAPI,
-		TenantAPI:     tenantAPI,
-		Firebase:      firebase,
-		GRPCServer:    grpcServer,
-		GRPCWebServer: httpServer,
-		log:           log,
+		Config:          &config,
+		VideoAPI:        videoAPI,
+		ChannelAPI:      channelAPI,
+		CommentAPI:      commentAPI,
+		UserAPI:         userAPI,
+		TenantAPI:       tenantAPI,
+		LocalAuthAPI:    localAuthAPI,
+		AccessTokenAPI:  accessTokenAPI,
+		SessionAPI:      sessionAPI,
+		NotificationAPI: notificationAPI,
+		WebhookAPI:      webhookAPI,
+		Auth:            authProvider,
+		GRPCServer:      grpcServer,
+		GRPCWebServer:   httpServer,
+		log:             log,
 	}, nil
 }
 
+// newUserAuthAPIs creates the token and session APIs of userservice. Email/password accounts are only served
+// with the local auth provider, publicMethods are the ones that must be reachable without a token.
+func newUserAuthAPIs(idTokenProvider auth.Auth, users *userAPI.UserAPI) (accessTokenAPI *userAPI.AccessTokenAPI, sessionAPI *userAPI.SessionAPI, localAuthAPI *userAPI.LocalAuthAPI, publicMethods []string) {
+	accessTokenAPI = userAPI.NewAccessTokenAPI(users)
+	sessionAPI = userAPI.NewSessionAPI(users)
+
+	localProvider, ok := idTokenProvider.(*auth.Local)
+	if !ok {
+		return accessTokenAPI, sessionAPI, nil, nil
+	}
+	slog.Info("Creating userservice local auth API")
+	localAuthAPI = userAPI.NewLocalAuthAPI(users, localProvider)
+	// Tokens from before a password change are refused
+	localProvider.SetTokenVersions(localAuthAPI)
+	return accessTokenAPI, sessionAPI, localAuthAPI, userAPI.LocalAuthPublicMethods
+}
+
 func (m *Monolith) InitServices() error {
 
 	m.log.Info("Initializing User Service")
@@ -292,6 +632,12 @@ func (m *Monolith) InitServices() error {
 		return err
 	}
 
+	m.log.Info("Initializing Notification Service")
+	err = m.NotificationAPI.Init()
+	if err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -315,10 +661,34 @@ func (m *Monolith) StartServices() error {
 		return err
 	}
 
+	m.log.Info("Starting Notification Service")
+	err = m.NotificationAPI.Start()
+	if err != nil {
+		return err
+	}
+
 	return nil
 
 }
 
+// registerServices registers the public gRPC services.
+// Access tokens need a policy for each of their methods, see auth.HasMethodPolicy.
+func (m *Monolith) registerServices(server grpc.ServiceRegistrar) {
+	videoProto.RegisterVideoServiceServer(server, m.VideoAPI)
+	videoProto.RegisterChannelServiceServer(server, m.ChannelAPI)
+	commentProto.RegisterCommentServiceServer(server, m.CommentAPI)
+	userProto.RegisterUserServiceServer(server, m.UserAPI)
+	userProto.RegisterTenantServiceServer(server, m.TenantAPI)
+	userProto.RegisterAccessTokenServiceServer(server, m.AccessTokenAPI)
+	userProto.RegisterSessionServiceServer(server, m.SessionAPI)
+	if m.LocalAuthAPI != nil {
+		userProto.RegisterLocalAuthServiceServer(server, m.LocalAuthAPI)
+	}
+	// NotificationPublisherService, UserDirectoryService and the TenantCleanupServices are internal and only reachable through their client wrappers
+	notificationProto.RegisterNotificationServiceServer(server, m.NotificationAPI)
+	notificationProto.RegisterWebhookServiceServer(server, m.WebhookAPI)
+}
+
 func (m *Monolith) startServer() error {
 
 	listener, err := net.Listen("tcp", m.Config.Server.GRPCAddrPortString())
@@ -326,12 +696,7 @@ func (m *Monolith) startServer() error {
 		panic(err)
 	}
 
-	videoProto.RegisterVideoServiceServer(m.GRPCServer, m.VideoAPI)
-	videoProto.RegisterChannelServiceServer(m.GRPCServer, m.ChannelAPI)
-	commentProto.RegisterCommentServiceServer(m.GRPCServer, m.CommentAPI)
-	userProto.RegisterUserServiceServer(m.GRPCServer, m.UserAPI)
-	userProto.RegisterTenantServiceServer(m.GRPCServer, m.TenantAPI)
-
+	m.registerServices(m.GRPCServer)
 	reflection.Register(m.GRPCServer)
 
 	serverErr := make(chan error)
@@ -0,0 +1,59 @@
+package main
+
+import (
+	"testing"
+
+	"google.golang.org/grpc"
+	commentAPI "sortedstartup.com/stream/commentservice/api"
+	"sortedstartup.com/stream/common/auth"
+	notificationAPI "sortedstartup.com/stream/notificationservice/api"
+	userAPI "sortedstartup.com/stream/userservice/api"
+	videoAPI "sortedstartup.com/stream/videoservice/api"
+)
+
+// serviceRecorder records the services instead of serving them
+type serviceRecorder struct {
+	services []*grpc.ServiceDesc
+}
+
+func (r *serviceRecorder) RegisterService(desc *grpc.ServiceDesc, impl any) {
+	r.services = append(r.services, desc)
+}
+
+// Access tokens are checked against an explicit method table, a new RPC has to be added to it
+func TestMethodPolicies(t *testing.T) {
+	// Registering only needs the types, the local auth service is included like with the local provider
+	m := &Monolith{
+		VideoAPI:        &videoAPI.VideoAPI{},
+		CommentAPI:      &commentAPI.CommentAPI{},
+		UserAPI:         &userAPI.UserAPI{},
+		TenantAPI:       &userAPI.TenantAPI{},
+		LocalAuthAPI:    &userAPI.LocalAuthAPI{},
+		AccessTokenAPI:  &userAPI.AccessTokenAPI{},
+		SessionAPI:      &userAPI.SessionAPI{},
+		ChannelAPI:      &videoAPI.ChannelAPI{},
+		NotificationAPI: &notificationAPI.NotificationAPI{},
+		WebhookAPI:      &notificationAPI.WebhookAPI{},
+	}
+	recorder := &serviceRecorder{}
+	m.registerServices(recorder)
+
+	if len(recorder.services) == 0 {
+		t.Fatal("expected services to be registered")
+	}
+	for _, service := range recorder.services {
+		methods := []string{}
+		for _, method := range service.Methods {
+			methods = append(methods, method.MethodName)
+		}
+		for _, stream := range service.Streams {
+			methods = append(methods, stream.StreamName)
+		}
+		for _, method := range methods {
+			fullMethod := "/" + service.ServiceName + "/" + method
+			if !auth.HasMethodPolicy(fullMethod) {
+				t.Errorf("%s has no access token policy, add its scope to methodScopes in common/auth/access_token.go", fullMethod)
+			}
+		}
+	}
+}
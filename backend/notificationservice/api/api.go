@@ -0,0 +1,326 @@
+package api
+
+import (
+	"context"
+	"database/sql"
+	"log/slog"
+	"net/http"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	_ "modernc.org/sqlite"
+	"sortedstartup.com/stream/common/auth"
+	"sortedstartup.com/stream/common/interceptors"
+	"sortedstartup.com/stream/notificationservice/config"
+	"sortedstartup.com/stream/notificationservice/db"
+	"sortedstartup.com/stream/notificationservice/mail"
+	"sortedstartup.com/stream/notificationservice/proto"
+	userProto "sortedstartup.com/stream/userservice/proto"
+	videoProto "sortedstartup.com/stream/videoservice/proto"
+)
+
+const (
+	defaultPageSize = 20
+	maxPageSize     = 100
+
+	// maxMarkReadIDs bounds a single MarkRead call
+	maxMarkReadIDs = 100
+)
+
+// NotificationAPI serves the inbox of the calling user
+type NotificationAPI struct {
+	HTTPServerMux *http.ServeMux
+	config        config.NotificationServiceConfig
+	db            *sql.DB
+
+	log                *slog.Logger
+	dbQueries          db.Querier
+	userServiceClient  userProto.UserServiceClient
+	videoServiceClient videoProto.VideoServiceClient
+
+	// Shared with PublisherAPI, which feeds it
+	hub *eventHub
+
+	emailSender   *EmailSender // nil when email is disabled
+	webhookSender *WebhookSender
+
+	//implemented proto server
+	proto.UnimplementedNotificationServiceServer
+}
+
+// PublisherAPI delivers notifications on behalf of the other services
+type PublisherAPI struct {
+	log       *slog.Logger
+	dbQueries db.Querier
+	hub       *eventHub
+
+	// nil when email is disabled
+	emailOutbox *emailOutbox
+
+	//implemented proto server
+	proto.UnimplementedNotificationPublisherServiceServer
+}
+
+// NewNotificationAPITest creates a NotificationAPI instance with a mock database for testing.
+func NewNotificationAPITest(mockDB db.Querier, logger *slog.Logger) *NotificationAPI {
+	return &NotificationAPI{
+		log:       logger,
+		dbQueries: mockDB,
+		hub:       newEventHub(logger),
+	}
+}
+
+// NewPublisherAPITest creates a PublisherAPI instance with a mock database for testing.
+func NewPublisherAPITest(mockDB db.Querier, logger *slog.Logger) *PublisherAPI {
+	return &PublisherAPI{
+		log:       logger,
+		dbQueries: mockDB,
+		hub:       newEventHub(logger),
+	}
+}
+
+func NewNotificationAPIProduction(config config.NotificationServiceConfig, sessions auth.SessionStore, userServiceClient userProto.UserServiceClient, userDirectoryClient userProto.UserDirectoryServiceClient, videoServiceClient videoProto.VideoServiceClient) (*NotificationAPI, *PublisherAPI, *WebhookAPI, error) {
+	slog.Info("NewNotificationAPIProduction")
+
+	childLogger := slog.With("service", "NotificationAPI")
+
+	_db, err := sql.Open(config.DB.Driver, config.DB.Url)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	dbQueries := db.New(_db)
+	webhookSender := newWebhookSender(childLogger, dbQueries)
+	hub := newEventHub(childLogger)
+	ServerMux := http.NewServeMux()
+
+	notificationAPI := &NotificationAPI{
+		HTTPServerMux:      ServerMux,
+		config:             config,
+		db:                 _db,
+		log:                childLogger,
+		dbQueries:          dbQueries,
+		userServiceClient:  userServiceClient,
+		videoServiceClient: videoServiceClient,
+		hub:                hub,
+		webhookSender:      webhookSender,
+	}
+
+	publisherAPI := &PublisherAPI{
+		log:       childLogger,
+		dbQueries: dbQueries,
+		hub:       hub,
+	}
+
+	// EventSource can't send the authorization header, so this uses the browser session
+	ServerMux.Handle("/events", interceptors.CookieAuthMiddleware(sessions, interceptors.RequireScope(auth.ScopeNotificationsRead, http.HandlerFunc(notificationAPI.eventsHandler))))
+
+	webhookAPI := &WebhookAPI{
+		log:               childLogger,
+		dbQueries:         dbQueries,
+		userServiceClient: userServiceClient,
+		sender:            webhookSender,
+	}
+
+	if config.SMTP.Host == "" {
+		childLogger.Info("No SMTP host configured, email notifications are disabled")
+		return notificationAPI, publisherAPI, webhookAPI, nil
+	}
+
+	mailer, err := mail.NewSMTPMailer(config.SMTP)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	outbox := &emailOutbox{
+		appURL:              strings.TrimSuffix(config.AppURL, "/"),
+		log:                 childLogger,
+		dbQueries:           dbQueries,
+		userDirectoryClient: userDirectoryClient,
+	}
+	publisherAPI.emailOutbox = outbox
+	notificationAPI.emailSender = &EmailSender{
+		outbox: outbox,
+		mailer: mailer,
+		log:    childLogger,
+	}
+
+	return notificationAPI, publisherAPI, webhookAPI, nil
+}
+
+func (s *NotificationAPI) Start() error {
+	if s.emailSender != nil {
+		go s.emailSender.Run(context.Background())
+	}
+	if s.webhookSender != nil {
+		go s.webhookSender.Run(context.Background())
+	}
+	return nil
+}
+
+func (s *NotificationAPI) Init() error {
+	s.log.Info("Migrating database", "dbDriver", s.config.DB.Driver, "dbURL", s.config.DB.Url)
+	err := db.MigrateDB(s.config.DB.Driver, s.config.DB.Url)
+	if err != nil {
+		return err
+	}
+	s.log.Info("Migrating database done")
+	return nil
+}
+
+// ListNotifications pages through the caller's inbox, newest first
+func (s *NotificationAPI) ListNotifications(ctx context.Context, req *proto.ListNotificationsRequest) (*proto.ListNotificationsResponse, error) {
+	authContext, tenantID, err := s.inboxOwner(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	pageSize, pageNumber, err := normalizePagination(req.PageSize, req.PageNumber)
+	if err != nil {
+		return nil, err
+	}
+
+	// Fetch one extra row to know whether there is a next page
+	notifications, err := s.dbQueries.GetNotificationsPaginated(ctx, db.GetNotificationsPaginatedParams{
+		TenantID:   tenantID,
+		UserID:     authContext.User.ID,
+		UnreadOnly: req.UnreadOnly,
+		PageSize:   int64(pageSize + 1),
+		PageNumber: int64(pageNumber),
+	})
+	if err != nil {
+		s.log.Error("Error getting notifications", "err", err, "userID", authContext.User.ID)
+		return nil, status.Error(codes.Internal, "failed to get notifications")
+	}
+
+	unreadCount, err := s.countUnread(ctx, tenantID, authContext.User.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &proto.ListNotificationsResponse{UnreadCount: unreadCount}
+	if len(notifications) > int(pageSize) {
+		notifications = notifications[:pageSize]
+		response.NextPageNumber = pageNumber + 1
+	}
+	for _, notification := range notifications {
+		response.Notifications = append(response.Notifications, notificationToProto(notification))
+	}
+
+	return response, nil
+}
+
+// MarkRead marks some of the caller's notifications as read.
+// IDs that don't belong to the caller or are already read are ignored.
+func (s *NotificationAPI) MarkRead(ctx context.Context, req *proto.MarkReadRequest) (*proto.MarkReadResponse, error) {
+	authContext, tenantID, err := s.inboxOwner(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(req.NotificationIds) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "notification IDs are required")
+	}
+	if len(req.NotificationIds) > maxMarkReadIDs {
+		return nil, status.Errorf(codes.InvalidArgument, "at most %d notifications can be marked at once", maxMarkReadIDs)
+	}
+
+	_, err = s.dbQueries.MarkNotificationsRead(ctx, db.MarkNotificationsReadParams{
+		TenantID: tenantID,
+		UserID:   authContext.User.ID,
+		Ids:      req.NotificationIds,
+	})
+	if err != nil {
+		s.log.Error("Error marking notifications read", "err", err, "userID", authContext.User.ID)
+		return nil, status.Error(codes.Internal, "failed to mark notifications read")
+	}
+
+	unreadCount, err := s.countUnread(ctx, tenantID, authContext.User.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &proto.MarkReadResponse{UnreadCount: unreadCount}, nil
+}
+
+// MarkAllRead empties the caller's unread notifications in the current tenant
+func (s *NotificationAPI) MarkAllRead(ctx context.Context, req *proto.MarkAllReadRequest) (*proto.MarkReadResponse, error) {
+	authContext, tenantID, err := s.inboxOwner(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = s.dbQueries.MarkAllNotificationsRead(ctx, db.MarkAllNotificationsReadParams{
+		TenantID: tenantID,
+		UserID:   authContext.User.ID,
+	})
+	if err != nil {
+		s.log.Error("Error marking all notifications read", "err", err, "userID", authContext.User.ID)
+		return nil, status.Error(codes.Internal, "failed to mark notifications read")
+	}
+
+	return &proto.MarkReadResponse{UnreadCount: 0}, nil
+}
+
+// inboxOwner returns the caller and the tenant of the inbox they are reading.
+// An inbox only ever holds the caller's own notifications, so no further access check is needed.
+func (s *NotificationAPI) inboxOwner(ctx context.Context) (*auth.AuthContext, string, error) {
+	authContext, err := interceptors.AuthFromContext(ctx)
+	if err != nil {
+		return nil, "", status.Error(codes.Unauthenticated, "unauthenticated")
+	}
+
+	tenantID, err := interceptors.GetTenantIDFromContext(ctx)
+	if err != nil {
+		return nil, "", status.Error(codes.InvalidArgument, "tenant ID is required")
+	}
+
+	return authContext, tenantID, nil
+}
+
+func (s *NotificationAPI) countUnread(ctx context.Context, tenantID, userID string) (int32, error) {
+	count, err := s.dbQueries.CountUnreadNotifications(ctx, db.CountUnreadNotificationsParams{
+		TenantID: tenantID,
+		UserID:   userID,
+	})
+	if err != nil {
+		s.log.Error("Error counting unread notifications", "err", err, "userID", userID)
+		return 0, status.Error(codes.Internal, "failed to count unread notifications")
+	}
+	return int32(count), nil
+}
+
+// normalizePagination applies the default page size and rejects out of range values
+func normalizePagination(pageSize, pageNumber int32) (int32, int32, error) {
+	if pageSize < 0 || pageNumber < 0 {
+		return 0, 0, status.Error(codes.InvalidArgument, "page size and page number must not be negative")
+	}
+	if pageSize == 0 {
+		pageSize = defaultPageSize
+	}
+	if pageSize > maxPageSize {
+		pageSize = maxPageSize
+	}
+	return pageSize, pageNumber, nil
+}
+
+func notificationToProto(notification db.NotificationserviceNotification) *proto.Notification {
+	protoNotification := &proto.Notification{
+		Id:        notification.ID,
+		Type:      notificationTypeFromDB(notification.Type),
+		ActorId:   notification.ActorID,
+		ActorName: notification.ActorName,
+		Message:   notification.Message,
+		VideoId:   notification.VideoID.String,
+		ChannelId: notification.ChannelID.String,
+		CommentId: notification.CommentID.String,
+		IsRead:    notification.IsRead,
+		CreatedAt: timestamppb.New(notification.CreatedAt),
+	}
+	if notification.ReadAt.Valid {
+		protoNotification.ReadAt = timestamppb.New(notification.ReadAt.Time)
+	}
+	return protoNotification
+}
@@ -0,0 +1,204 @@
+package api
+
+import (
+	"context"
+	"database/sql"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"sortedstartup.com/stream/common/auth"
+	"sortedstartup.com/stream/common/interceptors"
+	"sortedstartup.com/stream/notificationservice/db"
+	mockdb "sortedstartup.com/stream/notificationservice/db/mocks"
+	"sortedstartup.com/stream/notificationservice/proto"
+)
+
+// Helper to build an auth context with the tenant header already extracted
+func buildAuthContext() context.Context {
+	user := &auth.AuthContext{
+		User: &auth.User{
+			ID:    "test-user-id",
+			Name:  "Test User",
+			Email: "test@example.com",
+		},
+	}
+
+	ctx := context.WithValue(context.Background(), auth.AUTH_CONTEXT_KEY, user)
+	ctx = metadata.NewIncomingContext(ctx, metadata.Pairs(interceptors.TENANT_ID_HEADER, "test-tenant"))
+
+	_, _ = interceptors.TenantInterceptor()(ctx, nil, nil, func(tenantCtx context.Context, req interface{}) (interface{}, error) {
+		ctx = tenantCtx
+		return nil, nil
+	})
+
+	return ctx
+}
+
+func TestListNotifications(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockDB := mockdb.NewMockQuerier(ctrl)
+	notificationAPI := NewNotificationAPITest(mockDB, slog.Default())
+	ctx := buildAuthContext()
+
+	readAt := time.Now()
+	mockDB.EXPECT().
+		GetNotificationsPaginated(gomock.Any(), db.GetNotificationsPaginatedParams{
+			TenantID:   "test-tenant",
+			UserID:     "test-user-id",
+			UnreadOnly: false,
+			PageSize:   2,
+			PageNumber: int64(0),
+		}).
+		Return([]db.NotificationserviceNotification{
+			{ID: "n-2", Type: "mention", Message: "alice mentioned you in a comment", CommentID: sql.NullString{String: "c-1", Valid: true}},
+			{ID: "n-1", Type: "reply", IsRead: true, ReadAt: sql.NullTime{Time: readAt, Valid: true}},
+		}, nil).
+		Times(1)
+
+	mockDB.EXPECT().
+		CountUnreadNotifications(gomock.Any(), db.CountUnreadNotificationsParams{TenantID: "test-tenant", UserID: "test-user-id"}).
+		Return(int64(3), nil).
+		Times(1)
+
+	resp, err := notificationAPI.ListNotifications(ctx, &proto.ListNotificationsRequest{PageSize: 1})
+
+	assert.NoError(t, err)
+	assert.Len(t, resp.Notifications, 1)
+	assert.Equal(t, proto.NotificationType_NOTIFICATION_TYPE_MENTION, resp.Notifications[0].Type)
+	assert.Equal(t, "c-1", resp.Notifications[0].CommentId)
+	assert.Nil(t, resp.Notifications[0].ReadAt)
+	assert.Equal(t, int32(1), resp.NextPageNumber)
+	assert.Equal(t, int32(3), resp.UnreadCount)
+}
+
+func TestListNotifications_MissingTenant(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockDB := mockdb.NewMockQuerier(ctrl)
+	notificationAPI := NewNotificationAPITest(mockDB, slog.Default())
+	ctx := context.WithValue(context.Background(), auth.AUTH_CONTEXT_KEY, &auth.AuthContext{User: &auth.User{ID: "test-user-id"}})
+
+	_, err := notificationAPI.ListNotifications(ctx, &proto.ListNotificationsRequest{})
+
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestMarkRead(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockDB := mockdb.NewMockQuerier(ctrl)
+	notificationAPI := NewNotificationAPITest(mockDB, slog.Default())
+	ctx := buildAuthContext()
+
+	// Scoped to the caller, so other users' IDs can't be marked
+	mockDB.EXPECT().
+		MarkNotificationsRead(gomock.Any(), db.MarkNotificationsReadParams{
+			TenantID: "test-tenant",
+			UserID:   "test-user-id",
+			Ids:      []string{"n-1", "n-2"},
+		}).
+		Return(int64(2), nil).
+		Times(1)
+
+	mockDB.EXPECT().
+		CountUnreadNotifications(gomock.Any(), gomock.Any()).
+		Return(int64(1), nil).
+		Times(1)
+
+	resp, err := notificationAPI.MarkRead(ctx, &proto.MarkReadRequest{NotificationIds: []string{"n-1", "n-2"}})
+
+	assert.NoError(t, err)
+	assert.Equal(t, int32(1), resp.UnreadCount)
+}
+
+func TestMarkRead_NoIDs(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockDB := mockdb.NewMockQuerier(ctrl)
+	notificationAPI := NewNotificationAPITest(mockDB, slog.Default())
+
+	_, err := notificationAPI.MarkRead(buildAuthContext(), &proto.MarkReadRequest{})
+
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestMarkAllRead(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockDB := mockdb.NewMockQuerier(ctrl)
+	notificationAPI := NewNotificationAPITest(mockDB, slog.Default())
+
+	mockDB.EXPECT().
+		MarkAllNotificationsRead(gomock.Any(), db.MarkAllNotificationsReadParams{TenantID: "test-tenant", UserID: "test-user-id"}).
+		Return(int64(4), nil).
+		Times(1)
+
+	resp, err := notificationAPI.MarkAllRead(buildAuthContext(), &proto.MarkAllReadRequest{})
+
+	assert.NoError(t, err)
+	assert.Equal(t, int32(0), resp.UnreadCount)
+}
+
+func TestPublish_SkipsActorAndDuplicates(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockDB := mockdb.NewMockQuerier(ctrl)
+	publisherAPI := NewPublisherAPITest(mockDB, slog.Default())
+
+	var recipients []string
+	mockDB.EXPECT().
+		CreateNotification(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, params db.CreateNotificationParams) error {
+			assert.Equal(t, "tenant-1", params.TenantID)
+			assert.Equal(t, "channel_video", params.Type)
+			assert.True(t, params.ChannelID.Valid)
+			assert.False(t, params.CommentID.Valid)
+			recipients = append(recipients, params.UserID)
+			return nil
+		}).
+		Times(2)
+
+	resp, err := publisherAPI.Publish(context.Background(), &proto.PublishRequest{
+		TenantId:     "tenant-1",
+		RecipientIds: []string{"actor", "member-1", "member-2", "member-1"},
+		Type:         proto.NotificationType_NOTIFICATION_TYPE_CHANNEL_VIDEO,
+		ActorId:      "actor",
+		Message:      "actor added \"Demo\" to Marketing",
+		VideoId:      "video-1",
+		ChannelId:    "channel-1",
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, int32(2), resp.DeliveredCount)
+	assert.Equal(t, []string{"member-1", "member-2"}, recipients)
+}
+
+func TestPublish_UnspecifiedType(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockDB := mockdb.NewMockQuerier(ctrl)
+	publisherAPI := NewPublisherAPITest(mockDB, slog.Default())
+
+	_, err := publisherAPI.Publish(context.Background(), &proto.PublishRequest{
+		TenantId:     "tenant-1",
+		RecipientIds: []string{"member-1"},
+		Message:      "hello",
+	})
+
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
@@ -0,0 +1,312 @@
+package api
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log/slog"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"sortedstartup.com/stream/notificationservice/db"
+	"sortedstartup.com/stream/notificationservice/mail"
+	"sortedstartup.com/stream/notificationservice/proto"
+	userProto "sortedstartup.com/stream/userservice/proto"
+)
+
+const (
+	outboxPollInterval  = 10 * time.Second
+	digestCheckInterval = 15 * time.Minute
+
+	// outboxBatchSize bounds the emails sent per poll
+	outboxBatchSize = 50
+	// maxEmailAttempts is how often an email is tried before it is marked failed,
+	// with the backoff below that is roughly a day
+	maxEmailAttempts = 8
+	retryBaseDelay   = time.Minute
+	retryMaxDelay    = 6 * time.Hour
+
+	digestBatchSize = 100
+	// maxDigestItems bounds the videos listed in one digest
+	maxDigestItems = 20
+)
+
+// emailOutbox renders emails and stores them in the outbox table for the EmailSender
+type emailOutbox struct {
+	appURL              string
+	log                 *slog.Logger
+	dbQueries           db.Querier
+	userDirectoryClient userProto.UserDirectoryServiceClient
+}
+
+// enqueueNotification queues an email for each recipient who wants one for this
+// kind of notification. The in-app notifications are already stored, so failures
+// are logged instead of failing the publish.
+func (o *emailOutbox) enqueueNotification(ctx context.Context, req *proto.PublishRequest, notificationType string, recipientIDs []string) {
+	kind := emailKind(notificationType)
+	if kind == "" || len(recipientIDs) == 0 {
+		return
+	}
+
+	preferences, err := o.dbQueries.GetEmailPreferencesByUserIDs(ctx, recipientIDs)
+	if err != nil {
+		o.log.Error("Error getting email preferences", "err", err)
+		return
+	}
+	optedOut := make(map[string]bool, len(preferences))
+	for _, preference := range preferences {
+		optedOut[preference.UserID] = !emailAllowed(preference, kind)
+	}
+
+	var userIDs []string
+	for _, recipientID := range recipientIDs {
+		if !optedOut[recipientID] {
+			userIDs = append(userIDs, recipientID)
+		}
+	}
+	if len(userIDs) == 0 {
+		return
+	}
+
+	users, err := o.lookupUsers(ctx, userIDs)
+	if err != nil {
+		return
+	}
+
+	content, err := mail.Render(kind, mail.Data{
+		ActorName:    req.ActorName,
+		Message:      req.Message,
+		Link:         o.link(req.VideoId, req.ChannelId),
+		SettingsLink: o.appURL + "/settings",
+	})
+	if err != nil {
+		o.log.Error("Error rendering email", "err", err, "kind", kind)
+		return
+	}
+
+	for _, user := range users {
+		o.enqueue(ctx, user, kind, content)
+	}
+}
+
+// enqueueInvitation queues an invitation to join a workspace. The invitee may not
+// have an account, so there are no preferences to respect and no user ID to store.
+func (o *emailOutbox) enqueueInvitation(ctx context.Context, req *proto.SendInvitationRequest) bool {
+	content, err := mail.Render(mail.KindInvitation, mail.Data{
+		ActorName:    req.ActorName,
+		Message:      fmt.Sprintf("%s invited you to the %s workspace", req.ActorName, req.TenantName),
+		Link:         o.appURL + "/invitations/accept?token=" + url.QueryEscape(req.Token),
+		SettingsLink: o.appURL + "/settings",
+	})
+	if err != nil {
+		o.log.Error("Error rendering email", "err", err, "kind", mail.KindInvitation)
+		return false
+	}
+	return o.enqueue(ctx, &userProto.User{Email: req.Email}, mail.KindInvitation, content)
+}
+
+// enqueue stores one rendered email, users without an email address are skipped
+func (o *emailOutbox) enqueue(ctx context.Context, user *userProto.User, kind string, content mail.Content) bool {
+	if user.Email == "" {
+		return false
+	}
+
+	err := o.dbQueries.CreateOutboxEmail(ctx, db.CreateOutboxEmailParams{
+		ID:        uuid.New().String(),
+		UserID:    user.Id,
+		ToAddress: user.Email,
+		Kind:      kind,
+		Subject:   content.Subject,
+		TextBody:  content.Text,
+		HtmlBody:  content.HTML,
+	})
+	if err != nil {
+		o.log.Error("Error queueing email", "err", err, "kind", kind, "userID", user.Id)
+		return false
+	}
+	return true
+}
+
+func (o *emailOutbox) lookupUsers(ctx context.Context, userIDs []string) ([]*userProto.User, error) {
+	resp, err := o.userDirectoryClient.GetUsersByIDs(ctx, &userProto.GetUsersByIDsRequest{UserIds: userIDs})
+	if err != nil {
+		o.log.Error("Error looking up email recipients", "err", err)
+		return nil, err
+	}
+	return resp.Users, nil
+}
+
+// link points at the most specific page for a notification
+func (o *emailOutbox) link(videoID, channelID string) string {
+	switch {
+	case videoID != "":
+		return o.appURL + "/video/" + videoID
+	case channelID != "":
+		return o.appURL + "/channel/" + channelID
+	default:
+		return o.appURL + "/"
+	}
+}
+
+// EmailSender delivers the outbox and queues the digests.
+// There should be one per database, two senders could send an email twice.
+type EmailSender struct {
+	outbox *emailOutbox
+	mailer mail.Mailer
+	log    *slog.Logger
+}
+
+// Run sends emails until ctx is cancelled. Emails left over from before a restart
+// are picked up on the first poll, a crash in the middle of a send can repeat it.
+func (s *EmailSender) Run(ctx context.Context) {
+	s.log.Info("Email sender started")
+
+	poll := time.NewTicker(outboxPollInterval)
+	defer poll.Stop()
+	digest := time.NewTicker(digestCheckInterval)
+	defer digest.Stop()
+
+	s.queueDigests(ctx)
+	s.sendDue(ctx)
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-digest.C:
+			s.queueDigests(ctx)
+		case <-poll.C:
+			s.sendDue(ctx)
+		}
+	}
+}
+
+// sendDue sends pending emails whose next attempt is due
+func (s *EmailSender) sendDue(ctx context.Context) {
+	emails, err := s.outbox.dbQueries.GetDueOutboxEmails(ctx, outboxBatchSize)
+	if err != nil {
+		s.log.Error("Error getting due emails", "err", err)
+		return
+	}
+
+	for _, email := range emails {
+		err := s.mailer.Send(ctx, mail.Message{
+			To:      email.ToAddress,
+			Subject: email.Subject,
+			Text:    email.TextBody,
+			HTML:    email.HtmlBody,
+		})
+		if err != nil {
+			s.log.Warn("Error sending email", "err", err, "emailID", email.ID, "attempts", email.Attempts+1)
+			err = s.outbox.dbQueries.MarkOutboxEmailFailed(ctx, db.MarkOutboxEmailFailedParams{
+				ID:                email.ID,
+				LastError:         nullString(err.Error()),
+				MaxAttempts:       maxEmailAttempts,
+				RetryAfterSeconds: int64(retryDelay(email.Attempts).Seconds()),
+			})
+		} else {
+			err = s.outbox.dbQueries.MarkOutboxEmailSent(ctx, email.ID)
+		}
+		if err != nil {
+			s.log.Error("Error updating email status", "err", err, "emailID", email.ID)
+		}
+	}
+}
+
+// queueDigests queues a digest for every user whose digest is due
+func (s *EmailSender) queueDigests(ctx context.Context) {
+	userIDs, err := s.outbox.dbQueries.GetDueDigestUsers(ctx, digestBatchSize)
+	if err != nil {
+		s.log.Error("Error getting due digests", "err", err)
+		return
+	}
+	if len(userIDs) == 0 {
+		return
+	}
+
+	users, err := s.outbox.lookupUsers(ctx, userIDs)
+	if err != nil {
+		return
+	}
+
+	for _, user := range users {
+		notifications, err := s.outbox.dbQueries.GetDigestNotifications(ctx, db.GetDigestNotificationsParams{
+			UserID:   user.Id,
+			MaxItems: maxDigestItems,
+		})
+		if err != nil {
+			s.log.Error("Error getting digest notifications", "err", err, "userID", user.Id)
+			continue
+		}
+		if len(notifications) == 0 {
+			continue
+		}
+
+		data := mail.Data{
+			Link:         s.outbox.appURL + "/",
+			SettingsLink: s.outbox.appURL + "/settings",
+		}
+		for _, notification := range notifications {
+			data.Items = append(data.Items, mail.DigestItem{
+				Message: notification.Message,
+				Link:    s.outbox.link(notification.VideoID.String, notification.ChannelID.String),
+			})
+		}
+		content, err := mail.Render(mail.KindDigest, data)
+		if err != nil {
+			s.log.Error("Error rendering digest", "err", err, "userID", user.Id)
+			continue
+		}
+
+		// Users without an address are marked too, so they aren't picked up on every check
+		s.outbox.enqueue(ctx, user, mail.KindDigest, content)
+		if err := s.outbox.dbQueries.MarkDigestSent(ctx, user.Id); err != nil {
+			s.log.Error("Error marking digest sent", "err", err, "userID", user.Id)
+		}
+	}
+}
+
+// retryDelay doubles the wait after every failed attempt, up to retryMaxDelay
+func retryDelay(attempts int64) time.Duration {
+	delay := retryBaseDelay
+	for i := int64(0); i < attempts && delay < retryMaxDelay; i++ {
+		delay *= 2
+	}
+	if delay > retryMaxDelay {
+		delay = retryMaxDelay
+	}
+	return delay
+}
+
+func emailKind(notificationType string) string {
+	switch notificationType {
+	case typeReply:
+		return mail.KindReply
+	case typeMention:
+		return mail.KindMention
+	case typeTenantInvitation:
+		return mail.KindTenantInvitation
+	default:
+		// Channel videos go out in the digest, membership changes are in-app only
+		return ""
+	}
+}
+
+func emailAllowed(preference db.NotificationserviceEmailPreference, kind string) bool {
+	switch kind {
+	case mail.KindReply:
+		return preference.EmailReplies
+	case mail.KindMention:
+		return preference.EmailMentions
+	case mail.KindTenantInvitation:
+		return preference.EmailInvitations
+	default:
+		return false
+	}
+}
+
+func nullString(s string) sql.NullString {
+	s = strings.TrimSpace(s)
+	return sql.NullString{String: s, Valid: s != ""}
+}
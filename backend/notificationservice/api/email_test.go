@@ -0,0 +1,303 @@
+package api
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"log/slog"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"sortedstartup.com/stream/notificationservice/db"
+	mockdb "sortedstartup.com/stream/notificationservice/db/mocks"
+	"sortedstartup.com/stream/notificationservice/mail"
+	"sortedstartup.com/stream/notificationservice/proto"
+	userProto "sortedstartup.com/stream/userservice/proto"
+)
+
+type fakeMailer struct {
+	sent []mail.Message
+	err  error
+}
+
+func (m *fakeMailer) Send(ctx context.Context, msg mail.Message) error {
+	if m.err != nil {
+		return m.err
+	}
+	m.sent = append(m.sent, msg)
+	return nil
+}
+
+func newTestOutbox(mockDB db.Querier, directory userProto.UserDirectoryServiceClient) *emailOutbox {
+	return &emailOutbox{
+		appURL:              "https://stream.example.com",
+		log:                 slog.Default(),
+		dbQueries:           mockDB,
+		userDirectoryClient: directory,
+	}
+}
+
+func TestPublish_QueuesEmailsRespectingPreferences(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockDB := mockdb.NewMockQuerier(ctrl)
+	mockDirectory := userProto.NewMockUserDirectoryServiceClient(ctrl)
+	publisherAPI := NewPublisherAPITest(mockDB, slog.Default())
+	publisherAPI.emailOutbox = newTestOutbox(mockDB, mockDirectory)
+
+	mockDB.EXPECT().CreateNotification(gomock.Any(), gomock.Any()).Return(nil).Times(2)
+
+	// member-2 turned off mention emails, member-1 has no preferences row and gets the defaults
+	mockDB.EXPECT().
+		GetEmailPreferencesByUserIDs(gomock.Any(), []string{"member-1", "member-2"}).
+		Return([]db.NotificationserviceEmailPreference{{UserID: "member-2", EmailReplies: true}}, nil).
+		Times(1)
+
+	mockDirectory.EXPECT().
+		GetUsersByIDs(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, req *userProto.GetUsersByIDsRequest, _ ...grpc.CallOption) (*userProto.GetUsersByIDsResponse, error) {
+			assert.Equal(t, []string{"member-1"}, req.UserIds)
+			return &userProto.GetUsersByIDsResponse{Users: []*userProto.User{{Id: "member-1", Email: "member-1@example.com"}}}, nil
+		}).
+		Times(1)
+
+	mockDB.EXPECT().
+		CreateOutboxEmail(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, params db.CreateOutboxEmailParams) error {
+			assert.Equal(t, "member-1@example.com", params.ToAddress)
+			assert.Equal(t, mail.KindMention, params.Kind)
+			assert.Equal(t, "alice mentioned you", params.Subject)
+			assert.Contains(t, params.TextBody, "https://stream.example.com/video/video-1")
+			return nil
+		}).
+		Times(1)
+
+	resp, err := publisherAPI.Publish(context.Background(), &proto.PublishRequest{
+		TenantId:     "tenant-1",
+		RecipientIds: []string{"member-1", "member-2"},
+		Type:         proto.NotificationType_NOTIFICATION_TYPE_MENTION,
+		ActorId:      "actor",
+		ActorName:    "alice",
+		Message:      "alice mentioned you in a comment",
+		VideoId:      "video-1",
+		CommentId:    "comment-1",
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, int32(2), resp.DeliveredCount)
+}
+
+func TestPublish_ChannelVideoHasNoImmediateEmail(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockDB := mockdb.NewMockQuerier(ctrl)
+	publisherAPI := NewPublisherAPITest(mockDB, slog.Default())
+	// No directory expectations, channel videos only go out in the digest
+	publisherAPI.emailOutbox = newTestOutbox(mockDB, userProto.NewMockUserDirectoryServiceClient(ctrl))
+
+	mockDB.EXPECT().CreateNotification(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+
+	_, err := publisherAPI.Publish(context.Background(), &proto.PublishRequest{
+		TenantId:     "tenant-1",
+		RecipientIds: []string{"member-1"},
+		Type:         proto.NotificationType_NOTIFICATION_TYPE_CHANNEL_VIDEO,
+		ActorId:      "actor",
+		Message:      "actor added \"Demo\" to Marketing",
+		VideoId:      "video-1",
+	})
+
+	assert.NoError(t, err)
+}
+
+func TestEmailSender_SendDue(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockDB := mockdb.NewMockQuerier(ctrl)
+	mailer := &fakeMailer{}
+	sender := &EmailSender{outbox: newTestOutbox(mockDB, nil), mailer: mailer, log: slog.Default()}
+
+	mockDB.EXPECT().
+		GetDueOutboxEmails(gomock.Any(), int64(outboxBatchSize)).
+		Return([]db.NotificationserviceEmailOutbox{
+			{ID: "e-1", ToAddress: "alice@example.com", Subject: "hi", TextBody: "text", HtmlBody: "<p>html</p>"},
+		}, nil).
+		Times(1)
+	mockDB.EXPECT().MarkOutboxEmailSent(gomock.Any(), "e-1").Return(nil).Times(1)
+
+	sender.sendDue(context.Background())
+
+	assert.Equal(t, []mail.Message{{To: "alice@example.com", Subject: "hi", Text: "text", HTML: "<p>html</p>"}}, mailer.sent)
+}
+
+func TestEmailSender_SendDueRetriesWithBackoff(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockDB := mockdb.NewMockQuerier(ctrl)
+	mailer := &fakeMailer{err: errors.New("421 try again later")}
+	sender := &EmailSender{outbox: newTestOutbox(mockDB, nil), mailer: mailer, log: slog.Default()}
+
+	mockDB.EXPECT().
+		GetDueOutboxEmails(gomock.Any(), gomock.Any()).
+		Return([]db.NotificationserviceEmailOutbox{{ID: "e-1", ToAddress: "alice@example.com", Attempts: 2}}, nil).
+		Times(1)
+	mockDB.EXPECT().
+		MarkOutboxEmailFailed(gomock.Any(), db.MarkOutboxEmailFailedParams{
+			ID:                "e-1",
+			LastError:         sql.NullString{String: "421 try again later", Valid: true},
+			MaxAttempts:       maxEmailAttempts,
+			RetryAfterSeconds: 4 * 60,
+		}).
+		Return(nil).
+		Times(1)
+
+	sender.sendDue(context.Background())
+}
+
+func TestRetryDelay(t *testing.T) {
+	assert.Equal(t, retryBaseDelay, retryDelay(0))
+	assert.Equal(t, 8*retryBaseDelay, retryDelay(3))
+	assert.Equal(t, retryMaxDelay, retryDelay(20))
+}
+
+func TestEmailSender_QueueDigests(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockDB := mockdb.NewMockQuerier(ctrl)
+	mockDirectory := userProto.NewMockUserDirectoryServiceClient(ctrl)
+	sender := &EmailSender{outbox: newTestOutbox(mockDB, mockDirectory), mailer: &fakeMailer{}, log: slog.Default()}
+
+	mockDB.EXPECT().GetDueDigestUsers(gomock.Any(), int64(digestBatchSize)).Return([]string{"user-1"}, nil).Times(1)
+	mockDirectory.EXPECT().
+		GetUsersByIDs(gomock.Any(), &userProto.GetUsersByIDsRequest{UserIds: []string{"user-1"}}).
+		Return(&userProto.GetUsersByIDsResponse{Users: []*userProto.User{{Id: "user-1", Email: "user-1@example.com"}}}, nil).
+		Times(1)
+	mockDB.EXPECT().
+		GetDigestNotifications(gomock.Any(), db.GetDigestNotificationsParams{UserID: "user-1", MaxItems: maxDigestItems}).
+		Return([]db.NotificationserviceNotification{
+			{ID: "n-1", Message: "alice added \"Demo\" to Marketing", VideoID: sql.NullString{String: "video-1", Valid: true}},
+		}, nil).
+		Times(1)
+
+	gomock.InOrder(
+		mockDB.EXPECT().
+			CreateOutboxEmail(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, params db.CreateOutboxEmailParams) error {
+				assert.Equal(t, mail.KindDigest, params.Kind)
+				assert.Equal(t, "1 new video in your channels", params.Subject)
+				assert.Contains(t, params.HtmlBody, "https://stream.example.com/video/video-1")
+				return nil
+			}),
+		mockDB.EXPECT().MarkDigestSent(gomock.Any(), "user-1").Return(nil),
+	)
+
+	sender.queueDigests(context.Background())
+}
+
+func TestGetEmailPreferences_Defaults(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockDB := mockdb.NewMockQuerier(ctrl)
+	notificationAPI := NewNotificationAPITest(mockDB, slog.Default())
+
+	mockDB.EXPECT().
+		GetEmailPreferences(gomock.Any(), "test-user-id").
+		Return(db.NotificationserviceEmailPreference{}, sql.ErrNoRows).
+		Times(1)
+
+	resp, err := notificationAPI.GetEmailPreferences(buildAuthContext(), &proto.GetEmailPreferencesRequest{})
+
+	assert.NoError(t, err)
+	assert.Equal(t, defaultEmailPreferences(), resp)
+}
+
+func TestUpdateEmailPreferences(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockDB := mockdb.NewMockQuerier(ctrl)
+	notificationAPI := NewNotificationAPITest(mockDB, slog.Default())
+
+	mockDB.EXPECT().
+		UpsertEmailPreferences(gomock.Any(), db.UpsertEmailPreferencesParams{
+			UserID:          "test-user-id",
+			EmailReplies:    true,
+			DigestFrequency: "daily",
+		}).
+		Return(db.NotificationserviceEmailPreference{UserID: "test-user-id", EmailReplies: true, DigestFrequency: "daily"}, nil).
+		Times(1)
+
+	resp, err := notificationAPI.UpdateEmailPreferences(buildAuthContext(), &proto.UpdateEmailPreferencesRequest{
+		Preferences: &proto.EmailPreferences{Replies: true, DigestFrequency: proto.DigestFrequency_DIGEST_FREQUENCY_DAILY},
+	})
+
+	assert.NoError(t, err)
+	assert.True(t, resp.Replies)
+	assert.False(t, resp.Mentions)
+	assert.Equal(t, proto.DigestFrequency_DIGEST_FREQUENCY_DAILY, resp.DigestFrequency)
+}
+
+func TestUpdateEmailPreferences_Missing(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	notificationAPI := NewNotificationAPITest(mockdb.NewMockQuerier(ctrl), slog.Default())
+
+	_, err := notificationAPI.UpdateEmailPreferences(buildAuthContext(), &proto.UpdateEmailPreferencesRequest{})
+
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestSendInvitation_QueuesEmailWithoutAccount(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockDB := mockdb.NewMockQuerier(ctrl)
+	publisherAPI := NewPublisherAPITest(mockDB, slog.Default())
+	// No directory expectations, the invitee may not have an account
+	publisherAPI.emailOutbox = newTestOutbox(mockDB, userProto.NewMockUserDirectoryServiceClient(ctrl))
+
+	mockDB.EXPECT().
+		CreateOutboxEmail(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, params db.CreateOutboxEmailParams) error {
+			assert.Equal(t, "bob@example.com", params.ToAddress)
+			assert.Equal(t, mail.KindInvitation, params.Kind)
+			assert.Equal(t, "Alice invited you to a workspace", params.Subject)
+			assert.Contains(t, params.TextBody, "Alice invited you to the Acme workspace")
+			assert.Contains(t, params.TextBody, "https://stream.example.com/invitations/accept?token=secret%2Btoken")
+			return nil
+		}).
+		Times(1)
+
+	resp, err := publisherAPI.SendInvitation(context.Background(), &proto.SendInvitationRequest{
+		TenantId:   "tenant-1",
+		Email:      "bob@example.com",
+		TenantName: "Acme",
+		ActorName:  "Alice",
+		Token:      "secret+token",
+	})
+
+	assert.NoError(t, err)
+	assert.True(t, resp.Queued)
+}
+
+func TestSendInvitation_EmailDisabled(t *testing.T) {
+	publisherAPI := NewPublisherAPITest(nil, slog.Default())
+
+	resp, err := publisherAPI.SendInvitation(context.Background(), &proto.SendInvitationRequest{TenantId: "tenant-1", Email: "bob@example.com", Token: "token"})
+	assert.NoError(t, err)
+	assert.False(t, resp.Queued)
+
+	_, err = publisherAPI.SendInvitation(context.Background(), &proto.SendInvitationRequest{TenantId: "tenant-1", Email: "bob@example.com"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
@@ -0,0 +1,164 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"sortedstartup.com/stream/notificationservice/db"
+	"sortedstartup.com/stream/notificationservice/proto"
+)
+
+// Event types as sent in the payload and stored in notificationservice_webhooks.event_types
+const (
+	eventVideoUploaded  = "video.uploaded"
+	eventVideoDeleted   = "video.deleted"
+	eventCommentCreated = "comment.created"
+	eventWebhookTest    = "webhook.test"
+
+	eventVideoStatusChanged       = "video.status_changed"
+	eventChannelMembershipChanged = "channel.membership_changed"
+)
+
+// eventPayload is the JSON body posted to webhooks
+type eventPayload struct {
+	ID        string            `json:"id"`
+	Type      string            `json:"type"`
+	TenantID  string            `json:"tenant_id"`
+	ActorID   string            `json:"actor_id,omitempty"`
+	CreatedAt time.Time         `json:"created_at"`
+	Data      map[string]string `json:"data"`
+}
+
+// PublishEvent queues a delivery for every active webhook of the tenant subscribed to the event
+// and pushes it to live subscribers. Webhooks receive every event in the tenant, including ones
+// about private videos, live subscribers only the ones they may see.
+func (s *PublisherAPI) PublishEvent(ctx context.Context, req *proto.PublishEventRequest) (*proto.PublishEventResponse, error) {
+	if req.TenantId == "" {
+		return nil, status.Error(codes.InvalidArgument, "tenant ID is required")
+	}
+	eventType := eventTypeToDB(req.Type)
+	if eventType == "" || eventType == eventWebhookTest {
+		return nil, status.Error(codes.InvalidArgument, "invalid event type")
+	}
+
+	// Generated up front so the live event and the webhook payloads share it
+	eventID := uuid.New().String()
+	if isLiveEvent(eventType) {
+		s.hub.broadcast(req.TenantId, &proto.LiveEvent{
+			Id:        eventID,
+			Type:      req.Type,
+			ActorId:   req.ActorId,
+			Data:      req.Data,
+			CreatedAt: timestamppb.Now(),
+		}, req.AudienceUserIds)
+	}
+
+	webhooks, err := s.dbQueries.GetActiveWebhooksByTenantID(ctx, req.TenantId)
+	if err != nil {
+		s.log.Error("Error getting webhooks", "err", err, "tenantID", req.TenantId)
+		return nil, status.Error(codes.Internal, "failed to publish event")
+	}
+
+	response := &proto.PublishEventResponse{}
+	var payload string
+	for _, webhook := range webhooks {
+		if !subscribedTo(webhook, eventType) {
+			continue
+		}
+		// Built once the first subscriber is found
+		if payload == "" {
+			payload, err = buildEventPayload(eventID, eventType, req.TenantId, req.ActorId, req.Data)
+			if err != nil {
+				s.log.Error("Error building event payload", "err", err, "eventType", eventType)
+				return nil, status.Error(codes.Internal, "failed to publish event")
+			}
+		}
+
+		err := s.dbQueries.CreateWebhookDelivery(ctx, db.CreateWebhookDeliveryParams{
+			ID:        uuid.New().String(),
+			WebhookID: webhook.ID,
+			TenantID:  req.TenantId,
+			EventID:   eventID,
+			EventType: eventType,
+			Payload:   payload,
+		})
+		if err != nil {
+			s.log.Error("Error queueing webhook delivery", "err", err, "webhookID", webhook.ID)
+			return nil, status.Error(codes.Internal, "failed to publish event")
+		}
+		response.QueuedDeliveries++
+	}
+
+	return response, nil
+}
+
+func buildEventPayload(eventID, eventType, tenantID, actorID string, data map[string]string) (string, error) {
+	if data == nil {
+		data = map[string]string{}
+	}
+	payload, err := json.Marshal(eventPayload{
+		ID:        eventID,
+		Type:      eventType,
+		TenantID:  tenantID,
+		ActorID:   actorID,
+		CreatedAt: time.Now().UTC(),
+		Data:      data,
+	})
+	if err != nil {
+		return "", err
+	}
+	return string(payload), nil
+}
+
+func subscribedTo(webhook db.NotificationserviceWebhook, eventType string) bool {
+	for _, subscribed := range strings.Split(webhook.EventTypes, ",") {
+		if subscribed == eventType {
+			return true
+		}
+	}
+	return false
+}
+
+func eventTypeToDB(eventType proto.EventType) string {
+	switch eventType {
+	case proto.EventType_EVENT_TYPE_VIDEO_UPLOADED:
+		return eventVideoUploaded
+	case proto.EventType_EVENT_TYPE_VIDEO_DELETED:
+		return eventVideoDeleted
+	case proto.EventType_EVENT_TYPE_COMMENT_CREATED:
+		return eventCommentCreated
+	case proto.EventType_EVENT_TYPE_WEBHOOK_TEST:
+		return eventWebhookTest
+	case proto.EventType_EVENT_TYPE_VIDEO_STATUS_CHANGED:
+		return eventVideoStatusChanged
+	case proto.EventType_EVENT_TYPE_CHANNEL_MEMBERSHIP_CHANGED:
+		return eventChannelMembershipChanged
+	default:
+		return ""
+	}
+}
+
+func eventTypeFromDB(eventType string) proto.EventType {
+	switch eventType {
+	case eventVideoUploaded:
+		return proto.EventType_EVENT_TYPE_VIDEO_UPLOADED
+	case eventVideoDeleted:
+		return proto.EventType_EVENT_TYPE_VIDEO_DELETED
+	case eventCommentCreated:
+		return proto.EventType_EVENT_TYPE_COMMENT_CREATED
+	case eventWebhookTest:
+		return proto.EventType_EVENT_TYPE_WEBHOOK_TEST
+	case eventVideoStatusChanged:
+		return proto.EventType_EVENT_TYPE_VIDEO_STATUS_CHANGED
+	case eventChannelMembershipChanged:
+		return proto.EventType_EVENT_TYPE_CHANNEL_MEMBERSHIP_CHANGED
+	default:
+		return proto.EventType_EVENT_TYPE_UNSPECIFIED
+	}
+}
@@ -0,0 +1,108 @@
+package api
+
+import (
+	"log/slog"
+	"sync"
+
+	"sortedstartup.com/stream/notificationservice/proto"
+)
+
+// subscriberBufferSize events are queued per subscriber, a client that falls
+// further behind misses events and is expected to refetch
+const subscriberBufferSize = 64
+
+// subscriber is one open Subscribe stream or SSE connection
+type subscriber struct {
+	userID   string
+	videoIDs map[string]bool
+	events   chan *proto.LiveEvent
+}
+
+// eventHub fans live events out to the subscribers of a tenant.
+// Subscribers are kept in memory, a client only gets the events published by the instance it is connected to.
+type eventHub struct {
+	log *slog.Logger
+
+	mu          sync.RWMutex
+	subscribers map[string]map[*subscriber]struct{} // By tenant ID
+}
+
+func newEventHub(log *slog.Logger) *eventHub {
+	return &eventHub{
+		log:         log,
+		subscribers: map[string]map[*subscriber]struct{}{},
+	}
+}
+
+func (h *eventHub) subscribe(tenantID, userID string, videoIDs []string) *subscriber {
+	sub := &subscriber{
+		userID:   userID,
+		videoIDs: make(map[string]bool, len(videoIDs)),
+		events:   make(chan *proto.LiveEvent, subscriberBufferSize),
+	}
+	for _, videoID := range videoIDs {
+		sub.videoIDs[videoID] = true
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if h.subscribers[tenantID] == nil {
+		h.subscribers[tenantID] = map[*subscriber]struct{}{}
+	}
+	h.subscribers[tenantID][sub] = struct{}{}
+	return sub
+}
+
+func (h *eventHub) unsubscribe(tenantID string, sub *subscriber) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	delete(h.subscribers[tenantID], sub)
+	if len(h.subscribers[tenantID]) == 0 {
+		delete(h.subscribers, tenantID)
+	}
+}
+
+// broadcast queues the event for the tenant's subscribers it is meant for.
+// It never blocks the publisher: a subscriber with a full queue misses the event.
+// The checks here are the cheap ones, access to the video is checked by the subscriber itself.
+func (h *eventHub) broadcast(tenantID string, event *proto.LiveEvent, audience []string) {
+	// Membership events are only meant for the users the producer named
+	if event.Type == proto.EventType_EVENT_TYPE_CHANNEL_MEMBERSHIP_CHANGED && len(audience) == 0 {
+		return
+	}
+	var audienceSet map[string]bool
+	if len(audience) > 0 {
+		audienceSet = make(map[string]bool, len(audience))
+		for _, userID := range audience {
+			audienceSet[userID] = true
+		}
+	}
+
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	for sub := range h.subscribers[tenantID] {
+		if audienceSet != nil && !audienceSet[sub.userID] {
+			continue
+		}
+		// Comments are only pushed for the videos the client has open
+		if event.Type == proto.EventType_EVENT_TYPE_COMMENT_CREATED && !sub.videoIDs[event.Data["video_id"]] {
+			continue
+		}
+
+		select {
+		case sub.events <- event:
+		default:
+			h.log.Warn("Live event dropped, subscriber is too slow", "userID", sub.userID, "eventID", event.Id)
+		}
+	}
+}
+
+// isLiveEvent reports whether the event type is pushed to live subscribers
+func isLiveEvent(eventType string) bool {
+	switch eventType {
+	case eventCommentCreated, eventVideoStatusChanged, eventChannelMembershipChanged:
+		return true
+	default:
+		return false
+	}
+}
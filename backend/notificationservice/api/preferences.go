@@ -0,0 +1,112 @@
+package api
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"sortedstartup.com/stream/common/interceptors"
+	"sortedstartup.com/stream/notificationservice/db"
+	"sortedstartup.com/stream/notificationservice/proto"
+)
+
+// Digest frequencies as stored in notificationservice_email_preferences.digest_frequency
+const (
+	digestWeekly = "weekly"
+	digestDaily  = "daily"
+	digestOff    = "off"
+)
+
+// GetEmailPreferences returns the caller's email preferences, or the defaults if they never changed them
+func (s *NotificationAPI) GetEmailPreferences(ctx context.Context, req *proto.GetEmailPreferencesRequest) (*proto.EmailPreferences, error) {
+	authContext, err := interceptors.AuthFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "unauthenticated")
+	}
+
+	preferences, err := s.dbQueries.GetEmailPreferences(ctx, authContext.User.ID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return defaultEmailPreferences(), nil
+	}
+	if err != nil {
+		s.log.Error("Error getting email preferences", "err", err, "userID", authContext.User.ID)
+		return nil, status.Error(codes.Internal, "failed to get email preferences")
+	}
+
+	return emailPreferencesToProto(preferences), nil
+}
+
+// UpdateEmailPreferences replaces the caller's email preferences
+func (s *NotificationAPI) UpdateEmailPreferences(ctx context.Context, req *proto.UpdateEmailPreferencesRequest) (*proto.EmailPreferences, error) {
+	authContext, err := interceptors.AuthFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "unauthenticated")
+	}
+
+	if req.Preferences == nil {
+		return nil, status.Error(codes.InvalidArgument, "preferences are required")
+	}
+	digestFrequency := digestFrequencyToDB(req.Preferences.DigestFrequency)
+	if digestFrequency == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid digest frequency")
+	}
+
+	preferences, err := s.dbQueries.UpsertEmailPreferences(ctx, db.UpsertEmailPreferencesParams{
+		UserID:           authContext.User.ID,
+		EmailReplies:     req.Preferences.Replies,
+		EmailMentions:    req.Preferences.Mentions,
+		EmailInvitations: req.Preferences.Invitations,
+		DigestFrequency:  digestFrequency,
+	})
+	if err != nil {
+		s.log.Error("Error updating email preferences", "err", err, "userID", authContext.User.ID)
+		return nil, status.Error(codes.Internal, "failed to update email preferences")
+	}
+
+	return emailPreferencesToProto(preferences), nil
+}
+
+// defaultEmailPreferences matches the column defaults of notificationservice_email_preferences
+func defaultEmailPreferences() *proto.EmailPreferences {
+	return &proto.EmailPreferences{
+		Replies:         true,
+		Mentions:        true,
+		Invitations:     true,
+		DigestFrequency: proto.DigestFrequency_DIGEST_FREQUENCY_WEEKLY,
+	}
+}
+
+func emailPreferencesToProto(preferences db.NotificationserviceEmailPreference) *proto.EmailPreferences {
+	return &proto.EmailPreferences{
+		Replies:         preferences.EmailReplies,
+		Mentions:        preferences.EmailMentions,
+		Invitations:     preferences.EmailInvitations,
+		DigestFrequency: digestFrequencyFromDB(preferences.DigestFrequency),
+	}
+}
+
+func digestFrequencyToDB(frequency proto.DigestFrequency) string {
+	switch frequency {
+	case proto.DigestFrequency_DIGEST_FREQUENCY_WEEKLY:
+		return digestWeekly
+	case proto.DigestFrequency_DIGEST_FREQUENCY_DAILY:
+		return digestDaily
+	case proto.DigestFrequency_DIGEST_FREQUENCY_OFF:
+		return digestOff
+	default:
+		return ""
+	}
+}
+
+func digestFrequencyFromDB(frequency string) proto.DigestFrequency {
+	switch frequency {
+	case digestDaily:
+		return proto.DigestFrequency_DIGEST_FREQUENCY_DAILY
+	case digestOff:
+		return proto.DigestFrequency_DIGEST_FREQUENCY_OFF
+	default:
+		return proto.DigestFrequency_DIGEST_FREQUENCY_WEEKLY
+	}
+}
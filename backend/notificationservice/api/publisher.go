@@ -0,0 +1,123 @@
+package api
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"sortedstartup.com/stream/notificationservice/db"
+	"sortedstartup.com/stream/notificationservice/proto"
+)
+
+// Notification types as stored in notificationservice_notifications.type
+const (
+	typeReply             = "reply"
+	typeMention           = "mention"
+	typeChannelVideo      = "channel_video"
+	typeChannelMembership = "channel_membership"
+	typeTenantInvitation  = "tenant_invitation"
+)
+
+// Publish stores one notification per recipient.
+// The producing service is responsible for only passing recipients allowed to see the subject.
+func (s *PublisherAPI) Publish(ctx context.Context, req *proto.PublishRequest) (*proto.PublishResponse, error) {
+	if req.TenantId == "" {
+		return nil, status.Error(codes.InvalidArgument, "tenant ID is required")
+	}
+	notificationType := notificationTypeToDB(req.Type)
+	if notificationType == "" {
+		return nil, status.Error(codes.InvalidArgument, "notification type is required")
+	}
+	if strings.TrimSpace(req.Message) == "" {
+		return nil, status.Error(codes.InvalidArgument, "message is required")
+	}
+
+	response := &proto.PublishResponse{}
+	var delivered []string
+	seen := make(map[string]bool, len(req.RecipientIds))
+	for _, recipientID := range req.RecipientIds {
+		// Nobody is notified about their own actions
+		if recipientID == "" || recipientID == req.ActorId || seen[recipientID] {
+			continue
+		}
+		seen[recipientID] = true
+
+		err := s.dbQueries.CreateNotification(ctx, db.CreateNotificationParams{
+			ID:        uuid.New().String(),
+			TenantID:  req.TenantId,
+			UserID:    recipientID,
+			Type:      notificationType,
+			ActorID:   req.ActorId,
+			ActorName: req.ActorName,
+			Message:   req.Message,
+			VideoID:   sql.NullString{String: req.VideoId, Valid: req.VideoId != ""},
+			ChannelID: sql.NullString{String: req.ChannelId, Valid: req.ChannelId != ""},
+			CommentID: sql.NullString{String: req.CommentId, Valid: req.CommentId != ""},
+		})
+		if err != nil {
+			s.log.Error("Error creating notification", "err", err, "tenantID", req.TenantId, "userID", recipientID)
+			return nil, status.Error(codes.Internal, "failed to publish notification")
+		}
+		response.DeliveredCount++
+		delivered = append(delivered, recipientID)
+	}
+
+	// Email is disabled when no SMTP server is configured
+	if s.emailOutbox != nil {
+		s.emailOutbox.enqueueNotification(ctx, req, notificationType, delivered)
+	}
+
+	return response, nil
+}
+
+// SendInvitation emails an invitation to join a workspace.
+// The caller is responsible for checking the inviter may invite to the tenant.
+func (s *PublisherAPI) SendInvitation(ctx context.Context, req *proto.SendInvitationRequest) (*proto.SendInvitationResponse, error) {
+	if req.TenantId == "" || req.Email == "" || req.Token == "" {
+		return nil, status.Error(codes.InvalidArgument, "tenant ID, email and token are required")
+	}
+
+	// Email is disabled when no SMTP server is configured
+	if s.emailOutbox == nil {
+		return &proto.SendInvitationResponse{Queued: false}, nil
+	}
+
+	return &proto.SendInvitationResponse{Queued: s.emailOutbox.enqueueInvitation(ctx, req)}, nil
+}
+
+func notificationTypeToDB(notificationType proto.NotificationType) string {
+	switch notificationType {
+	case proto.NotificationType_NOTIFICATION_TYPE_REPLY:
+		return typeReply
+	case proto.NotificationType_NOTIFICATION_TYPE_MENTION:
+		return typeMention
+	case proto.NotificationType_NOTIFICATION_TYPE_CHANNEL_VIDEO:
+		return typeChannelVideo
+	case proto.NotificationType_NOTIFICATION_TYPE_CHANNEL_MEMBERSHIP:
+		return typeChannelMembership
+	case proto.NotificationType_NOTIFICATION_TYPE_TENANT_INVITATION:
+		return typeTenantInvitation
+	default:
+		return ""
+	}
+}
+
+func notificationTypeFromDB(notificationType string) proto.NotificationType {
+	switch notificationType {
+	case typeReply:
+		return proto.NotificationType_NOTIFICATION_TYPE_REPLY
+	case typeMention:
+		return proto.NotificationType_NOTIFICATION_TYPE_MENTION
+	case typeChannelVideo:
+		return proto.NotificationType_NOTIFICATION_TYPE_CHANNEL_VIDEO
+	case typeChannelMembership:
+		return proto.NotificationType_NOTIFICATION_TYPE_CHANNEL_MEMBERSHIP
+	case typeTenantInvitation:
+		return proto.NotificationType_NOTIFICATION_TYPE_TENANT_INVITATION
+	default:
+		return proto.NotificationType_NOTIFICATION_TYPE_UNSPECIFIED
+	}
+}
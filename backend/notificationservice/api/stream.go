@@ -0,0 +1,231 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"sortedstartup.com/stream/common/interceptors"
+	"sortedstartup.com/stream/notificationservice/proto"
+	userProto "sortedstartup.com/stream/userservice/proto"
+	videoProto "sortedstartup.com/stream/videoservice/proto"
+)
+
+const (
+	// maxSubscribedVideos bounds the videos a single stream can watch for comments
+	maxSubscribedVideos = 50
+
+	// sseKeepaliveInterval keeps proxies from closing idle SSE connections
+	sseKeepaliveInterval = 25 * time.Second
+
+	// videoAccessTTL is how long a stream reuses its access check of a video, a lost access is noticed after at most this long
+	videoAccessTTL = time.Minute
+	// maxCachedVideoAccess bounds the access checks a single stream remembers
+	maxCachedVideoAccess = 1000
+)
+
+// videoAccess remembers the subscriber's access checks for the life of their stream, so a busy video
+// costs one GetVideo call per subscriber and videoAccessTTL instead of one per event.
+// It is only used by the stream's pump goroutine.
+type videoAccess struct {
+	checks map[string]videoAccessCheck // By video ID
+}
+
+type videoAccessCheck struct {
+	allowed   bool
+	checkedAt time.Time
+}
+
+// Subscribe streams the caller's live events until the client goes away
+func (s *NotificationAPI) Subscribe(req *proto.SubscribeRequest, stream proto.NotificationService_SubscribeServer) error {
+	ctx := stream.Context()
+	tenantID, sub, err := s.openStream(ctx, req.VideoIds)
+	if err != nil {
+		return err
+	}
+	defer s.hub.unsubscribe(tenantID, sub)
+
+	return s.pump(ctx, sub, stream.Send, nil)
+}
+
+// eventsHandler is the SSE fallback of Subscribe for clients that can't stream over grpc-web.
+// EventSource can't set headers, so the tenant comes from the tenant_id query parameter
+// and the watched videos from repeated video_id parameters.
+func (s *NotificationAPI) eventsHandler(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		http.Error(w, "Streaming unsupported", http.StatusInternalServerError)
+		return
+	}
+
+	query := r.URL.Query()
+	ctx := r.Context()
+	if tenantID := query.Get("tenant_id"); tenantID != "" {
+		ctx = interceptors.ContextWithTenantID(ctx, tenantID)
+	}
+
+	tenantID, sub, err := s.openStream(ctx, query["video_id"])
+	if err != nil {
+		http.Error(w, status.Convert(err).Message(), httpStatusFromCode(status.Code(err)))
+		return
+	}
+	defer s.hub.unsubscribe(tenantID, sub)
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("X-Accel-Buffering", "no") // Disable proxy buffering in nginx
+	w.WriteHeader(http.StatusOK)
+	flusher.Flush()
+
+	send := func(event *proto.LiveEvent) error {
+		data, err := json.Marshal(eventPayload{
+			ID:        event.Id,
+			Type:      eventTypeToDB(event.Type),
+			TenantID:  tenantID,
+			ActorID:   event.ActorId,
+			CreatedAt: event.CreatedAt.AsTime(),
+			Data:      event.Data,
+		})
+		if err != nil {
+			return err
+		}
+		_, err = fmt.Fprintf(w, "id: %s\nevent: %s\ndata: %s\n\n", event.Id, eventTypeToDB(event.Type), data)
+		if err != nil {
+			return err
+		}
+		flusher.Flush()
+		return nil
+	}
+	keepalive := func() error {
+		_, err := fmt.Fprint(w, ": keepalive\n\n")
+		if err != nil {
+			return err
+		}
+		flusher.Flush()
+		return nil
+	}
+
+	err = s.pump(ctx, sub, send, keepalive)
+	if err != nil {
+		s.log.Debug("SSE stream closed", "err", err, "userID", sub.userID)
+	}
+}
+
+// openStream checks the caller belongs to the tenant and registers them with the hub.
+// The caller must unsubscribe once the stream ends.
+func (s *NotificationAPI) openStream(ctx context.Context, videoIDs []string) (string, *subscriber, error) {
+	authContext, tenantID, err := s.inboxOwner(ctx)
+	if err != nil {
+		return "", nil, err
+	}
+
+	if len(videoIDs) > maxSubscribedVideos {
+		return "", nil, status.Errorf(codes.InvalidArgument, "at most %d videos can be watched at once", maxSubscribedVideos)
+	}
+
+	resp, err := s.userServiceClient.GetTenants(ctx, &userProto.GetTenantsRequest{})
+	if err != nil {
+		s.log.Error("Failed to get user tenants from userservice", "err", err, "userID", authContext.User.ID)
+		return "", nil, status.Error(codes.Internal, "failed to check tenant access")
+	}
+	member := false
+	for _, tenantUser := range resp.TenantUsers {
+		if tenantUser.Tenant.Id == tenantID {
+			member = true
+			break
+		}
+	}
+	if !member {
+		return "", nil, status.Error(codes.PermissionDenied, "access denied: you are not a member of this tenant")
+	}
+
+	return tenantID, s.hub.subscribe(tenantID, authContext.User.ID, videoIDs), nil
+}
+
+// pump sends the subscriber's events until the context is done or a send fails.
+// keepalive is optional and called every sseKeepaliveInterval.
+func (s *NotificationAPI) pump(ctx context.Context, sub *subscriber, send func(*proto.LiveEvent) error, keepalive func() error) error {
+	var keepaliveC <-chan time.Time
+	if keepalive != nil {
+		ticker := time.NewTicker(sseKeepaliveInterval)
+		defer ticker.Stop()
+		keepaliveC = ticker.C
+	}
+
+	access := &videoAccess{checks: map[string]videoAccessCheck{}}
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-keepaliveC:
+			err := keepalive()
+			if err != nil {
+				return err
+			}
+		case event := <-sub.events:
+			if !s.canSee(ctx, event, access) {
+				continue
+			}
+			err := send(event)
+			if err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// canSee checks the subscriber may see the event, with their own credentials from the stream context.
+// Membership events were already narrowed down to their audience by the hub.
+func (s *NotificationAPI) canSee(ctx context.Context, event *proto.LiveEvent, access *videoAccess) bool {
+	switch event.Type {
+	case proto.EventType_EVENT_TYPE_COMMENT_CREATED, proto.EventType_EVENT_TYPE_VIDEO_STATUS_CHANGED:
+		return s.canViewVideo(ctx, event.Data["video_id"], access)
+	default:
+		return true
+	}
+}
+
+// canViewVideo asks videoservice unless the stream checked the video recently.
+// Only the answers are remembered, a failed check hides the event and is retried with the next one.
+func (s *NotificationAPI) canViewVideo(ctx context.Context, videoID string, access *videoAccess) bool {
+	now := time.Now()
+	check, ok := access.checks[videoID]
+	if ok && now.Sub(check.checkedAt) < videoAccessTTL {
+		return check.allowed
+	}
+
+	_, err := s.videoServiceClient.GetVideo(ctx, &videoProto.GetVideoRequest{VideoId: videoID})
+	code := status.Code(err)
+	if err != nil && code != codes.PermissionDenied && code != codes.NotFound {
+		s.log.Warn("Failed to check video access for a live event", "err", err, "videoID", videoID)
+		return false
+	}
+
+	if len(access.checks) >= maxCachedVideoAccess {
+		clear(access.checks)
+	}
+	access.checks[videoID] = videoAccessCheck{allowed: err == nil, checkedAt: now}
+	return err == nil
+}
+
+func httpStatusFromCode(code codes.Code) int {
+	switch code {
+	case codes.Unauthenticated:
+		return http.StatusUnauthorized
+	case codes.PermissionDenied:
+		return http.StatusForbidden
+	case codes.InvalidArgument:
+		return http.StatusBadRequest
+	default:
+		return http.StatusInternalServerError
+	}
+}
@@ -0,0 +1,215 @@
+package api
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"sortedstartup.com/stream/common/auth"
+	mockdb "sortedstartup.com/stream/notificationservice/db/mocks"
+	"sortedstartup.com/stream/notificationservice/proto"
+	videoProto "sortedstartup.com/stream/videoservice/proto"
+)
+
+func TestEventHub_Broadcast(t *testing.T) {
+	hub := newEventHub(slog.Default())
+	watcher := hub.subscribe("tenant-1", "user-1", []string{"video-1"})
+	other := hub.subscribe("tenant-1", "user-2", nil)
+	otherTenant := hub.subscribe("tenant-2", "user-1", []string{"video-1"})
+
+	hub.broadcast("tenant-1", &proto.LiveEvent{
+		Id:   "comment",
+		Type: proto.EventType_EVENT_TYPE_COMMENT_CREATED,
+		Data: map[string]string{"video_id": "video-1"},
+	}, nil)
+	hub.broadcast("tenant-1", &proto.LiveEvent{
+		Id:   "membership",
+		Type: proto.EventType_EVENT_TYPE_CHANNEL_MEMBERSHIP_CHANGED,
+	}, []string{"user-2"})
+	// Without an audience a membership event has nobody to go to
+	hub.broadcast("tenant-1", &proto.LiveEvent{
+		Id:   "no-audience",
+		Type: proto.EventType_EVENT_TYPE_CHANNEL_MEMBERSHIP_CHANGED,
+	}, nil)
+
+	assert.Equal(t, []string{"comment"}, drain(watcher))
+	assert.Equal(t, []string{"membership"}, drain(other))
+	assert.Empty(t, drain(otherTenant))
+}
+
+func TestEventHub_SlowSubscriberDoesNotBlock(t *testing.T) {
+	hub := newEventHub(slog.Default())
+	sub := hub.subscribe("tenant-1", "user-1", nil)
+
+	for i := 0; i < subscriberBufferSize+10; i++ {
+		hub.broadcast("tenant-1", &proto.LiveEvent{Type: proto.EventType_EVENT_TYPE_VIDEO_STATUS_CHANGED}, nil)
+	}
+
+	assert.Len(t, drain(sub), subscriberBufferSize)
+
+	hub.unsubscribe("tenant-1", sub)
+	assert.Empty(t, hub.subscribers)
+}
+
+func TestEventsHandler_StreamsVisibleEvents(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockDB := mockdb.NewMockQuerier(ctrl)
+	notificationAPI := NewNotificationAPITest(mockDB, slog.Default())
+	notificationAPI.userServiceClient = mockTenantRole(ctrl, "member")
+	publisherAPI := NewPublisherAPITest(mockDB, slog.Default())
+	publisherAPI.hub = notificationAPI.hub
+
+	// The subscriber can view video-1 but not video-2
+	mockVideos := videoProto.NewMockVideoServiceClient(ctrl)
+	mockVideos.EXPECT().
+		GetVideo(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, req *videoProto.GetVideoRequest, _ ...grpc.CallOption) (*videoProto.Video, error) {
+			authContext := ctx.Value(auth.AUTH_CONTEXT_KEY).(*auth.AuthContext)
+			assert.Equal(t, "test-user-id", authContext.User.ID)
+			if req.VideoId != "video-1" {
+				return nil, status.Error(codes.PermissionDenied, "access denied")
+			}
+			return &videoProto.Video{Id: req.VideoId}, nil
+		}).
+		AnyTimes()
+	notificationAPI.videoServiceClient = mockVideos
+
+	mockDB.EXPECT().
+		GetActiveWebhooksByTenantID(gomock.Any(), "test-tenant").
+		Return(nil, nil).
+		AnyTimes()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		user := &auth.AuthContext{User: &auth.User{ID: "test-user-id"}}
+		notificationAPI.eventsHandler(w, r.WithContext(context.WithValue(r.Context(), auth.AUTH_CONTEXT_KEY, user)))
+	}))
+	defer server.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	req, _ := http.NewRequestWithContext(ctx, http.MethodGet, server.URL+"/events?tenant_id=test-tenant&video_id=video-1", nil)
+	resp, err := http.DefaultClient.Do(req)
+	assert.NoError(t, err)
+	defer resp.Body.Close()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, "text/event-stream", resp.Header.Get("Content-Type"))
+
+	publish := func(eventType proto.EventType, videoID string) {
+		_, err := publisherAPI.PublishEvent(context.Background(), &proto.PublishEventRequest{
+			TenantId: "test-tenant",
+			Type:     eventType,
+			ActorId:  "actor",
+			Data:     map[string]string{"video_id": videoID},
+		})
+		assert.NoError(t, err)
+	}
+	publish(proto.EventType_EVENT_TYPE_COMMENT_CREATED, "video-2")      // Not watched
+	publish(proto.EventType_EVENT_TYPE_VIDEO_STATUS_CHANGED, "video-2") // Can't view
+	publish(proto.EventType_EVENT_TYPE_VIDEO_UPLOADED, "video-1")       // Webhooks only
+	publish(proto.EventType_EVENT_TYPE_COMMENT_CREATED, "video-1")      // Delivered
+	publish(proto.EventType_EVENT_TYPE_VIDEO_STATUS_CHANGED, "video-1") // Delivered
+
+	reader := bufio.NewReader(resp.Body)
+	var received []eventPayload
+	for len(received) < 2 {
+		line, err := reader.ReadString('\n')
+		if !assert.NoError(t, err) {
+			return
+		}
+		if data, ok := strings.CutPrefix(strings.TrimSpace(line), "data: "); ok {
+			var payload eventPayload
+			assert.NoError(t, json.Unmarshal([]byte(data), &payload))
+			received = append(received, payload)
+		}
+	}
+
+	assert.Equal(t, eventCommentCreated, received[0].Type)
+	assert.Equal(t, eventVideoStatusChanged, received[1].Type)
+	assert.Equal(t, "test-tenant", received[1].TenantID)
+	assert.Equal(t, "video-1", received[1].Data["video_id"])
+}
+
+func TestCanSee_CachesVideoAccess(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	notificationAPI := NewNotificationAPITest(mockdb.NewMockQuerier(ctrl), slog.Default())
+	mockVideos := videoProto.NewMockVideoServiceClient(ctrl)
+	notificationAPI.videoServiceClient = mockVideos
+
+	// One check per video however many events it has
+	mockVideos.EXPECT().
+		GetVideo(gomock.Any(), &videoProto.GetVideoRequest{VideoId: "video-1"}).
+		Return(&videoProto.Video{Id: "video-1"}, nil)
+	mockVideos.EXPECT().
+		GetVideo(gomock.Any(), &videoProto.GetVideoRequest{VideoId: "video-2"}).
+		Return(nil, status.Error(codes.PermissionDenied, "access denied"))
+	// Failed checks aren't remembered
+	mockVideos.EXPECT().
+		GetVideo(gomock.Any(), &videoProto.GetVideoRequest{VideoId: "video-3"}).
+		Return(nil, status.Error(codes.Unavailable, "unavailable")).
+		Times(2)
+
+	access := &videoAccess{checks: map[string]videoAccessCheck{}}
+	event := func(videoID string) *proto.LiveEvent {
+		return &proto.LiveEvent{Type: proto.EventType_EVENT_TYPE_COMMENT_CREATED, Data: map[string]string{"video_id": videoID}}
+	}
+	for i := 0; i < 3; i++ {
+		assert.True(t, notificationAPI.canSee(context.Background(), event("video-1"), access))
+		assert.False(t, notificationAPI.canSee(context.Background(), event("video-2"), access))
+	}
+	assert.False(t, notificationAPI.canSee(context.Background(), event("video-3"), access))
+	assert.False(t, notificationAPI.canSee(context.Background(), event("video-3"), access))
+
+	// Expired checks are asked again
+	access.checks["video-1"] = videoAccessCheck{allowed: true, checkedAt: time.Now().Add(-videoAccessTTL)}
+	mockVideos.EXPECT().
+		GetVideo(gomock.Any(), &videoProto.GetVideoRequest{VideoId: "video-1"}).
+		Return(nil, status.Error(codes.PermissionDenied, "access denied"))
+	assert.False(t, notificationAPI.canSee(context.Background(), event("video-1"), access))
+}
+
+func TestEventsHandler_RejectsNonMembers(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	notificationAPI := NewNotificationAPITest(mockdb.NewMockQuerier(ctrl), slog.Default())
+	notificationAPI.userServiceClient = mockTenantRole(ctrl, "member")
+
+	user := &auth.AuthContext{User: &auth.User{ID: "test-user-id"}}
+	req := httptest.NewRequest(http.MethodGet, "/events?tenant_id=other-tenant", nil)
+	req = req.WithContext(context.WithValue(req.Context(), auth.AUTH_CONTEXT_KEY, user))
+	rec := httptest.NewRecorder()
+
+	notificationAPI.eventsHandler(rec, req)
+
+	assert.Equal(t, http.StatusForbidden, rec.Code)
+	assert.Empty(t, notificationAPI.hub.subscribers)
+}
+
+// drain returns the IDs of the events queued for the subscriber
+func drain(sub *subscriber) []string {
+	var ids []string
+	for {
+		select {
+		case event := <-sub.events:
+			ids = append(ids, event.Id)
+		case <-time.After(10 * time.Millisecond):
+			return ids
+		}
+	}
+}
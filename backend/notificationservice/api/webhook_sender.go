@@ -0,0 +1,170 @@
+package api
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"fmt"
+	"log/slog"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+
+	"sortedstartup.com/stream/notificationservice/db"
+)
+
+const (
+	webhookPollInterval = 5 * time.Second
+	webhookTimeout      = 10 * time.Second
+
+	// webhookBatchSize bounds the deliveries sent per poll
+	webhookBatchSize = 20
+	// maxWebhookAttempts uses the same backoff as emails, roughly a day of retries
+	maxWebhookAttempts = 8
+)
+
+// Headers sent with every delivery
+const (
+	webhookEventHeader     = "X-Stream-Event"
+	webhookDeliveryHeader  = "X-Stream-Delivery"
+	webhookSignatureHeader = "X-Stream-Signature"
+)
+
+// WebhookSender posts queued deliveries to their webhooks.
+// There should be one per database, two senders could post an event twice.
+type WebhookSender struct {
+	log        *slog.Logger
+	dbQueries  db.Querier
+	httpClient *http.Client
+}
+
+func newWebhookSender(log *slog.Logger, dbQueries db.Querier) *WebhookSender {
+	// The address is checked after DNS resolution, a host that passed validation
+	// can't be pointed at an internal address later. Proxies would hide the address.
+	dialer := &net.Dialer{Timeout: webhookTimeout, Control: refuseInternalAddress}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = nil
+	transport.DialContext = dialer.DialContext
+
+	return &WebhookSender{
+		log:       log,
+		dbQueries: dbQueries,
+		httpClient: &http.Client{
+			Transport: transport,
+			Timeout:   webhookTimeout,
+			// A redirect counts as a failed delivery, the receiver should give the final URL
+			CheckRedirect: func(req *http.Request, via []*http.Request) error {
+				return http.ErrUseLastResponse
+			},
+		},
+	}
+}
+
+// Run sends deliveries until ctx is cancelled. Deliveries left over from before
+// a restart are picked up on the first poll.
+func (s *WebhookSender) Run(ctx context.Context) {
+	s.log.Info("Webhook sender started")
+
+	poll := time.NewTicker(webhookPollInterval)
+	defer poll.Stop()
+
+	s.sendDue(ctx)
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-poll.C:
+			s.sendDue(ctx)
+		}
+	}
+}
+
+func (s *WebhookSender) sendDue(ctx context.Context) {
+	deliveries, err := s.dbQueries.GetDueWebhookDeliveries(ctx, webhookBatchSize)
+	if err != nil {
+		s.log.Error("Error getting due webhook deliveries", "err", err)
+		return
+	}
+
+	for _, delivery := range deliveries {
+		s.attempt(ctx, delivery, maxWebhookAttempts)
+	}
+}
+
+// attempt posts a delivery once and records the outcome
+func (s *WebhookSender) attempt(ctx context.Context, delivery db.GetDueWebhookDeliveriesRow, maxAttempts int64) {
+	responseStatus, err := s.post(ctx, delivery)
+	if err != nil {
+		s.log.Warn("Webhook delivery failed", "err", err, "deliveryID", delivery.ID, "webhookID", delivery.WebhookID, "attempts", delivery.Attempts+1)
+		err = s.dbQueries.MarkWebhookDeliveryFailed(ctx, db.MarkWebhookDeliveryFailedParams{
+			ID:                delivery.ID,
+			ResponseStatus:    nullInt64(responseStatus),
+			LastError:         nullString(err.Error()),
+			MaxAttempts:       maxAttempts,
+			RetryAfterSeconds: int64(retryDelay(delivery.Attempts).Seconds()),
+		})
+	} else {
+		err = s.dbQueries.MarkWebhookDeliverySucceeded(ctx, db.MarkWebhookDeliverySucceededParams{
+			ID:             delivery.ID,
+			ResponseStatus: nullInt64(responseStatus),
+		})
+	}
+	if err != nil {
+		s.log.Error("Error updating webhook delivery", "err", err, "deliveryID", delivery.ID)
+	}
+}
+
+// post sends the payload and returns the response status, any 2xx response is a success
+func (s *WebhookSender) post(ctx context.Context, delivery db.GetDueWebhookDeliveriesRow) (int, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, delivery.Url, strings.NewReader(delivery.Payload))
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "Stream-Webhooks")
+	req.Header.Set(webhookEventHeader, delivery.EventType)
+	req.Header.Set(webhookDeliveryHeader, delivery.ID)
+	req.Header.Set(webhookSignatureHeader, signatureHeader(delivery.Secret, time.Now().Unix(), delivery.Payload))
+
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		// The body isn't kept, it would let admins read internal responses
+		return resp.StatusCode, fmt.Errorf("unexpected status %d", resp.StatusCode)
+	}
+	return resp.StatusCode, nil
+}
+
+// refuseInternalAddress is the dialer's Control hook, it runs with the resolved address
+func refuseInternalAddress(network, address string, _ syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+	if ip := net.ParseIP(host); ip == nil || isInternalIP(ip) {
+		return fmt.Errorf("webhook address %s is not allowed", host)
+	}
+	return nil
+}
+
+// signatureHeader signs "<timestamp>.<payload>" with HMAC-SHA256, formatted as t=<timestamp>,v1=<hex>.
+// Receivers should recompute the signature and reject old timestamps to prevent replays.
+func signatureHeader(secret string, timestamp int64, payload string) string {
+	ts := strconv.FormatInt(timestamp, 10)
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(ts + "." + payload))
+	return "t=" + ts + ",v1=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+func nullInt64(n int) sql.NullInt64 {
+	return sql.NullInt64{Int64: int64(n), Valid: n != 0}
+}
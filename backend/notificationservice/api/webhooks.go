@@ -0,0 +1,447 @@
+package api
+
+import (
+	"context"
+	"crypto/rand"
+	"database/sql"
+	"encoding/hex"
+	"errors"
+	"log/slog"
+	"net"
+	"net/url"
+	"sort"
+	"strings"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"sortedstartup.com/stream/common/auth"
+	"sortedstartup.com/stream/common/constants"
+	"sortedstartup.com/stream/common/interceptors"
+	"sortedstartup.com/stream/notificationservice/db"
+	"sortedstartup.com/stream/notificationservice/proto"
+	userProto "sortedstartup.com/stream/userservice/proto"
+)
+
+const (
+	maxWebhooksPerTenant = 20
+	maxWebhookURLLength  = 2048
+
+	minWebhookSecretLength = 16
+	maxWebhookSecretLength = 256
+)
+
+// Delivery statuses as stored in notificationservice_webhook_deliveries.status
+const (
+	deliveryPending   = "pending"
+	deliverySucceeded = "succeeded"
+	deliveryFailed    = "failed"
+)
+
+// WebhookAPI lets super admins manage the webhooks of their tenant
+type WebhookAPI struct {
+	log               *slog.Logger
+	dbQueries         db.Querier
+	userServiceClient userProto.UserServiceClient
+	sender            *WebhookSender
+
+	//implemented proto server
+	proto.UnimplementedWebhookServiceServer
+}
+
+// NewWebhookAPITest creates a WebhookAPI instance with a mock database for testing.
+func NewWebhookAPITest(mockDB db.Querier, userServiceClient userProto.UserServiceClient, logger *slog.Logger) *WebhookAPI {
+	return &WebhookAPI{
+		log:               logger,
+		dbQueries:         mockDB,
+		userServiceClient: userServiceClient,
+		sender:            newWebhookSender(logger, mockDB),
+	}
+}
+
+func (s *WebhookAPI) CreateWebhook(ctx context.Context, req *proto.CreateWebhookRequest) (*proto.CreateWebhookResponse, error) {
+	authContext, tenantID, err := s.requireSuperAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := validateWebhookURL(ctx, req.Url); err != nil {
+		return nil, err
+	}
+	eventTypes, err := eventTypesToDB(req.EventTypes)
+	if err != nil {
+		return nil, err
+	}
+
+	secret := req.Secret
+	if secret == "" {
+		secret, err = generateWebhookSecret()
+		if err != nil {
+			s.log.Error("Error generating webhook secret", "err", err)
+			return nil, status.Error(codes.Internal, "failed to create webhook")
+		}
+	}
+	if len(secret) < minWebhookSecretLength || len(secret) > maxWebhookSecretLength {
+		return nil, status.Errorf(codes.InvalidArgument, "secret must be between %d and %d characters", minWebhookSecretLength, maxWebhookSecretLength)
+	}
+
+	count, err := s.dbQueries.CountWebhooksByTenantID(ctx, tenantID)
+	if err != nil {
+		s.log.Error("Error counting webhooks", "err", err, "tenantID", tenantID)
+		return nil, status.Error(codes.Internal, "failed to create webhook")
+	}
+	if count >= maxWebhooksPerTenant {
+		return nil, status.Errorf(codes.FailedPrecondition, "a tenant can have at most %d webhooks", maxWebhooksPerTenant)
+	}
+
+	webhook, err := s.dbQueries.CreateWebhook(ctx, db.CreateWebhookParams{
+		ID:         uuid.New().String(),
+		TenantID:   tenantID,
+		Url:        req.Url,
+		Secret:     secret,
+		EventTypes: eventTypes,
+		CreatedBy:  authContext.User.ID,
+	})
+	if err != nil {
+		s.log.Error("Error creating webhook", "err", err, "tenantID", tenantID)
+		return nil, status.Error(codes.Internal, "failed to create webhook")
+	}
+
+	return &proto.CreateWebhookResponse{
+		Webhook: webhookToProto(webhook),
+		Secret:  secret,
+	}, nil
+}
+
+func (s *WebhookAPI) ListWebhooks(ctx context.Context, req *proto.ListWebhooksRequest) (*proto.ListWebhooksResponse, error) {
+	_, tenantID, err := s.requireSuperAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	webhooks, err := s.dbQueries.GetWebhooksByTenantID(ctx, tenantID)
+	if err != nil {
+		s.log.Error("Error getting webhooks", "err", err, "tenantID", tenantID)
+		return nil, status.Error(codes.Internal, "failed to get webhooks")
+	}
+
+	response := &proto.ListWebhooksResponse{}
+	for _, webhook := range webhooks {
+		response.Webhooks = append(response.Webhooks, webhookToProto(webhook))
+	}
+	return response, nil
+}
+
+func (s *WebhookAPI) UpdateWebhook(ctx context.Context, req *proto.UpdateWebhookRequest) (*proto.Webhook, error) {
+	_, tenantID, err := s.requireSuperAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.WebhookId == "" {
+		return nil, status.Error(codes.InvalidArgument, "webhook ID is required")
+	}
+	if err := validateWebhookURL(ctx, req.Url); err != nil {
+		return nil, err
+	}
+	eventTypes, err := eventTypesToDB(req.EventTypes)
+	if err != nil {
+		return nil, err
+	}
+
+	webhook, err := s.dbQueries.UpdateWebhook(ctx, db.UpdateWebhookParams{
+		ID:         req.WebhookId,
+		TenantID:   tenantID,
+		Url:        req.Url,
+		EventTypes: eventTypes,
+		IsActive:   req.Active,
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, status.Error(codes.NotFound, "webhook not found")
+	}
+	if err != nil {
+		s.log.Error("Error updating webhook", "err", err, "webhookID", req.WebhookId)
+		return nil, status.Error(codes.Internal, "failed to update webhook")
+	}
+
+	return webhookToProto(webhook), nil
+}
+
+func (s *WebhookAPI) DeleteWebhook(ctx context.Context, req *proto.DeleteWebhookRequest) (*proto.DeleteWebhookResponse, error) {
+	_, tenantID, err := s.requireSuperAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.WebhookId == "" {
+		return nil, status.Error(codes.InvalidArgument, "webhook ID is required")
+	}
+
+	deleted, err := s.dbQueries.DeleteWebhook(ctx, db.DeleteWebhookParams{ID: req.WebhookId, TenantID: tenantID})
+	if err != nil {
+		s.log.Error("Error deleting webhook", "err", err, "webhookID", req.WebhookId)
+		return nil, status.Error(codes.Internal, "failed to delete webhook")
+	}
+	if deleted == 0 {
+		return nil, status.Error(codes.NotFound, "webhook not found")
+	}
+
+	err = s.dbQueries.DeleteWebhookDeliveries(ctx, db.DeleteWebhookDeliveriesParams{WebhookID: req.WebhookId, TenantID: tenantID})
+	if err != nil {
+		// Leftover deliveries are never sent, their webhook is gone
+		s.log.Error("Error deleting webhook deliveries", "err", err, "webhookID", req.WebhookId)
+	}
+
+	return &proto.DeleteWebhookResponse{}, nil
+}
+
+func (s *WebhookAPI) ListWebhookDeliveries(ctx context.Context, req *proto.ListWebhookDeliveriesRequest) (*proto.ListWebhookDeliveriesResponse, error) {
+	_, tenantID, err := s.requireSuperAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.WebhookId == "" {
+		return nil, status.Error(codes.InvalidArgument, "webhook ID is required")
+	}
+	pageSize, pageNumber, err := normalizePagination(req.PageSize, req.PageNumber)
+	if err != nil {
+		return nil, err
+	}
+
+	// Fetch one extra row to know whether there is a next page
+	deliveries, err := s.dbQueries.GetWebhookDeliveriesPaginated(ctx, db.GetWebhookDeliveriesPaginatedParams{
+		WebhookID:  req.WebhookId,
+		TenantID:   tenantID,
+		PageSize:   int64(pageSize + 1),
+		PageNumber: int64(pageNumber),
+	})
+	if err != nil {
+		s.log.Error("Error getting webhook deliveries", "err", err, "webhookID", req.WebhookId)
+		return nil, status.Error(codes.Internal, "failed to get webhook deliveries")
+	}
+
+	response := &proto.ListWebhookDeliveriesResponse{}
+	if len(deliveries) > int(pageSize) {
+		deliveries = deliveries[:pageSize]
+		response.NextPageNumber = pageNumber + 1
+	}
+	for _, delivery := range deliveries {
+		response.Deliveries = append(response.Deliveries, deliveryToProto(delivery))
+	}
+	return response, nil
+}
+
+func (s *WebhookAPI) SendTestEvent(ctx context.Context, req *proto.SendTestEventRequest) (*proto.WebhookDelivery, error) {
+	authContext, tenantID, err := s.requireSuperAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.WebhookId == "" {
+		return nil, status.Error(codes.InvalidArgument, "webhook ID is required")
+	}
+
+	webhook, err := s.dbQueries.GetWebhookByIDAndTenantID(ctx, db.GetWebhookByIDAndTenantIDParams{ID: req.WebhookId, TenantID: tenantID})
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, status.Error(codes.NotFound, "webhook not found")
+	}
+	if err != nil {
+		s.log.Error("Error getting webhook", "err", err, "webhookID", req.WebhookId)
+		return nil, status.Error(codes.Internal, "failed to send test event")
+	}
+
+	eventID := uuid.New().String()
+	payload, err := buildEventPayload(eventID, eventWebhookTest, tenantID, authContext.User.ID, map[string]string{"webhook_id": webhook.ID})
+	if err != nil {
+		s.log.Error("Error building test payload", "err", err)
+		return nil, status.Error(codes.Internal, "failed to send test event")
+	}
+
+	deliveryID := uuid.New().String()
+	err = s.dbQueries.CreateWebhookDelivery(ctx, db.CreateWebhookDeliveryParams{
+		ID:        deliveryID,
+		WebhookID: webhook.ID,
+		TenantID:  tenantID,
+		EventID:   eventID,
+		EventType: eventWebhookTest,
+		Payload:   payload,
+	})
+	if err != nil {
+		s.log.Error("Error creating test delivery", "err", err, "webhookID", webhook.ID)
+		return nil, status.Error(codes.Internal, "failed to send test event")
+	}
+
+	// Sent right away and not retried, the caller is waiting for the outcome
+	s.sender.attempt(ctx, db.GetDueWebhookDeliveriesRow{
+		ID:        deliveryID,
+		WebhookID: webhook.ID,
+		EventType: eventWebhookTest,
+		Payload:   payload,
+		Url:       webhook.Url,
+		Secret:    webhook.Secret,
+	}, 1)
+
+	delivery, err := s.dbQueries.GetWebhookDeliveryByID(ctx, deliveryID)
+	if err != nil {
+		s.log.Error("Error getting test delivery", "err", err, "deliveryID", deliveryID)
+		return nil, status.Error(codes.Internal, "failed to get test delivery")
+	}
+	return deliveryToProto(delivery), nil
+}
+
+// requireSuperAdmin returns the caller and tenant if the caller is a super admin of the tenant
+func (s *WebhookAPI) requireSuperAdmin(ctx context.Context) (*auth.AuthContext, string, error) {
+	authContext, err := interceptors.AuthFromContext(ctx)
+	if err != nil {
+		return nil, "", status.Error(codes.Unauthenticated, "unauthenticated")
+	}
+
+	tenantID, err := interceptors.GetTenantIDFromContext(ctx)
+	if err != nil {
+		return nil, "", status.Error(codes.InvalidArgument, "tenant ID is required")
+	}
+
+	resp, err := s.userServiceClient.GetTenants(ctx, &userProto.GetTenantsRequest{})
+	if err != nil {
+		s.log.Error("Failed to get user tenants from userservice", "err", err, "userID", authContext.User.ID)
+		return nil, "", status.Error(codes.Internal, "failed to check tenant access")
+	}
+
+	for _, tenantUser := range resp.TenantUsers {
+		if tenantUser.Tenant.Id != tenantID {
+			continue
+		}
+		if tenantUser.Role.Role != constants.TenantRoleSuperAdmin {
+			return nil, "", status.Error(codes.PermissionDenied, "access denied: only super admins can manage webhooks")
+		}
+		return authContext, tenantID, nil
+	}
+
+	return nil, "", status.Error(codes.PermissionDenied, "access denied: you are not a member of this tenant")
+}
+
+// validateWebhookURL rejects URLs that point inside our network. Hostnames are resolved
+// here for a clear error, the sender checks the address again when it connects.
+func validateWebhookURL(ctx context.Context, rawURL string) error {
+	if rawURL == "" {
+		return status.Error(codes.InvalidArgument, "url is required")
+	}
+	if len(rawURL) > maxWebhookURLLength {
+		return status.Errorf(codes.InvalidArgument, "url cannot exceed %d characters", maxWebhookURLLength)
+	}
+
+	parsed, err := url.Parse(rawURL)
+	if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+		return status.Error(codes.InvalidArgument, "url must be an absolute http or https URL")
+	}
+
+	host := parsed.Hostname()
+	if ip := net.ParseIP(host); ip != nil {
+		if isInternalIP(ip) {
+			return status.Error(codes.InvalidArgument, "url must not point to a private address")
+		}
+		return nil
+	}
+	addrs, err := net.DefaultResolver.LookupIPAddr(ctx, host)
+	if err != nil {
+		// The host may not resolve from here yet, deliveries fail until it does
+		return nil
+	}
+	for _, addr := range addrs {
+		if isInternalIP(addr.IP) {
+			return status.Error(codes.InvalidArgument, "url must not point to a private address")
+		}
+	}
+	return nil
+}
+
+// isInternalIP reports whether ip is loopback, link-local, private or unspecified
+func isInternalIP(ip net.IP) bool {
+	return ip.IsLoopback() ||
+		ip.IsLinkLocalUnicast() ||
+		ip.IsLinkLocalMulticast() ||
+		ip.IsPrivate() ||
+		ip.IsUnspecified()
+}
+
+// eventTypesToDB validates the subscribed event types and joins them in a stable order
+func eventTypesToDB(eventTypes []proto.EventType) (string, error) {
+	seen := map[string]bool{}
+	var names []string
+	for _, eventType := range eventTypes {
+		name := eventTypeToDB(eventType)
+		if name == "" || name == eventWebhookTest {
+			return "", status.Errorf(codes.InvalidArgument, "invalid event type %s", eventType)
+		}
+		if !seen[name] {
+			seen[name] = true
+			names = append(names, name)
+		}
+	}
+	if len(names) == 0 {
+		return "", status.Error(codes.InvalidArgument, "at least one event type is required")
+	}
+
+	sort.Strings(names)
+	return strings.Join(names, ","), nil
+}
+
+func generateWebhookSecret() (string, error) {
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		return "", err
+	}
+	return "whsec_" + hex.EncodeToString(secret), nil
+}
+
+func webhookToProto(webhook db.NotificationserviceWebhook) *proto.Webhook {
+	protoWebhook := &proto.Webhook{
+		Id:        webhook.ID,
+		Url:       webhook.Url,
+		Active:    webhook.IsActive,
+		CreatedBy: webhook.CreatedBy,
+		CreatedAt: timestamppb.New(webhook.CreatedAt),
+		UpdatedAt: timestamppb.New(webhook.UpdatedAt),
+	}
+	for _, eventType := range strings.Split(webhook.EventTypes, ",") {
+		if protoType := eventTypeFromDB(eventType); protoType != proto.EventType_EVENT_TYPE_UNSPECIFIED {
+			protoWebhook.EventTypes = append(protoWebhook.EventTypes, protoType)
+		}
+	}
+	return protoWebhook
+}
+
+func deliveryToProto(delivery db.NotificationserviceWebhookDelivery) *proto.WebhookDelivery {
+	protoDelivery := &proto.WebhookDelivery{
+		Id:             delivery.ID,
+		WebhookId:      delivery.WebhookID,
+		EventId:        delivery.EventID,
+		EventType:      eventTypeFromDB(delivery.EventType),
+		Payload:        delivery.Payload,
+		Status:         deliveryStatusFromDB(delivery.Status),
+		Attempts:       int32(delivery.Attempts),
+		ResponseStatus: int32(delivery.ResponseStatus.Int64),
+		LastError:      delivery.LastError.String,
+		CreatedAt:      timestamppb.New(delivery.CreatedAt),
+	}
+	if delivery.Status == deliveryPending {
+		protoDelivery.NextAttemptAt = timestamppb.New(delivery.NextAttemptAt)
+	}
+	if delivery.DeliveredAt.Valid {
+		protoDelivery.DeliveredAt = timestamppb.New(delivery.DeliveredAt.Time)
+	}
+	return protoDelivery
+}
+
+func deliveryStatusFromDB(deliveryStatus string) proto.WebhookDeliveryStatus {
+	switch deliveryStatus {
+	case deliverySucceeded:
+		return proto.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_SUCCEEDED
+	case deliveryFailed:
+		return proto.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_FAILED
+	default:
+		return proto.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_PENDING
+	}
+}
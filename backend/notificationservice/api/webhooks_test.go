@@ -0,0 +1,365 @@
+package api
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"sortedstartup.com/stream/notificationservice/db"
+	mockdb "sortedstartup.com/stream/notificationservice/db/mocks"
+	"sortedstartup.com/stream/notificationservice/proto"
+	userProto "sortedstartup.com/stream/userservice/proto"
+)
+
+// Helper to give the test user a role in test-tenant
+func mockTenantRole(ctrl *gomock.Controller, role string) *userProto.MockUserServiceClient {
+	mockUsers := userProto.NewMockUserServiceClient(ctrl)
+	mockUsers.EXPECT().
+		GetTenants(gomock.Any(), gomock.Any()).
+		Return(&userProto.GetTenantsResponse{
+			TenantUsers: []*userProto.TenantUser{
+				{Tenant: &userProto.Tenant{Id: "test-tenant"}, Role: &userProto.Role{Role: role}},
+			},
+		}, nil).
+		AnyTimes()
+	return mockUsers
+}
+
+// Helper to let a sender reach a test server, loopback is refused otherwise
+func allowTestServer(sender *WebhookSender, server *httptest.Server) {
+	sender.httpClient.Transport = server.Client().Transport
+}
+
+func TestPublishEvent_QueuesForSubscribedWebhooks(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockDB := mockdb.NewMockQuerier(ctrl)
+	publisherAPI := NewPublisherAPITest(mockDB, slog.Default())
+
+	mockDB.EXPECT().
+		GetActiveWebhooksByTenantID(gomock.Any(), "tenant-1").
+		Return([]db.NotificationserviceWebhook{
+			{ID: "ci", EventTypes: "video.deleted,video.uploaded"},
+			{ID: "chat", EventTypes: "comment.created"},
+			{ID: "all", EventTypes: "comment.created,video.deleted,video.uploaded"},
+		}, nil).
+		Times(1)
+
+	var deliveries []db.CreateWebhookDeliveryParams
+	mockDB.EXPECT().
+		CreateWebhookDelivery(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, params db.CreateWebhookDeliveryParams) error {
+			deliveries = append(deliveries, params)
+			return nil
+		}).
+		Times(2)
+
+	resp, err := publisherAPI.PublishEvent(context.Background(), &proto.PublishEventRequest{
+		TenantId: "tenant-1",
+		Type:     proto.EventType_EVENT_TYPE_VIDEO_UPLOADED,
+		ActorId:  "user-1",
+		Data:     map[string]string{"video_id": "video-1", "title": "Demo"},
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, int32(2), resp.QueuedDeliveries)
+	assert.Equal(t, "ci", deliveries[0].WebhookID)
+	assert.Equal(t, "all", deliveries[1].WebhookID)
+	// Both webhooks get the same event
+	assert.Equal(t, deliveries[0].EventID, deliveries[1].EventID)
+	assert.Equal(t, deliveries[0].Payload, deliveries[1].Payload)
+
+	var payload eventPayload
+	assert.NoError(t, json.Unmarshal([]byte(deliveries[0].Payload), &payload))
+	assert.Equal(t, "video.uploaded", payload.Type)
+	assert.Equal(t, "tenant-1", payload.TenantID)
+	assert.Equal(t, "Demo", payload.Data["title"])
+}
+
+func TestPublishEvent_TestEventNotAllowed(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	publisherAPI := NewPublisherAPITest(mockdb.NewMockQuerier(ctrl), slog.Default())
+
+	_, err := publisherAPI.PublishEvent(context.Background(), &proto.PublishEventRequest{
+		TenantId: "tenant-1",
+		Type:     proto.EventType_EVENT_TYPE_WEBHOOK_TEST,
+	})
+
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestCreateWebhook(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockDB := mockdb.NewMockQuerier(ctrl)
+	webhookAPI := NewWebhookAPITest(mockDB, mockTenantRole(ctrl, "super_admin"), slog.Default())
+
+	mockDB.EXPECT().CountWebhooksByTenantID(gomock.Any(), "test-tenant").Return(int64(0), nil).Times(1)
+	mockDB.EXPECT().
+		CreateWebhook(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, params db.CreateWebhookParams) (db.NotificationserviceWebhook, error) {
+			assert.Equal(t, "test-tenant", params.TenantID)
+			assert.Equal(t, "comment.created,video.uploaded", params.EventTypes)
+			assert.True(t, strings.HasPrefix(params.Secret, "whsec_"))
+			assert.Equal(t, "test-user-id", params.CreatedBy)
+			return db.NotificationserviceWebhook{ID: params.ID, Url: params.Url, EventTypes: params.EventTypes, IsActive: true}, nil
+		}).
+		Times(1)
+
+	resp, err := webhookAPI.CreateWebhook(buildAuthContext(), &proto.CreateWebhookRequest{
+		Url: "https://ci.example.com/hooks/stream",
+		EventTypes: []proto.EventType{
+			proto.EventType_EVENT_TYPE_VIDEO_UPLOADED,
+			proto.EventType_EVENT_TYPE_COMMENT_CREATED,
+			proto.EventType_EVENT_TYPE_VIDEO_UPLOADED,
+		},
+	})
+
+	assert.NoError(t, err)
+	assert.NotEmpty(t, resp.Secret)
+	assert.Equal(t, []proto.EventType{proto.EventType_EVENT_TYPE_COMMENT_CREATED, proto.EventType_EVENT_TYPE_VIDEO_UPLOADED}, resp.Webhook.EventTypes)
+}
+
+func TestCreateWebhook_Validation(t *testing.T) {
+	tests := []struct {
+		name string
+		req  *proto.CreateWebhookRequest
+	}{
+		{
+			name: "not http",
+			req:  &proto.CreateWebhookRequest{Url: "ftp://example.com", EventTypes: []proto.EventType{proto.EventType_EVENT_TYPE_VIDEO_DELETED}},
+		},
+		{
+			name: "relative url",
+			req:  &proto.CreateWebhookRequest{Url: "/hooks", EventTypes: []proto.EventType{proto.EventType_EVENT_TYPE_VIDEO_DELETED}},
+		},
+		{
+			name: "loopback",
+			req:  &proto.CreateWebhookRequest{Url: "http://127.0.0.1:8080/hooks", EventTypes: []proto.EventType{proto.EventType_EVENT_TYPE_VIDEO_DELETED}},
+		},
+		{
+			name: "localhost",
+			req:  &proto.CreateWebhookRequest{Url: "http://localhost/hooks", EventTypes: []proto.EventType{proto.EventType_EVENT_TYPE_VIDEO_DELETED}},
+		},
+		{
+			name: "ipv6 loopback",
+			req:  &proto.CreateWebhookRequest{Url: "http://[::1]/hooks", EventTypes: []proto.EventType{proto.EventType_EVENT_TYPE_VIDEO_DELETED}},
+		},
+		{
+			name: "link-local metadata endpoint",
+			req:  &proto.CreateWebhookRequest{Url: "http://169.254.169.254/latest/meta-data", EventTypes: []proto.EventType{proto.EventType_EVENT_TYPE_VIDEO_DELETED}},
+		},
+		{
+			name: "private",
+			req:  &proto.CreateWebhookRequest{Url: "https://10.0.0.5/hooks", EventTypes: []proto.EventType{proto.EventType_EVENT_TYPE_VIDEO_DELETED}},
+		},
+		{
+			name: "unspecified",
+			req:  &proto.CreateWebhookRequest{Url: "http://0.0.0.0/hooks", EventTypes: []proto.EventType{proto.EventType_EVENT_TYPE_VIDEO_DELETED}},
+		},
+		{
+			name: "no event types",
+			req:  &proto.CreateWebhookRequest{Url: "https://example.com"},
+		},
+		{
+			name: "test event type",
+			req:  &proto.CreateWebhookRequest{Url: "https://example.com", EventTypes: []proto.EventType{proto.EventType_EVENT_TYPE_WEBHOOK_TEST}},
+		},
+		{
+			name: "short secret",
+			req:  &proto.CreateWebhookRequest{Url: "https://example.com", EventTypes: []proto.EventType{proto.EventType_EVENT_TYPE_VIDEO_DELETED}, Secret: "short"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			webhookAPI := NewWebhookAPITest(mockdb.NewMockQuerier(ctrl), mockTenantRole(ctrl, "super_admin"), slog.Default())
+
+			_, err := webhookAPI.CreateWebhook(buildAuthContext(), tt.req)
+
+			assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		})
+	}
+}
+
+func TestListWebhooks_MemberDenied(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	webhookAPI := NewWebhookAPITest(mockdb.NewMockQuerier(ctrl), mockTenantRole(ctrl, "member"), slog.Default())
+
+	_, err := webhookAPI.ListWebhooks(buildAuthContext(), &proto.ListWebhooksRequest{})
+
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+}
+
+func TestWebhookSender_SignsPayload(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	const secret = "whsec_test_secret_value"
+	const payload = `{"id":"event-1","type":"video.deleted"}`
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		assert.Equal(t, payload, string(body))
+		assert.Equal(t, "video.deleted", r.Header.Get(webhookEventHeader))
+		assert.Equal(t, "delivery-1", r.Header.Get(webhookDeliveryHeader))
+
+		// Recompute the signature like a receiver would
+		signature := r.Header.Get(webhookSignatureHeader)
+		timestamp, err := strconv.ParseInt(strings.TrimPrefix(strings.Split(signature, ",")[0], "t="), 10, 64)
+		assert.NoError(t, err)
+		assert.Equal(t, signatureHeader(secret, timestamp, payload), signature)
+
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	mockDB := mockdb.NewMockQuerier(ctrl)
+	sender := newWebhookSender(slog.Default(), mockDB)
+	allowTestServer(sender, server)
+
+	mockDB.EXPECT().
+		GetDueWebhookDeliveries(gomock.Any(), int64(webhookBatchSize)).
+		Return([]db.GetDueWebhookDeliveriesRow{
+			{ID: "delivery-1", WebhookID: "webhook-1", EventType: "video.deleted", Payload: payload, Url: server.URL, Secret: secret},
+		}, nil).
+		Times(1)
+	mockDB.EXPECT().
+		MarkWebhookDeliverySucceeded(gomock.Any(), db.MarkWebhookDeliverySucceededParams{
+			ID:             "delivery-1",
+			ResponseStatus: sql.NullInt64{Int64: http.StatusNoContent, Valid: true},
+		}).
+		Return(nil).
+		Times(1)
+
+	sender.sendDue(context.Background())
+}
+
+func TestWebhookSender_RetriesServerErrors(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "bot is down", http.StatusBadGateway)
+	}))
+	defer server.Close()
+
+	mockDB := mockdb.NewMockQuerier(ctrl)
+	sender := newWebhookSender(slog.Default(), mockDB)
+	allowTestServer(sender, server)
+
+	mockDB.EXPECT().
+		GetDueWebhookDeliveries(gomock.Any(), gomock.Any()).
+		Return([]db.GetDueWebhookDeliveriesRow{{ID: "delivery-1", Payload: "{}", Url: server.URL, Secret: "secret", Attempts: 1}}, nil).
+		Times(1)
+	mockDB.EXPECT().
+		MarkWebhookDeliveryFailed(gomock.Any(), db.MarkWebhookDeliveryFailedParams{
+			ID:                "delivery-1",
+			ResponseStatus:    sql.NullInt64{Int64: http.StatusBadGateway, Valid: true},
+			LastError:         sql.NullString{String: "unexpected status 502", Valid: true},
+			MaxAttempts:       maxWebhookAttempts,
+			RetryAfterSeconds: 2 * 60,
+		}).
+		Return(nil).
+		Times(1)
+
+	sender.sendDue(context.Background())
+}
+
+func TestWebhookSender_RefusesInternalAddresses(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// Stands in for a host that resolved to a public address when the webhook was created
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Error("the sender should not connect to a loopback address")
+	}))
+	defer server.Close()
+
+	mockDB := mockdb.NewMockQuerier(ctrl)
+	sender := newWebhookSender(slog.Default(), mockDB)
+
+	mockDB.EXPECT().
+		GetDueWebhookDeliveries(gomock.Any(), gomock.Any()).
+		Return([]db.GetDueWebhookDeliveriesRow{{ID: "delivery-1", Payload: "{}", Url: server.URL, Secret: "secret"}}, nil).
+		Times(1)
+	mockDB.EXPECT().
+		MarkWebhookDeliveryFailed(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, params db.MarkWebhookDeliveryFailedParams) error {
+			assert.False(t, params.ResponseStatus.Valid)
+			assert.Contains(t, params.LastError.String, "is not allowed")
+			return nil
+		}).
+		Times(1)
+
+	sender.sendDue(context.Background())
+}
+
+func TestSendTestEvent(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	received := make(chan string, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received <- r.Header.Get(webhookEventHeader)
+	}))
+	defer server.Close()
+
+	mockDB := mockdb.NewMockQuerier(ctrl)
+	webhookAPI := NewWebhookAPITest(mockDB, mockTenantRole(ctrl, "super_admin"), slog.Default())
+	allowTestServer(webhookAPI.sender, server)
+
+	mockDB.EXPECT().
+		GetWebhookByIDAndTenantID(gomock.Any(), db.GetWebhookByIDAndTenantIDParams{ID: "webhook-1", TenantID: "test-tenant"}).
+		Return(db.NotificationserviceWebhook{ID: "webhook-1", Url: server.URL, Secret: "secret", EventTypes: "video.uploaded"}, nil).
+		Times(1)
+
+	var deliveryID string
+	gomock.InOrder(
+		mockDB.EXPECT().
+			CreateWebhookDelivery(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, params db.CreateWebhookDeliveryParams) error {
+				assert.Equal(t, "webhook.test", params.EventType)
+				deliveryID = params.ID
+				return nil
+			}),
+		// A single attempt, a failure is final
+		mockDB.EXPECT().
+			MarkWebhookDeliverySucceeded(gomock.Any(), gomock.Any()).
+			Return(nil),
+		mockDB.EXPECT().
+			GetWebhookDeliveryByID(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, id string) (db.NotificationserviceWebhookDelivery, error) {
+				assert.Equal(t, deliveryID, id)
+				return db.NotificationserviceWebhookDelivery{ID: id, WebhookID: "webhook-1", EventType: "webhook.test", Status: "succeeded", Attempts: 1}, nil
+			}),
+	)
+
+	resp, err := webhookAPI.SendTestEvent(buildAuthContext(), &proto.SendTestEventRequest{WebhookId: "webhook-1"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "webhook.test", <-received)
+	assert.Equal(t, proto.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_SUCCEEDED, resp.Status)
+	assert.Equal(t, proto.EventType_EVENT_TYPE_WEBHOOK_TEST, resp.EventType)
+}
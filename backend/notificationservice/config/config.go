@@ -0,0 +1,22 @@
+package config
+
+type NotificationServiceConfig struct {
+	DB   DBConfig   `json:"db" mapstructure:"db"`
+	SMTP SMTPConfig `json:"smtp" mapstructure:"smtp"`
+	// Base URL of the web app used for links in emails, e.g. https://stream.example.com
+	AppURL string `json:"appUrl" mapstructure:"appUrl"`
+}
+
+type DBConfig struct {
+	Driver string `json:"driver" mapstructure:"driver"`
+	Url    string `json:"url" mapstructure:"url"`
+}
+
+// SMTPConfig configures outgoing email. Email is disabled when Host is empty.
+type SMTPConfig struct {
+	Host     string `json:"host" mapstructure:"host"`
+	Port     int    `json:"port" mapstructure:"port"`
+	Username string `json:"username" mapstructure:"username"` // Optional, no auth when empty
+	Password string `json:"password" mapstructure:"password"`
+	From     string `json:"from" mapstructure:"from"` // e.g. Stream <no-reply@example.com>
+}
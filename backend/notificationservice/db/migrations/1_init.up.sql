@@ -0,0 +1,21 @@
+-- Per user, per tenant notification inbox
+
+CREATE TABLE notificationservice_notifications (
+    id TEXT PRIMARY KEY,
+    tenant_id TEXT NOT NULL, -- References userservice_tenants(id) but no FK constraint
+    user_id TEXT NOT NULL, -- Recipient, references userservice_users(id) but no FK constraint
+    type TEXT NOT NULL, -- reply, mention, channel_video, channel_membership
+    actor_id TEXT NOT NULL,
+    actor_name TEXT NOT NULL DEFAULT '',
+    message TEXT NOT NULL,
+    video_id TEXT,
+    channel_id TEXT,
+    comment_id TEXT,
+    is_read BOOLEAN NOT NULL DEFAULT 0,
+    read_at TIMESTAMP,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Inbox listing and unread counts
+CREATE INDEX idx_notificationservice_notifications_inbox ON notificationservice_notifications(tenant_id, user_id, created_at DESC);
+CREATE INDEX idx_notificationservice_notifications_unread ON notificationservice_notifications(tenant_id, user_id) WHERE is_read = 0;
@@ -0,0 +1,35 @@
+-- Email preferences, per user across all tenants. Users without a row get the column defaults.
+
+CREATE TABLE notificationservice_email_preferences (
+    user_id TEXT PRIMARY KEY, -- References userservice_users(id) but no FK constraint
+    email_replies BOOLEAN NOT NULL DEFAULT 1,
+    email_mentions BOOLEAN NOT NULL DEFAULT 1,
+    email_invitations BOOLEAN NOT NULL DEFAULT 1,
+    digest_frequency TEXT NOT NULL DEFAULT 'weekly', -- off, daily, weekly
+    last_digest_at TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Rendered emails waiting to be sent. Rows are only written here and picked up
+-- by the sender, so nothing is lost when the server restarts before a send.
+
+CREATE TABLE notificationservice_email_outbox (
+    id TEXT PRIMARY KEY,
+    user_id TEXT NOT NULL, -- Recipient, references userservice_users(id) but no FK constraint
+    to_address TEXT NOT NULL,
+    kind TEXT NOT NULL, -- reply, mention, tenant_invitation, digest
+    subject TEXT NOT NULL,
+    text_body TEXT NOT NULL,
+    html_body TEXT NOT NULL,
+    status TEXT NOT NULL DEFAULT 'pending', -- pending, sent, failed
+    attempts INTEGER NOT NULL DEFAULT 0,
+    last_error TEXT,
+    next_attempt_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    sent_at TIMESTAMP
+);
+
+CREATE INDEX idx_notificationservice_email_outbox_due ON notificationservice_email_outbox(next_attempt_at) WHERE status = 'pending';
+
+-- Digest candidates
+CREATE INDEX idx_notificationservice_notifications_digest ON notificationservice_notifications(user_id, created_at) WHERE type = 'channel_video' AND is_read = 0;
@@ -0,0 +1,36 @@
+-- Outgoing webhooks, per tenant
+
+CREATE TABLE notificationservice_webhooks (
+    id TEXT PRIMARY KEY,
+    tenant_id TEXT NOT NULL, -- References userservice_tenants(id) but no FK constraint
+    url TEXT NOT NULL,
+    secret TEXT NOT NULL, -- HMAC key, kept in plain text because it is needed to sign
+    event_types TEXT NOT NULL, -- Comma separated, e.g. video.uploaded,comment.created
+    is_active BOOLEAN NOT NULL DEFAULT 1,
+    created_by TEXT NOT NULL, -- References userservice_users(id) but no FK constraint
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_notificationservice_webhooks_tenant ON notificationservice_webhooks(tenant_id);
+
+-- One row per event per webhook, doubles as the delivery log
+
+CREATE TABLE notificationservice_webhook_deliveries (
+    id TEXT PRIMARY KEY,
+    webhook_id TEXT NOT NULL, -- References notificationservice_webhooks(id) but no FK constraint
+    tenant_id TEXT NOT NULL,
+    event_id TEXT NOT NULL, -- Same for all webhooks receiving the event
+    event_type TEXT NOT NULL,
+    payload TEXT NOT NULL,
+    status TEXT NOT NULL DEFAULT 'pending', -- pending, succeeded, failed
+    attempts INTEGER NOT NULL DEFAULT 0,
+    response_status INTEGER, -- HTTP status of the last attempt
+    last_error TEXT,
+    next_attempt_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    delivered_at TIMESTAMP
+);
+
+CREATE INDEX idx_notificationservice_webhook_deliveries_due ON notificationservice_webhook_deliveries(next_attempt_at) WHERE status = 'pending';
+CREATE INDEX idx_notificationservice_webhook_deliveries_log ON notificationservice_webhook_deliveries(webhook_id, created_at DESC);
@@ -0,0 +1,462 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: db/querier.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	db "sortedstartup.com/stream/notificationservice/db"
+)
+
+// MockQuerier is a mock of Querier interface.
+type MockQuerier struct {
+	ctrl     *gomock.Controller
+	recorder *MockQuerierMockRecorder
+}
+
+// MockQuerierMockRecorder is the mock recorder for MockQuerier.
+type MockQuerierMockRecorder struct {
+	mock *MockQuerier
+}
+
+// NewMockQuerier creates a new mock instance.
+func NewMockQuerier(ctrl *gomock.Controller) *MockQuerier {
+	mock := &MockQuerier{ctrl: ctrl}
+	mock.recorder = &MockQuerierMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockQuerier) EXPECT() *MockQuerierMockRecorder {
+	return m.recorder
+}
+
+// CountUnreadNotifications mocks base method.
+func (m *MockQuerier) CountUnreadNotifications(ctx context.Context, arg db.CountUnreadNotificationsParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountUnreadNotifications", ctx, arg)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountUnreadNotifications indicates an expected call of CountUnreadNotifications.
+func (mr *MockQuerierMockRecorder) CountUnreadNotifications(ctx, arg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountUnreadNotifications", reflect.TypeOf((*MockQuerier)(nil).CountUnreadNotifications), ctx, arg)
+}
+
+// CountWebhooksByTenantID mocks base method.
+func (m *MockQuerier) CountWebhooksByTenantID(ctx context.Context, tenantID string) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountWebhooksByTenantID", ctx, tenantID)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountWebhooksByTenantID indicates an expected call of CountWebhooksByTenantID.
+func (mr *MockQuerierMockRecorder) CountWebhooksByTenantID(ctx, tenantID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountWebhooksByTenantID", reflect.TypeOf((*MockQuerier)(nil).CountWebhooksByTenantID), ctx, tenantID)
+}
+
+// CreateNotification mocks base method.
+func (m *MockQuerier) CreateNotification(ctx context.Context, arg db.CreateNotificationParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateNotification", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateNotification indicates an expected call of CreateNotification.
+func (mr *MockQuerierMockRecorder) CreateNotification(ctx, arg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateNotification", reflect.TypeOf((*MockQuerier)(nil).CreateNotification), ctx, arg)
+}
+
+// CreateOutboxEmail mocks base method.
+func (m *MockQuerier) CreateOutboxEmail(ctx context.Context, arg db.CreateOutboxEmailParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateOutboxEmail", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateOutboxEmail indicates an expected call of CreateOutboxEmail.
+func (mr *MockQuerierMockRecorder) CreateOutboxEmail(ctx, arg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOutboxEmail", reflect.TypeOf((*MockQuerier)(nil).CreateOutboxEmail), ctx, arg)
+}
+
+// CreateWebhook mocks base method.
+func (m *MockQuerier) CreateWebhook(ctx context.Context, arg db.CreateWebhookParams) (db.NotificationserviceWebhook, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateWebhook", ctx, arg)
+	ret0, _ := ret[0].(db.NotificationserviceWebhook)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateWebhook indicates an expected call of CreateWebhook.
+func (mr *MockQuerierMockRecorder) CreateWebhook(ctx, arg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateWebhook", reflect.TypeOf((*MockQuerier)(nil).CreateWebhook), ctx, arg)
+}
+
+// CreateWebhookDelivery mocks base method.
+func (m *MockQuerier) CreateWebhookDelivery(ctx context.Context, arg db.CreateWebhookDeliveryParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateWebhookDelivery", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateWebhookDelivery indicates an expected call of CreateWebhookDelivery.
+func (mr *MockQuerierMockRecorder) CreateWebhookDelivery(ctx, arg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateWebhookDelivery", reflect.TypeOf((*MockQuerier)(nil).CreateWebhookDelivery), ctx, arg)
+}
+
+// DeleteWebhook mocks base method.
+func (m *MockQuerier) DeleteWebhook(ctx context.Context, arg db.DeleteWebhookParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteWebhook", ctx, arg)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteWebhook indicates an expected call of DeleteWebhook.
+func (mr *MockQuerierMockRecorder) DeleteWebhook(ctx, arg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWebhook", reflect.TypeOf((*MockQuerier)(nil).DeleteWebhook), ctx, arg)
+}
+
+// DeleteWebhookDeliveries mocks base method.
+func (m *MockQuerier) DeleteWebhookDeliveries(ctx context.Context, arg db.DeleteWebhookDeliveriesParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteWebhookDeliveries", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteWebhookDeliveries indicates an expected call of DeleteWebhookDeliveries.
+func (mr *MockQuerierMockRecorder) DeleteWebhookDeliveries(ctx, arg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWebhookDeliveries", reflect.TypeOf((*MockQuerier)(nil).DeleteWebhookDeliveries), ctx, arg)
+}
+
+// GetActiveWebhooksByTenantID mocks base method.
+func (m *MockQuerier) GetActiveWebhooksByTenantID(ctx context.Context, tenantID string) ([]db.NotificationserviceWebhook, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetActiveWebhooksByTenantID", ctx, tenantID)
+	ret0, _ := ret[0].([]db.NotificationserviceWebhook)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetActiveWebhooksByTenantID indicates an expected call of GetActiveWebhooksByTenantID.
+func (mr *MockQuerierMockRecorder) GetActiveWebhooksByTenantID(ctx, tenantID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetActiveWebhooksByTenantID", reflect.TypeOf((*MockQuerier)(nil).GetActiveWebhooksByTenantID), ctx, tenantID)
+}
+
+// GetDigestNotifications mocks base method.
+func (m *MockQuerier) GetDigestNotifications(ctx context.Context, arg db.GetDigestNotificationsParams) ([]db.NotificationserviceNotification, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDigestNotifications", ctx, arg)
+	ret0, _ := ret[0].([]db.NotificationserviceNotification)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDigestNotifications indicates an expected call of GetDigestNotifications.
+func (mr *MockQuerierMockRecorder) GetDigestNotifications(ctx, arg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDigestNotifications", reflect.TypeOf((*MockQuerier)(nil).GetDigestNotifications), ctx, arg)
+}
+
+// GetDueDigestUsers mocks base method.
+func (m *MockQuerier) GetDueDigestUsers(ctx context.Context, maxUsers int64) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDueDigestUsers", ctx, maxUsers)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDueDigestUsers indicates an expected call of GetDueDigestUsers.
+func (mr *MockQuerierMockRecorder) GetDueDigestUsers(ctx, maxUsers interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDueDigestUsers", reflect.TypeOf((*MockQuerier)(nil).GetDueDigestUsers), ctx, maxUsers)
+}
+
+// GetDueOutboxEmails mocks base method.
+func (m *MockQuerier) GetDueOutboxEmails(ctx context.Context, maxEmails int64) ([]db.NotificationserviceEmailOutbox, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDueOutboxEmails", ctx, maxEmails)
+	ret0, _ := ret[0].([]db.NotificationserviceEmailOutbox)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDueOutboxEmails indicates an expected call of GetDueOutboxEmails.
+func (mr *MockQuerierMockRecorder) GetDueOutboxEmails(ctx, maxEmails interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDueOutboxEmails", reflect.TypeOf((*MockQuerier)(nil).GetDueOutboxEmails), ctx, maxEmails)
+}
+
+// GetDueWebhookDeliveries mocks base method.
+func (m *MockQuerier) GetDueWebhookDeliveries(ctx context.Context, maxDeliveries int64) ([]db.GetDueWebhookDeliveriesRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDueWebhookDeliveries", ctx, maxDeliveries)
+	ret0, _ := ret[0].([]db.GetDueWebhookDeliveriesRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDueWebhookDeliveries indicates an expected call of GetDueWebhookDeliveries.
+func (mr *MockQuerierMockRecorder) GetDueWebhookDeliveries(ctx, maxDeliveries interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDueWebhookDeliveries", reflect.TypeOf((*MockQuerier)(nil).GetDueWebhookDeliveries), ctx, maxDeliveries)
+}
+
+// GetEmailPreferences mocks base method.
+func (m *MockQuerier) GetEmailPreferences(ctx context.Context, userID string) (db.NotificationserviceEmailPreference, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEmailPreferences", ctx, userID)
+	ret0, _ := ret[0].(db.NotificationserviceEmailPreference)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEmailPreferences indicates an expected call of GetEmailPreferences.
+func (mr *MockQuerierMockRecorder) GetEmailPreferences(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEmailPreferences", reflect.TypeOf((*MockQuerier)(nil).GetEmailPreferences), ctx, userID)
+}
+
+// GetEmailPreferencesByUserIDs mocks base method.
+func (m *MockQuerier) GetEmailPreferencesByUserIDs(ctx context.Context, userIds []string) ([]db.NotificationserviceEmailPreference, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEmailPreferencesByUserIDs", ctx, userIds)
+	ret0, _ := ret[0].([]db.NotificationserviceEmailPreference)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEmailPreferencesByUserIDs indicates an expected call of GetEmailPreferencesByUserIDs.
+func (mr *MockQuerierMockRecorder) GetEmailPreferencesByUserIDs(ctx, userIds interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEmailPreferencesByUserIDs", reflect.TypeOf((*MockQuerier)(nil).GetEmailPreferencesByUserIDs), ctx, userIds)
+}
+
+// GetNotificationsPaginated mocks base method.
+func (m *MockQuerier) GetNotificationsPaginated(ctx context.Context, arg db.GetNotificationsPaginatedParams) ([]db.NotificationserviceNotification, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNotificationsPaginated", ctx, arg)
+	ret0, _ := ret[0].([]db.NotificationserviceNotification)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNotificationsPaginated indicates an expected call of GetNotificationsPaginated.
+func (mr *MockQuerierMockRecorder) GetNotificationsPaginated(ctx, arg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNotificationsPaginated", reflect.TypeOf((*MockQuerier)(nil).GetNotificationsPaginated), ctx, arg)
+}
+
+// GetWebhookByIDAndTenantID mocks base method.
+func (m *MockQuerier) GetWebhookByIDAndTenantID(ctx context.Context, arg db.GetWebhookByIDAndTenantIDParams) (db.NotificationserviceWebhook, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWebhookByIDAndTenantID", ctx, arg)
+	ret0, _ := ret[0].(db.NotificationserviceWebhook)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWebhookByIDAndTenantID indicates an expected call of GetWebhookByIDAndTenantID.
+func (mr *MockQuerierMockRecorder) GetWebhookByIDAndTenantID(ctx, arg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWebhookByIDAndTenantID", reflect.TypeOf((*MockQuerier)(nil).GetWebhookByIDAndTenantID), ctx, arg)
+}
+
+// GetWebhookDeliveriesPaginated mocks base method.
+func (m *MockQuerier) GetWebhookDeliveriesPaginated(ctx context.Context, arg db.GetWebhookDeliveriesPaginatedParams) ([]db.NotificationserviceWebhookDelivery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWebhookDeliveriesPaginated", ctx, arg)
+	ret0, _ := ret[0].([]db.NotificationserviceWebhookDelivery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWebhookDeliveriesPaginated indicates an expected call of GetWebhookDeliveriesPaginated.
+func (mr *MockQuerierMockRecorder) GetWebhookDeliveriesPaginated(ctx, arg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWebhookDeliveriesPaginated", reflect.TypeOf((*MockQuerier)(nil).GetWebhookDeliveriesPaginated), ctx, arg)
+}
+
+// GetWebhookDeliveryByID mocks base method.
+func (m *MockQuerier) GetWebhookDeliveryByID(ctx context.Context, id string) (db.NotificationserviceWebhookDelivery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWebhookDeliveryByID", ctx, id)
+	ret0, _ := ret[0].(db.NotificationserviceWebhookDelivery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWebhookDeliveryByID indicates an expected call of GetWebhookDeliveryByID.
+func (mr *MockQuerierMockRecorder) GetWebhookDeliveryByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWebhookDeliveryByID", reflect.TypeOf((*MockQuerier)(nil).GetWebhookDeliveryByID), ctx, id)
+}
+
+// GetWebhooksByTenantID mocks base method.
+func (m *MockQuerier) GetWebhooksByTenantID(ctx context.Context, tenantID string) ([]db.NotificationserviceWebhook, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWebhooksByTenantID", ctx, tenantID)
+	ret0, _ := ret[0].([]db.NotificationserviceWebhook)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWebhooksByTenantID indicates an expected call of GetWebhooksByTenantID.
+func (mr *MockQuerierMockRecorder) GetWebhooksByTenantID(ctx, tenantID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWebhooksByTenantID", reflect.TypeOf((*MockQuerier)(nil).GetWebhooksByTenantID), ctx, tenantID)
+}
+
+// MarkAllNotificationsRead mocks base method.
+func (m *MockQuerier) MarkAllNotificationsRead(ctx context.Context, arg db.MarkAllNotificationsReadParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkAllNotificationsRead", ctx, arg)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MarkAllNotificationsRead indicates an expected call of MarkAllNotificationsRead.
+func (mr *MockQuerierMockRecorder) MarkAllNotificationsRead(ctx, arg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkAllNotificationsRead", reflect.TypeOf((*MockQuerier)(nil).MarkAllNotificationsRead), ctx, arg)
+}
+
+// MarkDigestSent mocks base method.
+func (m *MockQuerier) MarkDigestSent(ctx context.Context, userID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkDigestSent", ctx, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkDigestSent indicates an expected call of MarkDigestSent.
+func (mr *MockQuerierMockRecorder) MarkDigestSent(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkDigestSent", reflect.TypeOf((*MockQuerier)(nil).MarkDigestSent), ctx, userID)
+}
+
+// MarkNotificationsRead mocks base method.
+func (m *MockQuerier) MarkNotificationsRead(ctx context.Context, arg db.MarkNotificationsReadParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkNotificationsRead", ctx, arg)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MarkNotificationsRead indicates an expected call of MarkNotificationsRead.
+func (mr *MockQuerierMockRecorder) MarkNotificationsRead(ctx, arg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkNotificationsRead", reflect.TypeOf((*MockQuerier)(nil).MarkNotificationsRead), ctx, arg)
+}
+
+// MarkOutboxEmailFailed mocks base method.
+func (m *MockQuerier) MarkOutboxEmailFailed(ctx context.Context, arg db.MarkOutboxEmailFailedParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkOutboxEmailFailed", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkOutboxEmailFailed indicates an expected call of MarkOutboxEmailFailed.
+func (mr *MockQuerierMockRecorder) MarkOutboxEmailFailed(ctx, arg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkOutboxEmailFailed", reflect.TypeOf((*MockQuerier)(nil).MarkOutboxEmailFailed), ctx, arg)
+}
+
+// MarkOutboxEmailSent mocks base method.
+func (m *MockQuerier) MarkOutboxEmailSent(ctx context.Context, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkOutboxEmailSent", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkOutboxEmailSent indicates an expected call of MarkOutboxEmailSent.
+func (mr *MockQuerierMockRecorder) MarkOutboxEmailSent(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkOutboxEmailSent", reflect.TypeOf((*MockQuerier)(nil).MarkOutboxEmailSent), ctx, id)
+}
+
+// MarkWebhookDeliveryFailed mocks base method.
+func (m *MockQuerier) MarkWebhookDeliveryFailed(ctx context.Context, arg db.MarkWebhookDeliveryFailedParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkWebhookDeliveryFailed", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkWebhookDeliveryFailed indicates an expected call of MarkWebhookDeliveryFailed.
+func (mr *MockQuerierMockRecorder) MarkWebhookDeliveryFailed(ctx, arg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkWebhookDeliveryFailed", reflect.TypeOf((*MockQuerier)(nil).MarkWebhookDeliveryFailed), ctx, arg)
+}
+
+// MarkWebhookDeliverySucceeded mocks base method.
+func (m *MockQuerier) MarkWebhookDeliverySucceeded(ctx context.Context, arg db.MarkWebhookDeliverySucceededParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkWebhookDeliverySucceeded", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkWebhookDeliverySucceeded indicates an expected call of MarkWebhookDeliverySucceeded.
+func (mr *MockQuerierMockRecorder) MarkWebhookDeliverySucceeded(ctx, arg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkWebhookDeliverySucceeded", reflect.TypeOf((*MockQuerier)(nil).MarkWebhookDeliverySucceeded), ctx, arg)
+}
+
+// UpdateWebhook mocks base method.
+func (m *MockQuerier) UpdateWebhook(ctx context.Context, arg db.UpdateWebhookParams) (db.NotificationserviceWebhook, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateWebhook", ctx, arg)
+	ret0, _ := ret[0].(db.NotificationserviceWebhook)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateWebhook indicates an expected call of UpdateWebhook.
+func (mr *MockQuerierMockRecorder) UpdateWebhook(ctx, arg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWebhook", reflect.TypeOf((*MockQuerier)(nil).UpdateWebhook), ctx, arg)
+}
+
+// UpsertEmailPreferences mocks base method.
+func (m *MockQuerier) UpsertEmailPreferences(ctx context.Context, arg db.UpsertEmailPreferencesParams) (db.NotificationserviceEmailPreference, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertEmailPreferences", ctx, arg)
+	ret0, _ := ret[0].(db.NotificationserviceEmailPreference)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpsertEmailPreferences indicates an expected call of UpsertEmailPreferences.
+func (mr *MockQuerierMockRecorder) UpsertEmailPreferences(ctx, arg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertEmailPreferences", reflect.TypeOf((*MockQuerier)(nil).UpsertEmailPreferences), ctx, arg)
+}
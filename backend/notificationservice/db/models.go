@@ -0,0 +1,80 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.28.0
+
+package db
+
+import (
+	"database/sql"
+	"time"
+)
+
+type NotificationserviceEmailOutbox struct {
+	ID            string
+	UserID        string
+	ToAddress     string
+	Kind          string
+	Subject       string
+	TextBody      string
+	HtmlBody      string
+	Status        string
+	Attempts      int64
+	LastError     sql.NullString
+	NextAttemptAt time.Time
+	CreatedAt     time.Time
+	SentAt        sql.NullTime
+}
+
+type NotificationserviceEmailPreference struct {
+	UserID           string
+	EmailReplies     bool
+	EmailMentions    bool
+	EmailInvitations bool
+	DigestFrequency  string
+	LastDigestAt     sql.NullTime
+	UpdatedAt        time.Time
+}
+
+type NotificationserviceNotification struct {
+	ID        string
+	TenantID  string
+	UserID    string
+	Type      string
+	ActorID   string
+	ActorName string
+	Message   string
+	VideoID   sql.NullString
+	ChannelID sql.NullString
+	CommentID sql.NullString
+	IsRead    bool
+	ReadAt    sql.NullTime
+	CreatedAt time.Time
+}
+
+type NotificationserviceWebhook struct {
+	ID         string
+	TenantID   string
+	Url        string
+	Secret     string
+	EventTypes string
+	IsActive   bool
+	CreatedBy  string
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+type NotificationserviceWebhookDelivery struct {
+	ID             string
+	WebhookID      string
+	TenantID       string
+	EventID        string
+	EventType      string
+	Payload        string
+	Status         string
+	Attempts       int64
+	ResponseStatus sql.NullInt64
+	LastError      sql.NullString
+	NextAttemptAt  time.Time
+	CreatedAt      time.Time
+	DeliveredAt    sql.NullTime
+}
@@ -0,0 +1,48 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.28.0
+
+package db
+
+import (
+	"context"
+)
+
+type Querier interface {
+	CountUnreadNotifications(ctx context.Context, arg CountUnreadNotificationsParams) (int64, error)
+	CountWebhooksByTenantID(ctx context.Context, tenantID string) (int64, error)
+	CreateNotification(ctx context.Context, arg CreateNotificationParams) error
+	CreateOutboxEmail(ctx context.Context, arg CreateOutboxEmailParams) error
+	CreateWebhook(ctx context.Context, arg CreateWebhookParams) (NotificationserviceWebhook, error)
+	CreateWebhookDelivery(ctx context.Context, arg CreateWebhookDeliveryParams) error
+	DeleteWebhook(ctx context.Context, arg DeleteWebhookParams) (int64, error)
+	DeleteWebhookDeliveries(ctx context.Context, arg DeleteWebhookDeliveriesParams) error
+	GetActiveWebhooksByTenantID(ctx context.Context, tenantID string) ([]NotificationserviceWebhook, error)
+	GetDigestNotifications(ctx context.Context, arg GetDigestNotificationsParams) ([]NotificationserviceNotification, error)
+	// Users with unread channel videos whose digest period has passed.
+	// A user's first digest goes out as soon as there is something in it.
+	GetDueDigestUsers(ctx context.Context, maxUsers int64) ([]string, error)
+	GetDueOutboxEmails(ctx context.Context, maxEmails int64) ([]NotificationserviceEmailOutbox, error)
+	// Deliveries of inactive webhooks wait until the webhook is activated again
+	GetDueWebhookDeliveries(ctx context.Context, maxDeliveries int64) ([]GetDueWebhookDeliveriesRow, error)
+	GetEmailPreferences(ctx context.Context, userID string) (NotificationserviceEmailPreference, error)
+	GetEmailPreferencesByUserIDs(ctx context.Context, userIds []string) ([]NotificationserviceEmailPreference, error)
+	GetNotificationsPaginated(ctx context.Context, arg GetNotificationsPaginatedParams) ([]NotificationserviceNotification, error)
+	GetWebhookByIDAndTenantID(ctx context.Context, arg GetWebhookByIDAndTenantIDParams) (NotificationserviceWebhook, error)
+	GetWebhookDeliveriesPaginated(ctx context.Context, arg GetWebhookDeliveriesPaginatedParams) ([]NotificationserviceWebhookDelivery, error)
+	GetWebhookDeliveryByID(ctx context.Context, id string) (NotificationserviceWebhookDelivery, error)
+	GetWebhooksByTenantID(ctx context.Context, tenantID string) ([]NotificationserviceWebhook, error)
+	MarkAllNotificationsRead(ctx context.Context, arg MarkAllNotificationsReadParams) (int64, error)
+	MarkDigestSent(ctx context.Context, userID string) error
+	MarkNotificationsRead(ctx context.Context, arg MarkNotificationsReadParams) (int64, error)
+	// Records a failed attempt, the email is given up on after max_attempts
+	MarkOutboxEmailFailed(ctx context.Context, arg MarkOutboxEmailFailedParams) error
+	MarkOutboxEmailSent(ctx context.Context, id string) error
+	// Records a failed attempt, the delivery is given up on after max_attempts
+	MarkWebhookDeliveryFailed(ctx context.Context, arg MarkWebhookDeliveryFailedParams) error
+	MarkWebhookDeliverySucceeded(ctx context.Context, arg MarkWebhookDeliverySucceededParams) error
+	UpdateWebhook(ctx context.Context, arg UpdateWebhookParams) (NotificationserviceWebhook, error)
+	UpsertEmailPreferences(ctx context.Context, arg UpsertEmailPreferencesParams) (NotificationserviceEmailPreference, error)
+}
+
+var _ Querier = (*Queries)(nil)
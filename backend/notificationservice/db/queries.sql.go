@@ -0,0 +1,950 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.28.0
+// source: queries.sql
+
+package db
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+)
+
+const countUnreadNotifications = `-- name: CountUnreadNotifications :one
+SELECT COUNT(*) FROM notificationservice_notifications
+WHERE tenant_id = ?1 AND user_id = ?2 AND is_read = 0
+`
+
+type CountUnreadNotificationsParams struct {
+	TenantID string
+	UserID   string
+}
+
+func (q *Queries) CountUnreadNotifications(ctx context.Context, arg CountUnreadNotificationsParams) (int64, error) {
+	row := q.db.QueryRowContext(ctx, countUnreadNotifications, arg.TenantID, arg.UserID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const countWebhooksByTenantID = `-- name: CountWebhooksByTenantID :one
+SELECT COUNT(*) FROM notificationservice_webhooks
+WHERE tenant_id = ?1
+`
+
+func (q *Queries) CountWebhooksByTenantID(ctx context.Context, tenantID string) (int64, error) {
+	row := q.db.QueryRowContext(ctx, countWebhooksByTenantID, tenantID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const createNotification = `-- name: CreateNotification :exec
+INSERT INTO notificationservice_notifications (
+    id, tenant_id, user_id, type, actor_id, actor_name, message, video_id, channel_id, comment_id
+) VALUES (
+    ?1, ?2, ?3, ?4, ?5, ?6, ?7, ?8, ?9, ?10
+)
+`
+
+type CreateNotificationParams struct {
+	ID        string
+	TenantID  string
+	UserID    string
+	Type      string
+	ActorID   string
+	ActorName string
+	Message   string
+	VideoID   sql.NullString
+	ChannelID sql.NullString
+	CommentID sql.NullString
+}
+
+func (q *Queries) CreateNotification(ctx context.Context, arg CreateNotificationParams) error {
+	_, err := q.db.ExecContext(ctx, createNotification,
+		arg.ID,
+		arg.TenantID,
+		arg.UserID,
+		arg.Type,
+		arg.ActorID,
+		arg.ActorName,
+		arg.Message,
+		arg.VideoID,
+		arg.ChannelID,
+		arg.CommentID,
+	)
+	return err
+}
+
+const createOutboxEmail = `-- name: CreateOutboxEmail :exec
+INSERT INTO notificationservice_email_outbox (
+    id, user_id, to_address, kind, subject, text_body, html_body
+) VALUES (
+    ?1, ?2, ?3, ?4, ?5, ?6, ?7
+)
+`
+
+type CreateOutboxEmailParams struct {
+	ID        string
+	UserID    string
+	ToAddress string
+	Kind      string
+	Subject   string
+	TextBody  string
+	HtmlBody  string
+}
+
+func (q *Queries) CreateOutboxEmail(ctx context.Context, arg CreateOutboxEmailParams) error {
+	_, err := q.db.ExecContext(ctx, createOutboxEmail,
+		arg.ID,
+		arg.UserID,
+		arg.ToAddress,
+		arg.Kind,
+		arg.Subject,
+		arg.TextBody,
+		arg.HtmlBody,
+	)
+	return err
+}
+
+const createWebhook = `-- name: CreateWebhook :one
+INSERT INTO notificationservice_webhooks (
+    id, tenant_id, url, secret, event_types, created_by
+) VALUES (
+    ?1, ?2, ?3, ?4, ?5, ?6
+) RETURNING id, tenant_id, url, secret, event_types, is_active, created_by, created_at, updated_at
+`
+
+type CreateWebhookParams struct {
+	ID         string
+	TenantID   string
+	Url        string
+	Secret     string
+	EventTypes string
+	CreatedBy  string
+}
+
+func (q *Queries) CreateWebhook(ctx context.Context, arg CreateWebhookParams) (NotificationserviceWebhook, error) {
+	row := q.db.QueryRowContext(ctx, createWebhook,
+		arg.ID,
+		arg.TenantID,
+		arg.Url,
+		arg.Secret,
+		arg.EventTypes,
+		arg.CreatedBy,
+	)
+	var i NotificationserviceWebhook
+	err := row.Scan(
+		&i.ID,
+		&i.TenantID,
+		&i.Url,
+		&i.Secret,
+		&i.EventTypes,
+		&i.IsActive,
+		&i.CreatedBy,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const createWebhookDelivery = `-- name: CreateWebhookDelivery :exec
+INSERT INTO notificationservice_webhook_deliveries (
+    id, webhook_id, tenant_id, event_id, event_type, payload
+) VALUES (
+    ?1, ?2, ?3, ?4, ?5, ?6
+)
+`
+
+type CreateWebhookDeliveryParams struct {
+	ID        string
+	WebhookID string
+	TenantID  string
+	EventID   string
+	EventType string
+	Payload   string
+}
+
+func (q *Queries) CreateWebhookDelivery(ctx context.Context, arg CreateWebhookDeliveryParams) error {
+	_, err := q.db.ExecContext(ctx, createWebhookDelivery,
+		arg.ID,
+		arg.WebhookID,
+		arg.TenantID,
+		arg.EventID,
+		arg.EventType,
+		arg.Payload,
+	)
+	return err
+}
+
+const deleteWebhook = `-- name: DeleteWebhook :execrows
+DELETE FROM notificationservice_webhooks
+WHERE id = ?1 AND tenant_id = ?2
+`
+
+type DeleteWebhookParams struct {
+	ID       string
+	TenantID string
+}
+
+func (q *Queries) DeleteWebhook(ctx context.Context, arg DeleteWebhookParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, deleteWebhook, arg.ID, arg.TenantID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const deleteWebhookDeliveries = `-- name: DeleteWebhookDeliveries :exec
+DELETE FROM notificationservice_webhook_deliveries
+WHERE webhook_id = ?1 AND tenant_id = ?2
+`
+
+type DeleteWebhookDeliveriesParams struct {
+	WebhookID string
+	TenantID  string
+}
+
+func (q *Queries) DeleteWebhookDeliveries(ctx context.Context, arg DeleteWebhookDeliveriesParams) error {
+	_, err := q.db.ExecContext(ctx, deleteWebhookDeliveries, arg.WebhookID, arg.TenantID)
+	return err
+}
+
+const getActiveWebhooksByTenantID = `-- name: GetActiveWebhooksByTenantID :many
+SELECT id, tenant_id, url, secret, event_types, is_active, created_by, created_at, updated_at FROM notificationservice_webhooks
+WHERE tenant_id = ?1 AND is_active = 1
+`
+
+func (q *Queries) GetActiveWebhooksByTenantID(ctx context.Context, tenantID string) ([]NotificationserviceWebhook, error) {
+	rows, err := q.db.QueryContext(ctx, getActiveWebhooksByTenantID, tenantID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []NotificationserviceWebhook
+	for rows.Next() {
+		var i NotificationserviceWebhook
+		if err := rows.Scan(
+			&i.ID,
+			&i.TenantID,
+			&i.Url,
+			&i.Secret,
+			&i.EventTypes,
+			&i.IsActive,
+			&i.CreatedBy,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getDigestNotifications = `-- name: GetDigestNotifications :many
+SELECT n.id, n.tenant_id, n.user_id, n.type, n.actor_id, n.actor_name, n.message, n.video_id, n.channel_id, n.comment_id, n.is_read, n.read_at, n.created_at FROM notificationservice_notifications n
+LEFT JOIN notificationservice_email_preferences p ON p.user_id = n.user_id
+WHERE n.user_id = ?1
+  AND n.type = 'channel_video'
+  AND n.is_read = 0
+  AND n.created_at > COALESCE(p.last_digest_at, datetime('now', '-7 days'))
+ORDER BY n.created_at DESC
+LIMIT ?2
+`
+
+type GetDigestNotificationsParams struct {
+	UserID   string
+	MaxItems int64
+}
+
+func (q *Queries) GetDigestNotifications(ctx context.Context, arg GetDigestNotificationsParams) ([]NotificationserviceNotification, error) {
+	rows, err := q.db.QueryContext(ctx, getDigestNotifications, arg.UserID, arg.MaxItems)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []NotificationserviceNotification
+	for rows.Next() {
+		var i NotificationserviceNotification
+		if err := rows.Scan(
+			&i.ID,
+			&i.TenantID,
+			&i.UserID,
+			&i.Type,
+			&i.ActorID,
+			&i.ActorName,
+			&i.Message,
+			&i.VideoID,
+			&i.ChannelID,
+			&i.CommentID,
+			&i.IsRead,
+			&i.ReadAt,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getDueDigestUsers = `-- name: GetDueDigestUsers :many
+SELECT n.user_id
+FROM notificationservice_notifications n
+LEFT JOIN notificationservice_email_preferences p ON p.user_id = n.user_id
+WHERE n.type = 'channel_video'
+  AND n.is_read = 0
+  AND COALESCE(p.digest_frequency, 'weekly') != 'off'
+  AND n.created_at > COALESCE(p.last_digest_at, datetime('now', '-7 days'))
+  AND (
+    p.last_digest_at IS NULL
+    OR p.last_digest_at <= datetime('now', CASE p.digest_frequency WHEN 'daily' THEN '-1 day' ELSE '-7 days' END)
+  )
+GROUP BY n.user_id
+LIMIT ?1
+`
+
+// Users with unread channel videos whose digest period has passed.
+// A user's first digest goes out as soon as there is something in it.
+func (q *Queries) GetDueDigestUsers(ctx context.Context, maxUsers int64) ([]string, error) {
+	rows, err := q.db.QueryContext(ctx, getDueDigestUsers, maxUsers)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []string
+	for rows.Next() {
+		var user_id string
+		if err := rows.Scan(&user_id); err != nil {
+			return nil, err
+		}
+		items = append(items, user_id)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getDueOutboxEmails = `-- name: GetDueOutboxEmails :many
+SELECT id, user_id, to_address, kind, subject, text_body, html_body, status, attempts, last_error, next_attempt_at, created_at, sent_at FROM notificationservice_email_outbox
+WHERE status = 'pending' AND next_attempt_at <= CURRENT_TIMESTAMP
+ORDER BY next_attempt_at ASC
+LIMIT ?1
+`
+
+func (q *Queries) GetDueOutboxEmails(ctx context.Context, maxEmails int64) ([]NotificationserviceEmailOutbox, error) {
+	rows, err := q.db.QueryContext(ctx, getDueOutboxEmails, maxEmails)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []NotificationserviceEmailOutbox
+	for rows.Next() {
+		var i NotificationserviceEmailOutbox
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.ToAddress,
+			&i.Kind,
+			&i.Subject,
+			&i.TextBody,
+			&i.HtmlBody,
+			&i.Status,
+			&i.Attempts,
+			&i.LastError,
+			&i.NextAttemptAt,
+			&i.CreatedAt,
+			&i.SentAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getDueWebhookDeliveries = `-- name: GetDueWebhookDeliveries :many
+SELECT d.id, d.webhook_id, d.event_type, d.payload, d.attempts, w.url, w.secret
+FROM notificationservice_webhook_deliveries d
+JOIN notificationservice_webhooks w ON w.id = d.webhook_id
+WHERE d.status = 'pending'
+  AND d.next_attempt_at <= CURRENT_TIMESTAMP
+  AND w.is_active = 1
+ORDER BY d.next_attempt_at ASC
+LIMIT ?1
+`
+
+type GetDueWebhookDeliveriesRow struct {
+	ID        string
+	WebhookID string
+	EventType string
+	Payload   string
+	Attempts  int64
+	Url       string
+	Secret    string
+}
+
+// Deliveries of inactive webhooks wait until the webhook is activated again
+func (q *Queries) GetDueWebhookDeliveries(ctx context.Context, maxDeliveries int64) ([]GetDueWebhookDeliveriesRow, error) {
+	rows, err := q.db.QueryContext(ctx, getDueWebhookDeliveries, maxDeliveries)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetDueWebhookDeliveriesRow
+	for rows.Next() {
+		var i GetDueWebhookDeliveriesRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.WebhookID,
+			&i.EventType,
+			&i.Payload,
+			&i.Attempts,
+			&i.Url,
+			&i.Secret,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getEmailPreferences = `-- name: GetEmailPreferences :one
+SELECT user_id, email_replies, email_mentions, email_invitations, digest_frequency, last_digest_at, updated_at FROM notificationservice_email_preferences
+WHERE user_id = ?1
+`
+
+func (q *Queries) GetEmailPreferences(ctx context.Context, userID string) (NotificationserviceEmailPreference, error) {
+	row := q.db.QueryRowContext(ctx, getEmailPreferences, userID)
+	var i NotificationserviceEmailPreference
+	err := row.Scan(
+		&i.UserID,
+		&i.EmailReplies,
+		&i.EmailMentions,
+		&i.EmailInvitations,
+		&i.DigestFrequency,
+		&i.LastDigestAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const getEmailPreferencesByUserIDs = `-- name: GetEmailPreferencesByUserIDs :many
+SELECT user_id, email_replies, email_mentions, email_invitations, digest_frequency, last_digest_at, updated_at FROM notificationservice_email_preferences
+WHERE user_id IN (/*SLICE:user_ids*/?)
+`
+
+func (q *Queries) GetEmailPreferencesByUserIDs(ctx context.Context, userIds []string) ([]NotificationserviceEmailPreference, error) {
+	query := getEmailPreferencesByUserIDs
+	var queryParams []interface{}
+	if len(userIds) > 0 {
+		for _, v := range userIds {
+			queryParams = append(queryParams, v)
+		}
+		query = strings.Replace(query, "/*SLICE:user_ids*/?", strings.Repeat(",?", len(userIds))[1:], 1)
+	} else {
+		query = strings.Replace(query, "/*SLICE:user_ids*/?", "NULL", 1)
+	}
+	rows, err := q.db.QueryContext(ctx, query, queryParams...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []NotificationserviceEmailPreference
+	for rows.Next() {
+		var i NotificationserviceEmailPreference
+		if err := rows.Scan(
+			&i.UserID,
+			&i.EmailReplies,
+			&i.EmailMentions,
+			&i.EmailInvitations,
+			&i.DigestFrequency,
+			&i.LastDigestAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getNotificationsPaginated = `-- name: GetNotificationsPaginated :many
+SELECT id, tenant_id, user_id, type, actor_id, actor_name, message, video_id, channel_id, comment_id, is_read, read_at, created_at FROM notificationservice_notifications
+WHERE tenant_id = ?1
+  AND user_id = ?2
+  AND (CAST(?3 AS BOOLEAN) = 0 OR is_read = 0)
+ORDER BY created_at DESC, id DESC
+LIMIT ?5 OFFSET (?4 * ?5)
+`
+
+type GetNotificationsPaginatedParams struct {
+	TenantID   string
+	UserID     string
+	UnreadOnly bool
+	PageNumber interface{}
+	PageSize   int64
+}
+
+func (q *Queries) GetNotificationsPaginated(ctx context.Context, arg GetNotificationsPaginatedParams) ([]NotificationserviceNotification, error) {
+	rows, err := q.db.QueryContext(ctx, getNotificationsPaginated,
+		arg.TenantID,
+		arg.UserID,
+		arg.UnreadOnly,
+		arg.PageNumber,
+		arg.PageSize,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []NotificationserviceNotification
+	for rows.Next() {
+		var i NotificationserviceNotification
+		if err := rows.Scan(
+			&i.ID,
+			&i.TenantID,
+			&i.UserID,
+			&i.Type,
+			&i.ActorID,
+			&i.ActorName,
+			&i.Message,
+			&i.VideoID,
+			&i.ChannelID,
+			&i.CommentID,
+			&i.IsRead,
+			&i.ReadAt,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getWebhookByIDAndTenantID = `-- name: GetWebhookByIDAndTenantID :one
+SELECT id, tenant_id, url, secret, event_types, is_active, created_by, created_at, updated_at FROM notificationservice_webhooks
+WHERE id = ?1 AND tenant_id = ?2
+`
+
+type GetWebhookByIDAndTenantIDParams struct {
+	ID       string
+	TenantID string
+}
+
+func (q *Queries) GetWebhookByIDAndTenantID(ctx context.Context, arg GetWebhookByIDAndTenantIDParams) (NotificationserviceWebhook, error) {
+	row := q.db.QueryRowContext(ctx, getWebhookByIDAndTenantID, arg.ID, arg.TenantID)
+	var i NotificationserviceWebhook
+	err := row.Scan(
+		&i.ID,
+		&i.TenantID,
+		&i.Url,
+		&i.Secret,
+		&i.EventTypes,
+		&i.IsActive,
+		&i.CreatedBy,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const getWebhookDeliveriesPaginated = `-- name: GetWebhookDeliveriesPaginated :many
+SELECT id, webhook_id, tenant_id, event_id, event_type, payload, status, attempts, response_status, last_error, next_attempt_at, created_at, delivered_at FROM notificationservice_webhook_deliveries
+WHERE webhook_id = ?1 AND tenant_id = ?2
+ORDER BY created_at DESC, id DESC
+LIMIT ?4 OFFSET (?3 * ?4)
+`
+
+type GetWebhookDeliveriesPaginatedParams struct {
+	WebhookID  string
+	TenantID   string
+	PageNumber interface{}
+	PageSize   int64
+}
+
+func (q *Queries) GetWebhookDeliveriesPaginated(ctx context.Context, arg GetWebhookDeliveriesPaginatedParams) ([]NotificationserviceWebhookDelivery, error) {
+	rows, err := q.db.QueryContext(ctx, getWebhookDeliveriesPaginated,
+		arg.WebhookID,
+		arg.TenantID,
+		arg.PageNumber,
+		arg.PageSize,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []NotificationserviceWebhookDelivery
+	for rows.Next() {
+		var i NotificationserviceWebhookDelivery
+		if err := rows.Scan(
+			&i.ID,
+			&i.WebhookID,
+			&i.TenantID,
+			&i.EventID,
+			&i.EventType,
+			&i.Payload,
+			&i.Status,
+			&i.Attempts,
+			&i.ResponseStatus,
+			&i.LastError,
+			&i.NextAttemptAt,
+			&i.CreatedAt,
+			&i.DeliveredAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getWebhookDeliveryByID = `-- name: GetWebhookDeliveryByID :one
+SELECT id, webhook_id, tenant_id, event_id, event_type, payload, status, attempts, response_status, last_error, next_attempt_at, created_at, delivered_at FROM notificationservice_webhook_deliveries
+WHERE id = ?1
+`
+
+func (q *Queries) GetWebhookDeliveryByID(ctx context.Context, id string) (NotificationserviceWebhookDelivery, error) {
+	row := q.db.QueryRowContext(ctx, getWebhookDeliveryByID, id)
+	var i NotificationserviceWebhookDelivery
+	err := row.Scan(
+		&i.ID,
+		&i.WebhookID,
+		&i.TenantID,
+		&i.EventID,
+		&i.EventType,
+		&i.Payload,
+		&i.Status,
+		&i.Attempts,
+		&i.ResponseStatus,
+		&i.LastError,
+		&i.NextAttemptAt,
+		&i.CreatedAt,
+		&i.DeliveredAt,
+	)
+	return i, err
+}
+
+const getWebhooksByTenantID = `-- name: GetWebhooksByTenantID :many
+SELECT id, tenant_id, url, secret, event_types, is_active, created_by, created_at, updated_at FROM notificationservice_webhooks
+WHERE tenant_id = ?1
+ORDER BY created_at ASC, id ASC
+`
+
+func (q *Queries) GetWebhooksByTenantID(ctx context.Context, tenantID string) ([]NotificationserviceWebhook, error) {
+	rows, err := q.db.QueryContext(ctx, getWebhooksByTenantID, tenantID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []NotificationserviceWebhook
+	for rows.Next() {
+		var i NotificationserviceWebhook
+		if err := rows.Scan(
+			&i.ID,
+			&i.TenantID,
+			&i.Url,
+			&i.Secret,
+			&i.EventTypes,
+			&i.IsActive,
+			&i.CreatedBy,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const markAllNotificationsRead = `-- name: MarkAllNotificationsRead :execrows
+UPDATE notificationservice_notifications
+SET is_read = 1, read_at = CURRENT_TIMESTAMP
+WHERE tenant_id = ?1 AND user_id = ?2 AND is_read = 0
+`
+
+type MarkAllNotificationsReadParams struct {
+	TenantID string
+	UserID   string
+}
+
+func (q *Queries) MarkAllNotificationsRead(ctx context.Context, arg MarkAllNotificationsReadParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, markAllNotificationsRead, arg.TenantID, arg.UserID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const markDigestSent = `-- name: MarkDigestSent :exec
+INSERT INTO notificationservice_email_preferences (user_id, last_digest_at)
+VALUES (?1, CURRENT_TIMESTAMP)
+ON CONFLICT (user_id) DO UPDATE SET last_digest_at = CURRENT_TIMESTAMP
+`
+
+func (q *Queries) MarkDigestSent(ctx context.Context, userID string) error {
+	_, err := q.db.ExecContext(ctx, markDigestSent, userID)
+	return err
+}
+
+const markNotificationsRead = `-- name: MarkNotificationsRead :execrows
+UPDATE notificationservice_notifications
+SET is_read = 1, read_at = CURRENT_TIMESTAMP
+WHERE tenant_id = ?1
+  AND user_id = ?2
+  AND is_read = 0
+  AND id IN (/*SLICE:ids*/?)
+`
+
+type MarkNotificationsReadParams struct {
+	TenantID string
+	UserID   string
+	Ids      []string
+}
+
+func (q *Queries) MarkNotificationsRead(ctx context.Context, arg MarkNotificationsReadParams) (int64, error) {
+	query := markNotificationsRead
+	var queryParams []interface{}
+	queryParams = append(queryParams, arg.TenantID)
+	queryParams = append(queryParams, arg.UserID)
+	if len(arg.Ids) > 0 {
+		for _, v := range arg.Ids {
+			queryParams = append(queryParams, v)
+		}
+		query = strings.Replace(query, "/*SLICE:ids*/?", strings.Repeat(",?", len(arg.Ids))[1:], 1)
+	} else {
+		query = strings.Replace(query, "/*SLICE:ids*/?", "NULL", 1)
+	}
+	result, err := q.db.ExecContext(ctx, query, queryParams...)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const markOutboxEmailFailed = `-- name: MarkOutboxEmailFailed :exec
+UPDATE notificationservice_email_outbox
+SET attempts = attempts + 1,
+    last_error = ?1,
+    status = CASE WHEN attempts + 1 >= CAST(?2 AS INTEGER) THEN 'failed' ELSE 'pending' END,
+    next_attempt_at = datetime('now', '+' || CAST(?3 AS INTEGER) || ' seconds')
+WHERE id = ?4
+`
+
+type MarkOutboxEmailFailedParams struct {
+	LastError         sql.NullString
+	MaxAttempts       int64
+	RetryAfterSeconds int64
+	ID                string
+}
+
+// Records a failed attempt, the email is given up on after max_attempts
+func (q *Queries) MarkOutboxEmailFailed(ctx context.Context, arg MarkOutboxEmailFailedParams) error {
+	_, err := q.db.ExecContext(ctx, markOutboxEmailFailed,
+		arg.LastError,
+		arg.MaxAttempts,
+		arg.RetryAfterSeconds,
+		arg.ID,
+	)
+	return err
+}
+
+const markOutboxEmailSent = `-- name: MarkOutboxEmailSent :exec
+UPDATE notificationservice_email_outbox
+SET status = 'sent', attempts = attempts + 1, last_error = NULL, sent_at = CURRENT_TIMESTAMP
+WHERE id = ?1
+`
+
+func (q *Queries) MarkOutboxEmailSent(ctx context.Context, id string) error {
+	_, err := q.db.ExecContext(ctx, markOutboxEmailSent, id)
+	return err
+}
+
+const markWebhookDeliveryFailed = `-- name: MarkWebhookDeliveryFailed :exec
+UPDATE notificationservice_webhook_deliveries
+SET attempts = attempts + 1,
+    response_status = ?1,
+    last_error = ?2,
+    status = CASE WHEN attempts + 1 >= CAST(?3 AS INTEGER) THEN 'failed' ELSE 'pending' END,
+    next_attempt_at = datetime('now', '+' || CAST(?4 AS INTEGER) || ' seconds')
+WHERE id = ?5
+`
+
+type MarkWebhookDeliveryFailedParams struct {
+	ResponseStatus    sql.NullInt64
+	LastError         sql.NullString
+	MaxAttempts       int64
+	RetryAfterSeconds int64
+	ID                string
+}
+
+// Records a failed attempt, the delivery is given up on after max_attempts
+func (q *Queries) MarkWebhookDeliveryFailed(ctx context.Context, arg MarkWebhookDeliveryFailedParams) error {
+	_, err := q.db.ExecContext(ctx, markWebhookDeliveryFailed,
+		arg.ResponseStatus,
+		arg.LastError,
+		arg.MaxAttempts,
+		arg.RetryAfterSeconds,
+		arg.ID,
+	)
+	return err
+}
+
+const markWebhookDeliverySucceeded = `-- name: MarkWebhookDeliverySucceeded :exec
+UPDATE notificationservice_webhook_deliveries
+SET status = 'succeeded',
+    attempts = attempts + 1,
+    response_status = ?1,
+    last_error = NULL,
+    delivered_at = CURRENT_TIMESTAMP
+WHERE id = ?2
+`
+
+type MarkWebhookDeliverySucceededParams struct {
+	ResponseStatus sql.NullInt64
+	ID             string
+}
+
+func (q *Queries) MarkWebhookDeliverySucceeded(ctx context.Context, arg MarkWebhookDeliverySucceededParams) error {
+	_, err := q.db.ExecContext(ctx, markWebhookDeliverySucceeded, arg.ResponseStatus, arg.ID)
+	return err
+}
+
+const updateWebhook = `-- name: UpdateWebhook :one
+UPDATE notificationservice_webhooks
+SET url = ?1, event_types = ?2, is_active = ?3, updated_at = CURRENT_TIMESTAMP
+WHERE id = ?4 AND tenant_id = ?5
+RETURNING id, tenant_id, url, secret, event_types, is_active, created_by, created_at, updated_at
+`
+
+type UpdateWebhookParams struct {
+	Url        string
+	EventTypes string
+	IsActive   bool
+	ID         string
+	TenantID   string
+}
+
+func (q *Queries) UpdateWebhook(ctx context.Context, arg UpdateWebhookParams) (NotificationserviceWebhook, error) {
+	row := q.db.QueryRowContext(ctx, updateWebhook,
+		arg.Url,
+		arg.EventTypes,
+		arg.IsActive,
+		arg.ID,
+		arg.TenantID,
+	)
+	var i NotificationserviceWebhook
+	err := row.Scan(
+		&i.ID,
+		&i.TenantID,
+		&i.Url,
+		&i.Secret,
+		&i.EventTypes,
+		&i.IsActive,
+		&i.CreatedBy,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const upsertEmailPreferences = `-- name: UpsertEmailPreferences :one
+INSERT INTO notificationservice_email_preferences (
+    user_id, email_replies, email_mentions, email_invitations, digest_frequency
+) VALUES (
+    ?1, ?2, ?3, ?4, ?5
+)
+ON CONFLICT (user_id) DO UPDATE SET
+    email_replies = excluded.email_replies,
+    email_mentions = excluded.email_mentions,
+    email_invitations = excluded.email_invitations,
+    digest_frequency = excluded.digest_frequency,
+    updated_at = CURRENT_TIMESTAMP
+RETURNING user_id, email_replies, email_mentions, email_invitations, digest_frequency, last_digest_at, updated_at
+`
+
+type UpsertEmailPreferencesParams struct {
+	UserID           string
+	EmailReplies     bool
+	EmailMentions    bool
+	EmailInvitations bool
+	DigestFrequency  string
+}
+
+func (q *Queries) UpsertEmailPreferences(ctx context.Context, arg UpsertEmailPreferencesParams) (NotificationserviceEmailPreference, error) {
+	row := q.db.QueryRowContext(ctx, upsertEmailPreferences,
+		arg.UserID,
+		arg.EmailReplies,
+		arg.EmailMentions,
+		arg.EmailInvitations,
+		arg.DigestFrequency,
+	)
+	var i NotificationserviceEmailPreference
+	err := row.Scan(
+		&i.UserID,
+		&i.EmailReplies,
+		&i.EmailMentions,
+		&i.EmailInvitations,
+		&i.DigestFrequency,
+		&i.LastDigestAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
@@ -0,0 +1,197 @@
+-- name: CreateNotification :exec
+INSERT INTO notificationservice_notifications (
+    id, tenant_id, user_id, type, actor_id, actor_name, message, video_id, channel_id, comment_id
+) VALUES (
+    @id, @tenant_id, @user_id, @type, @actor_id, @actor_name, @message, @video_id, @channel_id, @comment_id
+);
+
+-- name: GetNotificationsPaginated :many
+SELECT * FROM notificationservice_notifications
+WHERE tenant_id = @tenant_id
+  AND user_id = @user_id
+  AND (CAST(@unread_only AS BOOLEAN) = 0 OR is_read = 0)
+ORDER BY created_at DESC, id DESC
+LIMIT @page_size OFFSET (@page_number * @page_size);
+
+-- name: CountUnreadNotifications :one
+SELECT COUNT(*) FROM notificationservice_notifications
+WHERE tenant_id = @tenant_id AND user_id = @user_id AND is_read = 0;
+
+-- name: MarkNotificationsRead :execrows
+UPDATE notificationservice_notifications
+SET is_read = 1, read_at = CURRENT_TIMESTAMP
+WHERE tenant_id = @tenant_id
+  AND user_id = @user_id
+  AND is_read = 0
+  AND id IN (sqlc.slice(ids));
+
+-- name: MarkAllNotificationsRead :execrows
+UPDATE notificationservice_notifications
+SET is_read = 1, read_at = CURRENT_TIMESTAMP
+WHERE tenant_id = @tenant_id AND user_id = @user_id AND is_read = 0;
+
+-- name: GetEmailPreferences :one
+SELECT * FROM notificationservice_email_preferences
+WHERE user_id = @user_id;
+
+-- name: GetEmailPreferencesByUserIDs :many
+SELECT * FROM notificationservice_email_preferences
+WHERE user_id IN (sqlc.slice(user_ids));
+
+-- name: UpsertEmailPreferences :one
+INSERT INTO notificationservice_email_preferences (
+    user_id, email_replies, email_mentions, email_invitations, digest_frequency
+) VALUES (
+    @user_id, @email_replies, @email_mentions, @email_invitations, @digest_frequency
+)
+ON CONFLICT (user_id) DO UPDATE SET
+    email_replies = excluded.email_replies,
+    email_mentions = excluded.email_mentions,
+    email_invitations = excluded.email_invitations,
+    digest_frequency = excluded.digest_frequency,
+    updated_at = CURRENT_TIMESTAMP
+RETURNING *;
+
+-- name: MarkDigestSent :exec
+INSERT INTO notificationservice_email_preferences (user_id, last_digest_at)
+VALUES (@user_id, CURRENT_TIMESTAMP)
+ON CONFLICT (user_id) DO UPDATE SET last_digest_at = CURRENT_TIMESTAMP;
+
+-- Users with unread channel videos whose digest period has passed.
+-- A user's first digest goes out as soon as there is something in it.
+-- name: GetDueDigestUsers :many
+SELECT n.user_id
+FROM notificationservice_notifications n
+LEFT JOIN notificationservice_email_preferences p ON p.user_id = n.user_id
+WHERE n.type = 'channel_video'
+  AND n.is_read = 0
+  AND COALESCE(p.digest_frequency, 'weekly') != 'off'
+  AND n.created_at > COALESCE(p.last_digest_at, datetime('now', '-7 days'))
+  AND (
+    p.last_digest_at IS NULL
+    OR p.last_digest_at <= datetime('now', CASE p.digest_frequency WHEN 'daily' THEN '-1 day' ELSE '-7 days' END)
+  )
+GROUP BY n.user_id
+LIMIT @max_users;
+
+-- name: GetDigestNotifications :many
+SELECT n.* FROM notificationservice_notifications n
+LEFT JOIN notificationservice_email_preferences p ON p.user_id = n.user_id
+WHERE n.user_id = @user_id
+  AND n.type = 'channel_video'
+  AND n.is_read = 0
+  AND n.created_at > COALESCE(p.last_digest_at, datetime('now', '-7 days'))
+ORDER BY n.created_at DESC
+LIMIT @max_items;
+
+-- name: CreateOutboxEmail :exec
+INSERT INTO notificationservice_email_outbox (
+    id, user_id, to_address, kind, subject, text_body, html_body
+) VALUES (
+    @id, @user_id, @to_address, @kind, @subject, @text_body, @html_body
+);
+
+-- name: GetDueOutboxEmails :many
+SELECT * FROM notificationservice_email_outbox
+WHERE status = 'pending' AND next_attempt_at <= CURRENT_TIMESTAMP
+ORDER BY next_attempt_at ASC
+LIMIT @max_emails;
+
+-- name: MarkOutboxEmailSent :exec
+UPDATE notificationservice_email_outbox
+SET status = 'sent', attempts = attempts + 1, last_error = NULL, sent_at = CURRENT_TIMESTAMP
+WHERE id = @id;
+
+-- Records a failed attempt, the email is given up on after max_attempts
+-- name: MarkOutboxEmailFailed :exec
+UPDATE notificationservice_email_outbox
+SET attempts = attempts + 1,
+    last_error = @last_error,
+    status = CASE WHEN attempts + 1 >= CAST(@max_attempts AS INTEGER) THEN 'failed' ELSE 'pending' END,
+    next_attempt_at = datetime('now', '+' || CAST(@retry_after_seconds AS INTEGER) || ' seconds')
+WHERE id = @id;
+
+-- name: CreateWebhook :one
+INSERT INTO notificationservice_webhooks (
+    id, tenant_id, url, secret, event_types, created_by
+) VALUES (
+    @id, @tenant_id, @url, @secret, @event_types, @created_by
+) RETURNING *;
+
+-- name: GetWebhooksByTenantID :many
+SELECT * FROM notificationservice_webhooks
+WHERE tenant_id = @tenant_id
+ORDER BY created_at ASC, id ASC;
+
+-- name: GetActiveWebhooksByTenantID :many
+SELECT * FROM notificationservice_webhooks
+WHERE tenant_id = @tenant_id AND is_active = 1;
+
+-- name: GetWebhookByIDAndTenantID :one
+SELECT * FROM notificationservice_webhooks
+WHERE id = @id AND tenant_id = @tenant_id;
+
+-- name: CountWebhooksByTenantID :one
+SELECT COUNT(*) FROM notificationservice_webhooks
+WHERE tenant_id = @tenant_id;
+
+-- name: UpdateWebhook :one
+UPDATE notificationservice_webhooks
+SET url = @url, event_types = @event_types, is_active = @is_active, updated_at = CURRENT_TIMESTAMP
+WHERE id = @id AND tenant_id = @tenant_id
+RETURNING *;
+
+-- name: DeleteWebhook :execrows
+DELETE FROM notificationservice_webhooks
+WHERE id = @id AND tenant_id = @tenant_id;
+
+-- name: DeleteWebhookDeliveries :exec
+DELETE FROM notificationservice_webhook_deliveries
+WHERE webhook_id = @webhook_id AND tenant_id = @tenant_id;
+
+-- name: CreateWebhookDelivery :exec
+INSERT INTO notificationservice_webhook_deliveries (
+    id, webhook_id, tenant_id, event_id, event_type, payload
+) VALUES (
+    @id, @webhook_id, @tenant_id, @event_id, @event_type, @payload
+);
+
+-- Deliveries of inactive webhooks wait until the webhook is activated again
+-- name: GetDueWebhookDeliveries :many
+SELECT d.id, d.webhook_id, d.event_type, d.payload, d.attempts, w.url, w.secret
+FROM notificationservice_webhook_deliveries d
+JOIN notificationservice_webhooks w ON w.id = d.webhook_id
+WHERE d.status = 'pending'
+  AND d.next_attempt_at <= CURRENT_TIMESTAMP
+  AND w.is_active = 1
+ORDER BY d.next_attempt_at ASC
+LIMIT @max_deliveries;
+
+-- name: GetWebhookDeliveryByID :one
+SELECT * FROM notificationservice_webhook_deliveries
+WHERE id = @id;
+
+-- name: GetWebhookDeliveriesPaginated :many
+SELECT * FROM notificationservice_webhook_deliveries
+WHERE webhook_id = @webhook_id AND tenant_id = @tenant_id
+ORDER BY created_at DESC, id DESC
+LIMIT @page_size OFFSET (@page_number * @page_size);
+
+-- name: MarkWebhookDeliverySucceeded :exec
+UPDATE notificationservice_webhook_deliveries
+SET status = 'succeeded',
+    attempts = attempts + 1,
+    response_status = @response_status,
+    last_error = NULL,
+    delivered_at = CURRENT_TIMESTAMP
+WHERE id = @id;
+
+-- Records a failed attempt, the delivery is given up on after max_attempts
+-- name: MarkWebhookDeliveryFailed :exec
+UPDATE notificationservice_webhook_deliveries
+SET attempts = attempts + 1,
+    response_status = @response_status,
+    last_error = @last_error,
+    status = CASE WHEN attempts + 1 >= CAST(@max_attempts AS INTEGER) THEN 'failed' ELSE 'pending' END,
+    next_attempt_at = datetime('now', '+' || CAST(@retry_after_seconds AS INTEGER) || ' seconds')
+WHERE id = @id;
@@ -0,0 +1,3 @@
+module sortedstartup.com/stream/notificationservice
+
+go 1.23.5
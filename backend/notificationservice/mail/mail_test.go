@@ -0,0 +1,208 @@
+package mail
+
+import (
+	"bufio"
+	"context"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net"
+	netmail "net/mail"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"sortedstartup.com/stream/notificationservice/config"
+)
+
+// fakeSMTPServer speaks just enough SMTP to accept one message per connection
+type fakeSMTPServer struct {
+	listener net.Listener
+	received chan receivedMail
+}
+
+type receivedMail struct {
+	from string
+	to   []string
+	data string
+}
+
+func newFakeSMTPServer(t *testing.T, rejectRecipients bool) *fakeSMTPServer {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("failed to listen: %v", err)
+	}
+	server := &fakeSMTPServer{listener: listener, received: make(chan receivedMail, 1)}
+	t.Cleanup(func() { listener.Close() })
+
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go server.serve(conn, rejectRecipients)
+		}
+	}()
+
+	return server
+}
+
+func (s *fakeSMTPServer) serve(conn net.Conn, rejectRecipients bool) {
+	defer conn.Close()
+	reader := bufio.NewReader(conn)
+	reply := func(line string) { io.WriteString(conn, line+"\r\n") }
+
+	var mail receivedMail
+	reply("220 localhost fake smtp")
+	for {
+		line, err := reader.ReadString('\n')
+		if err != nil {
+			return
+		}
+		command := strings.ToUpper(strings.TrimSpace(line))
+		switch {
+		case strings.HasPrefix(command, "EHLO"), strings.HasPrefix(command, "HELO"):
+			reply("250 localhost")
+		case strings.HasPrefix(command, "MAIL FROM:"):
+			mail.from = strings.Trim(strings.TrimSpace(line)[len("MAIL FROM:"):], "<>")
+			reply("250 ok")
+		case strings.HasPrefix(command, "RCPT TO:"):
+			if rejectRecipients {
+				reply("550 no such user")
+				continue
+			}
+			mail.to = append(mail.to, strings.Trim(strings.TrimSpace(line)[len("RCPT TO:"):], "<>"))
+			reply("250 ok")
+		case command == "DATA":
+			reply("354 go ahead")
+			var data strings.Builder
+			for {
+				dataLine, err := reader.ReadString('\n')
+				if err != nil {
+					return
+				}
+				if dataLine == ".\r\n" {
+					break
+				}
+				data.WriteString(dataLine)
+			}
+			mail.data = data.String()
+			s.received <- mail
+			reply("250 queued")
+		case command == "QUIT":
+			reply("221 bye")
+			return
+		default:
+			reply("502 not implemented")
+		}
+	}
+}
+
+func (s *fakeSMTPServer) config() config.SMTPConfig {
+	addr := s.listener.Addr().(*net.TCPAddr)
+	return config.SMTPConfig{
+		Host: "127.0.0.1",
+		Port: addr.Port,
+		From: "Stream <no-reply@example.com>",
+	}
+}
+
+func TestSMTPMailer_Send(t *testing.T) {
+	server := newFakeSMTPServer(t, false)
+	mailer, err := NewSMTPMailer(server.config())
+	assert.NoError(t, err)
+
+	err = mailer.Send(context.Background(), Message{
+		To:      "alice@example.com",
+		Subject: "Bob replied\r\nBcc: everyone@example.com",
+		Text:    "Bob replied to your comment.",
+		HTML:    "<p>Bob replied to your comment.</p>",
+	})
+	assert.NoError(t, err)
+
+	mail := <-server.received
+	assert.Equal(t, "no-reply@example.com", mail.from)
+	assert.Equal(t, []string{"alice@example.com"}, mail.to)
+
+	parsed, err := netmail.ReadMessage(strings.NewReader(mail.data))
+	assert.NoError(t, err)
+	assert.Equal(t, "Bob replied Bcc: everyone@example.com", parsed.Header.Get("Subject"))
+	assert.Empty(t, parsed.Header.Get("Bcc"))
+
+	mediaType, params, err := mime.ParseMediaType(parsed.Header.Get("Content-Type"))
+	assert.NoError(t, err)
+	assert.Equal(t, "multipart/alternative", mediaType)
+
+	// multipart.Reader decodes quoted-printable parts
+	var bodies []string
+	parts := multipart.NewReader(parsed.Body, params["boundary"])
+	for {
+		part, err := parts.NextPart()
+		if err == io.EOF {
+			break
+		}
+		assert.NoError(t, err)
+		body, _ := io.ReadAll(part)
+		bodies = append(bodies, part.Header.Get("Content-Type")+": "+string(body))
+	}
+	assert.Equal(t, []string{
+		"text/plain; charset=utf-8: Bob replied to your comment.",
+		"text/html; charset=utf-8: <p>Bob replied to your comment.</p>",
+	}, bodies)
+}
+
+func TestSMTPMailer_RejectedRecipient(t *testing.T) {
+	server := newFakeSMTPServer(t, true)
+	mailer, err := NewSMTPMailer(server.config())
+	assert.NoError(t, err)
+
+	err = mailer.Send(context.Background(), Message{To: "nobody@example.com", Subject: "hi", Text: "hi", HTML: "hi"})
+
+	assert.ErrorContains(t, err, "550")
+}
+
+func TestNewSMTPMailer_InvalidConfig(t *testing.T) {
+	_, err := NewSMTPMailer(config.SMTPConfig{From: "no-reply@example.com"})
+	assert.Error(t, err)
+
+	_, err = NewSMTPMailer(config.SMTPConfig{Host: "localhost", Port: 25, From: "not an address"})
+	assert.Error(t, err)
+}
+
+func TestRender(t *testing.T) {
+	content, err := Render(KindMention, Data{
+		ActorName:    "<b>Bob</b>",
+		Message:      "<b>Bob</b> mentioned you in a comment",
+		Link:         "https://stream.example.com/video/v-1",
+		SettingsLink: "https://stream.example.com/settings",
+	})
+	assert.NoError(t, err)
+
+	assert.Equal(t, "<b>Bob</b> mentioned you", content.Subject)
+	assert.Contains(t, content.Text, "<b>Bob</b> mentioned you in a comment.")
+	assert.Contains(t, content.Text, "View the comment: https://stream.example.com/video/v-1")
+	// Names are escaped in the HTML part
+	assert.Contains(t, content.HTML, "&lt;b&gt;Bob&lt;/b&gt; mentioned you in a comment.")
+	assert.Contains(t, content.HTML, `href="https://stream.example.com/settings"`)
+}
+
+func TestRender_Digest(t *testing.T) {
+	items := []DigestItem{
+		{Message: "alice added \"Demo\" to Marketing", Link: "https://stream.example.com/video/v-1"},
+		{Message: "bob added \"Launch\" to Marketing", Link: "https://stream.example.com/video/v-2"},
+	}
+	content, err := Render(KindDigest, Data{Items: items, Link: "https://stream.example.com"})
+	assert.NoError(t, err)
+
+	assert.Equal(t, strconv.Itoa(len(items))+" new videos in your channels", content.Subject)
+	assert.Contains(t, content.Text, "- bob added \"Launch\" to Marketing\n  https://stream.example.com/video/v-2")
+	assert.Contains(t, content.HTML, `<li><a href="https://stream.example.com/video/v-1">alice added &#34;Demo&#34; to Marketing</a></li>`)
+}
+
+func TestRender_UnknownKind(t *testing.T) {
+	_, err := Render("unknown", Data{})
+	assert.Error(t, err)
+}
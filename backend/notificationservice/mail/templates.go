@@ -0,0 +1,85 @@
+package mail
+
+import (
+	"bytes"
+	"embed"
+	"fmt"
+	htmltemplate "html/template"
+	"strings"
+	texttemplate "text/template"
+)
+
+// Template kinds, each has a <kind>.txt defining "subject" and "content" and a <kind>.html defining "content"
+const (
+	KindReply            = "reply"
+	KindMention          = "mention"
+	KindTenantInvitation = "tenant_invitation"
+	KindInvitation       = "invitation"
+	KindDigest           = "digest"
+)
+
+//go:embed templates
+var templateFS embed.FS
+
+type templateSet struct {
+	text *texttemplate.Template
+	html *htmltemplate.Template
+}
+
+var templates = mustParseTemplates(KindReply, KindMention, KindTenantInvitation, KindInvitation, KindDigest)
+
+// Data is what the templates can use. Items is only used by the digest.
+type Data struct {
+	ActorName    string
+	Message      string
+	Link         string
+	SettingsLink string
+	Items        []DigestItem
+}
+
+type DigestItem struct {
+	Message string
+	Link    string
+}
+
+// Content is a rendered email without a recipient
+type Content struct {
+	Subject string
+	Text    string
+	HTML    string
+}
+
+func mustParseTemplates(kinds ...string) map[string]templateSet {
+	sets := make(map[string]templateSet, len(kinds))
+	for _, kind := range kinds {
+		text := texttemplate.Must(texttemplate.ParseFS(templateFS, "templates/layout.txt", "templates/"+kind+".txt"))
+		html := htmltemplate.Must(htmltemplate.ParseFS(templateFS, "templates/layout.html", "templates/"+kind+".html"))
+		sets[kind] = templateSet{text: text, html: html}
+	}
+	return sets
+}
+
+// Render fills the templates of kind with data
+func Render(kind string, data Data) (Content, error) {
+	set, ok := templates[kind]
+	if !ok {
+		return Content{}, fmt.Errorf("unknown email template %q", kind)
+	}
+
+	var subject, text, html bytes.Buffer
+	if err := set.text.ExecuteTemplate(&subject, "subject", data); err != nil {
+		return Content{}, fmt.Errorf("failed to render %s subject: %w", kind, err)
+	}
+	if err := set.text.ExecuteTemplate(&text, "layout", data); err != nil {
+		return Content{}, fmt.Errorf("failed to render %s text: %w", kind, err)
+	}
+	if err := set.html.ExecuteTemplate(&html, "layout", data); err != nil {
+		return Content{}, fmt.Errorf("failed to render %s html: %w", kind, err)
+	}
+
+	return Content{
+		Subject: singleLine(subject.String()),
+		Text:    strings.TrimSpace(text.String()) + "\n",
+		HTML:    html.String(),
+	}, nil
+}
@@ -0,0 +1,1059 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: notificationservice/proto/notificationservice_grpc.pb.go
+
+// Package proto is a generated GoMock package.
+package proto
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	grpc "google.golang.org/grpc"
+	metadata "google.golang.org/grpc/metadata"
+)
+
+// MockNotificationServiceClient is a mock of NotificationServiceClient interface.
+type MockNotificationServiceClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockNotificationServiceClientMockRecorder
+}
+
+// MockNotificationServiceClientMockRecorder is the mock recorder for MockNotificationServiceClient.
+type MockNotificationServiceClientMockRecorder struct {
+	mock *MockNotificationServiceClient
+}
+
+// NewMockNotificationServiceClient creates a new mock instance.
+func NewMockNotificationServiceClient(ctrl *gomock.Controller) *MockNotificationServiceClient {
+	mock := &MockNotificationServiceClient{ctrl: ctrl}
+	mock.recorder = &MockNotificationServiceClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockNotificationServiceClient) EXPECT() *MockNotificationServiceClientMockRecorder {
+	return m.recorder
+}
+
+// GetEmailPreferences mocks base method.
+func (m *MockNotificationServiceClient) GetEmailPreferences(ctx context.Context, in *GetEmailPreferencesRequest, opts ...grpc.CallOption) (*EmailPreferences, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetEmailPreferences", varargs...)
+	ret0, _ := ret[0].(*EmailPreferences)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEmailPreferences indicates an expected call of GetEmailPreferences.
+func (mr *MockNotificationServiceClientMockRecorder) GetEmailPreferences(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEmailPreferences", reflect.TypeOf((*MockNotificationServiceClient)(nil).GetEmailPreferences), varargs...)
+}
+
+// ListNotifications mocks base method.
+func (m *MockNotificationServiceClient) ListNotifications(ctx context.Context, in *ListNotificationsRequest, opts ...grpc.CallOption) (*ListNotificationsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListNotifications", varargs...)
+	ret0, _ := ret[0].(*ListNotificationsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListNotifications indicates an expected call of ListNotifications.
+func (mr *MockNotificationServiceClientMockRecorder) ListNotifications(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNotifications", reflect.TypeOf((*MockNotificationServiceClient)(nil).ListNotifications), varargs...)
+}
+
+// MarkAllRead mocks base method.
+func (m *MockNotificationServiceClient) MarkAllRead(ctx context.Context, in *MarkAllReadRequest, opts ...grpc.CallOption) (*MarkReadResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "MarkAllRead", varargs...)
+	ret0, _ := ret[0].(*MarkReadResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MarkAllRead indicates an expected call of MarkAllRead.
+func (mr *MockNotificationServiceClientMockRecorder) MarkAllRead(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkAllRead", reflect.TypeOf((*MockNotificationServiceClient)(nil).MarkAllRead), varargs...)
+}
+
+// MarkRead mocks base method.
+func (m *MockNotificationServiceClient) MarkRead(ctx context.Context, in *MarkReadRequest, opts ...grpc.CallOption) (*MarkReadResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "MarkRead", varargs...)
+	ret0, _ := ret[0].(*MarkReadResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MarkRead indicates an expected call of MarkRead.
+func (mr *MockNotificationServiceClientMockRecorder) MarkRead(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkRead", reflect.TypeOf((*MockNotificationServiceClient)(nil).MarkRead), varargs...)
+}
+
+// Subscribe mocks base method.
+func (m *MockNotificationServiceClient) Subscribe(ctx context.Context, in *SubscribeRequest, opts ...grpc.CallOption) (NotificationService_SubscribeClient, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Subscribe", varargs...)
+	ret0, _ := ret[0].(NotificationService_SubscribeClient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Subscribe indicates an expected call of Subscribe.
+func (mr *MockNotificationServiceClientMockRecorder) Subscribe(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Subscribe", reflect.TypeOf((*MockNotificationServiceClient)(nil).Subscribe), varargs...)
+}
+
+// UpdateEmailPreferences mocks base method.
+func (m *MockNotificationServiceClient) UpdateEmailPreferences(ctx context.Context, in *UpdateEmailPreferencesRequest, opts ...grpc.CallOption) (*EmailPreferences, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateEmailPreferences", varargs...)
+	ret0, _ := ret[0].(*EmailPreferences)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateEmailPreferences indicates an expected call of UpdateEmailPreferences.
+func (mr *MockNotificationServiceClientMockRecorder) UpdateEmailPreferences(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateEmailPreferences", reflect.TypeOf((*MockNotificationServiceClient)(nil).UpdateEmailPreferences), varargs...)
+}
+
+// MockNotificationService_SubscribeClient is a mock of NotificationService_SubscribeClient interface.
+type MockNotificationService_SubscribeClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockNotificationService_SubscribeClientMockRecorder
+}
+
+// MockNotificationService_SubscribeClientMockRecorder is the mock recorder for MockNotificationService_SubscribeClient.
+type MockNotificationService_SubscribeClientMockRecorder struct {
+	mock *MockNotificationService_SubscribeClient
+}
+
+// NewMockNotificationService_SubscribeClient creates a new mock instance.
+func NewMockNotificationService_SubscribeClient(ctrl *gomock.Controller) *MockNotificationService_SubscribeClient {
+	mock := &MockNotificationService_SubscribeClient{ctrl: ctrl}
+	mock.recorder = &MockNotificationService_SubscribeClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockNotificationService_SubscribeClient) EXPECT() *MockNotificationService_SubscribeClientMockRecorder {
+	return m.recorder
+}
+
+// CloseSend mocks base method.
+func (m *MockNotificationService_SubscribeClient) CloseSend() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CloseSend")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CloseSend indicates an expected call of CloseSend.
+func (mr *MockNotificationService_SubscribeClientMockRecorder) CloseSend() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseSend", reflect.TypeOf((*MockNotificationService_SubscribeClient)(nil).CloseSend))
+}
+
+// Context mocks base method.
+func (m *MockNotificationService_SubscribeClient) Context() context.Context {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Context")
+	ret0, _ := ret[0].(context.Context)
+	return ret0
+}
+
+// Context indicates an expected call of Context.
+func (mr *MockNotificationService_SubscribeClientMockRecorder) Context() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockNotificationService_SubscribeClient)(nil).Context))
+}
+
+// Header mocks base method.
+func (m *MockNotificationService_SubscribeClient) Header() (metadata.MD, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Header")
+	ret0, _ := ret[0].(metadata.MD)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Header indicates an expected call of Header.
+func (mr *MockNotificationService_SubscribeClientMockRecorder) Header() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Header", reflect.TypeOf((*MockNotificationService_SubscribeClient)(nil).Header))
+}
+
+// Recv mocks base method.
+func (m *MockNotificationService_SubscribeClient) Recv() (*LiveEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Recv")
+	ret0, _ := ret[0].(*LiveEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Recv indicates an expected call of Recv.
+func (mr *MockNotificationService_SubscribeClientMockRecorder) Recv() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Recv", reflect.TypeOf((*MockNotificationService_SubscribeClient)(nil).Recv))
+}
+
+// RecvMsg mocks base method.
+func (m_2 *MockNotificationService_SubscribeClient) RecvMsg(m any) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "RecvMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecvMsg indicates an expected call of RecvMsg.
+func (mr *MockNotificationService_SubscribeClientMockRecorder) RecvMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockNotificationService_SubscribeClient)(nil).RecvMsg), m)
+}
+
+// SendMsg mocks base method.
+func (m_2 *MockNotificationService_SubscribeClient) SendMsg(m any) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "SendMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendMsg indicates an expected call of SendMsg.
+func (mr *MockNotificationService_SubscribeClientMockRecorder) SendMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockNotificationService_SubscribeClient)(nil).SendMsg), m)
+}
+
+// Trailer mocks base method.
+func (m *MockNotificationService_SubscribeClient) Trailer() metadata.MD {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Trailer")
+	ret0, _ := ret[0].(metadata.MD)
+	return ret0
+}
+
+// Trailer indicates an expected call of Trailer.
+func (mr *MockNotificationService_SubscribeClientMockRecorder) Trailer() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Trailer", reflect.TypeOf((*MockNotificationService_SubscribeClient)(nil).Trailer))
+}
+
+// MockNotificationServiceServer is a mock of NotificationServiceServer interface.
+type MockNotificationServiceServer struct {
+	ctrl     *gomock.Controller
+	recorder *MockNotificationServiceServerMockRecorder
+}
+
+// MockNotificationServiceServerMockRecorder is the mock recorder for MockNotificationServiceServer.
+type MockNotificationServiceServerMockRecorder struct {
+	mock *MockNotificationServiceServer
+}
+
+// NewMockNotificationServiceServer creates a new mock instance.
+func NewMockNotificationServiceServer(ctrl *gomock.Controller) *MockNotificationServiceServer {
+	mock := &MockNotificationServiceServer{ctrl: ctrl}
+	mock.recorder = &MockNotificationServiceServerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockNotificationServiceServer) EXPECT() *MockNotificationServiceServerMockRecorder {
+	return m.recorder
+}
+
+// GetEmailPreferences mocks base method.
+func (m *MockNotificationServiceServer) GetEmailPreferences(arg0 context.Context, arg1 *GetEmailPreferencesRequest) (*EmailPreferences, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEmailPreferences", arg0, arg1)
+	ret0, _ := ret[0].(*EmailPreferences)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEmailPreferences indicates an expected call of GetEmailPreferences.
+func (mr *MockNotificationServiceServerMockRecorder) GetEmailPreferences(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEmailPreferences", reflect.TypeOf((*MockNotificationServiceServer)(nil).GetEmailPreferences), arg0, arg1)
+}
+
+// ListNotifications mocks base method.
+func (m *MockNotificationServiceServer) ListNotifications(arg0 context.Context, arg1 *ListNotificationsRequest) (*ListNotificationsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListNotifications", arg0, arg1)
+	ret0, _ := ret[0].(*ListNotificationsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListNotifications indicates an expected call of ListNotifications.
+func (mr *MockNotificationServiceServerMockRecorder) ListNotifications(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNotifications", reflect.TypeOf((*MockNotificationServiceServer)(nil).ListNotifications), arg0, arg1)
+}
+
+// MarkAllRead mocks base method.
+func (m *MockNotificationServiceServer) MarkAllRead(arg0 context.Context, arg1 *MarkAllReadRequest) (*MarkReadResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkAllRead", arg0, arg1)
+	ret0, _ := ret[0].(*MarkReadResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MarkAllRead indicates an expected call of MarkAllRead.
+func (mr *MockNotificationServiceServerMockRecorder) MarkAllRead(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkAllRead", reflect.TypeOf((*MockNotificationServiceServer)(nil).MarkAllRead), arg0, arg1)
+}
+
+// MarkRead mocks base method.
+func (m *MockNotificationServiceServer) MarkRead(arg0 context.Context, arg1 *MarkReadRequest) (*MarkReadResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkRead", arg0, arg1)
+	ret0, _ := ret[0].(*MarkReadResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MarkRead indicates an expected call of MarkRead.
+func (mr *MockNotificationServiceServerMockRecorder) MarkRead(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkRead", reflect.TypeOf((*MockNotificationServiceServer)(nil).MarkRead), arg0, arg1)
+}
+
+// Subscribe mocks base method.
+func (m *MockNotificationServiceServer) Subscribe(arg0 *SubscribeRequest, arg1 NotificationService_SubscribeServer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Subscribe", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Subscribe indicates an expected call of Subscribe.
+func (mr *MockNotificationServiceServerMockRecorder) Subscribe(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Subscribe", reflect.TypeOf((*MockNotificationServiceServer)(nil).Subscribe), arg0, arg1)
+}
+
+// UpdateEmailPreferences mocks base method.
+func (m *MockNotificationServiceServer) UpdateEmailPreferences(arg0 context.Context, arg1 *UpdateEmailPreferencesRequest) (*EmailPreferences, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateEmailPreferences", arg0, arg1)
+	ret0, _ := ret[0].(*EmailPreferences)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateEmailPreferences indicates an expected call of UpdateEmailPreferences.
+func (mr *MockNotificationServiceServerMockRecorder) UpdateEmailPreferences(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateEmailPreferences", reflect.TypeOf((*MockNotificationServiceServer)(nil).UpdateEmailPreferences), arg0, arg1)
+}
+
+// mustEmbedUnimplementedNotificationServiceServer mocks base method.
+func (m *MockNotificationServiceServer) mustEmbedUnimplementedNotificationServiceServer() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "mustEmbedUnimplementedNotificationServiceServer")
+}
+
+// mustEmbedUnimplementedNotificationServiceServer indicates an expected call of mustEmbedUnimplementedNotificationServiceServer.
+func (mr *MockNotificationServiceServerMockRecorder) mustEmbedUnimplementedNotificationServiceServer() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "mustEmbedUnimplementedNotificationServiceServer", reflect.TypeOf((*MockNotificationServiceServer)(nil).mustEmbedUnimplementedNotificationServiceServer))
+}
+
+// MockUnsafeNotificationServiceServer is a mock of UnsafeNotificationServiceServer interface.
+type MockUnsafeNotificationServiceServer struct {
+	ctrl     *gomock.Controller
+	recorder *MockUnsafeNotificationServiceServerMockRecorder
+}
+
+// MockUnsafeNotificationServiceServerMockRecorder is the mock recorder for MockUnsafeNotificationServiceServer.
+type MockUnsafeNotificationServiceServerMockRecorder struct {
+	mock *MockUnsafeNotificationServiceServer
+}
+
+// NewMockUnsafeNotificationServiceServer creates a new mock instance.
+func NewMockUnsafeNotificationServiceServer(ctrl *gomock.Controller) *MockUnsafeNotificationServiceServer {
+	mock := &MockUnsafeNotificationServiceServer{ctrl: ctrl}
+	mock.recorder = &MockUnsafeNotificationServiceServerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUnsafeNotificationServiceServer) EXPECT() *MockUnsafeNotificationServiceServerMockRecorder {
+	return m.recorder
+}
+
+// mustEmbedUnimplementedNotificationServiceServer mocks base method.
+func (m *MockUnsafeNotificationServiceServer) mustEmbedUnimplementedNotificationServiceServer() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "mustEmbedUnimplementedNotificationServiceServer")
+}
+
+// mustEmbedUnimplementedNotificationServiceServer indicates an expected call of mustEmbedUnimplementedNotificationServiceServer.
+func (mr *MockUnsafeNotificationServiceServerMockRecorder) mustEmbedUnimplementedNotificationServiceServer() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "mustEmbedUnimplementedNotificationServiceServer", reflect.TypeOf((*MockUnsafeNotificationServiceServer)(nil).mustEmbedUnimplementedNotificationServiceServer))
+}
+
+// MockNotificationService_SubscribeServer is a mock of NotificationService_SubscribeServer interface.
+type MockNotificationService_SubscribeServer struct {
+	ctrl     *gomock.Controller
+	recorder *MockNotificationService_SubscribeServerMockRecorder
+}
+
+// MockNotificationService_SubscribeServerMockRecorder is the mock recorder for MockNotificationService_SubscribeServer.
+type MockNotificationService_SubscribeServerMockRecorder struct {
+	mock *MockNotificationService_SubscribeServer
+}
+
+// NewMockNotificationService_SubscribeServer creates a new mock instance.
+func NewMockNotificationService_SubscribeServer(ctrl *gomock.Controller) *MockNotificationService_SubscribeServer {
+	mock := &MockNotificationService_SubscribeServer{ctrl: ctrl}
+	mock.recorder = &MockNotificationService_SubscribeServerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockNotificationService_SubscribeServer) EXPECT() *MockNotificationService_SubscribeServerMockRecorder {
+	return m.recorder
+}
+
+// Context mocks base method.
+func (m *MockNotificationService_SubscribeServer) Context() context.Context {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Context")
+	ret0, _ := ret[0].(context.Context)
+	return ret0
+}
+
+// Context indicates an expected call of Context.
+func (mr *MockNotificationService_SubscribeServerMockRecorder) Context() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockNotificationService_SubscribeServer)(nil).Context))
+}
+
+// RecvMsg mocks base method.
+func (m_2 *MockNotificationService_SubscribeServer) RecvMsg(m any) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "RecvMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecvMsg indicates an expected call of RecvMsg.
+func (mr *MockNotificationService_SubscribeServerMockRecorder) RecvMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockNotificationService_SubscribeServer)(nil).RecvMsg), m)
+}
+
+// Send mocks base method.
+func (m *MockNotificationService_SubscribeServer) Send(arg0 *LiveEvent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Send", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Send indicates an expected call of Send.
+func (mr *MockNotificationService_SubscribeServerMockRecorder) Send(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MockNotificationService_SubscribeServer)(nil).Send), arg0)
+}
+
+// SendHeader mocks base method.
+func (m *MockNotificationService_SubscribeServer) SendHeader(arg0 metadata.MD) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendHeader", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendHeader indicates an expected call of SendHeader.
+func (mr *MockNotificationService_SubscribeServerMockRecorder) SendHeader(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendHeader", reflect.TypeOf((*MockNotificationService_SubscribeServer)(nil).SendHeader), arg0)
+}
+
+// SendMsg mocks base method.
+func (m_2 *MockNotificationService_SubscribeServer) SendMsg(m any) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "SendMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendMsg indicates an expected call of SendMsg.
+func (mr *MockNotificationService_SubscribeServerMockRecorder) SendMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockNotificationService_SubscribeServer)(nil).SendMsg), m)
+}
+
+// SetHeader mocks base method.
+func (m *MockNotificationService_SubscribeServer) SetHeader(arg0 metadata.MD) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetHeader", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetHeader indicates an expected call of SetHeader.
+func (mr *MockNotificationService_SubscribeServerMockRecorder) SetHeader(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetHeader", reflect.TypeOf((*MockNotificationService_SubscribeServer)(nil).SetHeader), arg0)
+}
+
+// SetTrailer mocks base method.
+func (m *MockNotificationService_SubscribeServer) SetTrailer(arg0 metadata.MD) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetTrailer", arg0)
+}
+
+// SetTrailer indicates an expected call of SetTrailer.
+func (mr *MockNotificationService_SubscribeServerMockRecorder) SetTrailer(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTrailer", reflect.TypeOf((*MockNotificationService_SubscribeServer)(nil).SetTrailer), arg0)
+}
+
+// MockNotificationPublisherServiceClient is a mock of NotificationPublisherServiceClient interface.
+type MockNotificationPublisherServiceClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockNotificationPublisherServiceClientMockRecorder
+}
+
+// MockNotificationPublisherServiceClientMockRecorder is the mock recorder for MockNotificationPublisherServiceClient.
+type MockNotificationPublisherServiceClientMockRecorder struct {
+	mock *MockNotificationPublisherServiceClient
+}
+
+// NewMockNotificationPublisherServiceClient creates a new mock instance.
+func NewMockNotificationPublisherServiceClient(ctrl *gomock.Controller) *MockNotificationPublisherServiceClient {
+	mock := &MockNotificationPublisherServiceClient{ctrl: ctrl}
+	mock.recorder = &MockNotificationPublisherServiceClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockNotificationPublisherServiceClient) EXPECT() *MockNotificationPublisherServiceClientMockRecorder {
+	return m.recorder
+}
+
+// Publish mocks base method.
+func (m *MockNotificationPublisherServiceClient) Publish(ctx context.Context, in *PublishRequest, opts ...grpc.CallOption) (*PublishResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Publish", varargs...)
+	ret0, _ := ret[0].(*PublishResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Publish indicates an expected call of Publish.
+func (mr *MockNotificationPublisherServiceClientMockRecorder) Publish(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Publish", reflect.TypeOf((*MockNotificationPublisherServiceClient)(nil).Publish), varargs...)
+}
+
+// PublishEvent mocks base method.
+func (m *MockNotificationPublisherServiceClient) PublishEvent(ctx context.Context, in *PublishEventRequest, opts ...grpc.CallOption) (*PublishEventResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PublishEvent", varargs...)
+	ret0, _ := ret[0].(*PublishEventResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PublishEvent indicates an expected call of PublishEvent.
+func (mr *MockNotificationPublisherServiceClientMockRecorder) PublishEvent(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PublishEvent", reflect.TypeOf((*MockNotificationPublisherServiceClient)(nil).PublishEvent), varargs...)
+}
+
+// SendInvitation mocks base method.
+func (m *MockNotificationPublisherServiceClient) SendInvitation(ctx context.Context, in *SendInvitationRequest, opts ...grpc.CallOption) (*SendInvitationResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SendInvitation", varargs...)
+	ret0, _ := ret[0].(*SendInvitationResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SendInvitation indicates an expected call of SendInvitation.
+func (mr *MockNotificationPublisherServiceClientMockRecorder) SendInvitation(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendInvitation", reflect.TypeOf((*MockNotificationPublisherServiceClient)(nil).SendInvitation), varargs...)
+}
+
+// MockNotificationPublisherServiceServer is a mock of NotificationPublisherServiceServer interface.
+type MockNotificationPublisherServiceServer struct {
+	ctrl     *gomock.Controller
+	recorder *MockNotificationPublisherServiceServerMockRecorder
+}
+
+// MockNotificationPublisherServiceServerMockRecorder is the mock recorder for MockNotificationPublisherServiceServer.
+type MockNotificationPublisherServiceServerMockRecorder struct {
+	mock *MockNotificationPublisherServiceServer
+}
+
+// NewMockNotificationPublisherServiceServer creates a new mock instance.
+func NewMockNotificationPublisherServiceServer(ctrl *gomock.Controller) *MockNotificationPublisherServiceServer {
+	mock := &MockNotificationPublisherServiceServer{ctrl: ctrl}
+	mock.recorder = &MockNotificationPublisherServiceServerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockNotificationPublisherServiceServer) EXPECT() *MockNotificationPublisherServiceServerMockRecorder {
+	return m.recorder
+}
+
+// Publish mocks base method.
+func (m *MockNotificationPublisherServiceServer) Publish(arg0 context.Context, arg1 *PublishRequest) (*PublishResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Publish", arg0, arg1)
+	ret0, _ := ret[0].(*PublishResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Publish indicates an expected call of Publish.
+func (mr *MockNotificationPublisherServiceServerMockRecorder) Publish(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Publish", reflect.TypeOf((*MockNotificationPublisherServiceServer)(nil).Publish), arg0, arg1)
+}
+
+// PublishEvent mocks base method.
+func (m *MockNotificationPublisherServiceServer) PublishEvent(arg0 context.Context, arg1 *PublishEventRequest) (*PublishEventResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PublishEvent", arg0, arg1)
+	ret0, _ := ret[0].(*PublishEventResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PublishEvent indicates an expected call of PublishEvent.
+func (mr *MockNotificationPublisherServiceServerMockRecorder) PublishEvent(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PublishEvent", reflect.TypeOf((*MockNotificationPublisherServiceServer)(nil).PublishEvent), arg0, arg1)
+}
+
+// SendInvitation mocks base method.
+func (m *MockNotificationPublisherServiceServer) SendInvitation(arg0 context.Context, arg1 *SendInvitationRequest) (*SendInvitationResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendInvitation", arg0, arg1)
+	ret0, _ := ret[0].(*SendInvitationResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SendInvitation indicates an expected call of SendInvitation.
+func (mr *MockNotificationPublisherServiceServerMockRecorder) SendInvitation(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendInvitation", reflect.TypeOf((*MockNotificationPublisherServiceServer)(nil).SendInvitation), arg0, arg1)
+}
+
+// mustEmbedUnimplementedNotificationPublisherServiceServer mocks base method.
+func (m *MockNotificationPublisherServiceServer) mustEmbedUnimplementedNotificationPublisherServiceServer() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "mustEmbedUnimplementedNotificationPublisherServiceServer")
+}
+
+// mustEmbedUnimplementedNotificationPublisherServiceServer indicates an expected call of mustEmbedUnimplementedNotificationPublisherServiceServer.
+func (mr *MockNotificationPublisherServiceServerMockRecorder) mustEmbedUnimplementedNotificationPublisherServiceServer() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "mustEmbedUnimplementedNotificationPublisherServiceServer", reflect.TypeOf((*MockNotificationPublisherServiceServer)(nil).mustEmbedUnimplementedNotificationPublisherServiceServer))
+}
+
+// MockUnsafeNotificationPublisherServiceServer is a mock of UnsafeNotificationPublisherServiceServer interface.
+type MockUnsafeNotificationPublisherServiceServer struct {
+	ctrl     *gomock.Controller
+	recorder *MockUnsafeNotificationPublisherServiceServerMockRecorder
+}
+
+// MockUnsafeNotificationPublisherServiceServerMockRecorder is the mock recorder for MockUnsafeNotificationPublisherServiceServer.
+type MockUnsafeNotificationPublisherServiceServerMockRecorder struct {
+	mock *MockUnsafeNotificationPublisherServiceServer
+}
+
+// NewMockUnsafeNotificationPublisherServiceServer creates a new mock instance.
+func NewMockUnsafeNotificationPublisherServiceServer(ctrl *gomock.Controller) *MockUnsafeNotificationPublisherServiceServer {
+	mock := &MockUnsafeNotificationPublisherServiceServer{ctrl: ctrl}
+	mock.recorder = &MockUnsafeNotificationPublisherServiceServerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUnsafeNotificationPublisherServiceServer) EXPECT() *MockUnsafeNotificationPublisherServiceServerMockRecorder {
+	return m.recorder
+}
+
+// mustEmbedUnimplementedNotificationPublisherServiceServer mocks base method.
+func (m *MockUnsafeNotificationPublisherServiceServer) mustEmbedUnimplementedNotificationPublisherServiceServer() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "mustEmbedUnimplementedNotificationPublisherServiceServer")
+}
+
+// mustEmbedUnimplementedNotificationPublisherServiceServer indicates an expected call of mustEmbedUnimplementedNotificationPublisherServiceServer.
+func (mr *MockUnsafeNotificationPublisherServiceServerMockRecorder) mustEmbedUnimplementedNotificationPublisherServiceServer() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "mustEmbedUnimplementedNotificationPublisherServiceServer", reflect.TypeOf((*MockUnsafeNotificationPublisherServiceServer)(nil).mustEmbedUnimplementedNotificationPublisherServiceServer))
+}
+
+// MockWebhookServiceClient is a mock of WebhookServiceClient interface.
+type MockWebhookServiceClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockWebhookServiceClientMockRecorder
+}
+
+// MockWebhookServiceClientMockRecorder is the mock recorder for MockWebhookServiceClient.
+type MockWebhookServiceClientMockRecorder struct {
+	mock *MockWebhookServiceClient
+}
+
+// NewMockWebhookServiceClient creates a new mock instance.
+func NewMockWebhookServiceClient(ctrl *gomock.Controller) *MockWebhookServiceClient {
+	mock := &MockWebhookServiceClient{ctrl: ctrl}
+	mock.recorder = &MockWebhookServiceClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWebhookServiceClient) EXPECT() *MockWebhookServiceClientMockRecorder {
+	return m.recorder
+}
+
+// CreateWebhook mocks base method.
+func (m *MockWebhookServiceClient) CreateWebhook(ctx context.Context, in *CreateWebhookRequest, opts ...grpc.CallOption) (*CreateWebhookResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateWebhook", varargs...)
+	ret0, _ := ret[0].(*CreateWebhookResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateWebhook indicates an expected call of CreateWebhook.
+func (mr *MockWebhookServiceClientMockRecorder) CreateWebhook(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateWebhook", reflect.TypeOf((*MockWebhookServiceClient)(nil).CreateWebhook), varargs...)
+}
+
+// DeleteWebhook mocks base method.
+func (m *MockWebhookServiceClient) DeleteWebhook(ctx context.Context, in *DeleteWebhookRequest, opts ...grpc.CallOption) (*DeleteWebhookResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteWebhook", varargs...)
+	ret0, _ := ret[0].(*DeleteWebhookResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteWebhook indicates an expected call of DeleteWebhook.
+func (mr *MockWebhookServiceClientMockRecorder) DeleteWebhook(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWebhook", reflect.TypeOf((*MockWebhookServiceClient)(nil).DeleteWebhook), varargs...)
+}
+
+// ListWebhookDeliveries mocks base method.
+func (m *MockWebhookServiceClient) ListWebhookDeliveries(ctx context.Context, in *ListWebhookDeliveriesRequest, opts ...grpc.CallOption) (*ListWebhookDeliveriesResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListWebhookDeliveries", varargs...)
+	ret0, _ := ret[0].(*ListWebhookDeliveriesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListWebhookDeliveries indicates an expected call of ListWebhookDeliveries.
+func (mr *MockWebhookServiceClientMockRecorder) ListWebhookDeliveries(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWebhookDeliveries", reflect.TypeOf((*MockWebhookServiceClient)(nil).ListWebhookDeliveries), varargs...)
+}
+
+// ListWebhooks mocks base method.
+func (m *MockWebhookServiceClient) ListWebhooks(ctx context.Context, in *ListWebhooksRequest, opts ...grpc.CallOption) (*ListWebhooksResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListWebhooks", varargs...)
+	ret0, _ := ret[0].(*ListWebhooksResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListWebhooks indicates an expected call of ListWebhooks.
+func (mr *MockWebhookServiceClientMockRecorder) ListWebhooks(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWebhooks", reflect.TypeOf((*MockWebhookServiceClient)(nil).ListWebhooks), varargs...)
+}
+
+// SendTestEvent mocks base method.
+func (m *MockWebhookServiceClient) SendTestEvent(ctx context.Context, in *SendTestEventRequest, opts ...grpc.CallOption) (*WebhookDelivery, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SendTestEvent", varargs...)
+	ret0, _ := ret[0].(*WebhookDelivery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SendTestEvent indicates an expected call of SendTestEvent.
+func (mr *MockWebhookServiceClientMockRecorder) SendTestEvent(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendTestEvent", reflect.TypeOf((*MockWebhookServiceClient)(nil).SendTestEvent), varargs...)
+}
+
+// UpdateWebhook mocks base method.
+func (m *MockWebhookServiceClient) UpdateWebhook(ctx context.Context, in *UpdateWebhookRequest, opts ...grpc.CallOption) (*Webhook, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateWebhook", varargs...)
+	ret0, _ := ret[0].(*Webhook)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateWebhook indicates an expected call of UpdateWebhook.
+func (mr *MockWebhookServiceClientMockRecorder) UpdateWebhook(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWebhook", reflect.TypeOf((*MockWebhookServiceClient)(nil).UpdateWebhook), varargs...)
+}
+
+// MockWebhookServiceServer is a mock of WebhookServiceServer interface.
+type MockWebhookServiceServer struct {
+	ctrl     *gomock.Controller
+	recorder *MockWebhookServiceServerMockRecorder
+}
+
+// MockWebhookServiceServerMockRecorder is the mock recorder for MockWebhookServiceServer.
+type MockWebhookServiceServerMockRecorder struct {
+	mock *MockWebhookServiceServer
+}
+
+// NewMockWebhookServiceServer creates a new mock instance.
+func NewMockWebhookServiceServer(ctrl *gomock.Controller) *MockWebhookServiceServer {
+	mock := &MockWebhookServiceServer{ctrl: ctrl}
+	mock.recorder = &MockWebhookServiceServerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWebhookServiceServer) EXPECT() *MockWebhookServiceServerMockRecorder {
+	return m.recorder
+}
+
+// CreateWebhook mocks base method.
+func (m *MockWebhookServiceServer) CreateWebhook(arg0 context.Context, arg1 *CreateWebhookRequest) (*CreateWebhookResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateWebhook", arg0, arg1)
+	ret0, _ := ret[0].(*CreateWebhookResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateWebhook indicates an expected call of CreateWebhook.
+func (mr *MockWebhookServiceServerMockRecorder) CreateWebhook(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateWebhook", reflect.TypeOf((*MockWebhookServiceServer)(nil).CreateWebhook), arg0, arg1)
+}
+
+// DeleteWebhook mocks base method.
+func (m *MockWebhookServiceServer) DeleteWebhook(arg0 context.Context, arg1 *DeleteWebhookRequest) (*DeleteWebhookResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteWebhook", arg0, arg1)
+	ret0, _ := ret[0].(*DeleteWebhookResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteWebhook indicates an expected call of DeleteWebhook.
+func (mr *MockWebhookServiceServerMockRecorder) DeleteWebhook(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWebhook", reflect.TypeOf((*MockWebhookServiceServer)(nil).DeleteWebhook), arg0, arg1)
+}
+
+// ListWebhookDeliveries mocks base method.
+func (m *MockWebhookServiceServer) ListWebhookDeliveries(arg0 context.Context, arg1 *ListWebhookDeliveriesRequest) (*ListWebhookDeliveriesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListWebhookDeliveries", arg0, arg1)
+	ret0, _ := ret[0].(*ListWebhookDeliveriesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListWebhookDeliveries indicates an expected call of ListWebhookDeliveries.
+func (mr *MockWebhookServiceServerMockRecorder) ListWebhookDeliveries(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWebhookDeliveries", reflect.TypeOf((*MockWebhookServiceServer)(nil).ListWebhookDeliveries), arg0, arg1)
+}
+
+// ListWebhooks mocks base method.
+func (m *MockWebhookServiceServer) ListWebhooks(arg0 context.Context, arg1 *ListWebhooksRequest) (*ListWebhooksResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListWebhooks", arg0, arg1)
+	ret0, _ := ret[0].(*ListWebhooksResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListWebhooks indicates an expected call of ListWebhooks.
+func (mr *MockWebhookServiceServerMockRecorder) ListWebhooks(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWebhooks", reflect.TypeOf((*MockWebhookServiceServer)(nil).ListWebhooks), arg0, arg1)
+}
+
+// SendTestEvent mocks base method.
+func (m *MockWebhookServiceServer) SendTestEvent(arg0 context.Context, arg1 *SendTestEventRequest) (*WebhookDelivery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendTestEvent", arg0, arg1)
+	ret0, _ := ret[0].(*WebhookDelivery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SendTestEvent indicates an expected call of SendTestEvent.
+func (mr *MockWebhookServiceServerMockRecorder) SendTestEvent(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendTestEvent", reflect.TypeOf((*MockWebhookServiceServer)(nil).SendTestEvent), arg0, arg1)
+}
+
+// UpdateWebhook mocks base method.
+func (m *MockWebhookServiceServer) UpdateWebhook(arg0 context.Context, arg1 *UpdateWebhookRequest) (*Webhook, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateWebhook", arg0, arg1)
+	ret0, _ := ret[0].(*Webhook)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateWebhook indicates an expected call of UpdateWebhook.
+func (mr *MockWebhookServiceServerMockRecorder) UpdateWebhook(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWebhook", reflect.TypeOf((*MockWebhookServiceServer)(nil).UpdateWebhook), arg0, arg1)
+}
+
+// mustEmbedUnimplementedWebhookServiceServer mocks base method.
+func (m *MockWebhookServiceServer) mustEmbedUnimplementedWebhookServiceServer() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "mustEmbedUnimplementedWebhookServiceServer")
+}
+
+// mustEmbedUnimplementedWebhookServiceServer indicates an expected call of mustEmbedUnimplementedWebhookServiceServer.
+func (mr *MockWebhookServiceServerMockRecorder) mustEmbedUnimplementedWebhookServiceServer() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "mustEmbedUnimplementedWebhookServiceServer", reflect.TypeOf((*MockWebhookServiceServer)(nil).mustEmbedUnimplementedWebhookServiceServer))
+}
+
+// MockUnsafeWebhookServiceServer is a mock of UnsafeWebhookServiceServer interface.
+type MockUnsafeWebhookServiceServer struct {
+	ctrl     *gomock.Controller
+	recorder *MockUnsafeWebhookServiceServerMockRecorder
+}
+
+// MockUnsafeWebhookServiceServerMockRecorder is the mock recorder for MockUnsafeWebhookServiceServer.
+type MockUnsafeWebhookServiceServerMockRecorder struct {
+	mock *MockUnsafeWebhookServiceServer
+}
+
+// NewMockUnsafeWebhookServiceServer creates a new mock instance.
+func NewMockUnsafeWebhookServiceServer(ctrl *gomock.Controller) *MockUnsafeWebhookServiceServer {
+	mock := &MockUnsafeWebhookServiceServer{ctrl: ctrl}
+	mock.recorder = &MockUnsafeWebhookServiceServerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUnsafeWebhookServiceServer) EXPECT() *MockUnsafeWebhookServiceServerMockRecorder {
+	return m.recorder
+}
+
+// mustEmbedUnimplementedWebhookServiceServer mocks base method.
+func (m *MockUnsafeWebhookServiceServer) mustEmbedUnimplementedWebhookServiceServer() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "mustEmbedUnimplementedWebhookServiceServer")
+}
+
+// mustEmbedUnimplementedWebhookServiceServer indicates an expected call of mustEmbedUnimplementedWebhookServiceServer.
+func (mr *MockUnsafeWebhookServiceServerMockRecorder) mustEmbedUnimplementedWebhookServiceServer() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "mustEmbedUnimplementedWebhookServiceServer", reflect.TypeOf((*MockUnsafeWebhookServiceServer)(nil).mustEmbedUnimplementedWebhookServiceServer))
+}
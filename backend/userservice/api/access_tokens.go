@@ -0,0 +1,289 @@
+package api
+
+import (
+	"context"
+	"crypto/rand"
+	"database/sql"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"sortedstartup.com/stream/common/auth"
+	"sortedstartup.com/stream/common/interceptors"
+	"sortedstartup.com/stream/userservice/db"
+	"sortedstartup.com/stream/userservice/proto"
+)
+
+const (
+	defaultAccessTokenDays = 90
+	maxAccessTokenDays     = 365
+	maxAccessTokensPerUser = 50
+	maxAccessTokenName     = 100
+
+	// accessTokenPrefixLength is how much of a token is kept to recognize it, the prefix and a few random characters
+	accessTokenPrefixLength = len(auth.AccessTokenPrefix) + 4
+
+	// lastUsedInterval limits the writes for last used tracking, a busy CI job doesn't update it on every call
+	lastUsedInterval = time.Minute
+
+	// accessTokenVerifyTimeout bounds the database lookups of VerifyAccessToken, which has no request context
+	accessTokenVerifyTimeout = 5 * time.Second
+)
+
+var errInvalidAccessToken = errors.New("invalid access token")
+
+// AccessTokenAPI manages personal access tokens and verifies them for the auth interceptors
+type AccessTokenAPI struct {
+	log       *slog.Logger
+	dbQueries db.Querier
+	proto.UnimplementedAccessTokenServiceServer
+}
+
+func NewAccessTokenAPI(userAPI *UserAPI) *AccessTokenAPI {
+	return &AccessTokenAPI{
+		log:       userAPI.log.With("api", "AccessTokenAPI"),
+		dbQueries: userAPI.dbQueries,
+	}
+}
+
+func NewAccessTokenAPITest(querier db.Querier, logger *slog.Logger) *AccessTokenAPI {
+	return &AccessTokenAPI{
+		log:       logger,
+		dbQueries: querier,
+	}
+}
+
+func (s *AccessTokenAPI) CreateAccessToken(ctx context.Context, req *proto.CreateAccessTokenRequest) (*proto.CreateAccessTokenResponse, error) {
+	authContext, err := interceptors.AuthFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "unauthenticated")
+	}
+	userID := authContext.User.ID
+
+	name := strings.TrimSpace(req.Name)
+	if name == "" || len(name) > maxAccessTokenName {
+		return nil, status.Errorf(codes.InvalidArgument, "name is required and must be at most %d characters", maxAccessTokenName)
+	}
+	if req.TenantId == "" {
+		return nil, status.Error(codes.InvalidArgument, "tenant ID is required")
+	}
+	scopes, err := parseScopes(req.Scopes)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	days := req.ExpiresInDays
+	if days == 0 {
+		days = defaultAccessTokenDays
+	}
+	if days < 0 || days > maxAccessTokenDays {
+		return nil, status.Errorf(codes.InvalidArgument, "expires_in_days must be between 1 and %d", maxAccessTokenDays)
+	}
+
+	_, err = s.dbQueries.GetUserRoleInTenant(ctx, db.GetUserRoleInTenantParams{TenantID: req.TenantId, UserID: userID})
+	if err == sql.ErrNoRows {
+		return nil, status.Error(codes.PermissionDenied, "access denied: you are not a member of this tenant")
+	}
+	if err != nil {
+		s.log.Error("Failed to get user role in tenant", "err", err, "tenantID", req.TenantId, "userID", userID)
+		return nil, status.Error(codes.Internal, "failed to create access token")
+	}
+
+	now := time.Now()
+	// Expired tokens don't count, they can't be used anymore
+	count, err := s.dbQueries.CountAccessTokensByUserID(ctx, db.CountAccessTokensByUserIDParams{
+		UserID: userID,
+		Now:    now,
+	})
+	if err != nil {
+		s.log.Error("Failed to count access tokens", "err", err, "userID", userID)
+		return nil, status.Error(codes.Internal, "failed to create access token")
+	}
+	if count >= maxAccessTokensPerUser {
+		return nil, status.Errorf(codes.ResourceExhausted, "at most %d access tokens, revoke unused ones first", maxAccessTokensPerUser)
+	}
+
+	secret := make([]byte, 32)
+	_, err = rand.Read(secret)
+	if err != nil {
+		s.log.Error("Failed to generate access token", "err", err)
+		return nil, status.Error(codes.Internal, "failed to create access token")
+	}
+	token := auth.AccessTokenPrefix + base64.RawURLEncoding.EncodeToString(secret)
+
+	accessToken := db.UserserviceAccessToken{
+		ID:          uuid.New().String(),
+		UserID:      userID,
+		TenantID:    req.TenantId,
+		Name:        name,
+		TokenHash:   hashToken(token),
+		TokenPrefix: token[:accessTokenPrefixLength],
+		Scopes:      strings.Join(scopes, " "),
+		ExpiresAt:   now.AddDate(0, 0, int(days)),
+		CreatedAt:   now,
+	}
+	err = s.dbQueries.CreateAccessToken(ctx, db.CreateAccessTokenParams{
+		ID:          accessToken.ID,
+		UserID:      accessToken.UserID,
+		TenantID:    accessToken.TenantID,
+		Name:        accessToken.Name,
+		TokenHash:   accessToken.TokenHash,
+		TokenPrefix: accessToken.TokenPrefix,
+		Scopes:      accessToken.Scopes,
+		ExpiresAt:   accessToken.ExpiresAt,
+		CreatedAt:   accessToken.CreatedAt,
+	})
+	if err != nil {
+		s.log.Error("Failed to create access token", "err", err, "userID", userID)
+		return nil, status.Error(codes.Internal, "failed to create access token")
+	}
+
+	s.log.Info("Access token created", "tokenID", accessToken.ID, "userID", userID, "tenantID", req.TenantId, "scopes", accessToken.Scopes)
+	return &proto.CreateAccessTokenResponse{
+		Token:       token,
+		AccessToken: accessTokenToProto(accessToken),
+	}, nil
+}
+
+func (s *AccessTokenAPI) ListAccessTokens(ctx context.Context, req *proto.ListAccessTokensRequest) (*proto.ListAccessTokensResponse, error) {
+	authContext, err := interceptors.AuthFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "unauthenticated")
+	}
+
+	rows, err := s.dbQueries.ListAccessTokensByUserID(ctx, authContext.User.ID)
+	if err != nil {
+		s.log.Error("Failed to list access tokens", "err", err, "userID", authContext.User.ID)
+		return nil, status.Error(codes.Internal, "failed to list access tokens")
+	}
+
+	accessTokens := make([]*proto.AccessToken, 0, len(rows))
+	for _, row := range rows {
+		accessTokens = append(accessTokens, accessTokenToProto(row))
+	}
+	return &proto.ListAccessTokensResponse{AccessTokens: accessTokens}, nil
+}
+
+func (s *AccessTokenAPI) RevokeAccessToken(ctx context.Context, req *proto.RevokeAccessTokenRequest) (*proto.RevokeAccessTokenResponse, error) {
+	authContext, err := interceptors.AuthFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "unauthenticated")
+	}
+	if req.Id == "" {
+		return nil, status.Error(codes.InvalidArgument, "access token ID is required")
+	}
+
+	revoked, err := s.dbQueries.RevokeAccessToken(ctx, db.RevokeAccessTokenParams{
+		RevokedAt: sql.NullTime{Time: time.Now(), Valid: true},
+		ID:        req.Id,
+		UserID:    authContext.User.ID,
+	})
+	if err != nil {
+		s.log.Error("Failed to revoke access token", "err", err, "tokenID", req.Id)
+		return nil, status.Error(codes.Internal, "failed to revoke access token")
+	}
+	// Other users' tokens look the same as missing ones
+	if revoked == 0 {
+		return nil, status.Error(codes.NotFound, "access token not found")
+	}
+
+	s.log.Info("Access token revoked", "tokenID", req.Id, "userID", authContext.User.ID)
+	return &proto.RevokeAccessTokenResponse{Message: "Access token revoked"}, nil
+}
+
+// VerifyAccessToken resolves a personal access token to its user, tenant and scopes.
+// It has the signature of auth.Auth's VerifyIDToken, so the interceptors can use it like an identity provider.
+func (s *AccessTokenAPI) VerifyAccessToken(token string) (*auth.AuthContext, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), accessTokenVerifyTimeout)
+	defer cancel()
+
+	row, err := s.dbQueries.GetAccessTokenByHash(ctx, hashToken(token))
+	if err == sql.ErrNoRows {
+		return &auth.AuthContext{User: &auth.ANONYMOUS, IsAuthenticated: false}, errInvalidAccessToken
+	}
+	if err != nil {
+		s.log.Error("Failed to get access token", "err", err)
+		return &auth.AuthContext{User: &auth.ANONYMOUS, IsAuthenticated: false}, fmt.Errorf("failed to verify access token: %w", err)
+	}
+	accessToken := row.UserserviceAccessToken
+
+	now := time.Now()
+	if now.After(accessToken.ExpiresAt) {
+		return &auth.AuthContext{User: &auth.ANONYMOUS, IsAuthenticated: false}, errors.New("access token has expired")
+	}
+	if !auth.IsEmailAllowed(row.Email) {
+		return &auth.AuthContext{User: &auth.ANONYMOUS, IsAuthenticated: false}, fmt.Errorf("email not in allowed list: %s", row.Email)
+	}
+
+	if !accessToken.LastUsedAt.Valid || now.Sub(accessToken.LastUsedAt.Time) > lastUsedInterval {
+		err = s.dbQueries.UpdateAccessTokenLastUsed(ctx, db.UpdateAccessTokenLastUsedParams{
+			LastUsedAt: sql.NullTime{Time: now, Valid: true},
+			ID:         accessToken.ID,
+		})
+		if err != nil {
+			// Not worth failing the request for
+			s.log.Error("Failed to update access token last used", "err", err, "tokenID", accessToken.ID)
+		}
+	}
+
+	scopes := []auth.Scope{}
+	for _, scope := range strings.Fields(accessToken.Scopes) {
+		scopes = append(scopes, auth.Scope(scope))
+	}
+
+	return &auth.AuthContext{
+		User: &auth.User{
+			ID:    accessToken.UserID,
+			Name:  row.Username,
+			Email: row.Email,
+			Roles: []auth.Role{}, // Admin roles come from interactive logins only
+		},
+		IsAuthenticated: true,
+		AccessToken: &auth.AccessTokenGrant{
+			TokenID:  accessToken.ID,
+			TenantID: accessToken.TenantID,
+			Scopes:   scopes,
+		},
+	}, nil
+}
+
+// parseScopes validates the requested scopes and returns them sorted without duplicates
+func parseScopes(requested []string) ([]string, error) {
+	if len(requested) == 0 {
+		return nil, errors.New("at least one scope is required")
+	}
+
+	scopes := make([]string, 0, len(requested))
+	for _, scope := range requested {
+		scope = strings.TrimSpace(scope)
+		if !auth.IsValidScope(auth.Scope(scope)) {
+			return nil, fmt.Errorf("unknown scope %q", scope)
+		}
+		scopes = append(scopes, scope)
+	}
+	slices.Sort(scopes)
+	return slices.Compact(scopes), nil
+}
+
+func accessTokenToProto(accessToken db.UserserviceAccessToken) *proto.AccessToken {
+	protoToken := &proto.AccessToken{
+		Id:          accessToken.ID,
+		Name:        accessToken.Name,
+		TenantId:    accessToken.TenantID,
+		Scopes:      strings.Fields(accessToken.Scopes),
+		TokenPrefix: accessToken.TokenPrefix,
+		ExpiresAt:   timestamppb.New(accessToken.ExpiresAt),
+		CreatedAt:   timestamppb.New(accessToken.CreatedAt),
+	}
+	if accessToken.LastUsedAt.Valid {
+		protoToken.LastUsedAt = timestamppb.New(accessToken.LastUsedAt.Time)
+	}
+	return protoToken
+}
@@ -0,0 +1,173 @@
+package api_test
+
+import (
+	"context"
+	"database/sql"
+	"log/slog"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"sortedstartup.com/stream/common/auth"
+	"sortedstartup.com/stream/userservice/api"
+	"sortedstartup.com/stream/userservice/db"
+	"sortedstartup.com/stream/userservice/db/mocks"
+	"sortedstartup.com/stream/userservice/proto"
+)
+
+func TestCreateAccessToken_AndVerify(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockQuerier := mocks.NewMockQuerier(ctrl)
+	accessTokenAPI := api.NewAccessTokenAPITest(mockQuerier, slog.Default())
+	ctx := withAuthContext(context.Background(), &auth.User{ID: "user-1", Email: "ci@example.com"})
+
+	var created db.CreateAccessTokenParams
+	mockQuerier.EXPECT().
+		GetUserRoleInTenant(gomock.Any(), db.GetUserRoleInTenantParams{TenantID: "tenant-1", UserID: "user-1"}).
+		Return("member", nil)
+	mockQuerier.EXPECT().
+		CountAccessTokensByUserID(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, params db.CountAccessTokensByUserIDParams) (int64, error) {
+			// Expired tokens are left out of the count
+			assert.Equal(t, "user-1", params.UserID)
+			assert.WithinDuration(t, time.Now(), params.Now, time.Minute)
+			return 0, nil
+		})
+	mockQuerier.EXPECT().
+		CreateAccessToken(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, params db.CreateAccessTokenParams) error {
+			created = params
+			return nil
+		})
+
+	resp, err := accessTokenAPI.CreateAccessToken(ctx, &proto.CreateAccessTokenRequest{
+		Name:     "CI uploads",
+		TenantId: "tenant-1",
+		Scopes:   []string{"videos:write", "videos:read", "videos:write"},
+	})
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(resp.Token, auth.AccessTokenPrefix))
+	assert.True(t, strings.HasPrefix(resp.Token, resp.AccessToken.TokenPrefix))
+	assert.NotContains(t, created.TokenHash, resp.Token, "only the hash of the token is stored")
+	assert.Equal(t, "videos:read videos:write", created.Scopes)
+	assert.WithinDuration(t, time.Now().AddDate(0, 0, 90), created.ExpiresAt, time.Minute)
+
+	// The token resolves to its user, tenant and scopes
+	row := db.GetAccessTokenByHashRow{
+		UserserviceAccessToken: db.UserserviceAccessToken{
+			ID:        created.ID,
+			UserID:    created.UserID,
+			TenantID:  created.TenantID,
+			TokenHash: created.TokenHash,
+			Scopes:    created.Scopes,
+			ExpiresAt: created.ExpiresAt,
+		},
+		Username: "ci",
+		Email:    "ci@example.com",
+	}
+	mockQuerier.EXPECT().
+		GetAccessTokenByHash(gomock.Any(), created.TokenHash).
+		Return(row, nil)
+	mockQuerier.EXPECT().
+		UpdateAccessTokenLastUsed(gomock.Any(), gomock.Any()).
+		Return(nil)
+
+	authContext, err := accessTokenAPI.VerifyAccessToken(resp.Token)
+	require.NoError(t, err)
+	assert.Equal(t, "user-1", authContext.User.ID)
+	assert.Equal(t, "tenant-1", authContext.AccessToken.TenantID)
+	assert.Equal(t, []auth.Scope{auth.ScopeVideosRead, auth.ScopeVideosWrite}, authContext.AccessToken.Scopes)
+
+	// Recently used tokens don't write again
+	row.UserserviceAccessToken.LastUsedAt = sql.NullTime{Time: time.Now(), Valid: true}
+	mockQuerier.EXPECT().
+		GetAccessTokenByHash(gomock.Any(), created.TokenHash).
+		Return(row, nil)
+	_, err = accessTokenAPI.VerifyAccessToken(resp.Token)
+	require.NoError(t, err)
+
+	// Expired tokens are refused
+	row.UserserviceAccessToken.ExpiresAt = time.Now().Add(-time.Minute)
+	mockQuerier.EXPECT().
+		GetAccessTokenByHash(gomock.Any(), created.TokenHash).
+		Return(row, nil)
+	authContext, err = accessTokenAPI.VerifyAccessToken(resp.Token)
+	assert.Error(t, err)
+	assert.False(t, authContext.IsAuthenticated)
+}
+
+func TestCreateAccessToken_Invalid(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockQuerier := mocks.NewMockQuerier(ctrl)
+	accessTokenAPI := api.NewAccessTokenAPITest(mockQuerier, slog.Default())
+	ctx := withAuthContext(context.Background(), &auth.User{ID: "user-1"})
+
+	tests := []struct {
+		name string
+		req  *proto.CreateAccessTokenRequest
+	}{
+		{"No name", &proto.CreateAccessTokenRequest{TenantId: "tenant-1", Scopes: []string{"videos:read"}}},
+		{"No tenant", &proto.CreateAccessTokenRequest{Name: "CI", Scopes: []string{"videos:read"}}},
+		{"No scopes", &proto.CreateAccessTokenRequest{Name: "CI", TenantId: "tenant-1"}},
+		{"Unknown scope", &proto.CreateAccessTokenRequest{Name: "CI", TenantId: "tenant-1", Scopes: []string{"tenants:write"}}},
+		{"Too long", &proto.CreateAccessTokenRequest{Name: "CI", TenantId: "tenant-1", Scopes: []string{"videos:read"}, ExpiresInDays: 366}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := accessTokenAPI.CreateAccessToken(ctx, tt.req)
+			assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		})
+	}
+}
+
+func TestCreateAccessToken_NotAMember(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockQuerier := mocks.NewMockQuerier(ctrl)
+	accessTokenAPI := api.NewAccessTokenAPITest(mockQuerier, slog.Default())
+	ctx := withAuthContext(context.Background(), &auth.User{ID: "user-1"})
+
+	mockQuerier.EXPECT().
+		GetUserRoleInTenant(gomock.Any(), gomock.Any()).
+		Return("", sql.ErrNoRows)
+
+	_, err := accessTokenAPI.CreateAccessToken(ctx, &proto.CreateAccessTokenRequest{Name: "CI", TenantId: "tenant-2", Scopes: []string{"videos:read"}})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+}
+
+func TestRevokeAccessToken(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockQuerier := mocks.NewMockQuerier(ctrl)
+	accessTokenAPI := api.NewAccessTokenAPITest(mockQuerier, slog.Default())
+	ctx := withAuthContext(context.Background(), &auth.User{ID: "user-1"})
+
+	mockQuerier.EXPECT().
+		RevokeAccessToken(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, params db.RevokeAccessTokenParams) (int64, error) {
+			if params.ID == "token-1" && params.UserID == "user-1" {
+				return 1, nil
+			}
+			return 0, nil
+		}).
+		Times(2)
+
+	_, err := accessTokenAPI.RevokeAccessToken(ctx, &proto.RevokeAccessTokenRequest{Id: "token-1"})
+	assert.NoError(t, err)
+
+	// Tokens of other users can't be revoked
+	_, err = accessTokenAPI.RevokeAccessToken(ctx, &proto.RevokeAccessTokenRequest{Id: "token-of-someone-else"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
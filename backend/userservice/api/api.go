@@ -5,6 +5,7 @@ import (
 	"database/sql"
 	"fmt"
 	"log/slog"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
@@ -12,13 +13,22 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
+	commentProto "sortedstartup.com/stream/commentservice/proto"
 	"sortedstartup.com/stream/common/constants"
 	"sortedstartup.com/stream/common/interceptors"
+	notificationProto "sortedstartup.com/stream/notificationservice/proto"
 	"sortedstartup.com/stream/userservice/config"
 	"sortedstartup.com/stream/userservice/db"
 	"sortedstartup.com/stream/userservice/proto"
+	videoProto "sortedstartup.com/stream/videoservice/proto"
 )
 
+// maxLookupHandles bounds a single LookupUsers call, a comment rarely mentions more than a few people
+const maxLookupHandles = 50
+
+// maxDirectoryIDs bounds a single GetUsersByIDs call
+const maxDirectoryIDs = 500
+
 type UserAPI struct {
 	config    config.UserServiceConfig
 	db        *sql.DB
@@ -26,6 +36,7 @@ type UserAPI struct {
 	dbQueries db.Querier
 	userCache *lru.Cache
 	proto.UnimplementedUserServiceServer
+	proto.UnimplementedUserDirectoryServiceServer
 	tenantAPI *TenantAPI
 }
 
@@ -35,9 +46,14 @@ type TenantAPI struct {
 	log       *slog.Logger
 	dbQueries db.Querier
 	proto.UnimplementedTenantServiceServer
+
+	notificationClient notificationProto.NotificationPublisherServiceClient
+	// Membership changes and tenant deletion clean up the data of the other services
+	videoCleanupClient   videoProto.TenantCleanupServiceClient
+	commentCleanupClient commentProto.TenantCleanupServiceClient
 }
 
-func NewUserAPI(config config.UserServiceConfig) (*UserAPI, *TenantAPI, error) {
+func NewUserAPI(config config.UserServiceConfig, notificationClient notificationProto.NotificationPublisherServiceClient, videoCleanupClient videoProto.TenantCleanupServiceClient, commentCleanupClient commentProto.TenantCleanupServiceClient) (*UserAPI, *TenantAPI, error) {
 	slog.Info("NewUserAPI")
 
 	childLogger := slog.With("service", "UserAPI")
@@ -55,10 +71,13 @@ func NewUserAPI(config config.UserServiceConfig) (*UserAPI, *TenantAPI, error) {
 	}
 
 	tenantAPI := &TenantAPI{
-		config:    config,
-		db:        _db,
-		log:       childLogger,
-		dbQueries: dbQueries,
+		config:               config,
+		db:                   _db,
+		log:                  childLogger,
+		dbQueries:            dbQueries,
+		notificationClient:   notificationClient,
+		videoCleanupClient:   videoCleanupClient,
+		commentCleanupClient: commentCleanupClient,
 	}
 
 	userAPI := &UserAPI{
@@ -89,7 +108,19 @@ func NewTenantAPITest(querier db.Querier, logger *slog.Logger) *TenantAPI {
 	}
 }
 
+func NewTenantAPITestWithClients(querier db.Querier, notificationClient notificationProto.NotificationPublisherServiceClient, videoCleanupClient videoProto.TenantCleanupServiceClient, commentCleanupClient commentProto.TenantCleanupServiceClient, logger *slog.Logger) *TenantAPI {
+	return &TenantAPI{
+		dbQueries:            querier,
+		log:                  logger,
+		notificationClient:   notificationClient,
+		videoCleanupClient:   videoCleanupClient,
+		commentCleanupClient: commentCleanupClient,
+	}
+}
+
 func (s *UserAPI) Start() error {
+	go s.tenantAPI.RunTenantPurge(context.Background())
+	go NewSessionAPI(s).RunSessionPurge(context.Background())
 	return nil
 }
 
@@ -156,6 +187,9 @@ func (s *UserAPI) CreateUserIfNotExists(ctx context.Context, req *proto.CreateUs
 				// Don't fail the entire request, just log the error
 			}
 
+			// Invitations sent before the user had an account
+			s.tenantAPI.acceptPendingInvitations(ctx, dbUser)
+
 		} else {
 			s.log.Error("Database error while getting user", "error", err)
 			return nil, status.Error(codes.Internal, "internal server error")
@@ -317,6 +351,66 @@ func (s *TenantAPI) CreateTenant(ctx context.Context, req *proto.CreateTenantReq
 	}, nil
 }
 
+/**
+* UpdateTenant renames a tenant or changes its description - restricted to super_admin only
+* @param ctx context.Context
+* @param req *proto.UpdateTenantRequest
+* @return *proto.UpdateTenantResponse, error
+ */
+func (s *TenantAPI) UpdateTenant(ctx context.Context, req *proto.UpdateTenantRequest) (*proto.UpdateTenantResponse, error) {
+	s.log.Info("UpdateTenant", "tenantID", req.TenantId, "name", req.Name)
+
+	authContext, err := interceptors.AuthFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "unauthenticated")
+	}
+
+	name := strings.TrimSpace(req.Name)
+	if req.TenantId == "" || name == "" {
+		return nil, status.Error(codes.InvalidArgument, "tenant ID and name are required")
+	}
+
+	err = s.requireSuperAdmin(ctx, req.TenantId, authContext.User.ID, "update")
+	if err != nil {
+		return nil, err
+	}
+
+	tenant, err := s.dbQueries.GetTenantByID(ctx, req.TenantId)
+	if err != nil {
+		s.log.Error("Failed to get tenant", "error", err, "tenantID", req.TenantId)
+		return nil, status.Error(codes.Internal, "failed to get tenant")
+	}
+
+	// Same rule as CreateTenant, the names of the tenants someone created are unique
+	if name != tenant.Name {
+		_, err = s.dbQueries.GetTenantByName(ctx, db.GetTenantByNameParams{
+			Name:      name,
+			CreatedBy: tenant.CreatedBy,
+		})
+		if err == nil {
+			return nil, status.Error(codes.AlreadyExists, "A workspace with this name already exists")
+		} else if err != sql.ErrNoRows {
+			s.log.Error("Failed to check for existing tenant name", "error", err)
+			return nil, status.Error(codes.Internal, "failed to validate tenant name")
+		}
+	}
+
+	tenant, err = s.dbQueries.UpdateTenant(ctx, db.UpdateTenantParams{
+		Name:        name,
+		Description: sql.NullString{String: req.Description, Valid: req.Description != ""},
+		ID:          req.TenantId,
+	})
+	if err != nil {
+		s.log.Error("Failed to update tenant", "error", err, "tenantID", req.TenantId)
+		return nil, status.Error(codes.Internal, "failed to update tenant")
+	}
+
+	return &proto.UpdateTenantResponse{
+		Message: "Tenant updated successfully",
+		Tenant:  tenantToProto(tenant),
+	}, nil
+}
+
 /**
 * GetUserTenants returns all tenants a user belongs to
 * @param ctx context.Context
@@ -341,6 +435,14 @@ func (s *UserAPI) GetTenants(ctx context.Context, req *proto.GetTenantsRequest)
 
 	var tenants []*proto.TenantUser
 	for _, row := range tenantRows {
+		// Membership checks of the other services go through here, this keeps access tokens in their tenant
+		if !authContext.AllowsTenant(row.TenantID) {
+			continue
+		}
+		// and the tenants scheduled for deletion out of reach
+		if row.PurgeAfter.Valid && !req.IncludePendingDeletion {
+			continue
+		}
 		tenant := &proto.TenantUser{
 			Tenant: &proto.Tenant{
 				Id:          row.TenantID,
@@ -354,6 +456,9 @@ func (s *UserAPI) GetTenants(ctx context.Context, req *proto.GetTenantsRequest)
 				Role: row.Role,
 			},
 		}
+		if row.PurgeAfter.Valid {
+			tenant.Tenant.PurgeAfter = timestamppb.New(row.PurgeAfter.Time)
+		}
 		tenants = append(tenants, tenant)
 	}
 
@@ -432,6 +537,8 @@ func (s *TenantAPI) AddUser(ctx context.Context, req *proto.AddUserRequest) (*pr
 		return nil, status.Error(codes.Internal, "failed to add user to tenant")
 	}
 
+	s.notifyTenantInvitation(ctx, req.TenantId, authContext.User, user.ID)
+
 	return &proto.AddUserResponse{
 		Message: "User added to tenant successfully",
 	}, nil
@@ -507,3 +614,120 @@ func (s *TenantAPI) GetUsers(ctx context.Context, req *proto.GetUsersRequest) (*
 		TenantUsers: tenantUsersProto,
 	}, nil
 }
+
+/**
+* LookupUsers resolves handles to members of a tenant, used for @mentions.
+* Any member of the tenant can look up other members. A handle is either a full
+* username or the part before the @, and must match exactly one member.
+* @param ctx context.Context
+* @param req *proto.LookupUsersRequest
+* @return *proto.LookupUsersResponse, error
+ */
+func (s *TenantAPI) LookupUsers(ctx context.Context, req *proto.LookupUsersRequest) (*proto.LookupUsersResponse, error) {
+	authContext, err := interceptors.AuthFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "unauthenticated")
+	}
+
+	// Validate input
+	if req.TenantId == "" {
+		return nil, status.Error(codes.InvalidArgument, "tenant ID is required")
+	}
+	if len(req.Handles) > maxLookupHandles {
+		return nil, status.Errorf(codes.InvalidArgument, "at most %d handles can be looked up at once", maxLookupHandles)
+	}
+
+	// Authorization check - any member of the tenant can look up other members
+	_, err = s.dbQueries.GetUserRoleInTenant(ctx, db.GetUserRoleInTenantParams{
+		TenantID: req.TenantId,
+		UserID:   authContext.User.ID,
+	})
+	if err != nil {
+		if err == sql.ErrNoRows {
+			s.log.Warn("User attempted to look up users in tenant they don't belong to", "userID", authContext.User.ID, "tenantID", req.TenantId)
+			return nil, status.Error(codes.PermissionDenied, "access denied: you are not a member of this tenant")
+		}
+		s.log.Error("Failed to check user role in tenant", "error", err)
+		return nil, status.Error(codes.Internal, "failed to check permissions")
+	}
+
+	if len(req.Handles) == 0 {
+		return &proto.LookupUsersResponse{}, nil
+	}
+
+	tenantUsers, err := s.dbQueries.GetTenantUsers(ctx, req.TenantId)
+	if err != nil {
+		s.log.Error("Failed to get tenant users", "error", err)
+		return nil, status.Error(codes.Internal, "failed to get tenant users")
+	}
+
+	// Index members by full username and by the part before the @
+	byUsername := make(map[string]db.GetTenantUsersRow, len(tenantUsers))
+	byShortName := make(map[string][]db.GetTenantUsersRow, len(tenantUsers))
+	for _, user := range tenantUsers {
+		username := strings.ToLower(user.Username)
+		byUsername[username] = user
+		shortName, _, _ := strings.Cut(username, "@")
+		byShortName[shortName] = append(byShortName[shortName], user)
+	}
+
+	response := &proto.LookupUsersResponse{}
+	for _, handle := range req.Handles {
+		key := strings.ToLower(handle)
+
+		user, found := byUsername[key]
+		if !found {
+			// Short names are only usable while they are unambiguous
+			matches := byShortName[key]
+			if len(matches) != 1 {
+				continue
+			}
+			user = matches[0]
+		}
+
+		response.Users = append(response.Users, &proto.ResolvedUser{
+			Handle: handle,
+			User: &proto.User{
+				Id:       user.UserID,
+				Username: user.Username,
+				Email:    user.Email,
+			},
+		})
+	}
+
+	return response, nil
+}
+
+/**
+* GetUsersByIDs returns the users with the given IDs, used by other services
+* that need contact details (e.g. email notifications). Internal only.
+* @param ctx context.Context
+* @param req *proto.GetUsersByIDsRequest
+* @return *proto.GetUsersByIDsResponse, error
+ */
+func (s *UserAPI) GetUsersByIDs(ctx context.Context, req *proto.GetUsersByIDsRequest) (*proto.GetUsersByIDsResponse, error) {
+	if len(req.UserIds) > maxDirectoryIDs {
+		return nil, status.Errorf(codes.InvalidArgument, "at most %d users can be fetched at once", maxDirectoryIDs)
+	}
+	if len(req.UserIds) == 0 {
+		return &proto.GetUsersByIDsResponse{}, nil
+	}
+
+	users, err := s.dbQueries.GetUsersByIDs(ctx, req.UserIds)
+	if err != nil {
+		s.log.Error("Failed to get users by IDs", "error", err)
+		return nil, status.Error(codes.Internal, "failed to get users")
+	}
+
+	response := &proto.GetUsersByIDsResponse{}
+	for _, user := range users {
+		response.Users = append(response.Users, &proto.User{
+			Id:        user.ID,
+			Username:  user.Username,
+			Email:     user.Email,
+			CreatedAt: timestamppb.New(user.CreatedAt),
+		})
+	}
+
+	return response, nil
+}
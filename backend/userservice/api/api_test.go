@@ -79,6 +79,11 @@ func TestCreateUserIfNotExists_CreatesUserWhenNotExists(t *testing.T) {
 			CreatedAt: time.Now(),
 		}, nil)
 
+	// No pending invitations for the new user
+	mockQuerier.EXPECT().
+		ListInvitationsByEmail(gomock.Any(), testUser.Email).
+		Return(nil, nil)
+
 	logger := slog.Default()
 	tenantAPI := api.NewTenantAPITest(mockQuerier, logger)
 	userAPI := api.NewUserAPITest(mockQuerier, cache, tenantAPI, logger)
@@ -183,3 +188,112 @@ func TestCreateUserIfNotExists_CreateUserFails(t *testing.T) {
 	assert.Error(t, err)
 	assert.Contains(t, err.Error(), "failed to create user")
 }
+
+func TestLookupUsers_ResolvesMembers(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockQuerier := mocks.NewMockQuerier(ctrl)
+	user := &auth.User{ID: "123", Email: "test@example.com", Name: "Tester"}
+	ctx := withAuthContext(context.Background(), user)
+	tenantAPI := api.NewTenantAPITest(mockQuerier, slog.Default())
+
+	mockQuerier.EXPECT().
+		GetUserRoleInTenant(gomock.Any(), db.GetUserRoleInTenantParams{TenantID: "tenant-1", UserID: "123"}).
+		Return("member", nil)
+
+	mockQuerier.EXPECT().
+		GetTenantUsers(gomock.Any(), "tenant-1").
+		Return([]db.GetTenantUsersRow{
+			{UserID: "u1", Username: "alice@example.com"},
+			{UserID: "u2", Username: "bob@example.com"},
+			{UserID: "u3", Username: "bob@other.com"},
+		}, nil)
+
+	resp, err := tenantAPI.LookupUsers(ctx, &proto.LookupUsersRequest{
+		TenantId: "tenant-1",
+		Handles:  []string{"Alice", "bob", "bob@other.com", "nobody"},
+	})
+	assert.NoError(t, err)
+
+	// "bob" is ambiguous and "nobody" isn't a member
+	assert.Len(t, resp.Users, 2)
+	assert.Equal(t, "Alice", resp.Users[0].Handle)
+	assert.Equal(t, "u1", resp.Users[0].User.Id)
+	assert.Equal(t, "bob@other.com", resp.Users[1].Handle)
+	assert.Equal(t, "u3", resp.Users[1].User.Id)
+}
+
+func TestLookupUsers_NotAMember(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockQuerier := mocks.NewMockQuerier(ctrl)
+	user := &auth.User{ID: "123", Email: "test@example.com", Name: "Tester"}
+	ctx := withAuthContext(context.Background(), user)
+	tenantAPI := api.NewTenantAPITest(mockQuerier, slog.Default())
+
+	mockQuerier.EXPECT().
+		GetUserRoleInTenant(gomock.Any(), gomock.Any()).
+		Return("", sql.ErrNoRows)
+
+	resp, err := tenantAPI.LookupUsers(ctx, &proto.LookupUsersRequest{
+		TenantId: "tenant-1",
+		Handles:  []string{"alice"},
+	})
+	assert.Nil(t, resp)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "not a member")
+}
+
+func TestUpdateTenant_Renames(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockQuerier := mocks.NewMockQuerier(ctrl)
+	ctx := withAuthContext(context.Background(), &auth.User{ID: "123"})
+	tenantAPI := api.NewTenantAPITest(mockQuerier, slog.Default())
+
+	tenant := db.UserserviceTenant{ID: "tenant-1", Name: "Acme", CreatedBy: "123", CreatedAt: time.Now()}
+	mockQuerier.EXPECT().
+		GetUserRoleInTenant(gomock.Any(), db.GetUserRoleInTenantParams{TenantID: "tenant-1", UserID: "123"}).
+		Return("super_admin", nil)
+	mockQuerier.EXPECT().GetTenantByID(gomock.Any(), "tenant-1").Return(tenant, nil)
+	mockQuerier.EXPECT().
+		GetTenantByName(gomock.Any(), db.GetTenantByNameParams{Name: "Acme Inc", CreatedBy: "123"}).
+		Return(db.UserserviceTenant{}, sql.ErrNoRows)
+	mockQuerier.EXPECT().
+		UpdateTenant(gomock.Any(), db.UpdateTenantParams{
+			Name:        "Acme Inc",
+			Description: sql.NullString{String: "Video team", Valid: true},
+			ID:          "tenant-1",
+		}).
+		DoAndReturn(func(ctx context.Context, params db.UpdateTenantParams) (db.UserserviceTenant, error) {
+			tenant.Name = params.Name
+			tenant.Description = params.Description
+			return tenant, nil
+		})
+
+	resp, err := tenantAPI.UpdateTenant(ctx, &proto.UpdateTenantRequest{TenantId: "tenant-1", Name: " Acme Inc ", Description: "Video team"})
+	assert.NoError(t, err)
+	assert.Equal(t, "Acme Inc", resp.Tenant.Name)
+	assert.Equal(t, "Video team", resp.Tenant.Description)
+}
+
+func TestGetUsersByIDs(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockQuerier := mocks.NewMockQuerier(ctrl)
+	userAPI := api.NewUserAPITest(mockQuerier, nil, nil, slog.Default())
+
+	mockQuerier.EXPECT().
+		GetUsersByIDs(gomock.Any(), []string{"u1", "missing"}).
+		Return([]db.UserserviceUser{{ID: "u1", Username: "alice@example.com", Email: "alice@example.com"}}, nil)
+
+	resp, err := userAPI.GetUsersByIDs(context.Background(), &proto.GetUsersByIDsRequest{UserIds: []string{"u1", "missing"}})
+	assert.NoError(t, err)
+
+	assert.Len(t, resp.Users, 1)
+	assert.Equal(t, "alice@example.com", resp.Users[0].Email)
+}
@@ -0,0 +1,397 @@
+package api
+
+import (
+	"context"
+	"crypto/rand"
+	"database/sql"
+	"encoding/base64"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"sortedstartup.com/stream/common/constants"
+	"sortedstartup.com/stream/common/interceptors"
+	"sortedstartup.com/stream/userservice/db"
+	"sortedstartup.com/stream/userservice/proto"
+)
+
+// invitationTTL is how long an invitation can be accepted, the invitation email mentions it
+const invitationTTL = 7 * 24 * time.Hour
+
+/**
+* InviteUser invites an email address to a tenant - restricted to super_admin only.
+* A new invitation to the same address replaces the pending one.
+* @param ctx context.Context
+* @param req *proto.InviteUserRequest
+* @return *proto.InviteUserResponse, error
+ */
+func (s *TenantAPI) InviteUser(ctx context.Context, req *proto.InviteUserRequest) (*proto.InviteUserResponse, error) {
+	s.log.Info("InviteUser", "tenantID", req.TenantId)
+
+	authContext, err := interceptors.AuthFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "unauthenticated")
+	}
+
+	// Validate input
+	if req.TenantId == "" {
+		return nil, status.Error(codes.InvalidArgument, "tenant ID is required")
+	}
+	email, ok := normalizeEmail(req.Email)
+	if !ok {
+		return nil, status.Error(codes.InvalidArgument, "a valid email is required")
+	}
+	role := req.Role
+	if role == "" {
+		role = constants.TenantRoleMember
+	}
+	if !constants.IsValidTenantRole(role) {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid role %q", role)
+	}
+
+	err = s.requireSuperAdmin(ctx, req.TenantId, authContext.User.ID, "invite users to")
+	if err != nil {
+		return nil, err
+	}
+
+	// Existing members don't need an invitation
+	user, err := s.dbQueries.GetUserByEmail(ctx, email)
+	if err == nil {
+		_, err = s.dbQueries.GetUserRoleInTenant(ctx, db.GetUserRoleInTenantParams{TenantID: req.TenantId, UserID: user.ID})
+		if err == nil {
+			return nil, status.Error(codes.AlreadyExists, "user is already a member of this tenant")
+		}
+	}
+	if err != nil && err != sql.ErrNoRows {
+		s.log.Error("Failed to check existing membership", "error", err, "tenantID", req.TenantId)
+		return nil, status.Error(codes.Internal, "failed to invite user")
+	}
+
+	tenant, err := s.dbQueries.GetTenantByID(ctx, req.TenantId)
+	if err != nil {
+		s.log.Error("Failed to get tenant", "error", err, "tenantID", req.TenantId)
+		return nil, status.Error(codes.Internal, "failed to invite user")
+	}
+
+	now := time.Now()
+	err = s.dbQueries.RevokeInvitationsByTenantAndEmail(ctx, db.RevokeInvitationsByTenantAndEmailParams{
+		RevokedAt: sql.NullTime{Time: now, Valid: true},
+		TenantID:  req.TenantId,
+		Email:     email,
+	})
+	if err != nil {
+		s.log.Error("Failed to revoke previous invitations", "error", err, "tenantID", req.TenantId)
+		return nil, status.Error(codes.Internal, "failed to invite user")
+	}
+
+	token := make([]byte, 32)
+	_, err = rand.Read(token)
+	if err != nil {
+		s.log.Error("Failed to generate invitation token", "error", err)
+		return nil, status.Error(codes.Internal, "failed to invite user")
+	}
+	invitationToken := base64.RawURLEncoding.EncodeToString(token)
+
+	invitation := db.UserserviceInvitation{
+		ID:        uuid.New().String(),
+		TenantID:  req.TenantId,
+		Email:     email,
+		Role:      role,
+		TokenHash: hashToken(invitationToken),
+		InvitedBy: authContext.User.ID,
+		CreatedAt: now,
+		ExpiresAt: now.Add(invitationTTL),
+	}
+	err = s.dbQueries.CreateInvitation(ctx, db.CreateInvitationParams{
+		ID:        invitation.ID,
+		TenantID:  invitation.TenantID,
+		Email:     invitation.Email,
+		Role:      invitation.Role,
+		TokenHash: invitation.TokenHash,
+		InvitedBy: invitation.InvitedBy,
+		CreatedAt: invitation.CreatedAt,
+		ExpiresAt: invitation.ExpiresAt,
+	})
+	if err != nil {
+		s.log.Error("Failed to create invitation", "error", err, "tenantID", req.TenantId)
+		return nil, status.Error(codes.Internal, "failed to invite user")
+	}
+
+	emailQueued := s.sendInvitationEmail(ctx, tenant, authContext.User, email, invitationToken)
+
+	s.log.Info("Invitation created", "invitationID", invitation.ID, "tenantID", req.TenantId, "role", role, "emailQueued", emailQueued)
+	return &proto.InviteUserResponse{
+		Invitation:  invitationToProto(invitation),
+		EmailQueued: emailQueued,
+	}, nil
+}
+
+/**
+* ListInvitations returns the pending invitations of a tenant - restricted to super_admin only
+* @param ctx context.Context
+* @param req *proto.ListInvitationsRequest
+* @return *proto.ListInvitationsResponse, error
+ */
+func (s *TenantAPI) ListInvitations(ctx context.Context, req *proto.ListInvitationsRequest) (*proto.ListInvitationsResponse, error) {
+	authContext, err := interceptors.AuthFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "unauthenticated")
+	}
+	if req.TenantId == "" {
+		return nil, status.Error(codes.InvalidArgument, "tenant ID is required")
+	}
+
+	err = s.requireSuperAdmin(ctx, req.TenantId, authContext.User.ID, "view invitations of")
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := s.dbQueries.ListInvitationsByTenantID(ctx, req.TenantId)
+	if err != nil {
+		s.log.Error("Failed to list invitations", "error", err, "tenantID", req.TenantId)
+		return nil, status.Error(codes.Internal, "failed to list invitations")
+	}
+
+	now := time.Now()
+	invitations := make([]*proto.Invitation, 0, len(rows))
+	for _, row := range rows {
+		if now.After(row.ExpiresAt) {
+			continue
+		}
+		invitations = append(invitations, invitationToProto(row))
+	}
+	return &proto.ListInvitationsResponse{Invitations: invitations}, nil
+}
+
+/**
+* RevokeInvitation cancels a pending invitation - restricted to super_admin only
+* @param ctx context.Context
+* @param req *proto.RevokeInvitationRequest
+* @return *proto.RevokeInvitationResponse, error
+ */
+func (s *TenantAPI) RevokeInvitation(ctx context.Context, req *proto.RevokeInvitationRequest) (*proto.RevokeInvitationResponse, error) {
+	authContext, err := interceptors.AuthFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "unauthenticated")
+	}
+	if req.TenantId == "" || req.Id == "" {
+		return nil, status.Error(codes.InvalidArgument, "tenant ID and invitation ID are required")
+	}
+
+	err = s.requireSuperAdmin(ctx, req.TenantId, authContext.User.ID, "revoke invitations of")
+	if err != nil {
+		return nil, err
+	}
+
+	revoked, err := s.dbQueries.RevokeInvitation(ctx, db.RevokeInvitationParams{
+		RevokedAt: sql.NullTime{Time: time.Now(), Valid: true},
+		ID:        req.Id,
+		TenantID:  req.TenantId,
+	})
+	if err != nil {
+		s.log.Error("Failed to revoke invitation", "error", err, "invitationID", req.Id)
+		return nil, status.Error(codes.Internal, "failed to revoke invitation")
+	}
+	if revoked == 0 {
+		return nil, status.Error(codes.NotFound, "invitation not found")
+	}
+
+	s.log.Info("Invitation revoked", "invitationID", req.Id, "tenantID", req.TenantId, "userID", authContext.User.ID)
+	return &proto.RevokeInvitationResponse{Message: "Invitation revoked"}, nil
+}
+
+/**
+* AcceptInvitation adds the caller to the tenant of an invitation.
+* The token only works for the address it was sent to.
+* @param ctx context.Context
+* @param req *proto.AcceptInvitationRequest
+* @return *proto.AcceptInvitationResponse, error
+ */
+func (s *TenantAPI) AcceptInvitation(ctx context.Context, req *proto.AcceptInvitationRequest) (*proto.AcceptInvitationResponse, error) {
+	authContext, err := interceptors.AuthFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "unauthenticated")
+	}
+
+	row, err := s.invitationForCaller(ctx, req.Token, authContext.User.Email)
+	if err != nil {
+		return nil, err
+	}
+
+	tenantUser, err := s.acceptInvitation(ctx, row.UserserviceInvitation, authContext.User.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &proto.AcceptInvitationResponse{
+		TenantUser: &proto.TenantUser{
+			Tenant: &proto.Tenant{Id: row.UserserviceInvitation.TenantID, Name: row.TenantName},
+			Role:   &proto.Role{Role: tenantUser.Role},
+		},
+	}, nil
+}
+
+/**
+* DeclineInvitation turns down an invitation, it can't be accepted afterwards
+* @param ctx context.Context
+* @param req *proto.DeclineInvitationRequest
+* @return *proto.DeclineInvitationResponse, error
+ */
+func (s *TenantAPI) DeclineInvitation(ctx context.Context, req *proto.DeclineInvitationRequest) (*proto.DeclineInvitationResponse, error) {
+	authContext, err := interceptors.AuthFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "unauthenticated")
+	}
+
+	row, err := s.invitationForCaller(ctx, req.Token, authContext.User.Email)
+	if err != nil {
+		return nil, err
+	}
+	invitation := row.UserserviceInvitation
+
+	declined, err := s.dbQueries.DeclineInvitation(ctx, db.DeclineInvitationParams{
+		DeclinedAt: sql.NullTime{Time: time.Now(), Valid: true},
+		ID:         invitation.ID,
+	})
+	if err != nil {
+		s.log.Error("Failed to decline invitation", "error", err, "invitationID", invitation.ID)
+		return nil, status.Error(codes.Internal, "failed to decline invitation")
+	}
+	if declined == 0 {
+		return nil, status.Error(codes.NotFound, "invitation not found")
+	}
+
+	s.log.Info("Invitation declined", "invitationID", invitation.ID, "tenantID", invitation.TenantID)
+	return &proto.DeclineInvitationResponse{Message: "Invitation declined"}, nil
+}
+
+// acceptPendingInvitations adds a new user to every tenant their email was invited to.
+// It runs when a user first signs in, failures are logged and don't fail the sign in.
+func (s *TenantAPI) acceptPendingInvitations(ctx context.Context, user db.UserserviceUser) {
+	email, ok := normalizeEmail(user.Email)
+	if !ok {
+		return
+	}
+
+	invitations, err := s.dbQueries.ListInvitationsByEmail(ctx, email)
+	if err != nil {
+		s.log.Error("Failed to list invitations", "error", err, "userID", user.ID)
+		return
+	}
+
+	now := time.Now()
+	for _, invitation := range invitations {
+		if now.After(invitation.ExpiresAt) {
+			continue
+		}
+		_, err = s.acceptInvitation(ctx, invitation, user.ID)
+		if err != nil {
+			s.log.Error("Failed to accept invitation", "error", err, "invitationID", invitation.ID, "userID", user.ID)
+		}
+	}
+}
+
+// acceptInvitation marks the invitation accepted and adds the user to its tenant with its role
+func (s *TenantAPI) acceptInvitation(ctx context.Context, invitation db.UserserviceInvitation, userID string) (db.UserserviceTenantUser, error) {
+	// Members keep their current role
+	_, err := s.dbQueries.GetUserRoleInTenant(ctx, db.GetUserRoleInTenantParams{TenantID: invitation.TenantID, UserID: userID})
+	if err == nil {
+		return db.UserserviceTenantUser{}, status.Error(codes.AlreadyExists, "you are already a member of this tenant")
+	}
+	if err != sql.ErrNoRows {
+		s.log.Error("Failed to check user role in tenant", "error", err, "tenantID", invitation.TenantID)
+		return db.UserserviceTenantUser{}, status.Error(codes.Internal, "failed to accept invitation")
+	}
+
+	// Claiming the invitation first means a concurrent accept can't add the user twice
+	now := time.Now()
+	accepted, err := s.dbQueries.AcceptInvitation(ctx, db.AcceptInvitationParams{
+		AcceptedAt: sql.NullTime{Time: now, Valid: true},
+		AcceptedBy: sql.NullString{String: userID, Valid: true},
+		ID:         invitation.ID,
+	})
+	if err != nil {
+		s.log.Error("Failed to accept invitation", "error", err, "invitationID", invitation.ID)
+		return db.UserserviceTenantUser{}, status.Error(codes.Internal, "failed to accept invitation")
+	}
+	if accepted == 0 {
+		return db.UserserviceTenantUser{}, status.Error(codes.NotFound, "invitation not found")
+	}
+
+	tenantUser, err := s.dbQueries.CreateTenantUser(ctx, db.CreateTenantUserParams{
+		ID:        uuid.New().String(),
+		TenantID:  invitation.TenantID,
+		UserID:    userID,
+		Role:      invitation.Role,
+		CreatedAt: now,
+	})
+	if err != nil {
+		s.log.Error("Failed to add invited user to tenant", "error", err, "invitationID", invitation.ID, "userID", userID)
+		return db.UserserviceTenantUser{}, status.Error(codes.Internal, "failed to accept invitation")
+	}
+
+	s.log.Info("Invitation accepted", "invitationID", invitation.ID, "tenantID", invitation.TenantID, "userID", userID)
+	return tenantUser, nil
+}
+
+// invitationForCaller looks up a pending invitation by its token, it must have been sent to the caller's email
+func (s *TenantAPI) invitationForCaller(ctx context.Context, token string, callerEmail string) (db.GetInvitationByTokenHashRow, error) {
+	if token == "" {
+		return db.GetInvitationByTokenHashRow{}, status.Error(codes.InvalidArgument, "invitation token is required")
+	}
+
+	row, err := s.dbQueries.GetInvitationByTokenHash(ctx, hashToken(token))
+	if err == sql.ErrNoRows {
+		return db.GetInvitationByTokenHashRow{}, status.Error(codes.NotFound, "invitation not found")
+	}
+	if err != nil {
+		s.log.Error("Failed to get invitation", "error", err)
+		return db.GetInvitationByTokenHashRow{}, status.Error(codes.Internal, "failed to get invitation")
+	}
+	if time.Now().After(row.UserserviceInvitation.ExpiresAt) {
+		return db.GetInvitationByTokenHashRow{}, status.Error(codes.FailedPrecondition, "invitation has expired")
+	}
+
+	// A forwarded invitation email doesn't let someone else join
+	email, _ := normalizeEmail(callerEmail)
+	if email != row.UserserviceInvitation.Email {
+		return db.GetInvitationByTokenHashRow{}, status.Error(codes.PermissionDenied, "this invitation was sent to another email address")
+	}
+	return row, nil
+}
+
+// requireSuperAdmin checks the user is a super_admin of the tenant, action describes the refused operation
+func (s *TenantAPI) requireSuperAdmin(ctx context.Context, tenantID, userID, action string) error {
+	userRole, err := s.dbQueries.GetUserRoleInTenant(ctx, db.GetUserRoleInTenantParams{
+		TenantID: tenantID,
+		UserID:   userID,
+	})
+	if err != nil {
+		if err == sql.ErrNoRows {
+			s.log.Warn("User attempted to access tenant they don't belong to", "userID", userID, "tenantID", tenantID)
+			return status.Error(codes.PermissionDenied, "access denied: you are not a member of this tenant")
+		}
+		s.log.Error("Failed to check user role in tenant", "error", err)
+		return status.Error(codes.Internal, "failed to check permissions")
+	}
+
+	if userRole != constants.TenantRoleSuperAdmin {
+		s.log.Warn("Non-super-admin user attempted a super admin operation", "userID", userID, "role", userRole, "tenantID", tenantID, "action", action)
+		return status.Errorf(codes.PermissionDenied, "access denied: only super admins can %s tenant", action)
+	}
+	return nil
+}
+
+func invitationToProto(invitation db.UserserviceInvitation) *proto.Invitation {
+	return &proto.Invitation{
+		Id:        invitation.ID,
+		TenantId:  invitation.TenantID,
+		Email:     invitation.Email,
+		Role:      invitation.Role,
+		InvitedBy: invitation.InvitedBy,
+		CreatedAt: timestamppb.New(invitation.CreatedAt),
+		ExpiresAt: timestamppb.New(invitation.ExpiresAt),
+	}
+}
@@ -0,0 +1,297 @@
+package api_test
+
+import (
+	"context"
+	"database/sql"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	lru "github.com/hashicorp/golang-lru"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"sortedstartup.com/stream/common/auth"
+	notificationProto "sortedstartup.com/stream/notificationservice/proto"
+	"sortedstartup.com/stream/userservice/api"
+	"sortedstartup.com/stream/userservice/db"
+	"sortedstartup.com/stream/userservice/db/mocks"
+	"sortedstartup.com/stream/userservice/proto"
+)
+
+func TestInviteUser_AndAccept(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockQuerier := mocks.NewMockQuerier(ctrl)
+	mockNotifications := notificationProto.NewMockNotificationPublisherServiceClient(ctrl)
+	tenantAPI := api.NewTenantAPITestWithClients(mockQuerier, mockNotifications, nil, nil, slog.Default())
+	adminCtx := withAuthContext(context.Background(), &auth.User{ID: "admin-1", Name: "Alice"})
+
+	mockQuerier.EXPECT().
+		GetUserRoleInTenant(gomock.Any(), db.GetUserRoleInTenantParams{TenantID: "tenant-1", UserID: "admin-1"}).
+		Return("super_admin", nil)
+	mockQuerier.EXPECT().
+		GetUserByEmail(gomock.Any(), "bob@example.com").
+		Return(db.UserserviceUser{}, sql.ErrNoRows)
+	mockQuerier.EXPECT().
+		GetTenantByID(gomock.Any(), "tenant-1").
+		Return(db.UserserviceTenant{ID: "tenant-1", Name: "Acme"}, nil)
+	mockQuerier.EXPECT().
+		RevokeInvitationsByTenantAndEmail(gomock.Any(), gomock.Any()).
+		Return(nil)
+
+	var created db.CreateInvitationParams
+	mockQuerier.EXPECT().
+		CreateInvitation(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, params db.CreateInvitationParams) error {
+			created = params
+			return nil
+		})
+
+	var sent *notificationProto.SendInvitationRequest
+	mockNotifications.EXPECT().
+		SendInvitation(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, req *notificationProto.SendInvitationRequest, opts ...grpc.CallOption) (*notificationProto.SendInvitationResponse, error) {
+			sent = req
+			return &notificationProto.SendInvitationResponse{Queued: true}, nil
+		})
+
+	resp, err := tenantAPI.InviteUser(adminCtx, &proto.InviteUserRequest{TenantId: "tenant-1", Email: " Bob@Example.com "})
+	require.NoError(t, err)
+	assert.True(t, resp.EmailQueued)
+	assert.Equal(t, "bob@example.com", created.Email)
+	assert.Equal(t, "member", created.Role)
+	assert.WithinDuration(t, time.Now().Add(7*24*time.Hour), created.ExpiresAt, time.Minute)
+	assert.Equal(t, "Acme", sent.TenantName)
+	assert.NotEqual(t, sent.Token, created.TokenHash, "only the hash of the token is stored")
+
+	// The invitee accepts with the token of the email
+	row := db.GetInvitationByTokenHashRow{
+		UserserviceInvitation: db.UserserviceInvitation{
+			ID:        created.ID,
+			TenantID:  created.TenantID,
+			Email:     created.Email,
+			Role:      created.Role,
+			TokenHash: created.TokenHash,
+			ExpiresAt: created.ExpiresAt,
+		},
+		TenantName: "Acme",
+	}
+	mockQuerier.EXPECT().
+		GetInvitationByTokenHash(gomock.Any(), created.TokenHash).
+		Return(row, nil)
+	mockQuerier.EXPECT().
+		GetUserRoleInTenant(gomock.Any(), db.GetUserRoleInTenantParams{TenantID: "tenant-1", UserID: "bob"}).
+		Return("", sql.ErrNoRows)
+	mockQuerier.EXPECT().
+		AcceptInvitation(gomock.Any(), gomock.Any()).
+		Return(int64(1), nil)
+	mockQuerier.EXPECT().
+		CreateTenantUser(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, params db.CreateTenantUserParams) (db.UserserviceTenantUser, error) {
+			assert.Equal(t, "bob", params.UserID)
+			assert.Equal(t, "member", params.Role)
+			return db.UserserviceTenantUser(params), nil
+		})
+
+	bobCtx := withAuthContext(context.Background(), &auth.User{ID: "bob", Email: "bob@example.com"})
+	acceptResp, err := tenantAPI.AcceptInvitation(bobCtx, &proto.AcceptInvitationRequest{Token: sent.Token})
+	require.NoError(t, err)
+	assert.Equal(t, "tenant-1", acceptResp.TenantUser.Tenant.Id)
+	assert.Equal(t, "member", acceptResp.TenantUser.Role.Role)
+}
+
+func TestInviteUser_Refused(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockQuerier := mocks.NewMockQuerier(ctrl)
+	tenantAPI := api.NewTenantAPITest(mockQuerier, slog.Default())
+	ctx := withAuthContext(context.Background(), &auth.User{ID: "user-1"})
+
+	// Invalid input is refused before any lookup
+	_, err := tenantAPI.InviteUser(ctx, &proto.InviteUserRequest{TenantId: "tenant-1", Email: "not an email"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = tenantAPI.InviteUser(ctx, &proto.InviteUserRequest{TenantId: "tenant-1", Email: "bob@example.com", Role: "owner"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// Members can't invite
+	mockQuerier.EXPECT().
+		GetUserRoleInTenant(gomock.Any(), db.GetUserRoleInTenantParams{TenantID: "tenant-1", UserID: "user-1"}).
+		Return("member", nil)
+	_, err = tenantAPI.InviteUser(ctx, &proto.InviteUserRequest{TenantId: "tenant-1", Email: "bob@example.com"})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	// Existing members don't get an invitation
+	mockQuerier.EXPECT().
+		GetUserRoleInTenant(gomock.Any(), db.GetUserRoleInTenantParams{TenantID: "tenant-1", UserID: "user-1"}).
+		Return("super_admin", nil)
+	mockQuerier.EXPECT().
+		GetUserByEmail(gomock.Any(), "bob@example.com").
+		Return(db.UserserviceUser{ID: "bob"}, nil)
+	mockQuerier.EXPECT().
+		GetUserRoleInTenant(gomock.Any(), db.GetUserRoleInTenantParams{TenantID: "tenant-1", UserID: "bob"}).
+		Return("member", nil)
+	_, err = tenantAPI.InviteUser(ctx, &proto.InviteUserRequest{TenantId: "tenant-1", Email: "bob@example.com"})
+	assert.Equal(t, codes.AlreadyExists, status.Code(err))
+}
+
+func TestAcceptInvitation_Refused(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockQuerier := mocks.NewMockQuerier(ctrl)
+	tenantAPI := api.NewTenantAPITest(mockQuerier, slog.Default())
+
+	invitation := db.UserserviceInvitation{
+		ID:        "invitation-1",
+		TenantID:  "tenant-1",
+		Email:     "bob@example.com",
+		Role:      "member",
+		ExpiresAt: time.Now().Add(time.Hour),
+	}
+
+	// A forwarded invitation can't be used by someone else
+	mockQuerier.EXPECT().
+		GetInvitationByTokenHash(gomock.Any(), gomock.Any()).
+		Return(db.GetInvitationByTokenHashRow{UserserviceInvitation: invitation}, nil)
+	eveCtx := withAuthContext(context.Background(), &auth.User{ID: "eve", Email: "eve@example.com"})
+	_, err := tenantAPI.AcceptInvitation(eveCtx, &proto.AcceptInvitationRequest{Token: "token"})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	// Expired invitations can't be accepted
+	invitation.ExpiresAt = time.Now().Add(-time.Minute)
+	mockQuerier.EXPECT().
+		GetInvitationByTokenHash(gomock.Any(), gomock.Any()).
+		Return(db.GetInvitationByTokenHashRow{UserserviceInvitation: invitation}, nil)
+	bobCtx := withAuthContext(context.Background(), &auth.User{ID: "bob", Email: "bob@example.com"})
+	_, err = tenantAPI.AcceptInvitation(bobCtx, &proto.AcceptInvitationRequest{Token: "token"})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	// Unknown, revoked and used tokens look the same
+	mockQuerier.EXPECT().
+		GetInvitationByTokenHash(gomock.Any(), gomock.Any()).
+		Return(db.GetInvitationByTokenHashRow{}, sql.ErrNoRows)
+	_, err = tenantAPI.AcceptInvitation(bobCtx, &proto.AcceptInvitationRequest{Token: "token"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestDeclineInvitation(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockQuerier := mocks.NewMockQuerier(ctrl)
+	tenantAPI := api.NewTenantAPITest(mockQuerier, slog.Default())
+	ctx := withAuthContext(context.Background(), &auth.User{ID: "bob", Email: "bob@example.com"})
+
+	mockQuerier.EXPECT().
+		GetInvitationByTokenHash(gomock.Any(), gomock.Any()).
+		Return(db.GetInvitationByTokenHashRow{UserserviceInvitation: db.UserserviceInvitation{
+			ID:        "invitation-1",
+			TenantID:  "tenant-1",
+			Email:     "bob@example.com",
+			ExpiresAt: time.Now().Add(time.Hour),
+		}}, nil)
+	mockQuerier.EXPECT().
+		DeclineInvitation(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, params db.DeclineInvitationParams) (int64, error) {
+			assert.Equal(t, "invitation-1", params.ID)
+			return 1, nil
+		})
+
+	_, err := tenantAPI.DeclineInvitation(ctx, &proto.DeclineInvitationRequest{Token: "token"})
+	assert.NoError(t, err)
+}
+
+func TestRevokeInvitation(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockQuerier := mocks.NewMockQuerier(ctrl)
+	tenantAPI := api.NewTenantAPITest(mockQuerier, slog.Default())
+	ctx := withAuthContext(context.Background(), &auth.User{ID: "admin-1"})
+
+	mockQuerier.EXPECT().
+		GetUserRoleInTenant(gomock.Any(), gomock.Any()).
+		Return("super_admin", nil).
+		Times(2)
+	mockQuerier.EXPECT().
+		RevokeInvitation(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, params db.RevokeInvitationParams) (int64, error) {
+			if params.ID == "invitation-1" && params.TenantID == "tenant-1" {
+				return 1, nil
+			}
+			return 0, nil
+		}).
+		Times(2)
+
+	_, err := tenantAPI.RevokeInvitation(ctx, &proto.RevokeInvitationRequest{TenantId: "tenant-1", Id: "invitation-1"})
+	assert.NoError(t, err)
+
+	// Invitations of other tenants look the same as missing ones
+	_, err = tenantAPI.RevokeInvitation(ctx, &proto.RevokeInvitationRequest{TenantId: "tenant-1", Id: "invitation-of-tenant-2"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestCreateUserIfNotExists_AcceptsPendingInvitations(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockQuerier := mocks.NewMockQuerier(ctrl)
+	cache, _ := lru.New(128)
+	logger := slog.Default()
+	user := &auth.User{ID: "bob", Email: "bob@example.com", Name: "Bob"}
+	ctx := withAuthContext(context.Background(), user)
+
+	mockQuerier.EXPECT().
+		GetUserByEmail(gomock.Any(), user.Email).
+		Return(db.UserserviceUser{}, sql.ErrNoRows)
+	mockQuerier.EXPECT().
+		CreateUser(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, params db.CreateUserParams) (db.UserserviceUser, error) {
+			return db.UserserviceUser(params), nil
+		})
+	mockQuerier.EXPECT().
+		CreateTenant(gomock.Any(), gomock.Any()).
+		Return(db.UserserviceTenant{ID: "personal-tenant"}, nil)
+
+	// The personal tenant and the tenant of the invitation that hasn't expired
+	var memberships []db.CreateTenantUserParams
+	mockQuerier.EXPECT().
+		CreateTenantUser(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, params db.CreateTenantUserParams) (db.UserserviceTenantUser, error) {
+			memberships = append(memberships, params)
+			return db.UserserviceTenantUser(params), nil
+		}).
+		Times(2)
+
+	mockQuerier.EXPECT().
+		ListInvitationsByEmail(gomock.Any(), "bob@example.com").
+		Return([]db.UserserviceInvitation{
+			{ID: "invitation-1", TenantID: "tenant-1", Email: "bob@example.com", Role: "super_admin", ExpiresAt: time.Now().Add(time.Hour)},
+			{ID: "invitation-2", TenantID: "tenant-2", Email: "bob@example.com", Role: "member", ExpiresAt: time.Now().Add(-time.Hour)},
+		}, nil)
+	mockQuerier.EXPECT().
+		GetUserRoleInTenant(gomock.Any(), db.GetUserRoleInTenantParams{TenantID: "tenant-1", UserID: "bob"}).
+		Return("", sql.ErrNoRows)
+	mockQuerier.EXPECT().
+		AcceptInvitation(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, params db.AcceptInvitationParams) (int64, error) {
+			assert.Equal(t, "invitation-1", params.ID)
+			assert.Equal(t, "bob", params.AcceptedBy.String)
+			return 1, nil
+		})
+
+	tenantAPI := api.NewTenantAPITest(mockQuerier, logger)
+	userAPI := api.NewUserAPITest(mockQuerier, cache, tenantAPI, logger)
+
+	_, err := userAPI.CreateUserIfNotExists(ctx, &proto.CreateUserRequest{})
+	require.NoError(t, err)
+	require.Len(t, memberships, 2)
+	assert.Equal(t, "tenant-1", memberships[1].TenantID)
+	assert.Equal(t, "super_admin", memberships[1].Role)
+}
@@ -0,0 +1,463 @@
+package api
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/base64"
+	"encoding/hex"
+	"log/slog"
+	"net/mail"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"sortedstartup.com/stream/common/auth"
+	"sortedstartup.com/stream/common/interceptors"
+	"sortedstartup.com/stream/userservice/config"
+	"sortedstartup.com/stream/userservice/db"
+	"sortedstartup.com/stream/userservice/proto"
+)
+
+// passwordResetTTL is how long an admin issued reset token can be redeemed
+const passwordResetTTL = 24 * time.Hour
+
+// LocalAuthPublicMethods are called without a token, they are how clients get one
+var LocalAuthPublicMethods = []string{
+	proto.LocalAuthService_Signup_FullMethodName,
+	proto.LocalAuthService_Login_FullMethodName,
+	proto.LocalAuthService_CompletePasswordReset_FullMethodName,
+}
+
+// dummyPasswordHash is checked when a login has no password to check against,
+// so that it takes as long whether or not the account exists
+var dummyPasswordHash = sync.OnceValue(func() string {
+	hash, _ := hashPassword("not the password of anybody")
+	return hash
+})
+
+// LocalAuthAPI serves the built-in email/password accounts, it shares the database of UserAPI
+type LocalAuthAPI struct {
+	config    config.UserServiceConfig
+	log       *slog.Logger
+	dbQueries db.Querier
+	tenantAPI *TenantAPI
+	tokens    *auth.Local
+	proto.UnimplementedLocalAuthServiceServer
+}
+
+var _ auth.LocalTokenVersions = (*LocalAuthAPI)(nil)
+
+func NewLocalAuthAPI(userAPI *UserAPI, tokens *auth.Local) *LocalAuthAPI {
+	return &LocalAuthAPI{
+		config:    userAPI.config,
+		log:       userAPI.log.With("api", "LocalAuthAPI"),
+		dbQueries: userAPI.dbQueries,
+		tenantAPI: userAPI.tenantAPI,
+		tokens:    tokens,
+	}
+}
+
+func NewLocalAuthAPITest(querier db.Querier, tenantAPI *TenantAPI, tokens *auth.Local, config config.UserServiceConfig, logger *slog.Logger) *LocalAuthAPI {
+	return &LocalAuthAPI{
+		config:    config,
+		log:       logger,
+		dbQueries: querier,
+		tenantAPI: tenantAPI,
+		tokens:    tokens,
+	}
+}
+
+func (s *LocalAuthAPI) Signup(ctx context.Context, req *proto.SignupRequest) (*proto.LoginResponse, error) {
+	if !s.config.LocalAuth.SignupEnabled {
+		return nil, status.Error(codes.PermissionDenied, "signups are disabled, ask an admin for an account")
+	}
+
+	email, ok := normalizeEmail(req.Email)
+	if !ok {
+		return nil, status.Error(codes.InvalidArgument, "invalid email")
+	}
+	if !auth.IsEmailAllowed(email) {
+		return nil, status.Error(codes.PermissionDenied, "this email is not allowed to sign up")
+	}
+	err := validatePassword(req.Password)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	_, err = s.dbQueries.GetUserByEmail(ctx, email)
+	if err == nil {
+		return nil, status.Error(codes.AlreadyExists, "an account with this email already exists")
+	}
+	if err != sql.ErrNoRows {
+		s.log.Error("Failed to get user by email", "err", err)
+		return nil, status.Error(codes.Internal, "failed to sign up")
+	}
+
+	passwordHash, err := hashPassword(req.Password)
+	if err != nil {
+		s.log.Error("Failed to hash password", "err", err)
+		return nil, status.Error(codes.Internal, "failed to sign up")
+	}
+
+	now := time.Now()
+	dbUser, err := s.dbQueries.CreateUser(ctx, db.CreateUserParams{
+		ID:        uuid.New().String(),
+		Username:  email,
+		Email:     email,
+		CreatedAt: now,
+	})
+	if err != nil {
+		s.log.Error("Failed to create user", "err", err)
+		return nil, status.Error(codes.Internal, "failed to sign up")
+	}
+
+	err = s.dbQueries.UpsertLocalCredential(ctx, db.UpsertLocalCredentialParams{
+		UserID:       dbUser.ID,
+		PasswordHash: passwordHash,
+		UpdatedAt:    now,
+	})
+	if err != nil {
+		s.log.Error("Failed to save password", "err", err, "userID", dbUser.ID)
+		return nil, status.Error(codes.Internal, "failed to sign up")
+	}
+
+	// Same as CreateUserIfNotExists for users of the other providers
+	name := strings.TrimSpace(req.Name)
+	if name == "" {
+		name = email
+	}
+	tenantCtx := context.WithValue(ctx, auth.AUTH_CONTEXT_KEY, &auth.AuthContext{
+		User:            &auth.User{ID: dbUser.ID, Name: name, Email: email},
+		IsAuthenticated: true,
+	})
+	err = s.tenantAPI.createPersonalTenant(tenantCtx)
+	if err != nil {
+		s.log.Error("Failed to create personal tenant", "err", err, "userID", dbUser.ID)
+		// Don't fail the entire request, just log the error
+	}
+
+	// Pending invitations are not accepted here like for the other providers, nobody verified that the
+	// signer-up owns this email. They accept with AcceptInvitation and the token, which was emailed to it.
+
+	s.log.Info("Local account created", "userID", dbUser.ID)
+	return s.loginResponse(ctx, dbUser)
+}
+
+func (s *LocalAuthAPI) Login(ctx context.Context, req *proto.LoginRequest) (*proto.LoginResponse, error) {
+	invalidCredentials := status.Error(codes.Unauthenticated, "invalid email or password")
+
+	email, ok := normalizeEmail(req.Email)
+	if !ok || len(req.Password) > maxPasswordLength {
+		return nil, invalidCredentials
+	}
+
+	dbUser, err := s.dbQueries.GetUserByEmail(ctx, email)
+	if err == sql.ErrNoRows {
+		verifyPassword(req.Password, dummyPasswordHash())
+		return nil, invalidCredentials
+	}
+	if err != nil {
+		s.log.Error("Failed to get user by email", "err", err)
+		return nil, status.Error(codes.Internal, "failed to log in")
+	}
+
+	match, err := s.checkPassword(ctx, dbUser.ID, req.Password)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to log in")
+	}
+	if !match {
+		return nil, invalidCredentials
+	}
+
+	return s.loginResponse(ctx, dbUser)
+}
+
+func (s *LocalAuthAPI) ChangePassword(ctx context.Context, req *proto.ChangePasswordRequest) (*proto.ChangePasswordResponse, error) {
+	authContext, err := interceptors.AuthFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "unauthenticated")
+	}
+
+	err = validatePassword(req.NewPassword)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if len(req.CurrentPassword) > maxPasswordLength {
+		return nil, status.Error(codes.PermissionDenied, "current password is incorrect")
+	}
+
+	match, err := s.checkPassword(ctx, authContext.User.ID, req.CurrentPassword)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to change password")
+	}
+	if !match {
+		return nil, status.Error(codes.PermissionDenied, "current password is incorrect")
+	}
+
+	err = s.setPassword(ctx, authContext.User.ID, req.NewPassword)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to change password")
+	}
+	err = s.revokeSessions(ctx, authContext.User.ID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "password changed, but other sessions could not be signed out")
+	}
+
+	// The caller's token is outdated now as well
+	users, err := s.dbQueries.GetUsersByIDs(ctx, []string{authContext.User.ID})
+	if err != nil || len(users) == 0 {
+		s.log.Error("Failed to get user after password change", "err", err, "userID", authContext.User.ID)
+		return nil, status.Error(codes.Internal, "password changed, sign in again")
+	}
+	token, expiresAt, err := s.issueToken(ctx, users[0])
+	if err != nil {
+		return nil, status.Error(codes.Internal, "password changed, sign in again")
+	}
+
+	s.log.Info("Password changed", "userID", authContext.User.ID)
+	return &proto.ChangePasswordResponse{
+		Message:   "Password changed",
+		Token:     token,
+		ExpiresAt: timestamppb.New(expiresAt),
+	}, nil
+}
+
+func (s *LocalAuthAPI) ResetPassword(ctx context.Context, req *proto.ResetPasswordRequest) (*proto.ResetPasswordResponse, error) {
+	authContext, err := interceptors.AuthFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "unauthenticated")
+	}
+	if !slices.Contains(authContext.User.Roles, auth.Admin) {
+		return nil, status.Error(codes.PermissionDenied, "only admins can reset passwords")
+	}
+
+	email, ok := normalizeEmail(req.Email)
+	if !ok {
+		return nil, status.Error(codes.InvalidArgument, "invalid email")
+	}
+	dbUser, err := s.dbQueries.GetUserByEmail(ctx, email)
+	if err == sql.ErrNoRows {
+		return nil, status.Error(codes.NotFound, "user not found")
+	}
+	if err != nil {
+		s.log.Error("Failed to get user by email", "err", err)
+		return nil, status.Error(codes.Internal, "failed to reset password")
+	}
+
+	token := make([]byte, 32)
+	_, err = rand.Read(token)
+	if err != nil {
+		s.log.Error("Failed to generate reset token", "err", err)
+		return nil, status.Error(codes.Internal, "failed to reset password")
+	}
+	resetToken := base64.RawURLEncoding.EncodeToString(token)
+
+	now := time.Now()
+	expiresAt := now.Add(passwordResetTTL)
+	err = s.dbQueries.CreatePasswordReset(ctx, db.CreatePasswordResetParams{
+		TokenHash: hashToken(resetToken),
+		UserID:    dbUser.ID,
+		CreatedBy: authContext.User.ID,
+		ExpiresAt: expiresAt,
+		CreatedAt: now,
+	})
+	if err != nil {
+		s.log.Error("Failed to save password reset", "err", err, "userID", dbUser.ID)
+		return nil, status.Error(codes.Internal, "failed to reset password")
+	}
+
+	s.log.Info("Password reset created", "userID", dbUser.ID, "adminID", authContext.User.ID)
+	return &proto.ResetPasswordResponse{
+		ResetToken: resetToken,
+		ExpiresAt:  timestamppb.New(expiresAt),
+	}, nil
+}
+
+func (s *LocalAuthAPI) CompletePasswordReset(ctx context.Context, req *proto.CompletePasswordResetRequest) (*proto.LoginResponse, error) {
+	invalidToken := status.Error(codes.InvalidArgument, "invalid or expired reset token")
+
+	err := validatePassword(req.NewPassword)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	tokenHash := hashToken(req.ResetToken)
+	reset, err := s.dbQueries.GetPasswordResetByTokenHash(ctx, tokenHash)
+	if err == sql.ErrNoRows {
+		return nil, invalidToken
+	}
+	if err != nil {
+		s.log.Error("Failed to get password reset", "err", err)
+		return nil, status.Error(codes.Internal, "failed to reset password")
+	}
+	if reset.UsedAt.Valid || time.Now().After(reset.ExpiresAt) {
+		return nil, invalidToken
+	}
+
+	// Claim the token first, a token can only be used once even by concurrent requests
+	claimed, err := s.dbQueries.MarkPasswordResetUsed(ctx, db.MarkPasswordResetUsedParams{
+		TokenHash: tokenHash,
+		UsedAt:    sql.NullTime{Time: time.Now(), Valid: true},
+	})
+	if err != nil {
+		s.log.Error("Failed to mark password reset as used", "err", err)
+		return nil, status.Error(codes.Internal, "failed to reset password")
+	}
+	if claimed == 0 {
+		return nil, invalidToken
+	}
+
+	err = s.setPassword(ctx, reset.UserID, req.NewPassword)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to reset password")
+	}
+	err = s.revokeSessions(ctx, reset.UserID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "password reset, but existing sessions could not be signed out")
+	}
+
+	users, err := s.dbQueries.GetUsersByIDs(ctx, []string{reset.UserID})
+	if err != nil || len(users) == 0 {
+		s.log.Error("Failed to get user after password reset", "err", err, "userID", reset.UserID)
+		return nil, status.Error(codes.Internal, "failed to reset password")
+	}
+
+	s.log.Info("Password reset completed", "userID", reset.UserID)
+	return s.loginResponse(ctx, users[0])
+}
+
+// checkPassword reports whether the password is the user's. Users without a password never match.
+func (s *LocalAuthAPI) checkPassword(ctx context.Context, userID, password string) (bool, error) {
+	credential, err := s.dbQueries.GetLocalCredentialByUserID(ctx, userID)
+	if err == sql.ErrNoRows {
+		verifyPassword(password, dummyPasswordHash())
+		return false, nil
+	}
+	if err != nil {
+		s.log.Error("Failed to get local credential", "err", err, "userID", userID)
+		return false, err
+	}
+
+	match, err := verifyPassword(password, credential.PasswordHash)
+	if err != nil {
+		s.log.Error("Failed to verify password", "err", err, "userID", userID)
+		return false, err
+	}
+	return match, nil
+}
+
+func (s *LocalAuthAPI) setPassword(ctx context.Context, userID, password string) error {
+	passwordHash, err := hashPassword(password)
+	if err != nil {
+		s.log.Error("Failed to hash password", "err", err)
+		return err
+	}
+
+	err = s.dbQueries.UpsertLocalCredential(ctx, db.UpsertLocalCredentialParams{
+		UserID:       userID,
+		PasswordHash: passwordHash,
+		UpdatedAt:    time.Now(),
+	})
+	if err != nil {
+		s.log.Error("Failed to save password", "err", err, "userID", userID)
+	}
+	return err
+}
+
+// revokeSessions signs the user out of every browser session, sessions outlive the tokens they were started with
+func (s *LocalAuthAPI) revokeSessions(ctx context.Context, userID string) error {
+	err := s.dbQueries.RevokeSessionsByUserID(ctx, db.RevokeSessionsByUserIDParams{
+		RevokedAt: sql.NullTime{Time: time.Now(), Valid: true},
+		UserID:    userID,
+	})
+	if err != nil {
+		s.log.Error("Failed to revoke sessions", "err", err, "userID", userID)
+	}
+	return err
+}
+
+// LocalTokenVersion is the version the user's tokens must carry, users without a password have no valid tokens
+func (s *LocalAuthAPI) LocalTokenVersion(ctx context.Context, userID string) (int64, error) {
+	credential, err := s.dbQueries.GetLocalCredentialByUserID(ctx, userID)
+	if err != nil {
+		return 0, err
+	}
+	return credential.TokenVersion, nil
+}
+
+// issueToken issues a token of the user's current version, admins get the admin role
+func (s *LocalAuthAPI) issueToken(ctx context.Context, dbUser db.UserserviceUser) (string, time.Time, error) {
+	user := &auth.User{
+		ID:    dbUser.ID,
+		Name:  dbUser.Username,
+		Email: dbUser.Email,
+		Roles: []auth.Role{},
+	}
+	if s.isAdminEmail(dbUser.Email) {
+		user.Roles = append(user.Roles, auth.Admin)
+	}
+
+	tokenVersion, err := s.LocalTokenVersion(ctx, dbUser.ID)
+	if err != nil {
+		s.log.Error("Failed to get token version", "err", err, "userID", dbUser.ID)
+		return "", time.Time{}, err
+	}
+
+	token, expiresAt, err := s.tokens.IssueToken(user, tokenVersion)
+	if err != nil {
+		s.log.Error("Failed to issue token", "err", err, "userID", dbUser.ID)
+		return "", time.Time{}, err
+	}
+	return token, expiresAt, nil
+}
+
+// loginResponse issues a token for the user
+func (s *LocalAuthAPI) loginResponse(ctx context.Context, dbUser db.UserserviceUser) (*proto.LoginResponse, error) {
+	token, expiresAt, err := s.issueToken(ctx, dbUser)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to log in")
+	}
+
+	return &proto.LoginResponse{
+		Token:     token,
+		ExpiresAt: timestamppb.New(expiresAt),
+		User: &proto.User{
+			Id:        dbUser.ID,
+			Username:  dbUser.Username,
+			Email:     dbUser.Email,
+			CreatedAt: timestamppb.New(dbUser.CreatedAt),
+		},
+	}, nil
+}
+
+func (s *LocalAuthAPI) isAdminEmail(email string) bool {
+	for _, adminEmail := range s.config.LocalAuth.AdminEmails {
+		if strings.EqualFold(strings.TrimSpace(adminEmail), email) {
+			return true
+		}
+	}
+	return false
+}
+
+// normalizeEmail lowercases a bare address, "Name <address>" forms are rejected
+func normalizeEmail(email string) (string, bool) {
+	email = strings.ToLower(strings.TrimSpace(email))
+	address, err := mail.ParseAddress(email)
+	if err != nil || address.Address != email {
+		return "", false
+	}
+	return email, true
+}
+
+// hashToken is what is stored of reset tokens, access tokens and session tokens, a leaked database doesn't leak usable tokens
+func hashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
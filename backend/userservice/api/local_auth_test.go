@@ -0,0 +1,342 @@
+package api_test
+
+import (
+	"context"
+	"database/sql"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"sortedstartup.com/stream/common/auth"
+	"sortedstartup.com/stream/userservice/api"
+	"sortedstartup.com/stream/userservice/config"
+	"sortedstartup.com/stream/userservice/db"
+	"sortedstartup.com/stream/userservice/db/mocks"
+	"sortedstartup.com/stream/userservice/proto"
+)
+
+func newLocalAuthAPI(t *testing.T, mockQuerier *mocks.MockQuerier, localAuth config.LocalAuthConfig) (*api.LocalAuthAPI, *auth.Local) {
+	tokens, err := auth.NewLocal(auth.LocalConfig{TokenSecret: "0123456789abcdef0123456789abcdef"})
+	require.NoError(t, err)
+
+	logger := slog.Default()
+	tenantAPI := api.NewTenantAPITest(mockQuerier, logger)
+	return api.NewLocalAuthAPITest(mockQuerier, tenantAPI, tokens, config.UserServiceConfig{LocalAuth: localAuth}, logger), tokens
+}
+
+func TestLocalAuth_SignupAndLogin(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockQuerier := mocks.NewMockQuerier(ctrl)
+	localAuthAPI, tokens := newLocalAuthAPI(t, mockQuerier, config.LocalAuthConfig{SignupEnabled: true, AdminEmails: []string{"Admin@Example.com"}})
+
+	var created db.UserserviceUser
+	var credential db.UpsertLocalCredentialParams
+
+	mockQuerier.EXPECT().
+		GetUserByEmail(gomock.Any(), "admin@example.com").
+		Return(db.UserserviceUser{}, sql.ErrNoRows)
+	mockQuerier.EXPECT().
+		CreateUser(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, params db.CreateUserParams) (db.UserserviceUser, error) {
+			created = db.UserserviceUser(params)
+			return created, nil
+		})
+	mockQuerier.EXPECT().
+		UpsertLocalCredential(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, params db.UpsertLocalCredentialParams) error {
+			credential = params
+			return nil
+		})
+	mockQuerier.EXPECT().
+		CreateTenant(gomock.Any(), gomock.Any()).
+		Return(db.UserserviceTenant{ID: "tenant-1", IsPersonal: true}, nil)
+	mockQuerier.EXPECT().
+		CreateTenantUser(gomock.Any(), gomock.Any()).
+		Return(db.UserserviceTenantUser{ID: "tenantuser-1", TenantID: "tenant-1"}, nil)
+	mockQuerier.EXPECT().
+		GetLocalCredentialByUserID(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, userID string) (db.UserserviceLocalCredential, error) {
+			return db.UserserviceLocalCredential{UserID: userID, PasswordHash: credential.PasswordHash}, nil
+		})
+
+	resp, err := localAuthAPI.Signup(context.Background(), &proto.SignupRequest{
+		Email:    " Admin@Example.com ",
+		Password: "correct horse battery",
+		Name:     "Admin",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "admin@example.com", resp.User.Email)
+	assert.NotContains(t, credential.PasswordHash, "correct horse battery")
+
+	authContext, err := tokens.VerifyIDToken(resp.Token)
+	require.NoError(t, err)
+	assert.Equal(t, created.ID, authContext.User.ID)
+	assert.Equal(t, []auth.Role{auth.Admin}, authContext.User.Roles)
+
+	// Log in with the stored credential
+	mockQuerier.EXPECT().
+		GetUserByEmail(gomock.Any(), "admin@example.com").
+		Return(created, nil).
+		Times(2)
+	mockQuerier.EXPECT().
+		GetLocalCredentialByUserID(gomock.Any(), created.ID).
+		Return(db.UserserviceLocalCredential{UserID: created.ID, PasswordHash: credential.PasswordHash}, nil).
+		Times(3) // both password checks, then the token version
+
+	resp, err = localAuthAPI.Login(context.Background(), &proto.LoginRequest{Email: "admin@example.com", Password: "correct horse battery"})
+	require.NoError(t, err)
+	assert.NotEmpty(t, resp.Token)
+
+	_, err = localAuthAPI.Login(context.Background(), &proto.LoginRequest{Email: "admin@example.com", Password: "wrong password"})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+}
+
+func TestLocalAuth_SignupLeavesInvitationsPending(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockQuerier := mocks.NewMockQuerier(ctrl)
+	localAuthAPI, _ := newLocalAuthAPI(t, mockQuerier, config.LocalAuthConfig{SignupEnabled: true})
+
+	mockQuerier.EXPECT().
+		GetUserByEmail(gomock.Any(), "bob@example.com").
+		Return(db.UserserviceUser{}, sql.ErrNoRows)
+	mockQuerier.EXPECT().
+		CreateUser(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, params db.CreateUserParams) (db.UserserviceUser, error) {
+			return db.UserserviceUser(params), nil
+		})
+	mockQuerier.EXPECT().UpsertLocalCredential(gomock.Any(), gomock.Any()).Return(nil)
+	mockQuerier.EXPECT().GetLocalCredentialByUserID(gomock.Any(), gomock.Any()).Return(db.UserserviceLocalCredential{}, nil)
+	mockQuerier.EXPECT().
+		CreateTenant(gomock.Any(), gomock.Any()).
+		Return(db.UserserviceTenant{ID: "personal-tenant", IsPersonal: true}, nil)
+
+	// Only the personal tenant, the email isn't verified so invitations need their token.
+	// ListInvitationsByEmail and AcceptInvitation aren't expected, the mock fails the test if they are called.
+	var memberships []db.CreateTenantUserParams
+	mockQuerier.EXPECT().
+		CreateTenantUser(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, params db.CreateTenantUserParams) (db.UserserviceTenantUser, error) {
+			memberships = append(memberships, params)
+			return db.UserserviceTenantUser(params), nil
+		})
+
+	_, err := localAuthAPI.Signup(context.Background(), &proto.SignupRequest{Email: "bob@example.com", Password: "correct horse battery"})
+	require.NoError(t, err)
+	require.Len(t, memberships, 1)
+	assert.Equal(t, "personal-tenant", memberships[0].TenantID)
+}
+
+func TestLocalAuth_SignupExistingEmail(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockQuerier := mocks.NewMockQuerier(ctrl)
+	localAuthAPI, _ := newLocalAuthAPI(t, mockQuerier, config.LocalAuthConfig{SignupEnabled: true})
+
+	// Users created by another provider must not be taken over by signing up with their email
+	mockQuerier.EXPECT().
+		GetUserByEmail(gomock.Any(), "test@example.com").
+		Return(db.UserserviceUser{ID: "user-1", Email: "test@example.com"}, nil)
+
+	_, err := localAuthAPI.Signup(context.Background(), &proto.SignupRequest{Email: "test@example.com", Password: "long enough password"})
+	assert.Equal(t, codes.AlreadyExists, status.Code(err))
+}
+
+func TestLocalAuth_SignupRejected(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockQuerier := mocks.NewMockQuerier(ctrl)
+
+	tests := []struct {
+		name      string
+		localAuth config.LocalAuthConfig
+		req       *proto.SignupRequest
+		code      codes.Code
+	}{
+		{"Signups disabled", config.LocalAuthConfig{}, &proto.SignupRequest{Email: "test@example.com", Password: "long enough password"}, codes.PermissionDenied},
+		{"Invalid email", config.LocalAuthConfig{SignupEnabled: true}, &proto.SignupRequest{Email: "Test <test@example.com>", Password: "long enough password"}, codes.InvalidArgument},
+		{"Short password", config.LocalAuthConfig{SignupEnabled: true}, &proto.SignupRequest{Email: "test@example.com", Password: "short"}, codes.InvalidArgument},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			localAuthAPI, _ := newLocalAuthAPI(t, mockQuerier, tt.localAuth)
+			_, err := localAuthAPI.Signup(context.Background(), tt.req)
+			assert.Equal(t, tt.code, status.Code(err))
+		})
+	}
+}
+
+func TestLocalAuth_LoginUnknownUser(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockQuerier := mocks.NewMockQuerier(ctrl)
+	localAuthAPI, _ := newLocalAuthAPI(t, mockQuerier, config.LocalAuthConfig{})
+
+	mockQuerier.EXPECT().
+		GetUserByEmail(gomock.Any(), "nobody@example.com").
+		Return(db.UserserviceUser{}, sql.ErrNoRows)
+
+	_, err := localAuthAPI.Login(context.Background(), &proto.LoginRequest{Email: "nobody@example.com", Password: "some password"})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	assert.Equal(t, "invalid email or password", status.Convert(err).Message())
+}
+
+func TestLocalAuth_PasswordReset(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockQuerier := mocks.NewMockQuerier(ctrl)
+	localAuthAPI, _ := newLocalAuthAPI(t, mockQuerier, config.LocalAuthConfig{})
+
+	user := db.UserserviceUser{ID: "user-1", Username: "test@example.com", Email: "test@example.com", CreatedAt: time.Now()}
+
+	// Only admins can reset passwords
+	memberCtx := withAuthContext(context.Background(), &auth.User{ID: "user-2", Email: "member@example.com"})
+	_, err := localAuthAPI.ResetPassword(memberCtx, &proto.ResetPasswordRequest{Email: user.Email})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	var reset db.CreatePasswordResetParams
+	mockQuerier.EXPECT().
+		GetUserByEmail(gomock.Any(), user.Email).
+		Return(user, nil)
+	mockQuerier.EXPECT().
+		CreatePasswordReset(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, params db.CreatePasswordResetParams) error {
+			reset = params
+			return nil
+		})
+
+	adminCtx := withAuthContext(context.Background(), &auth.User{ID: "admin-1", Email: "admin@example.com", Roles: []auth.Role{auth.Admin}})
+	resetResp, err := localAuthAPI.ResetPassword(adminCtx, &proto.ResetPasswordRequest{Email: user.Email})
+	require.NoError(t, err)
+	assert.Equal(t, user.ID, reset.UserID)
+	assert.Equal(t, "admin-1", reset.CreatedBy)
+	assert.NotEqual(t, resetResp.ResetToken, reset.TokenHash, "only the hash of the token is stored")
+
+	mockQuerier.EXPECT().
+		GetPasswordResetByTokenHash(gomock.Any(), reset.TokenHash).
+		Return(db.UserservicePasswordReset{TokenHash: reset.TokenHash, UserID: user.ID, ExpiresAt: reset.ExpiresAt}, nil)
+	mockQuerier.EXPECT().
+		MarkPasswordResetUsed(gomock.Any(), gomock.Any()).
+		Return(int64(1), nil)
+	mockQuerier.EXPECT().
+		UpsertLocalCredential(gomock.Any(), gomock.Any()).
+		Return(nil)
+	// The reset signs out every session the user had
+	mockQuerier.EXPECT().
+		RevokeSessionsByUserID(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, params db.RevokeSessionsByUserIDParams) error {
+			assert.Equal(t, user.ID, params.UserID)
+			assert.True(t, params.RevokedAt.Valid)
+			return nil
+		})
+	mockQuerier.EXPECT().
+		GetUsersByIDs(gomock.Any(), []string{user.ID}).
+		Return([]db.UserserviceUser{user}, nil)
+	mockQuerier.EXPECT().
+		GetLocalCredentialByUserID(gomock.Any(), user.ID).
+		Return(db.UserserviceLocalCredential{UserID: user.ID, TokenVersion: 1}, nil)
+
+	loginResp, err := localAuthAPI.CompletePasswordReset(context.Background(), &proto.CompletePasswordResetRequest{
+		ResetToken:  resetResp.ResetToken,
+		NewPassword: "a brand new password",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, user.ID, loginResp.User.Id)
+
+	// Used and expired tokens are refused
+	mockQuerier.EXPECT().
+		GetPasswordResetByTokenHash(gomock.Any(), reset.TokenHash).
+		Return(db.UserservicePasswordReset{TokenHash: reset.TokenHash, UserID: user.ID, ExpiresAt: reset.ExpiresAt, UsedAt: sql.NullTime{Time: time.Now(), Valid: true}}, nil)
+	_, err = localAuthAPI.CompletePasswordReset(context.Background(), &proto.CompletePasswordResetRequest{
+		ResetToken:  resetResp.ResetToken,
+		NewPassword: "another new password",
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	mockQuerier.EXPECT().
+		GetPasswordResetByTokenHash(gomock.Any(), reset.TokenHash).
+		Return(db.UserservicePasswordReset{TokenHash: reset.TokenHash, UserID: user.ID, ExpiresAt: time.Now().Add(-time.Minute)}, nil)
+	_, err = localAuthAPI.CompletePasswordReset(context.Background(), &proto.CompletePasswordResetRequest{
+		ResetToken:  resetResp.ResetToken,
+		NewPassword: "another new password",
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestLocalAuth_ChangePasswordSignsOutOtherTokens(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockQuerier := mocks.NewMockQuerier(ctrl)
+	localAuthAPI, tokens := newLocalAuthAPI(t, mockQuerier, config.LocalAuthConfig{SignupEnabled: true})
+	tokens.SetTokenVersions(localAuthAPI)
+
+	var user db.UserserviceUser
+	var credential db.UserserviceLocalCredential
+	mockQuerier.EXPECT().
+		GetUserByEmail(gomock.Any(), "test@example.com").
+		Return(db.UserserviceUser{}, sql.ErrNoRows)
+	mockQuerier.EXPECT().
+		CreateUser(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, params db.CreateUserParams) (db.UserserviceUser, error) {
+			user = db.UserserviceUser(params)
+			return user, nil
+		})
+	mockQuerier.EXPECT().
+		UpsertLocalCredential(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, params db.UpsertLocalCredentialParams) error {
+			// Setting the password again bumps the token version
+			if credential.PasswordHash != "" {
+				credential.TokenVersion++
+			}
+			credential.UserID = params.UserID
+			credential.PasswordHash = params.PasswordHash
+			return nil
+		}).
+		Times(2)
+	mockQuerier.EXPECT().
+		GetLocalCredentialByUserID(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, userID string) (db.UserserviceLocalCredential, error) {
+			return credential, nil
+		}).
+		AnyTimes()
+	mockQuerier.EXPECT().CreateTenant(gomock.Any(), gomock.Any()).Return(db.UserserviceTenant{ID: "tenant-1", IsPersonal: true}, nil)
+	mockQuerier.EXPECT().CreateTenantUser(gomock.Any(), gomock.Any()).Return(db.UserserviceTenantUser{}, nil)
+
+	signupResp, err := localAuthAPI.Signup(context.Background(), &proto.SignupRequest{Email: "test@example.com", Password: "the current password"})
+	require.NoError(t, err)
+
+	// Browser sessions outlive tokens, they are revoked as well
+	mockQuerier.EXPECT().
+		RevokeSessionsByUserID(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, params db.RevokeSessionsByUserIDParams) error {
+			assert.Equal(t, user.ID, params.UserID)
+			return nil
+		})
+	mockQuerier.EXPECT().
+		GetUsersByIDs(gomock.Any(), []string{user.ID}).
+		Return([]db.UserserviceUser{user}, nil)
+
+	ctx := withAuthContext(context.Background(), &auth.User{ID: user.ID, Email: user.Email})
+	resp, err := localAuthAPI.ChangePassword(ctx, &proto.ChangePasswordRequest{CurrentPassword: "the current password", NewPassword: "a brand new password"})
+	require.NoError(t, err)
+
+	_, err = tokens.VerifyIDToken(signupResp.Token)
+	assert.Error(t, err, "tokens from before the change should be refused")
+	authContext, err := tokens.VerifyIDToken(resp.Token)
+	require.NoError(t, err)
+	assert.Equal(t, user.ID, authContext.User.ID)
+}
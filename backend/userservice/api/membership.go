@@ -0,0 +1,316 @@
+package api
+
+import (
+	"context"
+	"database/sql"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"sortedstartup.com/stream/common/constants"
+	"sortedstartup.com/stream/common/interceptors"
+	"sortedstartup.com/stream/userservice/db"
+	"sortedstartup.com/stream/userservice/proto"
+	videoProto "sortedstartup.com/stream/videoservice/proto"
+)
+
+/**
+* UpdateUserRole changes the role of a member - restricted to super_admin only.
+* The owner's role can't be changed and the last super_admin can't be demoted.
+* @param ctx context.Context
+* @param req *proto.UpdateUserRoleRequest
+* @return *proto.UpdateUserRoleResponse, error
+ */
+func (s *TenantAPI) UpdateUserRole(ctx context.Context, req *proto.UpdateUserRoleRequest) (*proto.UpdateUserRoleResponse, error) {
+	s.log.Info("UpdateUserRole", "tenantID", req.TenantId, "userID", req.UserId, "role", req.Role)
+
+	authContext, err := interceptors.AuthFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "unauthenticated")
+	}
+
+	// Validate input
+	if req.TenantId == "" || req.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "tenant ID and user ID are required")
+	}
+	if !constants.IsValidTenantRole(req.Role) {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid role %q", req.Role)
+	}
+
+	err = s.requireSuperAdmin(ctx, req.TenantId, authContext.User.ID, "change roles in")
+	if err != nil {
+		return nil, err
+	}
+
+	tenant, memberRole, err := s.getTenantMember(ctx, req.TenantId, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+	if tenant.CreatedBy == req.UserId {
+		return nil, status.Error(codes.FailedPrecondition, "the owner's role can't be changed, transfer ownership first")
+	}
+	if memberRole == req.Role {
+		return &proto.UpdateUserRoleResponse{Message: "Role unchanged"}, nil
+	}
+
+	// The update matches no row when it would demote the last super_admin
+	updated, err := s.dbQueries.UpdateTenantUserRole(ctx, db.UpdateTenantUserRoleParams{
+		Role:     req.Role,
+		TenantID: req.TenantId,
+		UserID:   req.UserId,
+	})
+	if err != nil {
+		s.log.Error("Failed to update user role", "error", err, "tenantID", req.TenantId, "userID", req.UserId)
+		return nil, status.Error(codes.Internal, "failed to update user role")
+	}
+	if updated == 0 {
+		return nil, memberGoneError(memberRole)
+	}
+
+	s.log.Info("Tenant role updated", "tenantID", req.TenantId, "userID", req.UserId, "from", memberRole, "to", req.Role, "by", authContext.User.ID)
+	return &proto.UpdateUserRoleResponse{Message: "Role updated successfully"}, nil
+}
+
+/**
+* RemoveUser removes a member from a tenant and its channels - restricted to super_admin only.
+* Channels the member was the only owner of are taken over by the caller.
+* @param ctx context.Context
+* @param req *proto.RemoveUserRequest
+* @return *proto.RemoveUserResponse, error
+ */
+func (s *TenantAPI) RemoveUser(ctx context.Context, req *proto.RemoveUserRequest) (*proto.RemoveUserResponse, error) {
+	s.log.Info("RemoveUser", "tenantID", req.TenantId, "userID", req.UserId)
+
+	authContext, err := interceptors.AuthFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "unauthenticated")
+	}
+
+	// Validate input
+	if req.TenantId == "" || req.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "tenant ID and user ID are required")
+	}
+	if req.UserId == authContext.User.ID {
+		return nil, status.Error(codes.InvalidArgument, "use LeaveTenant to leave a tenant")
+	}
+
+	err = s.requireSuperAdmin(ctx, req.TenantId, authContext.User.ID, "remove users from")
+	if err != nil {
+		return nil, err
+	}
+
+	tenant, memberRole, err := s.getTenantMember(ctx, req.TenantId, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+	if tenant.CreatedBy == req.UserId {
+		return nil, status.Error(codes.FailedPrecondition, "the owner can't be removed, transfer ownership first")
+	}
+	if memberRole == constants.TenantRoleSuperAdmin {
+		err = s.requireOtherSuperAdmin(ctx, req.TenantId)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err = s.removeTenantUser(ctx, req.TenantId, req.UserId, memberRole, authContext.User.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	s.log.Info("User removed from tenant", "tenantID", req.TenantId, "userID", req.UserId, "by", authContext.User.ID)
+	return &proto.RemoveUserResponse{Message: "User removed from tenant successfully"}, nil
+}
+
+/**
+* LeaveTenant removes the caller from a tenant and its channels.
+* The owner has to transfer ownership first, personal tenants can't be left.
+* @param ctx context.Context
+* @param req *proto.LeaveTenantRequest
+* @return *proto.LeaveTenantResponse, error
+ */
+func (s *TenantAPI) LeaveTenant(ctx context.Context, req *proto.LeaveTenantRequest) (*proto.LeaveTenantResponse, error) {
+	s.log.Info("LeaveTenant", "tenantID", req.TenantId)
+
+	authContext, err := interceptors.AuthFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "unauthenticated")
+	}
+	userID := authContext.User.ID
+
+	if req.TenantId == "" {
+		return nil, status.Error(codes.InvalidArgument, "tenant ID is required")
+	}
+
+	tenant, memberRole, err := s.getTenantMember(ctx, req.TenantId, userID)
+	if status.Code(err) == codes.NotFound {
+		return nil, status.Error(codes.PermissionDenied, "access denied: you are not a member of this tenant")
+	}
+	if err != nil {
+		return nil, err
+	}
+	if tenant.IsPersonal {
+		return nil, status.Error(codes.FailedPrecondition, "personal tenants can't be left")
+	}
+	if tenant.CreatedBy == userID {
+		return nil, status.Error(codes.FailedPrecondition, "the owner can't leave, transfer ownership first")
+	}
+	if memberRole == constants.TenantRoleSuperAdmin {
+		err = s.requireOtherSuperAdmin(ctx, req.TenantId)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// The owner takes over the channels nobody else owns
+	err = s.removeTenantUser(ctx, req.TenantId, userID, memberRole, tenant.CreatedBy)
+	if err != nil {
+		return nil, err
+	}
+
+	s.log.Info("User left tenant", "tenantID", req.TenantId, "userID", userID)
+	return &proto.LeaveTenantResponse{Message: "Left tenant successfully"}, nil
+}
+
+/**
+* TransferOwnership makes another member the owner of a tenant - restricted to the owner only.
+* The new owner becomes a super_admin, the previous owner stays a super_admin.
+* @param ctx context.Context
+* @param req *proto.TransferOwnershipRequest
+* @return *proto.TransferOwnershipResponse, error
+ */
+func (s *TenantAPI) TransferOwnership(ctx context.Context, req *proto.TransferOwnershipRequest) (*proto.TransferOwnershipResponse, error) {
+	s.log.Info("TransferOwnership", "tenantID", req.TenantId, "userID", req.UserId)
+
+	authContext, err := interceptors.AuthFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "unauthenticated")
+	}
+
+	// Validate input
+	if req.TenantId == "" || req.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "tenant ID and user ID are required")
+	}
+	if req.UserId == authContext.User.ID {
+		return nil, status.Error(codes.InvalidArgument, "you already own this tenant")
+	}
+
+	tenant, _, err := s.getTenantMember(ctx, req.TenantId, authContext.User.ID)
+	if status.Code(err) == codes.NotFound {
+		return nil, status.Error(codes.PermissionDenied, "access denied: you are not a member of this tenant")
+	}
+	if err != nil {
+		return nil, err
+	}
+	if tenant.CreatedBy != authContext.User.ID {
+		s.log.Warn("Non-owner attempted to transfer tenant ownership", "userID", authContext.User.ID, "tenantID", req.TenantId)
+		return nil, status.Error(codes.PermissionDenied, "access denied: only the owner can transfer ownership")
+	}
+	if tenant.IsPersonal {
+		return nil, status.Error(codes.FailedPrecondition, "personal tenants can't be transferred")
+	}
+
+	_, memberRole, err := s.getTenantMember(ctx, req.TenantId, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	// Promote first, a failure in between leaves two super_admins rather than an owner without the role
+	if memberRole != constants.TenantRoleSuperAdmin {
+		_, err = s.dbQueries.UpdateTenantUserRole(ctx, db.UpdateTenantUserRoleParams{
+			Role:     constants.TenantRoleSuperAdmin,
+			TenantID: req.TenantId,
+			UserID:   req.UserId,
+		})
+		if err != nil {
+			s.log.Error("Failed to promote new owner", "error", err, "tenantID", req.TenantId, "userID", req.UserId)
+			return nil, status.Error(codes.Internal, "failed to transfer ownership")
+		}
+	}
+
+	err = s.dbQueries.UpdateTenantCreatedBy(ctx, db.UpdateTenantCreatedByParams{
+		CreatedBy: req.UserId,
+		ID:        req.TenantId,
+	})
+	if err != nil {
+		s.log.Error("Failed to transfer ownership", "error", err, "tenantID", req.TenantId, "userID", req.UserId)
+		return nil, status.Error(codes.Internal, "failed to transfer ownership")
+	}
+
+	s.log.Info("Tenant ownership transferred", "tenantID", req.TenantId, "from", authContext.User.ID, "to", req.UserId)
+	return &proto.TransferOwnershipResponse{Message: "Ownership transferred successfully"}, nil
+}
+
+// getTenantMember returns the tenant and the role of one of its members, NotFound when the user isn't a member
+func (s *TenantAPI) getTenantMember(ctx context.Context, tenantID, userID string) (db.UserserviceTenant, string, error) {
+	role, err := s.dbQueries.GetUserRoleInTenant(ctx, db.GetUserRoleInTenantParams{
+		TenantID: tenantID,
+		UserID:   userID,
+	})
+	if err == sql.ErrNoRows {
+		return db.UserserviceTenant{}, "", status.Error(codes.NotFound, "user is not a member of this tenant")
+	}
+	if err != nil {
+		s.log.Error("Failed to check user role in tenant", "error", err, "tenantID", tenantID, "userID", userID)
+		return db.UserserviceTenant{}, "", status.Error(codes.Internal, "failed to check membership")
+	}
+
+	tenant, err := s.dbQueries.GetTenantByID(ctx, tenantID)
+	if err != nil {
+		s.log.Error("Failed to get tenant", "error", err, "tenantID", tenantID)
+		return db.UserserviceTenant{}, "", status.Error(codes.Internal, "failed to get tenant")
+	}
+	return tenant, role, nil
+}
+
+// requireOtherSuperAdmin refuses to take the super_admin role from the last super_admin of a tenant.
+// It runs before the channel cleanup of a removal, the delete itself checks again.
+func (s *TenantAPI) requireOtherSuperAdmin(ctx context.Context, tenantID string) error {
+	count, err := s.dbQueries.CountTenantUsersByRole(ctx, db.CountTenantUsersByRoleParams{
+		TenantID: tenantID,
+		Role:     constants.TenantRoleSuperAdmin,
+	})
+	if err != nil {
+		s.log.Error("Failed to count super admins", "error", err, "tenantID", tenantID)
+		return status.Error(codes.Internal, "failed to check permissions")
+	}
+	if count <= 1 {
+		return status.Error(codes.FailedPrecondition, "a tenant needs at least one super admin")
+	}
+	return nil
+}
+
+// memberGoneError explains a role update or delete that matched no row.
+// A super_admin's row is kept when they are the last one, anyone else is no longer a member.
+func memberGoneError(memberRole string) error {
+	if memberRole == constants.TenantRoleSuperAdmin {
+		return status.Error(codes.FailedPrecondition, "a tenant needs at least one super admin")
+	}
+	return status.Error(codes.NotFound, "user is not a member of this tenant")
+}
+
+// removeTenantUser removes the user's channel memberships in videoservice and then the tenant membership.
+// The cleanup goes first so that a failure can be retried, the membership is what lets the user try again.
+func (s *TenantAPI) removeTenantUser(ctx context.Context, tenantID, userID, memberRole, successorUserID string) error {
+	_, err := s.videoCleanupClient.RemoveTenantMember(ctx, &videoProto.RemoveTenantMemberRequest{
+		TenantId:        tenantID,
+		UserId:          userID,
+		SuccessorUserId: successorUserID,
+	})
+	if err != nil {
+		s.log.Error("Failed to remove channel memberships", "error", err, "tenantID", tenantID, "userID", userID)
+		return status.Error(codes.Internal, "failed to remove channel memberships")
+	}
+
+	removed, err := s.dbQueries.DeleteTenantUser(ctx, db.DeleteTenantUserParams{
+		TenantID: tenantID,
+		UserID:   userID,
+	})
+	if err != nil {
+		s.log.Error("Failed to remove user from tenant", "error", err, "tenantID", tenantID, "userID", userID)
+		return status.Error(codes.Internal, "failed to remove user from tenant")
+	}
+	if removed == 0 {
+		return memberGoneError(memberRole)
+	}
+	return nil
+}
@@ -0,0 +1,240 @@
+package api_test
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"sortedstartup.com/stream/common/auth"
+	"sortedstartup.com/stream/userservice/api"
+	"sortedstartup.com/stream/userservice/db"
+	"sortedstartup.com/stream/userservice/db/mocks"
+	"sortedstartup.com/stream/userservice/proto"
+	videoProto "sortedstartup.com/stream/videoservice/proto"
+)
+
+var teamTenant = db.UserserviceTenant{ID: "tenant-1", Name: "Acme", CreatedBy: "owner-1"}
+
+func expectMember(mockQuerier *mocks.MockQuerier, userID, role string) {
+	mockQuerier.EXPECT().
+		GetUserRoleInTenant(gomock.Any(), db.GetUserRoleInTenantParams{TenantID: "tenant-1", UserID: userID}).
+		Return(role, nil)
+}
+
+func TestUpdateUserRole(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockQuerier := mocks.NewMockQuerier(ctrl)
+	tenantAPI := api.NewTenantAPITest(mockQuerier, slog.Default())
+	ctx := withAuthContext(context.Background(), &auth.User{ID: "owner-1"})
+
+	// Another super admin can be demoted
+	expectMember(mockQuerier, "owner-1", "super_admin")
+	expectMember(mockQuerier, "admin-2", "super_admin")
+	mockQuerier.EXPECT().GetTenantByID(gomock.Any(), "tenant-1").Return(teamTenant, nil)
+	mockQuerier.EXPECT().
+		UpdateTenantUserRole(gomock.Any(), db.UpdateTenantUserRoleParams{Role: "member", TenantID: "tenant-1", UserID: "admin-2"}).
+		Return(int64(1), nil)
+
+	_, err := tenantAPI.UpdateUserRole(ctx, &proto.UpdateUserRoleRequest{TenantId: "tenant-1", UserId: "admin-2", Role: "member"})
+	require.NoError(t, err)
+
+	// The owner's role can't be changed
+	expectMember(mockQuerier, "owner-1", "super_admin")
+	expectMember(mockQuerier, "owner-1", "super_admin")
+	mockQuerier.EXPECT().GetTenantByID(gomock.Any(), "tenant-1").Return(teamTenant, nil)
+
+	_, err = tenantAPI.UpdateUserRole(ctx, &proto.UpdateUserRoleRequest{TenantId: "tenant-1", UserId: "owner-1", Role: "member"})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	// Invalid roles are refused before any lookup
+	_, err = tenantAPI.UpdateUserRole(ctx, &proto.UpdateUserRoleRequest{TenantId: "tenant-1", UserId: "admin-2", Role: "owner"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestUpdateUserRole_LastSuperAdmin(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockQuerier := mocks.NewMockQuerier(ctrl)
+	tenantAPI := api.NewTenantAPITest(mockQuerier, slog.Default())
+	ctx := withAuthContext(context.Background(), &auth.User{ID: "admin-2"})
+
+	// admin-2 is the only super admin left, e.g. when the owner was demoted directly in the database
+	expectMember(mockQuerier, "admin-2", "super_admin")
+	expectMember(mockQuerier, "admin-2", "super_admin")
+	mockQuerier.EXPECT().GetTenantByID(gomock.Any(), "tenant-1").Return(teamTenant, nil)
+	// The guarded update matches no row while they are the only one
+	mockQuerier.EXPECT().
+		UpdateTenantUserRole(gomock.Any(), gomock.Any()).
+		Return(int64(0), nil)
+
+	_, err := tenantAPI.UpdateUserRole(ctx, &proto.UpdateUserRoleRequest{TenantId: "tenant-1", UserId: "admin-2", Role: "member"})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+}
+
+func TestRemoveUser_CleansUpChannels(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockQuerier := mocks.NewMockQuerier(ctrl)
+	mockCleanup := videoProto.NewMockTenantCleanupServiceClient(ctrl)
+	tenantAPI := api.NewTenantAPITestWithClients(mockQuerier, nil, mockCleanup, nil, slog.Default())
+	ctx := withAuthContext(context.Background(), &auth.User{ID: "owner-1"})
+
+	expectMember(mockQuerier, "owner-1", "super_admin")
+	expectMember(mockQuerier, "user-2", "member")
+	mockQuerier.EXPECT().GetTenantByID(gomock.Any(), "tenant-1").Return(teamTenant, nil)
+
+	// The caller takes over the channels only the removed user owned, then the membership goes
+	gomock.InOrder(
+		mockCleanup.EXPECT().
+			RemoveTenantMember(gomock.Any(), &videoProto.RemoveTenantMemberRequest{TenantId: "tenant-1", UserId: "user-2", SuccessorUserId: "owner-1"}).
+			Return(&videoProto.RemoveTenantMemberResponse{RemovedMemberships: 2}, nil),
+		mockQuerier.EXPECT().
+			DeleteTenantUser(gomock.Any(), db.DeleteTenantUserParams{TenantID: "tenant-1", UserID: "user-2"}).
+			Return(int64(1), nil),
+	)
+
+	_, err := tenantAPI.RemoveUser(ctx, &proto.RemoveUserRequest{TenantId: "tenant-1", UserId: "user-2"})
+	require.NoError(t, err)
+}
+
+func TestRemoveUser_Refused(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockQuerier := mocks.NewMockQuerier(ctrl)
+	tenantAPI := api.NewTenantAPITest(mockQuerier, slog.Default())
+
+	// Callers leave instead of removing themselves
+	ctx := withAuthContext(context.Background(), &auth.User{ID: "admin-2"})
+	_, err := tenantAPI.RemoveUser(ctx, &proto.RemoveUserRequest{TenantId: "tenant-1", UserId: "admin-2"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// Members can't remove anyone
+	expectMember(mockQuerier, "admin-2", "member")
+	_, err = tenantAPI.RemoveUser(ctx, &proto.RemoveUserRequest{TenantId: "tenant-1", UserId: "user-3"})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	// The owner can't be removed
+	expectMember(mockQuerier, "admin-2", "super_admin")
+	expectMember(mockQuerier, "owner-1", "super_admin")
+	mockQuerier.EXPECT().GetTenantByID(gomock.Any(), "tenant-1").Return(teamTenant, nil)
+	_, err = tenantAPI.RemoveUser(ctx, &proto.RemoveUserRequest{TenantId: "tenant-1", UserId: "owner-1"})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+}
+
+func TestLeaveTenant(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockQuerier := mocks.NewMockQuerier(ctrl)
+	mockCleanup := videoProto.NewMockTenantCleanupServiceClient(ctrl)
+	tenantAPI := api.NewTenantAPITestWithClients(mockQuerier, nil, mockCleanup, nil, slog.Default())
+
+	// A member leaves, the owner takes over their channels
+	ctx := withAuthContext(context.Background(), &auth.User{ID: "user-2"})
+	expectMember(mockQuerier, "user-2", "member")
+	mockQuerier.EXPECT().GetTenantByID(gomock.Any(), "tenant-1").Return(teamTenant, nil)
+	mockCleanup.EXPECT().
+		RemoveTenantMember(gomock.Any(), &videoProto.RemoveTenantMemberRequest{TenantId: "tenant-1", UserId: "user-2", SuccessorUserId: "owner-1"}).
+		Return(nil, status.Error(codes.Unavailable, "videoservice is down"))
+
+	// A failed cleanup keeps the membership, so leaving can be retried
+	_, err := tenantAPI.LeaveTenant(ctx, &proto.LeaveTenantRequest{TenantId: "tenant-1"})
+	assert.Equal(t, codes.Internal, status.Code(err))
+
+	expectMember(mockQuerier, "user-2", "member")
+	mockQuerier.EXPECT().GetTenantByID(gomock.Any(), "tenant-1").Return(teamTenant, nil)
+	mockCleanup.EXPECT().
+		RemoveTenantMember(gomock.Any(), &videoProto.RemoveTenantMemberRequest{TenantId: "tenant-1", UserId: "user-2", SuccessorUserId: "owner-1"}).
+		Return(&videoProto.RemoveTenantMemberResponse{}, nil)
+	mockQuerier.EXPECT().
+		DeleteTenantUser(gomock.Any(), db.DeleteTenantUserParams{TenantID: "tenant-1", UserID: "user-2"}).
+		Return(int64(1), nil)
+
+	_, err = tenantAPI.LeaveTenant(ctx, &proto.LeaveTenantRequest{TenantId: "tenant-1"})
+	require.NoError(t, err)
+
+	// The owner has to transfer ownership first
+	ownerCtx := withAuthContext(context.Background(), &auth.User{ID: "owner-1"})
+	expectMember(mockQuerier, "owner-1", "super_admin")
+	mockQuerier.EXPECT().GetTenantByID(gomock.Any(), "tenant-1").Return(teamTenant, nil)
+	_, err = tenantAPI.LeaveTenant(ownerCtx, &proto.LeaveTenantRequest{TenantId: "tenant-1"})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	// Personal tenants can't be left
+	personalTenant := teamTenant
+	personalTenant.IsPersonal = true
+	personalTenant.CreatedBy = "someone-else"
+	expectMember(mockQuerier, "user-2", "member")
+	mockQuerier.EXPECT().GetTenantByID(gomock.Any(), "tenant-1").Return(personalTenant, nil)
+	_, err = tenantAPI.LeaveTenant(ctx, &proto.LeaveTenantRequest{TenantId: "tenant-1"})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+}
+
+func TestLeaveTenant_LastSuperAdmin(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockQuerier := mocks.NewMockQuerier(ctrl)
+	mockCleanup := videoProto.NewMockTenantCleanupServiceClient(ctrl)
+	tenantAPI := api.NewTenantAPITestWithClients(mockQuerier, nil, mockCleanup, nil, slog.Default())
+	ctx := withAuthContext(context.Background(), &auth.User{ID: "admin-2"})
+
+	// Another super admin was demoted after the count, the delete keeps the membership
+	expectMember(mockQuerier, "admin-2", "super_admin")
+	mockQuerier.EXPECT().GetTenantByID(gomock.Any(), "tenant-1").Return(teamTenant, nil)
+	mockQuerier.EXPECT().
+		CountTenantUsersByRole(gomock.Any(), db.CountTenantUsersByRoleParams{TenantID: "tenant-1", Role: "super_admin"}).
+		Return(int64(2), nil)
+	mockCleanup.EXPECT().
+		RemoveTenantMember(gomock.Any(), gomock.Any()).
+		Return(&videoProto.RemoveTenantMemberResponse{}, nil)
+	mockQuerier.EXPECT().
+		DeleteTenantUser(gomock.Any(), db.DeleteTenantUserParams{TenantID: "tenant-1", UserID: "admin-2"}).
+		Return(int64(0), nil)
+
+	_, err := tenantAPI.LeaveTenant(ctx, &proto.LeaveTenantRequest{TenantId: "tenant-1"})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+}
+
+func TestTransferOwnership(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockQuerier := mocks.NewMockQuerier(ctrl)
+	tenantAPI := api.NewTenantAPITest(mockQuerier, slog.Default())
+	ctx := withAuthContext(context.Background(), &auth.User{ID: "owner-1"})
+
+	expectMember(mockQuerier, "owner-1", "super_admin")
+	expectMember(mockQuerier, "user-2", "member")
+	mockQuerier.EXPECT().GetTenantByID(gomock.Any(), "tenant-1").Return(teamTenant, nil).Times(2)
+
+	// The new owner is promoted before the tenant changes hands
+	gomock.InOrder(
+		mockQuerier.EXPECT().
+			UpdateTenantUserRole(gomock.Any(), db.UpdateTenantUserRoleParams{Role: "super_admin", TenantID: "tenant-1", UserID: "user-2"}).
+			Return(int64(1), nil),
+		mockQuerier.EXPECT().
+			UpdateTenantCreatedBy(gomock.Any(), db.UpdateTenantCreatedByParams{CreatedBy: "user-2", ID: "tenant-1"}).
+			Return(nil),
+	)
+
+	_, err := tenantAPI.TransferOwnership(ctx, &proto.TransferOwnershipRequest{TenantId: "tenant-1", UserId: "user-2"})
+	require.NoError(t, err)
+
+	// Only the owner can transfer, being a super admin isn't enough
+	adminCtx := withAuthContext(context.Background(), &auth.User{ID: "admin-2"})
+	expectMember(mockQuerier, "admin-2", "super_admin")
+	mockQuerier.EXPECT().GetTenantByID(gomock.Any(), "tenant-1").Return(teamTenant, nil)
+	_, err = tenantAPI.TransferOwnership(adminCtx, &proto.TransferOwnershipRequest{TenantId: "tenant-1", UserId: "user-2"})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+}
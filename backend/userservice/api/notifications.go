@@ -0,0 +1,49 @@
+package api
+
+import (
+	"context"
+	"fmt"
+
+	"sortedstartup.com/stream/common/auth"
+	notificationProto "sortedstartup.com/stream/notificationservice/proto"
+	"sortedstartup.com/stream/userservice/db"
+)
+
+// notifyTenantInvitation tells a user they were added to a workspace.
+// Notifications are best effort, the user is already a member at this point.
+func (s *TenantAPI) notifyTenantInvitation(ctx context.Context, tenantID string, actor *auth.User, userID string) {
+	tenant, err := s.dbQueries.GetTenantByID(ctx, tenantID)
+	if err != nil {
+		s.log.Error("Failed to get tenant to notify", "error", err, "tenantID", tenantID)
+		return
+	}
+
+	_, err = s.notificationClient.Publish(ctx, &notificationProto.PublishRequest{
+		TenantId:     tenantID,
+		RecipientIds: []string{userID},
+		Type:         notificationProto.NotificationType_NOTIFICATION_TYPE_TENANT_INVITATION,
+		ActorId:      actor.ID,
+		ActorName:    actor.Name,
+		Message:      fmt.Sprintf("%s added you to the %s workspace", actor.Name, tenant.Name),
+	})
+	if err != nil {
+		s.log.Error("Failed to publish invitation notification", "error", err, "tenantID", tenantID, "userID", userID)
+	}
+}
+
+// sendInvitationEmail emails an invitation with its token, it reports whether the email was queued.
+// The invitation is stored already and is also accepted on first sign in, so failures are only logged.
+func (s *TenantAPI) sendInvitationEmail(ctx context.Context, tenant db.UserserviceTenant, actor *auth.User, email string, token string) bool {
+	resp, err := s.notificationClient.SendInvitation(ctx, &notificationProto.SendInvitationRequest{
+		TenantId:   tenant.ID,
+		Email:      email,
+		TenantName: tenant.Name,
+		ActorName:  actor.Name,
+		Token:      token,
+	})
+	if err != nil {
+		s.log.Error("Failed to send invitation email", "error", err, "tenantID", tenant.ID)
+		return false
+	}
+	return resp.Queued
+}
@@ -0,0 +1,288 @@
+package api
+
+import (
+	"context"
+	"crypto/rand"
+	"database/sql"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"sortedstartup.com/stream/common/auth"
+	"sortedstartup.com/stream/common/interceptors"
+	"sortedstartup.com/stream/userservice/config"
+	"sortedstartup.com/stream/userservice/db"
+	"sortedstartup.com/stream/userservice/proto"
+)
+
+const (
+	defaultSessionIdleTimeout = 7 * 24 * time.Hour
+	defaultSessionMaxAge      = 30 * 24 * time.Hour
+
+	// sessionTouchInterval limits the writes for sliding expiry, a playing video doesn't update the session on every range request
+	sessionTouchInterval = time.Minute
+
+	maxUserAgentLength = 256
+
+	// maxSessionsPerUser bounds the sessions of a user, the oldest are revoked first
+	maxSessionsPerUser = 20
+
+	// sessionPurgeInterval is how often revoked and expired sessions are deleted
+	sessionPurgeInterval = time.Hour
+)
+
+var errInvalidSession = errors.New("invalid session")
+
+// SessionAPI stores the browser sessions behind the session cookie, it is the auth.SessionStore of the HTTP middlewares
+type SessionAPI struct {
+	config    config.SessionsConfig
+	log       *slog.Logger
+	dbQueries db.Querier
+	proto.UnimplementedSessionServiceServer
+}
+
+var _ auth.SessionStore = (*SessionAPI)(nil)
+
+func NewSessionAPI(userAPI *UserAPI) *SessionAPI {
+	return NewSessionAPITest(userAPI.dbQueries, userAPI.config.Sessions, userAPI.log.With("api", "SessionAPI"))
+}
+
+func NewSessionAPITest(querier db.Querier, config config.SessionsConfig, logger *slog.Logger) *SessionAPI {
+	if config.IdleTimeout == 0 {
+		config.IdleTimeout = defaultSessionIdleTimeout
+	}
+	if config.MaxAge == 0 {
+		config.MaxAge = defaultSessionMaxAge
+	}
+	return &SessionAPI{
+		config:    config,
+		log:       logger,
+		dbQueries: querier,
+	}
+}
+
+func (s *SessionAPI) CreateSession(ctx context.Context, authContext *auth.AuthContext, userAgent string) (string, time.Time, error) {
+	token := make([]byte, 32)
+	_, err := rand.Read(token)
+	if err != nil {
+		return "", time.Time{}, err
+	}
+	sessionToken := base64.RawURLEncoding.EncodeToString(token)
+
+	roles := make([]string, 0, len(authContext.User.Roles))
+	for _, role := range authContext.User.Roles {
+		roles = append(roles, string(role))
+	}
+	if len(userAgent) > maxUserAgentLength {
+		userAgent = userAgent[:maxUserAgentLength]
+	}
+
+	now := time.Now()
+	expiresAt := s.expiresAt(now, now)
+	sessionID := uuid.New().String()
+	err = s.dbQueries.CreateSession(ctx, db.CreateSessionParams{
+		ID:        sessionID,
+		TokenHash: hashToken(sessionToken),
+		UserID:    authContext.User.ID,
+		Roles:     strings.Join(roles, " "),
+		UserAgent: userAgent,
+		CreatedAt: now,
+		ExpiresAt: expiresAt,
+	})
+	if err != nil {
+		return "", time.Time{}, fmt.Errorf("failed to create session: %w", err)
+	}
+
+	s.log.Info("Session created", "sessionID", sessionID, "userID", authContext.User.ID)
+	s.revokeOldestSessions(ctx, authContext.User.ID, now)
+	return sessionToken, expiresAt, nil
+}
+
+// revokeOldestSessions keeps the newest maxSessionsPerUser sessions of the user.
+// Failing is harmless, the sessions are capped again on the next sign-in.
+func (s *SessionAPI) revokeOldestSessions(ctx context.Context, userID string, now time.Time) {
+	rows, err := s.dbQueries.ListSessionsByUserID(ctx, userID)
+	if err != nil {
+		s.log.Error("Failed to list sessions", "err", err, "userID", userID)
+		return
+	}
+
+	active := 0
+	for _, row := range rows {
+		if now.After(row.ExpiresAt) {
+			continue
+		}
+		active++
+		if active <= maxSessionsPerUser {
+			continue
+		}
+		_, err := s.dbQueries.RevokeSession(ctx, db.RevokeSessionParams{
+			RevokedAt: sql.NullTime{Time: now, Valid: true},
+			ID:        row.ID,
+			UserID:    userID,
+		})
+		if err != nil {
+			s.log.Error("Failed to revoke old session", "err", err, "sessionID", row.ID)
+			return
+		}
+		s.log.Info("Old session revoked", "sessionID", row.ID, "userID", userID)
+	}
+}
+
+func (s *SessionAPI) GetSession(ctx context.Context, sessionToken string) (*auth.AuthContext, error) {
+	row, err := s.dbQueries.GetSessionByTokenHash(ctx, hashToken(sessionToken))
+	if err == sql.ErrNoRows {
+		return nil, errInvalidSession
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get session: %w", err)
+	}
+	session := row.UserserviceSession
+
+	now := time.Now()
+	if now.After(session.ExpiresAt) {
+		return nil, errors.New("session has expired")
+	}
+	if !auth.IsEmailAllowed(row.Email) {
+		return nil, fmt.Errorf("email not in allowed list: %s", row.Email)
+	}
+
+	if now.Sub(session.LastSeenAt) > sessionTouchInterval {
+		err = s.dbQueries.TouchSession(ctx, db.TouchSessionParams{
+			LastSeenAt: now,
+			ExpiresAt:  s.expiresAt(session.CreatedAt, now),
+			ID:         session.ID,
+		})
+		if err != nil {
+			// The session is still valid until its current expiry
+			s.log.Error("Failed to extend session", "err", err, "sessionID", session.ID)
+		}
+	}
+
+	user := &auth.User{
+		ID:    session.UserID,
+		Name:  row.Username,
+		Email: row.Email,
+		Roles: []auth.Role{},
+	}
+	for _, role := range strings.Fields(session.Roles) {
+		user.Roles = append(user.Roles, auth.Role(role))
+	}
+
+	return &auth.AuthContext{
+		User:            user,
+		IsAuthenticated: true,
+		SessionID:       session.ID,
+	}, nil
+}
+
+func (s *SessionAPI) DeleteSession(ctx context.Context, sessionToken string) error {
+	return s.dbQueries.RevokeSessionByTokenHash(ctx, db.RevokeSessionByTokenHashParams{
+		RevokedAt: sql.NullTime{Time: time.Now(), Valid: true},
+		TokenHash: hashToken(sessionToken),
+	})
+}
+
+func (s *SessionAPI) ListSessions(ctx context.Context, req *proto.ListSessionsRequest) (*proto.ListSessionsResponse, error) {
+	authContext, err := interceptors.AuthFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "unauthenticated")
+	}
+
+	rows, err := s.dbQueries.ListSessionsByUserID(ctx, authContext.User.ID)
+	if err != nil {
+		s.log.Error("Failed to list sessions", "err", err, "userID", authContext.User.ID)
+		return nil, status.Error(codes.Internal, "failed to list sessions")
+	}
+
+	now := time.Now()
+	sessions := make([]*proto.Session, 0, len(rows))
+	for _, row := range rows {
+		if now.After(row.ExpiresAt) {
+			continue
+		}
+		sessions = append(sessions, &proto.Session{
+			Id:         row.ID,
+			UserAgent:  row.UserAgent,
+			CreatedAt:  timestamppb.New(row.CreatedAt),
+			LastSeenAt: timestamppb.New(row.LastSeenAt),
+			ExpiresAt:  timestamppb.New(row.ExpiresAt),
+		})
+	}
+	return &proto.ListSessionsResponse{Sessions: sessions}, nil
+}
+
+// RevokeSession only drops the session and its cookie. A device that still holds a valid ID token
+// gets a new session on its next grpc-web call, revoking doesn't sign it out of the identity provider.
+func (s *SessionAPI) RevokeSession(ctx context.Context, req *proto.RevokeSessionRequest) (*proto.RevokeSessionResponse, error) {
+	authContext, err := interceptors.AuthFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "unauthenticated")
+	}
+	if req.Id == "" {
+		return nil, status.Error(codes.InvalidArgument, "session ID is required")
+	}
+
+	revoked, err := s.dbQueries.RevokeSession(ctx, db.RevokeSessionParams{
+		RevokedAt: sql.NullTime{Time: time.Now(), Valid: true},
+		ID:        req.Id,
+		UserID:    authContext.User.ID,
+	})
+	if err != nil {
+		s.log.Error("Failed to revoke session", "err", err, "sessionID", req.Id)
+		return nil, status.Error(codes.Internal, "failed to revoke session")
+	}
+	// Other users' sessions look the same as missing ones
+	if revoked == 0 {
+		return nil, status.Error(codes.NotFound, "session not found")
+	}
+
+	s.log.Info("Session revoked", "sessionID", req.Id, "userID", authContext.User.ID)
+	return &proto.RevokeSessionResponse{Message: "Session revoked"}, nil
+}
+
+// RunSessionPurge deletes revoked and expired sessions until ctx is cancelled
+func (s *SessionAPI) RunSessionPurge(ctx context.Context) {
+	s.log.Info("Session purge started")
+
+	ticker := time.NewTicker(sessionPurgeInterval)
+	defer ticker.Stop()
+
+	s.purgeStaleSessions(ctx, time.Now())
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			s.purgeStaleSessions(ctx, time.Now())
+		}
+	}
+}
+
+func (s *SessionAPI) purgeStaleSessions(ctx context.Context, now time.Time) {
+	deleted, err := s.dbQueries.DeleteStaleSessions(ctx, now)
+	if err != nil {
+		s.log.Error("Failed to delete stale sessions", "err", err)
+		return
+	}
+	if deleted > 0 {
+		s.log.Info("Stale sessions deleted", "count", deleted)
+	}
+}
+
+// expiresAt slides the expiry to the idle timeout from now, but never past the maximum age of the session
+func (s *SessionAPI) expiresAt(createdAt, now time.Time) time.Time {
+	idleExpiry := now.Add(s.config.IdleTimeout)
+	maxExpiry := createdAt.Add(s.config.MaxAge)
+	if idleExpiry.After(maxExpiry) {
+		return maxExpiry
+	}
+	return idleExpiry
+}
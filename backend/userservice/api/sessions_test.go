@@ -0,0 +1,169 @@
+package api_test
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"sortedstartup.com/stream/common/auth"
+	"sortedstartup.com/stream/userservice/api"
+	"sortedstartup.com/stream/userservice/config"
+	"sortedstartup.com/stream/userservice/db"
+	"sortedstartup.com/stream/userservice/db/mocks"
+	"sortedstartup.com/stream/userservice/proto"
+)
+
+func TestSessions_CreateAndGet(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockQuerier := mocks.NewMockQuerier(ctrl)
+	sessionAPI := api.NewSessionAPITest(mockQuerier, config.SessionsConfig{IdleTimeout: time.Hour, MaxAge: 2 * time.Hour}, slog.Default())
+
+	var created db.CreateSessionParams
+	mockQuerier.EXPECT().
+		CreateSession(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, params db.CreateSessionParams) error {
+			created = params
+			return nil
+		})
+	mockQuerier.EXPECT().ListSessionsByUserID(gomock.Any(), "user-1").Return(nil, nil)
+
+	authContext := &auth.AuthContext{User: &auth.User{ID: "user-1", Email: "test@example.com", Roles: []auth.Role{auth.Admin}}, IsAuthenticated: true}
+	sessionToken, expiresAt, err := sessionAPI.CreateSession(context.Background(), authContext, "Firefox")
+	require.NoError(t, err)
+	assert.NotEqual(t, sessionToken, created.TokenHash, "only the hash of the session token is stored")
+	assert.Equal(t, "admin", created.Roles)
+	assert.WithinDuration(t, time.Now().Add(time.Hour), expiresAt, time.Minute)
+
+	session := db.UserserviceSession{
+		ID:         created.ID,
+		TokenHash:  created.TokenHash,
+		UserID:     created.UserID,
+		Roles:      created.Roles,
+		CreatedAt:  time.Now().Add(-90 * time.Minute),
+		LastSeenAt: time.Now().Add(-10 * time.Minute),
+		ExpiresAt:  time.Now().Add(50 * time.Minute),
+	}
+
+	// Using the session slides its expiry, but not past the maximum age
+	mockQuerier.EXPECT().
+		GetSessionByTokenHash(gomock.Any(), created.TokenHash).
+		Return(db.GetSessionByTokenHashRow{UserserviceSession: session, Username: "test", Email: "test@example.com"}, nil)
+	mockQuerier.EXPECT().
+		TouchSession(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, params db.TouchSessionParams) error {
+			assert.WithinDuration(t, session.CreatedAt.Add(2*time.Hour), params.ExpiresAt, time.Second)
+			return nil
+		})
+
+	sessionContext, err := sessionAPI.GetSession(context.Background(), sessionToken)
+	require.NoError(t, err)
+	assert.Equal(t, "user-1", sessionContext.User.ID)
+	assert.Equal(t, created.ID, sessionContext.SessionID)
+	assert.Equal(t, []auth.Role{auth.Admin}, sessionContext.User.Roles)
+
+	// Expired sessions are refused
+	session.ExpiresAt = time.Now().Add(-time.Minute)
+	mockQuerier.EXPECT().
+		GetSessionByTokenHash(gomock.Any(), created.TokenHash).
+		Return(db.GetSessionByTokenHashRow{UserserviceSession: session, Email: "test@example.com"}, nil)
+	_, err = sessionAPI.GetSession(context.Background(), sessionToken)
+	assert.Error(t, err)
+}
+
+func TestSessions_ListAndRevoke(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockQuerier := mocks.NewMockQuerier(ctrl)
+	sessionAPI := api.NewSessionAPITest(mockQuerier, config.SessionsConfig{}, slog.Default())
+	ctx := withAuthContext(context.Background(), &auth.User{ID: "user-1"})
+
+	mockQuerier.EXPECT().
+		ListSessionsByUserID(gomock.Any(), "user-1").
+		Return([]db.UserserviceSession{
+			{ID: "session-1", UserAgent: "Firefox", ExpiresAt: time.Now().Add(time.Hour)},
+			{ID: "session-2", UserAgent: "Chrome", ExpiresAt: time.Now().Add(-time.Hour)},
+		}, nil)
+
+	resp, err := sessionAPI.ListSessions(ctx, &proto.ListSessionsRequest{})
+	require.NoError(t, err)
+	require.Len(t, resp.Sessions, 1, "expired sessions are left out")
+	assert.Equal(t, "session-1", resp.Sessions[0].Id)
+
+	mockQuerier.EXPECT().
+		RevokeSession(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, params db.RevokeSessionParams) (int64, error) {
+			if params.ID == "session-1" && params.UserID == "user-1" {
+				return 1, nil
+			}
+			return 0, nil
+		}).
+		Times(2)
+
+	_, err = sessionAPI.RevokeSession(ctx, &proto.RevokeSessionRequest{Id: "session-1"})
+	assert.NoError(t, err)
+
+	_, err = sessionAPI.RevokeSession(ctx, &proto.RevokeSessionRequest{Id: "session-of-someone-else"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestSessions_CapPerUser(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockQuerier := mocks.NewMockQuerier(ctrl)
+	sessionAPI := api.NewSessionAPITest(mockQuerier, config.SessionsConfig{}, slog.Default())
+
+	mockQuerier.EXPECT().CreateSession(gomock.Any(), gomock.Any()).Return(nil)
+
+	// Newest first, as listed by the query. Expired sessions don't count.
+	var sessions []db.UserserviceSession
+	for i := 0; i < 22; i++ {
+		sessions = append(sessions, db.UserserviceSession{ID: fmt.Sprintf("session-%d", i), UserID: "user-1", ExpiresAt: time.Now().Add(time.Hour)})
+	}
+	sessions[3].ExpiresAt = time.Now().Add(-time.Minute)
+	mockQuerier.EXPECT().ListSessionsByUserID(gomock.Any(), "user-1").Return(sessions, nil)
+
+	var revoked []string
+	mockQuerier.EXPECT().
+		RevokeSession(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, params db.RevokeSessionParams) (int64, error) {
+			assert.Equal(t, "user-1", params.UserID)
+			revoked = append(revoked, params.ID)
+			return 1, nil
+		}).
+		Times(1)
+
+	_, _, err := sessionAPI.CreateSession(context.Background(), &auth.AuthContext{User: &auth.User{ID: "user-1"}, IsAuthenticated: true}, "Firefox")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"session-21"}, revoked)
+}
+
+func TestRunSessionPurge(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockQuerier := mocks.NewMockQuerier(ctrl)
+	sessionAPI := api.NewSessionAPITest(mockQuerier, config.SessionsConfig{}, slog.Default())
+
+	mockQuerier.EXPECT().
+		DeleteStaleSessions(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, now time.Time) (int64, error) {
+			assert.WithinDuration(t, time.Now(), now, time.Minute)
+			return 3, nil
+		})
+
+	// A cancelled context runs a single purge
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	sessionAPI.RunSessionPurge(ctx)
+}
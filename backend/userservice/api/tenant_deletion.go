@@ -0,0 +1,251 @@
+package api
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	commentProto "sortedstartup.com/stream/commentservice/proto"
+	"sortedstartup.com/stream/common/constants"
+	"sortedstartup.com/stream/common/interceptors"
+	"sortedstartup.com/stream/userservice/db"
+	"sortedstartup.com/stream/userservice/proto"
+	videoProto "sortedstartup.com/stream/videoservice/proto"
+)
+
+const (
+	defaultTenantDeletionGracePeriod = 7 * 24 * time.Hour
+
+	// tenantPurgeInterval is how often the tenants past their grace period are looked for
+	tenantPurgeInterval = time.Hour
+)
+
+/**
+* DeleteTenant schedules a tenant for deletion - restricted to super_admin only, not for personal tenants.
+* The tenant disappears for its members right away and can be restored until the grace period is over,
+* then its data is purged in all services.
+* @param ctx context.Context
+* @param req *proto.DeleteTenantRequest
+* @return *proto.DeleteTenantResponse, error
+ */
+func (s *TenantAPI) DeleteTenant(ctx context.Context, req *proto.DeleteTenantRequest) (*proto.DeleteTenantResponse, error) {
+	s.log.Info("DeleteTenant", "tenantID", req.TenantId)
+
+	authContext, err := interceptors.AuthFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "unauthenticated")
+	}
+
+	if req.TenantId == "" {
+		return nil, status.Error(codes.InvalidArgument, "tenant ID is required")
+	}
+
+	err = s.requireSuperAdmin(ctx, req.TenantId, authContext.User.ID, "delete")
+	if err != nil {
+		return nil, err
+	}
+
+	tenant, err := s.dbQueries.GetTenantByID(ctx, req.TenantId)
+	if err != nil {
+		s.log.Error("Failed to get tenant", "error", err, "tenantID", req.TenantId)
+		return nil, status.Error(codes.Internal, "failed to get tenant")
+	}
+	if tenant.IsPersonal {
+		return nil, status.Error(codes.FailedPrecondition, "personal tenants can't be deleted")
+	}
+	// Typing the name guards against deleting the wrong tenant
+	if req.ConfirmName != tenant.Name {
+		return nil, status.Error(codes.FailedPrecondition, "the confirmation doesn't match the tenant name")
+	}
+
+	now := time.Now()
+	purgeAfter := now.Add(s.tenantDeletionGracePeriod())
+	scheduled, err := s.dbQueries.ScheduleTenantDeletion(ctx, db.ScheduleTenantDeletionParams{
+		DeletedAt:  sql.NullTime{Time: now, Valid: true},
+		DeletedBy:  sql.NullString{String: authContext.User.ID, Valid: true},
+		PurgeAfter: sql.NullTime{Time: purgeAfter, Valid: true},
+		ID:         req.TenantId,
+	})
+	if err != nil {
+		s.log.Error("Failed to schedule tenant deletion", "error", err, "tenantID", req.TenantId)
+		return nil, status.Error(codes.Internal, "failed to delete tenant")
+	}
+	if scheduled == 0 {
+		return nil, status.Error(codes.NotFound, "tenant not found")
+	}
+
+	// Nobody can join a tenant that is going away, restoring it doesn't bring the invitations back
+	err = s.dbQueries.RevokeInvitationsByTenantID(ctx, db.RevokeInvitationsByTenantIDParams{
+		RevokedAt: sql.NullTime{Time: now, Valid: true},
+		TenantID:  req.TenantId,
+	})
+	if err != nil {
+		s.log.Error("Failed to revoke invitations of deleted tenant", "error", err, "tenantID", req.TenantId)
+	}
+
+	s.log.Info("Tenant scheduled for deletion", "tenantID", req.TenantId, "by", authContext.User.ID, "purgeAfter", purgeAfter)
+	return &proto.DeleteTenantResponse{
+		Message:    "Tenant scheduled for deletion",
+		PurgeAfter: timestamppb.New(purgeAfter),
+	}, nil
+}
+
+/**
+* RestoreTenant cancels the deletion of a tenant during its grace period - restricted to super_admin only
+* @param ctx context.Context
+* @param req *proto.RestoreTenantRequest
+* @return *proto.RestoreTenantResponse, error
+ */
+func (s *TenantAPI) RestoreTenant(ctx context.Context, req *proto.RestoreTenantRequest) (*proto.RestoreTenantResponse, error) {
+	s.log.Info("RestoreTenant", "tenantID", req.TenantId)
+
+	authContext, err := interceptors.AuthFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "unauthenticated")
+	}
+
+	if req.TenantId == "" {
+		return nil, status.Error(codes.InvalidArgument, "tenant ID is required")
+	}
+
+	// The usual permission checks don't see deleted tenants
+	userRole, err := s.dbQueries.GetUserRoleInDeletedTenant(ctx, db.GetUserRoleInDeletedTenantParams{
+		TenantID: req.TenantId,
+		UserID:   authContext.User.ID,
+	})
+	if err == sql.ErrNoRows {
+		return nil, status.Error(codes.NotFound, "no deleted tenant found")
+	}
+	if err != nil {
+		s.log.Error("Failed to check user role in tenant", "error", err, "tenantID", req.TenantId)
+		return nil, status.Error(codes.Internal, "failed to check permissions")
+	}
+	if userRole != constants.TenantRoleSuperAdmin {
+		return nil, status.Error(codes.PermissionDenied, "access denied: only super admins can restore tenant")
+	}
+
+	tenant, err := s.dbQueries.GetTenantByID(ctx, req.TenantId)
+	if err != nil {
+		s.log.Error("Failed to get tenant", "error", err, "tenantID", req.TenantId)
+		return nil, status.Error(codes.Internal, "failed to get tenant")
+	}
+	// The purge may already have started
+	if tenant.PurgeAfter.Valid && !time.Now().Before(tenant.PurgeAfter.Time) {
+		return nil, status.Error(codes.FailedPrecondition, "the grace period is over, the tenant can't be restored")
+	}
+
+	restored, err := s.dbQueries.RestoreTenant(ctx, req.TenantId)
+	if err != nil {
+		s.log.Error("Failed to restore tenant", "error", err, "tenantID", req.TenantId)
+		return nil, status.Error(codes.Internal, "failed to restore tenant")
+	}
+	if restored == 0 {
+		return nil, status.Error(codes.NotFound, "no deleted tenant found")
+	}
+	tenant.DeletedAt = sql.NullTime{}
+	tenant.DeletedBy = sql.NullString{}
+	tenant.PurgeAfter = sql.NullTime{}
+
+	s.log.Info("Tenant restored", "tenantID", req.TenantId, "by", authContext.User.ID)
+	return &proto.RestoreTenantResponse{
+		Message: "Tenant restored successfully",
+		Tenant:  tenantToProto(tenant),
+	}, nil
+}
+
+// RunTenantPurge purges the tenants whose grace period is over until ctx is cancelled.
+// There should be one per database, it is started by UserAPI.Start.
+func (s *TenantAPI) RunTenantPurge(ctx context.Context) {
+	s.log.Info("Tenant purge started")
+
+	ticker := time.NewTicker(tenantPurgeInterval)
+	defer ticker.Stop()
+
+	s.purgeDeletedTenants(ctx, time.Now())
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			s.purgeDeletedTenants(ctx, time.Now())
+		}
+	}
+}
+
+// purgeDeletedTenants purges every tenant past its grace period, a tenant that fails is retried on the next run
+func (s *TenantAPI) purgeDeletedTenants(ctx context.Context, now time.Time) {
+	tenants, err := s.dbQueries.ListTenantsPendingDeletion(ctx)
+	if err != nil {
+		s.log.Error("Failed to list tenants pending deletion", "error", err)
+		return
+	}
+
+	for _, tenant := range tenants {
+		if !tenant.PurgeAfter.Valid || now.Before(tenant.PurgeAfter.Time) {
+			continue
+		}
+		err := s.purgeTenant(ctx, tenant.ID)
+		if err != nil {
+			s.log.Error("Failed to purge tenant", "error", err, "tenantID", tenant.ID)
+			continue
+		}
+		s.log.Info("Tenant purged", "tenantID", tenant.ID, "deletedBy", tenant.DeletedBy.String)
+	}
+}
+
+// purgeTenant deletes the data of the other services first, the tenant row is what marks the purge as pending.
+// Every step can be repeated, so a purge interrupted halfway finishes on the next run. Only comments from before
+// comments recorded their tenant are missed then, they are found through the videos deleted by the first run.
+func (s *TenantAPI) purgeTenant(ctx context.Context, tenantID string) error {
+	videoResp, err := s.videoCleanupClient.DeleteTenantData(ctx, &videoProto.DeleteTenantDataRequest{TenantId: tenantID})
+	if err != nil {
+		return err
+	}
+
+	_, err = s.commentCleanupClient.DeleteTenantData(ctx, &commentProto.DeleteTenantDataRequest{
+		TenantId: tenantID,
+		VideoIds: videoResp.VideoIds,
+	})
+	if err != nil {
+		return err
+	}
+
+	err = s.dbQueries.DeleteInvitationsByTenantID(ctx, tenantID)
+	if err != nil {
+		return err
+	}
+	err = s.dbQueries.DeleteAccessTokensByTenantID(ctx, tenantID)
+	if err != nil {
+		return err
+	}
+	err = s.dbQueries.DeleteTenantUsersByTenantID(ctx, tenantID)
+	if err != nil {
+		return err
+	}
+	return s.dbQueries.DeleteTenant(ctx, tenantID)
+}
+
+func (s *TenantAPI) tenantDeletionGracePeriod() time.Duration {
+	if s.config.TenantDeletion.GracePeriod > 0 {
+		return s.config.TenantDeletion.GracePeriod
+	}
+	return defaultTenantDeletionGracePeriod
+}
+
+func tenantToProto(tenant db.UserserviceTenant) *proto.Tenant {
+	protoTenant := &proto.Tenant{
+		Id:          tenant.ID,
+		Name:        tenant.Name,
+		Description: tenant.Description.String,
+		IsPersonal:  tenant.IsPersonal,
+		CreatedAt:   timestamppb.New(tenant.CreatedAt),
+		CreatedBy:   tenant.CreatedBy,
+	}
+	if tenant.PurgeAfter.Valid {
+		protoTenant.PurgeAfter = timestamppb.New(tenant.PurgeAfter.Time)
+	}
+	return protoTenant
+}
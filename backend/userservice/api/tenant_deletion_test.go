@@ -0,0 +1,158 @@
+package api_test
+
+import (
+	"context"
+	"database/sql"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	commentProto "sortedstartup.com/stream/commentservice/proto"
+	"sortedstartup.com/stream/common/auth"
+	"sortedstartup.com/stream/userservice/api"
+	"sortedstartup.com/stream/userservice/db"
+	"sortedstartup.com/stream/userservice/db/mocks"
+	"sortedstartup.com/stream/userservice/proto"
+	videoProto "sortedstartup.com/stream/videoservice/proto"
+)
+
+// commentCleanupStub records the purge requests, commentservice has no generated client mock
+type commentCleanupStub struct {
+	requests []*commentProto.DeleteTenantDataRequest
+}
+
+func (c *commentCleanupStub) DeleteTenantData(ctx context.Context, in *commentProto.DeleteTenantDataRequest, opts ...grpc.CallOption) (*commentProto.DeleteTenantDataResponse, error) {
+	c.requests = append(c.requests, in)
+	return &commentProto.DeleteTenantDataResponse{}, nil
+}
+
+func TestDeleteTenant_SchedulesPurge(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockQuerier := mocks.NewMockQuerier(ctrl)
+	tenantAPI := api.NewTenantAPITest(mockQuerier, slog.Default())
+	ctx := withAuthContext(context.Background(), &auth.User{ID: "owner-1"})
+
+	// A confirmation that doesn't match is refused
+	expectMember(mockQuerier, "owner-1", "super_admin")
+	mockQuerier.EXPECT().GetTenantByID(gomock.Any(), "tenant-1").Return(teamTenant, nil)
+	_, err := tenantAPI.DeleteTenant(ctx, &proto.DeleteTenantRequest{TenantId: "tenant-1", ConfirmName: "acme"})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	expectMember(mockQuerier, "owner-1", "super_admin")
+	mockQuerier.EXPECT().GetTenantByID(gomock.Any(), "tenant-1").Return(teamTenant, nil)
+	mockQuerier.EXPECT().
+		ScheduleTenantDeletion(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, params db.ScheduleTenantDeletionParams) (int64, error) {
+			assert.Equal(t, "tenant-1", params.ID)
+			assert.Equal(t, "owner-1", params.DeletedBy.String)
+			assert.WithinDuration(t, time.Now().Add(7*24*time.Hour), params.PurgeAfter.Time, time.Minute)
+			return 1, nil
+		})
+	mockQuerier.EXPECT().
+		RevokeInvitationsByTenantID(gomock.Any(), gomock.Any()).
+		Return(nil)
+
+	resp, err := tenantAPI.DeleteTenant(ctx, &proto.DeleteTenantRequest{TenantId: "tenant-1", ConfirmName: "Acme"})
+	require.NoError(t, err)
+	assert.WithinDuration(t, time.Now().Add(7*24*time.Hour), resp.PurgeAfter.AsTime(), time.Minute)
+}
+
+func TestDeleteTenant_Refused(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockQuerier := mocks.NewMockQuerier(ctrl)
+	tenantAPI := api.NewTenantAPITest(mockQuerier, slog.Default())
+	ctx := withAuthContext(context.Background(), &auth.User{ID: "user-2"})
+
+	// Members can't delete the tenant
+	expectMember(mockQuerier, "user-2", "member")
+	_, err := tenantAPI.DeleteTenant(ctx, &proto.DeleteTenantRequest{TenantId: "tenant-1", ConfirmName: "Acme"})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	// Personal tenants can't be deleted
+	personalTenant := teamTenant
+	personalTenant.IsPersonal = true
+	expectMember(mockQuerier, "user-2", "super_admin")
+	mockQuerier.EXPECT().GetTenantByID(gomock.Any(), "tenant-1").Return(personalTenant, nil)
+	_, err = tenantAPI.DeleteTenant(ctx, &proto.DeleteTenantRequest{TenantId: "tenant-1", ConfirmName: "Acme"})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+}
+
+func TestRestoreTenant(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockQuerier := mocks.NewMockQuerier(ctrl)
+	tenantAPI := api.NewTenantAPITest(mockQuerier, slog.Default())
+	ctx := withAuthContext(context.Background(), &auth.User{ID: "owner-1"})
+
+	deletedTenant := teamTenant
+	deletedTenant.DeletedAt = sql.NullTime{Time: time.Now(), Valid: true}
+	deletedTenant.PurgeAfter = sql.NullTime{Time: time.Now().Add(time.Hour), Valid: true}
+
+	mockQuerier.EXPECT().
+		GetUserRoleInDeletedTenant(gomock.Any(), db.GetUserRoleInDeletedTenantParams{TenantID: "tenant-1", UserID: "owner-1"}).
+		Return("super_admin", nil)
+	mockQuerier.EXPECT().GetTenantByID(gomock.Any(), "tenant-1").Return(deletedTenant, nil)
+	mockQuerier.EXPECT().RestoreTenant(gomock.Any(), "tenant-1").Return(int64(1), nil)
+
+	resp, err := tenantAPI.RestoreTenant(ctx, &proto.RestoreTenantRequest{TenantId: "tenant-1"})
+	require.NoError(t, err)
+	assert.Nil(t, resp.Tenant.PurgeAfter)
+
+	// Once the grace period is over the purge may have started
+	deletedTenant.PurgeAfter = sql.NullTime{Time: time.Now().Add(-time.Minute), Valid: true}
+	mockQuerier.EXPECT().
+		GetUserRoleInDeletedTenant(gomock.Any(), gomock.Any()).
+		Return("super_admin", nil)
+	mockQuerier.EXPECT().GetTenantByID(gomock.Any(), "tenant-1").Return(deletedTenant, nil)
+
+	_, err = tenantAPI.RestoreTenant(ctx, &proto.RestoreTenantRequest{TenantId: "tenant-1"})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+}
+
+func TestRunTenantPurge_PurgesTenantsPastGracePeriod(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockQuerier := mocks.NewMockQuerier(ctrl)
+	mockVideoCleanup := videoProto.NewMockTenantCleanupServiceClient(ctrl)
+	commentCleanup := &commentCleanupStub{}
+	tenantAPI := api.NewTenantAPITestWithClients(mockQuerier, nil, mockVideoCleanup, commentCleanup, slog.Default())
+
+	mockQuerier.EXPECT().
+		ListTenantsPendingDeletion(gomock.Any()).
+		Return([]db.UserserviceTenant{
+			{ID: "due", PurgeAfter: sql.NullTime{Time: time.Now().Add(-time.Minute), Valid: true}},
+			{ID: "in-grace-period", PurgeAfter: sql.NullTime{Time: time.Now().Add(time.Hour), Valid: true}},
+		}, nil)
+
+	// The other services go first, the tenant row goes last
+	gomock.InOrder(
+		mockVideoCleanup.EXPECT().
+			DeleteTenantData(gomock.Any(), &videoProto.DeleteTenantDataRequest{TenantId: "due"}).
+			Return(&videoProto.DeleteTenantDataResponse{VideoIds: []string{"video-1"}}, nil),
+		mockQuerier.EXPECT().DeleteInvitationsByTenantID(gomock.Any(), "due").Return(nil),
+		mockQuerier.EXPECT().DeleteAccessTokensByTenantID(gomock.Any(), "due").Return(nil),
+		mockQuerier.EXPECT().DeleteTenantUsersByTenantID(gomock.Any(), "due").Return(nil),
+		mockQuerier.EXPECT().DeleteTenant(gomock.Any(), "due").Return(nil),
+	)
+
+	// A cancelled context runs a single purge
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	tenantAPI.RunTenantPurge(ctx)
+
+	require.Len(t, commentCleanup.requests, 1)
+	assert.Equal(t, "due", commentCleanup.requests[0].TenantId)
+	assert.Equal(t, []string{"video-1"}, commentCleanup.requests[0].VideoIds)
+}
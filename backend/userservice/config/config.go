@@ -1,11 +1,35 @@
 package config
 
+import "time"
+
 type UserServiceConfig struct {
-	DB       DBConfig `json:"db" mapstructure:"db"`
-	CacheSize int      `json:"cacheSize" mapstructure:"cacheSize"`
+	DB        DBConfig        `json:"db" mapstructure:"db"`
+	CacheSize int             `json:"cacheSize" mapstructure:"cacheSize"`
+	LocalAuth LocalAuthConfig `json:"localAuth" mapstructure:"localAuth"`
+	Sessions  SessionsConfig  `json:"sessions" mapstructure:"sessions"`
+
+	TenantDeletion TenantDeletionConfig `json:"tenantDeletion" mapstructure:"tenantDeletion"`
 }
 
 type DBConfig struct {
 	Driver string `json:"driver" mapstructure:"driver"`
 	Url    string `json:"url" mapstructure:"url"`
 }
+
+// LocalAuthConfig configures the built-in email/password accounts, used when auth.provider is local
+type LocalAuthConfig struct {
+	SignupEnabled bool `json:"signupEnabled" mapstructure:"signupEnabled"` // When false Signup is refused, e.g. for a closed instance
+	// Users with these emails get the admin role when they log in, admins can reset passwords
+	AdminEmails []string `json:"adminEmails" mapstructure:"adminEmails"`
+}
+
+// SessionsConfig configures the browser sessions behind the session cookie
+type SessionsConfig struct {
+	IdleTimeout time.Duration `json:"idleTimeout" mapstructure:"idleTimeout"` // A session ends when it isn't used for this long
+	MaxAge      time.Duration `json:"maxAge" mapstructure:"maxAge"`           // A session ends this long after it started, however much it is used
+}
+
+// TenantDeletionConfig configures how long a deleted tenant can be restored before its data is purged
+type TenantDeletionConfig struct {
+	GracePeriod time.Duration `json:"gracePeriod" mapstructure:"gracePeriod"`
+}
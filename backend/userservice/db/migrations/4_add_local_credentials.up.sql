@@ -0,0 +1,20 @@
+-- Passwords of the built-in email/password accounts, only used when auth.provider is local.
+-- Users signed up through Firebase or OIDC have no row here.
+CREATE TABLE userservice_local_credentials (
+    user_id TEXT PRIMARY KEY REFERENCES userservice_users(id) ON DELETE CASCADE,
+    password_hash TEXT NOT NULL, -- argon2id, PHC string format
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- One-time password reset tokens created by admins. Only the SHA-256 of the token is stored.
+CREATE TABLE userservice_password_resets (
+    token_hash TEXT PRIMARY KEY,
+    user_id TEXT NOT NULL REFERENCES userservice_users(id) ON DELETE CASCADE,
+    created_by TEXT NOT NULL REFERENCES userservice_users(id),
+    expires_at TIMESTAMP NOT NULL,
+    used_at TIMESTAMP,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_userservice_password_resets_user_id ON userservice_password_resets(user_id);
@@ -0,0 +1,17 @@
+-- Personal access tokens for automation, e.g. CI uploading videos.
+-- Only the SHA-256 of the token is stored, the token itself is shown once when it is created.
+CREATE TABLE userservice_access_tokens (
+    id TEXT PRIMARY KEY,
+    user_id TEXT NOT NULL REFERENCES userservice_users(id) ON DELETE CASCADE,
+    tenant_id TEXT NOT NULL REFERENCES userservice_tenants(id) ON DELETE CASCADE, -- The only tenant the token can be used in
+    name TEXT NOT NULL,
+    token_hash TEXT NOT NULL UNIQUE,
+    token_prefix TEXT NOT NULL, -- First characters of the token, to tell tokens apart in lists
+    scopes TEXT NOT NULL, -- Space separated, e.g. 'videos:read videos:write'
+    expires_at TIMESTAMP NOT NULL,
+    last_used_at TIMESTAMP,
+    revoked_at TIMESTAMP,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_userservice_access_tokens_user_id ON userservice_access_tokens(user_id);
@@ -0,0 +1,15 @@
+-- Browser sessions behind the session cookie. The cookie holds an opaque token, only its SHA-256 is stored.
+-- id is what users see to revoke a session, it can't be used to authenticate.
+CREATE TABLE userservice_sessions (
+    id TEXT PRIMARY KEY,
+    token_hash TEXT NOT NULL UNIQUE,
+    user_id TEXT NOT NULL REFERENCES userservice_users(id) ON DELETE CASCADE,
+    roles TEXT NOT NULL DEFAULT '', -- Space separated roles of the ID token the session was created with
+    user_agent TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    last_seen_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    expires_at TIMESTAMP NOT NULL, -- Slides forward while the session is used, up to a maximum age
+    revoked_at TIMESTAMP
+);
+
+CREATE INDEX idx_userservice_sessions_user_id ON userservice_sessions(user_id);
@@ -0,0 +1,19 @@
+-- Invitations to join a tenant by email, the invitee may not have an account yet.
+-- The token is only sent in the invitation email, only its SHA-256 is stored.
+CREATE TABLE userservice_invitations (
+    id TEXT PRIMARY KEY,
+    tenant_id TEXT NOT NULL REFERENCES userservice_tenants(id),
+    email TEXT NOT NULL, -- Lowercased
+    role TEXT NOT NULL DEFAULT 'member',
+    token_hash TEXT NOT NULL UNIQUE,
+    invited_by TEXT NOT NULL REFERENCES userservice_users(id),
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    expires_at TIMESTAMP NOT NULL,
+    accepted_at TIMESTAMP,
+    accepted_by TEXT REFERENCES userservice_users(id),
+    declined_at TIMESTAMP,
+    revoked_at TIMESTAMP
+);
+
+CREATE INDEX idx_userservice_invitations_tenant_id ON userservice_invitations(tenant_id);
+CREATE INDEX idx_userservice_invitations_email ON userservice_invitations(email);
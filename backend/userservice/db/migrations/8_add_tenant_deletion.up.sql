@@ -0,0 +1,5 @@
+-- Deleted tenants are kept for a grace period, so a deletion can be undone.
+-- Their data in the other services is purged once purge_after has passed.
+ALTER TABLE userservice_tenants ADD COLUMN deleted_at TIMESTAMP;
+ALTER TABLE userservice_tenants ADD COLUMN deleted_by TEXT; -- References userservice_users(id)
+ALTER TABLE userservice_tenants ADD COLUMN purge_after TIMESTAMP;
@@ -0,0 +1,3 @@
+-- Local tokens carry the token version they were issued with. Changing the password bumps it,
+-- which signs out every token issued before.
+ALTER TABLE userservice_local_credentials ADD COLUMN token_version INTEGER NOT NULL DEFAULT 0;
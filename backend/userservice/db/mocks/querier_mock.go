@@ -7,6 +7,7 @@ package mocks
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	gomock "github.com/golang/mock/gomock"
 	db "sortedstartup.com/stream/userservice/db"
@@ -35,6 +36,107 @@ func (m *MockQuerier) EXPECT() *MockQuerierMockRecorder {
 	return m.recorder
 }
 
+// AcceptInvitation mocks base method.
+func (m *MockQuerier) AcceptInvitation(ctx context.Context, params db.AcceptInvitationParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AcceptInvitation", ctx, params)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AcceptInvitation indicates an expected call of AcceptInvitation.
+func (mr *MockQuerierMockRecorder) AcceptInvitation(ctx, params interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AcceptInvitation", reflect.TypeOf((*MockQuerier)(nil).AcceptInvitation), ctx, params)
+}
+
+// CountAccessTokensByUserID mocks base method.
+func (m *MockQuerier) CountAccessTokensByUserID(ctx context.Context, params db.CountAccessTokensByUserIDParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountAccessTokensByUserID", ctx, params)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountAccessTokensByUserID indicates an expected call of CountAccessTokensByUserID.
+func (mr *MockQuerierMockRecorder) CountAccessTokensByUserID(ctx, params interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountAccessTokensByUserID", reflect.TypeOf((*MockQuerier)(nil).CountAccessTokensByUserID), ctx, params)
+}
+
+// CountTenantUsersByRole mocks base method.
+func (m *MockQuerier) CountTenantUsersByRole(ctx context.Context, params db.CountTenantUsersByRoleParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountTenantUsersByRole", ctx, params)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountTenantUsersByRole indicates an expected call of CountTenantUsersByRole.
+func (mr *MockQuerierMockRecorder) CountTenantUsersByRole(ctx, params interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountTenantUsersByRole", reflect.TypeOf((*MockQuerier)(nil).CountTenantUsersByRole), ctx, params)
+}
+
+// CreateAccessToken mocks base method.
+func (m *MockQuerier) CreateAccessToken(ctx context.Context, params db.CreateAccessTokenParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAccessToken", ctx, params)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateAccessToken indicates an expected call of CreateAccessToken.
+func (mr *MockQuerierMockRecorder) CreateAccessToken(ctx, params interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAccessToken", reflect.TypeOf((*MockQuerier)(nil).CreateAccessToken), ctx, params)
+}
+
+// CreateInvitation mocks base method.
+func (m *MockQuerier) CreateInvitation(ctx context.Context, params db.CreateInvitationParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateInvitation", ctx, params)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateInvitation indicates an expected call of CreateInvitation.
+func (mr *MockQuerierMockRecorder) CreateInvitation(ctx, params interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateInvitation", reflect.TypeOf((*MockQuerier)(nil).CreateInvitation), ctx, params)
+}
+
+// CreatePasswordReset mocks base method.
+func (m *MockQuerier) CreatePasswordReset(ctx context.Context, params db.CreatePasswordResetParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePasswordReset", ctx, params)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreatePasswordReset indicates an expected call of CreatePasswordReset.
+func (mr *MockQuerierMockRecorder) CreatePasswordReset(ctx, params interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePasswordReset", reflect.TypeOf((*MockQuerier)(nil).CreatePasswordReset), ctx, params)
+}
+
+// CreateSession mocks base method.
+func (m *MockQuerier) CreateSession(ctx context.Context, params db.CreateSessionParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSession", ctx, params)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateSession indicates an expected call of CreateSession.
+func (mr *MockQuerierMockRecorder) CreateSession(ctx, params interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSession", reflect.TypeOf((*MockQuerier)(nil).CreateSession), ctx, params)
+}
+
 // CreateTenant mocks base method.
 func (m *MockQuerier) CreateTenant(ctx context.Context, params db.CreateTenantParams) (db.UserserviceTenant, error) {
 	m.ctrl.T.Helper()
@@ -80,6 +182,212 @@ func (mr *MockQuerierMockRecorder) CreateUser(ctx, params interface{}) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUser", reflect.TypeOf((*MockQuerier)(nil).CreateUser), ctx, params)
 }
 
+// DeclineInvitation mocks base method.
+func (m *MockQuerier) DeclineInvitation(ctx context.Context, params db.DeclineInvitationParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeclineInvitation", ctx, params)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeclineInvitation indicates an expected call of DeclineInvitation.
+func (mr *MockQuerierMockRecorder) DeclineInvitation(ctx, params interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeclineInvitation", reflect.TypeOf((*MockQuerier)(nil).DeclineInvitation), ctx, params)
+}
+
+// DeleteAccessTokensByTenantID mocks base method.
+func (m *MockQuerier) DeleteAccessTokensByTenantID(ctx context.Context, tenantID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAccessTokensByTenantID", ctx, tenantID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteAccessTokensByTenantID indicates an expected call of DeleteAccessTokensByTenantID.
+func (mr *MockQuerierMockRecorder) DeleteAccessTokensByTenantID(ctx, tenantID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAccessTokensByTenantID", reflect.TypeOf((*MockQuerier)(nil).DeleteAccessTokensByTenantID), ctx, tenantID)
+}
+
+// DeleteInvitationsByTenantID mocks base method.
+func (m *MockQuerier) DeleteInvitationsByTenantID(ctx context.Context, tenantID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteInvitationsByTenantID", ctx, tenantID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteInvitationsByTenantID indicates an expected call of DeleteInvitationsByTenantID.
+func (mr *MockQuerierMockRecorder) DeleteInvitationsByTenantID(ctx, tenantID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteInvitationsByTenantID", reflect.TypeOf((*MockQuerier)(nil).DeleteInvitationsByTenantID), ctx, tenantID)
+}
+
+// DeleteStaleSessions mocks base method.
+func (m *MockQuerier) DeleteStaleSessions(ctx context.Context, now time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteStaleSessions", ctx, now)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteStaleSessions indicates an expected call of DeleteStaleSessions.
+func (mr *MockQuerierMockRecorder) DeleteStaleSessions(ctx, now interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteStaleSessions", reflect.TypeOf((*MockQuerier)(nil).DeleteStaleSessions), ctx, now)
+}
+
+// DeleteTenant mocks base method.
+func (m *MockQuerier) DeleteTenant(ctx context.Context, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTenant", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteTenant indicates an expected call of DeleteTenant.
+func (mr *MockQuerierMockRecorder) DeleteTenant(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTenant", reflect.TypeOf((*MockQuerier)(nil).DeleteTenant), ctx, id)
+}
+
+// DeleteTenantUser mocks base method.
+func (m *MockQuerier) DeleteTenantUser(ctx context.Context, params db.DeleteTenantUserParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTenantUser", ctx, params)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteTenantUser indicates an expected call of DeleteTenantUser.
+func (mr *MockQuerierMockRecorder) DeleteTenantUser(ctx, params interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTenantUser", reflect.TypeOf((*MockQuerier)(nil).DeleteTenantUser), ctx, params)
+}
+
+// DeleteTenantUsersByTenantID mocks base method.
+func (m *MockQuerier) DeleteTenantUsersByTenantID(ctx context.Context, tenantID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTenantUsersByTenantID", ctx, tenantID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteTenantUsersByTenantID indicates an expected call of DeleteTenantUsersByTenantID.
+func (mr *MockQuerierMockRecorder) DeleteTenantUsersByTenantID(ctx, tenantID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTenantUsersByTenantID", reflect.TypeOf((*MockQuerier)(nil).DeleteTenantUsersByTenantID), ctx, tenantID)
+}
+
+// GetAccessTokenByHash mocks base method.
+func (m *MockQuerier) GetAccessTokenByHash(ctx context.Context, tokenHash string) (db.GetAccessTokenByHashRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAccessTokenByHash", ctx, tokenHash)
+	ret0, _ := ret[0].(db.GetAccessTokenByHashRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAccessTokenByHash indicates an expected call of GetAccessTokenByHash.
+func (mr *MockQuerierMockRecorder) GetAccessTokenByHash(ctx, tokenHash interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccessTokenByHash", reflect.TypeOf((*MockQuerier)(nil).GetAccessTokenByHash), ctx, tokenHash)
+}
+
+// GetInvitationByTokenHash mocks base method.
+func (m *MockQuerier) GetInvitationByTokenHash(ctx context.Context, tokenHash string) (db.GetInvitationByTokenHashRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetInvitationByTokenHash", ctx, tokenHash)
+	ret0, _ := ret[0].(db.GetInvitationByTokenHashRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetInvitationByTokenHash indicates an expected call of GetInvitationByTokenHash.
+func (mr *MockQuerierMockRecorder) GetInvitationByTokenHash(ctx, tokenHash interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetInvitationByTokenHash", reflect.TypeOf((*MockQuerier)(nil).GetInvitationByTokenHash), ctx, tokenHash)
+}
+
+// GetLocalCredentialByUserID mocks base method.
+func (m *MockQuerier) GetLocalCredentialByUserID(ctx context.Context, userID string) (db.UserserviceLocalCredential, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLocalCredentialByUserID", ctx, userID)
+	ret0, _ := ret[0].(db.UserserviceLocalCredential)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLocalCredentialByUserID indicates an expected call of GetLocalCredentialByUserID.
+func (mr *MockQuerierMockRecorder) GetLocalCredentialByUserID(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLocalCredentialByUserID", reflect.TypeOf((*MockQuerier)(nil).GetLocalCredentialByUserID), ctx, userID)
+}
+
+// GetPasswordResetByTokenHash mocks base method.
+func (m *MockQuerier) GetPasswordResetByTokenHash(ctx context.Context, tokenHash string) (db.UserservicePasswordReset, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPasswordResetByTokenHash", ctx, tokenHash)
+	ret0, _ := ret[0].(db.UserservicePasswordReset)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPasswordResetByTokenHash indicates an expected call of GetPasswordResetByTokenHash.
+func (mr *MockQuerierMockRecorder) GetPasswordResetByTokenHash(ctx, tokenHash interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPasswordResetByTokenHash", reflect.TypeOf((*MockQuerier)(nil).GetPasswordResetByTokenHash), ctx, tokenHash)
+}
+
+// GetSessionByTokenHash mocks base method.
+func (m *MockQuerier) GetSessionByTokenHash(ctx context.Context, tokenHash string) (db.GetSessionByTokenHashRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSessionByTokenHash", ctx, tokenHash)
+	ret0, _ := ret[0].(db.GetSessionByTokenHashRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSessionByTokenHash indicates an expected call of GetSessionByTokenHash.
+func (mr *MockQuerierMockRecorder) GetSessionByTokenHash(ctx, tokenHash interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSessionByTokenHash", reflect.TypeOf((*MockQuerier)(nil).GetSessionByTokenHash), ctx, tokenHash)
+}
+
+// GetTenantByID mocks base method.
+func (m *MockQuerier) GetTenantByID(ctx context.Context, id string) (db.UserserviceTenant, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTenantByID", ctx, id)
+	ret0, _ := ret[0].(db.UserserviceTenant)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTenantByID indicates an expected call of GetTenantByID.
+func (mr *MockQuerierMockRecorder) GetTenantByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTenantByID", reflect.TypeOf((*MockQuerier)(nil).GetTenantByID), ctx, id)
+}
+
+// GetTenantByName mocks base method.
+func (m *MockQuerier) GetTenantByName(ctx context.Context, params db.GetTenantByNameParams) (db.UserserviceTenant, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTenantByName", ctx, params)
+	ret0, _ := ret[0].(db.UserserviceTenant)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTenantByName indicates an expected call of GetTenantByName.
+func (mr *MockQuerierMockRecorder) GetTenantByName(ctx, params interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTenantByName", reflect.TypeOf((*MockQuerier)(nil).GetTenantByName), ctx, params)
+}
+
 // GetTenantUsers mocks base method.
 func (m *MockQuerier) GetTenantUsers(ctx context.Context, tenantID string) ([]db.GetTenantUsersRow, error) {
 	m.ctrl.T.Helper()
@@ -110,6 +418,21 @@ func (mr *MockQuerierMockRecorder) GetUserByEmail(ctx, email interface{}) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserByEmail", reflect.TypeOf((*MockQuerier)(nil).GetUserByEmail), ctx, email)
 }
 
+// GetUserRoleInDeletedTenant mocks base method.
+func (m *MockQuerier) GetUserRoleInDeletedTenant(ctx context.Context, params db.GetUserRoleInDeletedTenantParams) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserRoleInDeletedTenant", ctx, params)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserRoleInDeletedTenant indicates an expected call of GetUserRoleInDeletedTenant.
+func (mr *MockQuerierMockRecorder) GetUserRoleInDeletedTenant(ctx, params interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserRoleInDeletedTenant", reflect.TypeOf((*MockQuerier)(nil).GetUserRoleInDeletedTenant), ctx, params)
+}
+
 // GetUserRoleInTenant mocks base method.
 func (m *MockQuerier) GetUserRoleInTenant(ctx context.Context, params db.GetUserRoleInTenantParams) (string, error) {
 	m.ctrl.T.Helper()
@@ -139,3 +462,325 @@ func (mr *MockQuerierMockRecorder) GetUserTenants(ctx, userID interface{}) *gomo
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserTenants", reflect.TypeOf((*MockQuerier)(nil).GetUserTenants), ctx, userID)
 }
+
+// GetUsersByIDs mocks base method.
+func (m *MockQuerier) GetUsersByIDs(ctx context.Context, ids []string) ([]db.UserserviceUser, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUsersByIDs", ctx, ids)
+	ret0, _ := ret[0].([]db.UserserviceUser)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUsersByIDs indicates an expected call of GetUsersByIDs.
+func (mr *MockQuerierMockRecorder) GetUsersByIDs(ctx, ids interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUsersByIDs", reflect.TypeOf((*MockQuerier)(nil).GetUsersByIDs), ctx, ids)
+}
+
+// ListAccessTokensByUserID mocks base method.
+func (m *MockQuerier) ListAccessTokensByUserID(ctx context.Context, userID string) ([]db.UserserviceAccessToken, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAccessTokensByUserID", ctx, userID)
+	ret0, _ := ret[0].([]db.UserserviceAccessToken)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAccessTokensByUserID indicates an expected call of ListAccessTokensByUserID.
+func (mr *MockQuerierMockRecorder) ListAccessTokensByUserID(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAccessTokensByUserID", reflect.TypeOf((*MockQuerier)(nil).ListAccessTokensByUserID), ctx, userID)
+}
+
+// ListInvitationsByEmail mocks base method.
+func (m *MockQuerier) ListInvitationsByEmail(ctx context.Context, email string) ([]db.UserserviceInvitation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListInvitationsByEmail", ctx, email)
+	ret0, _ := ret[0].([]db.UserserviceInvitation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListInvitationsByEmail indicates an expected call of ListInvitationsByEmail.
+func (mr *MockQuerierMockRecorder) ListInvitationsByEmail(ctx, email interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListInvitationsByEmail", reflect.TypeOf((*MockQuerier)(nil).ListInvitationsByEmail), ctx, email)
+}
+
+// ListInvitationsByTenantID mocks base method.
+func (m *MockQuerier) ListInvitationsByTenantID(ctx context.Context, tenantID string) ([]db.UserserviceInvitation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListInvitationsByTenantID", ctx, tenantID)
+	ret0, _ := ret[0].([]db.UserserviceInvitation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListInvitationsByTenantID indicates an expected call of ListInvitationsByTenantID.
+func (mr *MockQuerierMockRecorder) ListInvitationsByTenantID(ctx, tenantID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListInvitationsByTenantID", reflect.TypeOf((*MockQuerier)(nil).ListInvitationsByTenantID), ctx, tenantID)
+}
+
+// ListSessionsByUserID mocks base method.
+func (m *MockQuerier) ListSessionsByUserID(ctx context.Context, userID string) ([]db.UserserviceSession, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSessionsByUserID", ctx, userID)
+	ret0, _ := ret[0].([]db.UserserviceSession)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSessionsByUserID indicates an expected call of ListSessionsByUserID.
+func (mr *MockQuerierMockRecorder) ListSessionsByUserID(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSessionsByUserID", reflect.TypeOf((*MockQuerier)(nil).ListSessionsByUserID), ctx, userID)
+}
+
+// ListTenantsPendingDeletion mocks base method.
+func (m *MockQuerier) ListTenantsPendingDeletion(ctx context.Context) ([]db.UserserviceTenant, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTenantsPendingDeletion", ctx)
+	ret0, _ := ret[0].([]db.UserserviceTenant)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTenantsPendingDeletion indicates an expected call of ListTenantsPendingDeletion.
+func (mr *MockQuerierMockRecorder) ListTenantsPendingDeletion(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTenantsPendingDeletion", reflect.TypeOf((*MockQuerier)(nil).ListTenantsPendingDeletion), ctx)
+}
+
+// MarkPasswordResetUsed mocks base method.
+func (m *MockQuerier) MarkPasswordResetUsed(ctx context.Context, params db.MarkPasswordResetUsedParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkPasswordResetUsed", ctx, params)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MarkPasswordResetUsed indicates an expected call of MarkPasswordResetUsed.
+func (mr *MockQuerierMockRecorder) MarkPasswordResetUsed(ctx, params interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkPasswordResetUsed", reflect.TypeOf((*MockQuerier)(nil).MarkPasswordResetUsed), ctx, params)
+}
+
+// RestoreTenant mocks base method.
+func (m *MockQuerier) RestoreTenant(ctx context.Context, id string) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreTenant", ctx, id)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RestoreTenant indicates an expected call of RestoreTenant.
+func (mr *MockQuerierMockRecorder) RestoreTenant(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreTenant", reflect.TypeOf((*MockQuerier)(nil).RestoreTenant), ctx, id)
+}
+
+// RevokeAccessToken mocks base method.
+func (m *MockQuerier) RevokeAccessToken(ctx context.Context, params db.RevokeAccessTokenParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeAccessToken", ctx, params)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RevokeAccessToken indicates an expected call of RevokeAccessToken.
+func (mr *MockQuerierMockRecorder) RevokeAccessToken(ctx, params interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeAccessToken", reflect.TypeOf((*MockQuerier)(nil).RevokeAccessToken), ctx, params)
+}
+
+// RevokeInvitation mocks base method.
+func (m *MockQuerier) RevokeInvitation(ctx context.Context, params db.RevokeInvitationParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeInvitation", ctx, params)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RevokeInvitation indicates an expected call of RevokeInvitation.
+func (mr *MockQuerierMockRecorder) RevokeInvitation(ctx, params interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeInvitation", reflect.TypeOf((*MockQuerier)(nil).RevokeInvitation), ctx, params)
+}
+
+// RevokeInvitationsByTenantAndEmail mocks base method.
+func (m *MockQuerier) RevokeInvitationsByTenantAndEmail(ctx context.Context, params db.RevokeInvitationsByTenantAndEmailParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeInvitationsByTenantAndEmail", ctx, params)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeInvitationsByTenantAndEmail indicates an expected call of RevokeInvitationsByTenantAndEmail.
+func (mr *MockQuerierMockRecorder) RevokeInvitationsByTenantAndEmail(ctx, params interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeInvitationsByTenantAndEmail", reflect.TypeOf((*MockQuerier)(nil).RevokeInvitationsByTenantAndEmail), ctx, params)
+}
+
+// RevokeInvitationsByTenantID mocks base method.
+func (m *MockQuerier) RevokeInvitationsByTenantID(ctx context.Context, params db.RevokeInvitationsByTenantIDParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeInvitationsByTenantID", ctx, params)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeInvitationsByTenantID indicates an expected call of RevokeInvitationsByTenantID.
+func (mr *MockQuerierMockRecorder) RevokeInvitationsByTenantID(ctx, params interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeInvitationsByTenantID", reflect.TypeOf((*MockQuerier)(nil).RevokeInvitationsByTenantID), ctx, params)
+}
+
+// RevokeSession mocks base method.
+func (m *MockQuerier) RevokeSession(ctx context.Context, params db.RevokeSessionParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeSession", ctx, params)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RevokeSession indicates an expected call of RevokeSession.
+func (mr *MockQuerierMockRecorder) RevokeSession(ctx, params interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeSession", reflect.TypeOf((*MockQuerier)(nil).RevokeSession), ctx, params)
+}
+
+// RevokeSessionByTokenHash mocks base method.
+func (m *MockQuerier) RevokeSessionByTokenHash(ctx context.Context, params db.RevokeSessionByTokenHashParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeSessionByTokenHash", ctx, params)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeSessionByTokenHash indicates an expected call of RevokeSessionByTokenHash.
+func (mr *MockQuerierMockRecorder) RevokeSessionByTokenHash(ctx, params interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeSessionByTokenHash", reflect.TypeOf((*MockQuerier)(nil).RevokeSessionByTokenHash), ctx, params)
+}
+
+// RevokeSessionsByUserID mocks base method.
+func (m *MockQuerier) RevokeSessionsByUserID(ctx context.Context, params db.RevokeSessionsByUserIDParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeSessionsByUserID", ctx, params)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeSessionsByUserID indicates an expected call of RevokeSessionsByUserID.
+func (mr *MockQuerierMockRecorder) RevokeSessionsByUserID(ctx, params interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeSessionsByUserID", reflect.TypeOf((*MockQuerier)(nil).RevokeSessionsByUserID), ctx, params)
+}
+
+// ScheduleTenantDeletion mocks base method.
+func (m *MockQuerier) ScheduleTenantDeletion(ctx context.Context, params db.ScheduleTenantDeletionParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ScheduleTenantDeletion", ctx, params)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ScheduleTenantDeletion indicates an expected call of ScheduleTenantDeletion.
+func (mr *MockQuerierMockRecorder) ScheduleTenantDeletion(ctx, params interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ScheduleTenantDeletion", reflect.TypeOf((*MockQuerier)(nil).ScheduleTenantDeletion), ctx, params)
+}
+
+// TouchSession mocks base method.
+func (m *MockQuerier) TouchSession(ctx context.Context, params db.TouchSessionParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TouchSession", ctx, params)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// TouchSession indicates an expected call of TouchSession.
+func (mr *MockQuerierMockRecorder) TouchSession(ctx, params interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TouchSession", reflect.TypeOf((*MockQuerier)(nil).TouchSession), ctx, params)
+}
+
+// UpdateAccessTokenLastUsed mocks base method.
+func (m *MockQuerier) UpdateAccessTokenLastUsed(ctx context.Context, params db.UpdateAccessTokenLastUsedParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateAccessTokenLastUsed", ctx, params)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateAccessTokenLastUsed indicates an expected call of UpdateAccessTokenLastUsed.
+func (mr *MockQuerierMockRecorder) UpdateAccessTokenLastUsed(ctx, params interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAccessTokenLastUsed", reflect.TypeOf((*MockQuerier)(nil).UpdateAccessTokenLastUsed), ctx, params)
+}
+
+// UpdateTenant mocks base method.
+func (m *MockQuerier) UpdateTenant(ctx context.Context, params db.UpdateTenantParams) (db.UserserviceTenant, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTenant", ctx, params)
+	ret0, _ := ret[0].(db.UserserviceTenant)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateTenant indicates an expected call of UpdateTenant.
+func (mr *MockQuerierMockRecorder) UpdateTenant(ctx, params interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTenant", reflect.TypeOf((*MockQuerier)(nil).UpdateTenant), ctx, params)
+}
+
+// UpdateTenantCreatedBy mocks base method.
+func (m *MockQuerier) UpdateTenantCreatedBy(ctx context.Context, params db.UpdateTenantCreatedByParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTenantCreatedBy", ctx, params)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateTenantCreatedBy indicates an expected call of UpdateTenantCreatedBy.
+func (mr *MockQuerierMockRecorder) UpdateTenantCreatedBy(ctx, params interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTenantCreatedBy", reflect.TypeOf((*MockQuerier)(nil).UpdateTenantCreatedBy), ctx, params)
+}
+
+// UpdateTenantUserRole mocks base method.
+func (m *MockQuerier) UpdateTenantUserRole(ctx context.Context, params db.UpdateTenantUserRoleParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTenantUserRole", ctx, params)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateTenantUserRole indicates an expected call of UpdateTenantUserRole.
+func (mr *MockQuerierMockRecorder) UpdateTenantUserRole(ctx, params interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTenantUserRole", reflect.TypeOf((*MockQuerier)(nil).UpdateTenantUserRole), ctx, params)
+}
+
+// UpsertLocalCredential mocks base method.
+func (m *MockQuerier) UpsertLocalCredential(ctx context.Context, params db.UpsertLocalCredentialParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertLocalCredential", ctx, params)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpsertLocalCredential indicates an expected call of UpsertLocalCredential.
+func (mr *MockQuerierMockRecorder) UpsertLocalCredential(ctx, params interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertLocalCredential", reflect.TypeOf((*MockQuerier)(nil).UpsertLocalCredential), ctx, params)
+}
@@ -9,6 +9,64 @@ import (
 	"time"
 )
 
+type UserserviceAccessToken struct {
+	ID          string
+	UserID      string
+	TenantID    string
+	Name        string
+	TokenHash   string
+	TokenPrefix string
+	Scopes      string
+	ExpiresAt   time.Time
+	LastUsedAt  sql.NullTime
+	RevokedAt   sql.NullTime
+	CreatedAt   time.Time
+}
+
+type UserserviceInvitation struct {
+	ID         string
+	TenantID   string
+	Email      string
+	Role       string
+	TokenHash  string
+	InvitedBy  string
+	CreatedAt  time.Time
+	ExpiresAt  time.Time
+	AcceptedAt sql.NullTime
+	AcceptedBy sql.NullString
+	DeclinedAt sql.NullTime
+	RevokedAt  sql.NullTime
+}
+
+type UserserviceLocalCredential struct {
+	UserID       string
+	PasswordHash string
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+	TokenVersion int64
+}
+
+type UserservicePasswordReset struct {
+	TokenHash string
+	UserID    string
+	CreatedBy string
+	ExpiresAt time.Time
+	UsedAt    sql.NullTime
+	CreatedAt time.Time
+}
+
+type UserserviceSession struct {
+	ID         string
+	TokenHash  string
+	UserID     string
+	Roles      string
+	UserAgent  string
+	CreatedAt  time.Time
+	LastSeenAt time.Time
+	ExpiresAt  time.Time
+	RevokedAt  sql.NullTime
+}
+
 type UserserviceTenant struct {
 	ID          string
 	Name        string
@@ -16,6 +74,9 @@ type UserserviceTenant struct {
 	IsPersonal  bool
 	CreatedAt   time.Time
 	CreatedBy   string
+	DeletedAt   sql.NullTime
+	DeletedBy   sql.NullString
+	PurgeAfter  sql.NullTime
 }
 
 type UserserviceTenantUser struct {
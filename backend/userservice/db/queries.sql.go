@@ -8,9 +8,247 @@ package db
 import (
 	"context"
 	"database/sql"
+	"strings"
 	"time"
 )
 
+const acceptInvitation = `-- name: AcceptInvitation :execrows
+UPDATE userservice_invitations
+SET accepted_at = ?1,
+    accepted_by = ?2
+WHERE id = ?3 AND accepted_at IS NULL AND declined_at IS NULL AND revoked_at IS NULL
+`
+
+type AcceptInvitationParams struct {
+	AcceptedAt sql.NullTime
+	AcceptedBy sql.NullString
+	ID         string
+}
+
+func (q *Queries) AcceptInvitation(ctx context.Context, arg AcceptInvitationParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, acceptInvitation, arg.AcceptedAt, arg.AcceptedBy, arg.ID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const countAccessTokensByUserID = `-- name: CountAccessTokensByUserID :one
+SELECT COUNT(*) FROM userservice_access_tokens
+WHERE user_id = ?1 AND revoked_at IS NULL
+  AND (expires_at IS NULL OR expires_at > ?2)
+`
+
+type CountAccessTokensByUserIDParams struct {
+	UserID string
+	Now    time.Time
+}
+
+func (q *Queries) CountAccessTokensByUserID(ctx context.Context, arg CountAccessTokensByUserIDParams) (int64, error) {
+	row := q.db.QueryRowContext(ctx, countAccessTokensByUserID, arg.UserID, arg.Now)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const countTenantUsersByRole = `-- name: CountTenantUsersByRole :one
+SELECT COUNT(*) FROM userservice_tenant_users
+WHERE tenant_id = ?1 AND role = ?2
+`
+
+type CountTenantUsersByRoleParams struct {
+	TenantID string
+	Role     string
+}
+
+func (q *Queries) CountTenantUsersByRole(ctx context.Context, arg CountTenantUsersByRoleParams) (int64, error) {
+	row := q.db.QueryRowContext(ctx, countTenantUsersByRole, arg.TenantID, arg.Role)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const createAccessToken = `-- name: CreateAccessToken :exec
+INSERT INTO userservice_access_tokens (
+    id,
+    user_id,
+    tenant_id,
+    name,
+    token_hash,
+    token_prefix,
+    scopes,
+    expires_at,
+    created_at
+) VALUES (
+    ?1,
+    ?2,
+    ?3,
+    ?4,
+    ?5,
+    ?6,
+    ?7,
+    ?8,
+    ?9
+)
+`
+
+type CreateAccessTokenParams struct {
+	ID          string
+	UserID      string
+	TenantID    string
+	Name        string
+	TokenHash   string
+	TokenPrefix string
+	Scopes      string
+	ExpiresAt   time.Time
+	CreatedAt   time.Time
+}
+
+// Personal access tokens
+func (q *Queries) CreateAccessToken(ctx context.Context, arg CreateAccessTokenParams) error {
+	_, err := q.db.ExecContext(ctx, createAccessToken,
+		arg.ID,
+		arg.UserID,
+		arg.TenantID,
+		arg.Name,
+		arg.TokenHash,
+		arg.TokenPrefix,
+		arg.Scopes,
+		arg.ExpiresAt,
+		arg.CreatedAt,
+	)
+	return err
+}
+
+const createInvitation = `-- name: CreateInvitation :exec
+INSERT INTO userservice_invitations (
+    id,
+    tenant_id,
+    email,
+    role,
+    token_hash,
+    invited_by,
+    created_at,
+    expires_at
+) VALUES (
+    ?1,
+    ?2,
+    ?3,
+    ?4,
+    ?5,
+    ?6,
+    ?7,
+    ?8
+)
+`
+
+type CreateInvitationParams struct {
+	ID        string
+	TenantID  string
+	Email     string
+	Role      string
+	TokenHash string
+	InvitedBy string
+	CreatedAt time.Time
+	ExpiresAt time.Time
+}
+
+// Invitation queries
+func (q *Queries) CreateInvitation(ctx context.Context, arg CreateInvitationParams) error {
+	_, err := q.db.ExecContext(ctx, createInvitation,
+		arg.ID,
+		arg.TenantID,
+		arg.Email,
+		arg.Role,
+		arg.TokenHash,
+		arg.InvitedBy,
+		arg.CreatedAt,
+		arg.ExpiresAt,
+	)
+	return err
+}
+
+const createPasswordReset = `-- name: CreatePasswordReset :exec
+INSERT INTO userservice_password_resets (
+    token_hash,
+    user_id,
+    created_by,
+    expires_at,
+    created_at
+) VALUES (
+    ?1,
+    ?2,
+    ?3,
+    ?4,
+    ?5
+)
+`
+
+type CreatePasswordResetParams struct {
+	TokenHash string
+	UserID    string
+	CreatedBy string
+	ExpiresAt time.Time
+	CreatedAt time.Time
+}
+
+func (q *Queries) CreatePasswordReset(ctx context.Context, arg CreatePasswordResetParams) error {
+	_, err := q.db.ExecContext(ctx, createPasswordReset,
+		arg.TokenHash,
+		arg.UserID,
+		arg.CreatedBy,
+		arg.ExpiresAt,
+		arg.CreatedAt,
+	)
+	return err
+}
+
+const createSession = `-- name: CreateSession :exec
+INSERT INTO userservice_sessions (
+    id,
+    token_hash,
+    user_id,
+    roles,
+    user_agent,
+    created_at,
+    last_seen_at,
+    expires_at
+) VALUES (
+    ?1,
+    ?2,
+    ?3,
+    ?4,
+    ?5,
+    ?6,
+    ?6,
+    ?7
+)
+`
+
+type CreateSessionParams struct {
+	ID        string
+	TokenHash string
+	UserID    string
+	Roles     string
+	UserAgent string
+	CreatedAt time.Time
+	ExpiresAt time.Time
+}
+
+// Sessions
+func (q *Queries) CreateSession(ctx context.Context, arg CreateSessionParams) error {
+	_, err := q.db.ExecContext(ctx, createSession,
+		arg.ID,
+		arg.TokenHash,
+		arg.UserID,
+		arg.Roles,
+		arg.UserAgent,
+		arg.CreatedAt,
+		arg.ExpiresAt,
+	)
+	return err
+}
+
 const createTenant = `-- name: CreateTenant :one
 INSERT INTO userservice_tenants (
     id,
@@ -26,7 +264,7 @@ INSERT INTO userservice_tenants (
     ?4,
     ?5,
     ?6
-) RETURNING id, name, description, is_personal, created_at, created_by
+) RETURNING id, name, description, is_personal, created_at, created_by, deleted_at, deleted_by, purge_after
 `
 
 type CreateTenantParams struct {
@@ -55,6 +293,9 @@ func (q *Queries) CreateTenant(ctx context.Context, arg CreateTenantParams) (Use
 		&i.IsPersonal,
 		&i.CreatedAt,
 		&i.CreatedBy,
+		&i.DeletedAt,
+		&i.DeletedBy,
+		&i.PurgeAfter,
 	)
 	return i, err
 }
@@ -140,9 +381,276 @@ func (q *Queries) CreateUser(ctx context.Context, arg CreateUserParams) (Userser
 	return i, err
 }
 
+const declineInvitation = `-- name: DeclineInvitation :execrows
+UPDATE userservice_invitations
+SET declined_at = ?1
+WHERE id = ?2 AND accepted_at IS NULL AND declined_at IS NULL AND revoked_at IS NULL
+`
+
+type DeclineInvitationParams struct {
+	DeclinedAt sql.NullTime
+	ID         string
+}
+
+func (q *Queries) DeclineInvitation(ctx context.Context, arg DeclineInvitationParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, declineInvitation, arg.DeclinedAt, arg.ID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const deleteAccessTokensByTenantID = `-- name: DeleteAccessTokensByTenantID :exec
+DELETE FROM userservice_access_tokens
+WHERE tenant_id = ?1
+`
+
+func (q *Queries) DeleteAccessTokensByTenantID(ctx context.Context, tenantID string) error {
+	_, err := q.db.ExecContext(ctx, deleteAccessTokensByTenantID, tenantID)
+	return err
+}
+
+const deleteInvitationsByTenantID = `-- name: DeleteInvitationsByTenantID :exec
+DELETE FROM userservice_invitations
+WHERE tenant_id = ?1
+`
+
+func (q *Queries) DeleteInvitationsByTenantID(ctx context.Context, tenantID string) error {
+	_, err := q.db.ExecContext(ctx, deleteInvitationsByTenantID, tenantID)
+	return err
+}
+
+const deleteStaleSessions = `-- name: DeleteStaleSessions :execrows
+DELETE FROM userservice_sessions
+WHERE revoked_at IS NOT NULL OR expires_at < ?1
+`
+
+// Revoked and expired sessions can't be used anymore
+func (q *Queries) DeleteStaleSessions(ctx context.Context, now time.Time) (int64, error) {
+	result, err := q.db.ExecContext(ctx, deleteStaleSessions, now)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const deleteTenant = `-- name: DeleteTenant :exec
+DELETE FROM userservice_tenants
+WHERE id = ?1
+`
+
+func (q *Queries) DeleteTenant(ctx context.Context, id string) error {
+	_, err := q.db.ExecContext(ctx, deleteTenant, id)
+	return err
+}
+
+const deleteTenantUser = `-- name: DeleteTenantUser :execrows
+DELETE FROM userservice_tenant_users
+WHERE userservice_tenant_users.tenant_id = ?1
+  AND userservice_tenant_users.user_id = ?2
+  AND (userservice_tenant_users.role <> 'super_admin' OR (
+    SELECT COUNT(*) FROM userservice_tenant_users admins
+    WHERE admins.tenant_id = userservice_tenant_users.tenant_id AND admins.role = 'super_admin'
+  ) > 1)
+`
+
+type DeleteTenantUserParams struct {
+	TenantID string
+	UserID   string
+}
+
+func (q *Queries) DeleteTenantUser(ctx context.Context, arg DeleteTenantUserParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, deleteTenantUser, arg.TenantID, arg.UserID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const deleteTenantUsersByTenantID = `-- name: DeleteTenantUsersByTenantID :exec
+DELETE FROM userservice_tenant_users
+WHERE tenant_id = ?1
+`
+
+func (q *Queries) DeleteTenantUsersByTenantID(ctx context.Context, tenantID string) error {
+	_, err := q.db.ExecContext(ctx, deleteTenantUsersByTenantID, tenantID)
+	return err
+}
+
+const getAccessTokenByHash = `-- name: GetAccessTokenByHash :one
+SELECT
+    t.id, t.user_id, t.tenant_id, t.name, t.token_hash, t.token_prefix, t.scopes, t.expires_at, t.last_used_at, t.revoked_at, t.created_at,
+    u.username,
+    u.email
+FROM userservice_access_tokens t
+JOIN userservice_users u ON t.user_id = u.id
+JOIN userservice_tenant_users tu ON tu.tenant_id = t.tenant_id AND tu.user_id = t.user_id
+WHERE t.token_hash = ?1 AND t.revoked_at IS NULL
+`
+
+type GetAccessTokenByHashRow struct {
+	UserserviceAccessToken UserserviceAccessToken
+	Username               string
+	Email                  string
+}
+
+// The token stops working when its user leaves the tenant, so the membership is part of the lookup
+func (q *Queries) GetAccessTokenByHash(ctx context.Context, tokenHash string) (GetAccessTokenByHashRow, error) {
+	row := q.db.QueryRowContext(ctx, getAccessTokenByHash, tokenHash)
+	var i GetAccessTokenByHashRow
+	err := row.Scan(
+		&i.UserserviceAccessToken.ID,
+		&i.UserserviceAccessToken.UserID,
+		&i.UserserviceAccessToken.TenantID,
+		&i.UserserviceAccessToken.Name,
+		&i.UserserviceAccessToken.TokenHash,
+		&i.UserserviceAccessToken.TokenPrefix,
+		&i.UserserviceAccessToken.Scopes,
+		&i.UserserviceAccessToken.ExpiresAt,
+		&i.UserserviceAccessToken.LastUsedAt,
+		&i.UserserviceAccessToken.RevokedAt,
+		&i.UserserviceAccessToken.CreatedAt,
+		&i.Username,
+		&i.Email,
+	)
+	return i, err
+}
+
+const getInvitationByTokenHash = `-- name: GetInvitationByTokenHash :one
+SELECT
+    i.id, i.tenant_id, i.email, i.role, i.token_hash, i.invited_by, i.created_at, i.expires_at, i.accepted_at, i.accepted_by, i.declined_at, i.revoked_at,
+    t.name AS tenant_name
+FROM userservice_invitations i
+JOIN userservice_tenants t ON i.tenant_id = t.id
+WHERE i.token_hash = ?1
+  AND i.accepted_at IS NULL AND i.declined_at IS NULL AND i.revoked_at IS NULL
+`
+
+type GetInvitationByTokenHashRow struct {
+	UserserviceInvitation UserserviceInvitation
+	TenantName            string
+}
+
+// Pending invitations, expired ones are filtered by the caller
+func (q *Queries) GetInvitationByTokenHash(ctx context.Context, tokenHash string) (GetInvitationByTokenHashRow, error) {
+	row := q.db.QueryRowContext(ctx, getInvitationByTokenHash, tokenHash)
+	var i GetInvitationByTokenHashRow
+	err := row.Scan(
+		&i.UserserviceInvitation.ID,
+		&i.UserserviceInvitation.TenantID,
+		&i.UserserviceInvitation.Email,
+		&i.UserserviceInvitation.Role,
+		&i.UserserviceInvitation.TokenHash,
+		&i.UserserviceInvitation.InvitedBy,
+		&i.UserserviceInvitation.CreatedAt,
+		&i.UserserviceInvitation.ExpiresAt,
+		&i.UserserviceInvitation.AcceptedAt,
+		&i.UserserviceInvitation.AcceptedBy,
+		&i.UserserviceInvitation.DeclinedAt,
+		&i.UserserviceInvitation.RevokedAt,
+		&i.TenantName,
+	)
+	return i, err
+}
+
+const getLocalCredentialByUserID = `-- name: GetLocalCredentialByUserID :one
+SELECT user_id, password_hash, created_at, updated_at, token_version FROM userservice_local_credentials
+WHERE user_id = ?1
+`
+
+func (q *Queries) GetLocalCredentialByUserID(ctx context.Context, userID string) (UserserviceLocalCredential, error) {
+	row := q.db.QueryRowContext(ctx, getLocalCredentialByUserID, userID)
+	var i UserserviceLocalCredential
+	err := row.Scan(
+		&i.UserID,
+		&i.PasswordHash,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.TokenVersion,
+	)
+	return i, err
+}
+
+const getPasswordResetByTokenHash = `-- name: GetPasswordResetByTokenHash :one
+SELECT token_hash, user_id, created_by, expires_at, used_at, created_at FROM userservice_password_resets
+WHERE token_hash = ?1
+`
+
+func (q *Queries) GetPasswordResetByTokenHash(ctx context.Context, tokenHash string) (UserservicePasswordReset, error) {
+	row := q.db.QueryRowContext(ctx, getPasswordResetByTokenHash, tokenHash)
+	var i UserservicePasswordReset
+	err := row.Scan(
+		&i.TokenHash,
+		&i.UserID,
+		&i.CreatedBy,
+		&i.ExpiresAt,
+		&i.UsedAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getSessionByTokenHash = `-- name: GetSessionByTokenHash :one
+SELECT
+    s.id, s.token_hash, s.user_id, s.roles, s.user_agent, s.created_at, s.last_seen_at, s.expires_at, s.revoked_at,
+    u.username,
+    u.email
+FROM userservice_sessions s
+JOIN userservice_users u ON s.user_id = u.id
+WHERE s.token_hash = ?1 AND s.revoked_at IS NULL
+`
+
+type GetSessionByTokenHashRow struct {
+	UserserviceSession UserserviceSession
+	Username           string
+	Email              string
+}
+
+func (q *Queries) GetSessionByTokenHash(ctx context.Context, tokenHash string) (GetSessionByTokenHashRow, error) {
+	row := q.db.QueryRowContext(ctx, getSessionByTokenHash, tokenHash)
+	var i GetSessionByTokenHashRow
+	err := row.Scan(
+		&i.UserserviceSession.ID,
+		&i.UserserviceSession.TokenHash,
+		&i.UserserviceSession.UserID,
+		&i.UserserviceSession.Roles,
+		&i.UserserviceSession.UserAgent,
+		&i.UserserviceSession.CreatedAt,
+		&i.UserserviceSession.LastSeenAt,
+		&i.UserserviceSession.ExpiresAt,
+		&i.UserserviceSession.RevokedAt,
+		&i.Username,
+		&i.Email,
+	)
+	return i, err
+}
+
+const getTenantByID = `-- name: GetTenantByID :one
+SELECT id, name, description, is_personal, created_at, created_by, deleted_at, deleted_by, purge_after FROM userservice_tenants
+WHERE id = ?1
+`
+
+// Tenant queries
+func (q *Queries) GetTenantByID(ctx context.Context, id string) (UserserviceTenant, error) {
+	row := q.db.QueryRowContext(ctx, getTenantByID, id)
+	var i UserserviceTenant
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.Description,
+		&i.IsPersonal,
+		&i.CreatedAt,
+		&i.CreatedBy,
+		&i.DeletedAt,
+		&i.DeletedBy,
+		&i.PurgeAfter,
+	)
+	return i, err
+}
+
 const getTenantByName = `-- name: GetTenantByName :one
-SELECT id, name, description, is_personal, created_at, created_by FROM userservice_tenants 
-WHERE name = ?1 AND created_by = ?2
+SELECT id, name, description, is_personal, created_at, created_by, deleted_at, deleted_by, purge_after FROM userservice_tenants 
+WHERE name = ?1 AND created_by = ?2 AND deleted_at IS NULL
 `
 
 type GetTenantByNameParams struct {
@@ -150,7 +658,6 @@ type GetTenantByNameParams struct {
 	CreatedBy string
 }
 
-// Tenant queries
 func (q *Queries) GetTenantByName(ctx context.Context, arg GetTenantByNameParams) (UserserviceTenant, error) {
 	row := q.db.QueryRowContext(ctx, getTenantByName, arg.Name, arg.CreatedBy)
 	var i UserserviceTenant
@@ -161,6 +668,9 @@ func (q *Queries) GetTenantByName(ctx context.Context, arg GetTenantByNameParams
 		&i.IsPersonal,
 		&i.CreatedAt,
 		&i.CreatedBy,
+		&i.DeletedAt,
+		&i.DeletedBy,
+		&i.PurgeAfter,
 	)
 	return i, err
 }
@@ -236,9 +746,28 @@ func (q *Queries) GetUserByEmail(ctx context.Context, email string) (Userservice
 	return i, err
 }
 
+const getUserRoleInDeletedTenant = `-- name: GetUserRoleInDeletedTenant :one
+SELECT tu.role FROM userservice_tenant_users tu
+JOIN userservice_tenants t ON t.id = tu.tenant_id
+WHERE tu.tenant_id = ?1 AND tu.user_id = ?2 AND t.deleted_at IS NOT NULL
+`
+
+type GetUserRoleInDeletedTenantParams struct {
+	TenantID string
+	UserID   string
+}
+
+func (q *Queries) GetUserRoleInDeletedTenant(ctx context.Context, arg GetUserRoleInDeletedTenantParams) (string, error) {
+	row := q.db.QueryRowContext(ctx, getUserRoleInDeletedTenant, arg.TenantID, arg.UserID)
+	var role string
+	err := row.Scan(&role)
+	return role, err
+}
+
 const getUserRoleInTenant = `-- name: GetUserRoleInTenant :one
-SELECT role FROM userservice_tenant_users 
-WHERE tenant_id = ?1 AND user_id = ?2
+SELECT tu.role FROM userservice_tenant_users tu
+JOIN userservice_tenants t ON t.id = tu.tenant_id
+WHERE tu.tenant_id = ?1 AND tu.user_id = ?2 AND t.deleted_at IS NULL
 `
 
 type GetUserRoleInTenantParams struct {
@@ -246,6 +775,7 @@ type GetUserRoleInTenantParams struct {
 	UserID   string
 }
 
+// Tenants scheduled for deletion have no members as far as permission checks go
 func (q *Queries) GetUserRoleInTenant(ctx context.Context, arg GetUserRoleInTenantParams) (string, error) {
 	row := q.db.QueryRowContext(ctx, getUserRoleInTenant, arg.TenantID, arg.UserID)
 	var role string
@@ -262,6 +792,7 @@ SELECT
     t.is_personal, 
     t.created_at, 
     t.created_by,
+    t.purge_after,
     tu.role, 
     tu.created_at as joined_at
 FROM userservice_tenants t
@@ -278,6 +809,7 @@ type GetUserTenantsRow struct {
 	IsPersonal   bool
 	CreatedAt    time.Time
 	CreatedBy    string
+	PurgeAfter   sql.NullTime
 	Role         string
 	JoinedAt     time.Time
 }
@@ -299,6 +831,7 @@ func (q *Queries) GetUserTenants(ctx context.Context, userID string) ([]GetUserT
 			&i.IsPersonal,
 			&i.CreatedAt,
 			&i.CreatedBy,
+			&i.PurgeAfter,
 			&i.Role,
 			&i.JoinedAt,
 		); err != nil {
@@ -314,3 +847,584 @@ func (q *Queries) GetUserTenants(ctx context.Context, userID string) ([]GetUserT
 	}
 	return items, nil
 }
+
+const getUsersByIDs = `-- name: GetUsersByIDs :many
+SELECT id, username, email, created_at FROM userservice_users
+WHERE id IN (/*SLICE:ids*/?)
+`
+
+func (q *Queries) GetUsersByIDs(ctx context.Context, ids []string) ([]UserserviceUser, error) {
+	query := getUsersByIDs
+	var queryParams []interface{}
+	if len(ids) > 0 {
+		for _, v := range ids {
+			queryParams = append(queryParams, v)
+		}
+		query = strings.Replace(query, "/*SLICE:ids*/?", strings.Repeat(",?", len(ids))[1:], 1)
+	} else {
+		query = strings.Replace(query, "/*SLICE:ids*/?", "NULL", 1)
+	}
+	rows, err := q.db.QueryContext(ctx, query, queryParams...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []UserserviceUser
+	for rows.Next() {
+		var i UserserviceUser
+		if err := rows.Scan(
+			&i.ID,
+			&i.Username,
+			&i.Email,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listAccessTokensByUserID = `-- name: ListAccessTokensByUserID :many
+SELECT id, user_id, tenant_id, name, token_hash, token_prefix, scopes, expires_at, last_used_at, revoked_at, created_at FROM userservice_access_tokens
+WHERE user_id = ?1 AND revoked_at IS NULL
+ORDER BY created_at DESC
+`
+
+func (q *Queries) ListAccessTokensByUserID(ctx context.Context, userID string) ([]UserserviceAccessToken, error) {
+	rows, err := q.db.QueryContext(ctx, listAccessTokensByUserID, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []UserserviceAccessToken
+	for rows.Next() {
+		var i UserserviceAccessToken
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.TenantID,
+			&i.Name,
+			&i.TokenHash,
+			&i.TokenPrefix,
+			&i.Scopes,
+			&i.ExpiresAt,
+			&i.LastUsedAt,
+			&i.RevokedAt,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listInvitationsByEmail = `-- name: ListInvitationsByEmail :many
+SELECT id, tenant_id, email, role, token_hash, invited_by, created_at, expires_at, accepted_at, accepted_by, declined_at, revoked_at FROM userservice_invitations
+WHERE email = ?1
+  AND accepted_at IS NULL AND declined_at IS NULL AND revoked_at IS NULL
+ORDER BY created_at
+`
+
+func (q *Queries) ListInvitationsByEmail(ctx context.Context, email string) ([]UserserviceInvitation, error) {
+	rows, err := q.db.QueryContext(ctx, listInvitationsByEmail, email)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []UserserviceInvitation
+	for rows.Next() {
+		var i UserserviceInvitation
+		if err := rows.Scan(
+			&i.ID,
+			&i.TenantID,
+			&i.Email,
+			&i.Role,
+			&i.TokenHash,
+			&i.InvitedBy,
+			&i.CreatedAt,
+			&i.ExpiresAt,
+			&i.AcceptedAt,
+			&i.AcceptedBy,
+			&i.DeclinedAt,
+			&i.RevokedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listInvitationsByTenantID = `-- name: ListInvitationsByTenantID :many
+SELECT id, tenant_id, email, role, token_hash, invited_by, created_at, expires_at, accepted_at, accepted_by, declined_at, revoked_at FROM userservice_invitations
+WHERE tenant_id = ?1
+  AND accepted_at IS NULL AND declined_at IS NULL AND revoked_at IS NULL
+ORDER BY created_at DESC
+`
+
+func (q *Queries) ListInvitationsByTenantID(ctx context.Context, tenantID string) ([]UserserviceInvitation, error) {
+	rows, err := q.db.QueryContext(ctx, listInvitationsByTenantID, tenantID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []UserserviceInvitation
+	for rows.Next() {
+		var i UserserviceInvitation
+		if err := rows.Scan(
+			&i.ID,
+			&i.TenantID,
+			&i.Email,
+			&i.Role,
+			&i.TokenHash,
+			&i.InvitedBy,
+			&i.CreatedAt,
+			&i.ExpiresAt,
+			&i.AcceptedAt,
+			&i.AcceptedBy,
+			&i.DeclinedAt,
+			&i.RevokedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listSessionsByUserID = `-- name: ListSessionsByUserID :many
+SELECT id, token_hash, user_id, roles, user_agent, created_at, last_seen_at, expires_at, revoked_at FROM userservice_sessions
+WHERE user_id = ?1 AND revoked_at IS NULL
+ORDER BY created_at DESC
+`
+
+// Expired sessions are filtered by the caller
+func (q *Queries) ListSessionsByUserID(ctx context.Context, userID string) ([]UserserviceSession, error) {
+	rows, err := q.db.QueryContext(ctx, listSessionsByUserID, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []UserserviceSession
+	for rows.Next() {
+		var i UserserviceSession
+		if err := rows.Scan(
+			&i.ID,
+			&i.TokenHash,
+			&i.UserID,
+			&i.Roles,
+			&i.UserAgent,
+			&i.CreatedAt,
+			&i.LastSeenAt,
+			&i.ExpiresAt,
+			&i.RevokedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listTenantsPendingDeletion = `-- name: ListTenantsPendingDeletion :many
+SELECT id, name, description, is_personal, created_at, created_by, deleted_at, deleted_by, purge_after FROM userservice_tenants
+WHERE deleted_at IS NOT NULL
+ORDER BY purge_after ASC
+`
+
+// purge_after is compared in Go, sqlite stores the timestamps as text
+func (q *Queries) ListTenantsPendingDeletion(ctx context.Context) ([]UserserviceTenant, error) {
+	rows, err := q.db.QueryContext(ctx, listTenantsPendingDeletion)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []UserserviceTenant
+	for rows.Next() {
+		var i UserserviceTenant
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.Description,
+			&i.IsPersonal,
+			&i.CreatedAt,
+			&i.CreatedBy,
+			&i.DeletedAt,
+			&i.DeletedBy,
+			&i.PurgeAfter,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const markPasswordResetUsed = `-- name: MarkPasswordResetUsed :execrows
+UPDATE userservice_password_resets
+SET used_at = ?1
+WHERE token_hash = ?2 AND used_at IS NULL
+`
+
+type MarkPasswordResetUsedParams struct {
+	UsedAt    sql.NullTime
+	TokenHash string
+}
+
+// Only succeeds once per token, so two concurrent resets can't both use it
+func (q *Queries) MarkPasswordResetUsed(ctx context.Context, arg MarkPasswordResetUsedParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, markPasswordResetUsed, arg.UsedAt, arg.TokenHash)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const restoreTenant = `-- name: RestoreTenant :execrows
+UPDATE userservice_tenants
+SET deleted_at = NULL,
+    deleted_by = NULL,
+    purge_after = NULL
+WHERE id = ?1 AND deleted_at IS NOT NULL
+`
+
+func (q *Queries) RestoreTenant(ctx context.Context, id string) (int64, error) {
+	result, err := q.db.ExecContext(ctx, restoreTenant, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const revokeAccessToken = `-- name: RevokeAccessToken :execrows
+UPDATE userservice_access_tokens
+SET revoked_at = ?1
+WHERE id = ?2 AND user_id = ?3 AND revoked_at IS NULL
+`
+
+type RevokeAccessTokenParams struct {
+	RevokedAt sql.NullTime
+	ID        string
+	UserID    string
+}
+
+func (q *Queries) RevokeAccessToken(ctx context.Context, arg RevokeAccessTokenParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, revokeAccessToken, arg.RevokedAt, arg.ID, arg.UserID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const revokeInvitation = `-- name: RevokeInvitation :execrows
+UPDATE userservice_invitations
+SET revoked_at = ?1
+WHERE id = ?2 AND tenant_id = ?3
+  AND accepted_at IS NULL AND declined_at IS NULL AND revoked_at IS NULL
+`
+
+type RevokeInvitationParams struct {
+	RevokedAt sql.NullTime
+	ID        string
+	TenantID  string
+}
+
+func (q *Queries) RevokeInvitation(ctx context.Context, arg RevokeInvitationParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, revokeInvitation, arg.RevokedAt, arg.ID, arg.TenantID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const revokeInvitationsByTenantAndEmail = `-- name: RevokeInvitationsByTenantAndEmail :exec
+UPDATE userservice_invitations
+SET revoked_at = ?1
+WHERE tenant_id = ?2 AND email = ?3
+  AND accepted_at IS NULL AND declined_at IS NULL AND revoked_at IS NULL
+`
+
+type RevokeInvitationsByTenantAndEmailParams struct {
+	RevokedAt sql.NullTime
+	TenantID  string
+	Email     string
+}
+
+// A new invitation to the same address replaces the pending ones
+func (q *Queries) RevokeInvitationsByTenantAndEmail(ctx context.Context, arg RevokeInvitationsByTenantAndEmailParams) error {
+	_, err := q.db.ExecContext(ctx, revokeInvitationsByTenantAndEmail, arg.RevokedAt, arg.TenantID, arg.Email)
+	return err
+}
+
+const revokeInvitationsByTenantID = `-- name: RevokeInvitationsByTenantID :exec
+UPDATE userservice_invitations
+SET revoked_at = ?1
+WHERE tenant_id = ?2
+  AND accepted_at IS NULL AND declined_at IS NULL AND revoked_at IS NULL
+`
+
+type RevokeInvitationsByTenantIDParams struct {
+	RevokedAt sql.NullTime
+	TenantID  string
+}
+
+func (q *Queries) RevokeInvitationsByTenantID(ctx context.Context, arg RevokeInvitationsByTenantIDParams) error {
+	_, err := q.db.ExecContext(ctx, revokeInvitationsByTenantID, arg.RevokedAt, arg.TenantID)
+	return err
+}
+
+const revokeSession = `-- name: RevokeSession :execrows
+UPDATE userservice_sessions
+SET revoked_at = ?1
+WHERE id = ?2 AND user_id = ?3 AND revoked_at IS NULL
+`
+
+type RevokeSessionParams struct {
+	RevokedAt sql.NullTime
+	ID        string
+	UserID    string
+}
+
+func (q *Queries) RevokeSession(ctx context.Context, arg RevokeSessionParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, revokeSession, arg.RevokedAt, arg.ID, arg.UserID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const revokeSessionByTokenHash = `-- name: RevokeSessionByTokenHash :exec
+UPDATE userservice_sessions
+SET revoked_at = ?1
+WHERE token_hash = ?2 AND revoked_at IS NULL
+`
+
+type RevokeSessionByTokenHashParams struct {
+	RevokedAt sql.NullTime
+	TokenHash string
+}
+
+func (q *Queries) RevokeSessionByTokenHash(ctx context.Context, arg RevokeSessionByTokenHashParams) error {
+	_, err := q.db.ExecContext(ctx, revokeSessionByTokenHash, arg.RevokedAt, arg.TokenHash)
+	return err
+}
+
+const revokeSessionsByUserID = `-- name: RevokeSessionsByUserID :exec
+UPDATE userservice_sessions
+SET revoked_at = ?1
+WHERE user_id = ?2 AND revoked_at IS NULL
+`
+
+type RevokeSessionsByUserIDParams struct {
+	RevokedAt sql.NullTime
+	UserID    string
+}
+
+func (q *Queries) RevokeSessionsByUserID(ctx context.Context, arg RevokeSessionsByUserIDParams) error {
+	_, err := q.db.ExecContext(ctx, revokeSessionsByUserID, arg.RevokedAt, arg.UserID)
+	return err
+}
+
+const scheduleTenantDeletion = `-- name: ScheduleTenantDeletion :execrows
+UPDATE userservice_tenants
+SET deleted_at = ?1,
+    deleted_by = ?2,
+    purge_after = ?3
+WHERE id = ?4 AND deleted_at IS NULL
+`
+
+type ScheduleTenantDeletionParams struct {
+	DeletedAt  sql.NullTime
+	DeletedBy  sql.NullString
+	PurgeAfter sql.NullTime
+	ID         string
+}
+
+func (q *Queries) ScheduleTenantDeletion(ctx context.Context, arg ScheduleTenantDeletionParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, scheduleTenantDeletion,
+		arg.DeletedAt,
+		arg.DeletedBy,
+		arg.PurgeAfter,
+		arg.ID,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const touchSession = `-- name: TouchSession :exec
+UPDATE userservice_sessions
+SET last_seen_at = ?1,
+    expires_at = ?2
+WHERE id = ?3
+`
+
+type TouchSessionParams struct {
+	LastSeenAt time.Time
+	ExpiresAt  time.Time
+	ID         string
+}
+
+func (q *Queries) TouchSession(ctx context.Context, arg TouchSessionParams) error {
+	_, err := q.db.ExecContext(ctx, touchSession, arg.LastSeenAt, arg.ExpiresAt, arg.ID)
+	return err
+}
+
+const updateAccessTokenLastUsed = `-- name: UpdateAccessTokenLastUsed :exec
+UPDATE userservice_access_tokens
+SET last_used_at = ?1
+WHERE id = ?2
+`
+
+type UpdateAccessTokenLastUsedParams struct {
+	LastUsedAt sql.NullTime
+	ID         string
+}
+
+func (q *Queries) UpdateAccessTokenLastUsed(ctx context.Context, arg UpdateAccessTokenLastUsedParams) error {
+	_, err := q.db.ExecContext(ctx, updateAccessTokenLastUsed, arg.LastUsedAt, arg.ID)
+	return err
+}
+
+const updateTenant = `-- name: UpdateTenant :one
+UPDATE userservice_tenants
+SET name = ?1,
+    description = ?2
+WHERE id = ?3 AND deleted_at IS NULL
+RETURNING id, name, description, is_personal, created_at, created_by, deleted_at, deleted_by, purge_after
+`
+
+type UpdateTenantParams struct {
+	Name        string
+	Description sql.NullString
+	ID          string
+}
+
+func (q *Queries) UpdateTenant(ctx context.Context, arg UpdateTenantParams) (UserserviceTenant, error) {
+	row := q.db.QueryRowContext(ctx, updateTenant, arg.Name, arg.Description, arg.ID)
+	var i UserserviceTenant
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.Description,
+		&i.IsPersonal,
+		&i.CreatedAt,
+		&i.CreatedBy,
+		&i.DeletedAt,
+		&i.DeletedBy,
+		&i.PurgeAfter,
+	)
+	return i, err
+}
+
+const updateTenantCreatedBy = `-- name: UpdateTenantCreatedBy :exec
+UPDATE userservice_tenants
+SET created_by = ?1
+WHERE id = ?2
+`
+
+type UpdateTenantCreatedByParams struct {
+	CreatedBy string
+	ID        string
+}
+
+func (q *Queries) UpdateTenantCreatedBy(ctx context.Context, arg UpdateTenantCreatedByParams) error {
+	_, err := q.db.ExecContext(ctx, updateTenantCreatedBy, arg.CreatedBy, arg.ID)
+	return err
+}
+
+const updateTenantUserRole = `-- name: UpdateTenantUserRole :execrows
+UPDATE userservice_tenant_users
+SET role = ?1
+WHERE userservice_tenant_users.tenant_id = ?2
+  AND userservice_tenant_users.user_id = ?3
+  AND (userservice_tenant_users.role <> 'super_admin' OR (
+    SELECT COUNT(*) FROM userservice_tenant_users admins
+    WHERE admins.tenant_id = userservice_tenant_users.tenant_id AND admins.role = 'super_admin'
+  ) > 1)
+`
+
+type UpdateTenantUserRoleParams struct {
+	Role     string
+	TenantID string
+	UserID   string
+}
+
+// A super_admin is only demoted or removed while the tenant has another one. The check is part of
+// the statement, so two super_admins demoting each other at the same time can't both succeed.
+func (q *Queries) UpdateTenantUserRole(ctx context.Context, arg UpdateTenantUserRoleParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, updateTenantUserRole, arg.Role, arg.TenantID, arg.UserID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const upsertLocalCredential = `-- name: UpsertLocalCredential :exec
+INSERT INTO userservice_local_credentials (
+    user_id,
+    password_hash,
+    created_at,
+    updated_at
+) VALUES (
+    ?1,
+    ?2,
+    ?3,
+    ?3
+)
+ON CONFLICT (user_id) DO UPDATE SET
+    password_hash = excluded.password_hash,
+    updated_at = excluded.updated_at,
+    token_version = userservice_local_credentials.token_version + 1
+`
+
+type UpsertLocalCredentialParams struct {
+	UserID       string
+	PasswordHash string
+	UpdatedAt    time.Time
+}
+
+// Local accounts
+func (q *Queries) UpsertLocalCredential(ctx context.Context, arg UpsertLocalCredentialParams) error {
+	_, err := q.db.ExecContext(ctx, upsertLocalCredential, arg.UserID, arg.PasswordHash, arg.UpdatedAt)
+	return err
+}
@@ -1,16 +1,62 @@
 package db
 
-import "context"
+import (
+	"context"
+	"time"
+)
 
 type Querier interface {
 	GetUserByEmail(ctx context.Context, email string) (UserserviceUser, error)
+	GetUsersByIDs(ctx context.Context, ids []string) ([]UserserviceUser, error)
 	CreateUser(ctx context.Context, params CreateUserParams) (UserserviceUser, error)
 	GetUserTenants(ctx context.Context, userID string) ([]GetUserTenantsRow, error)
 	GetTenantUsers(ctx context.Context, tenantID string) ([]GetTenantUsersRow, error)
+	GetTenantByID(ctx context.Context, id string) (UserserviceTenant, error)
 	GetTenantByName(ctx context.Context, params GetTenantByNameParams) (UserserviceTenant, error)
 	CreateTenant(ctx context.Context, params CreateTenantParams) (UserserviceTenant, error)
 	CreateTenantUser(ctx context.Context, params CreateTenantUserParams) (UserserviceTenantUser, error)
 	GetUserRoleInTenant(ctx context.Context, params GetUserRoleInTenantParams) (string, error)
+	CountTenantUsersByRole(ctx context.Context, params CountTenantUsersByRoleParams) (int64, error)
+	UpdateTenantUserRole(ctx context.Context, params UpdateTenantUserRoleParams) (int64, error)
+	DeleteTenantUser(ctx context.Context, params DeleteTenantUserParams) (int64, error)
+	UpdateTenantCreatedBy(ctx context.Context, params UpdateTenantCreatedByParams) error
+	UpdateTenant(ctx context.Context, params UpdateTenantParams) (UserserviceTenant, error)
+	GetUserRoleInDeletedTenant(ctx context.Context, params GetUserRoleInDeletedTenantParams) (string, error)
+	ScheduleTenantDeletion(ctx context.Context, params ScheduleTenantDeletionParams) (int64, error)
+	RestoreTenant(ctx context.Context, id string) (int64, error)
+	ListTenantsPendingDeletion(ctx context.Context) ([]UserserviceTenant, error)
+	DeleteTenantUsersByTenantID(ctx context.Context, tenantID string) error
+	DeleteAccessTokensByTenantID(ctx context.Context, tenantID string) error
+	DeleteInvitationsByTenantID(ctx context.Context, tenantID string) error
+	DeleteTenant(ctx context.Context, id string) error
+	UpsertLocalCredential(ctx context.Context, params UpsertLocalCredentialParams) error
+	GetLocalCredentialByUserID(ctx context.Context, userID string) (UserserviceLocalCredential, error)
+	CreatePasswordReset(ctx context.Context, params CreatePasswordResetParams) error
+	GetPasswordResetByTokenHash(ctx context.Context, tokenHash string) (UserservicePasswordReset, error)
+	MarkPasswordResetUsed(ctx context.Context, params MarkPasswordResetUsedParams) (int64, error)
+	CreateAccessToken(ctx context.Context, params CreateAccessTokenParams) error
+	GetAccessTokenByHash(ctx context.Context, tokenHash string) (GetAccessTokenByHashRow, error)
+	ListAccessTokensByUserID(ctx context.Context, userID string) ([]UserserviceAccessToken, error)
+	CountAccessTokensByUserID(ctx context.Context, params CountAccessTokensByUserIDParams) (int64, error)
+	RevokeAccessToken(ctx context.Context, params RevokeAccessTokenParams) (int64, error)
+	UpdateAccessTokenLastUsed(ctx context.Context, params UpdateAccessTokenLastUsedParams) error
+	CreateSession(ctx context.Context, params CreateSessionParams) error
+	GetSessionByTokenHash(ctx context.Context, tokenHash string) (GetSessionByTokenHashRow, error)
+	TouchSession(ctx context.Context, params TouchSessionParams) error
+	ListSessionsByUserID(ctx context.Context, userID string) ([]UserserviceSession, error)
+	RevokeSession(ctx context.Context, params RevokeSessionParams) (int64, error)
+	RevokeSessionByTokenHash(ctx context.Context, params RevokeSessionByTokenHashParams) error
+	RevokeSessionsByUserID(ctx context.Context, params RevokeSessionsByUserIDParams) error
+	DeleteStaleSessions(ctx context.Context, now time.Time) (int64, error)
+	CreateInvitation(ctx context.Context, params CreateInvitationParams) error
+	GetInvitationByTokenHash(ctx context.Context, tokenHash string) (GetInvitationByTokenHashRow, error)
+	ListInvitationsByTenantID(ctx context.Context, tenantID string) ([]UserserviceInvitation, error)
+	ListInvitationsByEmail(ctx context.Context, email string) ([]UserserviceInvitation, error)
+	AcceptInvitation(ctx context.Context, params AcceptInvitationParams) (int64, error)
+	DeclineInvitation(ctx context.Context, params DeclineInvitationParams) (int64, error)
+	RevokeInvitationsByTenantAndEmail(ctx context.Context, params RevokeInvitationsByTenantAndEmailParams) error
+	RevokeInvitationsByTenantID(ctx context.Context, params RevokeInvitationsByTenantIDParams) error
+	RevokeInvitation(ctx context.Context, params RevokeInvitationParams) (int64, error)
 }
 
 var _ Querier = (*Queries)(nil)
@@ -16,9 +16,13 @@ SELECT * FROM userservice_users
 WHERE email = @email;
 
 -- Tenant queries
+-- name: GetTenantByID :one
+SELECT * FROM userservice_tenants
+WHERE id = @id;
+
 -- name: GetTenantByName :one
 SELECT * FROM userservice_tenants 
-WHERE name = @name AND created_by = @created_by;
+WHERE name = @name AND created_by = @created_by AND deleted_at IS NULL;
 
 -- name: CreateTenant :one
 INSERT INTO userservice_tenants (
@@ -35,7 +39,7 @@ INSERT INTO userservice_tenants (
     @is_personal,
     @created_at,
     @created_by
-) RETURNING id, name, description, is_personal, created_at, created_by;
+) RETURNING *;
 
 -- name: GetUserTenants :many
 SELECT 
@@ -46,6 +50,7 @@ SELECT
     t.is_personal, 
     t.created_at, 
     t.created_by,
+    t.purge_after,
     tu.role, 
     tu.created_at as joined_at
 FROM userservice_tenants t
@@ -80,6 +85,329 @@ JOIN userservice_tenants t ON tu.tenant_id = t.id
 WHERE tu.tenant_id = @tenant_id
 ORDER BY tu.created_at ASC;
 
+-- Tenants scheduled for deletion have no members as far as permission checks go
 -- name: GetUserRoleInTenant :one
-SELECT role FROM userservice_tenant_users 
-WHERE tenant_id = @tenant_id AND user_id = @user_id;
\ No newline at end of file
+SELECT tu.role FROM userservice_tenant_users tu
+JOIN userservice_tenants t ON t.id = tu.tenant_id
+WHERE tu.tenant_id = @tenant_id AND tu.user_id = @user_id AND t.deleted_at IS NULL;
+
+-- name: GetUserRoleInDeletedTenant :one
+SELECT tu.role FROM userservice_tenant_users tu
+JOIN userservice_tenants t ON t.id = tu.tenant_id
+WHERE tu.tenant_id = @tenant_id AND tu.user_id = @user_id AND t.deleted_at IS NOT NULL;
+
+-- name: CountTenantUsersByRole :one
+SELECT COUNT(*) FROM userservice_tenant_users
+WHERE tenant_id = @tenant_id AND role = @role;
+
+-- A super_admin is only demoted or removed while the tenant has another one. The check is part of
+-- the statement, so two super_admins demoting each other at the same time can't both succeed.
+-- name: UpdateTenantUserRole :execrows
+UPDATE userservice_tenant_users
+SET role = @role
+WHERE userservice_tenant_users.tenant_id = @tenant_id
+  AND userservice_tenant_users.user_id = @user_id
+  AND (userservice_tenant_users.role <> 'super_admin' OR (
+    SELECT COUNT(*) FROM userservice_tenant_users admins
+    WHERE admins.tenant_id = userservice_tenant_users.tenant_id AND admins.role = 'super_admin'
+  ) > 1);
+
+-- name: DeleteTenantUser :execrows
+DELETE FROM userservice_tenant_users
+WHERE userservice_tenant_users.tenant_id = @tenant_id
+  AND userservice_tenant_users.user_id = @user_id
+  AND (userservice_tenant_users.role <> 'super_admin' OR (
+    SELECT COUNT(*) FROM userservice_tenant_users admins
+    WHERE admins.tenant_id = userservice_tenant_users.tenant_id AND admins.role = 'super_admin'
+  ) > 1);
+
+-- name: UpdateTenantCreatedBy :exec
+UPDATE userservice_tenants
+SET created_by = @created_by
+WHERE id = @id;
+
+-- name: UpdateTenant :one
+UPDATE userservice_tenants
+SET name = @name,
+    description = @description
+WHERE id = @id AND deleted_at IS NULL
+RETURNING *;
+
+-- name: ScheduleTenantDeletion :execrows
+UPDATE userservice_tenants
+SET deleted_at = @deleted_at,
+    deleted_by = @deleted_by,
+    purge_after = @purge_after
+WHERE id = @id AND deleted_at IS NULL;
+
+-- name: RestoreTenant :execrows
+UPDATE userservice_tenants
+SET deleted_at = NULL,
+    deleted_by = NULL,
+    purge_after = NULL
+WHERE id = @id AND deleted_at IS NOT NULL;
+
+-- purge_after is compared in Go, sqlite stores the timestamps as text
+-- name: ListTenantsPendingDeletion :many
+SELECT * FROM userservice_tenants
+WHERE deleted_at IS NOT NULL
+ORDER BY purge_after ASC;
+
+-- name: DeleteTenantUsersByTenantID :exec
+DELETE FROM userservice_tenant_users
+WHERE tenant_id = @tenant_id;
+
+-- name: DeleteAccessTokensByTenantID :exec
+DELETE FROM userservice_access_tokens
+WHERE tenant_id = @tenant_id;
+
+-- name: DeleteInvitationsByTenantID :exec
+DELETE FROM userservice_invitations
+WHERE tenant_id = @tenant_id;
+
+-- name: DeleteTenant :exec
+DELETE FROM userservice_tenants
+WHERE id = @id;
+
+-- name: GetUsersByIDs :many
+SELECT * FROM userservice_users
+WHERE id IN (sqlc.slice(ids));
+
+-- Local accounts
+-- name: UpsertLocalCredential :exec
+INSERT INTO userservice_local_credentials (
+    user_id,
+    password_hash,
+    created_at,
+    updated_at
+) VALUES (
+    @user_id,
+    @password_hash,
+    @updated_at,
+    @updated_at
+)
+ON CONFLICT (user_id) DO UPDATE SET
+    password_hash = excluded.password_hash,
+    updated_at = excluded.updated_at,
+    token_version = userservice_local_credentials.token_version + 1;
+
+-- name: GetLocalCredentialByUserID :one
+SELECT * FROM userservice_local_credentials
+WHERE user_id = @user_id;
+
+-- name: CreatePasswordReset :exec
+INSERT INTO userservice_password_resets (
+    token_hash,
+    user_id,
+    created_by,
+    expires_at,
+    created_at
+) VALUES (
+    @token_hash,
+    @user_id,
+    @created_by,
+    @expires_at,
+    @created_at
+);
+
+-- name: GetPasswordResetByTokenHash :one
+SELECT * FROM userservice_password_resets
+WHERE token_hash = @token_hash;
+
+-- Only succeeds once per token, so two concurrent resets can't both use it
+-- name: MarkPasswordResetUsed :execrows
+UPDATE userservice_password_resets
+SET used_at = @used_at
+WHERE token_hash = @token_hash AND used_at IS NULL;
+
+-- Personal access tokens
+-- name: CreateAccessToken :exec
+INSERT INTO userservice_access_tokens (
+    id,
+    user_id,
+    tenant_id,
+    name,
+    token_hash,
+    token_prefix,
+    scopes,
+    expires_at,
+    created_at
+) VALUES (
+    @id,
+    @user_id,
+    @tenant_id,
+    @name,
+    @token_hash,
+    @token_prefix,
+    @scopes,
+    @expires_at,
+    @created_at
+);
+
+-- The token stops working when its user leaves the tenant, so the membership is part of the lookup
+-- name: GetAccessTokenByHash :one
+SELECT
+    sqlc.embed(t),
+    u.username,
+    u.email
+FROM userservice_access_tokens t
+JOIN userservice_users u ON t.user_id = u.id
+JOIN userservice_tenant_users tu ON tu.tenant_id = t.tenant_id AND tu.user_id = t.user_id
+WHERE t.token_hash = @token_hash AND t.revoked_at IS NULL;
+
+-- name: ListAccessTokensByUserID :many
+SELECT * FROM userservice_access_tokens
+WHERE user_id = @user_id AND revoked_at IS NULL
+ORDER BY created_at DESC;
+
+-- name: CountAccessTokensByUserID :one
+SELECT COUNT(*) FROM userservice_access_tokens
+WHERE user_id = @user_id AND revoked_at IS NULL
+  AND (expires_at IS NULL OR expires_at > @now);
+
+-- name: RevokeAccessToken :execrows
+UPDATE userservice_access_tokens
+SET revoked_at = @revoked_at
+WHERE id = @id AND user_id = @user_id AND revoked_at IS NULL;
+
+-- name: UpdateAccessTokenLastUsed :exec
+UPDATE userservice_access_tokens
+SET last_used_at = @last_used_at
+WHERE id = @id;
+
+-- Sessions
+-- name: CreateSession :exec
+INSERT INTO userservice_sessions (
+    id,
+    token_hash,
+    user_id,
+    roles,
+    user_agent,
+    created_at,
+    last_seen_at,
+    expires_at
+) VALUES (
+    @id,
+    @token_hash,
+    @user_id,
+    @roles,
+    @user_agent,
+    @created_at,
+    @created_at,
+    @expires_at
+);
+
+-- name: GetSessionByTokenHash :one
+SELECT
+    sqlc.embed(s),
+    u.username,
+    u.email
+FROM userservice_sessions s
+JOIN userservice_users u ON s.user_id = u.id
+WHERE s.token_hash = @token_hash AND s.revoked_at IS NULL;
+
+-- name: TouchSession :exec
+UPDATE userservice_sessions
+SET last_seen_at = @last_seen_at,
+    expires_at = @expires_at
+WHERE id = @id;
+
+-- Expired sessions are filtered by the caller
+-- name: ListSessionsByUserID :many
+SELECT * FROM userservice_sessions
+WHERE user_id = @user_id AND revoked_at IS NULL
+ORDER BY created_at DESC;
+
+-- name: RevokeSession :execrows
+UPDATE userservice_sessions
+SET revoked_at = @revoked_at
+WHERE id = @id AND user_id = @user_id AND revoked_at IS NULL;
+
+-- name: RevokeSessionByTokenHash :exec
+UPDATE userservice_sessions
+SET revoked_at = @revoked_at
+WHERE token_hash = @token_hash AND revoked_at IS NULL;
+
+-- name: RevokeSessionsByUserID :exec
+UPDATE userservice_sessions
+SET revoked_at = @revoked_at
+WHERE user_id = @user_id AND revoked_at IS NULL;
+
+-- Revoked and expired sessions can't be used anymore
+-- name: DeleteStaleSessions :execrows
+DELETE FROM userservice_sessions
+WHERE revoked_at IS NOT NULL OR expires_at < @now;
+
+-- Invitation queries
+-- name: CreateInvitation :exec
+INSERT INTO userservice_invitations (
+    id,
+    tenant_id,
+    email,
+    role,
+    token_hash,
+    invited_by,
+    created_at,
+    expires_at
+) VALUES (
+    @id,
+    @tenant_id,
+    @email,
+    @role,
+    @token_hash,
+    @invited_by,
+    @created_at,
+    @expires_at
+);
+
+-- Pending invitations, expired ones are filtered by the caller
+-- name: GetInvitationByTokenHash :one
+SELECT
+    sqlc.embed(i),
+    t.name AS tenant_name
+FROM userservice_invitations i
+JOIN userservice_tenants t ON i.tenant_id = t.id
+WHERE i.token_hash = @token_hash
+  AND i.accepted_at IS NULL AND i.declined_at IS NULL AND i.revoked_at IS NULL;
+
+-- name: ListInvitationsByTenantID :many
+SELECT * FROM userservice_invitations
+WHERE tenant_id = @tenant_id
+  AND accepted_at IS NULL AND declined_at IS NULL AND revoked_at IS NULL
+ORDER BY created_at DESC;
+
+-- name: ListInvitationsByEmail :many
+SELECT * FROM userservice_invitations
+WHERE email = @email
+  AND accepted_at IS NULL AND declined_at IS NULL AND revoked_at IS NULL
+ORDER BY created_at;
+
+-- name: AcceptInvitation :execrows
+UPDATE userservice_invitations
+SET accepted_at = @accepted_at,
+    accepted_by = @accepted_by
+WHERE id = @id AND accepted_at IS NULL AND declined_at IS NULL AND revoked_at IS NULL;
+
+-- name: DeclineInvitation :execrows
+UPDATE userservice_invitations
+SET declined_at = @declined_at
+WHERE id = @id AND accepted_at IS NULL AND declined_at IS NULL AND revoked_at IS NULL;
+
+-- A new invitation to the same address replaces the pending ones
+-- name: RevokeInvitationsByTenantAndEmail :exec
+UPDATE userservice_invitations
+SET revoked_at = @revoked_at
+WHERE tenant_id = @tenant_id AND email = @email
+  AND accepted_at IS NULL AND declined_at IS NULL AND revoked_at IS NULL;
+
+-- name: RevokeInvitationsByTenantID :exec
+UPDATE userservice_invitations
+SET revoked_at = @revoked_at
+WHERE tenant_id = @tenant_id
+  AND accepted_at IS NULL AND declined_at IS NULL AND revoked_at IS NULL;
+
+-- name: RevokeInvitation :execrows
+UPDATE userservice_invitations
+SET revoked_at = @revoked_at
+WHERE id = @id AND tenant_id = @tenant_id
+  AND accepted_at IS NULL AND declined_at IS NULL AND revoked_at IS NULL;
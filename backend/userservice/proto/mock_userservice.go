@@ -198,6 +198,26 @@ func (m *MockTenantServiceClient) EXPECT() *MockTenantServiceClientMockRecorder
 	return m.recorder
 }
 
+// AcceptInvitation mocks base method.
+func (m *MockTenantServiceClient) AcceptInvitation(ctx context.Context, in *AcceptInvitationRequest, opts ...grpc.CallOption) (*AcceptInvitationResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "AcceptInvitation", varargs...)
+	ret0, _ := ret[0].(*AcceptInvitationResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AcceptInvitation indicates an expected call of AcceptInvitation.
+func (mr *MockTenantServiceClientMockRecorder) AcceptInvitation(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AcceptInvitation", reflect.TypeOf((*MockTenantServiceClient)(nil).AcceptInvitation), varargs...)
+}
+
 // AddUser mocks base method.
 func (m *MockTenantServiceClient) AddUser(ctx context.Context, in *AddUserRequest, opts ...grpc.CallOption) (*AddUserResponse, error) {
 	m.ctrl.T.Helper()
@@ -238,6 +258,46 @@ func (mr *MockTenantServiceClientMockRecorder) CreateTenant(ctx, in interface{},
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTenant", reflect.TypeOf((*MockTenantServiceClient)(nil).CreateTenant), varargs...)
 }
 
+// DeclineInvitation mocks base method.
+func (m *MockTenantServiceClient) DeclineInvitation(ctx context.Context, in *DeclineInvitationRequest, opts ...grpc.CallOption) (*DeclineInvitationResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeclineInvitation", varargs...)
+	ret0, _ := ret[0].(*DeclineInvitationResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeclineInvitation indicates an expected call of DeclineInvitation.
+func (mr *MockTenantServiceClientMockRecorder) DeclineInvitation(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeclineInvitation", reflect.TypeOf((*MockTenantServiceClient)(nil).DeclineInvitation), varargs...)
+}
+
+// DeleteTenant mocks base method.
+func (m *MockTenantServiceClient) DeleteTenant(ctx context.Context, in *DeleteTenantRequest, opts ...grpc.CallOption) (*DeleteTenantResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteTenant", varargs...)
+	ret0, _ := ret[0].(*DeleteTenantResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteTenant indicates an expected call of DeleteTenant.
+func (mr *MockTenantServiceClientMockRecorder) DeleteTenant(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTenant", reflect.TypeOf((*MockTenantServiceClient)(nil).DeleteTenant), varargs...)
+}
+
 // GetUsers mocks base method.
 func (m *MockTenantServiceClient) GetUsers(ctx context.Context, in *GetUsersRequest, opts ...grpc.CallOption) (*GetUsersResponse, error) {
 	m.ctrl.T.Helper()
@@ -258,6 +318,206 @@ func (mr *MockTenantServiceClientMockRecorder) GetUsers(ctx, in interface{}, opt
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUsers", reflect.TypeOf((*MockTenantServiceClient)(nil).GetUsers), varargs...)
 }
 
+// InviteUser mocks base method.
+func (m *MockTenantServiceClient) InviteUser(ctx context.Context, in *InviteUserRequest, opts ...grpc.CallOption) (*InviteUserResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "InviteUser", varargs...)
+	ret0, _ := ret[0].(*InviteUserResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InviteUser indicates an expected call of InviteUser.
+func (mr *MockTenantServiceClientMockRecorder) InviteUser(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InviteUser", reflect.TypeOf((*MockTenantServiceClient)(nil).InviteUser), varargs...)
+}
+
+// LeaveTenant mocks base method.
+func (m *MockTenantServiceClient) LeaveTenant(ctx context.Context, in *LeaveTenantRequest, opts ...grpc.CallOption) (*LeaveTenantResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "LeaveTenant", varargs...)
+	ret0, _ := ret[0].(*LeaveTenantResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LeaveTenant indicates an expected call of LeaveTenant.
+func (mr *MockTenantServiceClientMockRecorder) LeaveTenant(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LeaveTenant", reflect.TypeOf((*MockTenantServiceClient)(nil).LeaveTenant), varargs...)
+}
+
+// ListInvitations mocks base method.
+func (m *MockTenantServiceClient) ListInvitations(ctx context.Context, in *ListInvitationsRequest, opts ...grpc.CallOption) (*ListInvitationsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListInvitations", varargs...)
+	ret0, _ := ret[0].(*ListInvitationsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListInvitations indicates an expected call of ListInvitations.
+func (mr *MockTenantServiceClientMockRecorder) ListInvitations(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListInvitations", reflect.TypeOf((*MockTenantServiceClient)(nil).ListInvitations), varargs...)
+}
+
+// LookupUsers mocks base method.
+func (m *MockTenantServiceClient) LookupUsers(ctx context.Context, in *LookupUsersRequest, opts ...grpc.CallOption) (*LookupUsersResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "LookupUsers", varargs...)
+	ret0, _ := ret[0].(*LookupUsersResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LookupUsers indicates an expected call of LookupUsers.
+func (mr *MockTenantServiceClientMockRecorder) LookupUsers(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LookupUsers", reflect.TypeOf((*MockTenantServiceClient)(nil).LookupUsers), varargs...)
+}
+
+// RemoveUser mocks base method.
+func (m *MockTenantServiceClient) RemoveUser(ctx context.Context, in *RemoveUserRequest, opts ...grpc.CallOption) (*RemoveUserResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RemoveUser", varargs...)
+	ret0, _ := ret[0].(*RemoveUserResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveUser indicates an expected call of RemoveUser.
+func (mr *MockTenantServiceClientMockRecorder) RemoveUser(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveUser", reflect.TypeOf((*MockTenantServiceClient)(nil).RemoveUser), varargs...)
+}
+
+// RestoreTenant mocks base method.
+func (m *MockTenantServiceClient) RestoreTenant(ctx context.Context, in *RestoreTenantRequest, opts ...grpc.CallOption) (*RestoreTenantResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RestoreTenant", varargs...)
+	ret0, _ := ret[0].(*RestoreTenantResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RestoreTenant indicates an expected call of RestoreTenant.
+func (mr *MockTenantServiceClientMockRecorder) RestoreTenant(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreTenant", reflect.TypeOf((*MockTenantServiceClient)(nil).RestoreTenant), varargs...)
+}
+
+// RevokeInvitation mocks base method.
+func (m *MockTenantServiceClient) RevokeInvitation(ctx context.Context, in *RevokeInvitationRequest, opts ...grpc.CallOption) (*RevokeInvitationResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RevokeInvitation", varargs...)
+	ret0, _ := ret[0].(*RevokeInvitationResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RevokeInvitation indicates an expected call of RevokeInvitation.
+func (mr *MockTenantServiceClientMockRecorder) RevokeInvitation(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeInvitation", reflect.TypeOf((*MockTenantServiceClient)(nil).RevokeInvitation), varargs...)
+}
+
+// TransferOwnership mocks base method.
+func (m *MockTenantServiceClient) TransferOwnership(ctx context.Context, in *TransferOwnershipRequest, opts ...grpc.CallOption) (*TransferOwnershipResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "TransferOwnership", varargs...)
+	ret0, _ := ret[0].(*TransferOwnershipResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TransferOwnership indicates an expected call of TransferOwnership.
+func (mr *MockTenantServiceClientMockRecorder) TransferOwnership(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TransferOwnership", reflect.TypeOf((*MockTenantServiceClient)(nil).TransferOwnership), varargs...)
+}
+
+// UpdateTenant mocks base method.
+func (m *MockTenantServiceClient) UpdateTenant(ctx context.Context, in *UpdateTenantRequest, opts ...grpc.CallOption) (*UpdateTenantResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateTenant", varargs...)
+	ret0, _ := ret[0].(*UpdateTenantResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateTenant indicates an expected call of UpdateTenant.
+func (mr *MockTenantServiceClientMockRecorder) UpdateTenant(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTenant", reflect.TypeOf((*MockTenantServiceClient)(nil).UpdateTenant), varargs...)
+}
+
+// UpdateUserRole mocks base method.
+func (m *MockTenantServiceClient) UpdateUserRole(ctx context.Context, in *UpdateUserRoleRequest, opts ...grpc.CallOption) (*UpdateUserRoleResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateUserRole", varargs...)
+	ret0, _ := ret[0].(*UpdateUserRoleResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateUserRole indicates an expected call of UpdateUserRole.
+func (mr *MockTenantServiceClientMockRecorder) UpdateUserRole(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUserRole", reflect.TypeOf((*MockTenantServiceClient)(nil).UpdateUserRole), varargs...)
+}
+
 // MockTenantServiceServer is a mock of TenantServiceServer interface.
 type MockTenantServiceServer struct {
 	ctrl     *gomock.Controller
@@ -281,6 +541,21 @@ func (m *MockTenantServiceServer) EXPECT() *MockTenantServiceServerMockRecorder
 	return m.recorder
 }
 
+// AcceptInvitation mocks base method.
+func (m *MockTenantServiceServer) AcceptInvitation(arg0 context.Context, arg1 *AcceptInvitationRequest) (*AcceptInvitationResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AcceptInvitation", arg0, arg1)
+	ret0, _ := ret[0].(*AcceptInvitationResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AcceptInvitation indicates an expected call of AcceptInvitation.
+func (mr *MockTenantServiceServerMockRecorder) AcceptInvitation(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AcceptInvitation", reflect.TypeOf((*MockTenantServiceServer)(nil).AcceptInvitation), arg0, arg1)
+}
+
 // AddUser mocks base method.
 func (m *MockTenantServiceServer) AddUser(arg0 context.Context, arg1 *AddUserRequest) (*AddUserResponse, error) {
 	m.ctrl.T.Helper()
@@ -311,6 +586,36 @@ func (mr *MockTenantServiceServerMockRecorder) CreateTenant(arg0, arg1 interface
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTenant", reflect.TypeOf((*MockTenantServiceServer)(nil).CreateTenant), arg0, arg1)
 }
 
+// DeclineInvitation mocks base method.
+func (m *MockTenantServiceServer) DeclineInvitation(arg0 context.Context, arg1 *DeclineInvitationRequest) (*DeclineInvitationResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeclineInvitation", arg0, arg1)
+	ret0, _ := ret[0].(*DeclineInvitationResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeclineInvitation indicates an expected call of DeclineInvitation.
+func (mr *MockTenantServiceServerMockRecorder) DeclineInvitation(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeclineInvitation", reflect.TypeOf((*MockTenantServiceServer)(nil).DeclineInvitation), arg0, arg1)
+}
+
+// DeleteTenant mocks base method.
+func (m *MockTenantServiceServer) DeleteTenant(arg0 context.Context, arg1 *DeleteTenantRequest) (*DeleteTenantResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTenant", arg0, arg1)
+	ret0, _ := ret[0].(*DeleteTenantResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteTenant indicates an expected call of DeleteTenant.
+func (mr *MockTenantServiceServerMockRecorder) DeleteTenant(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTenant", reflect.TypeOf((*MockTenantServiceServer)(nil).DeleteTenant), arg0, arg1)
+}
+
 // GetUsers mocks base method.
 func (m *MockTenantServiceServer) GetUsers(arg0 context.Context, arg1 *GetUsersRequest) (*GetUsersResponse, error) {
 	m.ctrl.T.Helper()
@@ -326,49 +631,956 @@ func (mr *MockTenantServiceServerMockRecorder) GetUsers(arg0, arg1 interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUsers", reflect.TypeOf((*MockTenantServiceServer)(nil).GetUsers), arg0, arg1)
 }
 
-// mustEmbedUnimplementedTenantServiceServer mocks base method.
-func (m *MockTenantServiceServer) mustEmbedUnimplementedTenantServiceServer() {
+// InviteUser mocks base method.
+func (m *MockTenantServiceServer) InviteUser(arg0 context.Context, arg1 *InviteUserRequest) (*InviteUserResponse, error) {
 	m.ctrl.T.Helper()
-	m.ctrl.Call(m, "mustEmbedUnimplementedTenantServiceServer")
+	ret := m.ctrl.Call(m, "InviteUser", arg0, arg1)
+	ret0, _ := ret[0].(*InviteUserResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// mustEmbedUnimplementedTenantServiceServer indicates an expected call of mustEmbedUnimplementedTenantServiceServer.
-func (mr *MockTenantServiceServerMockRecorder) mustEmbedUnimplementedTenantServiceServer() *gomock.Call {
+// InviteUser indicates an expected call of InviteUser.
+func (mr *MockTenantServiceServerMockRecorder) InviteUser(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "mustEmbedUnimplementedTenantServiceServer", reflect.TypeOf((*MockTenantServiceServer)(nil).mustEmbedUnimplementedTenantServiceServer))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InviteUser", reflect.TypeOf((*MockTenantServiceServer)(nil).InviteUser), arg0, arg1)
 }
 
-// MockUnsafeTenantServiceServer is a mock of UnsafeTenantServiceServer interface.
-type MockUnsafeTenantServiceServer struct {
-	ctrl     *gomock.Controller
-	recorder *MockUnsafeTenantServiceServerMockRecorder
+// LeaveTenant mocks base method.
+func (m *MockTenantServiceServer) LeaveTenant(arg0 context.Context, arg1 *LeaveTenantRequest) (*LeaveTenantResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LeaveTenant", arg0, arg1)
+	ret0, _ := ret[0].(*LeaveTenantResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// MockUnsafeTenantServiceServerMockRecorder is the mock recorder for MockUnsafeTenantServiceServer.
-type MockUnsafeTenantServiceServerMockRecorder struct {
-	mock *MockUnsafeTenantServiceServer
+// LeaveTenant indicates an expected call of LeaveTenant.
+func (mr *MockTenantServiceServerMockRecorder) LeaveTenant(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LeaveTenant", reflect.TypeOf((*MockTenantServiceServer)(nil).LeaveTenant), arg0, arg1)
 }
 
-// NewMockUnsafeTenantServiceServer creates a new mock instance.
-func NewMockUnsafeTenantServiceServer(ctrl *gomock.Controller) *MockUnsafeTenantServiceServer {
-	mock := &MockUnsafeTenantServiceServer{ctrl: ctrl}
-	mock.recorder = &MockUnsafeTenantServiceServerMockRecorder{mock}
-	return mock
+// ListInvitations mocks base method.
+func (m *MockTenantServiceServer) ListInvitations(arg0 context.Context, arg1 *ListInvitationsRequest) (*ListInvitationsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListInvitations", arg0, arg1)
+	ret0, _ := ret[0].(*ListInvitationsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockUnsafeTenantServiceServer) EXPECT() *MockUnsafeTenantServiceServerMockRecorder {
-	return m.recorder
+// ListInvitations indicates an expected call of ListInvitations.
+func (mr *MockTenantServiceServerMockRecorder) ListInvitations(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListInvitations", reflect.TypeOf((*MockTenantServiceServer)(nil).ListInvitations), arg0, arg1)
 }
 
-// mustEmbedUnimplementedTenantServiceServer mocks base method.
-func (m *MockUnsafeTenantServiceServer) mustEmbedUnimplementedTenantServiceServer() {
+// LookupUsers mocks base method.
+func (m *MockTenantServiceServer) LookupUsers(arg0 context.Context, arg1 *LookupUsersRequest) (*LookupUsersResponse, error) {
 	m.ctrl.T.Helper()
-	m.ctrl.Call(m, "mustEmbedUnimplementedTenantServiceServer")
+	ret := m.ctrl.Call(m, "LookupUsers", arg0, arg1)
+	ret0, _ := ret[0].(*LookupUsersResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// mustEmbedUnimplementedTenantServiceServer indicates an expected call of mustEmbedUnimplementedTenantServiceServer.
-func (mr *MockUnsafeTenantServiceServerMockRecorder) mustEmbedUnimplementedTenantServiceServer() *gomock.Call {
+// LookupUsers indicates an expected call of LookupUsers.
+func (mr *MockTenantServiceServerMockRecorder) LookupUsers(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "mustEmbedUnimplementedTenantServiceServer", reflect.TypeOf((*MockUnsafeTenantServiceServer)(nil).mustEmbedUnimplementedTenantServiceServer))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LookupUsers", reflect.TypeOf((*MockTenantServiceServer)(nil).LookupUsers), arg0, arg1)
+}
+
+// RemoveUser mocks base method.
+func (m *MockTenantServiceServer) RemoveUser(arg0 context.Context, arg1 *RemoveUserRequest) (*RemoveUserResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveUser", arg0, arg1)
+	ret0, _ := ret[0].(*RemoveUserResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveUser indicates an expected call of RemoveUser.
+func (mr *MockTenantServiceServerMockRecorder) RemoveUser(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveUser", reflect.TypeOf((*MockTenantServiceServer)(nil).RemoveUser), arg0, arg1)
+}
+
+// RestoreTenant mocks base method.
+func (m *MockTenantServiceServer) RestoreTenant(arg0 context.Context, arg1 *RestoreTenantRequest) (*RestoreTenantResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreTenant", arg0, arg1)
+	ret0, _ := ret[0].(*RestoreTenantResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RestoreTenant indicates an expected call of RestoreTenant.
+func (mr *MockTenantServiceServerMockRecorder) RestoreTenant(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreTenant", reflect.TypeOf((*MockTenantServiceServer)(nil).RestoreTenant), arg0, arg1)
+}
+
+// RevokeInvitation mocks base method.
+func (m *MockTenantServiceServer) RevokeInvitation(arg0 context.Context, arg1 *RevokeInvitationRequest) (*RevokeInvitationResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeInvitation", arg0, arg1)
+	ret0, _ := ret[0].(*RevokeInvitationResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RevokeInvitation indicates an expected call of RevokeInvitation.
+func (mr *MockTenantServiceServerMockRecorder) RevokeInvitation(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeInvitation", reflect.TypeOf((*MockTenantServiceServer)(nil).RevokeInvitation), arg0, arg1)
+}
+
+// TransferOwnership mocks base method.
+func (m *MockTenantServiceServer) TransferOwnership(arg0 context.Context, arg1 *TransferOwnershipRequest) (*TransferOwnershipResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TransferOwnership", arg0, arg1)
+	ret0, _ := ret[0].(*TransferOwnershipResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TransferOwnership indicates an expected call of TransferOwnership.
+func (mr *MockTenantServiceServerMockRecorder) TransferOwnership(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TransferOwnership", reflect.TypeOf((*MockTenantServiceServer)(nil).TransferOwnership), arg0, arg1)
+}
+
+// UpdateTenant mocks base method.
+func (m *MockTenantServiceServer) UpdateTenant(arg0 context.Context, arg1 *UpdateTenantRequest) (*UpdateTenantResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTenant", arg0, arg1)
+	ret0, _ := ret[0].(*UpdateTenantResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateTenant indicates an expected call of UpdateTenant.
+func (mr *MockTenantServiceServerMockRecorder) UpdateTenant(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTenant", reflect.TypeOf((*MockTenantServiceServer)(nil).UpdateTenant), arg0, arg1)
+}
+
+// UpdateUserRole mocks base method.
+func (m *MockTenantServiceServer) UpdateUserRole(arg0 context.Context, arg1 *UpdateUserRoleRequest) (*UpdateUserRoleResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateUserRole", arg0, arg1)
+	ret0, _ := ret[0].(*UpdateUserRoleResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateUserRole indicates an expected call of UpdateUserRole.
+func (mr *MockTenantServiceServerMockRecorder) UpdateUserRole(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUserRole", reflect.TypeOf((*MockTenantServiceServer)(nil).UpdateUserRole), arg0, arg1)
+}
+
+// mustEmbedUnimplementedTenantServiceServer mocks base method.
+func (m *MockTenantServiceServer) mustEmbedUnimplementedTenantServiceServer() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "mustEmbedUnimplementedTenantServiceServer")
+}
+
+// mustEmbedUnimplementedTenantServiceServer indicates an expected call of mustEmbedUnimplementedTenantServiceServer.
+func (mr *MockTenantServiceServerMockRecorder) mustEmbedUnimplementedTenantServiceServer() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "mustEmbedUnimplementedTenantServiceServer", reflect.TypeOf((*MockTenantServiceServer)(nil).mustEmbedUnimplementedTenantServiceServer))
+}
+
+// MockUnsafeTenantServiceServer is a mock of UnsafeTenantServiceServer interface.
+type MockUnsafeTenantServiceServer struct {
+	ctrl     *gomock.Controller
+	recorder *MockUnsafeTenantServiceServerMockRecorder
+}
+
+// MockUnsafeTenantServiceServerMockRecorder is the mock recorder for MockUnsafeTenantServiceServer.
+type MockUnsafeTenantServiceServerMockRecorder struct {
+	mock *MockUnsafeTenantServiceServer
+}
+
+// NewMockUnsafeTenantServiceServer creates a new mock instance.
+func NewMockUnsafeTenantServiceServer(ctrl *gomock.Controller) *MockUnsafeTenantServiceServer {
+	mock := &MockUnsafeTenantServiceServer{ctrl: ctrl}
+	mock.recorder = &MockUnsafeTenantServiceServerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUnsafeTenantServiceServer) EXPECT() *MockUnsafeTenantServiceServerMockRecorder {
+	return m.recorder
+}
+
+// mustEmbedUnimplementedTenantServiceServer mocks base method.
+func (m *MockUnsafeTenantServiceServer) mustEmbedUnimplementedTenantServiceServer() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "mustEmbedUnimplementedTenantServiceServer")
+}
+
+// mustEmbedUnimplementedTenantServiceServer indicates an expected call of mustEmbedUnimplementedTenantServiceServer.
+func (mr *MockUnsafeTenantServiceServerMockRecorder) mustEmbedUnimplementedTenantServiceServer() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "mustEmbedUnimplementedTenantServiceServer", reflect.TypeOf((*MockUnsafeTenantServiceServer)(nil).mustEmbedUnimplementedTenantServiceServer))
+}
+
+// MockLocalAuthServiceClient is a mock of LocalAuthServiceClient interface.
+type MockLocalAuthServiceClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockLocalAuthServiceClientMockRecorder
+}
+
+// MockLocalAuthServiceClientMockRecorder is the mock recorder for MockLocalAuthServiceClient.
+type MockLocalAuthServiceClientMockRecorder struct {
+	mock *MockLocalAuthServiceClient
+}
+
+// NewMockLocalAuthServiceClient creates a new mock instance.
+func NewMockLocalAuthServiceClient(ctrl *gomock.Controller) *MockLocalAuthServiceClient {
+	mock := &MockLocalAuthServiceClient{ctrl: ctrl}
+	mock.recorder = &MockLocalAuthServiceClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLocalAuthServiceClient) EXPECT() *MockLocalAuthServiceClientMockRecorder {
+	return m.recorder
+}
+
+// ChangePassword mocks base method.
+func (m *MockLocalAuthServiceClient) ChangePassword(ctx context.Context, in *ChangePasswordRequest, opts ...grpc.CallOption) (*ChangePasswordResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ChangePassword", varargs...)
+	ret0, _ := ret[0].(*ChangePasswordResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ChangePassword indicates an expected call of ChangePassword.
+func (mr *MockLocalAuthServiceClientMockRecorder) ChangePassword(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangePassword", reflect.TypeOf((*MockLocalAuthServiceClient)(nil).ChangePassword), varargs...)
+}
+
+// CompletePasswordReset mocks base method.
+func (m *MockLocalAuthServiceClient) CompletePasswordReset(ctx context.Context, in *CompletePasswordResetRequest, opts ...grpc.CallOption) (*LoginResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CompletePasswordReset", varargs...)
+	ret0, _ := ret[0].(*LoginResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CompletePasswordReset indicates an expected call of CompletePasswordReset.
+func (mr *MockLocalAuthServiceClientMockRecorder) CompletePasswordReset(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompletePasswordReset", reflect.TypeOf((*MockLocalAuthServiceClient)(nil).CompletePasswordReset), varargs...)
+}
+
+// Login mocks base method.
+func (m *MockLocalAuthServiceClient) Login(ctx context.Context, in *LoginRequest, opts ...grpc.CallOption) (*LoginResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Login", varargs...)
+	ret0, _ := ret[0].(*LoginResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Login indicates an expected call of Login.
+func (mr *MockLocalAuthServiceClientMockRecorder) Login(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Login", reflect.TypeOf((*MockLocalAuthServiceClient)(nil).Login), varargs...)
+}
+
+// ResetPassword mocks base method.
+func (m *MockLocalAuthServiceClient) ResetPassword(ctx context.Context, in *ResetPasswordRequest, opts ...grpc.CallOption) (*ResetPasswordResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ResetPassword", varargs...)
+	ret0, _ := ret[0].(*ResetPasswordResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResetPassword indicates an expected call of ResetPassword.
+func (mr *MockLocalAuthServiceClientMockRecorder) ResetPassword(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetPassword", reflect.TypeOf((*MockLocalAuthServiceClient)(nil).ResetPassword), varargs...)
+}
+
+// Signup mocks base method.
+func (m *MockLocalAuthServiceClient) Signup(ctx context.Context, in *SignupRequest, opts ...grpc.CallOption) (*LoginResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Signup", varargs...)
+	ret0, _ := ret[0].(*LoginResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Signup indicates an expected call of Signup.
+func (mr *MockLocalAuthServiceClientMockRecorder) Signup(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Signup", reflect.TypeOf((*MockLocalAuthServiceClient)(nil).Signup), varargs...)
+}
+
+// MockLocalAuthServiceServer is a mock of LocalAuthServiceServer interface.
+type MockLocalAuthServiceServer struct {
+	ctrl     *gomock.Controller
+	recorder *MockLocalAuthServiceServerMockRecorder
+}
+
+// MockLocalAuthServiceServerMockRecorder is the mock recorder for MockLocalAuthServiceServer.
+type MockLocalAuthServiceServerMockRecorder struct {
+	mock *MockLocalAuthServiceServer
+}
+
+// NewMockLocalAuthServiceServer creates a new mock instance.
+func NewMockLocalAuthServiceServer(ctrl *gomock.Controller) *MockLocalAuthServiceServer {
+	mock := &MockLocalAuthServiceServer{ctrl: ctrl}
+	mock.recorder = &MockLocalAuthServiceServerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLocalAuthServiceServer) EXPECT() *MockLocalAuthServiceServerMockRecorder {
+	return m.recorder
+}
+
+// ChangePassword mocks base method.
+func (m *MockLocalAuthServiceServer) ChangePassword(arg0 context.Context, arg1 *ChangePasswordRequest) (*ChangePasswordResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ChangePassword", arg0, arg1)
+	ret0, _ := ret[0].(*ChangePasswordResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ChangePassword indicates an expected call of ChangePassword.
+func (mr *MockLocalAuthServiceServerMockRecorder) ChangePassword(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangePassword", reflect.TypeOf((*MockLocalAuthServiceServer)(nil).ChangePassword), arg0, arg1)
+}
+
+// CompletePasswordReset mocks base method.
+func (m *MockLocalAuthServiceServer) CompletePasswordReset(arg0 context.Context, arg1 *CompletePasswordResetRequest) (*LoginResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CompletePasswordReset", arg0, arg1)
+	ret0, _ := ret[0].(*LoginResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CompletePasswordReset indicates an expected call of CompletePasswordReset.
+func (mr *MockLocalAuthServiceServerMockRecorder) CompletePasswordReset(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompletePasswordReset", reflect.TypeOf((*MockLocalAuthServiceServer)(nil).CompletePasswordReset), arg0, arg1)
+}
+
+// Login mocks base method.
+func (m *MockLocalAuthServiceServer) Login(arg0 context.Context, arg1 *LoginRequest) (*LoginResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Login", arg0, arg1)
+	ret0, _ := ret[0].(*LoginResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Login indicates an expected call of Login.
+func (mr *MockLocalAuthServiceServerMockRecorder) Login(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Login", reflect.TypeOf((*MockLocalAuthServiceServer)(nil).Login), arg0, arg1)
+}
+
+// ResetPassword mocks base method.
+func (m *MockLocalAuthServiceServer) ResetPassword(arg0 context.Context, arg1 *ResetPasswordRequest) (*ResetPasswordResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResetPassword", arg0, arg1)
+	ret0, _ := ret[0].(*ResetPasswordResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResetPassword indicates an expected call of ResetPassword.
+func (mr *MockLocalAuthServiceServerMockRecorder) ResetPassword(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetPassword", reflect.TypeOf((*MockLocalAuthServiceServer)(nil).ResetPassword), arg0, arg1)
+}
+
+// Signup mocks base method.
+func (m *MockLocalAuthServiceServer) Signup(arg0 context.Context, arg1 *SignupRequest) (*LoginResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Signup", arg0, arg1)
+	ret0, _ := ret[0].(*LoginResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Signup indicates an expected call of Signup.
+func (mr *MockLocalAuthServiceServerMockRecorder) Signup(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Signup", reflect.TypeOf((*MockLocalAuthServiceServer)(nil).Signup), arg0, arg1)
+}
+
+// mustEmbedUnimplementedLocalAuthServiceServer mocks base method.
+func (m *MockLocalAuthServiceServer) mustEmbedUnimplementedLocalAuthServiceServer() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "mustEmbedUnimplementedLocalAuthServiceServer")
+}
+
+// mustEmbedUnimplementedLocalAuthServiceServer indicates an expected call of mustEmbedUnimplementedLocalAuthServiceServer.
+func (mr *MockLocalAuthServiceServerMockRecorder) mustEmbedUnimplementedLocalAuthServiceServer() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "mustEmbedUnimplementedLocalAuthServiceServer", reflect.TypeOf((*MockLocalAuthServiceServer)(nil).mustEmbedUnimplementedLocalAuthServiceServer))
+}
+
+// MockUnsafeLocalAuthServiceServer is a mock of UnsafeLocalAuthServiceServer interface.
+type MockUnsafeLocalAuthServiceServer struct {
+	ctrl     *gomock.Controller
+	recorder *MockUnsafeLocalAuthServiceServerMockRecorder
+}
+
+// MockUnsafeLocalAuthServiceServerMockRecorder is the mock recorder for MockUnsafeLocalAuthServiceServer.
+type MockUnsafeLocalAuthServiceServerMockRecorder struct {
+	mock *MockUnsafeLocalAuthServiceServer
+}
+
+// NewMockUnsafeLocalAuthServiceServer creates a new mock instance.
+func NewMockUnsafeLocalAuthServiceServer(ctrl *gomock.Controller) *MockUnsafeLocalAuthServiceServer {
+	mock := &MockUnsafeLocalAuthServiceServer{ctrl: ctrl}
+	mock.recorder = &MockUnsafeLocalAuthServiceServerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUnsafeLocalAuthServiceServer) EXPECT() *MockUnsafeLocalAuthServiceServerMockRecorder {
+	return m.recorder
+}
+
+// mustEmbedUnimplementedLocalAuthServiceServer mocks base method.
+func (m *MockUnsafeLocalAuthServiceServer) mustEmbedUnimplementedLocalAuthServiceServer() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "mustEmbedUnimplementedLocalAuthServiceServer")
+}
+
+// mustEmbedUnimplementedLocalAuthServiceServer indicates an expected call of mustEmbedUnimplementedLocalAuthServiceServer.
+func (mr *MockUnsafeLocalAuthServiceServerMockRecorder) mustEmbedUnimplementedLocalAuthServiceServer() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "mustEmbedUnimplementedLocalAuthServiceServer", reflect.TypeOf((*MockUnsafeLocalAuthServiceServer)(nil).mustEmbedUnimplementedLocalAuthServiceServer))
+}
+
+// MockAccessTokenServiceClient is a mock of AccessTokenServiceClient interface.
+type MockAccessTokenServiceClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockAccessTokenServiceClientMockRecorder
+}
+
+// MockAccessTokenServiceClientMockRecorder is the mock recorder for MockAccessTokenServiceClient.
+type MockAccessTokenServiceClientMockRecorder struct {
+	mock *MockAccessTokenServiceClient
+}
+
+// NewMockAccessTokenServiceClient creates a new mock instance.
+func NewMockAccessTokenServiceClient(ctrl *gomock.Controller) *MockAccessTokenServiceClient {
+	mock := &MockAccessTokenServiceClient{ctrl: ctrl}
+	mock.recorder = &MockAccessTokenServiceClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAccessTokenServiceClient) EXPECT() *MockAccessTokenServiceClientMockRecorder {
+	return m.recorder
+}
+
+// CreateAccessToken mocks base method.
+func (m *MockAccessTokenServiceClient) CreateAccessToken(ctx context.Context, in *CreateAccessTokenRequest, opts ...grpc.CallOption) (*CreateAccessTokenResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateAccessToken", varargs...)
+	ret0, _ := ret[0].(*CreateAccessTokenResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateAccessToken indicates an expected call of CreateAccessToken.
+func (mr *MockAccessTokenServiceClientMockRecorder) CreateAccessToken(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAccessToken", reflect.TypeOf((*MockAccessTokenServiceClient)(nil).CreateAccessToken), varargs...)
+}
+
+// ListAccessTokens mocks base method.
+func (m *MockAccessTokenServiceClient) ListAccessTokens(ctx context.Context, in *ListAccessTokensRequest, opts ...grpc.CallOption) (*ListAccessTokensResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListAccessTokens", varargs...)
+	ret0, _ := ret[0].(*ListAccessTokensResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAccessTokens indicates an expected call of ListAccessTokens.
+func (mr *MockAccessTokenServiceClientMockRecorder) ListAccessTokens(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAccessTokens", reflect.TypeOf((*MockAccessTokenServiceClient)(nil).ListAccessTokens), varargs...)
+}
+
+// RevokeAccessToken mocks base method.
+func (m *MockAccessTokenServiceClient) RevokeAccessToken(ctx context.Context, in *RevokeAccessTokenRequest, opts ...grpc.CallOption) (*RevokeAccessTokenResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RevokeAccessToken", varargs...)
+	ret0, _ := ret[0].(*RevokeAccessTokenResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RevokeAccessToken indicates an expected call of RevokeAccessToken.
+func (mr *MockAccessTokenServiceClientMockRecorder) RevokeAccessToken(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeAccessToken", reflect.TypeOf((*MockAccessTokenServiceClient)(nil).RevokeAccessToken), varargs...)
+}
+
+// MockAccessTokenServiceServer is a mock of AccessTokenServiceServer interface.
+type MockAccessTokenServiceServer struct {
+	ctrl     *gomock.Controller
+	recorder *MockAccessTokenServiceServerMockRecorder
+}
+
+// MockAccessTokenServiceServerMockRecorder is the mock recorder for MockAccessTokenServiceServer.
+type MockAccessTokenServiceServerMockRecorder struct {
+	mock *MockAccessTokenServiceServer
+}
+
+// NewMockAccessTokenServiceServer creates a new mock instance.
+func NewMockAccessTokenServiceServer(ctrl *gomock.Controller) *MockAccessTokenServiceServer {
+	mock := &MockAccessTokenServiceServer{ctrl: ctrl}
+	mock.recorder = &MockAccessTokenServiceServerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAccessTokenServiceServer) EXPECT() *MockAccessTokenServiceServerMockRecorder {
+	return m.recorder
+}
+
+// CreateAccessToken mocks base method.
+func (m *MockAccessTokenServiceServer) CreateAccessToken(arg0 context.Context, arg1 *CreateAccessTokenRequest) (*CreateAccessTokenResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAccessToken", arg0, arg1)
+	ret0, _ := ret[0].(*CreateAccessTokenResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateAccessToken indicates an expected call of CreateAccessToken.
+func (mr *MockAccessTokenServiceServerMockRecorder) CreateAccessToken(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAccessToken", reflect.TypeOf((*MockAccessTokenServiceServer)(nil).CreateAccessToken), arg0, arg1)
+}
+
+// ListAccessTokens mocks base method.
+func (m *MockAccessTokenServiceServer) ListAccessTokens(arg0 context.Context, arg1 *ListAccessTokensRequest) (*ListAccessTokensResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAccessTokens", arg0, arg1)
+	ret0, _ := ret[0].(*ListAccessTokensResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAccessTokens indicates an expected call of ListAccessTokens.
+func (mr *MockAccessTokenServiceServerMockRecorder) ListAccessTokens(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAccessTokens", reflect.TypeOf((*MockAccessTokenServiceServer)(nil).ListAccessTokens), arg0, arg1)
+}
+
+// RevokeAccessToken mocks base method.
+func (m *MockAccessTokenServiceServer) RevokeAccessToken(arg0 context.Context, arg1 *RevokeAccessTokenRequest) (*RevokeAccessTokenResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeAccessToken", arg0, arg1)
+	ret0, _ := ret[0].(*RevokeAccessTokenResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RevokeAccessToken indicates an expected call of RevokeAccessToken.
+func (mr *MockAccessTokenServiceServerMockRecorder) RevokeAccessToken(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeAccessToken", reflect.TypeOf((*MockAccessTokenServiceServer)(nil).RevokeAccessToken), arg0, arg1)
+}
+
+// mustEmbedUnimplementedAccessTokenServiceServer mocks base method.
+func (m *MockAccessTokenServiceServer) mustEmbedUnimplementedAccessTokenServiceServer() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "mustEmbedUnimplementedAccessTokenServiceServer")
+}
+
+// mustEmbedUnimplementedAccessTokenServiceServer indicates an expected call of mustEmbedUnimplementedAccessTokenServiceServer.
+func (mr *MockAccessTokenServiceServerMockRecorder) mustEmbedUnimplementedAccessTokenServiceServer() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "mustEmbedUnimplementedAccessTokenServiceServer", reflect.TypeOf((*MockAccessTokenServiceServer)(nil).mustEmbedUnimplementedAccessTokenServiceServer))
+}
+
+// MockUnsafeAccessTokenServiceServer is a mock of UnsafeAccessTokenServiceServer interface.
+type MockUnsafeAccessTokenServiceServer struct {
+	ctrl     *gomock.Controller
+	recorder *MockUnsafeAccessTokenServiceServerMockRecorder
+}
+
+// MockUnsafeAccessTokenServiceServerMockRecorder is the mock recorder for MockUnsafeAccessTokenServiceServer.
+type MockUnsafeAccessTokenServiceServerMockRecorder struct {
+	mock *MockUnsafeAccessTokenServiceServer
+}
+
+// NewMockUnsafeAccessTokenServiceServer creates a new mock instance.
+func NewMockUnsafeAccessTokenServiceServer(ctrl *gomock.Controller) *MockUnsafeAccessTokenServiceServer {
+	mock := &MockUnsafeAccessTokenServiceServer{ctrl: ctrl}
+	mock.recorder = &MockUnsafeAccessTokenServiceServerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUnsafeAccessTokenServiceServer) EXPECT() *MockUnsafeAccessTokenServiceServerMockRecorder {
+	return m.recorder
+}
+
+// mustEmbedUnimplementedAccessTokenServiceServer mocks base method.
+func (m *MockUnsafeAccessTokenServiceServer) mustEmbedUnimplementedAccessTokenServiceServer() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "mustEmbedUnimplementedAccessTokenServiceServer")
+}
+
+// mustEmbedUnimplementedAccessTokenServiceServer indicates an expected call of mustEmbedUnimplementedAccessTokenServiceServer.
+func (mr *MockUnsafeAccessTokenServiceServerMockRecorder) mustEmbedUnimplementedAccessTokenServiceServer() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "mustEmbedUnimplementedAccessTokenServiceServer", reflect.TypeOf((*MockUnsafeAccessTokenServiceServer)(nil).mustEmbedUnimplementedAccessTokenServiceServer))
+}
+
+// MockSessionServiceClient is a mock of SessionServiceClient interface.
+type MockSessionServiceClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockSessionServiceClientMockRecorder
+}
+
+// MockSessionServiceClientMockRecorder is the mock recorder for MockSessionServiceClient.
+type MockSessionServiceClientMockRecorder struct {
+	mock *MockSessionServiceClient
+}
+
+// NewMockSessionServiceClient creates a new mock instance.
+func NewMockSessionServiceClient(ctrl *gomock.Controller) *MockSessionServiceClient {
+	mock := &MockSessionServiceClient{ctrl: ctrl}
+	mock.recorder = &MockSessionServiceClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSessionServiceClient) EXPECT() *MockSessionServiceClientMockRecorder {
+	return m.recorder
+}
+
+// ListSessions mocks base method.
+func (m *MockSessionServiceClient) ListSessions(ctx context.Context, in *ListSessionsRequest, opts ...grpc.CallOption) (*ListSessionsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListSessions", varargs...)
+	ret0, _ := ret[0].(*ListSessionsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSessions indicates an expected call of ListSessions.
+func (mr *MockSessionServiceClientMockRecorder) ListSessions(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSessions", reflect.TypeOf((*MockSessionServiceClient)(nil).ListSessions), varargs...)
+}
+
+// RevokeSession mocks base method.
+func (m *MockSessionServiceClient) RevokeSession(ctx context.Context, in *RevokeSessionRequest, opts ...grpc.CallOption) (*RevokeSessionResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RevokeSession", varargs...)
+	ret0, _ := ret[0].(*RevokeSessionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RevokeSession indicates an expected call of RevokeSession.
+func (mr *MockSessionServiceClientMockRecorder) RevokeSession(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeSession", reflect.TypeOf((*MockSessionServiceClient)(nil).RevokeSession), varargs...)
+}
+
+// MockSessionServiceServer is a mock of SessionServiceServer interface.
+type MockSessionServiceServer struct {
+	ctrl     *gomock.Controller
+	recorder *MockSessionServiceServerMockRecorder
+}
+
+// MockSessionServiceServerMockRecorder is the mock recorder for MockSessionServiceServer.
+type MockSessionServiceServerMockRecorder struct {
+	mock *MockSessionServiceServer
+}
+
+// NewMockSessionServiceServer creates a new mock instance.
+func NewMockSessionServiceServer(ctrl *gomock.Controller) *MockSessionServiceServer {
+	mock := &MockSessionServiceServer{ctrl: ctrl}
+	mock.recorder = &MockSessionServiceServerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSessionServiceServer) EXPECT() *MockSessionServiceServerMockRecorder {
+	return m.recorder
+}
+
+// ListSessions mocks base method.
+func (m *MockSessionServiceServer) ListSessions(arg0 context.Context, arg1 *ListSessionsRequest) (*ListSessionsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSessions", arg0, arg1)
+	ret0, _ := ret[0].(*ListSessionsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSessions indicates an expected call of ListSessions.
+func (mr *MockSessionServiceServerMockRecorder) ListSessions(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSessions", reflect.TypeOf((*MockSessionServiceServer)(nil).ListSessions), arg0, arg1)
+}
+
+// RevokeSession mocks base method.
+func (m *MockSessionServiceServer) RevokeSession(arg0 context.Context, arg1 *RevokeSessionRequest) (*RevokeSessionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeSession", arg0, arg1)
+	ret0, _ := ret[0].(*RevokeSessionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RevokeSession indicates an expected call of RevokeSession.
+func (mr *MockSessionServiceServerMockRecorder) RevokeSession(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeSession", reflect.TypeOf((*MockSessionServiceServer)(nil).RevokeSession), arg0, arg1)
+}
+
+// mustEmbedUnimplementedSessionServiceServer mocks base method.
+func (m *MockSessionServiceServer) mustEmbedUnimplementedSessionServiceServer() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "mustEmbedUnimplementedSessionServiceServer")
+}
+
+// mustEmbedUnimplementedSessionServiceServer indicates an expected call of mustEmbedUnimplementedSessionServiceServer.
+func (mr *MockSessionServiceServerMockRecorder) mustEmbedUnimplementedSessionServiceServer() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "mustEmbedUnimplementedSessionServiceServer", reflect.TypeOf((*MockSessionServiceServer)(nil).mustEmbedUnimplementedSessionServiceServer))
+}
+
+// MockUnsafeSessionServiceServer is a mock of UnsafeSessionServiceServer interface.
+type MockUnsafeSessionServiceServer struct {
+	ctrl     *gomock.Controller
+	recorder *MockUnsafeSessionServiceServerMockRecorder
+}
+
+// MockUnsafeSessionServiceServerMockRecorder is the mock recorder for MockUnsafeSessionServiceServer.
+type MockUnsafeSessionServiceServerMockRecorder struct {
+	mock *MockUnsafeSessionServiceServer
+}
+
+// NewMockUnsafeSessionServiceServer creates a new mock instance.
+func NewMockUnsafeSessionServiceServer(ctrl *gomock.Controller) *MockUnsafeSessionServiceServer {
+	mock := &MockUnsafeSessionServiceServer{ctrl: ctrl}
+	mock.recorder = &MockUnsafeSessionServiceServerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUnsafeSessionServiceServer) EXPECT() *MockUnsafeSessionServiceServerMockRecorder {
+	return m.recorder
+}
+
+// mustEmbedUnimplementedSessionServiceServer mocks base method.
+func (m *MockUnsafeSessionServiceServer) mustEmbedUnimplementedSessionServiceServer() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "mustEmbedUnimplementedSessionServiceServer")
+}
+
+// mustEmbedUnimplementedSessionServiceServer indicates an expected call of mustEmbedUnimplementedSessionServiceServer.
+func (mr *MockUnsafeSessionServiceServerMockRecorder) mustEmbedUnimplementedSessionServiceServer() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "mustEmbedUnimplementedSessionServiceServer", reflect.TypeOf((*MockUnsafeSessionServiceServer)(nil).mustEmbedUnimplementedSessionServiceServer))
+}
+
+// MockUserDirectoryServiceClient is a mock of UserDirectoryServiceClient interface.
+type MockUserDirectoryServiceClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockUserDirectoryServiceClientMockRecorder
+}
+
+// MockUserDirectoryServiceClientMockRecorder is the mock recorder for MockUserDirectoryServiceClient.
+type MockUserDirectoryServiceClientMockRecorder struct {
+	mock *MockUserDirectoryServiceClient
+}
+
+// NewMockUserDirectoryServiceClient creates a new mock instance.
+func NewMockUserDirectoryServiceClient(ctrl *gomock.Controller) *MockUserDirectoryServiceClient {
+	mock := &MockUserDirectoryServiceClient{ctrl: ctrl}
+	mock.recorder = &MockUserDirectoryServiceClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUserDirectoryServiceClient) EXPECT() *MockUserDirectoryServiceClientMockRecorder {
+	return m.recorder
+}
+
+// GetUsersByIDs mocks base method.
+func (m *MockUserDirectoryServiceClient) GetUsersByIDs(ctx context.Context, in *GetUsersByIDsRequest, opts ...grpc.CallOption) (*GetUsersByIDsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetUsersByIDs", varargs...)
+	ret0, _ := ret[0].(*GetUsersByIDsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUsersByIDs indicates an expected call of GetUsersByIDs.
+func (mr *MockUserDirectoryServiceClientMockRecorder) GetUsersByIDs(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUsersByIDs", reflect.TypeOf((*MockUserDirectoryServiceClient)(nil).GetUsersByIDs), varargs...)
+}
+
+// MockUserDirectoryServiceServer is a mock of UserDirectoryServiceServer interface.
+type MockUserDirectoryServiceServer struct {
+	ctrl     *gomock.Controller
+	recorder *MockUserDirectoryServiceServerMockRecorder
+}
+
+// MockUserDirectoryServiceServerMockRecorder is the mock recorder for MockUserDirectoryServiceServer.
+type MockUserDirectoryServiceServerMockRecorder struct {
+	mock *MockUserDirectoryServiceServer
+}
+
+// NewMockUserDirectoryServiceServer creates a new mock instance.
+func NewMockUserDirectoryServiceServer(ctrl *gomock.Controller) *MockUserDirectoryServiceServer {
+	mock := &MockUserDirectoryServiceServer{ctrl: ctrl}
+	mock.recorder = &MockUserDirectoryServiceServerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUserDirectoryServiceServer) EXPECT() *MockUserDirectoryServiceServerMockRecorder {
+	return m.recorder
+}
+
+// GetUsersByIDs mocks base method.
+func (m *MockUserDirectoryServiceServer) GetUsersByIDs(arg0 context.Context, arg1 *GetUsersByIDsRequest) (*GetUsersByIDsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUsersByIDs", arg0, arg1)
+	ret0, _ := ret[0].(*GetUsersByIDsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUsersByIDs indicates an expected call of GetUsersByIDs.
+func (mr *MockUserDirectoryServiceServerMockRecorder) GetUsersByIDs(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUsersByIDs", reflect.TypeOf((*MockUserDirectoryServiceServer)(nil).GetUsersByIDs), arg0, arg1)
+}
+
+// mustEmbedUnimplementedUserDirectoryServiceServer mocks base method.
+func (m *MockUserDirectoryServiceServer) mustEmbedUnimplementedUserDirectoryServiceServer() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "mustEmbedUnimplementedUserDirectoryServiceServer")
+}
+
+// mustEmbedUnimplementedUserDirectoryServiceServer indicates an expected call of mustEmbedUnimplementedUserDirectoryServiceServer.
+func (mr *MockUserDirectoryServiceServerMockRecorder) mustEmbedUnimplementedUserDirectoryServiceServer() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "mustEmbedUnimplementedUserDirectoryServiceServer", reflect.TypeOf((*MockUserDirectoryServiceServer)(nil).mustEmbedUnimplementedUserDirectoryServiceServer))
+}
+
+// MockUnsafeUserDirectoryServiceServer is a mock of UnsafeUserDirectoryServiceServer interface.
+type MockUnsafeUserDirectoryServiceServer struct {
+	ctrl     *gomock.Controller
+	recorder *MockUnsafeUserDirectoryServiceServerMockRecorder
+}
+
+// MockUnsafeUserDirectoryServiceServerMockRecorder is the mock recorder for MockUnsafeUserDirectoryServiceServer.
+type MockUnsafeUserDirectoryServiceServerMockRecorder struct {
+	mock *MockUnsafeUserDirectoryServiceServer
+}
+
+// NewMockUnsafeUserDirectoryServiceServer creates a new mock instance.
+func NewMockUnsafeUserDirectoryServiceServer(ctrl *gomock.Controller) *MockUnsafeUserDirectoryServiceServer {
+	mock := &MockUnsafeUserDirectoryServiceServer{ctrl: ctrl}
+	mock.recorder = &MockUnsafeUserDirectoryServiceServerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUnsafeUserDirectoryServiceServer) EXPECT() *MockUnsafeUserDirectoryServiceServerMockRecorder {
+	return m.recorder
+}
+
+// mustEmbedUnimplementedUserDirectoryServiceServer mocks base method.
+func (m *MockUnsafeUserDirectoryServiceServer) mustEmbedUnimplementedUserDirectoryServiceServer() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "mustEmbedUnimplementedUserDirectoryServiceServer")
+}
+
+// mustEmbedUnimplementedUserDirectoryServiceServer indicates an expected call of mustEmbedUnimplementedUserDirectoryServiceServer.
+func (mr *MockUnsafeUserDirectoryServiceServerMockRecorder) mustEmbedUnimplementedUserDirectoryServiceServer() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "mustEmbedUnimplementedUserDirectoryServiceServer", reflect.TypeOf((*MockUnsafeUserDirectoryServiceServer)(nil).mustEmbedUnimplementedUserDirectoryServiceServer))
 }
@@ -15,6 +15,8 @@ import (
 	"sortedstartup.com/stream/common/auth"
 	"sortedstartup.com/stream/common/constants"
 	"sortedstartup.com/stream/common/interceptors"
+	"sortedstartup.com/stream/common/pagination"
+	notificationProto "sortedstartup.com/stream/notificationservice/proto"
 	userProto "sortedstartup.com/stream/userservice/proto"
 	"sortedstartup.com/stream/videoservice/config"
 	"sortedstartup.com/stream/videoservice/db"
@@ -27,14 +29,18 @@ type VideoAPI struct {
 	db            *sql.DB
 
 	log       *slog.Logger
-	dbQueries  db.DBQuerier 
+	dbQueries db.DBQuerier
 
 	// gRPC clients for other services
-	userServiceClient userProto.UserServiceClient
+	userServiceClient  userProto.UserServiceClient
+	notificationClient notificationProto.NotificationPublisherServiceClient
 
 	// Policy validator for common video operations
 	policyValidator *VideoPolicyValidator
 
+	// Signs the playback URLs of GetVideo
+	playback *playbackSigner
+
 	//implemented proto server
 	proto.UnimplementedVideoServiceServer
 	channelAPI *ChannelAPI
@@ -46,29 +52,26 @@ type ChannelAPI struct {
 	db            *sql.DB
 
 	log       *slog.Logger
-	dbQueries *db.Queries
+	dbQueries db.DBQuerier
 
 	// gRPC clients for other services
 	userServiceClient   userProto.UserServiceClient
 	tenantServiceClient userProto.TenantServiceClient
+	userDirectoryClient userProto.UserDirectoryServiceClient
+	notificationClient  notificationProto.NotificationPublisherServiceClient
 
 	//implemented proto server
 	proto.UnimplementedChannelServiceServer
 }
 
-func NewVideoAPIProduction(config config.VideoServiceConfig, userServiceClient userProto.UserServiceClient, tenantServiceClient userProto.TenantServiceClient) (*VideoAPI, *ChannelAPI, error) {
+func NewVideoAPIProduction(config config.VideoServiceConfig, authProvider auth.Auth, sessions auth.SessionStore, userServiceClient userProto.UserServiceClient, tenantServiceClient userProto.TenantServiceClient, userDirectoryClient userProto.UserDirectoryServiceClient, notificationClient notificationProto.NotificationPublisherServiceClient) (*VideoAPI, *ChannelAPI, *TenantCleanupAPI, error) {
 	slog.Info("NewVideoAPIProduction")
 
-	fbAuth, err := auth.NewFirebase()
-	if err != nil {
-		return nil, nil, err
-	}
-
 	childLogger := slog.With("service", "VideoAPI")
 
 	_db, err := sql.Open(config.DB.Driver, config.DB.Url)
 	if err != nil {
-		return nil, nil, err
+		return nil, nil, nil, err
 	}
 
 	dbQueries := db.New(_db)
@@ -83,28 +86,43 @@ func NewVideoAPIProduction(config config.VideoServiceConfig, userServiceClient u
 		dbQueries:           dbQueries,
 		userServiceClient:   userServiceClient,
 		tenantServiceClient: tenantServiceClient,
+		userDirectoryClient: userDirectoryClient,
+		notificationClient:  notificationClient,
 	}
 
 	// Create policy validator
 	policyValidator := NewVideoPolicyValidator(dbQueries, userServiceClient, childLogger)
 
+	playback, err := newPlaybackSigner(config.Playback, childLogger)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
 	videoAPI := &VideoAPI{
-		HTTPServerMux:     ServerMux,
-		config:            config,
-		db:                _db,
-		log:               childLogger,
-		dbQueries:         dbQueries,
-		userServiceClient: userServiceClient,
-		policyValidator:   policyValidator,
-		channelAPI:        channelAPI,
+		HTTPServerMux:      ServerMux,
+		config:             config,
+		db:                 _db,
+		log:                childLogger,
+		dbQueries:          dbQueries,
+		userServiceClient:  userServiceClient,
+		notificationClient: notificationClient,
+		policyValidator:    policyValidator,
+		playback:           playback,
+		channelAPI:         channelAPI,
 	}
 
 	// The authentication is handled in mono/main.go
-	ServerMux.Handle("/upload", interceptors.FirebaseHTTPHeaderAuthMiddleware(fbAuth, http.HandlerFunc(videoAPI.uploadHandler)))
-	//the cookie auth middleware is just to allow if the user is logged in
-	ServerMux.Handle("/video/", interceptors.FirebaseCookieAuthMiddleware(fbAuth, http.HandlerFunc(videoAPI.serveVideoHandler)))
+	ServerMux.Handle("/upload", interceptors.HTTPHeaderAuthMiddleware(authProvider, interceptors.RequireScope(auth.ScopeVideosWrite, http.HandlerFunc(videoAPI.uploadHandler))))
+	// Video elements can only send cookies, so playback uses the signed URL of GetVideo or the browser session
+	ServerMux.Handle("/video/", videoAPI.videoHandler(interceptors.CookieAuthMiddleware(sessions, interceptors.RequireScope(auth.ScopeVideosRead, http.HandlerFunc(videoAPI.serveVideoHandler)))))
 
-	return videoAPI, channelAPI, nil
+	tenantCleanupAPI := &TenantCleanupAPI{
+		log:       childLogger,
+		dbQueries: dbQueries,
+		videoDir:  videoAPI.getVideoDir(),
+	}
+
+	return videoAPI, channelAPI, tenantCleanupAPI, nil
 }
 
 func (s *VideoAPI) Start() error {
@@ -194,25 +212,56 @@ func (s *VideoAPI) ListVideos(ctx context.Context, req *proto.ListVideosRequest)
 		return nil, err
 	}
 
-	var videos []db.VideoserviceVideo
+	params, scope, err := videoListParams(req, tenantID, authContext.User.ID)
+	if err != nil {
+		return nil, err
+	}
+	pageSize, err := pageSizeParam(req.PageSize)
+	if err != nil {
+		return nil, err
+	}
+	err = setVideoPageCursor(&params, req.PageToken, scope)
+	if err != nil {
+		return nil, err
+	}
+
+	// One extra row is fetched to know whether there is a next page
+	params.PageSize = int64(pageSize + 1)
+	videos, err := s.dbQueries.GetAccessibleVideosPage(ctx, params)
+	if err != nil {
+		s.log.Error("Error getting videos", "err", err, "tenantID", tenantID, "channelIDs", params.ChannelIds)
+		return nil, status.Error(codes.Internal, "failed to get videos")
+	}
 
-	// Filter videos based on channel_id parameter
-	if req.ChannelId != "" {
-		// Get videos for specific channel
-		videos, err = s.dbQueries.GetVideosByTenantIDAndChannelID(ctx, db.GetVideosByTenantIDAndChannelIDParams{
-			TenantID:  sql.NullString{String: tenantID, Valid: true},
-			ChannelID: sql.NullString{String: req.ChannelId, Valid: true},
-		})
-	} else {
-		// Get all videos user has access to (their private videos + channel videos they're member of)
-		videos, err = s.dbQueries.GetAllAccessibleVideosByTenantID(ctx, db.GetAllAccessibleVideosByTenantIDParams{
-			TenantID: sql.NullString{String: tenantID, Valid: true},
-			UserID:   authContext.User.ID,
-		})
+	totalCount, err := s.dbQueries.CountAccessibleVideos(ctx, db.CountAccessibleVideosParams{
+		TenantID:           params.TenantID,
+		UserID:             params.UserID,
+		FilterChannels:     params.FilterChannels,
+		ChannelIds:         params.ChannelIds,
+		UploaderID:         params.UploaderID,
+		CreatedAfter:       params.CreatedAfter,
+		CreatedBefore:      params.CreatedBefore,
+		Status:             params.Status,
+		MinDurationSeconds: params.MinDurationSeconds,
+		MaxDurationSeconds: params.MaxDurationSeconds,
+	})
+	if err != nil {
+		s.log.Error("Error counting videos", "err", err, "tenantID", tenantID)
+		return nil, status.Error(codes.Internal, "failed to get videos")
 	}
 
+	videos, nextPageToken := pagination.TrimKeyedPage(videos, int(pageSize), scope, func(video db.VideoserviceVideo) string {
+		return video.ID
+	}, videoPageKeyOf)
+
+	// Caller's watch progress on this page, so the UI can show progress bars and "continue watching"
+	videoIDs := make([]string, 0, len(videos))
+	for _, video := range videos {
+		videoIDs = append(videoIDs, video.ID)
+	}
+	progressMap, err := s.getWatchProgressMap(ctx, tenantID, authContext.User.ID, videoIDs)
 	if err != nil {
-		s.log.Error("Error getting videos", "err", err, "tenantID", tenantID, "channelID", req.ChannelId)
+		s.log.Error("Error getting watch progress", "err", err, "tenantID", tenantID)
 		return nil, status.Error(codes.Internal, "failed to get videos")
 	}
 
@@ -220,63 +269,55 @@ func (s *VideoAPI) ListVideos(ctx context.Context, req *proto.ListVideosRequest)
 
 	for _, video := range videos {
 		protoVideos = append(protoVideos, &proto.Video{
-			Id:          video.ID,
-			Title:       video.Title,
-			Description: video.Description,
-			Url:         video.Url,
-			ChannelId:   video.ChannelID.String,              // Include channel_id in response
-			Visibility:  proto.Visibility_VISIBILITY_PRIVATE, // All videos are private for now
-			CreatedAt:   timestamppb.New(video.CreatedAt),
+			Id:              video.ID,
+			Title:           video.Title,
+			Description:     video.Description,
+			Url:             video.Url,
+			ChannelId:       video.ChannelID.String,              // Include channel_id in response
+			Visibility:      proto.Visibility_VISIBILITY_PRIVATE, // All videos are private for now
+			CreatedAt:       timestamppb.New(video.CreatedAt),
+			UserId:          video.UploadedUserID,
+			Status:          videoStatusFromDB(video.Status),
+			DurationSeconds: video.DurationSeconds,
+			WatchProgress:   progressMap[video.ID], // nil if the caller never watched it
 		})
 	}
 
-	return &proto.ListVideosResponse{Videos: protoVideos}, nil
+	return &proto.ListVideosResponse{
+		Videos:        protoVideos,
+		NextPageToken: nextPageToken,
+		TotalCount:    int32(totalCount),
+	}, nil
 }
 
 func (s *VideoAPI) GetVideo(ctx context.Context, req *proto.GetVideoRequest) (*proto.Video, error) {
-	authContext, err := interceptors.AuthFromContext(ctx)
+	// Common validation
+	authContext, tenantID, err := s.policyValidator.ValidateBasicRequest(ctx)
 	if err != nil {
-		s.log.Error("Error getting auth from context", "err", err)
 		return nil, err
 	}
 
-	// Get tenant ID from headers/metadata
-	tenantID, err := interceptors.GetTenantIDFromContext(ctx)
+	// Get video from database with tenant validation
+	video, err := s.policyValidator.GetAndValidateVideo(ctx, req.VideoId, tenantID)
 	if err != nil {
 		return nil, err
 	}
 
-	// Validate user has access to this tenant
-	err = isUserInTenant(ctx, s.userServiceClient, s.log, tenantID, authContext.User.ID)
+	// Other services (e.g. commentservice) rely on this check before exposing data tied to a video
+	err = s.policyValidator.ValidateVideoViewAccess(ctx, s.channelAPI, video, authContext.User.ID, tenantID)
 	if err != nil {
 		return nil, err
 	}
 
-	// Get video from database with tenant validation
-	video, err := s.dbQueries.GetVideoByVideoIDAndTenantID(ctx, db.GetVideoByVideoIDAndTenantIDParams{
-		ID: req.VideoId,
-		TenantID: sql.NullString{
-			String: tenantID,
-			Valid:  true,
-		},
-	})
-	if err != nil {
-		if err == sql.ErrNoRows {
-			return nil, status.Error(codes.NotFound, "video not found")
-		}
-		s.log.Error("Error getting video", "err", err)
-		return nil, status.Error(codes.Internal, "internal error")
-	}
-
 	// Convert to proto message
-	return &proto.Video{
-		Id:          video.ID,
-		Title:       video.Title,
-		Description: video.Description,
-		Url:         video.Url,
-		Visibility:  proto.Visibility_VISIBILITY_PRIVATE, // All videos are private for now
-		CreatedAt:   timestamppb.New(video.CreatedAt),
-	}, nil
+	protoVideo := s.policyValidator.ConvertVideoToProto(video)
+
+	// Players and download managers fetch the file with this URL, they don't need the session cookie
+	playbackURL, expiresAt := s.playback.sign(video.ID, tenantID, authContext.User.ID, time.Now())
+	protoVideo.PlaybackUrl = playbackURL
+	protoVideo.PlaybackUrlExpiresAt = timestamppb.New(expiresAt)
+
+	return protoVideo, nil
 }
 
 // ===== VIDEO-CHANNEL MANAGEMENT METHODS =====
@@ -334,6 +375,9 @@ func (s *VideoAPI) MoveVideoToChannel(ctx context.Context, req *proto.MoveVideoT
 		}
 	}
 
+	// Moving between channels is the same news for the target channel's members as an upload
+	s.notifyChannelVideo(ctx, tenantID, authContext.User, req.ChannelId, updatedVideo.ID, updatedVideo.Title)
+
 	return &proto.MoveVideoToChannelResponse{
 		Message: "Video moved to channel successfully",
 		Video:   s.policyValidator.ConvertVideoToProto(&updatedVideo),
@@ -417,6 +461,11 @@ func (s *VideoAPI) DeleteVideo(ctx context.Context, req *proto.DeleteVideoReques
 		return nil, status.Error(codes.Internal, "failed to delete video")
 	}
 
+	s.publishVideoEvent(ctx, tenantID, authContext.User, notificationProto.EventType_EVENT_TYPE_VIDEO_DELETED, map[string]string{
+		"video_id": video.ID,
+		"title":    video.Title,
+	})
+
 	return &proto.DeleteVideoResponse{
 		Message: "Video deleted successfully",
 	}, nil
@@ -491,7 +540,20 @@ func (s *ChannelAPI) CreateChannel(ctx context.Context, req *proto.CreateChannel
 	}
 
 	if len(req.Name) > 50 {
-    	return nil, status.Errorf(codes.InvalidArgument, "channel name cannot exceed 50 characters")
+		return nil, status.Errorf(codes.InvalidArgument, "channel name cannot exceed 50 characters")
+	}
+
+	privacy, err := privacyFromProto(req.Privacy)
+	if err != nil {
+		return nil, err
+	}
+	defaultRole := req.DefaultRole
+	if defaultRole == "" {
+		defaultRole = constants.ChannelRoleViewer
+	}
+	err = validateDefaultRole(defaultRole)
+	if err != nil {
+		return nil, err
 	}
 
 	// Create channel
@@ -506,6 +568,8 @@ func (s *ChannelAPI) CreateChannel(ctx context.Context, req *proto.CreateChannel
 		CreatedBy:   authContext.User.ID,
 		CreatedAt:   now,
 		UpdatedAt:   now,
+		Privacy:     privacy,
+		DefaultRole: defaultRole,
 	})
 	if err != nil {
 		s.log.Error("Failed to create channel", "error", err)
@@ -537,6 +601,8 @@ func (s *ChannelAPI) CreateChannel(ctx context.Context, req *proto.CreateChannel
 		UpdatedAt:   timestamppb.New(channel.UpdatedAt),
 		UserRole:    constants.ChannelRoleOwner, // Creator is always owner
 		MemberCount: 1,                          // Creator is the first member
+		Privacy:     privacyToProto(channel.Privacy),
+		DefaultRole: channel.DefaultRole,
 	}
 
 	return &proto.CreateChannelResponse{
@@ -563,65 +629,164 @@ func (s *ChannelAPI) GetChannels(ctx context.Context, req *proto.GetChannelsRequ
 		return nil, err
 	}
 
-	// Get all channels in tenant
-	channels, err := s.dbQueries.GetChannelsByTenantID(ctx, tenantID)
+	scope := "channels"
+	pageSize, afterID, err := pageParams(req.PageSize, req.PageToken, scope)
+	if err != nil {
+		return nil, err
+	}
+
+	// Channels the user is a member of, with their role.
+	// One extra row is fetched to know whether there is a next page.
+	channels, err := s.dbQueries.GetChannelsForUserPage(ctx, db.GetChannelsForUserPageParams{
+		TenantID:        tenantID,
+		UserID:          authContext.User.ID,
+		IncludeArchived: req.IncludeArchived,
+		AfterID:         afterID,
+		PageSize:        int64(pageSize + 1),
+	})
 	if err != nil {
 		s.log.Error("Failed to get channels", "error", err)
 		return nil, status.Error(codes.Internal, "failed to get channels")
 	}
 
-	tenantIDParam := sql.NullString{String: tenantID, Valid: true}
-	videoCounts, err := s.dbQueries.GetVideoCountsPerChannelByTenantID(ctx, tenantIDParam)
+	totalCount, err := s.dbQueries.CountChannelsForUser(ctx, db.CountChannelsForUserParams{
+		TenantID:        tenantID,
+		UserID:          authContext.User.ID,
+		IncludeArchived: req.IncludeArchived,
+	})
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to get video counts: %v", err)
+		s.log.Error("Failed to count channels", "error", err)
+		return nil, status.Error(codes.Internal, "failed to get channels")
 	}
 
-	
-	// Build map for lookup
+	channels, nextPageToken := pagination.TrimPage(channels, int(pageSize), scope, func(channel db.GetChannelsForUserPageRow) string {
+		return channel.ID
+	})
+
+	// Video counts of the channels on this page
+	channelIDs := make([]sql.NullString, 0, len(channels))
+	for _, channel := range channels {
+		channelIDs = append(channelIDs, sql.NullString{String: channel.ID, Valid: true})
+	}
 	countMap := make(map[string]int32)
-	for _, vc := range videoCounts {
-		if vc.ChannelID.Valid {
-			countMap[vc.ChannelID.String] = int32(vc.VideoCount)
+	if len(channelIDs) > 0 {
+		videoCounts, err := s.dbQueries.GetVideoCountsByChannelIDs(ctx, db.GetVideoCountsByChannelIDsParams{
+			TenantID:   sql.NullString{String: tenantID, Valid: true},
+			ChannelIds: channelIDs,
+		})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get video counts: %v", err)
+		}
+		for _, vc := range videoCounts {
+			if vc.ChannelID.Valid {
+				countMap[vc.ChannelID.String] = int32(vc.VideoCount)
+			}
 		}
 	}
 
-	// Filter channels where user is a member and include their role
-	var userChannels []*proto.Channel
+	userChannels := make([]*proto.Channel, 0, len(channels))
 	for _, channel := range channels {
-		// Check if user is a member of this channel and get their role
-		userRole, err := s.getUserRoleInChannel(ctx, channel.ID, authContext.User.ID, tenantID)
-		if err == nil {
-			// Create channel proto
-			channelProto := &proto.Channel{
-				Id:          channel.ID,
-				TenantId:    channel.TenantID,
-				Name:        channel.Name,
-				Description: channel.Description.String,
-				CreatedBy:   channel.CreatedBy,
-				CreatedAt:   timestamppb.New(channel.CreatedAt),
-				UpdatedAt:   timestamppb.New(channel.UpdatedAt),
-				UserRole:    userRole, // Include the user's role in this channel
-				VideoCount:  countMap[channel.ID],
-			}
+		channelProto := &proto.Channel{
+			Id:          channel.ID,
+			TenantId:    channel.TenantID,
+			Name:        channel.Name,
+			Description: channel.Description.String,
+			CreatedBy:   channel.CreatedBy,
+			CreatedAt:   timestamppb.New(channel.CreatedAt),
+			UpdatedAt:   timestamppb.New(channel.UpdatedAt),
+			UserRole:    channel.UserRole, // Include the user's role in this channel
+			VideoCount:  countMap[channel.ID],
+			Privacy:     privacyToProto(channel.Privacy),
+			DefaultRole: channel.DefaultRole,
+		}
+		if channel.ArchivedAt.Valid {
+			channelProto.ArchivedAt = timestamppb.New(channel.ArchivedAt.Time)
+		}
 
-			// Only include member count for channel owners
-			if userRole == constants.ChannelRoleOwner {
-				memberCount, err := s.getChannelMemberCount(ctx, channel.ID, tenantID)
-				if err != nil {
-					s.log.Warn("Failed to get member count for channel", "channel_id", channel.ID, "error", err)
-					memberCount = 0 // Default to 0 if we can't get the count
-				}
-				channelProto.MemberCount = memberCount
+		// Only include member count for channel owners
+		if channel.UserRole == constants.ChannelRoleOwner {
+			memberCount, err := s.getChannelMemberCount(ctx, channel.ID, tenantID)
+			if err != nil {
+				s.log.Warn("Failed to get member count for channel", "channel_id", channel.ID, "error", err)
+				memberCount = 0 // Default to 0 if we can't get the count
 			}
-
-			// User is a member, include this channel
-			userChannels = append(userChannels, channelProto)
+			channelProto.MemberCount = memberCount
 		}
+
+		userChannels = append(userChannels, channelProto)
 	}
 
 	return &proto.GetChannelsResponse{
-		Message:  "Channels retrieved successfully",
-		Channels: userChannels,
+		Message:       "Channels retrieved successfully",
+		Channels:      userChannels,
+		NextPageToken: nextPageToken,
+		TotalCount:    int32(totalCount),
+	}, nil
+}
+
+func (s *ChannelAPI) GetChannel(ctx context.Context, req *proto.GetChannelRequest) (*proto.GetChannelResponse, error) {
+	authContext, err := interceptors.AuthFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "unauthenticated")
+	}
+
+	tenantID, err := interceptors.GetTenantIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "tenant ID is required")
+	}
+
+	err = isUserInTenant(ctx, s.userServiceClient, s.log, tenantID, authContext.User.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.ChannelId == "" {
+		return nil, status.Error(codes.InvalidArgument, "channel ID is required")
+	}
+
+	userRole, err := s.getUserRoleInChannel(ctx, req.ChannelId, authContext.User.ID, tenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	channel, err := s.dbQueries.GetChannelByIDAndTenantID(ctx, db.GetChannelByIDAndTenantIDParams{
+		ID:       req.ChannelId,
+		TenantID: tenantID,
+	})
+	if err == sql.ErrNoRows {
+		return nil, status.Error(codes.NotFound, "channel not found")
+	}
+	if err != nil {
+		s.log.Error("Failed to get channel", "error", err, "channelID", req.ChannelId)
+		return nil, status.Error(codes.Internal, "failed to get channel")
+	}
+
+	channelProto := channelToProto(channel, userRole)
+
+	videoCounts, err := s.dbQueries.GetVideoCountsByChannelIDs(ctx, db.GetVideoCountsByChannelIDsParams{
+		TenantID:   sql.NullString{String: tenantID, Valid: true},
+		ChannelIds: []sql.NullString{{String: channel.ID, Valid: true}},
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get video counts: %v", err)
+	}
+	for _, vc := range videoCounts {
+		channelProto.VideoCount = int32(vc.VideoCount)
+	}
+
+	// Only include member count for channel owners
+	if userRole == constants.ChannelRoleOwner {
+		memberCount, err := s.getChannelMemberCount(ctx, channel.ID, tenantID)
+		if err != nil {
+			s.log.Warn("Failed to get member count for channel", "channel_id", channel.ID, "error", err)
+			memberCount = 0 // Default to 0 if we can't get the count
+		}
+		channelProto.MemberCount = memberCount
+	}
+
+	return &proto.GetChannelResponse{
+		Message: "Channel retrieved successfully",
+		Channel: channelProto,
 	}, nil
 }
 
@@ -660,12 +825,37 @@ func (s *ChannelAPI) UpdateChannel(ctx context.Context, req *proto.UpdateChannel
 		return nil, status.Error(codes.PermissionDenied, "access denied: only channel owners can update channels")
 	}
 
+	// Archived channels are read-only
+	current, err := getActiveChannel(ctx, s.dbQueries, s.log, req.ChannelId, tenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Privacy and default role are only changed when given
+	privacy := current.Privacy
+	if req.Privacy != nil {
+		privacy, err = privacyFromProto(*req.Privacy)
+		if err != nil {
+			return nil, err
+		}
+	}
+	defaultRole := current.DefaultRole
+	if req.DefaultRole != nil {
+		defaultRole = *req.DefaultRole
+		err = validateDefaultRole(defaultRole)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	// Update channel
 	channel, err := s.dbQueries.UpdateChannel(ctx, db.UpdateChannelParams{
 		ID:          req.ChannelId,
 		TenantID:    tenantID,
 		Name:        req.Name,
 		Description: sql.NullString{String: req.Description, Valid: req.Description != ""},
+		Privacy:     privacy,
+		DefaultRole: defaultRole,
 		UpdatedAt:   time.Now(),
 	})
 	if err != nil {
@@ -686,6 +876,8 @@ func (s *ChannelAPI) UpdateChannel(ctx context.Context, req *proto.UpdateChannel
 		CreatedAt:   timestamppb.New(channel.CreatedAt),
 		UpdatedAt:   timestamppb.New(channel.UpdatedAt),
 		UserRole:    role, // Include the user's role in the response
+		Privacy:     privacyToProto(channel.Privacy),
+		DefaultRole: channel.DefaultRole,
 	}
 
 	// Include member count since only owners can update channels
@@ -766,12 +958,17 @@ func (s *ChannelAPI) GetMembers(ctx context.Context, req *proto.GetChannelMember
 			continue // Skip members whose user details we can't find
 		}
 
-		protoMembers = append(protoMembers, &proto.ChannelMember{
-			User:      user,
-			Role:      member.Role,
-			AddedBy:   member.AddedBy,
-			CreatedAt: timestamppb.New(member.CreatedAt),
-		})
+		protoMember := &proto.ChannelMember{
+			User:          user,
+			Role:          member.Role,
+			AddedBy:       member.AddedBy,
+			CreatedAt:     timestamppb.New(member.CreatedAt),
+			RoleUpdatedBy: member.RoleUpdatedBy.String,
+		}
+		if member.RoleUpdatedAt.Valid {
+			protoMember.RoleUpdatedAt = timestamppb.New(member.RoleUpdatedAt.Time)
+		}
+		protoMembers = append(protoMembers, protoMember)
 	}
 
 	return &proto.GetChannelMembersResponse{
@@ -821,6 +1018,12 @@ func (s *ChannelAPI) AddMember(ctx context.Context, req *proto.AddChannelMemberR
 		return nil, status.Error(codes.PermissionDenied, "access denied: only channel owners can add members")
 	}
 
+	// Members can still be removed from archived channels, but not added
+	_, err = getActiveChannel(ctx, s.dbQueries, s.log, req.ChannelId, tenantID)
+	if err != nil {
+		return nil, err
+	}
+
 	// Validate that the user being added is a member of the tenant
 	err = isUserInTenant(ctx, s.userServiceClient, s.log, tenantID, req.UserId)
 	if err != nil {
@@ -841,6 +1044,9 @@ func (s *ChannelAPI) AddMember(ctx context.Context, req *proto.AddChannelMemberR
 		return nil, status.Error(codes.Internal, "failed to add channel member")
 	}
 
+	s.notifyMembership(ctx, tenantID, authContext.User, req.ChannelId, req.UserId, true)
+	s.publishMembershipChanged(ctx, tenantID, authContext.User, req.ChannelId, req.UserId, "added", req.Role)
+
 	return &proto.AddChannelMemberResponse{
 		Message: "Member added successfully",
 	}, nil
@@ -900,7 +1106,95 @@ func (s *ChannelAPI) RemoveMember(ctx context.Context, req *proto.RemoveChannelM
 		return nil, status.Error(codes.Internal, "failed to remove channel member")
 	}
 
+	s.notifyMembership(ctx, tenantID, authContext.User, req.ChannelId, req.UserId, false)
+	s.publishMembershipChanged(ctx, tenantID, authContext.User, req.ChannelId, req.UserId, "removed", memberRole)
+
 	return &proto.RemoveChannelMemberResponse{
 		Message: "Member removed successfully",
 	}, nil
 }
+
+func (s *ChannelAPI) UpdateMemberRole(ctx context.Context, req *proto.UpdateChannelMemberRoleRequest) (*proto.UpdateChannelMemberRoleResponse, error) {
+	authContext, err := interceptors.AuthFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "unauthenticated")
+	}
+
+	// Get tenant ID from headers/metadata
+	tenantID, err := interceptors.GetTenantIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "tenant ID is required")
+	}
+
+	// Validate user has access to this tenant
+	err = isUserInTenant(ctx, s.userServiceClient, s.log, tenantID, authContext.User.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validate input
+	if req.ChannelId == "" {
+		return nil, status.Error(codes.InvalidArgument, "channel ID is required")
+	}
+	if req.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "user ID is required")
+	}
+
+	// Validate role
+	err = s.validateChannelRole(req.Role)
+	if err != nil {
+		return nil, err
+	}
+
+	// Check if user is owner of the channel
+	role, err := s.getUserRoleInChannel(ctx, req.ChannelId, authContext.User.ID, tenantID)
+	if err != nil {
+		return nil, err
+	}
+	if role != constants.ChannelRoleOwner {
+		return nil, status.Error(codes.PermissionDenied, "access denied: only channel owners can change member roles")
+	}
+
+	// Archived channels are read-only
+	_, err = getActiveChannel(ctx, s.dbQueries, s.log, req.ChannelId, tenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	memberRole, err := s.getUserRoleInChannel(ctx, req.ChannelId, req.UserId, tenantID)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, "user is not a member of this channel")
+	}
+	if memberRole == req.Role {
+		return &proto.UpdateChannelMemberRoleResponse{
+			Message: "Member already has this role",
+		}, nil
+	}
+
+	// Demoting an owner, possibly the caller, must leave the channel with another owner.
+	// The update checks this itself, so it matches no row for the last owner.
+	updated, err := s.dbQueries.UpdateChannelMemberRole(ctx, db.UpdateChannelMemberRoleParams{
+		Role:          req.Role,
+		RoleUpdatedBy: sql.NullString{String: authContext.User.ID, Valid: true},
+		RoleUpdatedAt: sql.NullTime{Time: time.Now(), Valid: true},
+		ChannelID:     req.ChannelId,
+		UserID:        req.UserId,
+	})
+	if err != nil {
+		s.log.Error("Failed to update channel member role", "error", err, "channelID", req.ChannelId, "userID", req.UserId)
+		return nil, status.Error(codes.Internal, "failed to update member role")
+	}
+	if updated == 0 {
+		if memberRole == constants.ChannelRoleOwner {
+			return nil, status.Error(codes.FailedPrecondition, "cannot demote the last owner of the channel")
+		}
+		return nil, status.Error(codes.NotFound, "user is not a member of this channel")
+	}
+
+	s.log.Info("Channel member role changed", "channelID", req.ChannelId, "userID", req.UserId, "from", memberRole, "to", req.Role, "by", authContext.User.ID)
+	s.publishMembershipChanged(ctx, tenantID, authContext.User, req.ChannelId, req.UserId, "role_changed", req.Role)
+
+	return &proto.UpdateChannelMemberRoleResponse{
+		Message: "Member role updated successfully",
+	}, nil
+}
@@ -0,0 +1,481 @@
+package api
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"sortedstartup.com/stream/common/constants"
+	"sortedstartup.com/stream/common/interceptors"
+	"sortedstartup.com/stream/common/pagination"
+	userProto "sortedstartup.com/stream/userservice/proto"
+	"sortedstartup.com/stream/videoservice/db"
+	"sortedstartup.com/stream/videoservice/proto"
+)
+
+// Status of a channel access request
+const (
+	accessRequestPending  = "pending"
+	accessRequestApproved = "approved"
+	accessRequestDenied   = "denied"
+)
+
+const maxAccessRequestMessageLength = 500
+
+// DiscoverChannels lists the channels of the tenant the caller isn't a member of, open ones can be joined
+// right away and closed ones take an access request. Archived channels are left out.
+func (s *ChannelAPI) DiscoverChannels(ctx context.Context, req *proto.DiscoverChannelsRequest) (*proto.DiscoverChannelsResponse, error) {
+	authContext, err := interceptors.AuthFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "unauthenticated")
+	}
+
+	tenantID, err := interceptors.GetTenantIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "tenant ID is required")
+	}
+
+	err = isUserInTenant(ctx, s.userServiceClient, s.log, tenantID, authContext.User.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	scope := "discover_channels"
+	pageSize, afterID, err := pageParams(req.PageSize, req.PageToken, scope)
+	if err != nil {
+		return nil, err
+	}
+
+	// One extra row is fetched to know whether there is a next page
+	channels, err := s.dbQueries.GetDiscoverableChannelsPage(ctx, db.GetDiscoverableChannelsPageParams{
+		UserID:   authContext.User.ID,
+		TenantID: tenantID,
+		AfterID:  afterID,
+		PageSize: int64(pageSize + 1),
+	})
+	if err != nil {
+		s.log.Error("Failed to get discoverable channels", "error", err)
+		return nil, status.Error(codes.Internal, "failed to get channels")
+	}
+
+	totalCount, err := s.dbQueries.CountDiscoverableChannels(ctx, db.CountDiscoverableChannelsParams{
+		TenantID: tenantID,
+		UserID:   authContext.User.ID,
+	})
+	if err != nil {
+		s.log.Error("Failed to count discoverable channels", "error", err)
+		return nil, status.Error(codes.Internal, "failed to get channels")
+	}
+
+	channels, nextPageToken := pagination.TrimPage(channels, int(pageSize), scope, func(channel db.GetDiscoverableChannelsPageRow) string {
+		return channel.ID
+	})
+
+	discovered := make([]*proto.Channel, 0, len(channels))
+	for _, channel := range channels {
+		discovered = append(discovered, &proto.Channel{
+			Id:              channel.ID,
+			TenantId:        channel.TenantID,
+			Name:            channel.Name,
+			Description:     channel.Description.String,
+			CreatedBy:       channel.CreatedBy,
+			CreatedAt:       timestamppb.New(channel.CreatedAt),
+			UpdatedAt:       timestamppb.New(channel.UpdatedAt),
+			Privacy:         privacyToProto(channel.Privacy),
+			DefaultRole:     channel.DefaultRole,
+			AccessRequested: channel.AccessRequested != 0,
+		})
+	}
+
+	return &proto.DiscoverChannelsResponse{
+		Message:       "Channels retrieved successfully",
+		Channels:      discovered,
+		NextPageToken: nextPageToken,
+		TotalCount:    int32(totalCount),
+	}, nil
+}
+
+func (s *ChannelAPI) JoinChannel(ctx context.Context, req *proto.JoinChannelRequest) (*proto.JoinChannelResponse, error) {
+	authContext, err := interceptors.AuthFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "unauthenticated")
+	}
+
+	tenantID, err := interceptors.GetTenantIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "tenant ID is required")
+	}
+
+	err = isUserInTenant(ctx, s.userServiceClient, s.log, tenantID, authContext.User.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.ChannelId == "" {
+		return nil, status.Error(codes.InvalidArgument, "channel ID is required")
+	}
+
+	channel, err := s.getJoinableChannel(ctx, req.ChannelId, authContext.User.ID, tenantID)
+	if err != nil {
+		return nil, err
+	}
+	if channel.Privacy != constants.ChannelPrivacyOpen {
+		return nil, status.Error(codes.FailedPrecondition, "channel is closed, request access instead")
+	}
+
+	_, err = s.dbQueries.CreateChannelMember(ctx, db.CreateChannelMemberParams{
+		ID:        uuid.New().String(),
+		ChannelID: channel.ID,
+		UserID:    authContext.User.ID,
+		Role:      channel.DefaultRole,
+		AddedBy:   authContext.User.ID,
+		CreatedAt: time.Now(),
+	})
+	if err != nil {
+		s.log.Error("Failed to join channel", "error", err, "channelID", channel.ID)
+		return nil, status.Error(codes.Internal, "failed to join channel")
+	}
+
+	s.publishMembershipChanged(ctx, tenantID, authContext.User, channel.ID, authContext.User.ID, "joined", channel.DefaultRole)
+
+	return &proto.JoinChannelResponse{
+		Message: "Joined channel successfully",
+		Channel: channelToProto(channel, channel.DefaultRole),
+	}, nil
+}
+
+func (s *ChannelAPI) RequestAccess(ctx context.Context, req *proto.RequestChannelAccessRequest) (*proto.RequestChannelAccessResponse, error) {
+	authContext, err := interceptors.AuthFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "unauthenticated")
+	}
+
+	tenantID, err := interceptors.GetTenantIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "tenant ID is required")
+	}
+
+	err = isUserInTenant(ctx, s.userServiceClient, s.log, tenantID, authContext.User.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.ChannelId == "" {
+		return nil, status.Error(codes.InvalidArgument, "channel ID is required")
+	}
+	if len(req.Message) > maxAccessRequestMessageLength {
+		return nil, status.Errorf(codes.InvalidArgument, "message cannot exceed %d characters", maxAccessRequestMessageLength)
+	}
+
+	channel, err := s.getJoinableChannel(ctx, req.ChannelId, authContext.User.ID, tenantID)
+	if err != nil {
+		return nil, err
+	}
+	if channel.Privacy == constants.ChannelPrivacyOpen {
+		return nil, status.Error(codes.FailedPrecondition, "channel is open, join it instead")
+	}
+
+	_, err = s.dbQueries.GetPendingChannelAccessRequest(ctx, db.GetPendingChannelAccessRequestParams{
+		ChannelID: channel.ID,
+		UserID:    authContext.User.ID,
+	})
+	if err == nil {
+		return nil, status.Error(codes.AlreadyExists, "access to this channel was already requested")
+	}
+	if err != sql.ErrNoRows {
+		s.log.Error("Failed to check pending access request", "error", err, "channelID", channel.ID)
+		return nil, status.Error(codes.Internal, "failed to request access")
+	}
+
+	accessRequest, err := s.dbQueries.CreateChannelAccessRequest(ctx, db.CreateChannelAccessRequestParams{
+		ID:        uuid.New().String(),
+		ChannelID: channel.ID,
+		TenantID:  tenantID,
+		UserID:    authContext.User.ID,
+		Message:   req.Message,
+		CreatedAt: time.Now(),
+	})
+	if err != nil {
+		s.log.Error("Failed to create access request", "error", err, "channelID", channel.ID)
+		return nil, status.Error(codes.Internal, "failed to request access")
+	}
+
+	// The owners decide, so they are the ones told about it
+	members, err := s.dbQueries.GetChannelMembersByChannelIDAndTenantID(ctx, db.GetChannelMembersByChannelIDAndTenantIDParams{
+		ChannelID: channel.ID,
+		TenantID:  tenantID,
+	})
+	if err != nil {
+		s.log.Error("Error getting channel owners to notify", "err", err, "channelID", channel.ID)
+	}
+	var ownerIDs []string
+	for _, member := range members {
+		if member.Role == constants.ChannelRoleOwner {
+			ownerIDs = append(ownerIDs, member.UserID)
+		}
+	}
+	s.notifyChannelAccess(ctx, tenantID, authContext.User, channel.ID, ownerIDs, fmt.Sprintf("%s asked to join %s", authContext.User.Name, channel.Name))
+
+	return &proto.RequestChannelAccessResponse{
+		Message:       "Access requested successfully",
+		AccessRequest: accessRequestToProto(accessRequest),
+	}, nil
+}
+
+func (s *ChannelAPI) GetAccessRequests(ctx context.Context, req *proto.GetChannelAccessRequestsRequest) (*proto.GetChannelAccessRequestsResponse, error) {
+	_, tenantID, err := s.requireChannelOwner(ctx, req.ChannelId, "review access requests to")
+	if err != nil {
+		return nil, err
+	}
+
+	requests, err := s.dbQueries.GetPendingChannelAccessRequests(ctx, db.GetPendingChannelAccessRequestsParams{
+		ChannelID: req.ChannelId,
+		TenantID:  tenantID,
+	})
+	if err != nil {
+		s.log.Error("Failed to get access requests", "error", err, "channelID", req.ChannelId)
+		return nil, status.Error(codes.Internal, "failed to get access requests")
+	}
+
+	// Owners decide on people, not IDs. The directory is used because tenant GetUsers is for super admins only.
+	userIDs := make([]string, 0, len(requests))
+	for _, request := range requests {
+		userIDs = append(userIDs, request.UserID)
+	}
+	userMap := make(map[string]*userProto.User)
+	if len(userIDs) > 0 {
+		usersResp, err := s.userDirectoryClient.GetUsersByIDs(ctx, &userProto.GetUsersByIDsRequest{UserIds: userIDs})
+		if err != nil {
+			s.log.Error("failed to get users by IDs", "error", err)
+			return nil, status.Error(codes.Internal, "failed to get user details")
+		}
+		for _, user := range usersResp.Users {
+			userMap[user.Id] = user
+		}
+	}
+
+	protoRequests := make([]*proto.ChannelAccessRequest, 0, len(requests))
+	for _, request := range requests {
+		protoRequest := accessRequestToProto(request)
+		protoRequest.User = userMap[request.UserID]
+		protoRequests = append(protoRequests, protoRequest)
+	}
+
+	return &proto.GetChannelAccessRequestsResponse{
+		Message:        "Access requests retrieved successfully",
+		AccessRequests: protoRequests,
+	}, nil
+}
+
+func (s *ChannelAPI) ApproveAccessRequest(ctx context.Context, req *proto.ApproveChannelAccessRequest) (*proto.ApproveChannelAccessResponse, error) {
+	request, tenantID, err := s.getAccessRequestToDecide(ctx, req.RequestId, "approve access requests to")
+	if err != nil {
+		return nil, err
+	}
+	authContext, err := interceptors.AuthFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "unauthenticated")
+	}
+
+	// Archived channels don't get new members
+	channel, err := getActiveChannel(ctx, s.dbQueries, s.log, request.ChannelID, tenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	role := req.Role
+	if role == "" {
+		role = channel.DefaultRole
+	}
+	err = s.validateChannelRole(role)
+	if err != nil {
+		return nil, err
+	}
+
+	// The member is added first, a request that can't be marked approved stays pending and can be approved again
+	_, err = s.getUserRoleInChannel(ctx, request.ChannelID, request.UserID, tenantID)
+	if err != nil && status.Code(err) != codes.PermissionDenied {
+		return nil, err
+	}
+	alreadyMember := err == nil
+	if !alreadyMember {
+		_, err = s.dbQueries.CreateChannelMember(ctx, db.CreateChannelMemberParams{
+			ID:        uuid.New().String(),
+			ChannelID: request.ChannelID,
+			UserID:    request.UserID,
+			Role:      role,
+			AddedBy:   authContext.User.ID,
+			CreatedAt: time.Now(),
+		})
+		if err != nil {
+			s.log.Error("Failed to add channel member", "error", err, "channelID", request.ChannelID, "userID", request.UserID)
+			return nil, status.Error(codes.Internal, "failed to approve access request")
+		}
+	}
+
+	err = s.decideAccessRequest(ctx, request.ID, authContext.User.ID, accessRequestApproved)
+	if err != nil {
+		return nil, err
+	}
+
+	if !alreadyMember {
+		s.notifyMembership(ctx, tenantID, authContext.User, request.ChannelID, request.UserID, true)
+		s.publishMembershipChanged(ctx, tenantID, authContext.User, request.ChannelID, request.UserID, "added", role)
+	}
+
+	return &proto.ApproveChannelAccessResponse{
+		Message: "Access request approved",
+	}, nil
+}
+
+func (s *ChannelAPI) DenyAccessRequest(ctx context.Context, req *proto.DenyChannelAccessRequest) (*proto.DenyChannelAccessResponse, error) {
+	request, tenantID, err := s.getAccessRequestToDecide(ctx, req.RequestId, "deny access requests to")
+	if err != nil {
+		return nil, err
+	}
+	authContext, err := interceptors.AuthFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "unauthenticated")
+	}
+
+	err = s.decideAccessRequest(ctx, request.ID, authContext.User.ID, accessRequestDenied)
+	if err != nil {
+		return nil, err
+	}
+
+	channel, err := s.dbQueries.GetChannelByIDAndTenantID(ctx, db.GetChannelByIDAndTenantIDParams{
+		ID:       request.ChannelID,
+		TenantID: tenantID,
+	})
+	if err != nil {
+		s.log.Error("Error getting channel to notify", "err", err, "channelID", request.ChannelID)
+	} else {
+		s.notifyChannelAccess(ctx, tenantID, authContext.User, request.ChannelID, []string{request.UserID}, fmt.Sprintf("%s declined your request to join %s", authContext.User.Name, channel.Name))
+	}
+
+	return &proto.DenyChannelAccessResponse{
+		Message: "Access request denied",
+	}, nil
+}
+
+// getJoinableChannel returns an active channel of the tenant the user isn't a member of yet
+func (s *ChannelAPI) getJoinableChannel(ctx context.Context, channelID, userID, tenantID string) (db.VideoserviceChannel, error) {
+	channel, err := getActiveChannel(ctx, s.dbQueries, s.log, channelID, tenantID)
+	if err != nil {
+		return db.VideoserviceChannel{}, err
+	}
+
+	_, err = s.getUserRoleInChannel(ctx, channelID, userID, tenantID)
+	if err == nil {
+		return db.VideoserviceChannel{}, status.Error(codes.AlreadyExists, "you are already a member of this channel")
+	}
+	if status.Code(err) != codes.PermissionDenied {
+		return db.VideoserviceChannel{}, err
+	}
+	return channel, nil
+}
+
+// getAccessRequestToDecide returns a pending access request to one of the caller's channels
+func (s *ChannelAPI) getAccessRequestToDecide(ctx context.Context, requestID, action string) (db.VideoserviceChannelAccessRequest, string, error) {
+	tenantID, err := interceptors.GetTenantIDFromContext(ctx)
+	if err != nil {
+		return db.VideoserviceChannelAccessRequest{}, "", status.Error(codes.InvalidArgument, "tenant ID is required")
+	}
+	if requestID == "" {
+		return db.VideoserviceChannelAccessRequest{}, "", status.Error(codes.InvalidArgument, "request ID is required")
+	}
+
+	request, err := s.dbQueries.GetChannelAccessRequestByID(ctx, db.GetChannelAccessRequestByIDParams{
+		ID:       requestID,
+		TenantID: tenantID,
+	})
+	if err == sql.ErrNoRows {
+		return db.VideoserviceChannelAccessRequest{}, "", status.Error(codes.NotFound, "access request not found")
+	}
+	if err != nil {
+		s.log.Error("Failed to get access request", "error", err, "requestID", requestID)
+		return db.VideoserviceChannelAccessRequest{}, "", status.Error(codes.Internal, "failed to get access request")
+	}
+
+	_, _, err = s.requireChannelOwner(ctx, request.ChannelID, action)
+	if err != nil {
+		return db.VideoserviceChannelAccessRequest{}, "", err
+	}
+
+	if request.Status != accessRequestPending {
+		return db.VideoserviceChannelAccessRequest{}, "", status.Error(codes.FailedPrecondition, "access request was already decided")
+	}
+	return request, tenantID, nil
+}
+
+func (s *ChannelAPI) decideAccessRequest(ctx context.Context, requestID, userID, decision string) error {
+	decided, err := s.dbQueries.DecideChannelAccessRequest(ctx, db.DecideChannelAccessRequestParams{
+		Status:    decision,
+		DecidedBy: sql.NullString{String: userID, Valid: true},
+		DecidedAt: sql.NullTime{Time: time.Now(), Valid: true},
+		ID:        requestID,
+	})
+	if err != nil {
+		s.log.Error("Failed to decide access request", "error", err, "requestID", requestID, "decision", decision)
+		return status.Error(codes.Internal, "failed to decide access request")
+	}
+	// Another owner got there first
+	if decided == 0 {
+		return status.Error(codes.FailedPrecondition, "access request was already decided")
+	}
+	return nil
+}
+
+// validateDefaultRole checks the role of members joining an open channel, they can't join as owners
+func validateDefaultRole(role string) error {
+	if role != constants.ChannelRoleUploader && role != constants.ChannelRoleViewer {
+		return status.Error(codes.InvalidArgument, "invalid default role. Valid roles are: uploader, viewer")
+	}
+	return nil
+}
+
+func privacyFromProto(privacy proto.ChannelPrivacy) (string, error) {
+	switch privacy {
+	case proto.ChannelPrivacy_CHANNEL_PRIVACY_CLOSED:
+		return constants.ChannelPrivacyClosed, nil
+	case proto.ChannelPrivacy_CHANNEL_PRIVACY_OPEN:
+		return constants.ChannelPrivacyOpen, nil
+	default:
+		return "", status.Error(codes.InvalidArgument, "invalid channel privacy")
+	}
+}
+
+func privacyToProto(privacy string) proto.ChannelPrivacy {
+	if privacy == constants.ChannelPrivacyOpen {
+		return proto.ChannelPrivacy_CHANNEL_PRIVACY_OPEN
+	}
+	return proto.ChannelPrivacy_CHANNEL_PRIVACY_CLOSED
+}
+
+func accessRequestToProto(request db.VideoserviceChannelAccessRequest) *proto.ChannelAccessRequest {
+	protoRequest := &proto.ChannelAccessRequest{
+		Id:        request.ID,
+		ChannelId: request.ChannelID,
+		UserId:    request.UserID,
+		Message:   request.Message,
+		CreatedAt: timestamppb.New(request.CreatedAt),
+		DecidedBy: request.DecidedBy.String,
+	}
+	switch request.Status {
+	case accessRequestApproved:
+		protoRequest.Status = proto.ChannelAccessRequestStatus_CHANNEL_ACCESS_REQUEST_STATUS_APPROVED
+	case accessRequestDenied:
+		protoRequest.Status = proto.ChannelAccessRequestStatus_CHANNEL_ACCESS_REQUEST_STATUS_DENIED
+	default:
+		protoRequest.Status = proto.ChannelAccessRequestStatus_CHANNEL_ACCESS_REQUEST_STATUS_PENDING
+	}
+	if request.DecidedAt.Valid {
+		protoRequest.DecidedAt = timestamppb.New(request.DecidedAt.Time)
+	}
+	return protoRequest
+}
@@ -0,0 +1,263 @@
+package api
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	notificationProto "sortedstartup.com/stream/notificationservice/proto"
+	userProto "sortedstartup.com/stream/userservice/proto"
+	"sortedstartup.com/stream/videoservice/db"
+	"sortedstartup.com/stream/videoservice/db/mocks"
+	"sortedstartup.com/stream/videoservice/proto"
+)
+
+// helper: the caller isn't a member of the channel
+func expectNotMember(mockDB *mocks.MockDBQuerier, channelID string) *gomock.Call {
+	return mockDB.EXPECT().
+		GetUserRoleInChannel(gomock.Any(), db.GetUserRoleInChannelParams{ChannelID: channelID, UserID: "test-user-id", TenantID: "test-tenant"}).
+		Return("", sql.ErrNoRows)
+}
+
+func TestJoinChannel_OpenChannel(t *testing.T) {
+	api, mockDB := createTestChannelAPI(t)
+
+	mockDB.EXPECT().
+		GetChannelByIDAndTenantID(gomock.Any(), gomock.Any()).
+		Return(db.VideoserviceChannel{ID: "channel-1", Privacy: "open", DefaultRole: "uploader"}, nil)
+	expectNotMember(mockDB, "channel-1")
+
+	// Members of open channels join with the channel's default role
+	mockDB.EXPECT().
+		CreateChannelMember(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, params db.CreateChannelMemberParams) (db.VideoserviceChannelMember, error) {
+			if params.ChannelID != "channel-1" || params.UserID != "test-user-id" || params.Role != "uploader" || params.AddedBy != "test-user-id" {
+				t.Errorf("Unexpected member %+v", params)
+			}
+			return db.VideoserviceChannelMember{}, nil
+		})
+
+	mockDB.EXPECT().
+		GetChannelMembersByChannelIDAndTenantID(gomock.Any(), gomock.Any()).
+		Return(nil, nil)
+	mockNotifications := notificationProto.NewMockNotificationPublisherServiceClient(gomock.NewController(t))
+	mockNotifications.EXPECT().
+		PublishEvent(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, req *notificationProto.PublishEventRequest, opts ...grpc.CallOption) (*notificationProto.PublishEventResponse, error) {
+			if req.Data["change"] != "joined" || req.Data["role"] != "uploader" {
+				t.Errorf("Unexpected event %v", req)
+			}
+			return &notificationProto.PublishEventResponse{}, nil
+		})
+	api.notificationClient = mockNotifications
+
+	resp, err := api.JoinChannel(tenantCtx(t), &proto.JoinChannelRequest{ChannelId: "channel-1"})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if resp.Channel.UserRole != "uploader" || resp.Channel.Privacy != proto.ChannelPrivacy_CHANNEL_PRIVACY_OPEN {
+		t.Errorf("Unexpected channel %v", resp.Channel)
+	}
+}
+
+func TestJoinChannel_Refused(t *testing.T) {
+	api, mockDB := createTestChannelAPI(t)
+
+	// Closed channels take an access request
+	mockDB.EXPECT().
+		GetChannelByIDAndTenantID(gomock.Any(), gomock.Any()).
+		Return(db.VideoserviceChannel{ID: "channel-1", Privacy: "closed", DefaultRole: "viewer"}, nil)
+	expectNotMember(mockDB, "channel-1")
+	_, err := api.JoinChannel(tenantCtx(t), &proto.JoinChannelRequest{ChannelId: "channel-1"})
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("Expected FailedPrecondition, got %v", err)
+	}
+
+	// Members can't join again
+	mockDB.EXPECT().
+		GetChannelByIDAndTenantID(gomock.Any(), gomock.Any()).
+		Return(db.VideoserviceChannel{ID: "channel-1", Privacy: "open", DefaultRole: "viewer"}, nil)
+	expectChannelRole(mockDB, "channel-1", "viewer")
+	_, err = api.JoinChannel(tenantCtx(t), &proto.JoinChannelRequest{ChannelId: "channel-1"})
+	if status.Code(err) != codes.AlreadyExists {
+		t.Errorf("Expected AlreadyExists, got %v", err)
+	}
+}
+
+func TestRequestAccess_NotifiesOwners(t *testing.T) {
+	api, mockDB := createTestChannelAPI(t)
+
+	mockDB.EXPECT().
+		GetChannelByIDAndTenantID(gomock.Any(), gomock.Any()).
+		Return(db.VideoserviceChannel{ID: "channel-1", Name: "Design", Privacy: "closed"}, nil)
+	expectNotMember(mockDB, "channel-1")
+	mockDB.EXPECT().
+		GetPendingChannelAccessRequest(gomock.Any(), db.GetPendingChannelAccessRequestParams{ChannelID: "channel-1", UserID: "test-user-id"}).
+		Return(db.VideoserviceChannelAccessRequest{}, sql.ErrNoRows)
+	mockDB.EXPECT().
+		CreateChannelAccessRequest(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, params db.CreateChannelAccessRequestParams) (db.VideoserviceChannelAccessRequest, error) {
+			if params.ChannelID != "channel-1" || params.TenantID != "test-tenant" || params.Message != "Please" {
+				t.Errorf("Unexpected access request %+v", params)
+			}
+			return db.VideoserviceChannelAccessRequest{ID: params.ID, ChannelID: params.ChannelID, UserID: params.UserID, Status: "pending"}, nil
+		})
+
+	// Only the owners hear about it
+	mockDB.EXPECT().
+		GetChannelMembersByChannelIDAndTenantID(gomock.Any(), gomock.Any()).
+		Return([]db.GetChannelMembersByChannelIDAndTenantIDRow{
+			{UserID: "owner-1", Role: "owner"},
+			{UserID: "viewer-1", Role: "viewer"},
+		}, nil)
+	mockNotifications := notificationProto.NewMockNotificationPublisherServiceClient(gomock.NewController(t))
+	mockNotifications.EXPECT().
+		Publish(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, req *notificationProto.PublishRequest, opts ...grpc.CallOption) (*notificationProto.PublishResponse, error) {
+			if len(req.RecipientIds) != 1 || req.RecipientIds[0] != "owner-1" {
+				t.Errorf("Expected only the owner to be notified, got %v", req.RecipientIds)
+			}
+			return &notificationProto.PublishResponse{}, nil
+		})
+	api.notificationClient = mockNotifications
+
+	resp, err := api.RequestAccess(tenantCtx(t), &proto.RequestChannelAccessRequest{ChannelId: "channel-1", Message: "Please"})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if resp.AccessRequest.Status != proto.ChannelAccessRequestStatus_CHANNEL_ACCESS_REQUEST_STATUS_PENDING {
+		t.Errorf("Expected a pending request, got %v", resp.AccessRequest.Status)
+	}
+
+	// A second request waits for the first one
+	mockDB.EXPECT().
+		GetChannelByIDAndTenantID(gomock.Any(), gomock.Any()).
+		Return(db.VideoserviceChannel{ID: "channel-1", Privacy: "closed"}, nil)
+	expectNotMember(mockDB, "channel-1")
+	mockDB.EXPECT().
+		GetPendingChannelAccessRequest(gomock.Any(), gomock.Any()).
+		Return(db.VideoserviceChannelAccessRequest{ID: "request-1"}, nil)
+	_, err = api.RequestAccess(tenantCtx(t), &proto.RequestChannelAccessRequest{ChannelId: "channel-1"})
+	if status.Code(err) != codes.AlreadyExists {
+		t.Errorf("Expected AlreadyExists, got %v", err)
+	}
+}
+
+func TestApproveAccessRequest_AddsMember(t *testing.T) {
+	api, mockDB := createTestChannelAPI(t)
+
+	mockDB.EXPECT().
+		GetChannelAccessRequestByID(gomock.Any(), db.GetChannelAccessRequestByIDParams{ID: "request-1", TenantID: "test-tenant"}).
+		Return(db.VideoserviceChannelAccessRequest{ID: "request-1", ChannelID: "channel-1", UserID: "member-1", Status: "pending"}, nil)
+	expectChannelRole(mockDB, "channel-1", "owner")
+	mockDB.EXPECT().
+		GetChannelByIDAndTenantID(gomock.Any(), gomock.Any()).
+		Return(db.VideoserviceChannel{ID: "channel-1", Privacy: "closed", DefaultRole: "viewer"}, nil).
+		Times(2)
+	mockDB.EXPECT().
+		GetUserRoleInChannel(gomock.Any(), db.GetUserRoleInChannelParams{ChannelID: "channel-1", UserID: "member-1", TenantID: "test-tenant"}).
+		Return("", sql.ErrNoRows)
+
+	// The member is added before the request is marked approved
+	gomock.InOrder(
+		mockDB.EXPECT().
+			CreateChannelMember(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, params db.CreateChannelMemberParams) (db.VideoserviceChannelMember, error) {
+				if params.UserID != "member-1" || params.Role != "viewer" || params.AddedBy != "test-user-id" {
+					t.Errorf("Unexpected member %+v", params)
+				}
+				return db.VideoserviceChannelMember{}, nil
+			}),
+		mockDB.EXPECT().
+			DecideChannelAccessRequest(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, params db.DecideChannelAccessRequestParams) (int64, error) {
+				if params.ID != "request-1" || params.Status != "approved" || params.DecidedBy.String != "test-user-id" {
+					t.Errorf("Unexpected decision %+v", params)
+				}
+				return 1, nil
+			}),
+	)
+
+	mockDB.EXPECT().
+		GetChannelMembersByChannelIDAndTenantID(gomock.Any(), gomock.Any()).
+		Return(nil, nil)
+	mockNotifications := notificationProto.NewMockNotificationPublisherServiceClient(gomock.NewController(t))
+	mockNotifications.EXPECT().Publish(gomock.Any(), gomock.Any()).Return(&notificationProto.PublishResponse{}, nil)
+	mockNotifications.EXPECT().PublishEvent(gomock.Any(), gomock.Any()).Return(&notificationProto.PublishEventResponse{}, nil)
+	api.notificationClient = mockNotifications
+
+	_, err := api.ApproveAccessRequest(tenantCtx(t), &proto.ApproveChannelAccessRequest{RequestId: "request-1"})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+}
+
+func TestDenyAccessRequest_Refused(t *testing.T) {
+	api, mockDB := createTestChannelAPI(t)
+
+	// Only owners of the channel decide
+	mockDB.EXPECT().
+		GetChannelAccessRequestByID(gomock.Any(), gomock.Any()).
+		Return(db.VideoserviceChannelAccessRequest{ID: "request-1", ChannelID: "channel-1", UserID: "member-1", Status: "pending"}, nil)
+	expectChannelRole(mockDB, "channel-1", "uploader")
+	_, err := api.DenyAccessRequest(tenantCtx(t), &proto.DenyChannelAccessRequest{RequestId: "request-1"})
+	if status.Code(err) != codes.PermissionDenied {
+		t.Errorf("Expected PermissionDenied, got %v", err)
+	}
+
+	// Another owner got there first
+	mockDB.EXPECT().
+		GetChannelAccessRequestByID(gomock.Any(), gomock.Any()).
+		Return(db.VideoserviceChannelAccessRequest{ID: "request-1", ChannelID: "channel-1", UserID: "member-1", Status: "pending"}, nil)
+	expectChannelRole(mockDB, "channel-1", "owner")
+	mockDB.EXPECT().DecideChannelAccessRequest(gomock.Any(), gomock.Any()).Return(int64(0), nil)
+	_, err = api.DenyAccessRequest(tenantCtx(t), &proto.DenyChannelAccessRequest{RequestId: "request-1"})
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("Expected FailedPrecondition, got %v", err)
+	}
+
+	// Decided requests stay decided
+	mockDB.EXPECT().
+		GetChannelAccessRequestByID(gomock.Any(), gomock.Any()).
+		Return(db.VideoserviceChannelAccessRequest{ID: "request-1", ChannelID: "channel-1", UserID: "member-1", Status: "approved"}, nil)
+	expectChannelRole(mockDB, "channel-1", "owner")
+	_, err = api.DenyAccessRequest(tenantCtx(t), &proto.DenyChannelAccessRequest{RequestId: "request-1"})
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("Expected FailedPrecondition, got %v", err)
+	}
+}
+
+func TestGetAccessRequests_MemberOwner(t *testing.T) {
+	api, mockDB := createTestChannelAPI(t)
+	ctrl := gomock.NewController(t)
+
+	// The owner is a plain tenant member, tenant GetUsers would refuse them so it must not be called
+	api.tenantServiceClient = userProto.NewMockTenantServiceClient(ctrl)
+	mockDirectory := userProto.NewMockUserDirectoryServiceClient(ctrl)
+	mockDirectory.EXPECT().
+		GetUsersByIDs(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, req *userProto.GetUsersByIDsRequest, opts ...grpc.CallOption) (*userProto.GetUsersByIDsResponse, error) {
+			if len(req.UserIds) != 1 || req.UserIds[0] != "member-1" {
+				t.Errorf("Unexpected user IDs %v", req.UserIds)
+			}
+			return &userProto.GetUsersByIDsResponse{Users: []*userProto.User{{Id: "member-1", Email: "member-1@example.com"}}}, nil
+		})
+	api.userDirectoryClient = mockDirectory
+
+	expectChannelRole(mockDB, "channel-1", "owner")
+	mockDB.EXPECT().
+		GetPendingChannelAccessRequests(gomock.Any(), db.GetPendingChannelAccessRequestsParams{ChannelID: "channel-1", TenantID: "test-tenant"}).
+		Return([]db.VideoserviceChannelAccessRequest{{ID: "request-1", ChannelID: "channel-1", UserID: "member-1", Status: "pending"}}, nil)
+
+	resp, err := api.GetAccessRequests(tenantCtx(t), &proto.GetChannelAccessRequestsRequest{ChannelId: "channel-1"})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if len(resp.AccessRequests) != 1 || resp.AccessRequests[0].User.GetEmail() != "member-1@example.com" {
+		t.Errorf("Unexpected access requests %v", resp.AccessRequests)
+	}
+}
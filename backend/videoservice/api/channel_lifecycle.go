@@ -0,0 +1,268 @@
+package api
+
+import (
+	"context"
+	"database/sql"
+	"log/slog"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"sortedstartup.com/stream/common/constants"
+	"sortedstartup.com/stream/common/interceptors"
+	"sortedstartup.com/stream/videoservice/db"
+	"sortedstartup.com/stream/videoservice/proto"
+)
+
+// getActiveChannel returns a channel that can still be changed. Archived channels are read-only:
+// no uploads, no videos moved in or out, no edits and no new members.
+func getActiveChannel(ctx context.Context, dbQueries db.DBQuerier, log *slog.Logger, channelID, tenantID string) (db.VideoserviceChannel, error) {
+	channel, err := dbQueries.GetChannelByIDAndTenantID(ctx, db.GetChannelByIDAndTenantIDParams{
+		ID:       channelID,
+		TenantID: tenantID,
+	})
+	if err == sql.ErrNoRows {
+		return db.VideoserviceChannel{}, status.Error(codes.NotFound, "channel not found")
+	}
+	if err != nil {
+		log.Error("Failed to get channel", "error", err, "channelID", channelID)
+		return db.VideoserviceChannel{}, status.Error(codes.Internal, "failed to get channel")
+	}
+	if channel.ArchivedAt.Valid {
+		return db.VideoserviceChannel{}, status.Error(codes.FailedPrecondition, "channel is archived")
+	}
+	return channel, nil
+}
+
+// requireChannelOwner checks the tenant and channel access of the caller, only channel owners get through
+func (s *ChannelAPI) requireChannelOwner(ctx context.Context, channelID, action string) (userID, tenantID string, err error) {
+	authContext, err := interceptors.AuthFromContext(ctx)
+	if err != nil {
+		return "", "", status.Error(codes.Unauthenticated, "unauthenticated")
+	}
+
+	tenantID, err = interceptors.GetTenantIDFromContext(ctx)
+	if err != nil {
+		return "", "", status.Error(codes.InvalidArgument, "tenant ID is required")
+	}
+
+	err = isUserInTenant(ctx, s.userServiceClient, s.log, tenantID, authContext.User.ID)
+	if err != nil {
+		return "", "", err
+	}
+
+	if channelID == "" {
+		return "", "", status.Error(codes.InvalidArgument, "channel ID is required")
+	}
+
+	role, err := s.getUserRoleInChannel(ctx, channelID, authContext.User.ID, tenantID)
+	if err != nil {
+		return "", "", err
+	}
+	if role != constants.ChannelRoleOwner {
+		return "", "", status.Error(codes.PermissionDenied, "access denied: only channel owners can "+action+" channels")
+	}
+
+	return authContext.User.ID, tenantID, nil
+}
+
+func (s *ChannelAPI) ArchiveChannel(ctx context.Context, req *proto.ArchiveChannelRequest) (*proto.ArchiveChannelResponse, error) {
+	userID, tenantID, err := s.requireChannelOwner(ctx, req.ChannelId, "archive")
+	if err != nil {
+		return nil, err
+	}
+
+	archived, err := s.dbQueries.ArchiveChannel(ctx, db.ArchiveChannelParams{
+		ArchivedAt: sql.NullTime{Time: time.Now(), Valid: true},
+		ArchivedBy: sql.NullString{String: userID, Valid: true},
+		ID:         req.ChannelId,
+		TenantID:   tenantID,
+	})
+	if err != nil {
+		s.log.Error("Failed to archive channel", "error", err, "channelID", req.ChannelId)
+		return nil, status.Error(codes.Internal, "failed to archive channel")
+	}
+	if archived == 0 {
+		return nil, status.Error(codes.FailedPrecondition, "channel is already archived")
+	}
+
+	channel, err := s.getChannelProto(ctx, req.ChannelId, tenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	s.log.Info("Channel archived", "channelID", req.ChannelId, "by", userID)
+	return &proto.ArchiveChannelResponse{
+		Message: "Channel archived successfully",
+		Channel: channel,
+	}, nil
+}
+
+func (s *ChannelAPI) UnarchiveChannel(ctx context.Context, req *proto.UnarchiveChannelRequest) (*proto.UnarchiveChannelResponse, error) {
+	userID, tenantID, err := s.requireChannelOwner(ctx, req.ChannelId, "unarchive")
+	if err != nil {
+		return nil, err
+	}
+
+	unarchived, err := s.dbQueries.UnarchiveChannel(ctx, db.UnarchiveChannelParams{
+		UpdatedAt: time.Now(),
+		ID:        req.ChannelId,
+		TenantID:  tenantID,
+	})
+	if err != nil {
+		s.log.Error("Failed to unarchive channel", "error", err, "channelID", req.ChannelId)
+		return nil, status.Error(codes.Internal, "failed to unarchive channel")
+	}
+	if unarchived == 0 {
+		return nil, status.Error(codes.FailedPrecondition, "channel is not archived")
+	}
+
+	channel, err := s.getChannelProto(ctx, req.ChannelId, tenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	s.log.Info("Channel unarchived", "channelID", req.ChannelId, "by", userID)
+	return &proto.UnarchiveChannelResponse{
+		Message: "Channel unarchived successfully",
+		Channel: channel,
+	}, nil
+}
+
+// DeleteChannel deletes a channel, archived or not. Its videos are never left to the database:
+// the caller picks whether they move to another channel, go back to their uploaders or are trashed.
+func (s *ChannelAPI) DeleteChannel(ctx context.Context, req *proto.DeleteChannelRequest) (*proto.DeleteChannelResponse, error) {
+	userID, tenantID, err := s.requireChannelOwner(ctx, req.ChannelId, "delete")
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	var affected int64
+	switch req.VideoDisposition {
+	case proto.ChannelVideoDisposition_CHANNEL_VIDEO_DISPOSITION_MOVE_TO_CHANNEL:
+		err = s.validateMoveTarget(ctx, req.ChannelId, req.TargetChannelId, userID, tenantID)
+		if err != nil {
+			return nil, err
+		}
+		affected, err = s.dbQueries.MoveChannelVideos(ctx, db.MoveChannelVideosParams{
+			TargetChannelID: sql.NullString{String: req.TargetChannelId, Valid: true},
+			UpdatedAt:       now,
+			TenantID:        sql.NullString{String: tenantID, Valid: true},
+			ChannelID:       sql.NullString{String: req.ChannelId, Valid: true},
+		})
+	case proto.ChannelVideoDisposition_CHANNEL_VIDEO_DISPOSITION_RETURN_TO_UPLOADERS:
+		affected, err = s.dbQueries.ReturnChannelVideosToUploaders(ctx, db.ReturnChannelVideosToUploadersParams{
+			UpdatedAt: now,
+			TenantID:  sql.NullString{String: tenantID, Valid: true},
+			ChannelID: sql.NullString{String: req.ChannelId, Valid: true},
+		})
+	case proto.ChannelVideoDisposition_CHANNEL_VIDEO_DISPOSITION_TRASH:
+		affected, err = s.dbQueries.SoftDeleteChannelVideos(ctx, db.SoftDeleteChannelVideosParams{
+			UpdatedAt: now,
+			TenantID:  sql.NullString{String: tenantID, Valid: true},
+			ChannelID: sql.NullString{String: req.ChannelId, Valid: true},
+		})
+	default:
+		return nil, status.Error(codes.InvalidArgument, "video disposition is required: move to another channel, return to uploaders or trash")
+	}
+	if err != nil {
+		s.log.Error("Failed to handle videos of deleted channel", "error", err, "channelID", req.ChannelId, "disposition", req.VideoDisposition)
+		return nil, status.Error(codes.Internal, "failed to delete channel")
+	}
+
+	// The videos are taken care of, a failure from here on leaves an empty channel that can be deleted again
+	err = s.dbQueries.DeleteChannelAccessRequestsByChannelID(ctx, req.ChannelId)
+	if err != nil {
+		s.log.Error("Failed to delete channel access requests", "error", err, "channelID", req.ChannelId)
+		return nil, status.Error(codes.Internal, "failed to delete channel")
+	}
+	err = s.dbQueries.DeleteChannelMembersByChannelID(ctx, req.ChannelId)
+	if err != nil {
+		s.log.Error("Failed to delete channel members", "error", err, "channelID", req.ChannelId)
+		return nil, status.Error(codes.Internal, "failed to delete channel")
+	}
+	deleted, err := s.dbQueries.DeleteChannel(ctx, db.DeleteChannelParams{
+		ID:       req.ChannelId,
+		TenantID: tenantID,
+	})
+	if err != nil {
+		s.log.Error("Failed to delete channel", "error", err, "channelID", req.ChannelId)
+		return nil, status.Error(codes.Internal, "failed to delete channel")
+	}
+	if deleted == 0 {
+		return nil, status.Error(codes.NotFound, "channel not found")
+	}
+
+	s.log.Info("Channel deleted", "channelID", req.ChannelId, "by", userID, "disposition", req.VideoDisposition, "videos", affected)
+	return &proto.DeleteChannelResponse{
+		Message:        "Channel deleted successfully",
+		AffectedVideos: int32(affected),
+	}, nil
+}
+
+// validateMoveTarget checks the channel the videos of a deleted channel move to,
+// with the same access MoveVideoToChannel asks for
+func (s *ChannelAPI) validateMoveTarget(ctx context.Context, channelID, targetChannelID, userID, tenantID string) error {
+	if targetChannelID == "" {
+		return status.Error(codes.InvalidArgument, "target channel ID is required")
+	}
+	if targetChannelID == channelID {
+		return status.Error(codes.InvalidArgument, "target channel must be another channel")
+	}
+
+	role, err := s.getUserRoleInChannel(ctx, targetChannelID, userID, tenantID)
+	if err != nil {
+		return err
+	}
+	if role != constants.ChannelRoleOwner && role != constants.ChannelRoleUploader {
+		return status.Error(codes.PermissionDenied, "access denied: you need uploader or owner access to add videos to the target channel")
+	}
+
+	_, err = getActiveChannel(ctx, s.dbQueries, s.log, targetChannelID, tenantID)
+	return err
+}
+
+// getChannelProto returns the channel as seen by one of its owners
+func (s *ChannelAPI) getChannelProto(ctx context.Context, channelID, tenantID string) (*proto.Channel, error) {
+	channel, err := s.dbQueries.GetChannelByIDAndTenantID(ctx, db.GetChannelByIDAndTenantIDParams{
+		ID:       channelID,
+		TenantID: tenantID,
+	})
+	if err != nil {
+		s.log.Error("Failed to get channel", "error", err, "channelID", channelID)
+		return nil, status.Error(codes.Internal, "failed to get channel")
+	}
+
+	channelProto := channelToProto(channel, constants.ChannelRoleOwner)
+
+	memberCount, err := s.getChannelMemberCount(ctx, channel.ID, tenantID)
+	if err != nil {
+		s.log.Warn("Failed to get member count for channel", "channel_id", channel.ID, "error", err)
+		memberCount = 0 // Default to 0 if we can't get the count
+	}
+	channelProto.MemberCount = memberCount
+
+	return channelProto, nil
+}
+
+// channelToProto converts a channel as seen by a member with the given role, without counts
+func channelToProto(channel db.VideoserviceChannel, role string) *proto.Channel {
+	channelProto := &proto.Channel{
+		Id:          channel.ID,
+		TenantId:    channel.TenantID,
+		Name:        channel.Name,
+		Description: channel.Description.String,
+		CreatedBy:   channel.CreatedBy,
+		CreatedAt:   timestamppb.New(channel.CreatedAt),
+		UpdatedAt:   timestamppb.New(channel.UpdatedAt),
+		UserRole:    role,
+		Privacy:     privacyToProto(channel.Privacy),
+		DefaultRole: channel.DefaultRole,
+	}
+	if channel.ArchivedAt.Valid {
+		channelProto.ArchivedAt = timestamppb.New(channel.ArchivedAt.Time)
+	}
+	return channelProto
+}
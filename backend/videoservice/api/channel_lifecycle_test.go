@@ -0,0 +1,290 @@
+package api
+
+import (
+	"context"
+	"database/sql"
+	"io"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	userProto "sortedstartup.com/stream/userservice/proto"
+	"sortedstartup.com/stream/videoservice/db"
+	"sortedstartup.com/stream/videoservice/db/mocks"
+	"sortedstartup.com/stream/videoservice/proto"
+)
+
+// helper: create a test ChannelAPI with the mock db and a user in "test-tenant"
+func createTestChannelAPI(t *testing.T) (*ChannelAPI, *mocks.MockDBQuerier) {
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	mockUser := userProto.NewMockUserServiceClient(ctrl)
+	mockUser.EXPECT().
+		GetTenants(gomock.Any(), gomock.Any()).
+		Return(&userProto.GetTenantsResponse{
+			TenantUsers: []*userProto.TenantUser{
+				{Tenant: &userProto.Tenant{Id: "test-tenant"}},
+			},
+		}, nil).
+		AnyTimes()
+
+	mockDB := mocks.NewMockDBQuerier(ctrl)
+	api := &ChannelAPI{
+		log:               slog.New(slog.NewTextHandler(io.Discard, nil)),
+		dbQueries:         mockDB,
+		userServiceClient: mockUser,
+	}
+	return api, mockDB
+}
+
+// helper: the caller's role in a channel of "test-tenant"
+func expectChannelRole(mockDB *mocks.MockDBQuerier, channelID, role string) *gomock.Call {
+	return mockDB.EXPECT().
+		GetUserRoleInChannel(gomock.Any(), db.GetUserRoleInChannelParams{ChannelID: channelID, UserID: "test-user-id", TenantID: "test-tenant"}).
+		Return(role, nil)
+}
+
+func TestArchiveChannel(t *testing.T) {
+	api, mockDB := createTestChannelAPI(t)
+
+	// Uploaders can't archive
+	expectChannelRole(mockDB, "channel-1", "uploader")
+	_, err := api.ArchiveChannel(tenantCtx(t), &proto.ArchiveChannelRequest{ChannelId: "channel-1"})
+	if status.Code(err) != codes.PermissionDenied {
+		t.Errorf("Expected PermissionDenied, got %v", err)
+	}
+
+	archivedAt := time.Now()
+	expectChannelRole(mockDB, "channel-1", "owner")
+	mockDB.EXPECT().
+		ArchiveChannel(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, params db.ArchiveChannelParams) (int64, error) {
+			if params.ID != "channel-1" || params.TenantID != "test-tenant" || params.ArchivedBy.String != "test-user-id" {
+				t.Errorf("Unexpected archive %+v", params)
+			}
+			return 1, nil
+		})
+	mockDB.EXPECT().
+		GetChannelByIDAndTenantID(gomock.Any(), db.GetChannelByIDAndTenantIDParams{ID: "channel-1", TenantID: "test-tenant"}).
+		Return(db.VideoserviceChannel{ID: "channel-1", ArchivedAt: sql.NullTime{Time: archivedAt, Valid: true}}, nil)
+	mockDB.EXPECT().
+		GetChannelMembersByChannelIDAndTenantID(gomock.Any(), gomock.Any()).
+		Return([]db.GetChannelMembersByChannelIDAndTenantIDRow{{UserID: "test-user-id"}}, nil)
+
+	resp, err := api.ArchiveChannel(tenantCtx(t), &proto.ArchiveChannelRequest{ChannelId: "channel-1"})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if resp.Channel.ArchivedAt == nil || !resp.Channel.ArchivedAt.AsTime().Equal(archivedAt) {
+		t.Errorf("Expected the channel to be archived at %v, got %v", archivedAt, resp.Channel.ArchivedAt)
+	}
+
+	// Archiving twice is refused
+	expectChannelRole(mockDB, "channel-1", "owner")
+	mockDB.EXPECT().ArchiveChannel(gomock.Any(), gomock.Any()).Return(int64(0), nil)
+	_, err = api.ArchiveChannel(tenantCtx(t), &proto.ArchiveChannelRequest{ChannelId: "channel-1"})
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("Expected FailedPrecondition, got %v", err)
+	}
+}
+
+func TestUpdateChannel_ArchivedChannelIsReadOnly(t *testing.T) {
+	api, mockDB := createTestChannelAPI(t)
+
+	expectChannelRole(mockDB, "channel-1", "owner")
+	mockDB.EXPECT().
+		GetChannelByIDAndTenantID(gomock.Any(), gomock.Any()).
+		Return(db.VideoserviceChannel{ID: "channel-1", ArchivedAt: sql.NullTime{Time: time.Now(), Valid: true}}, nil)
+
+	_, err := api.UpdateChannel(tenantCtx(t), &proto.UpdateChannelRequest{ChannelId: "channel-1", Name: "Renamed"})
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("Expected FailedPrecondition, got %v", err)
+	}
+}
+
+func TestDeleteChannel_RequiresDisposition(t *testing.T) {
+	api, mockDB := createTestChannelAPI(t)
+
+	// Nothing is touched without an explicit choice for the videos
+	expectChannelRole(mockDB, "channel-1", "owner")
+	_, err := api.DeleteChannel(tenantCtx(t), &proto.DeleteChannelRequest{ChannelId: "channel-1"})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("Expected InvalidArgument, got %v", err)
+	}
+
+	// Only owners can delete
+	expectChannelRole(mockDB, "channel-1", "viewer")
+	_, err = api.DeleteChannel(tenantCtx(t), &proto.DeleteChannelRequest{
+		ChannelId:        "channel-1",
+		VideoDisposition: proto.ChannelVideoDisposition_CHANNEL_VIDEO_DISPOSITION_TRASH,
+	})
+	if status.Code(err) != codes.PermissionDenied {
+		t.Errorf("Expected PermissionDenied, got %v", err)
+	}
+}
+
+func TestDeleteChannel_MovesVideosToTargetChannel(t *testing.T) {
+	api, mockDB := createTestChannelAPI(t)
+
+	expectChannelRole(mockDB, "channel-1", "owner")
+	expectChannelRole(mockDB, "channel-2", "uploader")
+	mockDB.EXPECT().
+		GetChannelByIDAndTenantID(gomock.Any(), db.GetChannelByIDAndTenantIDParams{ID: "channel-2", TenantID: "test-tenant"}).
+		Return(db.VideoserviceChannel{ID: "channel-2"}, nil)
+
+	// The videos are moved before the channel goes away
+	gomock.InOrder(
+		mockDB.EXPECT().
+			MoveChannelVideos(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, params db.MoveChannelVideosParams) (int64, error) {
+				if params.ChannelID.String != "channel-1" || params.TargetChannelID.String != "channel-2" || params.TenantID.String != "test-tenant" {
+					t.Errorf("Unexpected move %+v", params)
+				}
+				return 3, nil
+			}),
+		mockDB.EXPECT().DeleteChannelAccessRequestsByChannelID(gomock.Any(), "channel-1").Return(nil),
+		mockDB.EXPECT().DeleteChannelMembersByChannelID(gomock.Any(), "channel-1").Return(nil),
+		mockDB.EXPECT().
+			DeleteChannel(gomock.Any(), db.DeleteChannelParams{ID: "channel-1", TenantID: "test-tenant"}).
+			Return(int64(1), nil),
+	)
+
+	resp, err := api.DeleteChannel(tenantCtx(t), &proto.DeleteChannelRequest{
+		ChannelId:        "channel-1",
+		VideoDisposition: proto.ChannelVideoDisposition_CHANNEL_VIDEO_DISPOSITION_MOVE_TO_CHANNEL,
+		TargetChannelId:  "channel-2",
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if resp.AffectedVideos != 3 {
+		t.Errorf("Expected 3 moved videos, got %d", resp.AffectedVideos)
+	}
+}
+
+func TestDeleteChannel_InvalidMoveTarget(t *testing.T) {
+	api, mockDB := createTestChannelAPI(t)
+
+	tests := []struct {
+		name     string
+		target   string
+		expect   func()
+		wantCode codes.Code
+	}{
+		{"Missing target", "", func() {}, codes.InvalidArgument},
+		{"Same channel", "channel-1", func() {}, codes.InvalidArgument},
+		{"Viewer of the target", "channel-2", func() {
+			expectChannelRole(mockDB, "channel-2", "viewer")
+		}, codes.PermissionDenied},
+		{"Archived target", "channel-2", func() {
+			expectChannelRole(mockDB, "channel-2", "owner")
+			mockDB.EXPECT().
+				GetChannelByIDAndTenantID(gomock.Any(), gomock.Any()).
+				Return(db.VideoserviceChannel{ID: "channel-2", ArchivedAt: sql.NullTime{Time: time.Now(), Valid: true}}, nil)
+		}, codes.FailedPrecondition},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			expectChannelRole(mockDB, "channel-1", "owner")
+			tt.expect()
+
+			_, err := api.DeleteChannel(tenantCtx(t), &proto.DeleteChannelRequest{
+				ChannelId:        "channel-1",
+				VideoDisposition: proto.ChannelVideoDisposition_CHANNEL_VIDEO_DISPOSITION_MOVE_TO_CHANNEL,
+				TargetChannelId:  tt.target,
+			})
+			if status.Code(err) != tt.wantCode {
+				t.Errorf("Expected %v, got %v", tt.wantCode, err)
+			}
+		})
+	}
+}
+
+func TestDeleteChannel_TrashesVideos(t *testing.T) {
+	api, mockDB := createTestChannelAPI(t)
+
+	expectChannelRole(mockDB, "channel-1", "owner")
+	gomock.InOrder(
+		mockDB.EXPECT().
+			SoftDeleteChannelVideos(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, params db.SoftDeleteChannelVideosParams) (int64, error) {
+				if params.ChannelID.String != "channel-1" || params.TenantID.String != "test-tenant" {
+					t.Errorf("Unexpected trash %+v", params)
+				}
+				return 2, nil
+			}),
+		mockDB.EXPECT().DeleteChannelAccessRequestsByChannelID(gomock.Any(), "channel-1").Return(nil),
+		mockDB.EXPECT().DeleteChannelMembersByChannelID(gomock.Any(), "channel-1").Return(nil),
+		mockDB.EXPECT().DeleteChannel(gomock.Any(), gomock.Any()).Return(int64(1), nil),
+	)
+
+	resp, err := api.DeleteChannel(tenantCtx(t), &proto.DeleteChannelRequest{
+		ChannelId:        "channel-1",
+		VideoDisposition: proto.ChannelVideoDisposition_CHANNEL_VIDEO_DISPOSITION_TRASH,
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if resp.AffectedVideos != 2 {
+		t.Errorf("Expected 2 trashed videos, got %d", resp.AffectedVideos)
+	}
+}
+
+func TestGetChannel(t *testing.T) {
+	api, mockDB := createTestChannelAPI(t)
+
+	// Non-members can't see the channel
+	mockDB.EXPECT().
+		GetUserRoleInChannel(gomock.Any(), gomock.Any()).
+		Return("", sql.ErrNoRows)
+	_, err := api.GetChannel(tenantCtx(t), &proto.GetChannelRequest{ChannelId: "channel-1"})
+	if status.Code(err) != codes.PermissionDenied {
+		t.Errorf("Expected PermissionDenied, got %v", err)
+	}
+
+	// Archived channels are still returned to their members
+	expectChannelRole(mockDB, "channel-1", "viewer")
+	mockDB.EXPECT().
+		GetChannelByIDAndTenantID(gomock.Any(), db.GetChannelByIDAndTenantIDParams{ID: "channel-1", TenantID: "test-tenant"}).
+		Return(db.VideoserviceChannel{ID: "channel-1", ArchivedAt: sql.NullTime{Time: time.Now(), Valid: true}}, nil)
+	mockDB.EXPECT().
+		GetVideoCountsByChannelIDs(gomock.Any(), gomock.Any()).
+		Return([]db.GetVideoCountsByChannelIDsRow{{ChannelID: sql.NullString{String: "channel-1", Valid: true}, VideoCount: 3}}, nil)
+
+	resp, err := api.GetChannel(tenantCtx(t), &proto.GetChannelRequest{ChannelId: "channel-1"})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if resp.Channel.UserRole != "viewer" || resp.Channel.VideoCount != 3 || resp.Channel.ArchivedAt == nil {
+		t.Errorf("Unexpected channel %+v", resp.Channel)
+	}
+	// Member counts are for owners only
+	if resp.Channel.MemberCount != 0 {
+		t.Errorf("Expected no member count, got %d", resp.Channel.MemberCount)
+	}
+
+	// Commentservice moderates with the role, owners of archived channels stay owners
+	expectChannelRole(mockDB, "channel-1", "owner")
+	mockDB.EXPECT().
+		GetChannelByIDAndTenantID(gomock.Any(), gomock.Any()).
+		Return(db.VideoserviceChannel{ID: "channel-1", ArchivedAt: sql.NullTime{Time: time.Now(), Valid: true}}, nil)
+	mockDB.EXPECT().
+		GetVideoCountsByChannelIDs(gomock.Any(), gomock.Any()).
+		Return(nil, nil)
+	mockDB.EXPECT().
+		GetChannelMembersByChannelIDAndTenantID(gomock.Any(), gomock.Any()).
+		Return([]db.GetChannelMembersByChannelIDAndTenantIDRow{{UserID: "test-user-id"}, {UserID: "member-1"}}, nil)
+
+	resp, err = api.GetChannel(tenantCtx(t), &proto.GetChannelRequest{ChannelId: "channel-1"})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if resp.Channel.UserRole != "owner" || resp.Channel.MemberCount != 2 {
+		t.Errorf("Unexpected channel %+v", resp.Channel)
+	}
+}
@@ -0,0 +1,93 @@
+package api
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	notificationProto "sortedstartup.com/stream/notificationservice/proto"
+	"sortedstartup.com/stream/videoservice/db"
+	"sortedstartup.com/stream/videoservice/proto"
+)
+
+func TestUpdateMemberRole_PromotesViewer(t *testing.T) {
+	api, mockDB := createTestChannelAPI(t)
+
+	expectChannelRole(mockDB, "channel-1", "owner")
+	mockDB.EXPECT().
+		GetChannelByIDAndTenantID(gomock.Any(), gomock.Any()).
+		Return(db.VideoserviceChannel{ID: "channel-1"}, nil)
+	mockDB.EXPECT().
+		GetUserRoleInChannel(gomock.Any(), db.GetUserRoleInChannelParams{ChannelID: "channel-1", UserID: "member-1", TenantID: "test-tenant"}).
+		Return("viewer", nil)
+
+	// The change records who made it
+	mockDB.EXPECT().
+		UpdateChannelMemberRole(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, params db.UpdateChannelMemberRoleParams) (int64, error) {
+			if params.ChannelID != "channel-1" || params.UserID != "member-1" || params.Role != "uploader" {
+				t.Errorf("Unexpected role change %+v", params)
+			}
+			if params.RoleUpdatedBy.String != "test-user-id" || time.Since(params.RoleUpdatedAt.Time) > time.Minute {
+				t.Errorf("Expected the change to be recorded, got %+v", params)
+			}
+			return 1, nil
+		})
+
+	mockDB.EXPECT().
+		GetChannelMembersByChannelIDAndTenantID(gomock.Any(), gomock.Any()).
+		Return([]db.GetChannelMembersByChannelIDAndTenantIDRow{{UserID: "test-user-id", Role: "owner"}}, nil)
+	mockNotifications := notificationProto.NewMockNotificationPublisherServiceClient(gomock.NewController(t))
+	mockNotifications.EXPECT().
+		PublishEvent(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, req *notificationProto.PublishEventRequest, opts ...grpc.CallOption) (*notificationProto.PublishEventResponse, error) {
+			if req.Data["change"] != "role_changed" || req.Data["role"] != "uploader" || req.Data["user_id"] != "member-1" {
+				t.Errorf("Unexpected event %v", req)
+			}
+			return &notificationProto.PublishEventResponse{}, nil
+		})
+	api.notificationClient = mockNotifications
+
+	_, err := api.UpdateMemberRole(tenantCtx(t), &proto.UpdateChannelMemberRoleRequest{ChannelId: "channel-1", UserId: "member-1", Role: "uploader"})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+}
+
+func TestUpdateMemberRole_KeepsLastOwner(t *testing.T) {
+	api, mockDB := createTestChannelAPI(t)
+
+	// The only owner can't demote themselves
+	expectChannelRole(mockDB, "channel-1", "owner").Times(2)
+	mockDB.EXPECT().
+		GetChannelByIDAndTenantID(gomock.Any(), gomock.Any()).
+		Return(db.VideoserviceChannel{ID: "channel-1"}, nil)
+	// The guarded update matches no row while they are the only owner
+	mockDB.EXPECT().UpdateChannelMemberRole(gomock.Any(), gomock.Any()).Return(int64(0), nil)
+
+	_, err := api.UpdateMemberRole(tenantCtx(t), &proto.UpdateChannelMemberRoleRequest{ChannelId: "channel-1", UserId: "test-user-id", Role: "viewer"})
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("Expected FailedPrecondition, got %v", err)
+	}
+}
+
+func TestUpdateMemberRole_Refused(t *testing.T) {
+	api, mockDB := createTestChannelAPI(t)
+
+	// Invalid roles are refused before any lookup
+	_, err := api.UpdateMemberRole(tenantCtx(t), &proto.UpdateChannelMemberRoleRequest{ChannelId: "channel-1", UserId: "member-1", Role: "admin"})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("Expected InvalidArgument, got %v", err)
+	}
+
+	// Uploaders can't change roles
+	expectChannelRole(mockDB, "channel-1", "uploader")
+	_, err = api.UpdateMemberRole(tenantCtx(t), &proto.UpdateChannelMemberRoleRequest{ChannelId: "channel-1", UserId: "member-1", Role: "owner"})
+	if status.Code(err) != codes.PermissionDenied {
+		t.Errorf("Expected PermissionDenied, got %v", err)
+	}
+}
@@ -14,6 +14,7 @@ import (
 
 	"github.com/google/uuid"
 	"sortedstartup.com/stream/common/interceptors"
+	notificationProto "sortedstartup.com/stream/notificationservice/proto"
 	"sortedstartup.com/stream/videoservice/db"
 )
 
@@ -158,6 +159,16 @@ func (api *VideoAPI) uploadHandler(w http.ResponseWriter, r *http.Request) {
 	}
 	channelID := strings.TrimSpace(string(channelData))
 
+	// Archived channels are read-only
+	if channelID != "" {
+		_, err = getActiveChannel(r.Context(), api.dbQueries, api.log, channelID, tenantID)
+		if err != nil {
+			http.Error(w, "Channel not found or archived", http.StatusBadRequest)
+			slog.Error("Upload to unavailable channel", "channelID", channelID, "err", err)
+			return
+		}
+	}
+
 	// Read video file (part 4)
 	videoPart, err := reader.NextPart()
 	if err != nil {
@@ -270,6 +281,21 @@ func (api *VideoAPI) uploadHandler(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	api.publishVideoEvent(r.Context(), tenantID, authContext.User, notificationProto.EventType_EVENT_TYPE_VIDEO_UPLOADED, map[string]string{
+		"video_id":    uid,
+		"title":       title,
+		"channel_id":  channelID,
+		"uploaded_by": userID,
+	})
+	// There is no transcoding step, an uploaded video is ready to play right away
+	api.publishVideoEvent(r.Context(), tenantID, authContext.User, notificationProto.EventType_EVENT_TYPE_VIDEO_STATUS_CHANGED, map[string]string{
+		"video_id": uid,
+		"status":   "ready",
+	})
+	if channelID != "" {
+		api.notifyChannelVideo(r.Context(), tenantID, authContext.User, channelID, uid, title)
+	}
+
 	// Success! Respond and exit
 	w.WriteHeader(http.StatusOK)
 	w.Write([]byte(fmt.Sprintf(`{"message": "File uploaded successfully", "filename": "%s"}`, fileName)))
@@ -326,6 +352,12 @@ func (api *VideoAPI) serveVideoHandler(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	// Revalidated on every play, so losing access takes effect immediately
+	api.serveVideoFile(w, r, videoID, tenantID, "private, no-cache")
+}
+
+// serveVideoFile serves the file of a video of the tenant with range request support
+func (api *VideoAPI) serveVideoFile(w http.ResponseWriter, r *http.Request, videoID, tenantID, cacheControl string) {
 	// Get video details from database with tenant validation
 	video, err := api.dbQueries.GetVideoByVideoIDAndTenantID(r.Context(), db.GetVideoByVideoIDAndTenantIDParams{
 		ID:       videoID,
@@ -368,9 +400,8 @@ func (api *VideoAPI) serveVideoHandler(w http.ResponseWriter, r *http.Request) {
 
 	w.Header().Set("Content-Disposition", "inline")
 
-	// Cache video files for a longer period since they rarely change
-	// 7 days = 604800 seconds
-	w.Header().Set("Cache-Control", "public, max-age=604800") // Cache for 7 days
+	// Videos are private to their tenant, they must never be stored by shared caches
+	w.Header().Set("Cache-Control", cacheControl)
 
 	// Use http.ServeContent to handle range requests, caching, and proper HTTP semantics
 	http.ServeContent(w, r, videoFileName, fileInfo.ModTime(), file)
@@ -18,7 +18,9 @@ import (
 	"context"
 
 	"github.com/golang/mock/gomock"
+	"google.golang.org/grpc"
 	"sortedstartup.com/stream/common/auth"
+	notificationProto "sortedstartup.com/stream/notificationservice/proto"
 	"sortedstartup.com/stream/userservice/proto"
 	"sortedstartup.com/stream/videoservice/config"
 	"sortedstartup.com/stream/videoservice/db"
@@ -56,8 +58,9 @@ func createTestVideoAPI() *VideoAPI {
 	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
 
 	api := &VideoAPI{
-		config: cfg,
-		log:    logger,
+		config:   cfg,
+		log:      logger,
+		playback: newTestPlaybackSigner(),
 	}
 
 	return api
@@ -171,6 +174,9 @@ func TestUploadHandlerMaxBytesReader(t *testing.T) {
 
 	api := createTestVideoAPI()
 	api.userServiceClient = mockUser
+	mockDB := mocks.NewMockDBQuerier(ctrl)
+	expectActiveChannel(mockDB, "test-channel")
+	api.dbQueries = mockDB
 
 	// Set file size to maxUploadSize + 1 to exceed limit
 	var fileSize int64 = maxUploadSize + 1
@@ -212,10 +218,18 @@ func createTestAPIWithMockDB(t *testing.T) (*VideoAPI, *mocks.MockDBQuerier, fun
 		config:    cfg,
 		dbQueries: mockDB,
 		log:       logger,
+		playback:  newTestPlaybackSigner(),
 	}
 	return api, mockDB, ctrl.Finish
 }
 
+// helper: the upload goes to a channel that isn't archived
+func expectActiveChannel(mockDB *mocks.MockDBQuerier, channelID string) {
+	mockDB.EXPECT().
+		GetChannelByIDAndTenantID(gomock.Any(), db.GetChannelByIDAndTenantIDParams{ID: channelID, TenantID: "tenant-1"}).
+		Return(db.VideoserviceChannel{ID: channelID, TenantID: "tenant-1"}, nil)
+}
+
 // helper: authenticated context with fixed userID
 func authCtx() context.Context {
 	authUser := &auth.AuthContext{
@@ -390,12 +404,56 @@ func TestUploadHandler_EmptyTitle(t *testing.T) {
 
 	api.userServiceClient = mockUser
 
+	expectActiveChannel(mockDB, "channel-1")
+
 	// Set expectation on mockDB because handler will call CreateVideoUploaded
 	mockDB.EXPECT().
 		CreateVideoUploaded(gomock.Any(), gomock.Any()).
 		Return(nil).
 		Times(1)
 
+	// Members of channel-1 are told about the new video
+	mockDB.EXPECT().
+		GetChannelMembersByChannelIDAndTenantID(gomock.Any(), db.GetChannelMembersByChannelIDAndTenantIDParams{ChannelID: "channel-1", TenantID: "tenant-1"}).
+		Return([]db.GetChannelMembersByChannelIDAndTenantIDRow{
+			{UserID: "test-user-id", ChannelName: "Demos"},
+			{UserID: "member-1", ChannelName: "Demos"},
+		}, nil).
+		Times(1)
+
+	mockNotifications := notificationProto.NewMockNotificationPublisherServiceClient(ctrl)
+	mockNotifications.EXPECT().
+		Publish(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, req *notificationProto.PublishRequest, opts ...grpc.CallOption) (*notificationProto.PublishResponse, error) {
+			if req.Type != notificationProto.NotificationType_NOTIFICATION_TYPE_CHANNEL_VIDEO || req.ChannelId != "channel-1" {
+				t.Errorf("Unexpected notification %v", req)
+			}
+			if len(req.RecipientIds) != 2 {
+				t.Errorf("Expected both channel members as recipients, got %v", req.RecipientIds)
+			}
+			return &notificationProto.PublishResponse{DeliveredCount: 1}, nil
+		}).
+		Times(1)
+	mockNotifications.EXPECT().
+		PublishEvent(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, req *notificationProto.PublishEventRequest, opts ...grpc.CallOption) (*notificationProto.PublishEventResponse, error) {
+			switch req.Type {
+			case notificationProto.EventType_EVENT_TYPE_VIDEO_UPLOADED:
+				if req.Data["channel_id"] != "channel-1" {
+					t.Errorf("Unexpected event %v", req)
+				}
+			case notificationProto.EventType_EVENT_TYPE_VIDEO_STATUS_CHANGED:
+				if req.Data["status"] != "ready" {
+					t.Errorf("Expected the video to be ready, got %v", req)
+				}
+			default:
+				t.Errorf("Unexpected event %v", req)
+			}
+			return &notificationProto.PublishEventResponse{}, nil
+		}).
+		Times(2)
+	api.notificationClient = mockNotifications
+
 	body, contentType := prepareMultipartBody(t, "   ", "desc", "channel-1", "test.mp4", []byte("dummy"))
 	req := httptest.NewRequest(http.MethodPost, "/upload", body)
 	req.Header.Set("Content-Type", contentType)
@@ -424,10 +482,11 @@ func TestUploadHandler_UnsupportedFileExtension(t *testing.T) {
 		}, nil).
 		Times(1)
 
-	api, _, teardown := createTestAPIWithMockDB(t)
+	api, mockDB, teardown := createTestAPIWithMockDB(t)
 	defer teardown()
 
 	api.userServiceClient = mockUser
+	expectActiveChannel(mockDB, "channel-1")
 
 	body, contentType := prepareMultipartBody(t, "title", "desc", "channel-1", "test.exe", []byte("dummy"))
 	req := httptest.NewRequest(http.MethodPost, "/upload", body)
@@ -462,6 +521,8 @@ func TestUploadHandler_FileCreationFailure(t *testing.T) {
 
 	api.userServiceClient = mockUser
 
+	expectActiveChannel(mockDB, "channel-1")
+
 	// Setup mockDB CreateVideoUploaded to succeed
 	mockDB.EXPECT().
 		CreateVideoUploaded(gomock.Any(), gomock.Any()).
@@ -503,6 +564,8 @@ func TestUploadHandler_DBError(t *testing.T) {
 
 	api.userServiceClient = mockUser
 
+	expectActiveChannel(mockDB, "channel-1")
+
 	// Setup mockDB CreateVideoUploaded to return error
 	mockDB.EXPECT().
 		CreateVideoUploaded(gomock.Any(), gomock.Any()).
@@ -768,4 +831,48 @@ func TestServeVideoHandler_Success(t *testing.T) {
 	if !strings.Contains(rec.Header().Get("Content-Type"), "video/mp4") {
 		t.Errorf("Expected video/mp4 Content-Type, got %s", rec.Header().Get("Content-Type"))
 	}
+	if rec.Header().Get("Cache-Control") != "private, no-cache" {
+		t.Errorf("Expected private, no-cache Cache-Control, got %s", rec.Header().Get("Cache-Control"))
+	}
+}
+
+func TestUploadHandler_ArchivedChannel(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUser := proto.NewMockUserServiceClient(ctrl)
+	mockUser.EXPECT().
+		GetTenants(gomock.Any(), gomock.Any()).
+		Return(&proto.GetTenantsResponse{
+			TenantUsers: []*proto.TenantUser{
+				{Tenant: &proto.Tenant{Id: "tenant-1"}},
+			},
+		}, nil).
+		Times(1)
+
+	api, mockDB, teardown := createTestAPIWithMockDB(t)
+	defer teardown()
+
+	api.userServiceClient = mockUser
+
+	// Archived channels are read-only, nothing is stored
+	mockDB.EXPECT().
+		GetChannelByIDAndTenantID(gomock.Any(), gomock.Any()).
+		Return(db.VideoserviceChannel{ID: "channel-1", ArchivedAt: sql.NullTime{Time: time.Now(), Valid: true}}, nil)
+	mockDB.EXPECT().
+		CreateVideoUploaded(gomock.Any(), gomock.Any()).
+		Times(0)
+
+	body, contentType := prepareMultipartBody(t, "title", "desc", "channel-1", "test.mp4", []byte("dummy"))
+	req := httptest.NewRequest(http.MethodPost, "/upload", body)
+	req.Header.Set("Content-Type", contentType)
+	req.Header.Set("x-tenant-id", "tenant-1")
+	req = req.WithContext(authCtx())
+	rec := httptest.NewRecorder()
+
+	api.uploadHandler(rec, req)
+
+	if rec.Code != http.StatusBadRequest {
+		t.Errorf("Expected 400 Bad Request for an archived channel, got %d", rec.Code)
+	}
 }
@@ -0,0 +1,144 @@
+package api
+
+import (
+	"context"
+	"fmt"
+
+	"sortedstartup.com/stream/common/auth"
+	"sortedstartup.com/stream/common/constants"
+	notificationProto "sortedstartup.com/stream/notificationservice/proto"
+	"sortedstartup.com/stream/videoservice/db"
+)
+
+// notifyChannelVideo tells the members of a channel that a video was added to it.
+// Notifications are best effort, the video is already in the channel at this point.
+func (s *VideoAPI) notifyChannelVideo(ctx context.Context, tenantID string, actor *auth.User, channelID, videoID, title string) {
+	members, err := s.dbQueries.GetChannelMembersByChannelIDAndTenantID(ctx, db.GetChannelMembersByChannelIDAndTenantIDParams{
+		ChannelID: channelID,
+		TenantID:  tenantID,
+	})
+	if err != nil {
+		s.log.Error("Error getting channel members to notify", "err", err, "channelID", channelID)
+		return
+	}
+	if len(members) == 0 {
+		return
+	}
+
+	recipientIDs := make([]string, 0, len(members))
+	for _, member := range members {
+		recipientIDs = append(recipientIDs, member.UserID)
+	}
+
+	_, err = s.notificationClient.Publish(ctx, &notificationProto.PublishRequest{
+		TenantId:     tenantID,
+		RecipientIds: recipientIDs,
+		Type:         notificationProto.NotificationType_NOTIFICATION_TYPE_CHANNEL_VIDEO,
+		ActorId:      actor.ID,
+		ActorName:    actor.Name,
+		Message:      fmt.Sprintf("%s added \"%s\" to %s", actor.Name, title, members[0].ChannelName),
+		VideoId:      videoID,
+		ChannelId:    channelID,
+	})
+	if err != nil {
+		s.log.Error("Error publishing channel video notification", "err", err, "channelID", channelID, "videoID", videoID)
+	}
+}
+
+// notifyMembership tells a user they were added to or removed from a channel
+func (s *ChannelAPI) notifyMembership(ctx context.Context, tenantID string, actor *auth.User, channelID, userID string, added bool) {
+	channel, err := s.dbQueries.GetChannelByIDAndTenantID(ctx, db.GetChannelByIDAndTenantIDParams{
+		ID:       channelID,
+		TenantID: tenantID,
+	})
+	if err != nil {
+		s.log.Error("Error getting channel to notify", "err", err, "channelID", channelID)
+		return
+	}
+
+	message := fmt.Sprintf("%s added you to %s", actor.Name, channel.Name)
+	if !added {
+		message = fmt.Sprintf("%s removed you from %s", actor.Name, channel.Name)
+	}
+
+	_, err = s.notificationClient.Publish(ctx, &notificationProto.PublishRequest{
+		TenantId:     tenantID,
+		RecipientIds: []string{userID},
+		Type:         notificationProto.NotificationType_NOTIFICATION_TYPE_CHANNEL_MEMBERSHIP,
+		ActorId:      actor.ID,
+		ActorName:    actor.Name,
+		Message:      message,
+		ChannelId:    channelID,
+	})
+	if err != nil {
+		s.log.Error("Error publishing membership notification", "err", err, "channelID", channelID, "userID", userID)
+	}
+}
+
+// notifyChannelAccess tells the owners about an access request, or the requester about the decision
+func (s *ChannelAPI) notifyChannelAccess(ctx context.Context, tenantID string, actor *auth.User, channelID string, recipientIDs []string, message string) {
+	if len(recipientIDs) == 0 {
+		return
+	}
+
+	_, err := s.notificationClient.Publish(ctx, &notificationProto.PublishRequest{
+		TenantId:     tenantID,
+		RecipientIds: recipientIDs,
+		Type:         notificationProto.NotificationType_NOTIFICATION_TYPE_CHANNEL_MEMBERSHIP,
+		ActorId:      actor.ID,
+		ActorName:    actor.Name,
+		Message:      message,
+		ChannelId:    channelID,
+	})
+	if err != nil {
+		s.log.Error("Error publishing access request notification", "err", err, "channelID", channelID)
+	}
+}
+
+// publishVideoEvent tells the tenant's webhooks and live subscribers about a video. Like notifications
+// this is best effort, the change is already saved at this point.
+func (s *VideoAPI) publishVideoEvent(ctx context.Context, tenantID string, actor *auth.User, eventType notificationProto.EventType, data map[string]string) {
+	_, err := s.notificationClient.PublishEvent(ctx, &notificationProto.PublishEventRequest{
+		TenantId: tenantID,
+		Type:     eventType,
+		ActorId:  actor.ID,
+		Data:     data,
+	})
+	if err != nil {
+		s.log.Error("Error publishing video event", "err", err, "type", eventType, "videoID", data["video_id"])
+	}
+}
+
+// publishMembershipChanged tells webhooks and live subscribers that someone joined or left a channel.
+// On live streams only the affected user and the channel owners, who can list members, get it.
+func (s *ChannelAPI) publishMembershipChanged(ctx context.Context, tenantID string, actor *auth.User, channelID, userID, change, role string) {
+	audience := []string{userID}
+	members, err := s.dbQueries.GetChannelMembersByChannelIDAndTenantID(ctx, db.GetChannelMembersByChannelIDAndTenantIDParams{
+		ChannelID: channelID,
+		TenantID:  tenantID,
+	})
+	if err != nil {
+		s.log.Error("Error getting channel owners for membership event", "err", err, "channelID", channelID)
+	}
+	for _, member := range members {
+		if member.Role == constants.ChannelRoleOwner {
+			audience = append(audience, member.UserID)
+		}
+	}
+
+	_, err = s.notificationClient.PublishEvent(ctx, &notificationProto.PublishEventRequest{
+		TenantId: tenantID,
+		Type:     notificationProto.EventType_EVENT_TYPE_CHANNEL_MEMBERSHIP_CHANGED,
+		ActorId:  actor.ID,
+		Data: map[string]string{
+			"channel_id": channelID,
+			"user_id":    userID,
+			"change":     change,
+			"role":       role,
+		},
+		AudienceUserIds: audience,
+	})
+	if err != nil {
+		s.log.Error("Error publishing membership event", "err", err, "channelID", channelID, "userID", userID)
+	}
+}
@@ -0,0 +1,41 @@
+package api
+
+import (
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"sortedstartup.com/stream/common/pagination"
+)
+
+const (
+	defaultPageSize = 50
+	maxPageSize     = 100
+)
+
+// pageParams applies the default page size and decodes the page token of a list request.
+// scope ties the token to the request's filters, see pagination.EncodeToken.
+func pageParams(pageSize int32, pageToken, scope string) (int32, string, error) {
+	pageSize, err := pageSizeParam(pageSize)
+	if err != nil {
+		return 0, "", err
+	}
+
+	afterID, err := pagination.DecodeToken(scope, pageToken)
+	if err != nil {
+		return 0, "", status.Error(codes.InvalidArgument, "invalid page token")
+	}
+	return pageSize, afterID, nil
+}
+
+// pageSizeParam applies the default and maximum page size
+func pageSizeParam(pageSize int32) (int32, error) {
+	if pageSize < 0 {
+		return 0, status.Error(codes.InvalidArgument, "page size must not be negative")
+	}
+	if pageSize == 0 {
+		pageSize = defaultPageSize
+	}
+	if pageSize > maxPageSize {
+		pageSize = maxPageSize
+	}
+	return pageSize, nil
+}
@@ -0,0 +1,123 @@
+package api
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"sortedstartup.com/stream/common/pagination"
+	"sortedstartup.com/stream/videoservice/db"
+	"sortedstartup.com/stream/videoservice/proto"
+)
+
+func TestListVideos_Pagination(t *testing.T) {
+	api, mockDB, teardown := createTestAPIWithPolicy(t)
+	defer teardown()
+
+	createdAt := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+
+	mockDB.EXPECT().
+		GetAccessibleVideosPage(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, params db.GetAccessibleVideosPageParams) ([]db.VideoserviceVideo, error) {
+			if params.AfterID != "" || params.PageSize != 3 {
+				t.Errorf("Unexpected page params: %+v", params)
+			}
+			return []db.VideoserviceVideo{{ID: "video-3"}, {ID: "video-2", Title: "Second", DurationSeconds: 42, CreatedAt: createdAt}, {ID: "video-1"}}, nil
+		}).
+		Times(1)
+	mockDB.EXPECT().
+		CountAccessibleVideos(gomock.Any(), gomock.Any()).
+		Return(int64(3), nil).
+		Times(1)
+	// Only the progress of the videos on the page is loaded
+	mockDB.EXPECT().
+		GetWatchProgressByVideoIDs(gomock.Any(), db.GetWatchProgressByVideoIDsParams{
+			TenantID: "test-tenant",
+			UserID:   "test-user-id",
+			VideoIds: []string{"video-3", "video-2"},
+		}).
+		Return([]db.VideoserviceWatchProgress{{VideoID: "video-2", PositionSeconds: 30}}, nil).
+		Times(1)
+
+	resp, err := api.ListVideos(tenantCtx(t), &proto.ListVideosRequest{PageSize: 2})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if len(resp.Videos) != 2 || resp.Videos[1].Id != "video-2" {
+		t.Errorf("Unexpected page: %+v", resp.Videos)
+	}
+	if resp.Videos[1].WatchProgress.GetPositionSeconds() != 30 || resp.Videos[0].WatchProgress != nil {
+		t.Errorf("Expected the watch progress of video-2 only, got %v and %v", resp.Videos[0].WatchProgress, resp.Videos[1].WatchProgress)
+	}
+	if resp.TotalCount != 3 {
+		t.Errorf("Expected total count 3, got %d", resp.TotalCount)
+	}
+
+	_, scope, _ := videoListParams(&proto.ListVideosRequest{}, "test-tenant", "test-user-id")
+	var key videoPageKey
+	afterID, err := pagination.DecodeKeyedToken(scope, resp.NextPageToken, &key)
+	if err != nil || afterID != "video-2" {
+		t.Errorf("Expected a token after video-2, got %q (%v)", afterID, err)
+	}
+	if !key.CreatedAt.Equal(createdAt) || key.Title != "Second" || key.DurationSeconds != 42 {
+		t.Errorf("Expected the sort values of video-2 in the token, got %+v", key)
+	}
+}
+
+// The next page resumes after the sort values in the token, it doesn't need the last video to still exist
+func TestListVideos_NextPageAfterDeletedVideo(t *testing.T) {
+	api, mockDB, teardown := createTestAPIWithPolicy(t)
+	defer teardown()
+
+	createdAt := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+	_, scope, _ := videoListParams(&proto.ListVideosRequest{}, "test-tenant", "test-user-id")
+	token := pagination.EncodeKeyedToken(scope, "deleted-video", videoPageKey{CreatedAt: createdAt, Title: "Gone", DurationSeconds: 7})
+
+	mockDB.EXPECT().
+		GetAccessibleVideosPage(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, params db.GetAccessibleVideosPageParams) ([]db.VideoserviceVideo, error) {
+			if params.AfterID != "deleted-video" || !params.AfterCreatedAt.Equal(createdAt) || params.AfterTitle != "Gone" || params.AfterDurationSeconds != 7 {
+				t.Errorf("Expected the cursor of the token, got %+v", params)
+			}
+			return []db.VideoserviceVideo{{ID: "video-1"}}, nil
+		})
+	mockDB.EXPECT().CountAccessibleVideos(gomock.Any(), gomock.Any()).Return(int64(1), nil)
+	mockDB.EXPECT().GetWatchProgressByVideoIDs(gomock.Any(), gomock.Any()).Return(nil, nil)
+
+	resp, err := api.ListVideos(tenantCtx(t), &proto.ListVideosRequest{PageToken: token})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if len(resp.Videos) != 1 || resp.NextPageToken != "" {
+		t.Errorf("Unexpected last page: %+v", resp)
+	}
+}
+
+func TestListVideos_TokenWithoutSortValues(t *testing.T) {
+	api, _, teardown := createTestAPIWithPolicy(t)
+	defer teardown()
+
+	_, scope, _ := videoListParams(&proto.ListVideosRequest{}, "test-tenant", "test-user-id")
+	_, err := api.ListVideos(tenantCtx(t), &proto.ListVideosRequest{PageToken: pagination.EncodeToken(scope, "video-2")})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("Expected InvalidArgument, got %v", err)
+	}
+}
+
+func TestListVideos_TokenFromOtherFilters(t *testing.T) {
+	api, _, teardown := createTestAPIWithPolicy(t)
+	defer teardown()
+
+	_, scope, _ := videoListParams(&proto.ListVideosRequest{ChannelId: "channel-1"}, "test-tenant", "test-user-id")
+	_, err := api.ListVideos(tenantCtx(t), &proto.ListVideosRequest{
+		ChannelId: "channel-2",
+		PageToken: pagination.EncodeKeyedToken(scope, "video-2", videoPageKey{}),
+	})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("Expected InvalidArgument, got %v", err)
+	}
+}
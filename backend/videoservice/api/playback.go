@@ -0,0 +1,154 @@
+package api
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"sortedstartup.com/stream/videoservice/config"
+)
+
+const (
+	defaultPlaybackURLTTL  = 2 * time.Hour
+	defaultPlaybackBaseURL = "/api/videoservice"
+)
+
+var (
+	errInvalidPlaybackURL = errors.New("invalid playback URL")
+	errPlaybackURLExpired = errors.New("playback URL has expired")
+)
+
+// playbackSigner creates and verifies the signed video URLs returned by GetVideo.
+// A signed URL carries the video, tenant, user and expiry, so it can be served without a cookie or a membership lookup.
+type playbackSigner struct {
+	key     []byte
+	ttl     time.Duration
+	baseURL string
+}
+
+// playbackGrant is what a verified playback URL gives access to
+type playbackGrant struct {
+	VideoID   string
+	TenantID  string
+	UserID    string
+	ExpiresAt time.Time
+}
+
+func newPlaybackSigner(config config.PlaybackConfig, log *slog.Logger) (*playbackSigner, error) {
+	key := []byte(config.SigningKey)
+	if len(key) == 0 {
+		key = make([]byte, 32)
+		_, err := rand.Read(key)
+		if err != nil {
+			return nil, fmt.Errorf("failed to generate playback signing key: %w", err)
+		}
+		log.Warn("No playback signing key configured, signed video URLs stop working on restart and across instances")
+	}
+
+	ttl := config.URLTTL
+	if ttl <= 0 {
+		ttl = defaultPlaybackURLTTL
+	}
+	baseURL := strings.TrimSuffix(config.BaseURL, "/")
+	if baseURL == "" {
+		baseURL = defaultPlaybackBaseURL
+	}
+
+	return &playbackSigner{key: key, ttl: ttl, baseURL: baseURL}, nil
+}
+
+// sign returns the playback URL of a video for a user and when it expires
+func (p *playbackSigner) sign(videoID, tenantID, userID string, now time.Time) (string, time.Time) {
+	expiresAt := now.Add(p.ttl).Truncate(time.Second)
+
+	query := url.Values{}
+	query.Set("tenant", tenantID)
+	query.Set("user", userID)
+	query.Set("expires", strconv.FormatInt(expiresAt.Unix(), 10))
+	query.Set("sig", p.signature(videoID, tenantID, userID, expiresAt.Unix()))
+
+	return p.baseURL + "/video/" + url.PathEscape(videoID) + "?" + query.Encode(), expiresAt
+}
+
+// verify checks the signature and expiry of the query of a playback URL for the video in its path
+func (p *playbackSigner) verify(videoID string, query url.Values, now time.Time) (playbackGrant, error) {
+	tenantID := query.Get("tenant")
+	userID := query.Get("user")
+	expires, err := strconv.ParseInt(query.Get("expires"), 10, 64)
+	if err != nil || tenantID == "" || userID == "" {
+		return playbackGrant{}, errInvalidPlaybackURL
+	}
+
+	expected := p.signature(videoID, tenantID, userID, expires)
+	if !hmac.Equal([]byte(expected), []byte(query.Get("sig"))) {
+		return playbackGrant{}, errInvalidPlaybackURL
+	}
+
+	expiresAt := time.Unix(expires, 0)
+	if now.After(expiresAt) {
+		return playbackGrant{}, errPlaybackURLExpired
+	}
+
+	return playbackGrant{
+		VideoID:   videoID,
+		TenantID:  tenantID,
+		UserID:    userID,
+		ExpiresAt: expiresAt,
+	}, nil
+}
+
+func (p *playbackSigner) signature(videoID, tenantID, userID string, expires int64) string {
+	mac := hmac.New(sha256.New, p.key)
+	fmt.Fprintf(mac, "%s\n%s\n%s\n%d", videoID, tenantID, userID, expires)
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+// videoHandler serves signed playback URLs statelessly and hands every other request to the cookie authenticated handler
+func (api *VideoAPI) videoHandler(cookieHandler http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Has("sig") {
+			api.serveSignedVideoHandler(w, r)
+			return
+		}
+		cookieHandler.ServeHTTP(w, r)
+	})
+}
+
+func (api *VideoAPI) serveSignedVideoHandler(w http.ResponseWriter, r *http.Request) {
+	// Download managers probe with HEAD before fetching
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	videoID := r.URL.Path[len("/video/"):]
+	if videoID == "" {
+		http.Error(w, "Video ID is required", http.StatusBadRequest)
+		return
+	}
+
+	now := time.Now()
+	grant, err := api.playback.verify(videoID, r.URL.Query(), now)
+	if err == errPlaybackURLExpired {
+		http.Error(w, "Playback URL has expired", http.StatusForbidden)
+		return
+	}
+	if err != nil {
+		api.log.Info("Invalid playback URL", "videoID", videoID)
+		http.Error(w, "Forbidden", http.StatusForbidden)
+		return
+	}
+
+	// Browsers may keep the file until the URL expires, shared caches may not keep it at all
+	maxAge := int(grant.ExpiresAt.Sub(now).Seconds())
+	api.serveVideoFile(w, r, grant.VideoID, grant.TenantID, fmt.Sprintf("private, max-age=%d", maxAge))
+}
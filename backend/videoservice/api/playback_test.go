@@ -0,0 +1,193 @@
+package api
+
+import (
+	"database/sql"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"sortedstartup.com/stream/videoservice/config"
+	"sortedstartup.com/stream/videoservice/db"
+)
+
+func newTestPlaybackSigner() *playbackSigner {
+	return &playbackSigner{key: []byte("test-key"), ttl: time.Hour, baseURL: defaultPlaybackBaseURL}
+}
+
+// helper: the path and query of a signed URL as the videoservice mux sees them
+func signedVideoRequest(t *testing.T, method, signedURL string) *http.Request {
+	parsed, err := url.Parse(strings.TrimPrefix(signedURL, defaultPlaybackBaseURL))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return httptest.NewRequest(method, parsed.String(), nil)
+}
+
+func TestPlaybackSigner_SignAndVerify(t *testing.T) {
+	signer := newTestPlaybackSigner()
+	now := time.Now()
+
+	signedURL, expiresAt := signer.sign("video-1", "tenant-1", "user-1", now)
+	if !strings.HasPrefix(signedURL, "/api/videoservice/video/video-1?") {
+		t.Fatalf("Unexpected playback URL %s", signedURL)
+	}
+	parsed, _ := url.Parse(signedURL)
+
+	grant, err := signer.verify("video-1", parsed.Query(), now)
+	if err != nil {
+		t.Fatalf("Expected valid URL, got %v", err)
+	}
+	if grant.TenantID != "tenant-1" || grant.UserID != "user-1" || !grant.ExpiresAt.Equal(expiresAt) {
+		t.Errorf("Unexpected grant %+v", grant)
+	}
+
+	tests := []struct {
+		name    string
+		videoID string
+		modify  func(query url.Values)
+		now     time.Time
+		wantErr error
+	}{
+		{"Other video", "video-2", func(query url.Values) {}, now, errInvalidPlaybackURL},
+		{"Other tenant", "video-1", func(query url.Values) { query.Set("tenant", "tenant-2") }, now, errInvalidPlaybackURL},
+		{"Longer expiry", "video-1", func(query url.Values) { query.Set("expires", "99999999999") }, now, errInvalidPlaybackURL},
+		{"No signature", "video-1", func(query url.Values) { query.Del("sig") }, now, errInvalidPlaybackURL},
+		{"Expired", "video-1", func(query url.Values) {}, now.Add(2 * time.Hour), errPlaybackURLExpired},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			query := parsed.Query()
+			tt.modify(query)
+			_, err := signer.verify(tt.videoID, query, tt.now)
+			if err != tt.wantErr {
+				t.Errorf("Expected %v, got %v", tt.wantErr, err)
+			}
+		})
+	}
+
+	// A different key doesn't accept the URL
+	otherSigner := &playbackSigner{key: []byte("other-key"), ttl: time.Hour}
+	_, err = otherSigner.verify("video-1", parsed.Query(), now)
+	if err != errInvalidPlaybackURL {
+		t.Errorf("Expected invalid URL for another key, got %v", err)
+	}
+}
+
+func TestNewPlaybackSigner_Defaults(t *testing.T) {
+	api := createTestVideoAPI()
+	signer, err := newPlaybackSigner(config.PlaybackConfig{}, api.log)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(signer.key) != 32 || signer.ttl != defaultPlaybackURLTTL || signer.baseURL != defaultPlaybackBaseURL {
+		t.Errorf("Unexpected defaults %+v", signer)
+	}
+
+	signer, err = newPlaybackSigner(config.PlaybackConfig{BaseURL: "https://stream.example.com/api/videoservice/"}, api.log)
+	if err != nil {
+		t.Fatal(err)
+	}
+	signedURL, _ := signer.sign("video-1", "tenant-1", "user-1", time.Now())
+	if !strings.HasPrefix(signedURL, "https://stream.example.com/api/videoservice/video/video-1?") {
+		t.Errorf("Unexpected playback URL %s", signedURL)
+	}
+}
+
+func TestServeSignedVideoHandler_Success(t *testing.T) {
+	api, mockDB, teardown := createTestAPIWithMockDB(t)
+	defer teardown()
+
+	uploadDir := t.TempDir()
+	err := os.WriteFile(filepath.Join(uploadDir, "test-video.mp4"), []byte("dummy video content"), 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	api.config.FileStoreDir = uploadDir
+
+	mockDB.EXPECT().
+		GetVideoByVideoIDAndTenantID(gomock.Any(), db.GetVideoByVideoIDAndTenantIDParams{
+			ID:       "video-1",
+			TenantID: sql.NullString{String: "tenant-1", Valid: true},
+		}).
+		Return(db.VideoserviceVideo{Url: "test-video.mp4"}, nil).
+		Times(2)
+
+	signedURL, _ := api.playback.sign("video-1", "tenant-1", "user-1", time.Now())
+
+	// No cookie and no membership lookup, the signature is enough
+	cookieHandler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Error("Signed URLs must not reach the cookie handler")
+	})
+	rec := httptest.NewRecorder()
+	api.videoHandler(cookieHandler).ServeHTTP(rec, signedVideoRequest(t, http.MethodGet, signedURL))
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("Expected 200, got %d", rec.Code)
+	}
+	if rec.Body.String() != "dummy video content" {
+		t.Errorf("Unexpected body %q", rec.Body.String())
+	}
+	cacheControl := rec.Header().Get("Cache-Control")
+	if !strings.HasPrefix(cacheControl, "private, max-age=") {
+		t.Errorf("Expected private caching, got %s", cacheControl)
+	}
+
+	// Download managers probe with HEAD
+	rec = httptest.NewRecorder()
+	api.videoHandler(cookieHandler).ServeHTTP(rec, signedVideoRequest(t, http.MethodHead, signedURL))
+	if rec.Code != http.StatusOK {
+		t.Errorf("Expected 200 for HEAD, got %d", rec.Code)
+	}
+}
+
+func TestServeSignedVideoHandler_Rejected(t *testing.T) {
+	api, _, teardown := createTestAPIWithMockDB(t)
+	defer teardown()
+
+	signedURL, _ := api.playback.sign("video-1", "tenant-1", "user-1", time.Now())
+	expiredURL, _ := api.playback.sign("video-1", "tenant-1", "user-1", time.Now().Add(-2*time.Hour))
+
+	tests := []struct {
+		name   string
+		method string
+		url    string
+		want   int
+	}{
+		{"Tampered tenant", http.MethodGet, strings.Replace(signedURL, "tenant=tenant-1", "tenant=tenant-2", 1), http.StatusForbidden},
+		{"Other video", http.MethodGet, strings.Replace(signedURL, "/video/video-1", "/video/video-2", 1), http.StatusForbidden},
+		{"Expired", http.MethodGet, expiredURL, http.StatusForbidden},
+		{"Method not allowed", http.MethodPost, signedURL, http.StatusMethodNotAllowed},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			api.serveSignedVideoHandler(rec, signedVideoRequest(t, tt.method, tt.url))
+			if rec.Code != tt.want {
+				t.Errorf("Expected %d, got %d", tt.want, rec.Code)
+			}
+		})
+	}
+}
+
+func TestVideoHandler_WithoutSignatureUsesCookieHandler(t *testing.T) {
+	api := createTestVideoAPI()
+
+	called := false
+	cookieHandler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		called = true
+	})
+	req := httptest.NewRequest(http.MethodGet, "/video/video-1?tenant=tenant-1", nil)
+	api.videoHandler(cookieHandler).ServeHTTP(httptest.NewRecorder(), req)
+
+	if !called {
+		t.Error("Expected unsigned requests to use the cookie handler")
+	}
+}
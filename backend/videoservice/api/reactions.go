@@ -0,0 +1,205 @@
+package api
+
+import (
+	"context"
+	"math"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"sortedstartup.com/stream/videoservice/db"
+	"sortedstartup.com/stream/videoservice/proto"
+)
+
+// maxEmojiBytes leaves room for multi-codepoint emoji (skin tones, ZWJ sequences, flags)
+const maxEmojiBytes = 32
+
+// ===== REACTION METHODS =====
+
+// AddReaction reacts to a moment in a video with an emoji
+func (s *VideoAPI) AddReaction(ctx context.Context, req *proto.AddReactionRequest) (*proto.Reaction, error) {
+	// Common validation
+	authContext, tenantID, err := s.policyValidator.ValidateBasicRequest(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validate input
+	if req.VideoId == "" {
+		return nil, status.Error(codes.InvalidArgument, "video ID is required")
+	}
+	if err := validateEmoji(req.Emoji); err != nil {
+		return nil, err
+	}
+	if math.IsNaN(req.TimestampSeconds) || math.IsInf(req.TimestampSeconds, 0) || req.TimestampSeconds < 0 {
+		return nil, status.Error(codes.InvalidArgument, "timestamp must be a non-negative number of seconds")
+	}
+
+	// Get and validate video
+	video, err := s.policyValidator.GetAndValidateVideo(ctx, req.VideoId, tenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Only viewers of the video can react to it
+	err = s.policyValidator.ValidateVideoViewAccess(ctx, s.channelAPI, video, authContext.User.ID, tenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	reaction, err := s.dbQueries.CreateVideoReaction(ctx, db.CreateVideoReactionParams{
+		ID:               uuid.New().String(),
+		TenantID:         tenantID,
+		VideoID:          req.VideoId,
+		UserID:           authContext.User.ID,
+		Emoji:            req.Emoji,
+		TimestampSeconds: req.TimestampSeconds,
+		CreatedAt:        time.Now(),
+	})
+	if err != nil {
+		s.log.Error("Error creating reaction", "err", err, "videoID", req.VideoId)
+		return nil, status.Error(codes.Internal, "failed to add reaction")
+	}
+
+	return reactionToProto(&reaction), nil
+}
+
+// RemoveReaction deletes one of the caller's own reactions
+func (s *VideoAPI) RemoveReaction(ctx context.Context, req *proto.RemoveReactionRequest) (*proto.RemoveReactionResponse, error) {
+	// Common validation
+	authContext, tenantID, err := s.policyValidator.ValidateBasicRequest(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.ReactionId == "" {
+		return nil, status.Error(codes.InvalidArgument, "reaction ID is required")
+	}
+
+	// Scoped to the caller, so other users' reactions look the same as missing ones
+	deleted, err := s.dbQueries.DeleteVideoReaction(ctx, db.DeleteVideoReactionParams{
+		ID:       req.ReactionId,
+		UserID:   authContext.User.ID,
+		TenantID: tenantID,
+	})
+	if err != nil {
+		s.log.Error("Error deleting reaction", "err", err, "reactionID", req.ReactionId)
+		return nil, status.Error(codes.Internal, "failed to remove reaction")
+	}
+	if deleted == 0 {
+		return nil, status.Error(codes.NotFound, "reaction not found")
+	}
+
+	return &proto.RemoveReactionResponse{
+		Message: "Reaction removed successfully",
+	}, nil
+}
+
+// ListReactions returns every reaction on a video in playback order,
+// along with per-emoji counts for the caller
+func (s *VideoAPI) ListReactions(ctx context.Context, req *proto.ListReactionsRequest) (*proto.ListReactionsResponse, error) {
+	// Common validation
+	authContext, tenantID, err := s.policyValidator.ValidateBasicRequest(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.VideoId == "" {
+		return nil, status.Error(codes.InvalidArgument, "video ID is required")
+	}
+
+	// Get and validate video
+	video, err := s.policyValidator.GetAndValidateVideo(ctx, req.VideoId, tenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.policyValidator.ValidateVideoViewAccess(ctx, s.channelAPI, video, authContext.User.ID, tenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	reactions, err := s.dbQueries.GetVideoReactionsByVideoID(ctx, db.GetVideoReactionsByVideoIDParams{
+		TenantID: tenantID,
+		VideoID:  req.VideoId,
+	})
+	if err != nil {
+		s.log.Error("Error getting reactions", "err", err, "videoID", req.VideoId)
+		return nil, status.Error(codes.Internal, "failed to list reactions")
+	}
+
+	counts, err := s.dbQueries.GetVideoReactionCountsByVideoID(ctx, db.GetVideoReactionCountsByVideoIDParams{
+		UserID:   authContext.User.ID,
+		TenantID: tenantID,
+		VideoID:  req.VideoId,
+	})
+	if err != nil {
+		s.log.Error("Error getting reaction counts", "err", err, "videoID", req.VideoId)
+		return nil, status.Error(codes.Internal, "failed to list reactions")
+	}
+
+	response := &proto.ListReactionsResponse{
+		Reactions: make([]*proto.Reaction, 0, len(reactions)),
+		Counts:    make([]*proto.ReactionCount, 0, len(counts)),
+	}
+	for i := range reactions {
+		response.Reactions = append(response.Reactions, reactionToProto(&reactions[i]))
+	}
+	for _, count := range counts {
+		response.Counts = append(response.Counts, &proto.ReactionCount{
+			Emoji:       count.Emoji,
+			Count:       int32(count.ReactionCount),
+			ReactedByMe: count.MyReactionCount > 0,
+		})
+	}
+
+	return response, nil
+}
+
+// validateEmoji rejects anything that is plainly text rather than an emoji.
+// It does not try to match the full Unicode emoji spec.
+func validateEmoji(emoji string) error {
+	if emoji == "" {
+		return status.Error(codes.InvalidArgument, "emoji is required")
+	}
+	if len(emoji) > maxEmojiBytes || !utf8.ValidString(emoji) {
+		return status.Error(codes.InvalidArgument, "invalid emoji")
+	}
+	if isKeycap(emoji) {
+		return nil
+	}
+	for _, r := range emoji {
+		if r < utf8.RuneSelf || unicode.IsLetter(r) || unicode.IsDigit(r) || unicode.IsSpace(r) {
+			return status.Error(codes.InvalidArgument, "invalid emoji")
+		}
+	}
+	return nil
+}
+
+// isKeycap reports whether the emoji is a keycap sequence like 1️⃣,
+// the only emoji with ASCII in it: a digit, # or * followed by U+FE0F U+20E3
+func isKeycap(emoji string) bool {
+	if len(emoji) == 0 {
+		return false
+	}
+	first := emoji[0]
+	if (first < '0' || first > '9') && first != '#' && first != '*' {
+		return false
+	}
+	return emoji[1:] == "\uFE0F\u20E3"
+}
+
+func reactionToProto(reaction *db.VideoserviceVideoReaction) *proto.Reaction {
+	return &proto.Reaction{
+		Id:               reaction.ID,
+		VideoId:          reaction.VideoID,
+		UserId:           reaction.UserID,
+		Emoji:            reaction.Emoji,
+		TimestampSeconds: reaction.TimestampSeconds,
+		CreatedAt:        timestamppb.New(reaction.CreatedAt),
+	}
+}
@@ -0,0 +1,149 @@
+package api
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"sortedstartup.com/stream/videoservice/db"
+	"sortedstartup.com/stream/videoservice/proto"
+)
+
+func TestAddReaction_InvalidEmoji(t *testing.T) {
+	api, _, teardown := createTestAPIWithPolicy(t)
+	defer teardown()
+
+	for _, emoji := range []string{"", "lol", "👍 ", "👍👍👍👍👍👍👍👍👍"} {
+		_, err := api.AddReaction(tenantCtx(t), &proto.AddReactionRequest{
+			VideoId:          "video-1",
+			Emoji:            emoji,
+			TimestampSeconds: 10,
+		})
+		if status.Code(err) != codes.InvalidArgument {
+			t.Errorf("Expected InvalidArgument for %q, got %v", emoji, err)
+		}
+	}
+}
+
+func TestValidateEmoji(t *testing.T) {
+	tests := []struct {
+		emoji string
+		valid bool
+	}{
+		{"🎉", true},
+		{"👍🏽", true},
+		{"1\uFE0F\u20E3", true},
+		{"#\uFE0F\u20E3", true},
+		{"*\uFE0F\u20E3", true},
+		{"9", false},
+		{"99", false},
+		{"123456", false},
+		{"#*#", false},
+		{"1\u20E3", false},
+		{"11\uFE0F\u20E3", false},
+		{"\uFE0F\u20E31", false},
+		{"👍1", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.emoji, func(t *testing.T) {
+			err := validateEmoji(tt.emoji)
+			if (err == nil) != tt.valid {
+				t.Errorf("validateEmoji(%q) = %v, want valid %v", tt.emoji, err, tt.valid)
+			}
+		})
+	}
+}
+
+func TestAddReaction_Success(t *testing.T) {
+	api, mockDB, teardown := createTestAPIWithPolicy(t)
+	defer teardown()
+
+	mockDB.EXPECT().
+		GetVideoByVideoIDAndTenantID(gomock.Any(), gomock.Any()).
+		Return(db.VideoserviceVideo{ID: "video-1", UploadedUserID: "test-user-id"}, nil).
+		Times(1)
+
+	mockDB.EXPECT().
+		CreateVideoReaction(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, params db.CreateVideoReactionParams) (db.VideoserviceVideoReaction, error) {
+			if params.UserID != "test-user-id" || params.TenantID != "test-tenant" {
+				t.Errorf("Unexpected user/tenant in params: %+v", params)
+			}
+			return db.VideoserviceVideoReaction(params), nil
+		}).
+		Times(1)
+
+	reaction, err := api.AddReaction(tenantCtx(t), &proto.AddReactionRequest{
+		VideoId:          "video-1",
+		Emoji:            "🎉",
+		TimestampSeconds: 12.5,
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if reaction.Emoji != "🎉" || reaction.TimestampSeconds != 12.5 {
+		t.Errorf("Unexpected reaction returned: %+v", reaction)
+	}
+}
+
+func TestRemoveReaction_NotOwn(t *testing.T) {
+	api, mockDB, teardown := createTestAPIWithPolicy(t)
+	defer teardown()
+
+	mockDB.EXPECT().
+		DeleteVideoReaction(gomock.Any(), gomock.Any()).
+		Return(int64(0), nil).
+		Times(1)
+
+	_, err := api.RemoveReaction(tenantCtx(t), &proto.RemoveReactionRequest{ReactionId: "reaction-1"})
+
+	if status.Code(err) != codes.NotFound {
+		t.Errorf("Expected NotFound, got %v", err)
+	}
+}
+
+func TestListReactions_Counts(t *testing.T) {
+	api, mockDB, teardown := createTestAPIWithPolicy(t)
+	defer teardown()
+
+	mockDB.EXPECT().
+		GetVideoByVideoIDAndTenantID(gomock.Any(), gomock.Any()).
+		Return(db.VideoserviceVideo{ID: "video-1", UploadedUserID: "test-user-id"}, nil).
+		Times(1)
+
+	mockDB.EXPECT().
+		GetVideoReactionsByVideoID(gomock.Any(), gomock.Any()).
+		Return([]db.VideoserviceVideoReaction{
+			{ID: "r1", VideoID: "video-1", UserID: "test-user-id", Emoji: "🎉", TimestampSeconds: 3},
+			{ID: "r2", VideoID: "video-1", UserID: "other-user", Emoji: "🎉", TimestampSeconds: 5},
+			{ID: "r3", VideoID: "video-1", UserID: "other-user", Emoji: "😂", TimestampSeconds: 8},
+		}, nil).
+		Times(1)
+
+	mockDB.EXPECT().
+		GetVideoReactionCountsByVideoID(gomock.Any(), gomock.Any()).
+		Return([]db.GetVideoReactionCountsByVideoIDRow{
+			{Emoji: "🎉", ReactionCount: 2, MyReactionCount: 1},
+			{Emoji: "😂", ReactionCount: 1, MyReactionCount: 0},
+		}, nil).
+		Times(1)
+
+	resp, err := api.ListReactions(tenantCtx(t), &proto.ListReactionsRequest{VideoId: "video-1"})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if len(resp.Reactions) != 3 || len(resp.Counts) != 2 {
+		t.Fatalf("Expected 3 reactions and 2 counts, got %d and %d", len(resp.Reactions), len(resp.Counts))
+	}
+	if resp.Counts[0].Count != 2 || !resp.Counts[0].ReactedByMe {
+		t.Errorf("Unexpected first count: %+v", resp.Counts[0])
+	}
+	if resp.Counts[1].ReactedByMe {
+		t.Errorf("Expected second emoji not reacted by me: %+v", resp.Counts[1])
+	}
+}
@@ -0,0 +1,165 @@
+package api
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"sortedstartup.com/stream/common/constants"
+	"sortedstartup.com/stream/videoservice/db"
+	"sortedstartup.com/stream/videoservice/proto"
+)
+
+// TenantCleanupAPI keeps channels consistent when userservice changes tenant membership.
+// It is internal, userservice checks the caller's permissions before calling it.
+type TenantCleanupAPI struct {
+	log       *slog.Logger
+	dbQueries db.DBQuerier
+	videoDir  string // Where the video files are stored, see VideoAPI.getVideoDir
+
+	//implemented proto server
+	proto.UnimplementedTenantCleanupServiceServer
+}
+
+func NewTenantCleanupAPITest(querier db.DBQuerier, logger *slog.Logger) *TenantCleanupAPI {
+	return &TenantCleanupAPI{
+		log:       logger,
+		dbQueries: querier,
+	}
+}
+
+func (s *TenantCleanupAPI) RemoveTenantMember(ctx context.Context, req *proto.RemoveTenantMemberRequest) (*proto.RemoveTenantMemberResponse, error) {
+	if req.TenantId == "" || req.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "tenant ID and user ID are required")
+	}
+	if req.SuccessorUserId == req.UserId {
+		return nil, status.Error(codes.InvalidArgument, "the successor can't be the removed user")
+	}
+
+	memberships, err := s.dbQueries.GetChannelMembershipsByUserAndTenant(ctx, db.GetChannelMembershipsByUserAndTenantParams{
+		UserID:   req.UserId,
+		TenantID: req.TenantId,
+	})
+	if err != nil {
+		s.log.Error("Failed to get channel memberships", "error", err, "tenantID", req.TenantId, "userID", req.UserId)
+		return nil, status.Error(codes.Internal, "failed to remove channel memberships")
+	}
+
+	// Channels would have no owner left, the successor takes them over before the user is removed
+	response := &proto.RemoveTenantMemberResponse{}
+	now := time.Now()
+	for _, membership := range memberships {
+		if membership.Role != constants.ChannelRoleOwner || membership.OtherOwnerCount > 0 {
+			continue
+		}
+		if req.SuccessorUserId == "" {
+			s.log.Warn("Channel left without an owner", "channelID", membership.ChannelID, "tenantID", req.TenantId)
+			continue
+		}
+
+		err = s.dbQueries.UpsertChannelOwner(ctx, db.UpsertChannelOwnerParams{
+			ID:        uuid.New().String(),
+			ChannelID: membership.ChannelID,
+			UserID:    req.SuccessorUserId,
+			AddedBy:   req.UserId,
+			CreatedAt: now,
+		})
+		if err != nil {
+			s.log.Error("Failed to transfer channel ownership", "error", err, "channelID", membership.ChannelID)
+			return nil, status.Error(codes.Internal, "failed to transfer channel ownership")
+		}
+		response.TransferredChannelIds = append(response.TransferredChannelIds, membership.ChannelID)
+	}
+
+	removed, err := s.dbQueries.DeleteChannelMembershipsByUserAndTenant(ctx, db.DeleteChannelMembershipsByUserAndTenantParams{
+		UserID:   req.UserId,
+		TenantID: req.TenantId,
+	})
+	if err != nil {
+		s.log.Error("Failed to remove channel memberships", "error", err, "tenantID", req.TenantId, "userID", req.UserId)
+		return nil, status.Error(codes.Internal, "failed to remove channel memberships")
+	}
+	response.RemovedMemberships = int32(removed)
+
+	// Pending requests would let an owner add someone outside the tenant
+	err = s.dbQueries.DeleteChannelAccessRequestsByUserAndTenant(ctx, db.DeleteChannelAccessRequestsByUserAndTenantParams{
+		UserID:   req.UserId,
+		TenantID: req.TenantId,
+	})
+	if err != nil {
+		s.log.Error("Failed to delete channel access requests", "error", err, "tenantID", req.TenantId, "userID", req.UserId)
+		return nil, status.Error(codes.Internal, "failed to remove channel memberships")
+	}
+
+	s.log.Info("Removed tenant member from channels", "tenantID", req.TenantId, "userID", req.UserId, "removed", removed, "transferred", len(response.TransferredChannelIds))
+	return response, nil
+}
+
+// DeleteTenantData deletes everything videoservice stores for a tenant, userservice calls it
+// once the grace period of a deleted tenant is over. Deleting again is harmless, so a failed purge can be retried.
+func (s *TenantCleanupAPI) DeleteTenantData(ctx context.Context, req *proto.DeleteTenantDataRequest) (*proto.DeleteTenantDataResponse, error) {
+	if req.TenantId == "" {
+		return nil, status.Error(codes.InvalidArgument, "tenant ID is required")
+	}
+	tenantID := sql.NullString{String: req.TenantId, Valid: true}
+
+	videos, err := s.dbQueries.GetVideoFilesByTenantID(ctx, tenantID)
+	if err != nil {
+		s.log.Error("Failed to get tenant videos", "error", err, "tenantID", req.TenantId)
+		return nil, status.Error(codes.Internal, "failed to delete tenant data")
+	}
+
+	// Rows referencing videos and channels go first, the foreign keys aren't enforced
+	err = s.dbQueries.DeleteWatchProgressByTenantID(ctx, req.TenantId)
+	if err != nil {
+		s.log.Error("Failed to delete watch progress", "error", err, "tenantID", req.TenantId)
+		return nil, status.Error(codes.Internal, "failed to delete tenant data")
+	}
+	err = s.dbQueries.DeleteVideoReactionsByTenantID(ctx, req.TenantId)
+	if err != nil {
+		s.log.Error("Failed to delete video reactions", "error", err, "tenantID", req.TenantId)
+		return nil, status.Error(codes.Internal, "failed to delete tenant data")
+	}
+	_, err = s.dbQueries.DeleteVideosByTenantID(ctx, tenantID)
+	if err != nil {
+		s.log.Error("Failed to delete videos", "error", err, "tenantID", req.TenantId)
+		return nil, status.Error(codes.Internal, "failed to delete tenant data")
+	}
+	err = s.dbQueries.DeleteChannelAccessRequestsByTenantID(ctx, req.TenantId)
+	if err != nil {
+		s.log.Error("Failed to delete channel access requests", "error", err, "tenantID", req.TenantId)
+		return nil, status.Error(codes.Internal, "failed to delete tenant data")
+	}
+	err = s.dbQueries.DeleteChannelMembersByTenantID(ctx, req.TenantId)
+	if err != nil {
+		s.log.Error("Failed to delete channel members", "error", err, "tenantID", req.TenantId)
+		return nil, status.Error(codes.Internal, "failed to delete tenant data")
+	}
+	deletedChannels, err := s.dbQueries.DeleteChannelsByTenantID(ctx, req.TenantId)
+	if err != nil {
+		s.log.Error("Failed to delete channels", "error", err, "tenantID", req.TenantId)
+		return nil, status.Error(codes.Internal, "failed to delete tenant data")
+	}
+
+	// The rows are gone, a file that can't be removed is only taking up space
+	response := &proto.DeleteTenantDataResponse{DeletedChannels: int32(deletedChannels)}
+	for _, video := range videos {
+		response.VideoIds = append(response.VideoIds, video.ID)
+
+		path := filepath.Join(s.videoDir, filepath.Base(video.Url))
+		err := os.Remove(path)
+		if err != nil && !errors.Is(err, os.ErrNotExist) {
+			s.log.Error("Failed to remove video file", "error", err, "path", path)
+		}
+	}
+
+	s.log.Info("Deleted tenant data", "tenantID", req.TenantId, "videos", len(videos), "channels", deletedChannels)
+	return response, nil
+}
@@ -0,0 +1,130 @@
+package api
+
+import (
+	"context"
+	"database/sql"
+	"io"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"sortedstartup.com/stream/videoservice/db"
+	"sortedstartup.com/stream/videoservice/db/mocks"
+	"sortedstartup.com/stream/videoservice/proto"
+)
+
+func TestRemoveTenantMember_TransfersSoleOwnedChannels(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockDB := mocks.NewMockDBQuerier(ctrl)
+	api := NewTenantCleanupAPITest(mockDB, slog.New(slog.NewTextHandler(io.Discard, nil)))
+
+	mockDB.EXPECT().
+		GetChannelMembershipsByUserAndTenant(gomock.Any(), db.GetChannelMembershipsByUserAndTenantParams{UserID: "user-1", TenantID: "tenant-1"}).
+		Return([]db.GetChannelMembershipsByUserAndTenantRow{
+			{ChannelID: "sole-owned", Role: "owner", OtherOwnerCount: 0},
+			{ChannelID: "co-owned", Role: "owner", OtherOwnerCount: 1},
+			{ChannelID: "viewed", Role: "viewer", OtherOwnerCount: 0},
+		}, nil)
+
+	// Only the channel without another owner is handed over, before the memberships are removed
+	gomock.InOrder(
+		mockDB.EXPECT().
+			UpsertChannelOwner(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, params db.UpsertChannelOwnerParams) error {
+				if params.ChannelID != "sole-owned" || params.UserID != "admin-1" {
+					t.Errorf("Unexpected ownership transfer %+v", params)
+				}
+				return nil
+			}),
+		mockDB.EXPECT().
+			DeleteChannelMembershipsByUserAndTenant(gomock.Any(), db.DeleteChannelMembershipsByUserAndTenantParams{UserID: "user-1", TenantID: "tenant-1"}).
+			Return(int64(3), nil),
+		mockDB.EXPECT().
+			DeleteChannelAccessRequestsByUserAndTenant(gomock.Any(), db.DeleteChannelAccessRequestsByUserAndTenantParams{UserID: "user-1", TenantID: "tenant-1"}).
+			Return(nil),
+	)
+
+	resp, err := api.RemoveTenantMember(context.Background(), &proto.RemoveTenantMemberRequest{
+		TenantId:        "tenant-1",
+		UserId:          "user-1",
+		SuccessorUserId: "admin-1",
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if resp.RemovedMemberships != 3 {
+		t.Errorf("Expected 3 removed memberships, got %d", resp.RemovedMemberships)
+	}
+	if len(resp.TransferredChannelIds) != 1 || resp.TransferredChannelIds[0] != "sole-owned" {
+		t.Errorf("Expected sole-owned to be transferred, got %v", resp.TransferredChannelIds)
+	}
+}
+
+func TestRemoveTenantMember_InvalidArguments(t *testing.T) {
+	api := NewTenantCleanupAPITest(nil, slog.New(slog.NewTextHandler(io.Discard, nil)))
+
+	tests := []struct {
+		name string
+		req  *proto.RemoveTenantMemberRequest
+	}{
+		{"Missing tenant", &proto.RemoveTenantMemberRequest{UserId: "user-1"}},
+		{"Missing user", &proto.RemoveTenantMemberRequest{TenantId: "tenant-1"}},
+		{"Successor is the removed user", &proto.RemoveTenantMemberRequest{TenantId: "tenant-1", UserId: "user-1", SuccessorUserId: "user-1"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := api.RemoveTenantMember(context.Background(), tt.req)
+			if status.Code(err) != codes.InvalidArgument {
+				t.Errorf("Expected InvalidArgument, got %v", err)
+			}
+		})
+	}
+}
+
+func TestDeleteTenantData_DeletesRowsAndFiles(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockDB := mocks.NewMockDBQuerier(ctrl)
+	api := NewTenantCleanupAPITest(mockDB, slog.New(slog.NewTextHandler(io.Discard, nil)))
+	api.videoDir = t.TempDir()
+
+	videoFile := filepath.Join(api.videoDir, "video-1.mp4")
+	if err := os.WriteFile(videoFile, []byte("video"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	tenantID := sql.NullString{String: "tenant-1", Valid: true}
+	mockDB.EXPECT().
+		GetVideoFilesByTenantID(gomock.Any(), tenantID).
+		Return([]db.GetVideoFilesByTenantIDRow{
+			{ID: "video-1", Url: "video-1.mp4"},
+			{ID: "video-2", Url: "already-removed.mp4"},
+		}, nil)
+	gomock.InOrder(
+		mockDB.EXPECT().DeleteWatchProgressByTenantID(gomock.Any(), "tenant-1").Return(nil),
+		mockDB.EXPECT().DeleteVideoReactionsByTenantID(gomock.Any(), "tenant-1").Return(nil),
+		mockDB.EXPECT().DeleteVideosByTenantID(gomock.Any(), tenantID).Return(int64(2), nil),
+		mockDB.EXPECT().DeleteChannelAccessRequestsByTenantID(gomock.Any(), "tenant-1").Return(nil),
+		mockDB.EXPECT().DeleteChannelMembersByTenantID(gomock.Any(), "tenant-1").Return(nil),
+		mockDB.EXPECT().DeleteChannelsByTenantID(gomock.Any(), "tenant-1").Return(int64(1), nil),
+	)
+
+	resp, err := api.DeleteTenantData(context.Background(), &proto.DeleteTenantDataRequest{TenantId: "tenant-1"})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if len(resp.VideoIds) != 2 || resp.DeletedChannels != 1 {
+		t.Errorf("Unexpected response %+v", resp)
+	}
+	if _, err := os.Stat(videoFile); !os.IsNotExist(err) {
+		t.Errorf("Expected the video file to be removed, got %v", err)
+	}
+}
@@ -0,0 +1,167 @@
+package api
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"sortedstartup.com/stream/common/pagination"
+	"sortedstartup.com/stream/videoservice/db"
+	"sortedstartup.com/stream/videoservice/proto"
+)
+
+// maxChannelFilter bounds the channel_ids of a ListVideos request
+const maxChannelFilter = 50
+
+// videoStatusToDB maps the proto status to the status column, "" for any status
+var videoStatusToDB = map[proto.VideoStatus]string{
+	proto.VideoStatus_STATUS_UNSPECIFIED: "",
+	proto.VideoStatus_STATUS_PROCESSING:  "processing",
+	proto.VideoStatus_STATUS_READY:       "ready",
+	proto.VideoStatus_STATUS_FAILED:      "failed",
+}
+
+func videoStatusFromDB(status string) proto.VideoStatus {
+	for protoStatus, dbStatus := range videoStatusToDB {
+		if dbStatus == status && dbStatus != "" {
+			return protoStatus
+		}
+	}
+	return proto.VideoStatus_STATUS_UNSPECIFIED
+}
+
+// videoSortOrder returns the sort_order of GetAccessibleVideosPage for a sort field and direction
+func videoSortOrder(field proto.VideoSortField, direction proto.SortDirection) (string, error) {
+	var column string
+	ascending := false
+	switch field {
+	case proto.VideoSortField_VIDEO_SORT_CREATED_AT:
+		column = "created_at"
+	case proto.VideoSortField_VIDEO_SORT_TITLE:
+		column = "title"
+		ascending = true // A-Z
+	case proto.VideoSortField_VIDEO_SORT_DURATION:
+		column = "duration"
+	default:
+		return "", status.Error(codes.InvalidArgument, "invalid sort field")
+	}
+
+	switch direction {
+	case proto.SortDirection_SORT_DIRECTION_DEFAULT:
+	case proto.SortDirection_SORT_DIRECTION_ASC:
+		ascending = true
+	case proto.SortDirection_SORT_DIRECTION_DESC:
+		ascending = false
+	default:
+		return "", status.Error(codes.InvalidArgument, "invalid sort direction")
+	}
+
+	if ascending {
+		return column + "_asc", nil
+	}
+	return column + "_desc", nil
+}
+
+// videoListParams validates the filters and sort of a ListVideos request.
+// The returned scope covers all of them, so a page token only resumes the same listing.
+func videoListParams(req *proto.ListVideosRequest, tenantID, userID string) (db.GetAccessibleVideosPageParams, string, error) {
+	params := db.GetAccessibleVideosPageParams{
+		TenantID:           sql.NullString{String: tenantID, Valid: true},
+		UserID:             userID,
+		UploaderID:         req.UploaderId,
+		MinDurationSeconds: req.MinDurationSeconds,
+		MaxDurationSeconds: req.MaxDurationSeconds,
+	}
+
+	channelIDs := slices.Clone(req.ChannelIds)
+	if req.ChannelId != "" {
+		channelIDs = append(channelIDs, req.ChannelId)
+	}
+	slices.Sort(channelIDs)
+	channelIDs = slices.Compact(channelIDs)
+	if len(channelIDs) > maxChannelFilter {
+		return params, "", status.Errorf(codes.InvalidArgument, "at most %d channels can be filtered on", maxChannelFilter)
+	}
+	if slices.Contains(channelIDs, "") {
+		return params, "", status.Error(codes.InvalidArgument, "channel IDs must not be empty")
+	}
+	// The query takes the IDs as a JSON array, an empty one when they aren't filtered on
+	channelIDsJSON, err := json.Marshal(append([]string{}, channelIDs...))
+	if err != nil {
+		return params, "", status.Error(codes.Internal, "failed to filter channels")
+	}
+	params.ChannelIds = string(channelIDsJSON)
+	params.FilterChannels = len(channelIDs) > 0
+
+	// created_at is stored as text in the server's local time, the bounds must be in the same zone to compare
+	if req.CreatedAfter != nil {
+		params.CreatedAfter = sql.NullTime{Time: req.CreatedAfter.AsTime().Local(), Valid: true}
+	}
+	if req.CreatedBefore != nil {
+		params.CreatedBefore = sql.NullTime{Time: req.CreatedBefore.AsTime().Local(), Valid: true}
+	}
+	if params.CreatedAfter.Valid && params.CreatedBefore.Valid && !params.CreatedAfter.Time.Before(params.CreatedBefore.Time) {
+		return params, "", status.Error(codes.InvalidArgument, "created_after must be before created_before")
+	}
+
+	videoStatus, ok := videoStatusToDB[req.Status]
+	if !ok {
+		return params, "", status.Error(codes.InvalidArgument, "invalid status")
+	}
+	params.Status = videoStatus
+
+	if req.MinDurationSeconds < 0 || req.MaxDurationSeconds < 0 {
+		return params, "", status.Error(codes.InvalidArgument, "durations must not be negative")
+	}
+	if req.MaxDurationSeconds > 0 && req.MinDurationSeconds > req.MaxDurationSeconds {
+		return params, "", status.Error(codes.InvalidArgument, "min_duration_seconds must not exceed max_duration_seconds")
+	}
+
+	sortOrder, err := videoSortOrder(req.SortBy, req.SortDirection)
+	if err != nil {
+		return params, "", err
+	}
+	params.SortOrder = sortOrder
+
+	// The user is implied by the auth context
+	scope := fmt.Sprintf("videos:%s:channels=%s:uploader=%s:status=%s:duration=%d-%d",
+		sortOrder, strings.Join(channelIDs, ","), req.UploaderId, videoStatus, req.MinDurationSeconds, req.MaxDurationSeconds)
+	if params.CreatedAfter.Valid {
+		scope += fmt.Sprintf(":after=%d", params.CreatedAfter.Time.UnixNano())
+	}
+	if params.CreatedBefore.Valid {
+		scope += fmt.Sprintf(":before=%d", params.CreatedBefore.Time.UnixNano())
+	}
+	return params, scope, nil
+}
+
+// videoPageKey are the sort values of the last video of a page, carried in the page token
+type videoPageKey struct {
+	CreatedAt       time.Time `json:"c"`
+	Title           string    `json:"t"`
+	DurationSeconds int64     `json:"d"`
+}
+
+func videoPageKeyOf(video db.VideoserviceVideo) any {
+	return videoPageKey{CreatedAt: video.CreatedAt, Title: video.Title, DurationSeconds: video.DurationSeconds}
+}
+
+// setVideoPageCursor sets where GetAccessibleVideosPage resumes from the page token
+func setVideoPageCursor(params *db.GetAccessibleVideosPageParams, pageToken, scope string) error {
+	var key videoPageKey
+	afterID, err := pagination.DecodeKeyedToken(scope, pageToken, &key)
+	if err != nil {
+		return status.Error(codes.InvalidArgument, "invalid page token")
+	}
+	params.AfterID = afterID
+	// Compared with the stored text like the created_at filters, so in the server's local time
+	params.AfterCreatedAt = key.CreatedAt.Local()
+	params.AfterTitle = key.Title
+	params.AfterDurationSeconds = key.DurationSeconds
+	return nil
+}
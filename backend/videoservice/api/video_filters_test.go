@@ -0,0 +1,91 @@
+package api
+
+import (
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"sortedstartup.com/stream/videoservice/proto"
+)
+
+func TestVideoSortOrder(t *testing.T) {
+	tests := []struct {
+		field     proto.VideoSortField
+		direction proto.SortDirection
+		want      string
+	}{
+		{proto.VideoSortField_VIDEO_SORT_CREATED_AT, proto.SortDirection_SORT_DIRECTION_DEFAULT, "created_at_desc"},
+		{proto.VideoSortField_VIDEO_SORT_CREATED_AT, proto.SortDirection_SORT_DIRECTION_ASC, "created_at_asc"},
+		{proto.VideoSortField_VIDEO_SORT_TITLE, proto.SortDirection_SORT_DIRECTION_DEFAULT, "title_asc"},
+		{proto.VideoSortField_VIDEO_SORT_TITLE, proto.SortDirection_SORT_DIRECTION_DESC, "title_desc"},
+		{proto.VideoSortField_VIDEO_SORT_DURATION, proto.SortDirection_SORT_DIRECTION_DEFAULT, "duration_desc"},
+		{proto.VideoSortField_VIDEO_SORT_DURATION, proto.SortDirection_SORT_DIRECTION_ASC, "duration_asc"},
+	}
+
+	for _, tt := range tests {
+		got, err := videoSortOrder(tt.field, tt.direction)
+		if err != nil || got != tt.want {
+			t.Errorf("videoSortOrder(%v, %v) = %q, %v, want %q", tt.field, tt.direction, got, err, tt.want)
+		}
+	}
+
+	_, err := videoSortOrder(proto.VideoSortField(42), proto.SortDirection_SORT_DIRECTION_DEFAULT)
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("Expected InvalidArgument for an unknown sort field, got %v", err)
+	}
+}
+
+func TestVideoListParams(t *testing.T) {
+	day := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	params, _, err := videoListParams(&proto.ListVideosRequest{
+		ChannelId:          "channel-2",
+		ChannelIds:         []string{"channel-1", "channel-2"},
+		UploaderId:         "user-2",
+		CreatedAfter:       timestamppb.New(day),
+		CreatedBefore:      timestamppb.New(day.AddDate(0, 0, 7)),
+		Status:             proto.VideoStatus_STATUS_READY,
+		MaxDurationSeconds: 600,
+	}, "test-tenant", "test-user-id")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if !params.FilterChannels || params.ChannelIds != `["channel-1","channel-2"]` {
+		t.Errorf("Expected channel_id and channel_ids merged into 2 channels, got %+v", params.ChannelIds)
+	}
+	if params.UploaderID != "user-2" || params.Status != "ready" || params.MaxDurationSeconds != 600 {
+		t.Errorf("Unexpected filters: %+v", params)
+	}
+	if !params.CreatedAfter.Valid || !params.CreatedAfter.Time.Equal(day) || !params.CreatedBefore.Valid {
+		t.Errorf("Unexpected date range: %v - %v", params.CreatedAfter, params.CreatedBefore)
+	}
+	if params.SortOrder != "created_at_desc" {
+		t.Errorf("Expected newest first by default, got %q", params.SortOrder)
+	}
+}
+
+func TestVideoListParams_Invalid(t *testing.T) {
+	now := time.Now()
+	tests := []struct {
+		name string
+		req  *proto.ListVideosRequest
+	}{
+		{"Empty channel", &proto.ListVideosRequest{ChannelIds: []string{""}}},
+		{"Reversed dates", &proto.ListVideosRequest{CreatedAfter: timestamppb.New(now), CreatedBefore: timestamppb.New(now.Add(-time.Hour))}},
+		{"Unknown status", &proto.ListVideosRequest{Status: proto.VideoStatus(42)}},
+		{"Negative duration", &proto.ListVideosRequest{MinDurationSeconds: -1}},
+		{"Reversed durations", &proto.ListVideosRequest{MinDurationSeconds: 600, MaxDurationSeconds: 60}},
+		{"Unknown direction", &proto.ListVideosRequest{SortDirection: proto.SortDirection(42)}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, _, err := videoListParams(tt.req, "test-tenant", "test-user-id")
+			if status.Code(err) != codes.InvalidArgument {
+				t.Errorf("Expected InvalidArgument, got %v", err)
+			}
+		})
+	}
+}
@@ -19,13 +19,13 @@ import (
 
 // VideoPolicyValidator handles video-related permission checks and validations
 type VideoPolicyValidator struct {
-	dbQueries         *db.Queries
+	dbQueries         db.DBQuerier
 	userServiceClient userProto.UserServiceClient
 	log               *slog.Logger
 }
 
 // NewVideoPolicyValidator creates a new video policy validator
-func NewVideoPolicyValidator(dbQueries *db.Queries, userServiceClient userProto.UserServiceClient, log *slog.Logger) *VideoPolicyValidator {
+func NewVideoPolicyValidator(dbQueries db.DBQuerier, userServiceClient userProto.UserServiceClient, log *slog.Logger) *VideoPolicyValidator {
 	return &VideoPolicyValidator{
 		dbQueries:         dbQueries,
 		userServiceClient: userServiceClient,
@@ -110,6 +110,26 @@ func (v *VideoPolicyValidator) ValidateChannelAccess(ctx context.Context, channe
 	return userRole, nil
 }
 
+// ValidateVideoViewAccess checks if user can view the video
+func (v *VideoPolicyValidator) ValidateVideoViewAccess(ctx context.Context, channelAPI *ChannelAPI, video *db.VideoserviceVideo, userID, tenantID string) error {
+	// Same visibility rules as ListVideos:
+	// 1. For tenant-level videos: Only the uploader can view them
+	// 2. For channel videos: Any member of the channel can view them
+	if video.ChannelID.Valid && video.ChannelID.String != "" {
+		_, err := channelAPI.getUserRoleInChannel(ctx, video.ChannelID.String, userID, tenantID)
+		if err != nil {
+			return status.Error(codes.PermissionDenied, "access denied: you are not a member of this video's channel")
+		}
+		return nil
+	}
+
+	if video.UploadedUserID != userID {
+		return status.Error(codes.PermissionDenied, "access denied: you can only view your own tenant-level videos")
+	}
+
+	return nil
+}
+
 // ValidateVideoMovePermissions checks permissions for moving a video
 func (v *VideoPolicyValidator) ValidateVideoMovePermissions(ctx context.Context, channelAPI *ChannelAPI, video *db.VideoserviceVideo, userID, tenantID, targetChannelID string) error {
 	// Validate that target channel exists and user has uploader+ access
@@ -119,6 +139,12 @@ func (v *VideoPolicyValidator) ValidateVideoMovePermissions(ctx context.Context,
 		return status.Error(codes.PermissionDenied, "access denied: you need uploader or owner access to add videos to the target channel")
 	}
 
+	// Videos can't be moved into or out of archived channels
+	_, err = getActiveChannel(ctx, v.dbQueries, v.log, targetChannelID, tenantID)
+	if err != nil {
+		return err
+	}
+
 	// Permission validation for moving videos:
 	// 1. For tenant-level videos: User must be the uploader (validated in database)
 	// 2. For channel videos: User must be the source channel owner
@@ -128,6 +154,10 @@ func (v *VideoPolicyValidator) ValidateVideoMovePermissions(ctx context.Context,
 		if err != nil {
 			return status.Error(codes.PermissionDenied, "access denied: only the source channel owner can move videos between channels")
 		}
+		_, err = getActiveChannel(ctx, v.dbQueries, v.log, video.ChannelID.String, tenantID)
+		if err != nil {
+			return err
+		}
 	}
 	// Note: For tenant-level videos, uploader ownership is validated in the database query
 
@@ -142,7 +172,14 @@ func (v *VideoPolicyValidator) ValidateVideoRemovalPermissions(ctx context.Conte
 	}
 
 	// Validate that user is the owner of the channel
-	return v.ValidateChannelOwnership(ctx, channelAPI, video.ChannelID.String, userID, tenantID)
+	err := v.ValidateChannelOwnership(ctx, channelAPI, video.ChannelID.String, userID, tenantID)
+	if err != nil {
+		return err
+	}
+
+	// Archived channels keep their videos
+	_, err = getActiveChannel(ctx, v.dbQueries, v.log, video.ChannelID.String, tenantID)
+	return err
 }
 
 // ValidateVideoDeletionPermissions checks permissions for deleting a video
@@ -156,6 +193,10 @@ func (v *VideoPolicyValidator) ValidateVideoDeletionPermissions(ctx context.Cont
 		if err != nil {
 			return status.Error(codes.PermissionDenied, "access denied: only channel owners can delete videos from channels")
 		}
+		_, err = getActiveChannel(ctx, v.dbQueries, v.log, video.ChannelID.String, tenantID)
+		if err != nil {
+			return err
+		}
 	} else {
 		// Video is at tenant-level - only uploader can delete their own videos
 		if video.UploadedUserID != userID {
@@ -169,12 +210,15 @@ func (v *VideoPolicyValidator) ValidateVideoDeletionPermissions(ctx context.Cont
 // ConvertVideoToProto converts a database video to proto format
 func (v *VideoPolicyValidator) ConvertVideoToProto(video *db.VideoserviceVideo) *proto.Video {
 	return &proto.Video{
-		Id:          video.ID,
-		Title:       video.Title,
-		Description: video.Description,
-		Url:         video.Url,
-		ChannelId:   video.ChannelID.String,
-		Visibility:  proto.Visibility_VISIBILITY_PRIVATE,
-		CreatedAt:   timestamppb.New(video.CreatedAt),
+		Id:              video.ID,
+		Title:           video.Title,
+		Description:     video.Description,
+		Url:             video.Url,
+		UserId:          video.UploadedUserID,
+		ChannelId:       video.ChannelID.String,
+		Visibility:      proto.Visibility_VISIBILITY_PRIVATE,
+		CreatedAt:       timestamppb.New(video.CreatedAt),
+		Status:          videoStatusFromDB(video.Status),
+		DurationSeconds: video.DurationSeconds,
 	}
 }
@@ -0,0 +1,73 @@
+package api
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"sortedstartup.com/stream/videoservice/db"
+	"sortedstartup.com/stream/videoservice/proto"
+)
+
+// maxFilterViewers bounds a single FilterVideoViewers call
+const maxFilterViewers = 100
+
+// FilterVideoViewers returns the requested users that can view a video, applying the same
+// rules as ValidateVideoViewAccess. The caller must be able to view the video themselves.
+// Users are expected to be members of the tenant already, this only checks video visibility.
+func (s *VideoAPI) FilterVideoViewers(ctx context.Context, req *proto.FilterVideoViewersRequest) (*proto.FilterVideoViewersResponse, error) {
+	// Common validation
+	authContext, tenantID, err := s.policyValidator.ValidateBasicRequest(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.VideoId == "" {
+		return nil, status.Error(codes.InvalidArgument, "video ID is required")
+	}
+	if len(req.UserIds) > maxFilterViewers {
+		return nil, status.Errorf(codes.InvalidArgument, "at most %d users can be checked at once", maxFilterViewers)
+	}
+
+	// Get and validate video
+	video, err := s.policyValidator.GetAndValidateVideo(ctx, req.VideoId, tenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.policyValidator.ValidateVideoViewAccess(ctx, s.channelAPI, video, authContext.User.ID, tenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Tenant-level videos are only visible to their uploader
+	viewers := map[string]bool{video.UploadedUserID: true}
+
+	// Channel videos are visible to every channel member, loaded in one query
+	if video.ChannelID.Valid && video.ChannelID.String != "" {
+		members, err := s.dbQueries.GetChannelMembersByChannelIDAndTenantID(ctx, db.GetChannelMembersByChannelIDAndTenantIDParams{
+			ChannelID: video.ChannelID.String,
+			TenantID:  tenantID,
+		})
+		if err != nil {
+			s.log.Error("Error getting channel members", "err", err, "channelID", video.ChannelID.String)
+			return nil, status.Error(codes.Internal, "failed to check video viewers")
+		}
+
+		viewers = make(map[string]bool, len(members))
+		for _, member := range members {
+			viewers[member.UserID] = true
+		}
+	}
+
+	response := &proto.FilterVideoViewersResponse{}
+	seen := make(map[string]bool, len(req.UserIds))
+	for _, userID := range req.UserIds {
+		if viewers[userID] && !seen[userID] {
+			response.UserIds = append(response.UserIds, userID)
+			seen[userID] = true
+		}
+	}
+
+	return response, nil
+}
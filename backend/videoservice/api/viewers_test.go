@@ -0,0 +1,32 @@
+package api
+
+import (
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"sortedstartup.com/stream/videoservice/db"
+	"sortedstartup.com/stream/videoservice/proto"
+)
+
+func TestFilterVideoViewers_TenantLevelVideo(t *testing.T) {
+	api, mockDB, teardown := createTestAPIWithPolicy(t)
+	defer teardown()
+
+	mockDB.EXPECT().
+		GetVideoByVideoIDAndTenantID(gomock.Any(), gomock.Any()).
+		Return(db.VideoserviceVideo{ID: "video-1", UploadedUserID: "test-user-id"}, nil).
+		Times(1)
+
+	// Only the uploader can see a video outside of a channel
+	resp, err := api.FilterVideoViewers(tenantCtx(t), &proto.FilterVideoViewersRequest{
+		VideoId: "video-1",
+		UserIds: []string{"someone-else", "test-user-id", "test-user-id"},
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if len(resp.UserIds) != 1 || resp.UserIds[0] != "test-user-id" {
+		t.Errorf("Expected only the uploader, got %v", resp.UserIds)
+	}
+}
@@ -0,0 +1,135 @@
+package api
+
+import (
+	"context"
+	"database/sql"
+	"math"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"sortedstartup.com/stream/videoservice/db"
+	"sortedstartup.com/stream/videoservice/proto"
+)
+
+// ===== WATCH PROGRESS METHODS =====
+
+// SaveWatchProgress stores the caller's last playback position for a video
+func (s *VideoAPI) SaveWatchProgress(ctx context.Context, req *proto.SaveWatchProgressRequest) (*proto.WatchProgress, error) {
+	// Common validation
+	authContext, tenantID, err := s.policyValidator.ValidateBasicRequest(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validate input
+	if req.VideoId == "" {
+		return nil, status.Error(codes.InvalidArgument, "video ID is required")
+	}
+	if math.IsNaN(req.PositionSeconds) || math.IsInf(req.PositionSeconds, 0) || req.PositionSeconds < 0 {
+		return nil, status.Error(codes.InvalidArgument, "position must be a non-negative number of seconds")
+	}
+
+	// Get and validate video
+	video, err := s.policyValidator.GetAndValidateVideo(ctx, req.VideoId, tenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Only viewers of the video can track progress on it
+	err = s.policyValidator.ValidateVideoViewAccess(ctx, s.channelAPI, video, authContext.User.ID, tenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	progress, err := s.dbQueries.UpsertWatchProgress(ctx, db.UpsertWatchProgressParams{
+		ID:              uuid.New().String(),
+		TenantID:        tenantID,
+		VideoID:         req.VideoId,
+		UserID:          authContext.User.ID,
+		PositionSeconds: req.PositionSeconds,
+		Completed:       req.Completed,
+		CreatedAt:       now,
+		UpdatedAt:       now,
+	})
+	if err != nil {
+		s.log.Error("Error saving watch progress", "err", err, "videoID", req.VideoId)
+		return nil, status.Error(codes.Internal, "failed to save watch progress")
+	}
+
+	return watchProgressToProto(&progress), nil
+}
+
+// GetWatchProgress returns the caller's playback position for a video.
+// A video that was never watched returns an empty progress instead of NotFound.
+func (s *VideoAPI) GetWatchProgress(ctx context.Context, req *proto.GetWatchProgressRequest) (*proto.WatchProgress, error) {
+	// Common validation
+	authContext, tenantID, err := s.policyValidator.ValidateBasicRequest(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.VideoId == "" {
+		return nil, status.Error(codes.InvalidArgument, "video ID is required")
+	}
+
+	// Get and validate video
+	video, err := s.policyValidator.GetAndValidateVideo(ctx, req.VideoId, tenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.policyValidator.ValidateVideoViewAccess(ctx, s.channelAPI, video, authContext.User.ID, tenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	progress, err := s.dbQueries.GetWatchProgress(ctx, db.GetWatchProgressParams{
+		VideoID:  req.VideoId,
+		UserID:   authContext.User.ID,
+		TenantID: tenantID,
+	})
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return &proto.WatchProgress{VideoId: req.VideoId}, nil
+		}
+		s.log.Error("Error getting watch progress", "err", err, "videoID", req.VideoId)
+		return nil, status.Error(codes.Internal, "failed to get watch progress")
+	}
+
+	return watchProgressToProto(&progress), nil
+}
+
+// getWatchProgressMap loads the user's progress on the given videos keyed by video ID,
+// so listing videos needs a single query instead of one per video
+func (s *VideoAPI) getWatchProgressMap(ctx context.Context, tenantID, userID string, videoIDs []string) (map[string]*proto.WatchProgress, error) {
+	if len(videoIDs) == 0 {
+		return map[string]*proto.WatchProgress{}, nil
+	}
+	rows, err := s.dbQueries.GetWatchProgressByVideoIDs(ctx, db.GetWatchProgressByVideoIDsParams{
+		TenantID: tenantID,
+		UserID:   userID,
+		VideoIds: videoIDs,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	progressMap := make(map[string]*proto.WatchProgress, len(rows))
+	for i := range rows {
+		progressMap[rows[i].VideoID] = watchProgressToProto(&rows[i])
+	}
+	return progressMap, nil
+}
+
+func watchProgressToProto(progress *db.VideoserviceWatchProgress) *proto.WatchProgress {
+	return &proto.WatchProgress{
+		VideoId:         progress.VideoID,
+		PositionSeconds: progress.PositionSeconds,
+		Completed:       progress.Completed,
+		UpdatedAt:       timestamppb.New(progress.UpdatedAt),
+	}
+}
@@ -0,0 +1,145 @@
+package api
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"sortedstartup.com/stream/common/interceptors"
+	userProto "sortedstartup.com/stream/userservice/proto"
+	"sortedstartup.com/stream/videoservice/db"
+	"sortedstartup.com/stream/videoservice/db/mocks"
+	"sortedstartup.com/stream/videoservice/proto"
+)
+
+// helper: create a test API whose policy validator uses the mock db and a user in "test-tenant"
+func createTestAPIWithPolicy(t *testing.T) (*VideoAPI, *mocks.MockDBQuerier, func()) {
+	api, mockDB, teardown := createTestAPIWithMockDB(t)
+
+	mockUser := userProto.NewMockUserServiceClient(gomock.NewController(t))
+	mockUser.EXPECT().
+		GetTenants(gomock.Any(), gomock.Any()).
+		Return(&userProto.GetTenantsResponse{
+			TenantUsers: []*userProto.TenantUser{
+				{Tenant: &userProto.Tenant{Id: "test-tenant"}},
+			},
+		}, nil).
+		AnyTimes()
+
+	api.userServiceClient = mockUser
+	api.policyValidator = NewVideoPolicyValidator(mockDB, mockUser, api.log)
+	return api, mockDB, teardown
+}
+
+// helper: authenticated gRPC context with the tenant header already extracted
+func tenantCtx(t *testing.T) context.Context {
+	ctx := metadata.NewIncomingContext(authCtx(), metadata.Pairs(interceptors.TENANT_ID_HEADER, "test-tenant"))
+	var out context.Context
+	_, err := interceptors.TenantInterceptor()(ctx, nil, nil, func(ctx context.Context, req interface{}) (interface{}, error) {
+		out = ctx
+		return nil, nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return out
+}
+
+func TestSaveWatchProgress_InvalidPosition(t *testing.T) {
+	api, _, teardown := createTestAPIWithPolicy(t)
+	defer teardown()
+
+	_, err := api.SaveWatchProgress(tenantCtx(t), &proto.SaveWatchProgressRequest{
+		VideoId:         "video-1",
+		PositionSeconds: -5,
+	})
+
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("Expected InvalidArgument, got %v", err)
+	}
+}
+
+func TestSaveWatchProgress_OtherUsersPrivateVideo(t *testing.T) {
+	api, mockDB, teardown := createTestAPIWithPolicy(t)
+	defer teardown()
+
+	mockDB.EXPECT().
+		GetVideoByVideoIDAndTenantID(gomock.Any(), gomock.Any()).
+		Return(db.VideoserviceVideo{ID: "video-1", UploadedUserID: "someone-else"}, nil).
+		Times(1)
+
+	_, err := api.SaveWatchProgress(tenantCtx(t), &proto.SaveWatchProgressRequest{
+		VideoId:         "video-1",
+		PositionSeconds: 42,
+	})
+
+	if status.Code(err) != codes.PermissionDenied {
+		t.Errorf("Expected PermissionDenied, got %v", err)
+	}
+}
+
+func TestSaveWatchProgress_Success(t *testing.T) {
+	api, mockDB, teardown := createTestAPIWithPolicy(t)
+	defer teardown()
+
+	mockDB.EXPECT().
+		GetVideoByVideoIDAndTenantID(gomock.Any(), gomock.Any()).
+		Return(db.VideoserviceVideo{ID: "video-1", UploadedUserID: "test-user-id"}, nil).
+		Times(1)
+
+	mockDB.EXPECT().
+		UpsertWatchProgress(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, params db.UpsertWatchProgressParams) (db.VideoserviceWatchProgress, error) {
+			if params.UserID != "test-user-id" || params.TenantID != "test-tenant" {
+				t.Errorf("Unexpected user/tenant in params: %+v", params)
+			}
+			return db.VideoserviceWatchProgress{
+				VideoID:         params.VideoID,
+				PositionSeconds: params.PositionSeconds,
+				Completed:       params.Completed,
+				UpdatedAt:       params.UpdatedAt,
+			}, nil
+		}).
+		Times(1)
+
+	progress, err := api.SaveWatchProgress(tenantCtx(t), &proto.SaveWatchProgressRequest{
+		VideoId:         "video-1",
+		PositionSeconds: 42.5,
+		Completed:       true,
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if progress.PositionSeconds != 42.5 || !progress.Completed {
+		t.Errorf("Unexpected progress returned: %+v", progress)
+	}
+}
+
+func TestGetWatchProgress_NeverWatched(t *testing.T) {
+	api, mockDB, teardown := createTestAPIWithPolicy(t)
+	defer teardown()
+
+	mockDB.EXPECT().
+		GetVideoByVideoIDAndTenantID(gomock.Any(), gomock.Any()).
+		Return(db.VideoserviceVideo{ID: "video-1", UploadedUserID: "test-user-id"}, nil).
+		Times(1)
+
+	mockDB.EXPECT().
+		GetWatchProgress(gomock.Any(), gomock.Any()).
+		Return(db.VideoserviceWatchProgress{}, sql.ErrNoRows).
+		Times(1)
+
+	progress, err := api.GetWatchProgress(tenantCtx(t), &proto.GetWatchProgressRequest{VideoId: "video-1"})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if progress.VideoId != "video-1" || progress.PositionSeconds != 0 || progress.Completed {
+		t.Errorf("Expected empty progress, got %+v", progress)
+	}
+}
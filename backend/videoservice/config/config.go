@@ -1,11 +1,24 @@
 package config
 
+import "time"
+
 type VideoServiceConfig struct {
-	DB           DBConfig `json:"db" mapstructure:"db"`
-	FileStoreDir string   `json:"fileStoreDir" mapstructure:"fileStoreDir"`
+	DB           DBConfig       `json:"db" mapstructure:"db"`
+	FileStoreDir string         `json:"fileStoreDir" mapstructure:"fileStoreDir"`
+	Playback     PlaybackConfig `json:"playback" mapstructure:"playback"`
 }
 
 type DBConfig struct {
 	Driver string `json:"driver" mapstructure:"driver"`
 	Url    string `json:"url" mapstructure:"url"`
 }
+
+// PlaybackConfig configures the signed URLs GetVideo returns for players and downloads
+type PlaybackConfig struct {
+	// SigningKey is the HMAC key of the URLs, a random key per process is used when empty
+	SigningKey string `json:"signingKey" mapstructure:"signingKey"`
+	// URLTTL is how long a signed URL stays valid
+	URLTTL time.Duration `json:"urlTTL" mapstructure:"urlTTL"`
+	// BaseURL is where the videoservice HTTP handlers are served, it can be absolute for links outside the webapp
+	BaseURL string `json:"baseUrl" mapstructure:"baseUrl"`
+}
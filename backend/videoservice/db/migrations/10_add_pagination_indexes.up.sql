@@ -0,0 +1,10 @@
+-- Keyset pagination walks videos newest first with the id as tie breaker.
+-- Partial indexes, ListVideos never returns deleted videos.
+CREATE INDEX idx_videoservice_videos_tenant_created ON videoservice_videos(tenant_id, created_at DESC, id DESC) WHERE is_deleted = FALSE;
+CREATE INDEX idx_videoservice_videos_tenant_channel_created ON videoservice_videos(tenant_id, channel_id, created_at DESC, id DESC) WHERE is_deleted = FALSE;
+
+-- Channels of a user, for the accessible videos and GetChannels
+CREATE INDEX idx_videoservice_channel_members_user_channel ON videoservice_channel_members(user_id, channel_id, role);
+CREATE INDEX idx_videoservice_channels_tenant_created_id ON videoservice_channels(tenant_id, created_at DESC, id DESC);
+-- Superseded by the index above
+DROP INDEX IF EXISTS idx_videoservice_channels_tenant_created;
@@ -0,0 +1,8 @@
+-- Processing status and duration of videos, so ListVideos can filter and sort on them.
+-- Uploads are stored before the request returns, so existing videos are ready.
+-- A duration of 0 means it is not known yet.
+ALTER TABLE videoservice_videos ADD COLUMN status TEXT NOT NULL DEFAULT 'ready' CHECK (status IN ('processing', 'ready', 'failed'));
+ALTER TABLE videoservice_videos ADD COLUMN duration_seconds INTEGER NOT NULL DEFAULT 0;
+
+-- "My uploads" and "uploaded by" filters
+CREATE INDEX idx_videoservice_videos_tenant_uploader_created ON videoservice_videos(tenant_id, uploaded_user_id, created_at DESC, id DESC) WHERE is_deleted = FALSE;
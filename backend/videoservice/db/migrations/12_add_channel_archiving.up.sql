@@ -0,0 +1,4 @@
+-- Archived channels are read-only and hidden from the channel list unless asked for.
+-- Unarchiving clears both columns.
+ALTER TABLE videoservice_channels ADD COLUMN archived_at TIMESTAMP;
+ALTER TABLE videoservice_channels ADD COLUMN archived_by TEXT; -- References userservice_users(id) but no FK constraint
@@ -0,0 +1,3 @@
+-- Who changed a channel member's role last, and when. Unset until the role is first changed.
+ALTER TABLE videoservice_channel_members ADD COLUMN role_updated_by TEXT; -- References userservice_users(id) but no FK constraint
+ALTER TABLE videoservice_channel_members ADD COLUMN role_updated_at TIMESTAMP;
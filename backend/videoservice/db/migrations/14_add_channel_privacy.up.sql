@@ -0,0 +1,21 @@
+-- Open channels can be joined by any tenant member with the channel's default role,
+-- closed ones (all existing channels) take an owner's approval.
+ALTER TABLE videoservice_channels ADD COLUMN privacy TEXT NOT NULL DEFAULT 'closed' CHECK (privacy IN ('open', 'closed'));
+ALTER TABLE videoservice_channels ADD COLUMN default_role TEXT NOT NULL DEFAULT 'viewer' CHECK (default_role IN ('uploader', 'viewer'));
+
+-- Requests to join closed channels, kept once decided
+CREATE TABLE videoservice_channel_access_requests (
+    id TEXT PRIMARY KEY,
+    channel_id TEXT NOT NULL REFERENCES videoservice_channels(id) ON DELETE CASCADE,
+    tenant_id TEXT NOT NULL, -- References userservice_tenants(id) but no FK constraint
+    user_id TEXT NOT NULL, -- References userservice_users(id) but no FK constraint
+    message TEXT NOT NULL DEFAULT '',
+    status TEXT NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'approved', 'denied')),
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    decided_by TEXT, -- References userservice_users(id) but no FK constraint
+    decided_at TIMESTAMP
+);
+
+-- One pending request per user and channel
+CREATE UNIQUE INDEX idx_videoservice_channel_access_requests_pending ON videoservice_channel_access_requests(channel_id, user_id) WHERE status = 'pending';
+CREATE INDEX idx_videoservice_channel_access_requests_tenant_user ON videoservice_channel_access_requests(tenant_id, user_id);
@@ -0,0 +1,17 @@
+-- Track per-user playback position so viewers can resume where they left off
+-- One row per (user, video), updated in place as the viewer watches
+
+CREATE TABLE videoservice_watch_progress (
+    id TEXT PRIMARY KEY,
+    tenant_id TEXT NOT NULL, -- References userservice_tenants(id) but no FK constraint
+    video_id TEXT NOT NULL REFERENCES videoservice_videos(id) ON DELETE CASCADE,
+    user_id TEXT NOT NULL, -- References userservice_users(id) but no FK constraint
+    position_seconds REAL NOT NULL DEFAULT 0,
+    completed BOOLEAN NOT NULL DEFAULT FALSE,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE(user_id, video_id)
+);
+
+-- ListVideos loads all progress rows of the caller in a tenant at once
+CREATE INDEX idx_videoservice_watch_progress_tenant_user ON videoservice_watch_progress(tenant_id, user_id);
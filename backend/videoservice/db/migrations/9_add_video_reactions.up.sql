@@ -0,0 +1,16 @@
+-- Emoji reactions anchored to a moment in a video
+-- A user can react with the same emoji at different timestamps, but only once per timestamp
+
+CREATE TABLE videoservice_video_reactions (
+    id TEXT PRIMARY KEY,
+    tenant_id TEXT NOT NULL, -- References userservice_tenants(id) but no FK constraint
+    video_id TEXT NOT NULL REFERENCES videoservice_videos(id) ON DELETE CASCADE,
+    user_id TEXT NOT NULL, -- References userservice_users(id) but no FK constraint
+    emoji TEXT NOT NULL,
+    timestamp_seconds REAL NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE(video_id, user_id, emoji, timestamp_seconds)
+);
+
+-- Reactions are always listed per video in playback order
+CREATE INDEX idx_videoservice_video_reactions_tenant_video ON videoservice_video_reactions(tenant_id, video_id, timestamp_seconds);
@@ -36,6 +36,126 @@ func (m *MockDBQuerier) EXPECT() *MockDBQuerierMockRecorder {
 	return m.recorder
 }
 
+// ArchiveChannel mocks base method.
+func (m *MockDBQuerier) ArchiveChannel(ctx context.Context, params db.ArchiveChannelParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ArchiveChannel", ctx, params)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ArchiveChannel indicates an expected call of ArchiveChannel.
+func (mr *MockDBQuerierMockRecorder) ArchiveChannel(ctx, params interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ArchiveChannel", reflect.TypeOf((*MockDBQuerier)(nil).ArchiveChannel), ctx, params)
+}
+
+// CountAccessibleVideos mocks base method.
+func (m *MockDBQuerier) CountAccessibleVideos(ctx context.Context, params db.CountAccessibleVideosParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountAccessibleVideos", ctx, params)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountAccessibleVideos indicates an expected call of CountAccessibleVideos.
+func (mr *MockDBQuerierMockRecorder) CountAccessibleVideos(ctx, params interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountAccessibleVideos", reflect.TypeOf((*MockDBQuerier)(nil).CountAccessibleVideos), ctx, params)
+}
+
+// CountChannelsForUser mocks base method.
+func (m *MockDBQuerier) CountChannelsForUser(ctx context.Context, params db.CountChannelsForUserParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountChannelsForUser", ctx, params)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountChannelsForUser indicates an expected call of CountChannelsForUser.
+func (mr *MockDBQuerierMockRecorder) CountChannelsForUser(ctx, params interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountChannelsForUser", reflect.TypeOf((*MockDBQuerier)(nil).CountChannelsForUser), ctx, params)
+}
+
+// CountDiscoverableChannels mocks base method.
+func (m *MockDBQuerier) CountDiscoverableChannels(ctx context.Context, params db.CountDiscoverableChannelsParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountDiscoverableChannels", ctx, params)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountDiscoverableChannels indicates an expected call of CountDiscoverableChannels.
+func (mr *MockDBQuerierMockRecorder) CountDiscoverableChannels(ctx, params interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountDiscoverableChannels", reflect.TypeOf((*MockDBQuerier)(nil).CountDiscoverableChannels), ctx, params)
+}
+
+// CreateChannel mocks base method.
+func (m *MockDBQuerier) CreateChannel(ctx context.Context, params db.CreateChannelParams) (db.VideoserviceChannel, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateChannel", ctx, params)
+	ret0, _ := ret[0].(db.VideoserviceChannel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateChannel indicates an expected call of CreateChannel.
+func (mr *MockDBQuerierMockRecorder) CreateChannel(ctx, params interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateChannel", reflect.TypeOf((*MockDBQuerier)(nil).CreateChannel), ctx, params)
+}
+
+// CreateChannelAccessRequest mocks base method.
+func (m *MockDBQuerier) CreateChannelAccessRequest(ctx context.Context, params db.CreateChannelAccessRequestParams) (db.VideoserviceChannelAccessRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateChannelAccessRequest", ctx, params)
+	ret0, _ := ret[0].(db.VideoserviceChannelAccessRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateChannelAccessRequest indicates an expected call of CreateChannelAccessRequest.
+func (mr *MockDBQuerierMockRecorder) CreateChannelAccessRequest(ctx, params interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateChannelAccessRequest", reflect.TypeOf((*MockDBQuerier)(nil).CreateChannelAccessRequest), ctx, params)
+}
+
+// CreateChannelMember mocks base method.
+func (m *MockDBQuerier) CreateChannelMember(ctx context.Context, params db.CreateChannelMemberParams) (db.VideoserviceChannelMember, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateChannelMember", ctx, params)
+	ret0, _ := ret[0].(db.VideoserviceChannelMember)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateChannelMember indicates an expected call of CreateChannelMember.
+func (mr *MockDBQuerierMockRecorder) CreateChannelMember(ctx, params interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateChannelMember", reflect.TypeOf((*MockDBQuerier)(nil).CreateChannelMember), ctx, params)
+}
+
+// CreateVideoReaction mocks base method.
+func (m *MockDBQuerier) CreateVideoReaction(ctx context.Context, params db.CreateVideoReactionParams) (db.VideoserviceVideoReaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateVideoReaction", ctx, params)
+	ret0, _ := ret[0].(db.VideoserviceVideoReaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateVideoReaction indicates an expected call of CreateVideoReaction.
+func (mr *MockDBQuerierMockRecorder) CreateVideoReaction(ctx, params interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateVideoReaction", reflect.TypeOf((*MockDBQuerier)(nil).CreateVideoReaction), ctx, params)
+}
+
 // CreateVideoUploaded mocks base method.
 func (m *MockDBQuerier) CreateVideoUploaded(ctx context.Context, params db.CreateVideoUploadedParams) error {
 	m.ctrl.T.Helper()
@@ -50,19 +170,356 @@ func (mr *MockDBQuerierMockRecorder) CreateVideoUploaded(ctx, params interface{}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateVideoUploaded", reflect.TypeOf((*MockDBQuerier)(nil).CreateVideoUploaded), ctx, params)
 }
 
-// GetAllAccessibleVideosByTenantID mocks base method.
-func (m *MockDBQuerier) GetAllAccessibleVideosByTenantID(ctx context.Context, params db.GetAllAccessibleVideosByTenantIDParams) ([]db.VideoserviceVideo, error) {
+// DecideChannelAccessRequest mocks base method.
+func (m *MockDBQuerier) DecideChannelAccessRequest(ctx context.Context, params db.DecideChannelAccessRequestParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DecideChannelAccessRequest", ctx, params)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DecideChannelAccessRequest indicates an expected call of DecideChannelAccessRequest.
+func (mr *MockDBQuerierMockRecorder) DecideChannelAccessRequest(ctx, params interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DecideChannelAccessRequest", reflect.TypeOf((*MockDBQuerier)(nil).DecideChannelAccessRequest), ctx, params)
+}
+
+// DeleteChannel mocks base method.
+func (m *MockDBQuerier) DeleteChannel(ctx context.Context, params db.DeleteChannelParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteChannel", ctx, params)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteChannel indicates an expected call of DeleteChannel.
+func (mr *MockDBQuerierMockRecorder) DeleteChannel(ctx, params interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteChannel", reflect.TypeOf((*MockDBQuerier)(nil).DeleteChannel), ctx, params)
+}
+
+// DeleteChannelAccessRequestsByChannelID mocks base method.
+func (m *MockDBQuerier) DeleteChannelAccessRequestsByChannelID(ctx context.Context, channelID string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAllAccessibleVideosByTenantID", ctx, params)
+	ret := m.ctrl.Call(m, "DeleteChannelAccessRequestsByChannelID", ctx, channelID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteChannelAccessRequestsByChannelID indicates an expected call of DeleteChannelAccessRequestsByChannelID.
+func (mr *MockDBQuerierMockRecorder) DeleteChannelAccessRequestsByChannelID(ctx, channelID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteChannelAccessRequestsByChannelID", reflect.TypeOf((*MockDBQuerier)(nil).DeleteChannelAccessRequestsByChannelID), ctx, channelID)
+}
+
+// DeleteChannelAccessRequestsByTenantID mocks base method.
+func (m *MockDBQuerier) DeleteChannelAccessRequestsByTenantID(ctx context.Context, tenantID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteChannelAccessRequestsByTenantID", ctx, tenantID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteChannelAccessRequestsByTenantID indicates an expected call of DeleteChannelAccessRequestsByTenantID.
+func (mr *MockDBQuerierMockRecorder) DeleteChannelAccessRequestsByTenantID(ctx, tenantID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteChannelAccessRequestsByTenantID", reflect.TypeOf((*MockDBQuerier)(nil).DeleteChannelAccessRequestsByTenantID), ctx, tenantID)
+}
+
+// DeleteChannelAccessRequestsByUserAndTenant mocks base method.
+func (m *MockDBQuerier) DeleteChannelAccessRequestsByUserAndTenant(ctx context.Context, params db.DeleteChannelAccessRequestsByUserAndTenantParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteChannelAccessRequestsByUserAndTenant", ctx, params)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteChannelAccessRequestsByUserAndTenant indicates an expected call of DeleteChannelAccessRequestsByUserAndTenant.
+func (mr *MockDBQuerierMockRecorder) DeleteChannelAccessRequestsByUserAndTenant(ctx, params interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteChannelAccessRequestsByUserAndTenant", reflect.TypeOf((*MockDBQuerier)(nil).DeleteChannelAccessRequestsByUserAndTenant), ctx, params)
+}
+
+// DeleteChannelMember mocks base method.
+func (m *MockDBQuerier) DeleteChannelMember(ctx context.Context, params db.DeleteChannelMemberParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteChannelMember", ctx, params)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteChannelMember indicates an expected call of DeleteChannelMember.
+func (mr *MockDBQuerierMockRecorder) DeleteChannelMember(ctx, params interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteChannelMember", reflect.TypeOf((*MockDBQuerier)(nil).DeleteChannelMember), ctx, params)
+}
+
+// DeleteChannelMembersByChannelID mocks base method.
+func (m *MockDBQuerier) DeleteChannelMembersByChannelID(ctx context.Context, channelID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteChannelMembersByChannelID", ctx, channelID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteChannelMembersByChannelID indicates an expected call of DeleteChannelMembersByChannelID.
+func (mr *MockDBQuerierMockRecorder) DeleteChannelMembersByChannelID(ctx, channelID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteChannelMembersByChannelID", reflect.TypeOf((*MockDBQuerier)(nil).DeleteChannelMembersByChannelID), ctx, channelID)
+}
+
+// DeleteChannelMembersByTenantID mocks base method.
+func (m *MockDBQuerier) DeleteChannelMembersByTenantID(ctx context.Context, tenantID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteChannelMembersByTenantID", ctx, tenantID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteChannelMembersByTenantID indicates an expected call of DeleteChannelMembersByTenantID.
+func (mr *MockDBQuerierMockRecorder) DeleteChannelMembersByTenantID(ctx, tenantID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteChannelMembersByTenantID", reflect.TypeOf((*MockDBQuerier)(nil).DeleteChannelMembersByTenantID), ctx, tenantID)
+}
+
+// DeleteChannelMembershipsByUserAndTenant mocks base method.
+func (m *MockDBQuerier) DeleteChannelMembershipsByUserAndTenant(ctx context.Context, params db.DeleteChannelMembershipsByUserAndTenantParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteChannelMembershipsByUserAndTenant", ctx, params)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteChannelMembershipsByUserAndTenant indicates an expected call of DeleteChannelMembershipsByUserAndTenant.
+func (mr *MockDBQuerierMockRecorder) DeleteChannelMembershipsByUserAndTenant(ctx, params interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteChannelMembershipsByUserAndTenant", reflect.TypeOf((*MockDBQuerier)(nil).DeleteChannelMembershipsByUserAndTenant), ctx, params)
+}
+
+// DeleteChannelsByTenantID mocks base method.
+func (m *MockDBQuerier) DeleteChannelsByTenantID(ctx context.Context, tenantID string) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteChannelsByTenantID", ctx, tenantID)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteChannelsByTenantID indicates an expected call of DeleteChannelsByTenantID.
+func (mr *MockDBQuerierMockRecorder) DeleteChannelsByTenantID(ctx, tenantID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteChannelsByTenantID", reflect.TypeOf((*MockDBQuerier)(nil).DeleteChannelsByTenantID), ctx, tenantID)
+}
+
+// DeleteVideoReaction mocks base method.
+func (m *MockDBQuerier) DeleteVideoReaction(ctx context.Context, params db.DeleteVideoReactionParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteVideoReaction", ctx, params)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteVideoReaction indicates an expected call of DeleteVideoReaction.
+func (mr *MockDBQuerierMockRecorder) DeleteVideoReaction(ctx, params interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteVideoReaction", reflect.TypeOf((*MockDBQuerier)(nil).DeleteVideoReaction), ctx, params)
+}
+
+// DeleteVideoReactionsByTenantID mocks base method.
+func (m *MockDBQuerier) DeleteVideoReactionsByTenantID(ctx context.Context, tenantID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteVideoReactionsByTenantID", ctx, tenantID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteVideoReactionsByTenantID indicates an expected call of DeleteVideoReactionsByTenantID.
+func (mr *MockDBQuerierMockRecorder) DeleteVideoReactionsByTenantID(ctx, tenantID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteVideoReactionsByTenantID", reflect.TypeOf((*MockDBQuerier)(nil).DeleteVideoReactionsByTenantID), ctx, tenantID)
+}
+
+// DeleteVideosByTenantID mocks base method.
+func (m *MockDBQuerier) DeleteVideosByTenantID(ctx context.Context, tenantID sql.NullString) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteVideosByTenantID", ctx, tenantID)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteVideosByTenantID indicates an expected call of DeleteVideosByTenantID.
+func (mr *MockDBQuerierMockRecorder) DeleteVideosByTenantID(ctx, tenantID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteVideosByTenantID", reflect.TypeOf((*MockDBQuerier)(nil).DeleteVideosByTenantID), ctx, tenantID)
+}
+
+// DeleteWatchProgressByTenantID mocks base method.
+func (m *MockDBQuerier) DeleteWatchProgressByTenantID(ctx context.Context, tenantID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteWatchProgressByTenantID", ctx, tenantID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteWatchProgressByTenantID indicates an expected call of DeleteWatchProgressByTenantID.
+func (mr *MockDBQuerierMockRecorder) DeleteWatchProgressByTenantID(ctx, tenantID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWatchProgressByTenantID", reflect.TypeOf((*MockDBQuerier)(nil).DeleteWatchProgressByTenantID), ctx, tenantID)
+}
+
+// GetAccessibleVideosPage mocks base method.
+func (m *MockDBQuerier) GetAccessibleVideosPage(ctx context.Context, params db.GetAccessibleVideosPageParams) ([]db.VideoserviceVideo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAccessibleVideosPage", ctx, params)
 	ret0, _ := ret[0].([]db.VideoserviceVideo)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetAllAccessibleVideosByTenantID indicates an expected call of GetAllAccessibleVideosByTenantID.
-func (mr *MockDBQuerierMockRecorder) GetAllAccessibleVideosByTenantID(ctx, params interface{}) *gomock.Call {
+// GetAccessibleVideosPage indicates an expected call of GetAccessibleVideosPage.
+func (mr *MockDBQuerierMockRecorder) GetAccessibleVideosPage(ctx, params interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllAccessibleVideosByTenantID", reflect.TypeOf((*MockDBQuerier)(nil).GetAllAccessibleVideosByTenantID), ctx, params)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccessibleVideosPage", reflect.TypeOf((*MockDBQuerier)(nil).GetAccessibleVideosPage), ctx, params)
+}
+
+// GetChannelAccessRequestByID mocks base method.
+func (m *MockDBQuerier) GetChannelAccessRequestByID(ctx context.Context, params db.GetChannelAccessRequestByIDParams) (db.VideoserviceChannelAccessRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetChannelAccessRequestByID", ctx, params)
+	ret0, _ := ret[0].(db.VideoserviceChannelAccessRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetChannelAccessRequestByID indicates an expected call of GetChannelAccessRequestByID.
+func (mr *MockDBQuerierMockRecorder) GetChannelAccessRequestByID(ctx, params interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChannelAccessRequestByID", reflect.TypeOf((*MockDBQuerier)(nil).GetChannelAccessRequestByID), ctx, params)
+}
+
+// GetChannelByIDAndTenantID mocks base method.
+func (m *MockDBQuerier) GetChannelByIDAndTenantID(ctx context.Context, params db.GetChannelByIDAndTenantIDParams) (db.VideoserviceChannel, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetChannelByIDAndTenantID", ctx, params)
+	ret0, _ := ret[0].(db.VideoserviceChannel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetChannelByIDAndTenantID indicates an expected call of GetChannelByIDAndTenantID.
+func (mr *MockDBQuerierMockRecorder) GetChannelByIDAndTenantID(ctx, params interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChannelByIDAndTenantID", reflect.TypeOf((*MockDBQuerier)(nil).GetChannelByIDAndTenantID), ctx, params)
+}
+
+// GetChannelMembersByChannelIDAndTenantID mocks base method.
+func (m *MockDBQuerier) GetChannelMembersByChannelIDAndTenantID(ctx context.Context, params db.GetChannelMembersByChannelIDAndTenantIDParams) ([]db.GetChannelMembersByChannelIDAndTenantIDRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetChannelMembersByChannelIDAndTenantID", ctx, params)
+	ret0, _ := ret[0].([]db.GetChannelMembersByChannelIDAndTenantIDRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetChannelMembersByChannelIDAndTenantID indicates an expected call of GetChannelMembersByChannelIDAndTenantID.
+func (mr *MockDBQuerierMockRecorder) GetChannelMembersByChannelIDAndTenantID(ctx, params interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChannelMembersByChannelIDAndTenantID", reflect.TypeOf((*MockDBQuerier)(nil).GetChannelMembersByChannelIDAndTenantID), ctx, params)
+}
+
+// GetChannelMembershipsByUserAndTenant mocks base method.
+func (m *MockDBQuerier) GetChannelMembershipsByUserAndTenant(ctx context.Context, params db.GetChannelMembershipsByUserAndTenantParams) ([]db.GetChannelMembershipsByUserAndTenantRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetChannelMembershipsByUserAndTenant", ctx, params)
+	ret0, _ := ret[0].([]db.GetChannelMembershipsByUserAndTenantRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetChannelMembershipsByUserAndTenant indicates an expected call of GetChannelMembershipsByUserAndTenant.
+func (mr *MockDBQuerierMockRecorder) GetChannelMembershipsByUserAndTenant(ctx, params interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChannelMembershipsByUserAndTenant", reflect.TypeOf((*MockDBQuerier)(nil).GetChannelMembershipsByUserAndTenant), ctx, params)
+}
+
+// GetChannelsForUserPage mocks base method.
+func (m *MockDBQuerier) GetChannelsForUserPage(ctx context.Context, params db.GetChannelsForUserPageParams) ([]db.GetChannelsForUserPageRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetChannelsForUserPage", ctx, params)
+	ret0, _ := ret[0].([]db.GetChannelsForUserPageRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetChannelsForUserPage indicates an expected call of GetChannelsForUserPage.
+func (mr *MockDBQuerierMockRecorder) GetChannelsForUserPage(ctx, params interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChannelsForUserPage", reflect.TypeOf((*MockDBQuerier)(nil).GetChannelsForUserPage), ctx, params)
+}
+
+// GetDiscoverableChannelsPage mocks base method.
+func (m *MockDBQuerier) GetDiscoverableChannelsPage(ctx context.Context, params db.GetDiscoverableChannelsPageParams) ([]db.GetDiscoverableChannelsPageRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDiscoverableChannelsPage", ctx, params)
+	ret0, _ := ret[0].([]db.GetDiscoverableChannelsPageRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDiscoverableChannelsPage indicates an expected call of GetDiscoverableChannelsPage.
+func (mr *MockDBQuerierMockRecorder) GetDiscoverableChannelsPage(ctx, params interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDiscoverableChannelsPage", reflect.TypeOf((*MockDBQuerier)(nil).GetDiscoverableChannelsPage), ctx, params)
+}
+
+// GetPendingChannelAccessRequest mocks base method.
+func (m *MockDBQuerier) GetPendingChannelAccessRequest(ctx context.Context, params db.GetPendingChannelAccessRequestParams) (db.VideoserviceChannelAccessRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPendingChannelAccessRequest", ctx, params)
+	ret0, _ := ret[0].(db.VideoserviceChannelAccessRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPendingChannelAccessRequest indicates an expected call of GetPendingChannelAccessRequest.
+func (mr *MockDBQuerierMockRecorder) GetPendingChannelAccessRequest(ctx, params interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPendingChannelAccessRequest", reflect.TypeOf((*MockDBQuerier)(nil).GetPendingChannelAccessRequest), ctx, params)
+}
+
+// GetPendingChannelAccessRequests mocks base method.
+func (m *MockDBQuerier) GetPendingChannelAccessRequests(ctx context.Context, params db.GetPendingChannelAccessRequestsParams) ([]db.VideoserviceChannelAccessRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPendingChannelAccessRequests", ctx, params)
+	ret0, _ := ret[0].([]db.VideoserviceChannelAccessRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPendingChannelAccessRequests indicates an expected call of GetPendingChannelAccessRequests.
+func (mr *MockDBQuerierMockRecorder) GetPendingChannelAccessRequests(ctx, params interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPendingChannelAccessRequests", reflect.TypeOf((*MockDBQuerier)(nil).GetPendingChannelAccessRequests), ctx, params)
+}
+
+// GetUserRoleInChannel mocks base method.
+func (m *MockDBQuerier) GetUserRoleInChannel(ctx context.Context, params db.GetUserRoleInChannelParams) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserRoleInChannel", ctx, params)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserRoleInChannel indicates an expected call of GetUserRoleInChannel.
+func (mr *MockDBQuerierMockRecorder) GetUserRoleInChannel(ctx, params interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserRoleInChannel", reflect.TypeOf((*MockDBQuerier)(nil).GetUserRoleInChannel), ctx, params)
 }
 
 // GetVideoByVideoIDAndTenantID mocks base method.
@@ -80,6 +537,66 @@ func (mr *MockDBQuerierMockRecorder) GetVideoByVideoIDAndTenantID(ctx, params in
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVideoByVideoIDAndTenantID", reflect.TypeOf((*MockDBQuerier)(nil).GetVideoByVideoIDAndTenantID), ctx, params)
 }
 
+// GetVideoCountsByChannelIDs mocks base method.
+func (m *MockDBQuerier) GetVideoCountsByChannelIDs(ctx context.Context, params db.GetVideoCountsByChannelIDsParams) ([]db.GetVideoCountsByChannelIDsRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVideoCountsByChannelIDs", ctx, params)
+	ret0, _ := ret[0].([]db.GetVideoCountsByChannelIDsRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVideoCountsByChannelIDs indicates an expected call of GetVideoCountsByChannelIDs.
+func (mr *MockDBQuerierMockRecorder) GetVideoCountsByChannelIDs(ctx, params interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVideoCountsByChannelIDs", reflect.TypeOf((*MockDBQuerier)(nil).GetVideoCountsByChannelIDs), ctx, params)
+}
+
+// GetVideoFilesByTenantID mocks base method.
+func (m *MockDBQuerier) GetVideoFilesByTenantID(ctx context.Context, tenantID sql.NullString) ([]db.GetVideoFilesByTenantIDRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVideoFilesByTenantID", ctx, tenantID)
+	ret0, _ := ret[0].([]db.GetVideoFilesByTenantIDRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVideoFilesByTenantID indicates an expected call of GetVideoFilesByTenantID.
+func (mr *MockDBQuerierMockRecorder) GetVideoFilesByTenantID(ctx, tenantID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVideoFilesByTenantID", reflect.TypeOf((*MockDBQuerier)(nil).GetVideoFilesByTenantID), ctx, tenantID)
+}
+
+// GetVideoReactionCountsByVideoID mocks base method.
+func (m *MockDBQuerier) GetVideoReactionCountsByVideoID(ctx context.Context, params db.GetVideoReactionCountsByVideoIDParams) ([]db.GetVideoReactionCountsByVideoIDRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVideoReactionCountsByVideoID", ctx, params)
+	ret0, _ := ret[0].([]db.GetVideoReactionCountsByVideoIDRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVideoReactionCountsByVideoID indicates an expected call of GetVideoReactionCountsByVideoID.
+func (mr *MockDBQuerierMockRecorder) GetVideoReactionCountsByVideoID(ctx, params interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVideoReactionCountsByVideoID", reflect.TypeOf((*MockDBQuerier)(nil).GetVideoReactionCountsByVideoID), ctx, params)
+}
+
+// GetVideoReactionsByVideoID mocks base method.
+func (m *MockDBQuerier) GetVideoReactionsByVideoID(ctx context.Context, params db.GetVideoReactionsByVideoIDParams) ([]db.VideoserviceVideoReaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVideoReactionsByVideoID", ctx, params)
+	ret0, _ := ret[0].([]db.VideoserviceVideoReaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVideoReactionsByVideoID indicates an expected call of GetVideoReactionsByVideoID.
+func (mr *MockDBQuerierMockRecorder) GetVideoReactionsByVideoID(ctx, params interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVideoReactionsByVideoID", reflect.TypeOf((*MockDBQuerier)(nil).GetVideoReactionsByVideoID), ctx, params)
+}
+
 // GetVideosByTenantID mocks base method.
 func (m *MockDBQuerier) GetVideosByTenantID(ctx context.Context, tenantID sql.NullString) ([]db.VideoserviceVideo, error) {
 	m.ctrl.T.Helper()
@@ -95,19 +612,49 @@ func (mr *MockDBQuerierMockRecorder) GetVideosByTenantID(ctx, tenantID interface
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVideosByTenantID", reflect.TypeOf((*MockDBQuerier)(nil).GetVideosByTenantID), ctx, tenantID)
 }
 
-// GetVideosByTenantIDAndChannelID mocks base method.
-func (m *MockDBQuerier) GetVideosByTenantIDAndChannelID(ctx context.Context, params db.GetVideosByTenantIDAndChannelIDParams) ([]db.VideoserviceVideo, error) {
+// GetWatchProgress mocks base method.
+func (m *MockDBQuerier) GetWatchProgress(ctx context.Context, params db.GetWatchProgressParams) (db.VideoserviceWatchProgress, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetVideosByTenantIDAndChannelID", ctx, params)
-	ret0, _ := ret[0].([]db.VideoserviceVideo)
+	ret := m.ctrl.Call(m, "GetWatchProgress", ctx, params)
+	ret0, _ := ret[0].(db.VideoserviceWatchProgress)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWatchProgress indicates an expected call of GetWatchProgress.
+func (mr *MockDBQuerierMockRecorder) GetWatchProgress(ctx, params interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWatchProgress", reflect.TypeOf((*MockDBQuerier)(nil).GetWatchProgress), ctx, params)
+}
+
+// GetWatchProgressByVideoIDs mocks base method.
+func (m *MockDBQuerier) GetWatchProgressByVideoIDs(ctx context.Context, params db.GetWatchProgressByVideoIDsParams) ([]db.VideoserviceWatchProgress, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWatchProgressByVideoIDs", ctx, params)
+	ret0, _ := ret[0].([]db.VideoserviceWatchProgress)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetVideosByTenantIDAndChannelID indicates an expected call of GetVideosByTenantIDAndChannelID.
-func (mr *MockDBQuerierMockRecorder) GetVideosByTenantIDAndChannelID(ctx, params interface{}) *gomock.Call {
+// GetWatchProgressByVideoIDs indicates an expected call of GetWatchProgressByVideoIDs.
+func (mr *MockDBQuerierMockRecorder) GetWatchProgressByVideoIDs(ctx, params interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVideosByTenantIDAndChannelID", reflect.TypeOf((*MockDBQuerier)(nil).GetVideosByTenantIDAndChannelID), ctx, params)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWatchProgressByVideoIDs", reflect.TypeOf((*MockDBQuerier)(nil).GetWatchProgressByVideoIDs), ctx, params)
+}
+
+// MoveChannelVideos mocks base method.
+func (m *MockDBQuerier) MoveChannelVideos(ctx context.Context, params db.MoveChannelVideosParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MoveChannelVideos", ctx, params)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MoveChannelVideos indicates an expected call of MoveChannelVideos.
+func (mr *MockDBQuerierMockRecorder) MoveChannelVideos(ctx, params interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveChannelVideos", reflect.TypeOf((*MockDBQuerier)(nil).MoveChannelVideos), ctx, params)
 }
 
 // RemoveVideoFromChannel mocks base method.
@@ -124,6 +671,36 @@ func (mr *MockDBQuerierMockRecorder) RemoveVideoFromChannel(ctx, params interfac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveVideoFromChannel", reflect.TypeOf((*MockDBQuerier)(nil).RemoveVideoFromChannel), ctx, params)
 }
 
+// ReturnChannelVideosToUploaders mocks base method.
+func (m *MockDBQuerier) ReturnChannelVideosToUploaders(ctx context.Context, params db.ReturnChannelVideosToUploadersParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReturnChannelVideosToUploaders", ctx, params)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReturnChannelVideosToUploaders indicates an expected call of ReturnChannelVideosToUploaders.
+func (mr *MockDBQuerierMockRecorder) ReturnChannelVideosToUploaders(ctx, params interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReturnChannelVideosToUploaders", reflect.TypeOf((*MockDBQuerier)(nil).ReturnChannelVideosToUploaders), ctx, params)
+}
+
+// SoftDeleteChannelVideos mocks base method.
+func (m *MockDBQuerier) SoftDeleteChannelVideos(ctx context.Context, params db.SoftDeleteChannelVideosParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SoftDeleteChannelVideos", ctx, params)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SoftDeleteChannelVideos indicates an expected call of SoftDeleteChannelVideos.
+func (mr *MockDBQuerierMockRecorder) SoftDeleteChannelVideos(ctx, params interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SoftDeleteChannelVideos", reflect.TypeOf((*MockDBQuerier)(nil).SoftDeleteChannelVideos), ctx, params)
+}
+
 // SoftDeleteVideo mocks base method.
 func (m *MockDBQuerier) SoftDeleteVideo(ctx context.Context, params db.SoftDeleteVideoParams) error {
 	m.ctrl.T.Helper()
@@ -138,6 +715,51 @@ func (mr *MockDBQuerierMockRecorder) SoftDeleteVideo(ctx, params interface{}) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SoftDeleteVideo", reflect.TypeOf((*MockDBQuerier)(nil).SoftDeleteVideo), ctx, params)
 }
 
+// UnarchiveChannel mocks base method.
+func (m *MockDBQuerier) UnarchiveChannel(ctx context.Context, params db.UnarchiveChannelParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnarchiveChannel", ctx, params)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UnarchiveChannel indicates an expected call of UnarchiveChannel.
+func (mr *MockDBQuerierMockRecorder) UnarchiveChannel(ctx, params interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnarchiveChannel", reflect.TypeOf((*MockDBQuerier)(nil).UnarchiveChannel), ctx, params)
+}
+
+// UpdateChannel mocks base method.
+func (m *MockDBQuerier) UpdateChannel(ctx context.Context, params db.UpdateChannelParams) (db.VideoserviceChannel, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateChannel", ctx, params)
+	ret0, _ := ret[0].(db.VideoserviceChannel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateChannel indicates an expected call of UpdateChannel.
+func (mr *MockDBQuerierMockRecorder) UpdateChannel(ctx, params interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateChannel", reflect.TypeOf((*MockDBQuerier)(nil).UpdateChannel), ctx, params)
+}
+
+// UpdateChannelMemberRole mocks base method.
+func (m *MockDBQuerier) UpdateChannelMemberRole(ctx context.Context, params db.UpdateChannelMemberRoleParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateChannelMemberRole", ctx, params)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateChannelMemberRole indicates an expected call of UpdateChannelMemberRole.
+func (mr *MockDBQuerierMockRecorder) UpdateChannelMemberRole(ctx, params interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateChannelMemberRole", reflect.TypeOf((*MockDBQuerier)(nil).UpdateChannelMemberRole), ctx, params)
+}
+
 // UpdateVideoChannel mocks base method.
 func (m *MockDBQuerier) UpdateVideoChannel(ctx context.Context, params db.UpdateVideoChannelParams) error {
 	m.ctrl.T.Helper()
@@ -151,3 +773,32 @@ func (mr *MockDBQuerierMockRecorder) UpdateVideoChannel(ctx, params interface{})
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateVideoChannel", reflect.TypeOf((*MockDBQuerier)(nil).UpdateVideoChannel), ctx, params)
 }
+
+// UpsertChannelOwner mocks base method.
+func (m *MockDBQuerier) UpsertChannelOwner(ctx context.Context, params db.UpsertChannelOwnerParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertChannelOwner", ctx, params)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpsertChannelOwner indicates an expected call of UpsertChannelOwner.
+func (mr *MockDBQuerierMockRecorder) UpsertChannelOwner(ctx, params interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertChannelOwner", reflect.TypeOf((*MockDBQuerier)(nil).UpsertChannelOwner), ctx, params)
+}
+
+// UpsertWatchProgress mocks base method.
+func (m *MockDBQuerier) UpsertWatchProgress(ctx context.Context, params db.UpsertWatchProgressParams) (db.VideoserviceWatchProgress, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertWatchProgress", ctx, params)
+	ret0, _ := ret[0].(db.VideoserviceWatchProgress)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpsertWatchProgress indicates an expected call of UpsertWatchProgress.
+func (mr *MockDBQuerierMockRecorder) UpsertWatchProgress(ctx, params interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertWatchProgress", reflect.TypeOf((*MockDBQuerier)(nil).UpsertWatchProgress), ctx, params)
+}
@@ -17,27 +17,68 @@ type VideoserviceChannel struct {
 	CreatedBy   string
 	CreatedAt   time.Time
 	UpdatedAt   time.Time
+	ArchivedAt  sql.NullTime
+	ArchivedBy  sql.NullString
+	Privacy     string
+	DefaultRole string
 }
 
-type VideoserviceChannelMember struct {
+type VideoserviceChannelAccessRequest struct {
 	ID        string
 	ChannelID string
+	TenantID  string
 	UserID    string
-	Role      string
-	AddedBy   string
+	Message   string
+	Status    string
 	CreatedAt time.Time
+	DecidedBy sql.NullString
+	DecidedAt sql.NullTime
+}
+
+type VideoserviceChannelMember struct {
+	ID            string
+	ChannelID     string
+	UserID        string
+	Role          string
+	AddedBy       string
+	CreatedAt     time.Time
+	RoleUpdatedBy sql.NullString
+	RoleUpdatedAt sql.NullTime
 }
 
 type VideoserviceVideo struct {
-	ID             string
-	Title          string
-	Description    string
-	Url            string
-	CreatedAt      time.Time
-	UploadedUserID string
-	UpdatedAt      time.Time
-	IsPrivate      sql.NullBool
-	TenantID       sql.NullString
-	ChannelID      sql.NullString
-	IsDeleted      sql.NullBool
+	ID              string
+	Title           string
+	Description     string
+	Url             string
+	CreatedAt       time.Time
+	UploadedUserID  string
+	UpdatedAt       time.Time
+	IsPrivate       sql.NullBool
+	TenantID        sql.NullString
+	ChannelID       sql.NullString
+	IsDeleted       sql.NullBool
+	Status          string
+	DurationSeconds int64
+}
+
+type VideoserviceVideoReaction struct {
+	ID               string
+	TenantID         string
+	VideoID          string
+	UserID           string
+	Emoji            string
+	TimestampSeconds float64
+	CreatedAt        time.Time
+}
+
+type VideoserviceWatchProgress struct {
+	ID              string
+	TenantID        string
+	VideoID         string
+	UserID          string
+	PositionSeconds float64
+	Completed       bool
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
 }
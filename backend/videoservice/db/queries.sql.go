@@ -8,9 +8,126 @@ package db
 import (
 	"context"
 	"database/sql"
+	"strings"
 	"time"
 )
 
+const archiveChannel = `-- name: ArchiveChannel :execrows
+UPDATE videoservice_channels
+SET archived_at = ?1, archived_by = ?2, updated_at = ?1
+WHERE id = ?3 AND tenant_id = ?4 AND archived_at IS NULL
+`
+
+type ArchiveChannelParams struct {
+	ArchivedAt sql.NullTime
+	ArchivedBy sql.NullString
+	ID         string
+	TenantID   string
+}
+
+func (q *Queries) ArchiveChannel(ctx context.Context, arg ArchiveChannelParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, archiveChannel,
+		arg.ArchivedAt,
+		arg.ArchivedBy,
+		arg.ID,
+		arg.TenantID,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const countAccessibleVideos = `-- name: CountAccessibleVideos :one
+WITH channel_filter AS (SELECT CAST(?10 AS TEXT) AS channel_ids)
+SELECT COUNT(*) FROM videoservice_videos v
+WHERE v.tenant_id = ?1 AND v.is_deleted = FALSE
+  AND (
+    ((v.channel_id IS NULL OR v.channel_id = '') AND v.uploaded_user_id = ?2)
+    OR v.channel_id IN (SELECT cm.channel_id FROM videoservice_channel_members cm WHERE cm.user_id = ?2)
+  )
+  -- Optional filters, '' / 0 / NULL / FALSE leave them out
+  AND (CAST(?3 AS BOOLEAN) = FALSE OR v.channel_id IN (SELECT value FROM json_each((SELECT channel_ids FROM channel_filter))))
+  AND (CAST(?4 AS TEXT) = '' OR v.uploaded_user_id = CAST(?4 AS TEXT))
+  AND (v.created_at >= ?5 OR ?5 IS NULL)
+  AND (v.created_at < ?6 OR ?6 IS NULL)
+  AND (CAST(?7 AS TEXT) = '' OR v.status = CAST(?7 AS TEXT))
+  AND (CAST(?8 AS INTEGER) = 0 OR v.duration_seconds >= CAST(?8 AS INTEGER))
+  AND (CAST(?9 AS INTEGER) = 0 OR (v.duration_seconds > 0 AND v.duration_seconds <= CAST(?9 AS INTEGER)))
+`
+
+type CountAccessibleVideosParams struct {
+	TenantID           sql.NullString
+	UserID             string
+	FilterChannels     bool
+	UploaderID         string
+	CreatedAfter       sql.NullTime
+	CreatedBefore      sql.NullTime
+	Status             string
+	MinDurationSeconds int64
+	MaxDurationSeconds int64
+	ChannelIds         string
+}
+
+// Same filters as GetAccessibleVideosPage, for the total count
+func (q *Queries) CountAccessibleVideos(ctx context.Context, arg CountAccessibleVideosParams) (int64, error) {
+	row := q.db.QueryRowContext(ctx, countAccessibleVideos,
+		arg.TenantID,
+		arg.UserID,
+		arg.FilterChannels,
+		arg.UploaderID,
+		arg.CreatedAfter,
+		arg.CreatedBefore,
+		arg.Status,
+		arg.MinDurationSeconds,
+		arg.MaxDurationSeconds,
+		arg.ChannelIds,
+	)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const countChannelsForUser = `-- name: CountChannelsForUser :one
+SELECT COUNT(*) FROM videoservice_channels c
+JOIN videoservice_channel_members cm ON cm.channel_id = c.id
+WHERE c.tenant_id = ?1 AND cm.user_id = ?2
+  AND (CAST(?3 AS BOOLEAN) OR c.archived_at IS NULL)
+`
+
+type CountChannelsForUserParams struct {
+	TenantID        string
+	UserID          string
+	IncludeArchived bool
+}
+
+func (q *Queries) CountChannelsForUser(ctx context.Context, arg CountChannelsForUserParams) (int64, error) {
+	row := q.db.QueryRowContext(ctx, countChannelsForUser, arg.TenantID, arg.UserID, arg.IncludeArchived)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const countDiscoverableChannels = `-- name: CountDiscoverableChannels :one
+SELECT COUNT(*) FROM videoservice_channels c
+WHERE c.tenant_id = ?1 AND c.archived_at IS NULL
+  AND c.id NOT IN (
+    SELECT cm.channel_id FROM videoservice_channel_members cm WHERE cm.user_id = ?2
+  )
+`
+
+type CountDiscoverableChannelsParams struct {
+	TenantID string
+	UserID   string
+}
+
+func (q *Queries) CountDiscoverableChannels(ctx context.Context, arg CountDiscoverableChannelsParams) (int64, error) {
+	row := q.db.QueryRowContext(ctx, countDiscoverableChannels, arg.TenantID, arg.UserID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
 const createChannel = `-- name: CreateChannel :one
 INSERT INTO videoservice_channels (
     id,
@@ -19,7 +136,9 @@ INSERT INTO videoservice_channels (
     description,
     created_by,
     created_at,
-    updated_at
+    updated_at,
+    privacy,
+    default_role
 ) VALUES (
     ?1,
     ?2,
@@ -27,8 +146,10 @@ INSERT INTO videoservice_channels (
     ?4,
     ?5,
     ?6,
-    ?7
-) RETURNING id, tenant_id, name, description, created_by, created_at, updated_at
+    ?7,
+    ?8,
+    ?9
+) RETURNING id, tenant_id, name, description, created_by, created_at, updated_at, archived_at, archived_by, privacy, default_role
 `
 
 type CreateChannelParams struct {
@@ -39,6 +160,8 @@ type CreateChannelParams struct {
 	CreatedBy   string
 	CreatedAt   time.Time
 	UpdatedAt   time.Time
+	Privacy     string
+	DefaultRole string
 }
 
 // Channel queries
@@ -51,6 +174,8 @@ func (q *Queries) CreateChannel(ctx context.Context, arg CreateChannelParams) (V
 		arg.CreatedBy,
 		arg.CreatedAt,
 		arg.UpdatedAt,
+		arg.Privacy,
+		arg.DefaultRole,
 	)
 	var i VideoserviceChannel
 	err := row.Scan(
@@ -61,6 +186,64 @@ func (q *Queries) CreateChannel(ctx context.Context, arg CreateChannelParams) (V
 		&i.CreatedBy,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.ArchivedAt,
+		&i.ArchivedBy,
+		&i.Privacy,
+		&i.DefaultRole,
+	)
+	return i, err
+}
+
+const createChannelAccessRequest = `-- name: CreateChannelAccessRequest :one
+INSERT INTO videoservice_channel_access_requests (
+    id,
+    channel_id,
+    tenant_id,
+    user_id,
+    message,
+    status,
+    created_at
+) VALUES (
+    ?1,
+    ?2,
+    ?3,
+    ?4,
+    ?5,
+    'pending',
+    ?6
+) RETURNING id, channel_id, tenant_id, user_id, message, status, created_at, decided_by, decided_at
+`
+
+type CreateChannelAccessRequestParams struct {
+	ID        string
+	ChannelID string
+	TenantID  string
+	UserID    string
+	Message   string
+	CreatedAt time.Time
+}
+
+// Access requests to closed channels
+func (q *Queries) CreateChannelAccessRequest(ctx context.Context, arg CreateChannelAccessRequestParams) (VideoserviceChannelAccessRequest, error) {
+	row := q.db.QueryRowContext(ctx, createChannelAccessRequest,
+		arg.ID,
+		arg.ChannelID,
+		arg.TenantID,
+		arg.UserID,
+		arg.Message,
+		arg.CreatedAt,
+	)
+	var i VideoserviceChannelAccessRequest
+	err := row.Scan(
+		&i.ID,
+		&i.ChannelID,
+		&i.TenantID,
+		&i.UserID,
+		&i.Message,
+		&i.Status,
+		&i.CreatedAt,
+		&i.DecidedBy,
+		&i.DecidedAt,
 	)
 	return i, err
 }
@@ -80,7 +263,7 @@ INSERT INTO videoservice_channel_members (
     ?4,
     ?5,
     ?6
-) RETURNING id, channel_id, user_id, role, added_by, created_at
+) RETURNING id, channel_id, user_id, role, added_by, created_at, role_updated_by, role_updated_at
 `
 
 type CreateChannelMemberParams struct {
@@ -109,6 +292,66 @@ func (q *Queries) CreateChannelMember(ctx context.Context, arg CreateChannelMemb
 		&i.Role,
 		&i.AddedBy,
 		&i.CreatedAt,
+		&i.RoleUpdatedBy,
+		&i.RoleUpdatedAt,
+	)
+	return i, err
+}
+
+const createVideoReaction = `-- name: CreateVideoReaction :one
+INSERT INTO videoservice_video_reactions (
+    id,
+    tenant_id,
+    video_id,
+    user_id,
+    emoji,
+    timestamp_seconds,
+    created_at
+) VALUES (
+    ?1,
+    ?2,
+    ?3,
+    ?4,
+    ?5,
+    ?6,
+    ?7
+)
+ON CONFLICT(video_id, user_id, emoji, timestamp_seconds) DO UPDATE SET
+    emoji = excluded.emoji
+RETURNING id, tenant_id, video_id, user_id, emoji, timestamp_seconds, created_at
+`
+
+type CreateVideoReactionParams struct {
+	ID               string
+	TenantID         string
+	VideoID          string
+	UserID           string
+	Emoji            string
+	TimestampSeconds float64
+	CreatedAt        time.Time
+}
+
+// Reaction queries
+// Reacting twice with the same emoji at the same moment returns the existing reaction
+func (q *Queries) CreateVideoReaction(ctx context.Context, arg CreateVideoReactionParams) (VideoserviceVideoReaction, error) {
+	row := q.db.QueryRowContext(ctx, createVideoReaction,
+		arg.ID,
+		arg.TenantID,
+		arg.VideoID,
+		arg.UserID,
+		arg.Emoji,
+		arg.TimestampSeconds,
+		arg.CreatedAt,
+	)
+	var i VideoserviceVideoReaction
+	err := row.Scan(
+		&i.ID,
+		&i.TenantID,
+		&i.VideoID,
+		&i.UserID,
+		&i.Emoji,
+		&i.TimestampSeconds,
+		&i.CreatedAt,
 	)
 	return i, err
 }
@@ -172,6 +415,85 @@ func (q *Queries) CreateVideoUploaded(ctx context.Context, arg CreateVideoUpload
 	return err
 }
 
+const decideChannelAccessRequest = `-- name: DecideChannelAccessRequest :execrows
+UPDATE videoservice_channel_access_requests
+SET status = ?1, decided_by = ?2, decided_at = ?3
+WHERE id = ?4 AND status = 'pending'
+`
+
+type DecideChannelAccessRequestParams struct {
+	Status    string
+	DecidedBy sql.NullString
+	DecidedAt sql.NullTime
+	ID        string
+}
+
+func (q *Queries) DecideChannelAccessRequest(ctx context.Context, arg DecideChannelAccessRequestParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, decideChannelAccessRequest,
+		arg.Status,
+		arg.DecidedBy,
+		arg.DecidedAt,
+		arg.ID,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const deleteChannel = `-- name: DeleteChannel :execrows
+DELETE FROM videoservice_channels
+WHERE id = ?1 AND tenant_id = ?2
+`
+
+type DeleteChannelParams struct {
+	ID       string
+	TenantID string
+}
+
+func (q *Queries) DeleteChannel(ctx context.Context, arg DeleteChannelParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, deleteChannel, arg.ID, arg.TenantID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const deleteChannelAccessRequestsByChannelID = `-- name: DeleteChannelAccessRequestsByChannelID :exec
+DELETE FROM videoservice_channel_access_requests
+WHERE channel_id = ?1
+`
+
+func (q *Queries) DeleteChannelAccessRequestsByChannelID(ctx context.Context, channelID string) error {
+	_, err := q.db.ExecContext(ctx, deleteChannelAccessRequestsByChannelID, channelID)
+	return err
+}
+
+const deleteChannelAccessRequestsByTenantID = `-- name: DeleteChannelAccessRequestsByTenantID :exec
+DELETE FROM videoservice_channel_access_requests
+WHERE tenant_id = ?1
+`
+
+func (q *Queries) DeleteChannelAccessRequestsByTenantID(ctx context.Context, tenantID string) error {
+	_, err := q.db.ExecContext(ctx, deleteChannelAccessRequestsByTenantID, tenantID)
+	return err
+}
+
+const deleteChannelAccessRequestsByUserAndTenant = `-- name: DeleteChannelAccessRequestsByUserAndTenant :exec
+DELETE FROM videoservice_channel_access_requests
+WHERE user_id = ?1 AND tenant_id = ?2
+`
+
+type DeleteChannelAccessRequestsByUserAndTenantParams struct {
+	UserID   string
+	TenantID string
+}
+
+func (q *Queries) DeleteChannelAccessRequestsByUserAndTenant(ctx context.Context, arg DeleteChannelAccessRequestsByUserAndTenantParams) error {
+	_, err := q.db.ExecContext(ctx, deleteChannelAccessRequestsByUserAndTenant, arg.UserID, arg.TenantID)
+	return err
+}
+
 const deleteChannelMember = `-- name: DeleteChannelMember :exec
 DELETE FROM videoservice_channel_members 
 WHERE channel_id = ?1 AND user_id = ?2
@@ -187,28 +509,194 @@ func (q *Queries) DeleteChannelMember(ctx context.Context, arg DeleteChannelMemb
 	return err
 }
 
-const getAllAccessibleVideosByTenantID = `-- name: GetAllAccessibleVideosByTenantID :many
-SELECT DISTINCT v.id, v.title, v.description, v.url, v.created_at, v.uploaded_user_id, v.updated_at, v.is_private, v.tenant_id, v.channel_id, v.is_deleted FROM videoservice_videos v
-LEFT JOIN videoservice_channels c ON v.channel_id = c.id
-LEFT JOIN videoservice_channel_members cm ON c.id = cm.channel_id
+const deleteChannelMembersByChannelID = `-- name: DeleteChannelMembersByChannelID :exec
+DELETE FROM videoservice_channel_members
+WHERE channel_id = ?1
+`
+
+func (q *Queries) DeleteChannelMembersByChannelID(ctx context.Context, channelID string) error {
+	_, err := q.db.ExecContext(ctx, deleteChannelMembersByChannelID, channelID)
+	return err
+}
+
+const deleteChannelMembersByTenantID = `-- name: DeleteChannelMembersByTenantID :exec
+DELETE FROM videoservice_channel_members
+WHERE channel_id IN (SELECT c.id FROM videoservice_channels c WHERE c.tenant_id = ?1)
+`
+
+func (q *Queries) DeleteChannelMembersByTenantID(ctx context.Context, tenantID string) error {
+	_, err := q.db.ExecContext(ctx, deleteChannelMembersByTenantID, tenantID)
+	return err
+}
+
+const deleteChannelMembershipsByUserAndTenant = `-- name: DeleteChannelMembershipsByUserAndTenant :execrows
+DELETE FROM videoservice_channel_members
+WHERE user_id = ?1
+  AND channel_id IN (SELECT c.id FROM videoservice_channels c WHERE c.tenant_id = ?2)
+`
+
+type DeleteChannelMembershipsByUserAndTenantParams struct {
+	UserID   string
+	TenantID string
+}
+
+func (q *Queries) DeleteChannelMembershipsByUserAndTenant(ctx context.Context, arg DeleteChannelMembershipsByUserAndTenantParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, deleteChannelMembershipsByUserAndTenant, arg.UserID, arg.TenantID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const deleteChannelsByTenantID = `-- name: DeleteChannelsByTenantID :execrows
+DELETE FROM videoservice_channels
+WHERE tenant_id = ?1
+`
+
+func (q *Queries) DeleteChannelsByTenantID(ctx context.Context, tenantID string) (int64, error) {
+	result, err := q.db.ExecContext(ctx, deleteChannelsByTenantID, tenantID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const deleteVideoReaction = `-- name: DeleteVideoReaction :execrows
+DELETE FROM videoservice_video_reactions
+WHERE id = ?1 AND user_id = ?2 AND tenant_id = ?3
+`
+
+type DeleteVideoReactionParams struct {
+	ID       string
+	UserID   string
+	TenantID string
+}
+
+func (q *Queries) DeleteVideoReaction(ctx context.Context, arg DeleteVideoReactionParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, deleteVideoReaction, arg.ID, arg.UserID, arg.TenantID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const deleteVideoReactionsByTenantID = `-- name: DeleteVideoReactionsByTenantID :exec
+DELETE FROM videoservice_video_reactions
+WHERE tenant_id = ?1
+`
+
+func (q *Queries) DeleteVideoReactionsByTenantID(ctx context.Context, tenantID string) error {
+	_, err := q.db.ExecContext(ctx, deleteVideoReactionsByTenantID, tenantID)
+	return err
+}
+
+const deleteVideosByTenantID = `-- name: DeleteVideosByTenantID :execrows
+DELETE FROM videoservice_videos
+WHERE tenant_id = ?1
+`
+
+func (q *Queries) DeleteVideosByTenantID(ctx context.Context, tenantID sql.NullString) (int64, error) {
+	result, err := q.db.ExecContext(ctx, deleteVideosByTenantID, tenantID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const deleteWatchProgressByTenantID = `-- name: DeleteWatchProgressByTenantID :exec
+DELETE FROM videoservice_watch_progress
+WHERE tenant_id = ?1
+`
+
+func (q *Queries) DeleteWatchProgressByTenantID(ctx context.Context, tenantID string) error {
+	_, err := q.db.ExecContext(ctx, deleteWatchProgressByTenantID, tenantID)
+	return err
+}
+
+const getAccessibleVideosPage = `-- name: GetAccessibleVideosPage :many
+WITH sort_params AS (SELECT CAST(?15 AS TEXT) AS sort_order),
+  channel_filter AS (SELECT CAST(?16 AS TEXT) AS channel_ids)
+SELECT v.id, v.title, v.description, v.url, v.created_at, v.uploaded_user_id, v.updated_at, v.is_private, v.tenant_id, v.channel_id, v.is_deleted, v.status, v.duration_seconds FROM videoservice_videos v
 WHERE v.tenant_id = ?1 AND v.is_deleted = FALSE
   AND (
-    -- User's own videos (private)
-    (v.uploaded_user_id = ?2 AND (v.channel_id IS NULL OR v.channel_id = ''))
-    OR 
-    -- Videos in channels user is member of
-    (v.channel_id IS NOT NULL AND v.channel_id != '' AND cm.user_id = ?2)
+    ((v.channel_id IS NULL OR v.channel_id = '') AND v.uploaded_user_id = ?2)
+    OR v.channel_id IN (SELECT cm.channel_id FROM videoservice_channel_members cm WHERE cm.user_id = ?2)
   )
-ORDER BY v.created_at DESC
+  -- Optional filters, '' / 0 / NULL / FALSE leave them out
+  AND (CAST(?3 AS BOOLEAN) = FALSE OR v.channel_id IN (SELECT value FROM json_each((SELECT channel_ids FROM channel_filter))))
+  AND (CAST(?4 AS TEXT) = '' OR v.uploaded_user_id = CAST(?4 AS TEXT))
+  AND (v.created_at >= ?5 OR ?5 IS NULL)
+  AND (v.created_at < ?6 OR ?6 IS NULL)
+  AND (CAST(?7 AS TEXT) = '' OR v.status = CAST(?7 AS TEXT))
+  AND (CAST(?8 AS INTEGER) = 0 OR v.duration_seconds >= CAST(?8 AS INTEGER))
+  AND (CAST(?9 AS INTEGER) = 0 OR (v.duration_seconds > 0 AND v.duration_seconds <= CAST(?9 AS INTEGER)))
+  -- Keyset pagination: after_id is the last video of the previous page, '' for the first page.
+  -- The page token carries that video's sort values, so the listing goes on when it was deleted or moved since.
+  -- Each sort order resumes after those values, the id breaks ties.
+  AND (CAST(?10 AS TEXT) = '' OR CASE (SELECT sort_order FROM sort_params)
+    WHEN 'created_at_asc' THEN (v.created_at, v.id) > (?11, CAST(?10 AS TEXT))
+    WHEN 'title_asc' THEN (v.title COLLATE NOCASE, v.id) > (CAST(?12 AS TEXT), CAST(?10 AS TEXT))
+    WHEN 'title_desc' THEN (v.title COLLATE NOCASE, v.id) < (CAST(?12 AS TEXT), CAST(?10 AS TEXT))
+    WHEN 'duration_asc' THEN (v.duration_seconds, v.id) > (CAST(?13 AS INTEGER), CAST(?10 AS TEXT))
+    WHEN 'duration_desc' THEN (v.duration_seconds, v.id) < (CAST(?13 AS INTEGER), CAST(?10 AS TEXT))
+    ELSE (v.created_at, v.id) < (?11, CAST(?10 AS TEXT))
+  END)
+ORDER BY
+  CASE WHEN (SELECT sort_order FROM sort_params) = 'title_asc' THEN v.title END COLLATE NOCASE ASC,
+  CASE WHEN (SELECT sort_order FROM sort_params) = 'title_desc' THEN v.title END COLLATE NOCASE DESC,
+  CASE WHEN (SELECT sort_order FROM sort_params) = 'duration_asc' THEN v.duration_seconds END ASC,
+  CASE WHEN (SELECT sort_order FROM sort_params) = 'duration_desc' THEN v.duration_seconds END DESC,
+  CASE WHEN (SELECT sort_order FROM sort_params) = 'created_at_asc' THEN v.created_at END ASC,
+  -- Ties are broken by the id in the direction of the sort, as in the keyset condition
+  CASE WHEN (SELECT sort_order FROM sort_params) IN ('created_at_asc', 'title_asc', 'duration_asc') THEN v.id END ASC,
+  CASE WHEN (SELECT sort_order FROM sort_params) IN ('title_desc', 'duration_desc') THEN v.id END DESC,
+  -- 'created_at_desc'
+  v.created_at DESC,
+  v.id DESC
+LIMIT ?14
 `
 
-type GetAllAccessibleVideosByTenantIDParams struct {
-	TenantID sql.NullString
-	UserID   string
+type GetAccessibleVideosPageParams struct {
+	TenantID             sql.NullString
+	UserID               string
+	FilterChannels       bool
+	UploaderID           string
+	CreatedAfter         sql.NullTime
+	CreatedBefore        sql.NullTime
+	Status               string
+	MinDurationSeconds   int64
+	MaxDurationSeconds   int64
+	AfterID              string
+	AfterCreatedAt       time.Time
+	AfterTitle           string
+	AfterDurationSeconds int64
+	PageSize             int64
+	SortOrder            string
+	ChannelIds           string
 }
 
-func (q *Queries) GetAllAccessibleVideosByTenantID(ctx context.Context, arg GetAllAccessibleVideosByTenantIDParams) ([]VideoserviceVideo, error) {
-	rows, err := q.db.QueryContext(ctx, getAllAccessibleVideosByTenantID, arg.TenantID, arg.UserID)
+// Videos the user can see: their own videos outside channels plus the videos of channels they are a member of.
+// sort_order is one of created_at_desc (default), created_at_asc, title_asc, title_desc, duration_asc and duration_desc.
+// channel_ids is a JSON array of IDs, a sqlc.slice would be numbered wrongly among the other parameters.
+func (q *Queries) GetAccessibleVideosPage(ctx context.Context, arg GetAccessibleVideosPageParams) ([]VideoserviceVideo, error) {
+	rows, err := q.db.QueryContext(ctx, getAccessibleVideosPage,
+		arg.TenantID,
+		arg.UserID,
+		arg.FilterChannels,
+		arg.UploaderID,
+		arg.CreatedAfter,
+		arg.CreatedBefore,
+		arg.Status,
+		arg.MinDurationSeconds,
+		arg.MaxDurationSeconds,
+		arg.AfterID,
+		arg.AfterCreatedAt,
+		arg.AfterTitle,
+		arg.AfterDurationSeconds,
+		arg.PageSize,
+		arg.SortOrder,
+		arg.ChannelIds,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -228,6 +716,8 @@ func (q *Queries) GetAllAccessibleVideosByTenantID(ctx context.Context, arg GetA
 			&i.TenantID,
 			&i.ChannelID,
 			&i.IsDeleted,
+			&i.Status,
+			&i.DurationSeconds,
 		); err != nil {
 			return nil, err
 		}
@@ -243,7 +733,7 @@ func (q *Queries) GetAllAccessibleVideosByTenantID(ctx context.Context, arg GetA
 }
 
 const getAllVideoUploadedByUserPaginated = `-- name: GetAllVideoUploadedByUserPaginated :many
-SELECT id, title, description, url, created_at, uploaded_user_id, updated_at, is_private, tenant_id, channel_id, is_deleted FROM videoservice_videos 
+SELECT id, title, description, url, created_at, uploaded_user_id, updated_at, is_private, tenant_id, channel_id, is_deleted, status, duration_seconds FROM videoservice_videos 
 WHERE uploaded_user_id = ?1 AND is_deleted = FALSE
 ORDER BY created_at DESC
 LIMIT ?3 OFFSET ?2
@@ -276,6 +766,8 @@ func (q *Queries) GetAllVideoUploadedByUserPaginated(ctx context.Context, arg Ge
 			&i.TenantID,
 			&i.ChannelID,
 			&i.IsDeleted,
+			&i.Status,
+			&i.DurationSeconds,
 		); err != nil {
 			return nil, err
 		}
@@ -290,8 +782,35 @@ func (q *Queries) GetAllVideoUploadedByUserPaginated(ctx context.Context, arg Ge
 	return items, nil
 }
 
+const getChannelAccessRequestByID = `-- name: GetChannelAccessRequestByID :one
+SELECT id, channel_id, tenant_id, user_id, message, status, created_at, decided_by, decided_at FROM videoservice_channel_access_requests
+WHERE id = ?1 AND tenant_id = ?2
+`
+
+type GetChannelAccessRequestByIDParams struct {
+	ID       string
+	TenantID string
+}
+
+func (q *Queries) GetChannelAccessRequestByID(ctx context.Context, arg GetChannelAccessRequestByIDParams) (VideoserviceChannelAccessRequest, error) {
+	row := q.db.QueryRowContext(ctx, getChannelAccessRequestByID, arg.ID, arg.TenantID)
+	var i VideoserviceChannelAccessRequest
+	err := row.Scan(
+		&i.ID,
+		&i.ChannelID,
+		&i.TenantID,
+		&i.UserID,
+		&i.Message,
+		&i.Status,
+		&i.CreatedAt,
+		&i.DecidedBy,
+		&i.DecidedAt,
+	)
+	return i, err
+}
+
 const getChannelByIDAndTenantID = `-- name: GetChannelByIDAndTenantID :one
-SELECT id, tenant_id, name, description, created_by, created_at, updated_at FROM videoservice_channels 
+SELECT id, tenant_id, name, description, created_by, created_at, updated_at, archived_at, archived_by, privacy, default_role FROM videoservice_channels 
 WHERE id = ?1 AND tenant_id = ?2
 `
 
@@ -311,6 +830,10 @@ func (q *Queries) GetChannelByIDAndTenantID(ctx context.Context, arg GetChannelB
 		&i.CreatedBy,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.ArchivedAt,
+		&i.ArchivedBy,
+		&i.Privacy,
+		&i.DefaultRole,
 	)
 	return i, err
 }
@@ -323,6 +846,8 @@ SELECT
     cm.role,
     cm.added_by,
     cm.created_at,
+    cm.role_updated_by,
+    cm.role_updated_at,
     c.name as channel_name,
     c.tenant_id
 FROM videoservice_channel_members cm
@@ -343,6 +868,8 @@ type GetChannelMembersByChannelIDAndTenantIDRow struct {
 	Role            string
 	AddedBy         string
 	CreatedAt       time.Time
+	RoleUpdatedBy   sql.NullString
+	RoleUpdatedAt   sql.NullTime
 	ChannelName     string
 	TenantID        string
 }
@@ -363,6 +890,8 @@ func (q *Queries) GetChannelMembersByChannelIDAndTenantID(ctx context.Context, a
 			&i.Role,
 			&i.AddedBy,
 			&i.CreatedAt,
+			&i.RoleUpdatedBy,
+			&i.RoleUpdatedAt,
 			&i.ChannelName,
 			&i.TenantID,
 		); err != nil {
@@ -444,29 +973,310 @@ func (q *Queries) GetChannelMembersByChannelIDExcludingUser(ctx context.Context,
 	return items, nil
 }
 
-const getChannelsByTenantID = `-- name: GetChannelsByTenantID :many
-SELECT id, tenant_id, name, description, created_by, created_at, updated_at FROM videoservice_channels 
-WHERE tenant_id = ?1
-ORDER BY created_at DESC
+const getChannelMembershipsByUserAndTenant = `-- name: GetChannelMembershipsByUserAndTenant :many
+SELECT
+    cm.channel_id,
+    cm.role,
+    (SELECT COUNT(*) FROM videoservice_channel_members o
+     WHERE o.channel_id = cm.channel_id AND o.role = 'owner' AND o.user_id != cm.user_id) AS other_owner_count
+FROM videoservice_channel_members cm
+JOIN videoservice_channels c ON cm.channel_id = c.id
+WHERE cm.user_id = ?1 AND c.tenant_id = ?2
 `
 
-func (q *Queries) GetChannelsByTenantID(ctx context.Context, tenantID string) ([]VideoserviceChannel, error) {
-	rows, err := q.db.QueryContext(ctx, getChannelsByTenantID, tenantID)
+type GetChannelMembershipsByUserAndTenantParams struct {
+	UserID   string
+	TenantID string
+}
+
+type GetChannelMembershipsByUserAndTenantRow struct {
+	ChannelID       string
+	Role            string
+	OtherOwnerCount int64
+}
+
+// Tenant membership cleanup
+func (q *Queries) GetChannelMembershipsByUserAndTenant(ctx context.Context, arg GetChannelMembershipsByUserAndTenantParams) ([]GetChannelMembershipsByUserAndTenantRow, error) {
+	rows, err := q.db.QueryContext(ctx, getChannelMembershipsByUserAndTenant, arg.UserID, arg.TenantID)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []VideoserviceChannel
+	var items []GetChannelMembershipsByUserAndTenantRow
 	for rows.Next() {
-		var i VideoserviceChannel
-		if err := rows.Scan(
-			&i.ID,
+		var i GetChannelMembershipsByUserAndTenantRow
+		if err := rows.Scan(&i.ChannelID, &i.Role, &i.OtherOwnerCount); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getChannelsByTenantID = `-- name: GetChannelsByTenantID :many
+SELECT id, tenant_id, name, description, created_by, created_at, updated_at, archived_at, archived_by, privacy, default_role FROM videoservice_channels 
+WHERE tenant_id = ?1
+ORDER BY created_at DESC
+`
+
+func (q *Queries) GetChannelsByTenantID(ctx context.Context, tenantID string) ([]VideoserviceChannel, error) {
+	rows, err := q.db.QueryContext(ctx, getChannelsByTenantID, tenantID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []VideoserviceChannel
+	for rows.Next() {
+		var i VideoserviceChannel
+		if err := rows.Scan(
+			&i.ID,
+			&i.TenantID,
+			&i.Name,
+			&i.Description,
+			&i.CreatedBy,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.ArchivedAt,
+			&i.ArchivedBy,
+			&i.Privacy,
+			&i.DefaultRole,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getChannelsForUserPage = `-- name: GetChannelsForUserPage :many
+SELECT c.id, c.tenant_id, c.name, c.description, c.created_by, c.created_at, c.updated_at, c.archived_at, c.archived_by, c.privacy, c.default_role, cm.role AS user_role FROM videoservice_channels c
+JOIN videoservice_channel_members cm ON cm.channel_id = c.id
+WHERE c.tenant_id = ?1 AND cm.user_id = ?2
+  AND (CAST(?3 AS BOOLEAN) OR c.archived_at IS NULL)
+  AND (CAST(?4 AS TEXT) = '' OR (c.created_at, c.id) < (
+    SELECT a.created_at, a.id FROM videoservice_channels a WHERE a.id = CAST(?4 AS TEXT)
+  ))
+ORDER BY c.created_at DESC, c.id DESC
+LIMIT ?5
+`
+
+type GetChannelsForUserPageParams struct {
+	TenantID        string
+	UserID          string
+	IncludeArchived bool
+	AfterID         string
+	PageSize        int64
+}
+
+type GetChannelsForUserPageRow struct {
+	ID          string
+	TenantID    string
+	Name        string
+	Description sql.NullString
+	CreatedBy   string
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+	ArchivedAt  sql.NullTime
+	ArchivedBy  sql.NullString
+	Privacy     string
+	DefaultRole string
+	UserRole    string
+}
+
+// Channels the user is a member of, with their role, newest first
+func (q *Queries) GetChannelsForUserPage(ctx context.Context, arg GetChannelsForUserPageParams) ([]GetChannelsForUserPageRow, error) {
+	rows, err := q.db.QueryContext(ctx, getChannelsForUserPage,
+		arg.TenantID,
+		arg.UserID,
+		arg.IncludeArchived,
+		arg.AfterID,
+		arg.PageSize,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetChannelsForUserPageRow
+	for rows.Next() {
+		var i GetChannelsForUserPageRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.TenantID,
+			&i.Name,
+			&i.Description,
+			&i.CreatedBy,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.ArchivedAt,
+			&i.ArchivedBy,
+			&i.Privacy,
+			&i.DefaultRole,
+			&i.UserRole,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getDiscoverableChannelsPage = `-- name: GetDiscoverableChannelsPage :many
+SELECT c.id, c.tenant_id, c.name, c.description, c.created_by, c.created_at, c.updated_at, c.archived_at, c.archived_by, c.privacy, c.default_role,
+    EXISTS (
+        SELECT 1 FROM videoservice_channel_access_requests r
+        WHERE r.channel_id = c.id AND r.user_id = ?1 AND r.status = 'pending'
+    ) AS access_requested
+FROM videoservice_channels c
+WHERE c.tenant_id = ?2 AND c.archived_at IS NULL
+  AND c.id NOT IN (
+    SELECT cm.channel_id FROM videoservice_channel_members cm WHERE cm.user_id = ?1
+  )
+  AND (CAST(?3 AS TEXT) = '' OR (c.created_at, c.id) < (
+    SELECT a.created_at, a.id FROM videoservice_channels a WHERE a.id = CAST(?3 AS TEXT)
+  ))
+ORDER BY c.created_at DESC, c.id DESC
+LIMIT ?4
+`
+
+type GetDiscoverableChannelsPageParams struct {
+	UserID   string
+	TenantID string
+	AfterID  string
+	PageSize int64
+}
+
+type GetDiscoverableChannelsPageRow struct {
+	ID              string
+	TenantID        string
+	Name            string
+	Description     sql.NullString
+	CreatedBy       string
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+	ArchivedAt      sql.NullTime
+	ArchivedBy      sql.NullString
+	Privacy         string
+	DefaultRole     string
+	AccessRequested int64
+}
+
+// Channels of the tenant the user isn't a member of, newest first
+func (q *Queries) GetDiscoverableChannelsPage(ctx context.Context, arg GetDiscoverableChannelsPageParams) ([]GetDiscoverableChannelsPageRow, error) {
+	rows, err := q.db.QueryContext(ctx, getDiscoverableChannelsPage,
+		arg.UserID,
+		arg.TenantID,
+		arg.AfterID,
+		arg.PageSize,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetDiscoverableChannelsPageRow
+	for rows.Next() {
+		var i GetDiscoverableChannelsPageRow
+		if err := rows.Scan(
+			&i.ID,
 			&i.TenantID,
 			&i.Name,
 			&i.Description,
 			&i.CreatedBy,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.ArchivedAt,
+			&i.ArchivedBy,
+			&i.Privacy,
+			&i.DefaultRole,
+			&i.AccessRequested,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getPendingChannelAccessRequest = `-- name: GetPendingChannelAccessRequest :one
+SELECT id, channel_id, tenant_id, user_id, message, status, created_at, decided_by, decided_at FROM videoservice_channel_access_requests
+WHERE channel_id = ?1 AND user_id = ?2 AND status = 'pending'
+`
+
+type GetPendingChannelAccessRequestParams struct {
+	ChannelID string
+	UserID    string
+}
+
+func (q *Queries) GetPendingChannelAccessRequest(ctx context.Context, arg GetPendingChannelAccessRequestParams) (VideoserviceChannelAccessRequest, error) {
+	row := q.db.QueryRowContext(ctx, getPendingChannelAccessRequest, arg.ChannelID, arg.UserID)
+	var i VideoserviceChannelAccessRequest
+	err := row.Scan(
+		&i.ID,
+		&i.ChannelID,
+		&i.TenantID,
+		&i.UserID,
+		&i.Message,
+		&i.Status,
+		&i.CreatedAt,
+		&i.DecidedBy,
+		&i.DecidedAt,
+	)
+	return i, err
+}
+
+const getPendingChannelAccessRequests = `-- name: GetPendingChannelAccessRequests :many
+SELECT id, channel_id, tenant_id, user_id, message, status, created_at, decided_by, decided_at FROM videoservice_channel_access_requests
+WHERE channel_id = ?1 AND tenant_id = ?2 AND status = 'pending'
+ORDER BY created_at ASC
+`
+
+type GetPendingChannelAccessRequestsParams struct {
+	ChannelID string
+	TenantID  string
+}
+
+func (q *Queries) GetPendingChannelAccessRequests(ctx context.Context, arg GetPendingChannelAccessRequestsParams) ([]VideoserviceChannelAccessRequest, error) {
+	rows, err := q.db.QueryContext(ctx, getPendingChannelAccessRequests, arg.ChannelID, arg.TenantID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []VideoserviceChannelAccessRequest
+	for rows.Next() {
+		var i VideoserviceChannelAccessRequest
+		if err := rows.Scan(
+			&i.ID,
+			&i.ChannelID,
+			&i.TenantID,
+			&i.UserID,
+			&i.Message,
+			&i.Status,
+			&i.CreatedAt,
+			&i.DecidedBy,
+			&i.DecidedAt,
 		); err != nil {
 			return nil, err
 		}
@@ -501,7 +1311,7 @@ func (q *Queries) GetUserRoleInChannel(ctx context.Context, arg GetUserRoleInCha
 }
 
 const getVideoByVideoIDAndTenantID = `-- name: GetVideoByVideoIDAndTenantID :one
-SELECT id, title, description, url, created_at, uploaded_user_id, updated_at, is_private, tenant_id, channel_id, is_deleted FROM videoservice_videos 
+SELECT id, title, description, url, created_at, uploaded_user_id, updated_at, is_private, tenant_id, channel_id, is_deleted, status, duration_seconds FROM videoservice_videos 
 WHERE id = ?1 AND tenant_id = ?2 AND is_deleted = FALSE
 LIMIT 1
 `
@@ -526,33 +1336,51 @@ func (q *Queries) GetVideoByVideoIDAndTenantID(ctx context.Context, arg GetVideo
 		&i.TenantID,
 		&i.ChannelID,
 		&i.IsDeleted,
+		&i.Status,
+		&i.DurationSeconds,
 	)
 	return i, err
 }
 
-const getVideoCountsPerChannelByTenantID = `-- name: GetVideoCountsPerChannelByTenantID :many
-SELECT 
+const getVideoCountsByChannelIDs = `-- name: GetVideoCountsByChannelIDs :many
+SELECT
   channel_id,
   COUNT(*) AS video_count
 FROM videoservice_videos
-WHERE tenant_id = ?1 AND is_deleted = FALSE AND channel_id IS NOT NULL AND channel_id != ''
+WHERE tenant_id = ?1 AND is_deleted = FALSE AND channel_id IN (/*SLICE:channel_ids*/?)
 GROUP BY channel_id
 `
 
-type GetVideoCountsPerChannelByTenantIDRow struct {
+type GetVideoCountsByChannelIDsParams struct {
+	TenantID   sql.NullString
+	ChannelIds []sql.NullString
+}
+
+type GetVideoCountsByChannelIDsRow struct {
 	ChannelID  sql.NullString
 	VideoCount int64
 }
 
-func (q *Queries) GetVideoCountsPerChannelByTenantID(ctx context.Context, tenantID sql.NullString) ([]GetVideoCountsPerChannelByTenantIDRow, error) {
-	rows, err := q.db.QueryContext(ctx, getVideoCountsPerChannelByTenantID, tenantID)
+func (q *Queries) GetVideoCountsByChannelIDs(ctx context.Context, arg GetVideoCountsByChannelIDsParams) ([]GetVideoCountsByChannelIDsRow, error) {
+	query := getVideoCountsByChannelIDs
+	var queryParams []interface{}
+	queryParams = append(queryParams, arg.TenantID)
+	if len(arg.ChannelIds) > 0 {
+		for _, v := range arg.ChannelIds {
+			queryParams = append(queryParams, v)
+		}
+		query = strings.Replace(query, "/*SLICE:channel_ids*/?", strings.Repeat(",?", len(arg.ChannelIds))[1:], 1)
+	} else {
+		query = strings.Replace(query, "/*SLICE:channel_ids*/?", "NULL", 1)
+	}
+	rows, err := q.db.QueryContext(ctx, query, queryParams...)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []GetVideoCountsPerChannelByTenantIDRow
+	var items []GetVideoCountsByChannelIDsRow
 	for rows.Next() {
-		var i GetVideoCountsPerChannelByTenantIDRow
+		var i GetVideoCountsByChannelIDsRow
 		if err := rows.Scan(&i.ChannelID, &i.VideoCount); err != nil {
 			return nil, err
 		}
@@ -567,8 +1395,130 @@ func (q *Queries) GetVideoCountsPerChannelByTenantID(ctx context.Context, tenant
 	return items, nil
 }
 
+const getVideoFilesByTenantID = `-- name: GetVideoFilesByTenantID :many
+SELECT id, url FROM videoservice_videos
+WHERE tenant_id = ?1
+`
+
+type GetVideoFilesByTenantIDRow struct {
+	ID  string
+	Url string
+}
+
+// Tenant deletion, including soft deleted videos
+func (q *Queries) GetVideoFilesByTenantID(ctx context.Context, tenantID sql.NullString) ([]GetVideoFilesByTenantIDRow, error) {
+	rows, err := q.db.QueryContext(ctx, getVideoFilesByTenantID, tenantID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetVideoFilesByTenantIDRow
+	for rows.Next() {
+		var i GetVideoFilesByTenantIDRow
+		if err := rows.Scan(&i.ID, &i.Url); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getVideoReactionCountsByVideoID = `-- name: GetVideoReactionCountsByVideoID :many
+SELECT
+    emoji,
+    COUNT(*) AS reaction_count,
+    CAST(SUM(CASE WHEN user_id = ?1 THEN 1 ELSE 0 END) AS INTEGER) AS my_reaction_count
+FROM videoservice_video_reactions
+WHERE tenant_id = ?2 AND video_id = ?3
+GROUP BY emoji
+ORDER BY reaction_count DESC, emoji ASC
+`
+
+type GetVideoReactionCountsByVideoIDParams struct {
+	UserID   string
+	TenantID string
+	VideoID  string
+}
+
+type GetVideoReactionCountsByVideoIDRow struct {
+	Emoji           string
+	ReactionCount   int64
+	MyReactionCount int64
+}
+
+func (q *Queries) GetVideoReactionCountsByVideoID(ctx context.Context, arg GetVideoReactionCountsByVideoIDParams) ([]GetVideoReactionCountsByVideoIDRow, error) {
+	rows, err := q.db.QueryContext(ctx, getVideoReactionCountsByVideoID, arg.UserID, arg.TenantID, arg.VideoID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetVideoReactionCountsByVideoIDRow
+	for rows.Next() {
+		var i GetVideoReactionCountsByVideoIDRow
+		if err := rows.Scan(&i.Emoji, &i.ReactionCount, &i.MyReactionCount); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getVideoReactionsByVideoID = `-- name: GetVideoReactionsByVideoID :many
+SELECT id, tenant_id, video_id, user_id, emoji, timestamp_seconds, created_at FROM videoservice_video_reactions
+WHERE tenant_id = ?1 AND video_id = ?2
+ORDER BY timestamp_seconds ASC, created_at ASC
+`
+
+type GetVideoReactionsByVideoIDParams struct {
+	TenantID string
+	VideoID  string
+}
+
+func (q *Queries) GetVideoReactionsByVideoID(ctx context.Context, arg GetVideoReactionsByVideoIDParams) ([]VideoserviceVideoReaction, error) {
+	rows, err := q.db.QueryContext(ctx, getVideoReactionsByVideoID, arg.TenantID, arg.VideoID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []VideoserviceVideoReaction
+	for rows.Next() {
+		var i VideoserviceVideoReaction
+		if err := rows.Scan(
+			&i.ID,
+			&i.TenantID,
+			&i.VideoID,
+			&i.UserID,
+			&i.Emoji,
+			&i.TimestampSeconds,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getVideosByTenantID = `-- name: GetVideosByTenantID :many
-SELECT id, title, description, url, created_at, uploaded_user_id, updated_at, is_private, tenant_id, channel_id, is_deleted FROM videoservice_videos 
+SELECT id, title, description, url, created_at, uploaded_user_id, updated_at, is_private, tenant_id, channel_id, is_deleted, status, duration_seconds FROM videoservice_videos 
 WHERE tenant_id = ?1 AND is_deleted = FALSE
 ORDER BY created_at DESC
 `
@@ -594,6 +1544,8 @@ func (q *Queries) GetVideosByTenantID(ctx context.Context, tenantID sql.NullStri
 			&i.TenantID,
 			&i.ChannelID,
 			&i.IsDeleted,
+			&i.Status,
+			&i.DurationSeconds,
 		); err != nil {
 			return nil, err
 		}
@@ -608,38 +1560,74 @@ func (q *Queries) GetVideosByTenantID(ctx context.Context, tenantID sql.NullStri
 	return items, nil
 }
 
-const getVideosByTenantIDAndChannelID = `-- name: GetVideosByTenantIDAndChannelID :many
-SELECT id, title, description, url, created_at, uploaded_user_id, updated_at, is_private, tenant_id, channel_id, is_deleted FROM videoservice_videos 
-WHERE tenant_id = ?1 AND channel_id = ?2 AND is_deleted = FALSE
-ORDER BY created_at DESC
+const getWatchProgress = `-- name: GetWatchProgress :one
+SELECT id, tenant_id, video_id, user_id, position_seconds, completed, created_at, updated_at FROM videoservice_watch_progress
+WHERE video_id = ?1 AND user_id = ?2 AND tenant_id = ?3
 `
 
-type GetVideosByTenantIDAndChannelIDParams struct {
-	TenantID  sql.NullString
-	ChannelID sql.NullString
+type GetWatchProgressParams struct {
+	VideoID  string
+	UserID   string
+	TenantID string
+}
+
+func (q *Queries) GetWatchProgress(ctx context.Context, arg GetWatchProgressParams) (VideoserviceWatchProgress, error) {
+	row := q.db.QueryRowContext(ctx, getWatchProgress, arg.VideoID, arg.UserID, arg.TenantID)
+	var i VideoserviceWatchProgress
+	err := row.Scan(
+		&i.ID,
+		&i.TenantID,
+		&i.VideoID,
+		&i.UserID,
+		&i.PositionSeconds,
+		&i.Completed,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
 }
 
-func (q *Queries) GetVideosByTenantIDAndChannelID(ctx context.Context, arg GetVideosByTenantIDAndChannelIDParams) ([]VideoserviceVideo, error) {
-	rows, err := q.db.QueryContext(ctx, getVideosByTenantIDAndChannelID, arg.TenantID, arg.ChannelID)
+const getWatchProgressByVideoIDs = `-- name: GetWatchProgressByVideoIDs :many
+SELECT id, tenant_id, video_id, user_id, position_seconds, completed, created_at, updated_at FROM videoservice_watch_progress
+WHERE tenant_id = ?1 AND user_id = ?2 AND video_id IN (/*SLICE:video_ids*/?)
+`
+
+type GetWatchProgressByVideoIDsParams struct {
+	TenantID string
+	UserID   string
+	VideoIds []string
+}
+
+func (q *Queries) GetWatchProgressByVideoIDs(ctx context.Context, arg GetWatchProgressByVideoIDsParams) ([]VideoserviceWatchProgress, error) {
+	query := getWatchProgressByVideoIDs
+	var queryParams []interface{}
+	queryParams = append(queryParams, arg.TenantID)
+	queryParams = append(queryParams, arg.UserID)
+	if len(arg.VideoIds) > 0 {
+		for _, v := range arg.VideoIds {
+			queryParams = append(queryParams, v)
+		}
+		query = strings.Replace(query, "/*SLICE:video_ids*/?", strings.Repeat(",?", len(arg.VideoIds))[1:], 1)
+	} else {
+		query = strings.Replace(query, "/*SLICE:video_ids*/?", "NULL", 1)
+	}
+	rows, err := q.db.QueryContext(ctx, query, queryParams...)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []VideoserviceVideo
+	var items []VideoserviceWatchProgress
 	for rows.Next() {
-		var i VideoserviceVideo
+		var i VideoserviceWatchProgress
 		if err := rows.Scan(
 			&i.ID,
-			&i.Title,
-			&i.Description,
-			&i.Url,
+			&i.TenantID,
+			&i.VideoID,
+			&i.UserID,
+			&i.PositionSeconds,
+			&i.Completed,
 			&i.CreatedAt,
-			&i.UploadedUserID,
 			&i.UpdatedAt,
-			&i.IsPrivate,
-			&i.TenantID,
-			&i.ChannelID,
-			&i.IsDeleted,
 		); err != nil {
 			return nil, err
 		}
@@ -654,6 +1642,33 @@ func (q *Queries) GetVideosByTenantIDAndChannelID(ctx context.Context, arg GetVi
 	return items, nil
 }
 
+const moveChannelVideos = `-- name: MoveChannelVideos :execrows
+UPDATE videoservice_videos
+SET channel_id = ?1, updated_at = ?2
+WHERE tenant_id = ?3 AND channel_id = ?4 AND is_deleted = FALSE
+`
+
+type MoveChannelVideosParams struct {
+	TargetChannelID sql.NullString
+	UpdatedAt       time.Time
+	TenantID        sql.NullString
+	ChannelID       sql.NullString
+}
+
+// What happens to the videos of a deleted channel
+func (q *Queries) MoveChannelVideos(ctx context.Context, arg MoveChannelVideosParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, moveChannelVideos,
+		arg.TargetChannelID,
+		arg.UpdatedAt,
+		arg.TenantID,
+		arg.ChannelID,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
 const removeVideoFromChannel = `-- name: RemoveVideoFromChannel :exec
 UPDATE videoservice_videos 
 SET channel_id = NULL, updated_at = ?1
@@ -677,6 +1692,46 @@ func (q *Queries) RemoveVideoFromChannel(ctx context.Context, arg RemoveVideoFro
 	return err
 }
 
+const returnChannelVideosToUploaders = `-- name: ReturnChannelVideosToUploaders :execrows
+UPDATE videoservice_videos
+SET channel_id = NULL, updated_at = ?1
+WHERE tenant_id = ?2 AND channel_id = ?3 AND is_deleted = FALSE
+`
+
+type ReturnChannelVideosToUploadersParams struct {
+	UpdatedAt time.Time
+	TenantID  sql.NullString
+	ChannelID sql.NullString
+}
+
+func (q *Queries) ReturnChannelVideosToUploaders(ctx context.Context, arg ReturnChannelVideosToUploadersParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, returnChannelVideosToUploaders, arg.UpdatedAt, arg.TenantID, arg.ChannelID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const softDeleteChannelVideos = `-- name: SoftDeleteChannelVideos :execrows
+UPDATE videoservice_videos
+SET is_deleted = TRUE, updated_at = ?1
+WHERE tenant_id = ?2 AND channel_id = ?3 AND is_deleted = FALSE
+`
+
+type SoftDeleteChannelVideosParams struct {
+	UpdatedAt time.Time
+	TenantID  sql.NullString
+	ChannelID sql.NullString
+}
+
+func (q *Queries) SoftDeleteChannelVideos(ctx context.Context, arg SoftDeleteChannelVideosParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, softDeleteChannelVideos, arg.UpdatedAt, arg.TenantID, arg.ChannelID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
 const softDeleteVideo = `-- name: SoftDeleteVideo :exec
 UPDATE videoservice_videos 
 SET is_deleted = TRUE, updated_at = ?1
@@ -694,19 +1749,43 @@ func (q *Queries) SoftDeleteVideo(ctx context.Context, arg SoftDeleteVideoParams
 	return err
 }
 
+const unarchiveChannel = `-- name: UnarchiveChannel :execrows
+UPDATE videoservice_channels
+SET archived_at = NULL, archived_by = NULL, updated_at = ?1
+WHERE id = ?2 AND tenant_id = ?3 AND archived_at IS NOT NULL
+`
+
+type UnarchiveChannelParams struct {
+	UpdatedAt time.Time
+	ID        string
+	TenantID  string
+}
+
+func (q *Queries) UnarchiveChannel(ctx context.Context, arg UnarchiveChannelParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, unarchiveChannel, arg.UpdatedAt, arg.ID, arg.TenantID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
 const updateChannel = `-- name: UpdateChannel :one
 UPDATE videoservice_channels 
 SET 
     name = ?1,
     description = ?2,
-    updated_at = ?3
-WHERE id = ?4 AND tenant_id = ?5
-RETURNING id, tenant_id, name, description, created_by, created_at, updated_at
+    privacy = ?3,
+    default_role = ?4,
+    updated_at = ?5
+WHERE id = ?6 AND tenant_id = ?7
+RETURNING id, tenant_id, name, description, created_by, created_at, updated_at, archived_at, archived_by, privacy, default_role
 `
 
 type UpdateChannelParams struct {
 	Name        string
 	Description sql.NullString
+	Privacy     string
+	DefaultRole string
 	UpdatedAt   time.Time
 	ID          string
 	TenantID    string
@@ -716,6 +1795,8 @@ func (q *Queries) UpdateChannel(ctx context.Context, arg UpdateChannelParams) (V
 	row := q.db.QueryRowContext(ctx, updateChannel,
 		arg.Name,
 		arg.Description,
+		arg.Privacy,
+		arg.DefaultRole,
 		arg.UpdatedAt,
 		arg.ID,
 		arg.TenantID,
@@ -729,10 +1810,49 @@ func (q *Queries) UpdateChannel(ctx context.Context, arg UpdateChannelParams) (V
 		&i.CreatedBy,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.ArchivedAt,
+		&i.ArchivedBy,
+		&i.Privacy,
+		&i.DefaultRole,
 	)
 	return i, err
 }
 
+const updateChannelMemberRole = `-- name: UpdateChannelMemberRole :execrows
+UPDATE videoservice_channel_members
+SET role = ?1, role_updated_by = ?2, role_updated_at = ?3
+WHERE videoservice_channel_members.channel_id = ?4
+  AND videoservice_channel_members.user_id = ?5
+  AND (videoservice_channel_members.role <> 'owner' OR (
+    SELECT COUNT(*) FROM videoservice_channel_members owners
+    WHERE owners.channel_id = videoservice_channel_members.channel_id AND owners.role = 'owner'
+  ) > 1)
+`
+
+type UpdateChannelMemberRoleParams struct {
+	Role          string
+	RoleUpdatedBy sql.NullString
+	RoleUpdatedAt sql.NullTime
+	ChannelID     string
+	UserID        string
+}
+
+// An owner is only changed while the channel has another one. The check is part of the update,
+// so two owners demoting each other at the same time can't both succeed.
+func (q *Queries) UpdateChannelMemberRole(ctx context.Context, arg UpdateChannelMemberRoleParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, updateChannelMemberRole,
+		arg.Role,
+		arg.RoleUpdatedBy,
+		arg.RoleUpdatedAt,
+		arg.ChannelID,
+		arg.UserID,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
 const updateVideoChannel = `-- name: UpdateVideoChannel :exec
 UPDATE videoservice_videos 
 SET channel_id = ?1, updated_at = ?2
@@ -767,3 +1887,104 @@ func (q *Queries) UpdateVideoChannel(ctx context.Context, arg UpdateVideoChannel
 	)
 	return err
 }
+
+const upsertChannelOwner = `-- name: UpsertChannelOwner :exec
+INSERT INTO videoservice_channel_members (
+    id,
+    channel_id,
+    user_id,
+    role,
+    added_by,
+    created_at
+) VALUES (
+    ?1,
+    ?2,
+    ?3,
+    'owner',
+    ?4,
+    ?5
+) ON CONFLICT (channel_id, user_id) DO UPDATE SET role = 'owner'
+`
+
+type UpsertChannelOwnerParams struct {
+	ID        string
+	ChannelID string
+	UserID    string
+	AddedBy   string
+	CreatedAt time.Time
+}
+
+func (q *Queries) UpsertChannelOwner(ctx context.Context, arg UpsertChannelOwnerParams) error {
+	_, err := q.db.ExecContext(ctx, upsertChannelOwner,
+		arg.ID,
+		arg.ChannelID,
+		arg.UserID,
+		arg.AddedBy,
+		arg.CreatedAt,
+	)
+	return err
+}
+
+const upsertWatchProgress = `-- name: UpsertWatchProgress :one
+INSERT INTO videoservice_watch_progress (
+    id,
+    tenant_id,
+    video_id,
+    user_id,
+    position_seconds,
+    completed,
+    created_at,
+    updated_at
+) VALUES (
+    ?1,
+    ?2,
+    ?3,
+    ?4,
+    ?5,
+    ?6,
+    ?7,
+    ?8
+)
+ON CONFLICT(user_id, video_id) DO UPDATE SET
+    position_seconds = excluded.position_seconds,
+    completed = excluded.completed,
+    updated_at = excluded.updated_at
+RETURNING id, tenant_id, video_id, user_id, position_seconds, completed, created_at, updated_at
+`
+
+type UpsertWatchProgressParams struct {
+	ID              string
+	TenantID        string
+	VideoID         string
+	UserID          string
+	PositionSeconds float64
+	Completed       bool
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+}
+
+// Watch progress queries
+func (q *Queries) UpsertWatchProgress(ctx context.Context, arg UpsertWatchProgressParams) (VideoserviceWatchProgress, error) {
+	row := q.db.QueryRowContext(ctx, upsertWatchProgress,
+		arg.ID,
+		arg.TenantID,
+		arg.VideoID,
+		arg.UserID,
+		arg.PositionSeconds,
+		arg.Completed,
+		arg.CreatedAt,
+		arg.UpdatedAt,
+	)
+	var i VideoserviceWatchProgress
+	err := row.Scan(
+		&i.ID,
+		&i.TenantID,
+		&i.VideoID,
+		&i.UserID,
+		&i.PositionSeconds,
+		&i.Completed,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
@@ -9,11 +9,55 @@ type DBQuerier interface {
 	CreateVideoUploaded(ctx context.Context, params CreateVideoUploadedParams) error
 	GetVideoByVideoIDAndTenantID(ctx context.Context, params GetVideoByVideoIDAndTenantIDParams) (VideoserviceVideo, error)
 	GetVideosByTenantID(ctx context.Context, tenantID sql.NullString) ([]VideoserviceVideo, error)
-	GetVideosByTenantIDAndChannelID(ctx context.Context, params GetVideosByTenantIDAndChannelIDParams) ([]VideoserviceVideo, error)
-    GetAllAccessibleVideosByTenantID(ctx context.Context, params GetAllAccessibleVideosByTenantIDParams) ([]VideoserviceVideo, error)
-    UpdateVideoChannel(ctx context.Context, params UpdateVideoChannelParams) error
-    RemoveVideoFromChannel(ctx context.Context, params RemoveVideoFromChannelParams) error
-    SoftDeleteVideo(ctx context.Context, params SoftDeleteVideoParams) error
+	GetAccessibleVideosPage(ctx context.Context, params GetAccessibleVideosPageParams) ([]VideoserviceVideo, error)
+	CountAccessibleVideos(ctx context.Context, params CountAccessibleVideosParams) (int64, error)
+	UpdateVideoChannel(ctx context.Context, params UpdateVideoChannelParams) error
+	RemoveVideoFromChannel(ctx context.Context, params RemoveVideoFromChannelParams) error
+	SoftDeleteVideo(ctx context.Context, params SoftDeleteVideoParams) error
+	UpsertWatchProgress(ctx context.Context, params UpsertWatchProgressParams) (VideoserviceWatchProgress, error)
+	GetWatchProgress(ctx context.Context, params GetWatchProgressParams) (VideoserviceWatchProgress, error)
+	GetWatchProgressByVideoIDs(ctx context.Context, params GetWatchProgressByVideoIDsParams) ([]VideoserviceWatchProgress, error)
+	CreateVideoReaction(ctx context.Context, params CreateVideoReactionParams) (VideoserviceVideoReaction, error)
+	DeleteVideoReaction(ctx context.Context, params DeleteVideoReactionParams) (int64, error)
+	GetVideoReactionsByVideoID(ctx context.Context, params GetVideoReactionsByVideoIDParams) ([]VideoserviceVideoReaction, error)
+	GetVideoReactionCountsByVideoID(ctx context.Context, params GetVideoReactionCountsByVideoIDParams) ([]GetVideoReactionCountsByVideoIDRow, error)
+	CreateChannel(ctx context.Context, params CreateChannelParams) (VideoserviceChannel, error)
+	GetChannelByIDAndTenantID(ctx context.Context, params GetChannelByIDAndTenantIDParams) (VideoserviceChannel, error)
+	GetChannelsForUserPage(ctx context.Context, params GetChannelsForUserPageParams) ([]GetChannelsForUserPageRow, error)
+	CountChannelsForUser(ctx context.Context, params CountChannelsForUserParams) (int64, error)
+	GetDiscoverableChannelsPage(ctx context.Context, params GetDiscoverableChannelsPageParams) ([]GetDiscoverableChannelsPageRow, error)
+	CountDiscoverableChannels(ctx context.Context, params CountDiscoverableChannelsParams) (int64, error)
+	UpdateChannel(ctx context.Context, params UpdateChannelParams) (VideoserviceChannel, error)
+	ArchiveChannel(ctx context.Context, params ArchiveChannelParams) (int64, error)
+	UnarchiveChannel(ctx context.Context, params UnarchiveChannelParams) (int64, error)
+	DeleteChannel(ctx context.Context, params DeleteChannelParams) (int64, error)
+	CreateChannelMember(ctx context.Context, params CreateChannelMemberParams) (VideoserviceChannelMember, error)
+	GetUserRoleInChannel(ctx context.Context, params GetUserRoleInChannelParams) (string, error)
+	DeleteChannelMember(ctx context.Context, params DeleteChannelMemberParams) error
+	UpdateChannelMemberRole(ctx context.Context, params UpdateChannelMemberRoleParams) (int64, error)
+	CreateChannelAccessRequest(ctx context.Context, params CreateChannelAccessRequestParams) (VideoserviceChannelAccessRequest, error)
+	GetChannelAccessRequestByID(ctx context.Context, params GetChannelAccessRequestByIDParams) (VideoserviceChannelAccessRequest, error)
+	GetPendingChannelAccessRequest(ctx context.Context, params GetPendingChannelAccessRequestParams) (VideoserviceChannelAccessRequest, error)
+	GetPendingChannelAccessRequests(ctx context.Context, params GetPendingChannelAccessRequestsParams) ([]VideoserviceChannelAccessRequest, error)
+	DecideChannelAccessRequest(ctx context.Context, params DecideChannelAccessRequestParams) (int64, error)
+	DeleteChannelAccessRequestsByChannelID(ctx context.Context, channelID string) error
+	DeleteChannelAccessRequestsByUserAndTenant(ctx context.Context, params DeleteChannelAccessRequestsByUserAndTenantParams) error
+	DeleteChannelAccessRequestsByTenantID(ctx context.Context, tenantID string) error
+	DeleteChannelMembersByChannelID(ctx context.Context, channelID string) error
+	GetVideoCountsByChannelIDs(ctx context.Context, params GetVideoCountsByChannelIDsParams) ([]GetVideoCountsByChannelIDsRow, error)
+	MoveChannelVideos(ctx context.Context, params MoveChannelVideosParams) (int64, error)
+	ReturnChannelVideosToUploaders(ctx context.Context, params ReturnChannelVideosToUploadersParams) (int64, error)
+	SoftDeleteChannelVideos(ctx context.Context, params SoftDeleteChannelVideosParams) (int64, error)
+	GetChannelMembersByChannelIDAndTenantID(ctx context.Context, params GetChannelMembersByChannelIDAndTenantIDParams) ([]GetChannelMembersByChannelIDAndTenantIDRow, error)
+	GetChannelMembershipsByUserAndTenant(ctx context.Context, params GetChannelMembershipsByUserAndTenantParams) ([]GetChannelMembershipsByUserAndTenantRow, error)
+	DeleteChannelMembershipsByUserAndTenant(ctx context.Context, params DeleteChannelMembershipsByUserAndTenantParams) (int64, error)
+	UpsertChannelOwner(ctx context.Context, params UpsertChannelOwnerParams) error
+	GetVideoFilesByTenantID(ctx context.Context, tenantID sql.NullString) ([]GetVideoFilesByTenantIDRow, error)
+	DeleteWatchProgressByTenantID(ctx context.Context, tenantID string) error
+	DeleteVideoReactionsByTenantID(ctx context.Context, tenantID string) error
+	DeleteVideosByTenantID(ctx context.Context, tenantID sql.NullString) (int64, error)
+	DeleteChannelMembersByTenantID(ctx context.Context, tenantID string) error
+	DeleteChannelsByTenantID(ctx context.Context, tenantID string) (int64, error)
 }
 
 var _ DBQuerier = (*Queries)(nil)
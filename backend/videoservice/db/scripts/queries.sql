@@ -41,24 +41,68 @@ SELECT * FROM videoservice_videos
 WHERE tenant_id = @tenant_id AND is_deleted = FALSE
 ORDER BY created_at DESC;
 
--- name: GetVideosByTenantIDAndChannelID :many
-SELECT * FROM videoservice_videos 
-WHERE tenant_id = @tenant_id AND channel_id = @channel_id AND is_deleted = FALSE
-ORDER BY created_at DESC;
+-- Videos the user can see: their own videos outside channels plus the videos of channels they are a member of.
+-- sort_order is one of created_at_desc (default), created_at_asc, title_asc, title_desc, duration_asc and duration_desc.
+-- channel_ids is a JSON array of IDs, a sqlc.slice would be numbered wrongly among the other parameters.
+-- name: GetAccessibleVideosPage :many
+WITH sort_params AS (SELECT CAST(@sort_order AS TEXT) AS sort_order),
+  channel_filter AS (SELECT CAST(@channel_ids AS TEXT) AS channel_ids)
+SELECT v.* FROM videoservice_videos v
+WHERE v.tenant_id = @tenant_id AND v.is_deleted = FALSE
+  AND (
+    ((v.channel_id IS NULL OR v.channel_id = '') AND v.uploaded_user_id = @user_id)
+    OR v.channel_id IN (SELECT cm.channel_id FROM videoservice_channel_members cm WHERE cm.user_id = @user_id)
+  )
+  -- Optional filters, '' / 0 / NULL / FALSE leave them out
+  AND (CAST(@filter_channels AS BOOLEAN) = FALSE OR v.channel_id IN (SELECT value FROM json_each((SELECT channel_ids FROM channel_filter))))
+  AND (CAST(@uploader_id AS TEXT) = '' OR v.uploaded_user_id = CAST(@uploader_id AS TEXT))
+  AND (v.created_at >= sqlc.narg(created_after) OR sqlc.narg(created_after) IS NULL)
+  AND (v.created_at < sqlc.narg(created_before) OR sqlc.narg(created_before) IS NULL)
+  AND (CAST(@status AS TEXT) = '' OR v.status = CAST(@status AS TEXT))
+  AND (CAST(@min_duration_seconds AS INTEGER) = 0 OR v.duration_seconds >= CAST(@min_duration_seconds AS INTEGER))
+  AND (CAST(@max_duration_seconds AS INTEGER) = 0 OR (v.duration_seconds > 0 AND v.duration_seconds <= CAST(@max_duration_seconds AS INTEGER)))
+  -- Keyset pagination: after_id is the last video of the previous page, '' for the first page.
+  -- The page token carries that video's sort values, so the listing goes on when it was deleted or moved since.
+  -- Each sort order resumes after those values, the id breaks ties.
+  AND (CAST(@after_id AS TEXT) = '' OR CASE (SELECT sort_order FROM sort_params)
+    WHEN 'created_at_asc' THEN (v.created_at, v.id) > (sqlc.arg(after_created_at), CAST(@after_id AS TEXT))
+    WHEN 'title_asc' THEN (v.title COLLATE NOCASE, v.id) > (CAST(@after_title AS TEXT), CAST(@after_id AS TEXT))
+    WHEN 'title_desc' THEN (v.title COLLATE NOCASE, v.id) < (CAST(@after_title AS TEXT), CAST(@after_id AS TEXT))
+    WHEN 'duration_asc' THEN (v.duration_seconds, v.id) > (CAST(@after_duration_seconds AS INTEGER), CAST(@after_id AS TEXT))
+    WHEN 'duration_desc' THEN (v.duration_seconds, v.id) < (CAST(@after_duration_seconds AS INTEGER), CAST(@after_id AS TEXT))
+    ELSE (v.created_at, v.id) < (sqlc.arg(after_created_at), CAST(@after_id AS TEXT))
+  END)
+ORDER BY
+  CASE WHEN (SELECT sort_order FROM sort_params) = 'title_asc' THEN v.title END COLLATE NOCASE ASC,
+  CASE WHEN (SELECT sort_order FROM sort_params) = 'title_desc' THEN v.title END COLLATE NOCASE DESC,
+  CASE WHEN (SELECT sort_order FROM sort_params) = 'duration_asc' THEN v.duration_seconds END ASC,
+  CASE WHEN (SELECT sort_order FROM sort_params) = 'duration_desc' THEN v.duration_seconds END DESC,
+  CASE WHEN (SELECT sort_order FROM sort_params) = 'created_at_asc' THEN v.created_at END ASC,
+  -- Ties are broken by the id in the direction of the sort, as in the keyset condition
+  CASE WHEN (SELECT sort_order FROM sort_params) IN ('created_at_asc', 'title_asc', 'duration_asc') THEN v.id END ASC,
+  CASE WHEN (SELECT sort_order FROM sort_params) IN ('title_desc', 'duration_desc') THEN v.id END DESC,
+  -- 'created_at_desc'
+  v.created_at DESC,
+  v.id DESC
+LIMIT @page_size;
 
--- name: GetAllAccessibleVideosByTenantID :many
-SELECT DISTINCT v.* FROM videoservice_videos v
-LEFT JOIN videoservice_channels c ON v.channel_id = c.id
-LEFT JOIN videoservice_channel_members cm ON c.id = cm.channel_id
+-- Same filters as GetAccessibleVideosPage, for the total count
+-- name: CountAccessibleVideos :one
+WITH channel_filter AS (SELECT CAST(@channel_ids AS TEXT) AS channel_ids)
+SELECT COUNT(*) FROM videoservice_videos v
 WHERE v.tenant_id = @tenant_id AND v.is_deleted = FALSE
   AND (
-    -- User's own videos (private)
-    (v.uploaded_user_id = @user_id AND (v.channel_id IS NULL OR v.channel_id = ''))
-    OR 
-    -- Videos in channels user is member of
-    (v.channel_id IS NOT NULL AND v.channel_id != '' AND cm.user_id = @user_id)
+    ((v.channel_id IS NULL OR v.channel_id = '') AND v.uploaded_user_id = @user_id)
+    OR v.channel_id IN (SELECT cm.channel_id FROM videoservice_channel_members cm WHERE cm.user_id = @user_id)
   )
-ORDER BY v.created_at DESC;
+  -- Optional filters, '' / 0 / NULL / FALSE leave them out
+  AND (CAST(@filter_channels AS BOOLEAN) = FALSE OR v.channel_id IN (SELECT value FROM json_each((SELECT channel_ids FROM channel_filter))))
+  AND (CAST(@uploader_id AS TEXT) = '' OR v.uploaded_user_id = CAST(@uploader_id AS TEXT))
+  AND (v.created_at >= sqlc.narg(created_after) OR sqlc.narg(created_after) IS NULL)
+  AND (v.created_at < sqlc.narg(created_before) OR sqlc.narg(created_before) IS NULL)
+  AND (CAST(@status AS TEXT) = '' OR v.status = CAST(@status AS TEXT))
+  AND (CAST(@min_duration_seconds AS INTEGER) = 0 OR v.duration_seconds >= CAST(@min_duration_seconds AS INTEGER))
+  AND (CAST(@max_duration_seconds AS INTEGER) = 0 OR (v.duration_seconds > 0 AND v.duration_seconds <= CAST(@max_duration_seconds AS INTEGER)));
 
 -- Channel queries
 -- name: CreateChannel :one
@@ -69,7 +113,9 @@ INSERT INTO videoservice_channels (
     description,
     created_by,
     created_at,
-    updated_at
+    updated_at,
+    privacy,
+    default_role
 ) VALUES (
     @id,
     @tenant_id,
@@ -77,7 +123,9 @@ INSERT INTO videoservice_channels (
     @description,
     @created_by,
     @created_at,
-    @updated_at
+    @updated_at,
+    @privacy,
+    @default_role
 ) RETURNING *;
 
 -- name: GetChannelsByTenantID :many
@@ -85,6 +133,49 @@ SELECT * FROM videoservice_channels
 WHERE tenant_id = @tenant_id
 ORDER BY created_at DESC;
 
+-- Channels the user is a member of, with their role, newest first
+-- name: GetChannelsForUserPage :many
+SELECT c.*, cm.role AS user_role FROM videoservice_channels c
+JOIN videoservice_channel_members cm ON cm.channel_id = c.id
+WHERE c.tenant_id = @tenant_id AND cm.user_id = @user_id
+  AND (CAST(@include_archived AS BOOLEAN) OR c.archived_at IS NULL)
+  AND (CAST(@after_id AS TEXT) = '' OR (c.created_at, c.id) < (
+    SELECT a.created_at, a.id FROM videoservice_channels a WHERE a.id = CAST(@after_id AS TEXT)
+  ))
+ORDER BY c.created_at DESC, c.id DESC
+LIMIT @page_size;
+
+-- name: CountChannelsForUser :one
+SELECT COUNT(*) FROM videoservice_channels c
+JOIN videoservice_channel_members cm ON cm.channel_id = c.id
+WHERE c.tenant_id = @tenant_id AND cm.user_id = @user_id
+  AND (CAST(@include_archived AS BOOLEAN) OR c.archived_at IS NULL);
+
+-- Channels of the tenant the user isn't a member of, newest first
+-- name: GetDiscoverableChannelsPage :many
+SELECT c.*,
+    EXISTS (
+        SELECT 1 FROM videoservice_channel_access_requests r
+        WHERE r.channel_id = c.id AND r.user_id = @user_id AND r.status = 'pending'
+    ) AS access_requested
+FROM videoservice_channels c
+WHERE c.tenant_id = @tenant_id AND c.archived_at IS NULL
+  AND c.id NOT IN (
+    SELECT cm.channel_id FROM videoservice_channel_members cm WHERE cm.user_id = @user_id
+  )
+  AND (CAST(@after_id AS TEXT) = '' OR (c.created_at, c.id) < (
+    SELECT a.created_at, a.id FROM videoservice_channels a WHERE a.id = CAST(@after_id AS TEXT)
+  ))
+ORDER BY c.created_at DESC, c.id DESC
+LIMIT @page_size;
+
+-- name: CountDiscoverableChannels :one
+SELECT COUNT(*) FROM videoservice_channels c
+WHERE c.tenant_id = @tenant_id AND c.archived_at IS NULL
+  AND c.id NOT IN (
+    SELECT cm.channel_id FROM videoservice_channel_members cm WHERE cm.user_id = @user_id
+  );
+
 -- name: GetChannelByIDAndTenantID :one
 SELECT * FROM videoservice_channels 
 WHERE id = @id AND tenant_id = @tenant_id;
@@ -94,10 +185,30 @@ UPDATE videoservice_channels
 SET 
     name = @name,
     description = @description,
+    privacy = @privacy,
+    default_role = @default_role,
     updated_at = @updated_at
 WHERE id = @id AND tenant_id = @tenant_id
 RETURNING *;
 
+-- name: ArchiveChannel :execrows
+UPDATE videoservice_channels
+SET archived_at = @archived_at, archived_by = @archived_by, updated_at = @archived_at
+WHERE id = @id AND tenant_id = @tenant_id AND archived_at IS NULL;
+
+-- name: UnarchiveChannel :execrows
+UPDATE videoservice_channels
+SET archived_at = NULL, archived_by = NULL, updated_at = @updated_at
+WHERE id = @id AND tenant_id = @tenant_id AND archived_at IS NOT NULL;
+
+-- name: DeleteChannel :execrows
+DELETE FROM videoservice_channels
+WHERE id = @id AND tenant_id = @tenant_id;
+
+-- name: DeleteChannelMembersByChannelID :exec
+DELETE FROM videoservice_channel_members
+WHERE channel_id = @channel_id;
+
 -- name: CreateChannelMember :one
 INSERT INTO videoservice_channel_members (
     id,
@@ -123,6 +234,8 @@ SELECT
     cm.role,
     cm.added_by,
     cm.created_at,
+    cm.role_updated_by,
+    cm.role_updated_at,
     c.name as channel_name,
     c.tenant_id
 FROM videoservice_channel_members cm
@@ -154,6 +267,68 @@ WHERE cm.channel_id = @channel_id AND cm.user_id = @user_id AND c.tenant_id = @t
 DELETE FROM videoservice_channel_members 
 WHERE channel_id = @channel_id AND user_id = @user_id;
 
+-- An owner is only changed while the channel has another one. The check is part of the update,
+-- so two owners demoting each other at the same time can't both succeed.
+-- name: UpdateChannelMemberRole :execrows
+UPDATE videoservice_channel_members
+SET role = @role, role_updated_by = @role_updated_by, role_updated_at = @role_updated_at
+WHERE videoservice_channel_members.channel_id = @channel_id
+  AND videoservice_channel_members.user_id = @user_id
+  AND (videoservice_channel_members.role <> 'owner' OR (
+    SELECT COUNT(*) FROM videoservice_channel_members owners
+    WHERE owners.channel_id = videoservice_channel_members.channel_id AND owners.role = 'owner'
+  ) > 1);
+
+-- Access requests to closed channels
+-- name: CreateChannelAccessRequest :one
+INSERT INTO videoservice_channel_access_requests (
+    id,
+    channel_id,
+    tenant_id,
+    user_id,
+    message,
+    status,
+    created_at
+) VALUES (
+    @id,
+    @channel_id,
+    @tenant_id,
+    @user_id,
+    @message,
+    'pending',
+    @created_at
+) RETURNING *;
+
+-- name: GetChannelAccessRequestByID :one
+SELECT * FROM videoservice_channel_access_requests
+WHERE id = @id AND tenant_id = @tenant_id;
+
+-- name: GetPendingChannelAccessRequest :one
+SELECT * FROM videoservice_channel_access_requests
+WHERE channel_id = @channel_id AND user_id = @user_id AND status = 'pending';
+
+-- name: GetPendingChannelAccessRequests :many
+SELECT * FROM videoservice_channel_access_requests
+WHERE channel_id = @channel_id AND tenant_id = @tenant_id AND status = 'pending'
+ORDER BY created_at ASC;
+
+-- name: DecideChannelAccessRequest :execrows
+UPDATE videoservice_channel_access_requests
+SET status = @status, decided_by = @decided_by, decided_at = @decided_at
+WHERE id = @id AND status = 'pending';
+
+-- name: DeleteChannelAccessRequestsByChannelID :exec
+DELETE FROM videoservice_channel_access_requests
+WHERE channel_id = @channel_id;
+
+-- name: DeleteChannelAccessRequestsByUserAndTenant :exec
+DELETE FROM videoservice_channel_access_requests
+WHERE user_id = @user_id AND tenant_id = @tenant_id;
+
+-- name: DeleteChannelAccessRequestsByTenantID :exec
+DELETE FROM videoservice_channel_access_requests
+WHERE tenant_id = @tenant_id;
+
 -- Video-Channel Management Queries
 -- name: UpdateVideoChannel :exec
 UPDATE videoservice_videos 
@@ -177,11 +352,163 @@ UPDATE videoservice_videos
 SET is_deleted = TRUE, updated_at = @updated_at
 WHERE id = @video_id AND tenant_id = @tenant_id AND is_deleted = FALSE;
 
--- name: GetVideoCountsPerChannelByTenantID :many
-SELECT 
+-- What happens to the videos of a deleted channel
+-- name: MoveChannelVideos :execrows
+UPDATE videoservice_videos
+SET channel_id = @target_channel_id, updated_at = @updated_at
+WHERE tenant_id = @tenant_id AND channel_id = @channel_id AND is_deleted = FALSE;
+
+-- name: ReturnChannelVideosToUploaders :execrows
+UPDATE videoservice_videos
+SET channel_id = NULL, updated_at = @updated_at
+WHERE tenant_id = @tenant_id AND channel_id = @channel_id AND is_deleted = FALSE;
+
+-- name: SoftDeleteChannelVideos :execrows
+UPDATE videoservice_videos
+SET is_deleted = TRUE, updated_at = @updated_at
+WHERE tenant_id = @tenant_id AND channel_id = @channel_id AND is_deleted = FALSE;
+
+-- name: GetVideoCountsByChannelIDs :many
+SELECT
   channel_id,
   COUNT(*) AS video_count
 FROM videoservice_videos
-WHERE tenant_id = @tenant_id AND is_deleted = FALSE AND channel_id IS NOT NULL AND channel_id != ''
+WHERE tenant_id = @tenant_id AND is_deleted = FALSE AND channel_id IN (sqlc.slice(channel_ids))
 GROUP BY channel_id;
 
+
+-- Watch progress queries
+-- name: UpsertWatchProgress :one
+INSERT INTO videoservice_watch_progress (
+    id,
+    tenant_id,
+    video_id,
+    user_id,
+    position_seconds,
+    completed,
+    created_at,
+    updated_at
+) VALUES (
+    @id,
+    @tenant_id,
+    @video_id,
+    @user_id,
+    @position_seconds,
+    @completed,
+    @created_at,
+    @updated_at
+)
+ON CONFLICT(user_id, video_id) DO UPDATE SET
+    position_seconds = excluded.position_seconds,
+    completed = excluded.completed,
+    updated_at = excluded.updated_at
+RETURNING *;
+
+-- name: GetWatchProgress :one
+SELECT * FROM videoservice_watch_progress
+WHERE video_id = @video_id AND user_id = @user_id AND tenant_id = @tenant_id;
+
+-- name: GetWatchProgressByVideoIDs :many
+SELECT * FROM videoservice_watch_progress
+WHERE tenant_id = @tenant_id AND user_id = @user_id AND video_id IN (sqlc.slice(video_ids));
+
+-- Reaction queries
+-- name: CreateVideoReaction :one
+INSERT INTO videoservice_video_reactions (
+    id,
+    tenant_id,
+    video_id,
+    user_id,
+    emoji,
+    timestamp_seconds,
+    created_at
+) VALUES (
+    @id,
+    @tenant_id,
+    @video_id,
+    @user_id,
+    @emoji,
+    @timestamp_seconds,
+    @created_at
+)
+-- Reacting twice with the same emoji at the same moment returns the existing reaction
+ON CONFLICT(video_id, user_id, emoji, timestamp_seconds) DO UPDATE SET
+    emoji = excluded.emoji
+RETURNING *;
+
+-- name: DeleteVideoReaction :execrows
+DELETE FROM videoservice_video_reactions
+WHERE id = @id AND user_id = @user_id AND tenant_id = @tenant_id;
+
+-- name: GetVideoReactionsByVideoID :many
+SELECT * FROM videoservice_video_reactions
+WHERE tenant_id = @tenant_id AND video_id = @video_id
+ORDER BY timestamp_seconds ASC, created_at ASC;
+
+-- name: GetVideoReactionCountsByVideoID :many
+SELECT
+    emoji,
+    COUNT(*) AS reaction_count,
+    CAST(SUM(CASE WHEN user_id = @user_id THEN 1 ELSE 0 END) AS INTEGER) AS my_reaction_count
+FROM videoservice_video_reactions
+WHERE tenant_id = @tenant_id AND video_id = @video_id
+GROUP BY emoji
+ORDER BY reaction_count DESC, emoji ASC;
+
+-- Tenant membership cleanup
+-- name: GetChannelMembershipsByUserAndTenant :many
+SELECT
+    cm.channel_id,
+    cm.role,
+    (SELECT COUNT(*) FROM videoservice_channel_members o
+     WHERE o.channel_id = cm.channel_id AND o.role = 'owner' AND o.user_id != cm.user_id) AS other_owner_count
+FROM videoservice_channel_members cm
+JOIN videoservice_channels c ON cm.channel_id = c.id
+WHERE cm.user_id = @user_id AND c.tenant_id = @tenant_id;
+
+-- name: DeleteChannelMembershipsByUserAndTenant :execrows
+DELETE FROM videoservice_channel_members
+WHERE user_id = @user_id
+  AND channel_id IN (SELECT c.id FROM videoservice_channels c WHERE c.tenant_id = @tenant_id);
+
+-- name: UpsertChannelOwner :exec
+INSERT INTO videoservice_channel_members (
+    id,
+    channel_id,
+    user_id,
+    role,
+    added_by,
+    created_at
+) VALUES (
+    @id,
+    @channel_id,
+    @user_id,
+    'owner',
+    @added_by,
+    @created_at
+) ON CONFLICT (channel_id, user_id) DO UPDATE SET role = 'owner';
+
+-- Tenant deletion, including soft deleted videos
+-- name: GetVideoFilesByTenantID :many
+SELECT id, url FROM videoservice_videos
+WHERE tenant_id = @tenant_id;
+
+-- name: DeleteWatchProgressByTenantID :exec
+DELETE FROM videoservice_watch_progress
+WHERE tenant_id = @tenant_id;
+
+-- name: DeleteVideoReactionsByTenantID :exec
+DELETE FROM videoservice_video_reactions
+WHERE tenant_id = @tenant_id;
+
+-- name: DeleteVideosByTenantID :execrows
+DELETE FROM videoservice_videos
+WHERE tenant_id = @tenant_id;
+
+-- name: DeleteChannelMembersByTenantID :exec
+DELETE FROM videoservice_channel_members
+WHERE channel_id IN (SELECT c.id FROM videoservice_channels c WHERE c.tenant_id = @tenant_id);
+
+-- name: DeleteChannelsByTenantID :execrows
+DELETE FROM videoservice_channels
+WHERE tenant_id = @tenant_id;
@@ -0,0 +1,1447 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: videoservice/proto/videoservice_grpc.pb.go
+
+// Package proto is a generated GoMock package.
+package proto
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	grpc "google.golang.org/grpc"
+)
+
+// MockVideoServiceClient is a mock of VideoServiceClient interface.
+type MockVideoServiceClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockVideoServiceClientMockRecorder
+}
+
+// MockVideoServiceClientMockRecorder is the mock recorder for MockVideoServiceClient.
+type MockVideoServiceClientMockRecorder struct {
+	mock *MockVideoServiceClient
+}
+
+// NewMockVideoServiceClient creates a new mock instance.
+func NewMockVideoServiceClient(ctrl *gomock.Controller) *MockVideoServiceClient {
+	mock := &MockVideoServiceClient{ctrl: ctrl}
+	mock.recorder = &MockVideoServiceClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockVideoServiceClient) EXPECT() *MockVideoServiceClientMockRecorder {
+	return m.recorder
+}
+
+// AddReaction mocks base method.
+func (m *MockVideoServiceClient) AddReaction(ctx context.Context, in *AddReactionRequest, opts ...grpc.CallOption) (*Reaction, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "AddReaction", varargs...)
+	ret0, _ := ret[0].(*Reaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddReaction indicates an expected call of AddReaction.
+func (mr *MockVideoServiceClientMockRecorder) AddReaction(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddReaction", reflect.TypeOf((*MockVideoServiceClient)(nil).AddReaction), varargs...)
+}
+
+// CreateVideo mocks base method.
+func (m *MockVideoServiceClient) CreateVideo(ctx context.Context, in *CreateVideoRequest, opts ...grpc.CallOption) (*Video, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateVideo", varargs...)
+	ret0, _ := ret[0].(*Video)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateVideo indicates an expected call of CreateVideo.
+func (mr *MockVideoServiceClientMockRecorder) CreateVideo(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateVideo", reflect.TypeOf((*MockVideoServiceClient)(nil).CreateVideo), varargs...)
+}
+
+// DeleteVideo mocks base method.
+func (m *MockVideoServiceClient) DeleteVideo(ctx context.Context, in *DeleteVideoRequest, opts ...grpc.CallOption) (*DeleteVideoResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteVideo", varargs...)
+	ret0, _ := ret[0].(*DeleteVideoResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteVideo indicates an expected call of DeleteVideo.
+func (mr *MockVideoServiceClientMockRecorder) DeleteVideo(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteVideo", reflect.TypeOf((*MockVideoServiceClient)(nil).DeleteVideo), varargs...)
+}
+
+// FilterVideoViewers mocks base method.
+func (m *MockVideoServiceClient) FilterVideoViewers(ctx context.Context, in *FilterVideoViewersRequest, opts ...grpc.CallOption) (*FilterVideoViewersResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "FilterVideoViewers", varargs...)
+	ret0, _ := ret[0].(*FilterVideoViewersResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FilterVideoViewers indicates an expected call of FilterVideoViewers.
+func (mr *MockVideoServiceClientMockRecorder) FilterVideoViewers(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FilterVideoViewers", reflect.TypeOf((*MockVideoServiceClient)(nil).FilterVideoViewers), varargs...)
+}
+
+// GetVideo mocks base method.
+func (m *MockVideoServiceClient) GetVideo(ctx context.Context, in *GetVideoRequest, opts ...grpc.CallOption) (*Video, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetVideo", varargs...)
+	ret0, _ := ret[0].(*Video)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVideo indicates an expected call of GetVideo.
+func (mr *MockVideoServiceClientMockRecorder) GetVideo(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVideo", reflect.TypeOf((*MockVideoServiceClient)(nil).GetVideo), varargs...)
+}
+
+// GetWatchProgress mocks base method.
+func (m *MockVideoServiceClient) GetWatchProgress(ctx context.Context, in *GetWatchProgressRequest, opts ...grpc.CallOption) (*WatchProgress, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetWatchProgress", varargs...)
+	ret0, _ := ret[0].(*WatchProgress)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWatchProgress indicates an expected call of GetWatchProgress.
+func (mr *MockVideoServiceClientMockRecorder) GetWatchProgress(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWatchProgress", reflect.TypeOf((*MockVideoServiceClient)(nil).GetWatchProgress), varargs...)
+}
+
+// ListReactions mocks base method.
+func (m *MockVideoServiceClient) ListReactions(ctx context.Context, in *ListReactionsRequest, opts ...grpc.CallOption) (*ListReactionsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListReactions", varargs...)
+	ret0, _ := ret[0].(*ListReactionsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListReactions indicates an expected call of ListReactions.
+func (mr *MockVideoServiceClientMockRecorder) ListReactions(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListReactions", reflect.TypeOf((*MockVideoServiceClient)(nil).ListReactions), varargs...)
+}
+
+// ListVideos mocks base method.
+func (m *MockVideoServiceClient) ListVideos(ctx context.Context, in *ListVideosRequest, opts ...grpc.CallOption) (*ListVideosResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListVideos", varargs...)
+	ret0, _ := ret[0].(*ListVideosResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListVideos indicates an expected call of ListVideos.
+func (mr *MockVideoServiceClientMockRecorder) ListVideos(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVideos", reflect.TypeOf((*MockVideoServiceClient)(nil).ListVideos), varargs...)
+}
+
+// MoveVideoToChannel mocks base method.
+func (m *MockVideoServiceClient) MoveVideoToChannel(ctx context.Context, in *MoveVideoToChannelRequest, opts ...grpc.CallOption) (*MoveVideoToChannelResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "MoveVideoToChannel", varargs...)
+	ret0, _ := ret[0].(*MoveVideoToChannelResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MoveVideoToChannel indicates an expected call of MoveVideoToChannel.
+func (mr *MockVideoServiceClientMockRecorder) MoveVideoToChannel(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveVideoToChannel", reflect.TypeOf((*MockVideoServiceClient)(nil).MoveVideoToChannel), varargs...)
+}
+
+// RemoveReaction mocks base method.
+func (m *MockVideoServiceClient) RemoveReaction(ctx context.Context, in *RemoveReactionRequest, opts ...grpc.CallOption) (*RemoveReactionResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RemoveReaction", varargs...)
+	ret0, _ := ret[0].(*RemoveReactionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveReaction indicates an expected call of RemoveReaction.
+func (mr *MockVideoServiceClientMockRecorder) RemoveReaction(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveReaction", reflect.TypeOf((*MockVideoServiceClient)(nil).RemoveReaction), varargs...)
+}
+
+// RemoveVideoFromChannel mocks base method.
+func (m *MockVideoServiceClient) RemoveVideoFromChannel(ctx context.Context, in *RemoveVideoFromChannelRequest, opts ...grpc.CallOption) (*RemoveVideoFromChannelResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RemoveVideoFromChannel", varargs...)
+	ret0, _ := ret[0].(*RemoveVideoFromChannelResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveVideoFromChannel indicates an expected call of RemoveVideoFromChannel.
+func (mr *MockVideoServiceClientMockRecorder) RemoveVideoFromChannel(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveVideoFromChannel", reflect.TypeOf((*MockVideoServiceClient)(nil).RemoveVideoFromChannel), varargs...)
+}
+
+// SaveWatchProgress mocks base method.
+func (m *MockVideoServiceClient) SaveWatchProgress(ctx context.Context, in *SaveWatchProgressRequest, opts ...grpc.CallOption) (*WatchProgress, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SaveWatchProgress", varargs...)
+	ret0, _ := ret[0].(*WatchProgress)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SaveWatchProgress indicates an expected call of SaveWatchProgress.
+func (mr *MockVideoServiceClientMockRecorder) SaveWatchProgress(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveWatchProgress", reflect.TypeOf((*MockVideoServiceClient)(nil).SaveWatchProgress), varargs...)
+}
+
+// ShareVideo mocks base method.
+func (m *MockVideoServiceClient) ShareVideo(ctx context.Context, in *ShareVideoRequest, opts ...grpc.CallOption) (*ShareLink, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ShareVideo", varargs...)
+	ret0, _ := ret[0].(*ShareLink)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ShareVideo indicates an expected call of ShareVideo.
+func (mr *MockVideoServiceClientMockRecorder) ShareVideo(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ShareVideo", reflect.TypeOf((*MockVideoServiceClient)(nil).ShareVideo), varargs...)
+}
+
+// UpdateVideo mocks base method.
+func (m *MockVideoServiceClient) UpdateVideo(ctx context.Context, in *UpdateVideoRequest, opts ...grpc.CallOption) (*Video, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateVideo", varargs...)
+	ret0, _ := ret[0].(*Video)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateVideo indicates an expected call of UpdateVideo.
+func (mr *MockVideoServiceClientMockRecorder) UpdateVideo(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateVideo", reflect.TypeOf((*MockVideoServiceClient)(nil).UpdateVideo), varargs...)
+}
+
+// MockVideoServiceServer is a mock of VideoServiceServer interface.
+type MockVideoServiceServer struct {
+	ctrl     *gomock.Controller
+	recorder *MockVideoServiceServerMockRecorder
+}
+
+// MockVideoServiceServerMockRecorder is the mock recorder for MockVideoServiceServer.
+type MockVideoServiceServerMockRecorder struct {
+	mock *MockVideoServiceServer
+}
+
+// NewMockVideoServiceServer creates a new mock instance.
+func NewMockVideoServiceServer(ctrl *gomock.Controller) *MockVideoServiceServer {
+	mock := &MockVideoServiceServer{ctrl: ctrl}
+	mock.recorder = &MockVideoServiceServerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockVideoServiceServer) EXPECT() *MockVideoServiceServerMockRecorder {
+	return m.recorder
+}
+
+// AddReaction mocks base method.
+func (m *MockVideoServiceServer) AddReaction(arg0 context.Context, arg1 *AddReactionRequest) (*Reaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddReaction", arg0, arg1)
+	ret0, _ := ret[0].(*Reaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddReaction indicates an expected call of AddReaction.
+func (mr *MockVideoServiceServerMockRecorder) AddReaction(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddReaction", reflect.TypeOf((*MockVideoServiceServer)(nil).AddReaction), arg0, arg1)
+}
+
+// CreateVideo mocks base method.
+func (m *MockVideoServiceServer) CreateVideo(arg0 context.Context, arg1 *CreateVideoRequest) (*Video, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateVideo", arg0, arg1)
+	ret0, _ := ret[0].(*Video)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateVideo indicates an expected call of CreateVideo.
+func (mr *MockVideoServiceServerMockRecorder) CreateVideo(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateVideo", reflect.TypeOf((*MockVideoServiceServer)(nil).CreateVideo), arg0, arg1)
+}
+
+// DeleteVideo mocks base method.
+func (m *MockVideoServiceServer) DeleteVideo(arg0 context.Context, arg1 *DeleteVideoRequest) (*DeleteVideoResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteVideo", arg0, arg1)
+	ret0, _ := ret[0].(*DeleteVideoResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteVideo indicates an expected call of DeleteVideo.
+func (mr *MockVideoServiceServerMockRecorder) DeleteVideo(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteVideo", reflect.TypeOf((*MockVideoServiceServer)(nil).DeleteVideo), arg0, arg1)
+}
+
+// FilterVideoViewers mocks base method.
+func (m *MockVideoServiceServer) FilterVideoViewers(arg0 context.Context, arg1 *FilterVideoViewersRequest) (*FilterVideoViewersResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FilterVideoViewers", arg0, arg1)
+	ret0, _ := ret[0].(*FilterVideoViewersResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FilterVideoViewers indicates an expected call of FilterVideoViewers.
+func (mr *MockVideoServiceServerMockRecorder) FilterVideoViewers(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FilterVideoViewers", reflect.TypeOf((*MockVideoServiceServer)(nil).FilterVideoViewers), arg0, arg1)
+}
+
+// GetVideo mocks base method.
+func (m *MockVideoServiceServer) GetVideo(arg0 context.Context, arg1 *GetVideoRequest) (*Video, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVideo", arg0, arg1)
+	ret0, _ := ret[0].(*Video)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVideo indicates an expected call of GetVideo.
+func (mr *MockVideoServiceServerMockRecorder) GetVideo(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVideo", reflect.TypeOf((*MockVideoServiceServer)(nil).GetVideo), arg0, arg1)
+}
+
+// GetWatchProgress mocks base method.
+func (m *MockVideoServiceServer) GetWatchProgress(arg0 context.Context, arg1 *GetWatchProgressRequest) (*WatchProgress, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWatchProgress", arg0, arg1)
+	ret0, _ := ret[0].(*WatchProgress)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWatchProgress indicates an expected call of GetWatchProgress.
+func (mr *MockVideoServiceServerMockRecorder) GetWatchProgress(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWatchProgress", reflect.TypeOf((*MockVideoServiceServer)(nil).GetWatchProgress), arg0, arg1)
+}
+
+// ListReactions mocks base method.
+func (m *MockVideoServiceServer) ListReactions(arg0 context.Context, arg1 *ListReactionsRequest) (*ListReactionsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListReactions", arg0, arg1)
+	ret0, _ := ret[0].(*ListReactionsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListReactions indicates an expected call of ListReactions.
+func (mr *MockVideoServiceServerMockRecorder) ListReactions(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListReactions", reflect.TypeOf((*MockVideoServiceServer)(nil).ListReactions), arg0, arg1)
+}
+
+// ListVideos mocks base method.
+func (m *MockVideoServiceServer) ListVideos(arg0 context.Context, arg1 *ListVideosRequest) (*ListVideosResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListVideos", arg0, arg1)
+	ret0, _ := ret[0].(*ListVideosResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListVideos indicates an expected call of ListVideos.
+func (mr *MockVideoServiceServerMockRecorder) ListVideos(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVideos", reflect.TypeOf((*MockVideoServiceServer)(nil).ListVideos), arg0, arg1)
+}
+
+// MoveVideoToChannel mocks base method.
+func (m *MockVideoServiceServer) MoveVideoToChannel(arg0 context.Context, arg1 *MoveVideoToChannelRequest) (*MoveVideoToChannelResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MoveVideoToChannel", arg0, arg1)
+	ret0, _ := ret[0].(*MoveVideoToChannelResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MoveVideoToChannel indicates an expected call of MoveVideoToChannel.
+func (mr *MockVideoServiceServerMockRecorder) MoveVideoToChannel(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveVideoToChannel", reflect.TypeOf((*MockVideoServiceServer)(nil).MoveVideoToChannel), arg0, arg1)
+}
+
+// RemoveReaction mocks base method.
+func (m *MockVideoServiceServer) RemoveReaction(arg0 context.Context, arg1 *RemoveReactionRequest) (*RemoveReactionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveReaction", arg0, arg1)
+	ret0, _ := ret[0].(*RemoveReactionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveReaction indicates an expected call of RemoveReaction.
+func (mr *MockVideoServiceServerMockRecorder) RemoveReaction(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveReaction", reflect.TypeOf((*MockVideoServiceServer)(nil).RemoveReaction), arg0, arg1)
+}
+
+// RemoveVideoFromChannel mocks base method.
+func (m *MockVideoServiceServer) RemoveVideoFromChannel(arg0 context.Context, arg1 *RemoveVideoFromChannelRequest) (*RemoveVideoFromChannelResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveVideoFromChannel", arg0, arg1)
+	ret0, _ := ret[0].(*RemoveVideoFromChannelResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveVideoFromChannel indicates an expected call of RemoveVideoFromChannel.
+func (mr *MockVideoServiceServerMockRecorder) RemoveVideoFromChannel(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveVideoFromChannel", reflect.TypeOf((*MockVideoServiceServer)(nil).RemoveVideoFromChannel), arg0, arg1)
+}
+
+// SaveWatchProgress mocks base method.
+func (m *MockVideoServiceServer) SaveWatchProgress(arg0 context.Context, arg1 *SaveWatchProgressRequest) (*WatchProgress, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveWatchProgress", arg0, arg1)
+	ret0, _ := ret[0].(*WatchProgress)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SaveWatchProgress indicates an expected call of SaveWatchProgress.
+func (mr *MockVideoServiceServerMockRecorder) SaveWatchProgress(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveWatchProgress", reflect.TypeOf((*MockVideoServiceServer)(nil).SaveWatchProgress), arg0, arg1)
+}
+
+// ShareVideo mocks base method.
+func (m *MockVideoServiceServer) ShareVideo(arg0 context.Context, arg1 *ShareVideoRequest) (*ShareLink, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ShareVideo", arg0, arg1)
+	ret0, _ := ret[0].(*ShareLink)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ShareVideo indicates an expected call of ShareVideo.
+func (mr *MockVideoServiceServerMockRecorder) ShareVideo(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ShareVideo", reflect.TypeOf((*MockVideoServiceServer)(nil).ShareVideo), arg0, arg1)
+}
+
+// UpdateVideo mocks base method.
+func (m *MockVideoServiceServer) UpdateVideo(arg0 context.Context, arg1 *UpdateVideoRequest) (*Video, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateVideo", arg0, arg1)
+	ret0, _ := ret[0].(*Video)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateVideo indicates an expected call of UpdateVideo.
+func (mr *MockVideoServiceServerMockRecorder) UpdateVideo(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateVideo", reflect.TypeOf((*MockVideoServiceServer)(nil).UpdateVideo), arg0, arg1)
+}
+
+// mustEmbedUnimplementedVideoServiceServer mocks base method.
+func (m *MockVideoServiceServer) mustEmbedUnimplementedVideoServiceServer() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "mustEmbedUnimplementedVideoServiceServer")
+}
+
+// mustEmbedUnimplementedVideoServiceServer indicates an expected call of mustEmbedUnimplementedVideoServiceServer.
+func (mr *MockVideoServiceServerMockRecorder) mustEmbedUnimplementedVideoServiceServer() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "mustEmbedUnimplementedVideoServiceServer", reflect.TypeOf((*MockVideoServiceServer)(nil).mustEmbedUnimplementedVideoServiceServer))
+}
+
+// MockUnsafeVideoServiceServer is a mock of UnsafeVideoServiceServer interface.
+type MockUnsafeVideoServiceServer struct {
+	ctrl     *gomock.Controller
+	recorder *MockUnsafeVideoServiceServerMockRecorder
+}
+
+// MockUnsafeVideoServiceServerMockRecorder is the mock recorder for MockUnsafeVideoServiceServer.
+type MockUnsafeVideoServiceServerMockRecorder struct {
+	mock *MockUnsafeVideoServiceServer
+}
+
+// NewMockUnsafeVideoServiceServer creates a new mock instance.
+func NewMockUnsafeVideoServiceServer(ctrl *gomock.Controller) *MockUnsafeVideoServiceServer {
+	mock := &MockUnsafeVideoServiceServer{ctrl: ctrl}
+	mock.recorder = &MockUnsafeVideoServiceServerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUnsafeVideoServiceServer) EXPECT() *MockUnsafeVideoServiceServerMockRecorder {
+	return m.recorder
+}
+
+// mustEmbedUnimplementedVideoServiceServer mocks base method.
+func (m *MockUnsafeVideoServiceServer) mustEmbedUnimplementedVideoServiceServer() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "mustEmbedUnimplementedVideoServiceServer")
+}
+
+// mustEmbedUnimplementedVideoServiceServer indicates an expected call of mustEmbedUnimplementedVideoServiceServer.
+func (mr *MockUnsafeVideoServiceServerMockRecorder) mustEmbedUnimplementedVideoServiceServer() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "mustEmbedUnimplementedVideoServiceServer", reflect.TypeOf((*MockUnsafeVideoServiceServer)(nil).mustEmbedUnimplementedVideoServiceServer))
+}
+
+// MockChannelServiceClient is a mock of ChannelServiceClient interface.
+type MockChannelServiceClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockChannelServiceClientMockRecorder
+}
+
+// MockChannelServiceClientMockRecorder is the mock recorder for MockChannelServiceClient.
+type MockChannelServiceClientMockRecorder struct {
+	mock *MockChannelServiceClient
+}
+
+// NewMockChannelServiceClient creates a new mock instance.
+func NewMockChannelServiceClient(ctrl *gomock.Controller) *MockChannelServiceClient {
+	mock := &MockChannelServiceClient{ctrl: ctrl}
+	mock.recorder = &MockChannelServiceClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockChannelServiceClient) EXPECT() *MockChannelServiceClientMockRecorder {
+	return m.recorder
+}
+
+// AddMember mocks base method.
+func (m *MockChannelServiceClient) AddMember(ctx context.Context, in *AddChannelMemberRequest, opts ...grpc.CallOption) (*AddChannelMemberResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "AddMember", varargs...)
+	ret0, _ := ret[0].(*AddChannelMemberResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddMember indicates an expected call of AddMember.
+func (mr *MockChannelServiceClientMockRecorder) AddMember(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddMember", reflect.TypeOf((*MockChannelServiceClient)(nil).AddMember), varargs...)
+}
+
+// ApproveAccessRequest mocks base method.
+func (m *MockChannelServiceClient) ApproveAccessRequest(ctx context.Context, in *ApproveChannelAccessRequest, opts ...grpc.CallOption) (*ApproveChannelAccessResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ApproveAccessRequest", varargs...)
+	ret0, _ := ret[0].(*ApproveChannelAccessResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ApproveAccessRequest indicates an expected call of ApproveAccessRequest.
+func (mr *MockChannelServiceClientMockRecorder) ApproveAccessRequest(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApproveAccessRequest", reflect.TypeOf((*MockChannelServiceClient)(nil).ApproveAccessRequest), varargs...)
+}
+
+// ArchiveChannel mocks base method.
+func (m *MockChannelServiceClient) ArchiveChannel(ctx context.Context, in *ArchiveChannelRequest, opts ...grpc.CallOption) (*ArchiveChannelResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ArchiveChannel", varargs...)
+	ret0, _ := ret[0].(*ArchiveChannelResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ArchiveChannel indicates an expected call of ArchiveChannel.
+func (mr *MockChannelServiceClientMockRecorder) ArchiveChannel(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ArchiveChannel", reflect.TypeOf((*MockChannelServiceClient)(nil).ArchiveChannel), varargs...)
+}
+
+// CreateChannel mocks base method.
+func (m *MockChannelServiceClient) CreateChannel(ctx context.Context, in *CreateChannelRequest, opts ...grpc.CallOption) (*CreateChannelResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateChannel", varargs...)
+	ret0, _ := ret[0].(*CreateChannelResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateChannel indicates an expected call of CreateChannel.
+func (mr *MockChannelServiceClientMockRecorder) CreateChannel(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateChannel", reflect.TypeOf((*MockChannelServiceClient)(nil).CreateChannel), varargs...)
+}
+
+// DeleteChannel mocks base method.
+func (m *MockChannelServiceClient) DeleteChannel(ctx context.Context, in *DeleteChannelRequest, opts ...grpc.CallOption) (*DeleteChannelResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteChannel", varargs...)
+	ret0, _ := ret[0].(*DeleteChannelResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteChannel indicates an expected call of DeleteChannel.
+func (mr *MockChannelServiceClientMockRecorder) DeleteChannel(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteChannel", reflect.TypeOf((*MockChannelServiceClient)(nil).DeleteChannel), varargs...)
+}
+
+// DenyAccessRequest mocks base method.
+func (m *MockChannelServiceClient) DenyAccessRequest(ctx context.Context, in *DenyChannelAccessRequest, opts ...grpc.CallOption) (*DenyChannelAccessResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DenyAccessRequest", varargs...)
+	ret0, _ := ret[0].(*DenyChannelAccessResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DenyAccessRequest indicates an expected call of DenyAccessRequest.
+func (mr *MockChannelServiceClientMockRecorder) DenyAccessRequest(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DenyAccessRequest", reflect.TypeOf((*MockChannelServiceClient)(nil).DenyAccessRequest), varargs...)
+}
+
+// DiscoverChannels mocks base method.
+func (m *MockChannelServiceClient) DiscoverChannels(ctx context.Context, in *DiscoverChannelsRequest, opts ...grpc.CallOption) (*DiscoverChannelsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DiscoverChannels", varargs...)
+	ret0, _ := ret[0].(*DiscoverChannelsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DiscoverChannels indicates an expected call of DiscoverChannels.
+func (mr *MockChannelServiceClientMockRecorder) DiscoverChannels(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DiscoverChannels", reflect.TypeOf((*MockChannelServiceClient)(nil).DiscoverChannels), varargs...)
+}
+
+// GetAccessRequests mocks base method.
+func (m *MockChannelServiceClient) GetAccessRequests(ctx context.Context, in *GetChannelAccessRequestsRequest, opts ...grpc.CallOption) (*GetChannelAccessRequestsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetAccessRequests", varargs...)
+	ret0, _ := ret[0].(*GetChannelAccessRequestsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAccessRequests indicates an expected call of GetAccessRequests.
+func (mr *MockChannelServiceClientMockRecorder) GetAccessRequests(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccessRequests", reflect.TypeOf((*MockChannelServiceClient)(nil).GetAccessRequests), varargs...)
+}
+
+// GetChannel mocks base method.
+func (m *MockChannelServiceClient) GetChannel(ctx context.Context, in *GetChannelRequest, opts ...grpc.CallOption) (*GetChannelResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetChannel", varargs...)
+	ret0, _ := ret[0].(*GetChannelResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetChannel indicates an expected call of GetChannel.
+func (mr *MockChannelServiceClientMockRecorder) GetChannel(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChannel", reflect.TypeOf((*MockChannelServiceClient)(nil).GetChannel), varargs...)
+}
+
+// GetChannels mocks base method.
+func (m *MockChannelServiceClient) GetChannels(ctx context.Context, in *GetChannelsRequest, opts ...grpc.CallOption) (*GetChannelsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetChannels", varargs...)
+	ret0, _ := ret[0].(*GetChannelsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetChannels indicates an expected call of GetChannels.
+func (mr *MockChannelServiceClientMockRecorder) GetChannels(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChannels", reflect.TypeOf((*MockChannelServiceClient)(nil).GetChannels), varargs...)
+}
+
+// GetMembers mocks base method.
+func (m *MockChannelServiceClient) GetMembers(ctx context.Context, in *GetChannelMembersRequest, opts ...grpc.CallOption) (*GetChannelMembersResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetMembers", varargs...)
+	ret0, _ := ret[0].(*GetChannelMembersResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMembers indicates an expected call of GetMembers.
+func (mr *MockChannelServiceClientMockRecorder) GetMembers(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMembers", reflect.TypeOf((*MockChannelServiceClient)(nil).GetMembers), varargs...)
+}
+
+// JoinChannel mocks base method.
+func (m *MockChannelServiceClient) JoinChannel(ctx context.Context, in *JoinChannelRequest, opts ...grpc.CallOption) (*JoinChannelResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "JoinChannel", varargs...)
+	ret0, _ := ret[0].(*JoinChannelResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// JoinChannel indicates an expected call of JoinChannel.
+func (mr *MockChannelServiceClientMockRecorder) JoinChannel(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "JoinChannel", reflect.TypeOf((*MockChannelServiceClient)(nil).JoinChannel), varargs...)
+}
+
+// RemoveMember mocks base method.
+func (m *MockChannelServiceClient) RemoveMember(ctx context.Context, in *RemoveChannelMemberRequest, opts ...grpc.CallOption) (*RemoveChannelMemberResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RemoveMember", varargs...)
+	ret0, _ := ret[0].(*RemoveChannelMemberResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveMember indicates an expected call of RemoveMember.
+func (mr *MockChannelServiceClientMockRecorder) RemoveMember(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveMember", reflect.TypeOf((*MockChannelServiceClient)(nil).RemoveMember), varargs...)
+}
+
+// RequestAccess mocks base method.
+func (m *MockChannelServiceClient) RequestAccess(ctx context.Context, in *RequestChannelAccessRequest, opts ...grpc.CallOption) (*RequestChannelAccessResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RequestAccess", varargs...)
+	ret0, _ := ret[0].(*RequestChannelAccessResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RequestAccess indicates an expected call of RequestAccess.
+func (mr *MockChannelServiceClientMockRecorder) RequestAccess(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RequestAccess", reflect.TypeOf((*MockChannelServiceClient)(nil).RequestAccess), varargs...)
+}
+
+// UnarchiveChannel mocks base method.
+func (m *MockChannelServiceClient) UnarchiveChannel(ctx context.Context, in *UnarchiveChannelRequest, opts ...grpc.CallOption) (*UnarchiveChannelResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UnarchiveChannel", varargs...)
+	ret0, _ := ret[0].(*UnarchiveChannelResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UnarchiveChannel indicates an expected call of UnarchiveChannel.
+func (mr *MockChannelServiceClientMockRecorder) UnarchiveChannel(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnarchiveChannel", reflect.TypeOf((*MockChannelServiceClient)(nil).UnarchiveChannel), varargs...)
+}
+
+// UpdateChannel mocks base method.
+func (m *MockChannelServiceClient) UpdateChannel(ctx context.Context, in *UpdateChannelRequest, opts ...grpc.CallOption) (*UpdateChannelResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateChannel", varargs...)
+	ret0, _ := ret[0].(*UpdateChannelResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateChannel indicates an expected call of UpdateChannel.
+func (mr *MockChannelServiceClientMockRecorder) UpdateChannel(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateChannel", reflect.TypeOf((*MockChannelServiceClient)(nil).UpdateChannel), varargs...)
+}
+
+// UpdateMemberRole mocks base method.
+func (m *MockChannelServiceClient) UpdateMemberRole(ctx context.Context, in *UpdateChannelMemberRoleRequest, opts ...grpc.CallOption) (*UpdateChannelMemberRoleResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateMemberRole", varargs...)
+	ret0, _ := ret[0].(*UpdateChannelMemberRoleResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateMemberRole indicates an expected call of UpdateMemberRole.
+func (mr *MockChannelServiceClientMockRecorder) UpdateMemberRole(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateMemberRole", reflect.TypeOf((*MockChannelServiceClient)(nil).UpdateMemberRole), varargs...)
+}
+
+// MockChannelServiceServer is a mock of ChannelServiceServer interface.
+type MockChannelServiceServer struct {
+	ctrl     *gomock.Controller
+	recorder *MockChannelServiceServerMockRecorder
+}
+
+// MockChannelServiceServerMockRecorder is the mock recorder for MockChannelServiceServer.
+type MockChannelServiceServerMockRecorder struct {
+	mock *MockChannelServiceServer
+}
+
+// NewMockChannelServiceServer creates a new mock instance.
+func NewMockChannelServiceServer(ctrl *gomock.Controller) *MockChannelServiceServer {
+	mock := &MockChannelServiceServer{ctrl: ctrl}
+	mock.recorder = &MockChannelServiceServerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockChannelServiceServer) EXPECT() *MockChannelServiceServerMockRecorder {
+	return m.recorder
+}
+
+// AddMember mocks base method.
+func (m *MockChannelServiceServer) AddMember(arg0 context.Context, arg1 *AddChannelMemberRequest) (*AddChannelMemberResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddMember", arg0, arg1)
+	ret0, _ := ret[0].(*AddChannelMemberResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddMember indicates an expected call of AddMember.
+func (mr *MockChannelServiceServerMockRecorder) AddMember(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddMember", reflect.TypeOf((*MockChannelServiceServer)(nil).AddMember), arg0, arg1)
+}
+
+// ApproveAccessRequest mocks base method.
+func (m *MockChannelServiceServer) ApproveAccessRequest(arg0 context.Context, arg1 *ApproveChannelAccessRequest) (*ApproveChannelAccessResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ApproveAccessRequest", arg0, arg1)
+	ret0, _ := ret[0].(*ApproveChannelAccessResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ApproveAccessRequest indicates an expected call of ApproveAccessRequest.
+func (mr *MockChannelServiceServerMockRecorder) ApproveAccessRequest(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApproveAccessRequest", reflect.TypeOf((*MockChannelServiceServer)(nil).ApproveAccessRequest), arg0, arg1)
+}
+
+// ArchiveChannel mocks base method.
+func (m *MockChannelServiceServer) ArchiveChannel(arg0 context.Context, arg1 *ArchiveChannelRequest) (*ArchiveChannelResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ArchiveChannel", arg0, arg1)
+	ret0, _ := ret[0].(*ArchiveChannelResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ArchiveChannel indicates an expected call of ArchiveChannel.
+func (mr *MockChannelServiceServerMockRecorder) ArchiveChannel(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ArchiveChannel", reflect.TypeOf((*MockChannelServiceServer)(nil).ArchiveChannel), arg0, arg1)
+}
+
+// CreateChannel mocks base method.
+func (m *MockChannelServiceServer) CreateChannel(arg0 context.Context, arg1 *CreateChannelRequest) (*CreateChannelResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateChannel", arg0, arg1)
+	ret0, _ := ret[0].(*CreateChannelResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateChannel indicates an expected call of CreateChannel.
+func (mr *MockChannelServiceServerMockRecorder) CreateChannel(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateChannel", reflect.TypeOf((*MockChannelServiceServer)(nil).CreateChannel), arg0, arg1)
+}
+
+// DeleteChannel mocks base method.
+func (m *MockChannelServiceServer) DeleteChannel(arg0 context.Context, arg1 *DeleteChannelRequest) (*DeleteChannelResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteChannel", arg0, arg1)
+	ret0, _ := ret[0].(*DeleteChannelResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteChannel indicates an expected call of DeleteChannel.
+func (mr *MockChannelServiceServerMockRecorder) DeleteChannel(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteChannel", reflect.TypeOf((*MockChannelServiceServer)(nil).DeleteChannel), arg0, arg1)
+}
+
+// DenyAccessRequest mocks base method.
+func (m *MockChannelServiceServer) DenyAccessRequest(arg0 context.Context, arg1 *DenyChannelAccessRequest) (*DenyChannelAccessResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DenyAccessRequest", arg0, arg1)
+	ret0, _ := ret[0].(*DenyChannelAccessResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DenyAccessRequest indicates an expected call of DenyAccessRequest.
+func (mr *MockChannelServiceServerMockRecorder) DenyAccessRequest(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DenyAccessRequest", reflect.TypeOf((*MockChannelServiceServer)(nil).DenyAccessRequest), arg0, arg1)
+}
+
+// DiscoverChannels mocks base method.
+func (m *MockChannelServiceServer) DiscoverChannels(arg0 context.Context, arg1 *DiscoverChannelsRequest) (*DiscoverChannelsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DiscoverChannels", arg0, arg1)
+	ret0, _ := ret[0].(*DiscoverChannelsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DiscoverChannels indicates an expected call of DiscoverChannels.
+func (mr *MockChannelServiceServerMockRecorder) DiscoverChannels(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DiscoverChannels", reflect.TypeOf((*MockChannelServiceServer)(nil).DiscoverChannels), arg0, arg1)
+}
+
+// GetAccessRequests mocks base method.
+func (m *MockChannelServiceServer) GetAccessRequests(arg0 context.Context, arg1 *GetChannelAccessRequestsRequest) (*GetChannelAccessRequestsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAccessRequests", arg0, arg1)
+	ret0, _ := ret[0].(*GetChannelAccessRequestsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAccessRequests indicates an expected call of GetAccessRequests.
+func (mr *MockChannelServiceServerMockRecorder) GetAccessRequests(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccessRequests", reflect.TypeOf((*MockChannelServiceServer)(nil).GetAccessRequests), arg0, arg1)
+}
+
+// GetChannel mocks base method.
+func (m *MockChannelServiceServer) GetChannel(arg0 context.Context, arg1 *GetChannelRequest) (*GetChannelResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetChannel", arg0, arg1)
+	ret0, _ := ret[0].(*GetChannelResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetChannel indicates an expected call of GetChannel.
+func (mr *MockChannelServiceServerMockRecorder) GetChannel(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChannel", reflect.TypeOf((*MockChannelServiceServer)(nil).GetChannel), arg0, arg1)
+}
+
+// GetChannels mocks base method.
+func (m *MockChannelServiceServer) GetChannels(arg0 context.Context, arg1 *GetChannelsRequest) (*GetChannelsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetChannels", arg0, arg1)
+	ret0, _ := ret[0].(*GetChannelsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetChannels indicates an expected call of GetChannels.
+func (mr *MockChannelServiceServerMockRecorder) GetChannels(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChannels", reflect.TypeOf((*MockChannelServiceServer)(nil).GetChannels), arg0, arg1)
+}
+
+// GetMembers mocks base method.
+func (m *MockChannelServiceServer) GetMembers(arg0 context.Context, arg1 *GetChannelMembersRequest) (*GetChannelMembersResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMembers", arg0, arg1)
+	ret0, _ := ret[0].(*GetChannelMembersResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMembers indicates an expected call of GetMembers.
+func (mr *MockChannelServiceServerMockRecorder) GetMembers(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMembers", reflect.TypeOf((*MockChannelServiceServer)(nil).GetMembers), arg0, arg1)
+}
+
+// JoinChannel mocks base method.
+func (m *MockChannelServiceServer) JoinChannel(arg0 context.Context, arg1 *JoinChannelRequest) (*JoinChannelResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "JoinChannel", arg0, arg1)
+	ret0, _ := ret[0].(*JoinChannelResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// JoinChannel indicates an expected call of JoinChannel.
+func (mr *MockChannelServiceServerMockRecorder) JoinChannel(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "JoinChannel", reflect.TypeOf((*MockChannelServiceServer)(nil).JoinChannel), arg0, arg1)
+}
+
+// RemoveMember mocks base method.
+func (m *MockChannelServiceServer) RemoveMember(arg0 context.Context, arg1 *RemoveChannelMemberRequest) (*RemoveChannelMemberResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveMember", arg0, arg1)
+	ret0, _ := ret[0].(*RemoveChannelMemberResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveMember indicates an expected call of RemoveMember.
+func (mr *MockChannelServiceServerMockRecorder) RemoveMember(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveMember", reflect.TypeOf((*MockChannelServiceServer)(nil).RemoveMember), arg0, arg1)
+}
+
+// RequestAccess mocks base method.
+func (m *MockChannelServiceServer) RequestAccess(arg0 context.Context, arg1 *RequestChannelAccessRequest) (*RequestChannelAccessResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RequestAccess", arg0, arg1)
+	ret0, _ := ret[0].(*RequestChannelAccessResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RequestAccess indicates an expected call of RequestAccess.
+func (mr *MockChannelServiceServerMockRecorder) RequestAccess(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RequestAccess", reflect.TypeOf((*MockChannelServiceServer)(nil).RequestAccess), arg0, arg1)
+}
+
+// UnarchiveChannel mocks base method.
+func (m *MockChannelServiceServer) UnarchiveChannel(arg0 context.Context, arg1 *UnarchiveChannelRequest) (*UnarchiveChannelResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnarchiveChannel", arg0, arg1)
+	ret0, _ := ret[0].(*UnarchiveChannelResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UnarchiveChannel indicates an expected call of UnarchiveChannel.
+func (mr *MockChannelServiceServerMockRecorder) UnarchiveChannel(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnarchiveChannel", reflect.TypeOf((*MockChannelServiceServer)(nil).UnarchiveChannel), arg0, arg1)
+}
+
+// UpdateChannel mocks base method.
+func (m *MockChannelServiceServer) UpdateChannel(arg0 context.Context, arg1 *UpdateChannelRequest) (*UpdateChannelResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateChannel", arg0, arg1)
+	ret0, _ := ret[0].(*UpdateChannelResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateChannel indicates an expected call of UpdateChannel.
+func (mr *MockChannelServiceServerMockRecorder) UpdateChannel(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateChannel", reflect.TypeOf((*MockChannelServiceServer)(nil).UpdateChannel), arg0, arg1)
+}
+
+// UpdateMemberRole mocks base method.
+func (m *MockChannelServiceServer) UpdateMemberRole(arg0 context.Context, arg1 *UpdateChannelMemberRoleRequest) (*UpdateChannelMemberRoleResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateMemberRole", arg0, arg1)
+	ret0, _ := ret[0].(*UpdateChannelMemberRoleResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateMemberRole indicates an expected call of UpdateMemberRole.
+func (mr *MockChannelServiceServerMockRecorder) UpdateMemberRole(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateMemberRole", reflect.TypeOf((*MockChannelServiceServer)(nil).UpdateMemberRole), arg0, arg1)
+}
+
+// mustEmbedUnimplementedChannelServiceServer mocks base method.
+func (m *MockChannelServiceServer) mustEmbedUnimplementedChannelServiceServer() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "mustEmbedUnimplementedChannelServiceServer")
+}
+
+// mustEmbedUnimplementedChannelServiceServer indicates an expected call of mustEmbedUnimplementedChannelServiceServer.
+func (mr *MockChannelServiceServerMockRecorder) mustEmbedUnimplementedChannelServiceServer() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "mustEmbedUnimplementedChannelServiceServer", reflect.TypeOf((*MockChannelServiceServer)(nil).mustEmbedUnimplementedChannelServiceServer))
+}
+
+// MockUnsafeChannelServiceServer is a mock of UnsafeChannelServiceServer interface.
+type MockUnsafeChannelServiceServer struct {
+	ctrl     *gomock.Controller
+	recorder *MockUnsafeChannelServiceServerMockRecorder
+}
+
+// MockUnsafeChannelServiceServerMockRecorder is the mock recorder for MockUnsafeChannelServiceServer.
+type MockUnsafeChannelServiceServerMockRecorder struct {
+	mock *MockUnsafeChannelServiceServer
+}
+
+// NewMockUnsafeChannelServiceServer creates a new mock instance.
+func NewMockUnsafeChannelServiceServer(ctrl *gomock.Controller) *MockUnsafeChannelServiceServer {
+	mock := &MockUnsafeChannelServiceServer{ctrl: ctrl}
+	mock.recorder = &MockUnsafeChannelServiceServerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUnsafeChannelServiceServer) EXPECT() *MockUnsafeChannelServiceServerMockRecorder {
+	return m.recorder
+}
+
+// mustEmbedUnimplementedChannelServiceServer mocks base method.
+func (m *MockUnsafeChannelServiceServer) mustEmbedUnimplementedChannelServiceServer() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "mustEmbedUnimplementedChannelServiceServer")
+}
+
+// mustEmbedUnimplementedChannelServiceServer indicates an expected call of mustEmbedUnimplementedChannelServiceServer.
+func (mr *MockUnsafeChannelServiceServerMockRecorder) mustEmbedUnimplementedChannelServiceServer() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "mustEmbedUnimplementedChannelServiceServer", reflect.TypeOf((*MockUnsafeChannelServiceServer)(nil).mustEmbedUnimplementedChannelServiceServer))
+}
+
+// MockTenantCleanupServiceClient is a mock of TenantCleanupServiceClient interface.
+type MockTenantCleanupServiceClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockTenantCleanupServiceClientMockRecorder
+}
+
+// MockTenantCleanupServiceClientMockRecorder is the mock recorder for MockTenantCleanupServiceClient.
+type MockTenantCleanupServiceClientMockRecorder struct {
+	mock *MockTenantCleanupServiceClient
+}
+
+// NewMockTenantCleanupServiceClient creates a new mock instance.
+func NewMockTenantCleanupServiceClient(ctrl *gomock.Controller) *MockTenantCleanupServiceClient {
+	mock := &MockTenantCleanupServiceClient{ctrl: ctrl}
+	mock.recorder = &MockTenantCleanupServiceClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTenantCleanupServiceClient) EXPECT() *MockTenantCleanupServiceClientMockRecorder {
+	return m.recorder
+}
+
+// DeleteTenantData mocks base method.
+func (m *MockTenantCleanupServiceClient) DeleteTenantData(ctx context.Context, in *DeleteTenantDataRequest, opts ...grpc.CallOption) (*DeleteTenantDataResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteTenantData", varargs...)
+	ret0, _ := ret[0].(*DeleteTenantDataResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteTenantData indicates an expected call of DeleteTenantData.
+func (mr *MockTenantCleanupServiceClientMockRecorder) DeleteTenantData(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTenantData", reflect.TypeOf((*MockTenantCleanupServiceClient)(nil).DeleteTenantData), varargs...)
+}
+
+// RemoveTenantMember mocks base method.
+func (m *MockTenantCleanupServiceClient) RemoveTenantMember(ctx context.Context, in *RemoveTenantMemberRequest, opts ...grpc.CallOption) (*RemoveTenantMemberResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RemoveTenantMember", varargs...)
+	ret0, _ := ret[0].(*RemoveTenantMemberResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveTenantMember indicates an expected call of RemoveTenantMember.
+func (mr *MockTenantCleanupServiceClientMockRecorder) RemoveTenantMember(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveTenantMember", reflect.TypeOf((*MockTenantCleanupServiceClient)(nil).RemoveTenantMember), varargs...)
+}
+
+// MockTenantCleanupServiceServer is a mock of TenantCleanupServiceServer interface.
+type MockTenantCleanupServiceServer struct {
+	ctrl     *gomock.Controller
+	recorder *MockTenantCleanupServiceServerMockRecorder
+}
+
+// MockTenantCleanupServiceServerMockRecorder is the mock recorder for MockTenantCleanupServiceServer.
+type MockTenantCleanupServiceServerMockRecorder struct {
+	mock *MockTenantCleanupServiceServer
+}
+
+// NewMockTenantCleanupServiceServer creates a new mock instance.
+func NewMockTenantCleanupServiceServer(ctrl *gomock.Controller) *MockTenantCleanupServiceServer {
+	mock := &MockTenantCleanupServiceServer{ctrl: ctrl}
+	mock.recorder = &MockTenantCleanupServiceServerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTenantCleanupServiceServer) EXPECT() *MockTenantCleanupServiceServerMockRecorder {
+	return m.recorder
+}
+
+// DeleteTenantData mocks base method.
+func (m *MockTenantCleanupServiceServer) DeleteTenantData(arg0 context.Context, arg1 *DeleteTenantDataRequest) (*DeleteTenantDataResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTenantData", arg0, arg1)
+	ret0, _ := ret[0].(*DeleteTenantDataResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteTenantData indicates an expected call of DeleteTenantData.
+func (mr *MockTenantCleanupServiceServerMockRecorder) DeleteTenantData(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTenantData", reflect.TypeOf((*MockTenantCleanupServiceServer)(nil).DeleteTenantData), arg0, arg1)
+}
+
+// RemoveTenantMember mocks base method.
+func (m *MockTenantCleanupServiceServer) RemoveTenantMember(arg0 context.Context, arg1 *RemoveTenantMemberRequest) (*RemoveTenantMemberResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveTenantMember", arg0, arg1)
+	ret0, _ := ret[0].(*RemoveTenantMemberResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveTenantMember indicates an expected call of RemoveTenantMember.
+func (mr *MockTenantCleanupServiceServerMockRecorder) RemoveTenantMember(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveTenantMember", reflect.TypeOf((*MockTenantCleanupServiceServer)(nil).RemoveTenantMember), arg0, arg1)
+}
+
+// mustEmbedUnimplementedTenantCleanupServiceServer mocks base method.
+func (m *MockTenantCleanupServiceServer) mustEmbedUnimplementedTenantCleanupServiceServer() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "mustEmbedUnimplementedTenantCleanupServiceServer")
+}
+
+// mustEmbedUnimplementedTenantCleanupServiceServer indicates an expected call of mustEmbedUnimplementedTenantCleanupServiceServer.
+func (mr *MockTenantCleanupServiceServerMockRecorder) mustEmbedUnimplementedTenantCleanupServiceServer() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "mustEmbedUnimplementedTenantCleanupServiceServer", reflect.TypeOf((*MockTenantCleanupServiceServer)(nil).mustEmbedUnimplementedTenantCleanupServiceServer))
+}
+
+// MockUnsafeTenantCleanupServiceServer is a mock of UnsafeTenantCleanupServiceServer interface.
+type MockUnsafeTenantCleanupServiceServer struct {
+	ctrl     *gomock.Controller
+	recorder *MockUnsafeTenantCleanupServiceServerMockRecorder
+}
+
+// MockUnsafeTenantCleanupServiceServerMockRecorder is the mock recorder for MockUnsafeTenantCleanupServiceServer.
+type MockUnsafeTenantCleanupServiceServerMockRecorder struct {
+	mock *MockUnsafeTenantCleanupServiceServer
+}
+
+// NewMockUnsafeTenantCleanupServiceServer creates a new mock instance.
+func NewMockUnsafeTenantCleanupServiceServer(ctrl *gomock.Controller) *MockUnsafeTenantCleanupServiceServer {
+	mock := &MockUnsafeTenantCleanupServiceServer{ctrl: ctrl}
+	mock.recorder = &MockUnsafeTenantCleanupServiceServerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUnsafeTenantCleanupServiceServer) EXPECT() *MockUnsafeTenantCleanupServiceServerMockRecorder {
+	return m.recorder
+}
+
+// mustEmbedUnimplementedTenantCleanupServiceServer mocks base method.
+func (m *MockUnsafeTenantCleanupServiceServer) mustEmbedUnimplementedTenantCleanupServiceServer() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "mustEmbedUnimplementedTenantCleanupServiceServer")
+}
+
+// mustEmbedUnimplementedTenantCleanupServiceServer indicates an expected call of mustEmbedUnimplementedTenantCleanupServiceServer.
+func (mr *MockUnsafeTenantCleanupServiceServerMockRecorder) mustEmbedUnimplementedTenantCleanupServiceServer() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "mustEmbedUnimplementedTenantCleanupServiceServer", reflect.TypeOf((*MockUnsafeTenantCleanupServiceServer)(nil).mustEmbedUnimplementedTenantCleanupServiceServer))
+}
@@ -27,6 +27,12 @@ const (
 	VideoService_MoveVideoToChannel_FullMethodName     = "/videoservice.VideoService/MoveVideoToChannel"
 	VideoService_RemoveVideoFromChannel_FullMethodName = "/videoservice.VideoService/RemoveVideoFromChannel"
 	VideoService_ShareVideo_FullMethodName             = "/videoservice.VideoService/ShareVideo"
+	VideoService_SaveWatchProgress_FullMethodName      = "/videoservice.VideoService/SaveWatchProgress"
+	VideoService_GetWatchProgress_FullMethodName       = "/videoservice.VideoService/GetWatchProgress"
+	VideoService_AddReaction_FullMethodName            = "/videoservice.VideoService/AddReaction"
+	VideoService_RemoveReaction_FullMethodName         = "/videoservice.VideoService/RemoveReaction"
+	VideoService_ListReactions_FullMethodName          = "/videoservice.VideoService/ListReactions"
+	VideoService_FilterVideoViewers_FullMethodName     = "/videoservice.VideoService/FilterVideoViewers"
 )
 
 // VideoServiceClient is the client API for VideoService service.
@@ -44,6 +50,15 @@ type VideoServiceClient interface {
 	RemoveVideoFromChannel(ctx context.Context, in *RemoveVideoFromChannelRequest, opts ...grpc.CallOption) (*RemoveVideoFromChannelResponse, error)
 	// Sharing
 	ShareVideo(ctx context.Context, in *ShareVideoRequest, opts ...grpc.CallOption) (*ShareLink, error)
+	// Watch progress (resume where you left off)
+	SaveWatchProgress(ctx context.Context, in *SaveWatchProgressRequest, opts ...grpc.CallOption) (*WatchProgress, error)
+	GetWatchProgress(ctx context.Context, in *GetWatchProgressRequest, opts ...grpc.CallOption) (*WatchProgress, error)
+	// Reactions anchored to a moment in the video
+	AddReaction(ctx context.Context, in *AddReactionRequest, opts ...grpc.CallOption) (*Reaction, error)
+	RemoveReaction(ctx context.Context, in *RemoveReactionRequest, opts ...grpc.CallOption) (*RemoveReactionResponse, error)
+	ListReactions(ctx context.Context, in *ListReactionsRequest, opts ...grpc.CallOption) (*ListReactionsResponse, error)
+	// Narrow a set of users down to those who can view a video, e.g. before notifying them
+	FilterVideoViewers(ctx context.Context, in *FilterVideoViewersRequest, opts ...grpc.CallOption) (*FilterVideoViewersResponse, error)
 }
 
 type videoServiceClient struct {
@@ -134,6 +149,66 @@ func (c *videoServiceClient) ShareVideo(ctx context.Context, in *ShareVideoReque
 	return out, nil
 }
 
+func (c *videoServiceClient) SaveWatchProgress(ctx context.Context, in *SaveWatchProgressRequest, opts ...grpc.CallOption) (*WatchProgress, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(WatchProgress)
+	err := c.cc.Invoke(ctx, VideoService_SaveWatchProgress_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *videoServiceClient) GetWatchProgress(ctx context.Context, in *GetWatchProgressRequest, opts ...grpc.CallOption) (*WatchProgress, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(WatchProgress)
+	err := c.cc.Invoke(ctx, VideoService_GetWatchProgress_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *videoServiceClient) AddReaction(ctx context.Context, in *AddReactionRequest, opts ...grpc.CallOption) (*Reaction, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Reaction)
+	err := c.cc.Invoke(ctx, VideoService_AddReaction_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *videoServiceClient) RemoveReaction(ctx context.Context, in *RemoveReactionRequest, opts ...grpc.CallOption) (*RemoveReactionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemoveReactionResponse)
+	err := c.cc.Invoke(ctx, VideoService_RemoveReaction_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *videoServiceClient) ListReactions(ctx context.Context, in *ListReactionsRequest, opts ...grpc.CallOption) (*ListReactionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListReactionsResponse)
+	err := c.cc.Invoke(ctx, VideoService_ListReactions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *videoServiceClient) FilterVideoViewers(ctx context.Context, in *FilterVideoViewersRequest, opts ...grpc.CallOption) (*FilterVideoViewersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FilterVideoViewersResponse)
+	err := c.cc.Invoke(ctx, VideoService_FilterVideoViewers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // VideoServiceServer is the server API for VideoService service.
 // All implementations must embed UnimplementedVideoServiceServer
 // for forward compatibility.
@@ -149,6 +224,15 @@ type VideoServiceServer interface {
 	RemoveVideoFromChannel(context.Context, *RemoveVideoFromChannelRequest) (*RemoveVideoFromChannelResponse, error)
 	// Sharing
 	ShareVideo(context.Context, *ShareVideoRequest) (*ShareLink, error)
+	// Watch progress (resume where you left off)
+	SaveWatchProgress(context.Context, *SaveWatchProgressRequest) (*WatchProgress, error)
+	GetWatchProgress(context.Context, *GetWatchProgressRequest) (*WatchProgress, error)
+	// Reactions anchored to a moment in the video
+	AddReaction(context.Context, *AddReactionRequest) (*Reaction, error)
+	RemoveReaction(context.Context, *RemoveReactionRequest) (*RemoveReactionResponse, error)
+	ListReactions(context.Context, *ListReactionsRequest) (*ListReactionsResponse, error)
+	// Narrow a set of users down to those who can view a video, e.g. before notifying them
+	FilterVideoViewers(context.Context, *FilterVideoViewersRequest) (*FilterVideoViewersResponse, error)
 	mustEmbedUnimplementedVideoServiceServer()
 }
 
@@ -183,6 +267,24 @@ func (UnimplementedVideoServiceServer) RemoveVideoFromChannel(context.Context, *
 func (UnimplementedVideoServiceServer) ShareVideo(context.Context, *ShareVideoRequest) (*ShareLink, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ShareVideo not implemented")
 }
+func (UnimplementedVideoServiceServer) SaveWatchProgress(context.Context, *SaveWatchProgressRequest) (*WatchProgress, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SaveWatchProgress not implemented")
+}
+func (UnimplementedVideoServiceServer) GetWatchProgress(context.Context, *GetWatchProgressRequest) (*WatchProgress, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWatchProgress not implemented")
+}
+func (UnimplementedVideoServiceServer) AddReaction(context.Context, *AddReactionRequest) (*Reaction, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddReaction not implemented")
+}
+func (UnimplementedVideoServiceServer) RemoveReaction(context.Context, *RemoveReactionRequest) (*RemoveReactionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveReaction not implemented")
+}
+func (UnimplementedVideoServiceServer) ListReactions(context.Context, *ListReactionsRequest) (*ListReactionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListReactions not implemented")
+}
+func (UnimplementedVideoServiceServer) FilterVideoViewers(context.Context, *FilterVideoViewersRequest) (*FilterVideoViewersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FilterVideoViewers not implemented")
+}
 func (UnimplementedVideoServiceServer) mustEmbedUnimplementedVideoServiceServer() {}
 func (UnimplementedVideoServiceServer) testEmbeddedByValue()                      {}
 
@@ -348,6 +450,114 @@ func _VideoService_ShareVideo_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _VideoService_SaveWatchProgress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SaveWatchProgressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VideoServiceServer).SaveWatchProgress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VideoService_SaveWatchProgress_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VideoServiceServer).SaveWatchProgress(ctx, req.(*SaveWatchProgressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VideoService_GetWatchProgress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWatchProgressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VideoServiceServer).GetWatchProgress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VideoService_GetWatchProgress_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VideoServiceServer).GetWatchProgress(ctx, req.(*GetWatchProgressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VideoService_AddReaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddReactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VideoServiceServer).AddReaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VideoService_AddReaction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VideoServiceServer).AddReaction(ctx, req.(*AddReactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VideoService_RemoveReaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveReactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VideoServiceServer).RemoveReaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VideoService_RemoveReaction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VideoServiceServer).RemoveReaction(ctx, req.(*RemoveReactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VideoService_ListReactions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListReactionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VideoServiceServer).ListReactions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VideoService_ListReactions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VideoServiceServer).ListReactions(ctx, req.(*ListReactionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VideoService_FilterVideoViewers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FilterVideoViewersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VideoServiceServer).FilterVideoViewers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VideoService_FilterVideoViewers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VideoServiceServer).FilterVideoViewers(ctx, req.(*FilterVideoViewersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // VideoService_ServiceDesc is the grpc.ServiceDesc for VideoService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -387,18 +597,53 @@ var VideoService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ShareVideo",
 			Handler:    _VideoService_ShareVideo_Handler,
 		},
+		{
+			MethodName: "SaveWatchProgress",
+			Handler:    _VideoService_SaveWatchProgress_Handler,
+		},
+		{
+			MethodName: "GetWatchProgress",
+			Handler:    _VideoService_GetWatchProgress_Handler,
+		},
+		{
+			MethodName: "AddReaction",
+			Handler:    _VideoService_AddReaction_Handler,
+		},
+		{
+			MethodName: "RemoveReaction",
+			Handler:    _VideoService_RemoveReaction_Handler,
+		},
+		{
+			MethodName: "ListReactions",
+			Handler:    _VideoService_ListReactions_Handler,
+		},
+		{
+			MethodName: "FilterVideoViewers",
+			Handler:    _VideoService_FilterVideoViewers_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "videoservice.proto",
 }
 
 const (
-	ChannelService_CreateChannel_FullMethodName = "/videoservice.ChannelService/CreateChannel"
-	ChannelService_UpdateChannel_FullMethodName = "/videoservice.ChannelService/UpdateChannel"
-	ChannelService_GetChannels_FullMethodName   = "/videoservice.ChannelService/GetChannels"
-	ChannelService_GetMembers_FullMethodName    = "/videoservice.ChannelService/GetMembers"
-	ChannelService_AddMember_FullMethodName     = "/videoservice.ChannelService/AddMember"
-	ChannelService_RemoveMember_FullMethodName  = "/videoservice.ChannelService/RemoveMember"
+	ChannelService_CreateChannel_FullMethodName        = "/videoservice.ChannelService/CreateChannel"
+	ChannelService_UpdateChannel_FullMethodName        = "/videoservice.ChannelService/UpdateChannel"
+	ChannelService_GetChannels_FullMethodName          = "/videoservice.ChannelService/GetChannels"
+	ChannelService_GetChannel_FullMethodName           = "/videoservice.ChannelService/GetChannel"
+	ChannelService_GetMembers_FullMethodName           = "/videoservice.ChannelService/GetMembers"
+	ChannelService_AddMember_FullMethodName            = "/videoservice.ChannelService/AddMember"
+	ChannelService_RemoveMember_FullMethodName         = "/videoservice.ChannelService/RemoveMember"
+	ChannelService_UpdateMemberRole_FullMethodName     = "/videoservice.ChannelService/UpdateMemberRole"
+	ChannelService_ArchiveChannel_FullMethodName       = "/videoservice.ChannelService/ArchiveChannel"
+	ChannelService_UnarchiveChannel_FullMethodName     = "/videoservice.ChannelService/UnarchiveChannel"
+	ChannelService_DeleteChannel_FullMethodName        = "/videoservice.ChannelService/DeleteChannel"
+	ChannelService_DiscoverChannels_FullMethodName     = "/videoservice.ChannelService/DiscoverChannels"
+	ChannelService_JoinChannel_FullMethodName          = "/videoservice.ChannelService/JoinChannel"
+	ChannelService_RequestAccess_FullMethodName        = "/videoservice.ChannelService/RequestAccess"
+	ChannelService_GetAccessRequests_FullMethodName    = "/videoservice.ChannelService/GetAccessRequests"
+	ChannelService_ApproveAccessRequest_FullMethodName = "/videoservice.ChannelService/ApproveAccessRequest"
+	ChannelService_DenyAccessRequest_FullMethodName    = "/videoservice.ChannelService/DenyAccessRequest"
 )
 
 // ChannelServiceClient is the client API for ChannelService service.
@@ -408,9 +653,27 @@ type ChannelServiceClient interface {
 	CreateChannel(ctx context.Context, in *CreateChannelRequest, opts ...grpc.CallOption) (*CreateChannelResponse, error)
 	UpdateChannel(ctx context.Context, in *UpdateChannelRequest, opts ...grpc.CallOption) (*UpdateChannelResponse, error)
 	GetChannels(ctx context.Context, in *GetChannelsRequest, opts ...grpc.CallOption) (*GetChannelsResponse, error)
+	// A channel the caller is a member of, archived or not
+	GetChannel(ctx context.Context, in *GetChannelRequest, opts ...grpc.CallOption) (*GetChannelResponse, error)
 	GetMembers(ctx context.Context, in *GetChannelMembersRequest, opts ...grpc.CallOption) (*GetChannelMembersResponse, error)
 	AddMember(ctx context.Context, in *AddChannelMemberRequest, opts ...grpc.CallOption) (*AddChannelMemberResponse, error)
 	RemoveMember(ctx context.Context, in *RemoveChannelMemberRequest, opts ...grpc.CallOption) (*RemoveChannelMemberResponse, error)
+	// Owner only, a channel always keeps at least one owner
+	UpdateMemberRole(ctx context.Context, in *UpdateChannelMemberRoleRequest, opts ...grpc.CallOption) (*UpdateChannelMemberRoleResponse, error)
+	// Owner only. Archived channels are read-only and left out of GetChannels by default
+	ArchiveChannel(ctx context.Context, in *ArchiveChannelRequest, opts ...grpc.CallOption) (*ArchiveChannelResponse, error)
+	UnarchiveChannel(ctx context.Context, in *UnarchiveChannelRequest, opts ...grpc.CallOption) (*UnarchiveChannelResponse, error)
+	// Owner only. The caller decides what happens to the channel's videos
+	DeleteChannel(ctx context.Context, in *DeleteChannelRequest, opts ...grpc.CallOption) (*DeleteChannelResponse, error)
+	// Channels of the tenant the caller can join or ask to join
+	DiscoverChannels(ctx context.Context, in *DiscoverChannelsRequest, opts ...grpc.CallOption) (*DiscoverChannelsResponse, error)
+	// Open channels only, the caller becomes a member with the channel's default role
+	JoinChannel(ctx context.Context, in *JoinChannelRequest, opts ...grpc.CallOption) (*JoinChannelResponse, error)
+	// Closed channels only, an owner approves or denies the request
+	RequestAccess(ctx context.Context, in *RequestChannelAccessRequest, opts ...grpc.CallOption) (*RequestChannelAccessResponse, error)
+	GetAccessRequests(ctx context.Context, in *GetChannelAccessRequestsRequest, opts ...grpc.CallOption) (*GetChannelAccessRequestsResponse, error)
+	ApproveAccessRequest(ctx context.Context, in *ApproveChannelAccessRequest, opts ...grpc.CallOption) (*ApproveChannelAccessResponse, error)
+	DenyAccessRequest(ctx context.Context, in *DenyChannelAccessRequest, opts ...grpc.CallOption) (*DenyChannelAccessResponse, error)
 }
 
 type channelServiceClient struct {
@@ -451,6 +714,16 @@ func (c *channelServiceClient) GetChannels(ctx context.Context, in *GetChannelsR
 	return out, nil
 }
 
+func (c *channelServiceClient) GetChannel(ctx context.Context, in *GetChannelRequest, opts ...grpc.CallOption) (*GetChannelResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetChannelResponse)
+	err := c.cc.Invoke(ctx, ChannelService_GetChannel_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *channelServiceClient) GetMembers(ctx context.Context, in *GetChannelMembersRequest, opts ...grpc.CallOption) (*GetChannelMembersResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetChannelMembersResponse)
@@ -481,6 +754,106 @@ func (c *channelServiceClient) RemoveMember(ctx context.Context, in *RemoveChann
 	return out, nil
 }
 
+func (c *channelServiceClient) UpdateMemberRole(ctx context.Context, in *UpdateChannelMemberRoleRequest, opts ...grpc.CallOption) (*UpdateChannelMemberRoleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateChannelMemberRoleResponse)
+	err := c.cc.Invoke(ctx, ChannelService_UpdateMemberRole_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *channelServiceClient) ArchiveChannel(ctx context.Context, in *ArchiveChannelRequest, opts ...grpc.CallOption) (*ArchiveChannelResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ArchiveChannelResponse)
+	err := c.cc.Invoke(ctx, ChannelService_ArchiveChannel_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *channelServiceClient) UnarchiveChannel(ctx context.Context, in *UnarchiveChannelRequest, opts ...grpc.CallOption) (*UnarchiveChannelResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UnarchiveChannelResponse)
+	err := c.cc.Invoke(ctx, ChannelService_UnarchiveChannel_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *channelServiceClient) DeleteChannel(ctx context.Context, in *DeleteChannelRequest, opts ...grpc.CallOption) (*DeleteChannelResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteChannelResponse)
+	err := c.cc.Invoke(ctx, ChannelService_DeleteChannel_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *channelServiceClient) DiscoverChannels(ctx context.Context, in *DiscoverChannelsRequest, opts ...grpc.CallOption) (*DiscoverChannelsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DiscoverChannelsResponse)
+	err := c.cc.Invoke(ctx, ChannelService_DiscoverChannels_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *channelServiceClient) JoinChannel(ctx context.Context, in *JoinChannelRequest, opts ...grpc.CallOption) (*JoinChannelResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(JoinChannelResponse)
+	err := c.cc.Invoke(ctx, ChannelService_JoinChannel_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *channelServiceClient) RequestAccess(ctx context.Context, in *RequestChannelAccessRequest, opts ...grpc.CallOption) (*RequestChannelAccessResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RequestChannelAccessResponse)
+	err := c.cc.Invoke(ctx, ChannelService_RequestAccess_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *channelServiceClient) GetAccessRequests(ctx context.Context, in *GetChannelAccessRequestsRequest, opts ...grpc.CallOption) (*GetChannelAccessRequestsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetChannelAccessRequestsResponse)
+	err := c.cc.Invoke(ctx, ChannelService_GetAccessRequests_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *channelServiceClient) ApproveAccessRequest(ctx context.Context, in *ApproveChannelAccessRequest, opts ...grpc.CallOption) (*ApproveChannelAccessResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ApproveChannelAccessResponse)
+	err := c.cc.Invoke(ctx, ChannelService_ApproveAccessRequest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *channelServiceClient) DenyAccessRequest(ctx context.Context, in *DenyChannelAccessRequest, opts ...grpc.CallOption) (*DenyChannelAccessResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DenyChannelAccessResponse)
+	err := c.cc.Invoke(ctx, ChannelService_DenyAccessRequest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ChannelServiceServer is the server API for ChannelService service.
 // All implementations must embed UnimplementedChannelServiceServer
 // for forward compatibility.
@@ -488,9 +861,27 @@ type ChannelServiceServer interface {
 	CreateChannel(context.Context, *CreateChannelRequest) (*CreateChannelResponse, error)
 	UpdateChannel(context.Context, *UpdateChannelRequest) (*UpdateChannelResponse, error)
 	GetChannels(context.Context, *GetChannelsRequest) (*GetChannelsResponse, error)
+	// A channel the caller is a member of, archived or not
+	GetChannel(context.Context, *GetChannelRequest) (*GetChannelResponse, error)
 	GetMembers(context.Context, *GetChannelMembersRequest) (*GetChannelMembersResponse, error)
 	AddMember(context.Context, *AddChannelMemberRequest) (*AddChannelMemberResponse, error)
 	RemoveMember(context.Context, *RemoveChannelMemberRequest) (*RemoveChannelMemberResponse, error)
+	// Owner only, a channel always keeps at least one owner
+	UpdateMemberRole(context.Context, *UpdateChannelMemberRoleRequest) (*UpdateChannelMemberRoleResponse, error)
+	// Owner only. Archived channels are read-only and left out of GetChannels by default
+	ArchiveChannel(context.Context, *ArchiveChannelRequest) (*ArchiveChannelResponse, error)
+	UnarchiveChannel(context.Context, *UnarchiveChannelRequest) (*UnarchiveChannelResponse, error)
+	// Owner only. The caller decides what happens to the channel's videos
+	DeleteChannel(context.Context, *DeleteChannelRequest) (*DeleteChannelResponse, error)
+	// Channels of the tenant the caller can join or ask to join
+	DiscoverChannels(context.Context, *DiscoverChannelsRequest) (*DiscoverChannelsResponse, error)
+	// Open channels only, the caller becomes a member with the channel's default role
+	JoinChannel(context.Context, *JoinChannelRequest) (*JoinChannelResponse, error)
+	// Closed channels only, an owner approves or denies the request
+	RequestAccess(context.Context, *RequestChannelAccessRequest) (*RequestChannelAccessResponse, error)
+	GetAccessRequests(context.Context, *GetChannelAccessRequestsRequest) (*GetChannelAccessRequestsResponse, error)
+	ApproveAccessRequest(context.Context, *ApproveChannelAccessRequest) (*ApproveChannelAccessResponse, error)
+	DenyAccessRequest(context.Context, *DenyChannelAccessRequest) (*DenyChannelAccessResponse, error)
 	mustEmbedUnimplementedChannelServiceServer()
 }
 
@@ -510,6 +901,9 @@ func (UnimplementedChannelServiceServer) UpdateChannel(context.Context, *UpdateC
 func (UnimplementedChannelServiceServer) GetChannels(context.Context, *GetChannelsRequest) (*GetChannelsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetChannels not implemented")
 }
+func (UnimplementedChannelServiceServer) GetChannel(context.Context, *GetChannelRequest) (*GetChannelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetChannel not implemented")
+}
 func (UnimplementedChannelServiceServer) GetMembers(context.Context, *GetChannelMembersRequest) (*GetChannelMembersResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetMembers not implemented")
 }
@@ -519,6 +913,36 @@ func (UnimplementedChannelServiceServer) AddMember(context.Context, *AddChannelM
 func (UnimplementedChannelServiceServer) RemoveMember(context.Context, *RemoveChannelMemberRequest) (*RemoveChannelMemberResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RemoveMember not implemented")
 }
+func (UnimplementedChannelServiceServer) UpdateMemberRole(context.Context, *UpdateChannelMemberRoleRequest) (*UpdateChannelMemberRoleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateMemberRole not implemented")
+}
+func (UnimplementedChannelServiceServer) ArchiveChannel(context.Context, *ArchiveChannelRequest) (*ArchiveChannelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ArchiveChannel not implemented")
+}
+func (UnimplementedChannelServiceServer) UnarchiveChannel(context.Context, *UnarchiveChannelRequest) (*UnarchiveChannelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnarchiveChannel not implemented")
+}
+func (UnimplementedChannelServiceServer) DeleteChannel(context.Context, *DeleteChannelRequest) (*DeleteChannelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteChannel not implemented")
+}
+func (UnimplementedChannelServiceServer) DiscoverChannels(context.Context, *DiscoverChannelsRequest) (*DiscoverChannelsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DiscoverChannels not implemented")
+}
+func (UnimplementedChannelServiceServer) JoinChannel(context.Context, *JoinChannelRequest) (*JoinChannelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method JoinChannel not implemented")
+}
+func (UnimplementedChannelServiceServer) RequestAccess(context.Context, *RequestChannelAccessRequest) (*RequestChannelAccessResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestAccess not implemented")
+}
+func (UnimplementedChannelServiceServer) GetAccessRequests(context.Context, *GetChannelAccessRequestsRequest) (*GetChannelAccessRequestsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAccessRequests not implemented")
+}
+func (UnimplementedChannelServiceServer) ApproveAccessRequest(context.Context, *ApproveChannelAccessRequest) (*ApproveChannelAccessResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveAccessRequest not implemented")
+}
+func (UnimplementedChannelServiceServer) DenyAccessRequest(context.Context, *DenyChannelAccessRequest) (*DenyChannelAccessResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DenyAccessRequest not implemented")
+}
 func (UnimplementedChannelServiceServer) mustEmbedUnimplementedChannelServiceServer() {}
 func (UnimplementedChannelServiceServer) testEmbeddedByValue()                        {}
 
@@ -594,6 +1018,24 @@ func _ChannelService_GetChannels_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ChannelService_GetChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetChannelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChannelServiceServer).GetChannel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChannelService_GetChannel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChannelServiceServer).GetChannel(ctx, req.(*GetChannelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ChannelService_GetMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetChannelMembersRequest)
 	if err := dec(in); err != nil {
@@ -648,6 +1090,186 @@ func _ChannelService_RemoveMember_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ChannelService_UpdateMemberRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateChannelMemberRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChannelServiceServer).UpdateMemberRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChannelService_UpdateMemberRole_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChannelServiceServer).UpdateMemberRole(ctx, req.(*UpdateChannelMemberRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChannelService_ArchiveChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ArchiveChannelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChannelServiceServer).ArchiveChannel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChannelService_ArchiveChannel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChannelServiceServer).ArchiveChannel(ctx, req.(*ArchiveChannelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChannelService_UnarchiveChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnarchiveChannelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChannelServiceServer).UnarchiveChannel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChannelService_UnarchiveChannel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChannelServiceServer).UnarchiveChannel(ctx, req.(*UnarchiveChannelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChannelService_DeleteChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteChannelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChannelServiceServer).DeleteChannel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChannelService_DeleteChannel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChannelServiceServer).DeleteChannel(ctx, req.(*DeleteChannelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChannelService_DiscoverChannels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiscoverChannelsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChannelServiceServer).DiscoverChannels(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChannelService_DiscoverChannels_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChannelServiceServer).DiscoverChannels(ctx, req.(*DiscoverChannelsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChannelService_JoinChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JoinChannelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChannelServiceServer).JoinChannel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChannelService_JoinChannel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChannelServiceServer).JoinChannel(ctx, req.(*JoinChannelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChannelService_RequestAccess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestChannelAccessRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChannelServiceServer).RequestAccess(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChannelService_RequestAccess_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChannelServiceServer).RequestAccess(ctx, req.(*RequestChannelAccessRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChannelService_GetAccessRequests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetChannelAccessRequestsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChannelServiceServer).GetAccessRequests(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChannelService_GetAccessRequests_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChannelServiceServer).GetAccessRequests(ctx, req.(*GetChannelAccessRequestsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChannelService_ApproveAccessRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApproveChannelAccessRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChannelServiceServer).ApproveAccessRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChannelService_ApproveAccessRequest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChannelServiceServer).ApproveAccessRequest(ctx, req.(*ApproveChannelAccessRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChannelService_DenyAccessRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DenyChannelAccessRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChannelServiceServer).DenyAccessRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChannelService_DenyAccessRequest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChannelServiceServer).DenyAccessRequest(ctx, req.(*DenyChannelAccessRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ChannelService_ServiceDesc is the grpc.ServiceDesc for ChannelService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -667,6 +1289,10 @@ var ChannelService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetChannels",
 			Handler:    _ChannelService_GetChannels_Handler,
 		},
+		{
+			MethodName: "GetChannel",
+			Handler:    _ChannelService_GetChannel_Handler,
+		},
 		{
 			MethodName: "GetMembers",
 			Handler:    _ChannelService_GetMembers_Handler,
@@ -679,6 +1305,196 @@ var ChannelService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RemoveMember",
 			Handler:    _ChannelService_RemoveMember_Handler,
 		},
+		{
+			MethodName: "UpdateMemberRole",
+			Handler:    _ChannelService_UpdateMemberRole_Handler,
+		},
+		{
+			MethodName: "ArchiveChannel",
+			Handler:    _ChannelService_ArchiveChannel_Handler,
+		},
+		{
+			MethodName: "UnarchiveChannel",
+			Handler:    _ChannelService_UnarchiveChannel_Handler,
+		},
+		{
+			MethodName: "DeleteChannel",
+			Handler:    _ChannelService_DeleteChannel_Handler,
+		},
+		{
+			MethodName: "DiscoverChannels",
+			Handler:    _ChannelService_DiscoverChannels_Handler,
+		},
+		{
+			MethodName: "JoinChannel",
+			Handler:    _ChannelService_JoinChannel_Handler,
+		},
+		{
+			MethodName: "RequestAccess",
+			Handler:    _ChannelService_RequestAccess_Handler,
+		},
+		{
+			MethodName: "GetAccessRequests",
+			Handler:    _ChannelService_GetAccessRequests_Handler,
+		},
+		{
+			MethodName: "ApproveAccessRequest",
+			Handler:    _ChannelService_ApproveAccessRequest_Handler,
+		},
+		{
+			MethodName: "DenyAccessRequest",
+			Handler:    _ChannelService_DenyAccessRequest_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "videoservice.proto",
+}
+
+const (
+	TenantCleanupService_RemoveTenantMember_FullMethodName = "/videoservice.TenantCleanupService/RemoveTenantMember"
+	TenantCleanupService_DeleteTenantData_FullMethodName   = "/videoservice.TenantCleanupService/DeleteTenantData"
+)
+
+// TenantCleanupServiceClient is the client API for TenantCleanupService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Used by userservice to keep channels consistent with tenant membership.
+// Internal only, it is not registered on the public gRPC server.
+type TenantCleanupServiceClient interface {
+	// Removes a user who left a tenant from all of its channels
+	RemoveTenantMember(ctx context.Context, in *RemoveTenantMemberRequest, opts ...grpc.CallOption) (*RemoveTenantMemberResponse, error)
+	// Deletes the channels, videos and video files of a deleted tenant
+	DeleteTenantData(ctx context.Context, in *DeleteTenantDataRequest, opts ...grpc.CallOption) (*DeleteTenantDataResponse, error)
+}
+
+type tenantCleanupServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewTenantCleanupServiceClient(cc grpc.ClientConnInterface) TenantCleanupServiceClient {
+	return &tenantCleanupServiceClient{cc}
+}
+
+func (c *tenantCleanupServiceClient) RemoveTenantMember(ctx context.Context, in *RemoveTenantMemberRequest, opts ...grpc.CallOption) (*RemoveTenantMemberResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemoveTenantMemberResponse)
+	err := c.cc.Invoke(ctx, TenantCleanupService_RemoveTenantMember_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tenantCleanupServiceClient) DeleteTenantData(ctx context.Context, in *DeleteTenantDataRequest, opts ...grpc.CallOption) (*DeleteTenantDataResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteTenantDataResponse)
+	err := c.cc.Invoke(ctx, TenantCleanupService_DeleteTenantData_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// TenantCleanupServiceServer is the server API for TenantCleanupService service.
+// All implementations must embed UnimplementedTenantCleanupServiceServer
+// for forward compatibility.
+//
+// Used by userservice to keep channels consistent with tenant membership.
+// Internal only, it is not registered on the public gRPC server.
+type TenantCleanupServiceServer interface {
+	// Removes a user who left a tenant from all of its channels
+	RemoveTenantMember(context.Context, *RemoveTenantMemberRequest) (*RemoveTenantMemberResponse, error)
+	// Deletes the channels, videos and video files of a deleted tenant
+	DeleteTenantData(context.Context, *DeleteTenantDataRequest) (*DeleteTenantDataResponse, error)
+	mustEmbedUnimplementedTenantCleanupServiceServer()
+}
+
+// UnimplementedTenantCleanupServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedTenantCleanupServiceServer struct{}
+
+func (UnimplementedTenantCleanupServiceServer) RemoveTenantMember(context.Context, *RemoveTenantMemberRequest) (*RemoveTenantMemberResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveTenantMember not implemented")
+}
+func (UnimplementedTenantCleanupServiceServer) DeleteTenantData(context.Context, *DeleteTenantDataRequest) (*DeleteTenantDataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteTenantData not implemented")
+}
+func (UnimplementedTenantCleanupServiceServer) mustEmbedUnimplementedTenantCleanupServiceServer() {}
+func (UnimplementedTenantCleanupServiceServer) testEmbeddedByValue()                              {}
+
+// UnsafeTenantCleanupServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to TenantCleanupServiceServer will
+// result in compilation errors.
+type UnsafeTenantCleanupServiceServer interface {
+	mustEmbedUnimplementedTenantCleanupServiceServer()
+}
+
+func RegisterTenantCleanupServiceServer(s grpc.ServiceRegistrar, srv TenantCleanupServiceServer) {
+	// If the following call pancis, it indicates UnimplementedTenantCleanupServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&TenantCleanupService_ServiceDesc, srv)
+}
+
+func _TenantCleanupService_RemoveTenantMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveTenantMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TenantCleanupServiceServer).RemoveTenantMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TenantCleanupService_RemoveTenantMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TenantCleanupServiceServer).RemoveTenantMember(ctx, req.(*RemoveTenantMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TenantCleanupService_DeleteTenantData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTenantDataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TenantCleanupServiceServer).DeleteTenantData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TenantCleanupService_DeleteTenantData_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TenantCleanupServiceServer).DeleteTenantData(ctx, req.(*DeleteTenantDataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// TenantCleanupService_ServiceDesc is the grpc.ServiceDesc for TenantCleanupService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var TenantCleanupService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "videoservice.TenantCleanupService",
+	HandlerType: (*TenantCleanupServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "RemoveTenantMember",
+			Handler:    _TenantCleanupService_RemoveTenantMember_Handler,
+		},
+		{
+			MethodName: "DeleteTenantData",
+			Handler:    _TenantCleanupService_DeleteTenantData_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "videoservice.proto",
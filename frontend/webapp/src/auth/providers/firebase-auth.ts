@@ -53,6 +53,11 @@ export const startUi = (elementId: string, onSuccess: (result: AuthResult) => vo
 
 export const logout = async (): Promise<boolean> => {
   try {
+    // End the server session behind the session cookie, video playback uses it
+    await fetch(`${import.meta.env.VITE_PUBLIC_API_URL.replace(/\/$/, "")}/api/auth/logout`, {
+      method: 'POST',
+      credentials: 'include',
+    }).catch((error) => console.error('Error ending session:', error))
     await auth.signOut()
     return true
   } catch (error) {
@@ -8,9 +8,14 @@ import * as dependency_1 from "./google/protobuf/timestamp";
 import * as pb_1 from "google-protobuf";
 import * as grpc_1 from "grpc-web";
 import * as grpc_web_1 from "grpc-web";
+export enum CommentSortOrder {
+    COMMENT_SORT_NEWEST = 0,
+    COMMENT_SORT_OLDEST = 1,
+    COMMENT_SORT_TIMESTAMP = 2
+}
 export class Comment extends pb_1.Message {
-    #one_of_decls: number[][] = [];
-    constructor(data?: any[] | {
+    #one_of_decls: number[][] = [[10]];
+    constructor(data?: any[] | ({
         id?: string;
         content?: string;
         video_id?: string;
@@ -20,9 +25,15 @@ export class Comment extends pb_1.Message {
         updated_at?: dependency_1.Timestamp;
         parent_comment_id?: string;
         replies?: Comment[];
-    }) {
+        like_count?: number;
+        liked_by_me?: boolean;
+        is_deleted?: boolean;
+        mentions?: Mention[];
+    } & (({
+        timestamp_seconds?: number;
+    })))) {
         super();
-        pb_1.Message.initialize(this, Array.isArray(data) ? data : [], 0, -1, [9], this.#one_of_decls);
+        pb_1.Message.initialize(this, Array.isArray(data) ? data : [], 0, -1, [9, 14], this.#one_of_decls);
         if (!Array.isArray(data) && typeof data == "object") {
             if ("id" in data && data.id != undefined) {
                 this.id = data.id;
@@ -51,6 +62,21 @@ export class Comment extends pb_1.Message {
             if ("replies" in data && data.replies != undefined) {
                 this.replies = data.replies;
             }
+            if ("timestamp_seconds" in data && data.timestamp_seconds != undefined) {
+                this.timestamp_seconds = data.timestamp_seconds;
+            }
+            if ("like_count" in data && data.like_count != undefined) {
+                this.like_count = data.like_count;
+            }
+            if ("liked_by_me" in data && data.liked_by_me != undefined) {
+                this.liked_by_me = data.liked_by_me;
+            }
+            if ("is_deleted" in data && data.is_deleted != undefined) {
+                this.is_deleted = data.is_deleted;
+            }
+            if ("mentions" in data && data.mentions != undefined) {
+                this.mentions = data.mentions;
+            }
         }
     }
     get id() {
@@ -113,6 +139,48 @@ export class Comment extends pb_1.Message {
     set replies(value: Comment[]) {
         pb_1.Message.setRepeatedWrapperField(this, 9, value);
     }
+    get timestamp_seconds() {
+        return pb_1.Message.getFieldWithDefault(this, 10, 0) as number;
+    }
+    set timestamp_seconds(value: number) {
+        pb_1.Message.setOneofField(this, 10, this.#one_of_decls[0], value);
+    }
+    get has_timestamp_seconds() {
+        return pb_1.Message.getField(this, 10) != null;
+    }
+    get like_count() {
+        return pb_1.Message.getFieldWithDefault(this, 11, 0) as number;
+    }
+    set like_count(value: number) {
+        pb_1.Message.setField(this, 11, value);
+    }
+    get liked_by_me() {
+        return pb_1.Message.getFieldWithDefault(this, 12, false) as boolean;
+    }
+    set liked_by_me(value: boolean) {
+        pb_1.Message.setField(this, 12, value);
+    }
+    get is_deleted() {
+        return pb_1.Message.getFieldWithDefault(this, 13, false) as boolean;
+    }
+    set is_deleted(value: boolean) {
+        pb_1.Message.setField(this, 13, value);
+    }
+    get mentions() {
+        return pb_1.Message.getRepeatedWrapperField(this, Mention, 14) as Mention[];
+    }
+    set mentions(value: Mention[]) {
+        pb_1.Message.setRepeatedWrapperField(this, 14, value);
+    }
+    get _timestamp_seconds() {
+        const cases: {
+            [index: number]: "none" | "timestamp_seconds";
+        } = {
+            0: "none",
+            10: "timestamp_seconds"
+        };
+        return cases[pb_1.Message.computeOneofCase(this, [10])];
+    }
     static fromObject(data: {
         id?: string;
         content?: string;
@@ -123,6 +191,11 @@ export class Comment extends pb_1.Message {
         updated_at?: ReturnType<typeof dependency_1.Timestamp.prototype.toObject>;
         parent_comment_id?: string;
         replies?: ReturnType<typeof Comment.prototype.toObject>[];
+        timestamp_seconds?: number;
+        like_count?: number;
+        liked_by_me?: boolean;
+        is_deleted?: boolean;
+        mentions?: ReturnType<typeof Mention.prototype.toObject>[];
     }): Comment {
         const message = new Comment({});
         if (data.id != null) {
@@ -152,6 +225,21 @@ export class Comment extends pb_1.Message {
         if (data.replies != null) {
             message.replies = data.replies.map(item => Comment.fromObject(item));
         }
+        if (data.timestamp_seconds != null) {
+            message.timestamp_seconds = data.timestamp_seconds;
+        }
+        if (data.like_count != null) {
+            message.like_count = data.like_count;
+        }
+        if (data.liked_by_me != null) {
+            message.liked_by_me = data.liked_by_me;
+        }
+        if (data.is_deleted != null) {
+            message.is_deleted = data.is_deleted;
+        }
+        if (data.mentions != null) {
+            message.mentions = data.mentions.map(item => Mention.fromObject(item));
+        }
         return message;
     }
     toObject() {
@@ -165,6 +253,11 @@ export class Comment extends pb_1.Message {
             updated_at?: ReturnType<typeof dependency_1.Timestamp.prototype.toObject>;
             parent_comment_id?: string;
             replies?: ReturnType<typeof Comment.prototype.toObject>[];
+            timestamp_seconds?: number;
+            like_count?: number;
+            liked_by_me?: boolean;
+            is_deleted?: boolean;
+            mentions?: ReturnType<typeof Mention.prototype.toObject>[];
         } = {};
         if (this.id != null) {
             data.id = this.id;
@@ -193,6 +286,21 @@ export class Comment extends pb_1.Message {
         if (this.replies != null) {
             data.replies = this.replies.map((item: Comment) => item.toObject());
         }
+        if (this.timestamp_seconds != null) {
+            data.timestamp_seconds = this.timestamp_seconds;
+        }
+        if (this.like_count != null) {
+            data.like_count = this.like_count;
+        }
+        if (this.liked_by_me != null) {
+            data.liked_by_me = this.liked_by_me;
+        }
+        if (this.is_deleted != null) {
+            data.is_deleted = this.is_deleted;
+        }
+        if (this.mentions != null) {
+            data.mentions = this.mentions.map((item: Mention) => item.toObject());
+        }
         return data;
     }
     serialize(): Uint8Array;
@@ -217,6 +325,16 @@ export class Comment extends pb_1.Message {
             writer.writeString(8, this.parent_comment_id);
         if (this.replies.length)
             writer.writeRepeatedMessage(9, this.replies, (item: Comment) => item.serialize(writer));
+        if (this.has_timestamp_seconds)
+            writer.writeDouble(10, this.timestamp_seconds);
+        if (this.like_count != 0)
+            writer.writeInt32(11, this.like_count);
+        if (this.liked_by_me != false)
+            writer.writeBool(12, this.liked_by_me);
+        if (this.is_deleted != false)
+            writer.writeBool(13, this.is_deleted);
+        if (this.mentions.length)
+            writer.writeRepeatedMessage(14, this.mentions, (item: Mention) => item.serialize(writer));
         if (!w)
             return writer.getResultBuffer();
     }
@@ -253,6 +371,21 @@ export class Comment extends pb_1.Message {
                 case 9:
                     reader.readMessage(message.replies, () => pb_1.Message.addToRepeatedWrapperField(message, 9, Comment.deserialize(reader), Comment));
                     break;
+                case 10:
+                    message.timestamp_seconds = reader.readDouble();
+                    break;
+                case 11:
+                    message.like_count = reader.readInt32();
+                    break;
+                case 12:
+                    message.liked_by_me = reader.readBool();
+                    break;
+                case 13:
+                    message.is_deleted = reader.readBool();
+                    break;
+                case 14:
+                    reader.readMessage(message.mentions, () => pb_1.Message.addToRepeatedWrapperField(message, 14, Mention.deserialize(reader), Mention));
+                    break;
                 default: reader.skipField();
             }
         }
@@ -265,6 +398,142 @@ export class Comment extends pb_1.Message {
         return Comment.deserialize(bytes);
     }
 }
+export class Mention extends pb_1.Message {
+    #one_of_decls: number[][] = [];
+    constructor(data?: any[] | {
+        user_id?: string;
+        username?: string;
+        start?: number;
+        end?: number;
+    }) {
+        super();
+        pb_1.Message.initialize(this, Array.isArray(data) ? data : [], 0, -1, [], this.#one_of_decls);
+        if (!Array.isArray(data) && typeof data == "object") {
+            if ("user_id" in data && data.user_id != undefined) {
+                this.user_id = data.user_id;
+            }
+            if ("username" in data && data.username != undefined) {
+                this.username = data.username;
+            }
+            if ("start" in data && data.start != undefined) {
+                this.start = data.start;
+            }
+            if ("end" in data && data.end != undefined) {
+                this.end = data.end;
+            }
+        }
+    }
+    get user_id() {
+        return pb_1.Message.getFieldWithDefault(this, 1, "") as string;
+    }
+    set user_id(value: string) {
+        pb_1.Message.setField(this, 1, value);
+    }
+    get username() {
+        return pb_1.Message.getFieldWithDefault(this, 2, "") as string;
+    }
+    set username(value: string) {
+        pb_1.Message.setField(this, 2, value);
+    }
+    get start() {
+        return pb_1.Message.getFieldWithDefault(this, 3, 0) as number;
+    }
+    set start(value: number) {
+        pb_1.Message.setField(this, 3, value);
+    }
+    get end() {
+        return pb_1.Message.getFieldWithDefault(this, 4, 0) as number;
+    }
+    set end(value: number) {
+        pb_1.Message.setField(this, 4, value);
+    }
+    static fromObject(data: {
+        user_id?: string;
+        username?: string;
+        start?: number;
+        end?: number;
+    }): Mention {
+        const message = new Mention({});
+        if (data.user_id != null) {
+            message.user_id = data.user_id;
+        }
+        if (data.username != null) {
+            message.username = data.username;
+        }
+        if (data.start != null) {
+            message.start = data.start;
+        }
+        if (data.end != null) {
+            message.end = data.end;
+        }
+        return message;
+    }
+    toObject() {
+        const data: {
+            user_id?: string;
+            username?: string;
+            start?: number;
+            end?: number;
+        } = {};
+        if (this.user_id != null) {
+            data.user_id = this.user_id;
+        }
+        if (this.username != null) {
+            data.username = this.username;
+        }
+        if (this.start != null) {
+            data.start = this.start;
+        }
+        if (this.end != null) {
+            data.end = this.end;
+        }
+        return data;
+    }
+    serialize(): Uint8Array;
+    serialize(w: pb_1.BinaryWriter): void;
+    serialize(w?: pb_1.BinaryWriter): Uint8Array | void {
+        const writer = w || new pb_1.BinaryWriter();
+        if (this.user_id.length)
+            writer.writeString(1, this.user_id);
+        if (this.username.length)
+            writer.writeString(2, this.username);
+        if (this.start != 0)
+            writer.writeInt32(3, this.start);
+        if (this.end != 0)
+            writer.writeInt32(4, this.end);
+        if (!w)
+            return writer.getResultBuffer();
+    }
+    static deserialize(bytes: Uint8Array | pb_1.BinaryReader): Mention {
+        const reader = bytes instanceof pb_1.BinaryReader ? bytes : new pb_1.BinaryReader(bytes), message = new Mention();
+        while (reader.nextField()) {
+            if (reader.isEndGroup())
+                break;
+            switch (reader.getFieldNumber()) {
+                case 1:
+                    message.user_id = reader.readString();
+                    break;
+                case 2:
+                    message.username = reader.readString();
+                    break;
+                case 3:
+                    message.start = reader.readInt32();
+                    break;
+                case 4:
+                    message.end = reader.readInt32();
+                    break;
+                default: reader.skipField();
+            }
+        }
+        return message;
+    }
+    serializeBinary(): Uint8Array {
+        return this.serialize();
+    }
+    static deserializeBinary(bytes: Uint8Array): Mention {
+        return Mention.deserialize(bytes);
+    }
+}
 export class Reply extends pb_1.Message {
     #one_of_decls: number[][] = [];
     constructor(data?: any[] | {
@@ -275,9 +544,10 @@ export class Reply extends pb_1.Message {
         username?: string;
         created_at?: dependency_1.Timestamp;
         updated_at?: dependency_1.Timestamp;
+        mentions?: Mention[];
     }) {
         super();
-        pb_1.Message.initialize(this, Array.isArray(data) ? data : [], 0, -1, [], this.#one_of_decls);
+        pb_1.Message.initialize(this, Array.isArray(data) ? data : [], 0, -1, [8], this.#one_of_decls);
         if (!Array.isArray(data) && typeof data == "object") {
             if ("id" in data && data.id != undefined) {
                 this.id = data.id;
@@ -300,6 +570,9 @@ export class Reply extends pb_1.Message {
             if ("updated_at" in data && data.updated_at != undefined) {
                 this.updated_at = data.updated_at;
             }
+            if ("mentions" in data && data.mentions != undefined) {
+                this.mentions = data.mentions;
+            }
         }
     }
     get id() {
@@ -350,6 +623,12 @@ export class Reply extends pb_1.Message {
     get has_updated_at() {
         return pb_1.Message.getField(this, 7) != null;
     }
+    get mentions() {
+        return pb_1.Message.getRepeatedWrapperField(this, Mention, 8) as Mention[];
+    }
+    set mentions(value: Mention[]) {
+        pb_1.Message.setRepeatedWrapperField(this, 8, value);
+    }
     static fromObject(data: {
         id?: string;
         content?: string;
@@ -358,6 +637,7 @@ export class Reply extends pb_1.Message {
         username?: string;
         created_at?: ReturnType<typeof dependency_1.Timestamp.prototype.toObject>;
         updated_at?: ReturnType<typeof dependency_1.Timestamp.prototype.toObject>;
+        mentions?: ReturnType<typeof Mention.prototype.toObject>[];
     }): Reply {
         const message = new Reply({});
         if (data.id != null) {
@@ -381,6 +661,9 @@ export class Reply extends pb_1.Message {
         if (data.updated_at != null) {
             message.updated_at = dependency_1.Timestamp.fromObject(data.updated_at);
         }
+        if (data.mentions != null) {
+            message.mentions = data.mentions.map(item => Mention.fromObject(item));
+        }
         return message;
     }
     toObject() {
@@ -392,6 +675,7 @@ export class Reply extends pb_1.Message {
             username?: string;
             created_at?: ReturnType<typeof dependency_1.Timestamp.prototype.toObject>;
             updated_at?: ReturnType<typeof dependency_1.Timestamp.prototype.toObject>;
+            mentions?: ReturnType<typeof Mention.prototype.toObject>[];
         } = {};
         if (this.id != null) {
             data.id = this.id;
@@ -414,6 +698,9 @@ export class Reply extends pb_1.Message {
         if (this.updated_at != null) {
             data.updated_at = this.updated_at.toObject();
         }
+        if (this.mentions != null) {
+            data.mentions = this.mentions.map((item: Mention) => item.toObject());
+        }
         return data;
     }
     serialize(): Uint8Array;
@@ -434,6 +721,8 @@ export class Reply extends pb_1.Message {
             writer.writeMessage(6, this.created_at, () => this.created_at.serialize(writer));
         if (this.has_updated_at)
             writer.writeMessage(7, this.updated_at, () => this.updated_at.serialize(writer));
+        if (this.mentions.length)
+            writer.writeRepeatedMessage(8, this.mentions, (item: Mention) => item.serialize(writer));
         if (!w)
             return writer.getResultBuffer();
     }
@@ -464,6 +753,9 @@ export class Reply extends pb_1.Message {
                 case 7:
                     reader.readMessage(message.updated_at, () => message.updated_at = dependency_1.Timestamp.deserialize(reader));
                     break;
+                case 8:
+                    reader.readMessage(message.mentions, () => pb_1.Message.addToRepeatedWrapperField(message, 8, Mention.deserialize(reader), Mention));
+                    break;
                 default: reader.skipField();
             }
         }
@@ -477,12 +769,14 @@ export class Reply extends pb_1.Message {
     }
 }
 export class CreateCommentRequest extends pb_1.Message {
-    #one_of_decls: number[][] = [[3]];
+    #one_of_decls: number[][] = [[3], [4]];
     constructor(data?: any[] | ({
         content?: string;
         video_id?: string;
     } & (({
         parent_comment_id?: string;
+    })) & (({
+        timestamp_seconds?: number;
     })))) {
         super();
         pb_1.Message.initialize(this, Array.isArray(data) ? data : [], 0, -1, [], this.#one_of_decls);
@@ -496,6 +790,9 @@ export class CreateCommentRequest extends pb_1.Message {
             if ("parent_comment_id" in data && data.parent_comment_id != undefined) {
                 this.parent_comment_id = data.parent_comment_id;
             }
+            if ("timestamp_seconds" in data && data.timestamp_seconds != undefined) {
+                this.timestamp_seconds = data.timestamp_seconds;
+            }
         }
     }
     get content() {
@@ -519,6 +816,15 @@ export class CreateCommentRequest extends pb_1.Message {
     get has_parent_comment_id() {
         return pb_1.Message.getField(this, 3) != null;
     }
+    get timestamp_seconds() {
+        return pb_1.Message.getFieldWithDefault(this, 4, 0) as number;
+    }
+    set timestamp_seconds(value: number) {
+        pb_1.Message.setOneofField(this, 4, this.#one_of_decls[1], value);
+    }
+    get has_timestamp_seconds() {
+        return pb_1.Message.getField(this, 4) != null;
+    }
     get _parent_comment_id() {
         const cases: {
             [index: number]: "none" | "parent_comment_id";
@@ -528,10 +834,20 @@ export class CreateCommentRequest extends pb_1.Message {
         };
         return cases[pb_1.Message.computeOneofCase(this, [3])];
     }
+    get _timestamp_seconds() {
+        const cases: {
+            [index: number]: "none" | "timestamp_seconds";
+        } = {
+            0: "none",
+            4: "timestamp_seconds"
+        };
+        return cases[pb_1.Message.computeOneofCase(this, [4])];
+    }
     static fromObject(data: {
         content?: string;
         video_id?: string;
         parent_comment_id?: string;
+        timestamp_seconds?: number;
     }): CreateCommentRequest {
         const message = new CreateCommentRequest({});
         if (data.content != null) {
@@ -543,6 +859,9 @@ export class CreateCommentRequest extends pb_1.Message {
         if (data.parent_comment_id != null) {
             message.parent_comment_id = data.parent_comment_id;
         }
+        if (data.timestamp_seconds != null) {
+            message.timestamp_seconds = data.timestamp_seconds;
+        }
         return message;
     }
     toObject() {
@@ -550,6 +869,7 @@ export class CreateCommentRequest extends pb_1.Message {
             content?: string;
             video_id?: string;
             parent_comment_id?: string;
+            timestamp_seconds?: number;
         } = {};
         if (this.content != null) {
             data.content = this.content;
@@ -560,6 +880,9 @@ export class CreateCommentRequest extends pb_1.Message {
         if (this.parent_comment_id != null) {
             data.parent_comment_id = this.parent_comment_id;
         }
+        if (this.timestamp_seconds != null) {
+            data.timestamp_seconds = this.timestamp_seconds;
+        }
         return data;
     }
     serialize(): Uint8Array;
@@ -572,6 +895,8 @@ export class CreateCommentRequest extends pb_1.Message {
             writer.writeString(2, this.video_id);
         if (this.has_parent_comment_id)
             writer.writeString(3, this.parent_comment_id);
+        if (this.has_timestamp_seconds)
+            writer.writeDouble(4, this.timestamp_seconds);
         if (!w)
             return writer.getResultBuffer();
     }
@@ -590,6 +915,9 @@ export class CreateCommentRequest extends pb_1.Message {
                 case 3:
                     message.parent_comment_id = reader.readString();
                     break;
+                case 4:
+                    message.timestamp_seconds = reader.readDouble();
+                    break;
                 default: reader.skipField();
             }
         }
@@ -740,12 +1068,19 @@ export class GetCommentResponse extends pb_1.Message {
     }
 }
 export class ListCommentsRequest extends pb_1.Message {
-    #one_of_decls: number[][] = [];
-    constructor(data?: any[] | {
+    #one_of_decls: number[][] = [[5], [6]];
+    constructor(data?: any[] | ({
         video_id?: string;
         page_size?: number;
+        /** @deprecated*/
         page_number?: number;
-    }) {
+        sort_by?: CommentSortOrder;
+        page_token?: string;
+    } & (({
+        from_seconds?: number;
+    })) & (({
+        to_seconds?: number;
+    })))) {
         super();
         pb_1.Message.initialize(this, Array.isArray(data) ? data : [], 0, -1, [], this.#one_of_decls);
         if (!Array.isArray(data) && typeof data == "object") {
@@ -758,6 +1093,18 @@ export class ListCommentsRequest extends pb_1.Message {
             if ("page_number" in data && data.page_number != undefined) {
                 this.page_number = data.page_number;
             }
+            if ("sort_by" in data && data.sort_by != undefined) {
+                this.sort_by = data.sort_by;
+            }
+            if ("from_seconds" in data && data.from_seconds != undefined) {
+                this.from_seconds = data.from_seconds;
+            }
+            if ("to_seconds" in data && data.to_seconds != undefined) {
+                this.to_seconds = data.to_seconds;
+            }
+            if ("page_token" in data && data.page_token != undefined) {
+                this.page_token = data.page_token;
+            }
         }
     }
     get video_id() {
@@ -772,16 +1119,70 @@ export class ListCommentsRequest extends pb_1.Message {
     set page_size(value: number) {
         pb_1.Message.setField(this, 2, value);
     }
+    /** @deprecated*/
     get page_number() {
         return pb_1.Message.getFieldWithDefault(this, 3, 0) as number;
     }
     set page_number(value: number) {
         pb_1.Message.setField(this, 3, value);
     }
+    get sort_by() {
+        return pb_1.Message.getFieldWithDefault(this, 4, CommentSortOrder.COMMENT_SORT_NEWEST) as CommentSortOrder;
+    }
+    set sort_by(value: CommentSortOrder) {
+        pb_1.Message.setField(this, 4, value);
+    }
+    get from_seconds() {
+        return pb_1.Message.getFieldWithDefault(this, 5, 0) as number;
+    }
+    set from_seconds(value: number) {
+        pb_1.Message.setOneofField(this, 5, this.#one_of_decls[0], value);
+    }
+    get has_from_seconds() {
+        return pb_1.Message.getField(this, 5) != null;
+    }
+    get to_seconds() {
+        return pb_1.Message.getFieldWithDefault(this, 6, 0) as number;
+    }
+    set to_seconds(value: number) {
+        pb_1.Message.setOneofField(this, 6, this.#one_of_decls[1], value);
+    }
+    get has_to_seconds() {
+        return pb_1.Message.getField(this, 6) != null;
+    }
+    get page_token() {
+        return pb_1.Message.getFieldWithDefault(this, 7, "") as string;
+    }
+    set page_token(value: string) {
+        pb_1.Message.setField(this, 7, value);
+    }
+    get _from_seconds() {
+        const cases: {
+            [index: number]: "none" | "from_seconds";
+        } = {
+            0: "none",
+            5: "from_seconds"
+        };
+        return cases[pb_1.Message.computeOneofCase(this, [5])];
+    }
+    get _to_seconds() {
+        const cases: {
+            [index: number]: "none" | "to_seconds";
+        } = {
+            0: "none",
+            6: "to_seconds"
+        };
+        return cases[pb_1.Message.computeOneofCase(this, [6])];
+    }
     static fromObject(data: {
         video_id?: string;
         page_size?: number;
+        /** @deprecated*/
         page_number?: number;
+        sort_by?: CommentSortOrder;
+        from_seconds?: number;
+        to_seconds?: number;
+        page_token?: string;
     }): ListCommentsRequest {
         const message = new ListCommentsRequest({});
         if (data.video_id != null) {
@@ -793,13 +1194,30 @@ export class ListCommentsRequest extends pb_1.Message {
         if (data.page_number != null) {
             message.page_number = data.page_number;
         }
+        if (data.sort_by != null) {
+            message.sort_by = data.sort_by;
+        }
+        if (data.from_seconds != null) {
+            message.from_seconds = data.from_seconds;
+        }
+        if (data.to_seconds != null) {
+            message.to_seconds = data.to_seconds;
+        }
+        if (data.page_token != null) {
+            message.page_token = data.page_token;
+        }
         return message;
     }
     toObject() {
         const data: {
             video_id?: string;
             page_size?: number;
+            /** @deprecated*/
             page_number?: number;
+            sort_by?: CommentSortOrder;
+            from_seconds?: number;
+            to_seconds?: number;
+            page_token?: string;
         } = {};
         if (this.video_id != null) {
             data.video_id = this.video_id;
@@ -810,6 +1228,18 @@ export class ListCommentsRequest extends pb_1.Message {
         if (this.page_number != null) {
             data.page_number = this.page_number;
         }
+        if (this.sort_by != null) {
+            data.sort_by = this.sort_by;
+        }
+        if (this.from_seconds != null) {
+            data.from_seconds = this.from_seconds;
+        }
+        if (this.to_seconds != null) {
+            data.to_seconds = this.to_seconds;
+        }
+        if (this.page_token != null) {
+            data.page_token = this.page_token;
+        }
         return data;
     }
     serialize(): Uint8Array;
@@ -822,6 +1252,14 @@ export class ListCommentsRequest extends pb_1.Message {
             writer.writeInt32(2, this.page_size);
         if (this.page_number != 0)
             writer.writeInt32(3, this.page_number);
+        if (this.sort_by != CommentSortOrder.COMMENT_SORT_NEWEST)
+            writer.writeEnum(4, this.sort_by);
+        if (this.has_from_seconds)
+            writer.writeDouble(5, this.from_seconds);
+        if (this.has_to_seconds)
+            writer.writeDouble(6, this.to_seconds);
+        if (this.page_token.length)
+            writer.writeString(7, this.page_token);
         if (!w)
             return writer.getResultBuffer();
     }
@@ -840,6 +1278,18 @@ export class ListCommentsRequest extends pb_1.Message {
                 case 3:
                     message.page_number = reader.readInt32();
                     break;
+                case 4:
+                    message.sort_by = reader.readEnum();
+                    break;
+                case 5:
+                    message.from_seconds = reader.readDouble();
+                    break;
+                case 6:
+                    message.to_seconds = reader.readDouble();
+                    break;
+                case 7:
+                    message.page_token = reader.readString();
+                    break;
                 default: reader.skipField();
             }
         }
@@ -856,7 +1306,10 @@ export class ListCommentsResponse extends pb_1.Message {
     #one_of_decls: number[][] = [];
     constructor(data?: any[] | {
         comments?: Comment[];
+        /** @deprecated*/
         next_page_number?: number;
+        next_page_token?: string;
+        total_count?: number;
     }) {
         super();
         pb_1.Message.initialize(this, Array.isArray(data) ? data : [], 0, -1, [1], this.#one_of_decls);
@@ -867,6 +1320,12 @@ export class ListCommentsResponse extends pb_1.Message {
             if ("next_page_number" in data && data.next_page_number != undefined) {
                 this.next_page_number = data.next_page_number;
             }
+            if ("next_page_token" in data && data.next_page_token != undefined) {
+                this.next_page_token = data.next_page_token;
+            }
+            if ("total_count" in data && data.total_count != undefined) {
+                this.total_count = data.total_count;
+            }
         }
     }
     get comments() {
@@ -875,15 +1334,31 @@ export class ListCommentsResponse extends pb_1.Message {
     set comments(value: Comment[]) {
         pb_1.Message.setRepeatedWrapperField(this, 1, value);
     }
+    /** @deprecated*/
     get next_page_number() {
         return pb_1.Message.getFieldWithDefault(this, 2, 0) as number;
     }
     set next_page_number(value: number) {
         pb_1.Message.setField(this, 2, value);
     }
+    get next_page_token() {
+        return pb_1.Message.getFieldWithDefault(this, 3, "") as string;
+    }
+    set next_page_token(value: string) {
+        pb_1.Message.setField(this, 3, value);
+    }
+    get total_count() {
+        return pb_1.Message.getFieldWithDefault(this, 4, 0) as number;
+    }
+    set total_count(value: number) {
+        pb_1.Message.setField(this, 4, value);
+    }
     static fromObject(data: {
         comments?: ReturnType<typeof Comment.prototype.toObject>[];
+        /** @deprecated*/
         next_page_number?: number;
+        next_page_token?: string;
+        total_count?: number;
     }): ListCommentsResponse {
         const message = new ListCommentsResponse({});
         if (data.comments != null) {
@@ -892,12 +1367,21 @@ export class ListCommentsResponse extends pb_1.Message {
         if (data.next_page_number != null) {
             message.next_page_number = data.next_page_number;
         }
+        if (data.next_page_token != null) {
+            message.next_page_token = data.next_page_token;
+        }
+        if (data.total_count != null) {
+            message.total_count = data.total_count;
+        }
         return message;
     }
     toObject() {
         const data: {
             comments?: ReturnType<typeof Comment.prototype.toObject>[];
+            /** @deprecated*/
             next_page_number?: number;
+            next_page_token?: string;
+            total_count?: number;
         } = {};
         if (this.comments != null) {
             data.comments = this.comments.map((item: Comment) => item.toObject());
@@ -905,6 +1389,12 @@ export class ListCommentsResponse extends pb_1.Message {
         if (this.next_page_number != null) {
             data.next_page_number = this.next_page_number;
         }
+        if (this.next_page_token != null) {
+            data.next_page_token = this.next_page_token;
+        }
+        if (this.total_count != null) {
+            data.total_count = this.total_count;
+        }
         return data;
     }
     serialize(): Uint8Array;
@@ -915,6 +1405,10 @@ export class ListCommentsResponse extends pb_1.Message {
             writer.writeRepeatedMessage(1, this.comments, (item: Comment) => item.serialize(writer));
         if (this.next_page_number != 0)
             writer.writeInt32(2, this.next_page_number);
+        if (this.next_page_token.length)
+            writer.writeString(3, this.next_page_token);
+        if (this.total_count != 0)
+            writer.writeInt32(4, this.total_count);
         if (!w)
             return writer.getResultBuffer();
     }
@@ -930,6 +1424,12 @@ export class ListCommentsResponse extends pb_1.Message {
                 case 2:
                     message.next_page_number = reader.readInt32();
                     break;
+                case 3:
+                    message.next_page_token = reader.readString();
+                    break;
+                case 4:
+                    message.total_count = reader.readInt32();
+                    break;
                 default: reader.skipField();
             }
         }
@@ -1549,23 +2049,589 @@ export class DeleteReplyRequest extends pb_1.Message {
         return DeleteReplyRequest.deserialize(bytes);
     }
 }
-export class Empty extends pb_1.Message {
+export class LikeCommentRequest extends pb_1.Message {
     #one_of_decls: number[][] = [];
-    constructor(data?: any[] | {}) {
+    constructor(data?: any[] | {
+        comment_id?: string;
+    }) {
         super();
         pb_1.Message.initialize(this, Array.isArray(data) ? data : [], 0, -1, [], this.#one_of_decls);
-        if (!Array.isArray(data) && typeof data == "object") { }
+        if (!Array.isArray(data) && typeof data == "object") {
+            if ("comment_id" in data && data.comment_id != undefined) {
+                this.comment_id = data.comment_id;
+            }
+        }
     }
-    static fromObject(data: {}): Empty {
-        const message = new Empty({});
+    get comment_id() {
+        return pb_1.Message.getFieldWithDefault(this, 1, "") as string;
+    }
+    set comment_id(value: string) {
+        pb_1.Message.setField(this, 1, value);
+    }
+    static fromObject(data: {
+        comment_id?: string;
+    }): LikeCommentRequest {
+        const message = new LikeCommentRequest({});
+        if (data.comment_id != null) {
+            message.comment_id = data.comment_id;
+        }
         return message;
     }
     toObject() {
-        const data: {} = {};
-        return data;
-    }
-    serialize(): Uint8Array;
-    serialize(w: pb_1.BinaryWriter): void;
+        const data: {
+            comment_id?: string;
+        } = {};
+        if (this.comment_id != null) {
+            data.comment_id = this.comment_id;
+        }
+        return data;
+    }
+    serialize(): Uint8Array;
+    serialize(w: pb_1.BinaryWriter): void;
+    serialize(w?: pb_1.BinaryWriter): Uint8Array | void {
+        const writer = w || new pb_1.BinaryWriter();
+        if (this.comment_id.length)
+            writer.writeString(1, this.comment_id);
+        if (!w)
+            return writer.getResultBuffer();
+    }
+    static deserialize(bytes: Uint8Array | pb_1.BinaryReader): LikeCommentRequest {
+        const reader = bytes instanceof pb_1.BinaryReader ? bytes : new pb_1.BinaryReader(bytes), message = new LikeCommentRequest();
+        while (reader.nextField()) {
+            if (reader.isEndGroup())
+                break;
+            switch (reader.getFieldNumber()) {
+                case 1:
+                    message.comment_id = reader.readString();
+                    break;
+                default: reader.skipField();
+            }
+        }
+        return message;
+    }
+    serializeBinary(): Uint8Array {
+        return this.serialize();
+    }
+    static deserializeBinary(bytes: Uint8Array): LikeCommentRequest {
+        return LikeCommentRequest.deserialize(bytes);
+    }
+}
+export class UnlikeCommentRequest extends pb_1.Message {
+    #one_of_decls: number[][] = [];
+    constructor(data?: any[] | {
+        comment_id?: string;
+    }) {
+        super();
+        pb_1.Message.initialize(this, Array.isArray(data) ? data : [], 0, -1, [], this.#one_of_decls);
+        if (!Array.isArray(data) && typeof data == "object") {
+            if ("comment_id" in data && data.comment_id != undefined) {
+                this.comment_id = data.comment_id;
+            }
+        }
+    }
+    get comment_id() {
+        return pb_1.Message.getFieldWithDefault(this, 1, "") as string;
+    }
+    set comment_id(value: string) {
+        pb_1.Message.setField(this, 1, value);
+    }
+    static fromObject(data: {
+        comment_id?: string;
+    }): UnlikeCommentRequest {
+        const message = new UnlikeCommentRequest({});
+        if (data.comment_id != null) {
+            message.comment_id = data.comment_id;
+        }
+        return message;
+    }
+    toObject() {
+        const data: {
+            comment_id?: string;
+        } = {};
+        if (this.comment_id != null) {
+            data.comment_id = this.comment_id;
+        }
+        return data;
+    }
+    serialize(): Uint8Array;
+    serialize(w: pb_1.BinaryWriter): void;
+    serialize(w?: pb_1.BinaryWriter): Uint8Array | void {
+        const writer = w || new pb_1.BinaryWriter();
+        if (this.comment_id.length)
+            writer.writeString(1, this.comment_id);
+        if (!w)
+            return writer.getResultBuffer();
+    }
+    static deserialize(bytes: Uint8Array | pb_1.BinaryReader): UnlikeCommentRequest {
+        const reader = bytes instanceof pb_1.BinaryReader ? bytes : new pb_1.BinaryReader(bytes), message = new UnlikeCommentRequest();
+        while (reader.nextField()) {
+            if (reader.isEndGroup())
+                break;
+            switch (reader.getFieldNumber()) {
+                case 1:
+                    message.comment_id = reader.readString();
+                    break;
+                default: reader.skipField();
+            }
+        }
+        return message;
+    }
+    serializeBinary(): Uint8Array {
+        return this.serialize();
+    }
+    static deserializeBinary(bytes: Uint8Array): UnlikeCommentRequest {
+        return UnlikeCommentRequest.deserialize(bytes);
+    }
+}
+export class CommentLikeStatus extends pb_1.Message {
+    #one_of_decls: number[][] = [];
+    constructor(data?: any[] | {
+        comment_id?: string;
+        like_count?: number;
+        liked_by_me?: boolean;
+    }) {
+        super();
+        pb_1.Message.initialize(this, Array.isArray(data) ? data : [], 0, -1, [], this.#one_of_decls);
+        if (!Array.isArray(data) && typeof data == "object") {
+            if ("comment_id" in data && data.comment_id != undefined) {
+                this.comment_id = data.comment_id;
+            }
+            if ("like_count" in data && data.like_count != undefined) {
+                this.like_count = data.like_count;
+            }
+            if ("liked_by_me" in data && data.liked_by_me != undefined) {
+                this.liked_by_me = data.liked_by_me;
+            }
+        }
+    }
+    get comment_id() {
+        return pb_1.Message.getFieldWithDefault(this, 1, "") as string;
+    }
+    set comment_id(value: string) {
+        pb_1.Message.setField(this, 1, value);
+    }
+    get like_count() {
+        return pb_1.Message.getFieldWithDefault(this, 2, 0) as number;
+    }
+    set like_count(value: number) {
+        pb_1.Message.setField(this, 2, value);
+    }
+    get liked_by_me() {
+        return pb_1.Message.getFieldWithDefault(this, 3, false) as boolean;
+    }
+    set liked_by_me(value: boolean) {
+        pb_1.Message.setField(this, 3, value);
+    }
+    static fromObject(data: {
+        comment_id?: string;
+        like_count?: number;
+        liked_by_me?: boolean;
+    }): CommentLikeStatus {
+        const message = new CommentLikeStatus({});
+        if (data.comment_id != null) {
+            message.comment_id = data.comment_id;
+        }
+        if (data.like_count != null) {
+            message.like_count = data.like_count;
+        }
+        if (data.liked_by_me != null) {
+            message.liked_by_me = data.liked_by_me;
+        }
+        return message;
+    }
+    toObject() {
+        const data: {
+            comment_id?: string;
+            like_count?: number;
+            liked_by_me?: boolean;
+        } = {};
+        if (this.comment_id != null) {
+            data.comment_id = this.comment_id;
+        }
+        if (this.like_count != null) {
+            data.like_count = this.like_count;
+        }
+        if (this.liked_by_me != null) {
+            data.liked_by_me = this.liked_by_me;
+        }
+        return data;
+    }
+    serialize(): Uint8Array;
+    serialize(w: pb_1.BinaryWriter): void;
+    serialize(w?: pb_1.BinaryWriter): Uint8Array | void {
+        const writer = w || new pb_1.BinaryWriter();
+        if (this.comment_id.length)
+            writer.writeString(1, this.comment_id);
+        if (this.like_count != 0)
+            writer.writeInt32(2, this.like_count);
+        if (this.liked_by_me != false)
+            writer.writeBool(3, this.liked_by_me);
+        if (!w)
+            return writer.getResultBuffer();
+    }
+    static deserialize(bytes: Uint8Array | pb_1.BinaryReader): CommentLikeStatus {
+        const reader = bytes instanceof pb_1.BinaryReader ? bytes : new pb_1.BinaryReader(bytes), message = new CommentLikeStatus();
+        while (reader.nextField()) {
+            if (reader.isEndGroup())
+                break;
+            switch (reader.getFieldNumber()) {
+                case 1:
+                    message.comment_id = reader.readString();
+                    break;
+                case 2:
+                    message.like_count = reader.readInt32();
+                    break;
+                case 3:
+                    message.liked_by_me = reader.readBool();
+                    break;
+                default: reader.skipField();
+            }
+        }
+        return message;
+    }
+    serializeBinary(): Uint8Array {
+        return this.serialize();
+    }
+    static deserializeBinary(bytes: Uint8Array): CommentLikeStatus {
+        return CommentLikeStatus.deserialize(bytes);
+    }
+}
+export class CommentLike extends pb_1.Message {
+    #one_of_decls: number[][] = [];
+    constructor(data?: any[] | {
+        user_id?: string;
+        username?: string;
+        created_at?: dependency_1.Timestamp;
+    }) {
+        super();
+        pb_1.Message.initialize(this, Array.isArray(data) ? data : [], 0, -1, [], this.#one_of_decls);
+        if (!Array.isArray(data) && typeof data == "object") {
+            if ("user_id" in data && data.user_id != undefined) {
+                this.user_id = data.user_id;
+            }
+            if ("username" in data && data.username != undefined) {
+                this.username = data.username;
+            }
+            if ("created_at" in data && data.created_at != undefined) {
+                this.created_at = data.created_at;
+            }
+        }
+    }
+    get user_id() {
+        return pb_1.Message.getFieldWithDefault(this, 1, "") as string;
+    }
+    set user_id(value: string) {
+        pb_1.Message.setField(this, 1, value);
+    }
+    get username() {
+        return pb_1.Message.getFieldWithDefault(this, 2, "") as string;
+    }
+    set username(value: string) {
+        pb_1.Message.setField(this, 2, value);
+    }
+    get created_at() {
+        return pb_1.Message.getWrapperField(this, dependency_1.Timestamp, 3) as dependency_1.Timestamp;
+    }
+    set created_at(value: dependency_1.Timestamp) {
+        pb_1.Message.setWrapperField(this, 3, value);
+    }
+    get has_created_at() {
+        return pb_1.Message.getField(this, 3) != null;
+    }
+    static fromObject(data: {
+        user_id?: string;
+        username?: string;
+        created_at?: ReturnType<typeof dependency_1.Timestamp.prototype.toObject>;
+    }): CommentLike {
+        const message = new CommentLike({});
+        if (data.user_id != null) {
+            message.user_id = data.user_id;
+        }
+        if (data.username != null) {
+            message.username = data.username;
+        }
+        if (data.created_at != null) {
+            message.created_at = dependency_1.Timestamp.fromObject(data.created_at);
+        }
+        return message;
+    }
+    toObject() {
+        const data: {
+            user_id?: string;
+            username?: string;
+            created_at?: ReturnType<typeof dependency_1.Timestamp.prototype.toObject>;
+        } = {};
+        if (this.user_id != null) {
+            data.user_id = this.user_id;
+        }
+        if (this.username != null) {
+            data.username = this.username;
+        }
+        if (this.created_at != null) {
+            data.created_at = this.created_at.toObject();
+        }
+        return data;
+    }
+    serialize(): Uint8Array;
+    serialize(w: pb_1.BinaryWriter): void;
+    serialize(w?: pb_1.BinaryWriter): Uint8Array | void {
+        const writer = w || new pb_1.BinaryWriter();
+        if (this.user_id.length)
+            writer.writeString(1, this.user_id);
+        if (this.username.length)
+            writer.writeString(2, this.username);
+        if (this.has_created_at)
+            writer.writeMessage(3, this.created_at, () => this.created_at.serialize(writer));
+        if (!w)
+            return writer.getResultBuffer();
+    }
+    static deserialize(bytes: Uint8Array | pb_1.BinaryReader): CommentLike {
+        const reader = bytes instanceof pb_1.BinaryReader ? bytes : new pb_1.BinaryReader(bytes), message = new CommentLike();
+        while (reader.nextField()) {
+            if (reader.isEndGroup())
+                break;
+            switch (reader.getFieldNumber()) {
+                case 1:
+                    message.user_id = reader.readString();
+                    break;
+                case 2:
+                    message.username = reader.readString();
+                    break;
+                case 3:
+                    reader.readMessage(message.created_at, () => message.created_at = dependency_1.Timestamp.deserialize(reader));
+                    break;
+                default: reader.skipField();
+            }
+        }
+        return message;
+    }
+    serializeBinary(): Uint8Array {
+        return this.serialize();
+    }
+    static deserializeBinary(bytes: Uint8Array): CommentLike {
+        return CommentLike.deserialize(bytes);
+    }
+}
+export class ListCommentLikesRequest extends pb_1.Message {
+    #one_of_decls: number[][] = [];
+    constructor(data?: any[] | {
+        comment_id?: string;
+        page_size?: number;
+        page_number?: number;
+    }) {
+        super();
+        pb_1.Message.initialize(this, Array.isArray(data) ? data : [], 0, -1, [], this.#one_of_decls);
+        if (!Array.isArray(data) && typeof data == "object") {
+            if ("comment_id" in data && data.comment_id != undefined) {
+                this.comment_id = data.comment_id;
+            }
+            if ("page_size" in data && data.page_size != undefined) {
+                this.page_size = data.page_size;
+            }
+            if ("page_number" in data && data.page_number != undefined) {
+                this.page_number = data.page_number;
+            }
+        }
+    }
+    get comment_id() {
+        return pb_1.Message.getFieldWithDefault(this, 1, "") as string;
+    }
+    set comment_id(value: string) {
+        pb_1.Message.setField(this, 1, value);
+    }
+    get page_size() {
+        return pb_1.Message.getFieldWithDefault(this, 2, 0) as number;
+    }
+    set page_size(value: number) {
+        pb_1.Message.setField(this, 2, value);
+    }
+    get page_number() {
+        return pb_1.Message.getFieldWithDefault(this, 3, 0) as number;
+    }
+    set page_number(value: number) {
+        pb_1.Message.setField(this, 3, value);
+    }
+    static fromObject(data: {
+        comment_id?: string;
+        page_size?: number;
+        page_number?: number;
+    }): ListCommentLikesRequest {
+        const message = new ListCommentLikesRequest({});
+        if (data.comment_id != null) {
+            message.comment_id = data.comment_id;
+        }
+        if (data.page_size != null) {
+            message.page_size = data.page_size;
+        }
+        if (data.page_number != null) {
+            message.page_number = data.page_number;
+        }
+        return message;
+    }
+    toObject() {
+        const data: {
+            comment_id?: string;
+            page_size?: number;
+            page_number?: number;
+        } = {};
+        if (this.comment_id != null) {
+            data.comment_id = this.comment_id;
+        }
+        if (this.page_size != null) {
+            data.page_size = this.page_size;
+        }
+        if (this.page_number != null) {
+            data.page_number = this.page_number;
+        }
+        return data;
+    }
+    serialize(): Uint8Array;
+    serialize(w: pb_1.BinaryWriter): void;
+    serialize(w?: pb_1.BinaryWriter): Uint8Array | void {
+        const writer = w || new pb_1.BinaryWriter();
+        if (this.comment_id.length)
+            writer.writeString(1, this.comment_id);
+        if (this.page_size != 0)
+            writer.writeInt32(2, this.page_size);
+        if (this.page_number != 0)
+            writer.writeInt32(3, this.page_number);
+        if (!w)
+            return writer.getResultBuffer();
+    }
+    static deserialize(bytes: Uint8Array | pb_1.BinaryReader): ListCommentLikesRequest {
+        const reader = bytes instanceof pb_1.BinaryReader ? bytes : new pb_1.BinaryReader(bytes), message = new ListCommentLikesRequest();
+        while (reader.nextField()) {
+            if (reader.isEndGroup())
+                break;
+            switch (reader.getFieldNumber()) {
+                case 1:
+                    message.comment_id = reader.readString();
+                    break;
+                case 2:
+                    message.page_size = reader.readInt32();
+                    break;
+                case 3:
+                    message.page_number = reader.readInt32();
+                    break;
+                default: reader.skipField();
+            }
+        }
+        return message;
+    }
+    serializeBinary(): Uint8Array {
+        return this.serialize();
+    }
+    static deserializeBinary(bytes: Uint8Array): ListCommentLikesRequest {
+        return ListCommentLikesRequest.deserialize(bytes);
+    }
+}
+export class ListCommentLikesResponse extends pb_1.Message {
+    #one_of_decls: number[][] = [];
+    constructor(data?: any[] | {
+        likes?: CommentLike[];
+        next_page_number?: number;
+    }) {
+        super();
+        pb_1.Message.initialize(this, Array.isArray(data) ? data : [], 0, -1, [1], this.#one_of_decls);
+        if (!Array.isArray(data) && typeof data == "object") {
+            if ("likes" in data && data.likes != undefined) {
+                this.likes = data.likes;
+            }
+            if ("next_page_number" in data && data.next_page_number != undefined) {
+                this.next_page_number = data.next_page_number;
+            }
+        }
+    }
+    get likes() {
+        return pb_1.Message.getRepeatedWrapperField(this, CommentLike, 1) as CommentLike[];
+    }
+    set likes(value: CommentLike[]) {
+        pb_1.Message.setRepeatedWrapperField(this, 1, value);
+    }
+    get next_page_number() {
+        return pb_1.Message.getFieldWithDefault(this, 2, 0) as number;
+    }
+    set next_page_number(value: number) {
+        pb_1.Message.setField(this, 2, value);
+    }
+    static fromObject(data: {
+        likes?: ReturnType<typeof CommentLike.prototype.toObject>[];
+        next_page_number?: number;
+    }): ListCommentLikesResponse {
+        const message = new ListCommentLikesResponse({});
+        if (data.likes != null) {
+            message.likes = data.likes.map(item => CommentLike.fromObject(item));
+        }
+        if (data.next_page_number != null) {
+            message.next_page_number = data.next_page_number;
+        }
+        return message;
+    }
+    toObject() {
+        const data: {
+            likes?: ReturnType<typeof CommentLike.prototype.toObject>[];
+            next_page_number?: number;
+        } = {};
+        if (this.likes != null) {
+            data.likes = this.likes.map((item: CommentLike) => item.toObject());
+        }
+        if (this.next_page_number != null) {
+            data.next_page_number = this.next_page_number;
+        }
+        return data;
+    }
+    serialize(): Uint8Array;
+    serialize(w: pb_1.BinaryWriter): void;
+    serialize(w?: pb_1.BinaryWriter): Uint8Array | void {
+        const writer = w || new pb_1.BinaryWriter();
+        if (this.likes.length)
+            writer.writeRepeatedMessage(1, this.likes, (item: CommentLike) => item.serialize(writer));
+        if (this.next_page_number != 0)
+            writer.writeInt32(2, this.next_page_number);
+        if (!w)
+            return writer.getResultBuffer();
+    }
+    static deserialize(bytes: Uint8Array | pb_1.BinaryReader): ListCommentLikesResponse {
+        const reader = bytes instanceof pb_1.BinaryReader ? bytes : new pb_1.BinaryReader(bytes), message = new ListCommentLikesResponse();
+        while (reader.nextField()) {
+            if (reader.isEndGroup())
+                break;
+            switch (reader.getFieldNumber()) {
+                case 1:
+                    reader.readMessage(message.likes, () => pb_1.Message.addToRepeatedWrapperField(message, 1, CommentLike.deserialize(reader), CommentLike));
+                    break;
+                case 2:
+                    message.next_page_number = reader.readInt32();
+                    break;
+                default: reader.skipField();
+            }
+        }
+        return message;
+    }
+    serializeBinary(): Uint8Array {
+        return this.serialize();
+    }
+    static deserializeBinary(bytes: Uint8Array): ListCommentLikesResponse {
+        return ListCommentLikesResponse.deserialize(bytes);
+    }
+}
+export class Empty extends pb_1.Message {
+    #one_of_decls: number[][] = [];
+    constructor(data?: any[] | {}) {
+        super();
+        pb_1.Message.initialize(this, Array.isArray(data) ? data : [], 0, -1, [], this.#one_of_decls);
+        if (!Array.isArray(data) && typeof data == "object") { }
+    }
+    static fromObject(data: {}): Empty {
+        const message = new Empty({});
+        return message;
+    }
+    toObject() {
+        const data: {} = {};
+        return data;
+    }
+    serialize(): Uint8Array;
+    serialize(w: pb_1.BinaryWriter): void;
     serialize(w?: pb_1.BinaryWriter): Uint8Array | void {
         const writer = w || new pb_1.BinaryWriter();
         if (!w)
@@ -1589,6 +2655,163 @@ export class Empty extends pb_1.Message {
         return Empty.deserialize(bytes);
     }
 }
+export class DeleteTenantDataRequest extends pb_1.Message {
+    #one_of_decls: number[][] = [];
+    constructor(data?: any[] | {
+        tenant_id?: string;
+        video_ids?: string[];
+    }) {
+        super();
+        pb_1.Message.initialize(this, Array.isArray(data) ? data : [], 0, -1, [2], this.#one_of_decls);
+        if (!Array.isArray(data) && typeof data == "object") {
+            if ("tenant_id" in data && data.tenant_id != undefined) {
+                this.tenant_id = data.tenant_id;
+            }
+            if ("video_ids" in data && data.video_ids != undefined) {
+                this.video_ids = data.video_ids;
+            }
+        }
+    }
+    get tenant_id() {
+        return pb_1.Message.getFieldWithDefault(this, 1, "") as string;
+    }
+    set tenant_id(value: string) {
+        pb_1.Message.setField(this, 1, value);
+    }
+    get video_ids() {
+        return pb_1.Message.getFieldWithDefault(this, 2, []) as string[];
+    }
+    set video_ids(value: string[]) {
+        pb_1.Message.setField(this, 2, value);
+    }
+    static fromObject(data: {
+        tenant_id?: string;
+        video_ids?: string[];
+    }): DeleteTenantDataRequest {
+        const message = new DeleteTenantDataRequest({});
+        if (data.tenant_id != null) {
+            message.tenant_id = data.tenant_id;
+        }
+        if (data.video_ids != null) {
+            message.video_ids = data.video_ids;
+        }
+        return message;
+    }
+    toObject() {
+        const data: {
+            tenant_id?: string;
+            video_ids?: string[];
+        } = {};
+        if (this.tenant_id != null) {
+            data.tenant_id = this.tenant_id;
+        }
+        if (this.video_ids != null) {
+            data.video_ids = this.video_ids;
+        }
+        return data;
+    }
+    serialize(): Uint8Array;
+    serialize(w: pb_1.BinaryWriter): void;
+    serialize(w?: pb_1.BinaryWriter): Uint8Array | void {
+        const writer = w || new pb_1.BinaryWriter();
+        if (this.tenant_id.length)
+            writer.writeString(1, this.tenant_id);
+        if (this.video_ids.length)
+            writer.writeRepeatedString(2, this.video_ids);
+        if (!w)
+            return writer.getResultBuffer();
+    }
+    static deserialize(bytes: Uint8Array | pb_1.BinaryReader): DeleteTenantDataRequest {
+        const reader = bytes instanceof pb_1.BinaryReader ? bytes : new pb_1.BinaryReader(bytes), message = new DeleteTenantDataRequest();
+        while (reader.nextField()) {
+            if (reader.isEndGroup())
+                break;
+            switch (reader.getFieldNumber()) {
+                case 1:
+                    message.tenant_id = reader.readString();
+                    break;
+                case 2:
+                    pb_1.Message.addToRepeatedField(message, 2, reader.readString());
+                    break;
+                default: reader.skipField();
+            }
+        }
+        return message;
+    }
+    serializeBinary(): Uint8Array {
+        return this.serialize();
+    }
+    static deserializeBinary(bytes: Uint8Array): DeleteTenantDataRequest {
+        return DeleteTenantDataRequest.deserialize(bytes);
+    }
+}
+export class DeleteTenantDataResponse extends pb_1.Message {
+    #one_of_decls: number[][] = [];
+    constructor(data?: any[] | {
+        deleted_comments?: number;
+    }) {
+        super();
+        pb_1.Message.initialize(this, Array.isArray(data) ? data : [], 0, -1, [], this.#one_of_decls);
+        if (!Array.isArray(data) && typeof data == "object") {
+            if ("deleted_comments" in data && data.deleted_comments != undefined) {
+                this.deleted_comments = data.deleted_comments;
+            }
+        }
+    }
+    get deleted_comments() {
+        return pb_1.Message.getFieldWithDefault(this, 1, 0) as number;
+    }
+    set deleted_comments(value: number) {
+        pb_1.Message.setField(this, 1, value);
+    }
+    static fromObject(data: {
+        deleted_comments?: number;
+    }): DeleteTenantDataResponse {
+        const message = new DeleteTenantDataResponse({});
+        if (data.deleted_comments != null) {
+            message.deleted_comments = data.deleted_comments;
+        }
+        return message;
+    }
+    toObject() {
+        const data: {
+            deleted_comments?: number;
+        } = {};
+        if (this.deleted_comments != null) {
+            data.deleted_comments = this.deleted_comments;
+        }
+        return data;
+    }
+    serialize(): Uint8Array;
+    serialize(w: pb_1.BinaryWriter): void;
+    serialize(w?: pb_1.BinaryWriter): Uint8Array | void {
+        const writer = w || new pb_1.BinaryWriter();
+        if (this.deleted_comments != 0)
+            writer.writeInt32(1, this.deleted_comments);
+        if (!w)
+            return writer.getResultBuffer();
+    }
+    static deserialize(bytes: Uint8Array | pb_1.BinaryReader): DeleteTenantDataResponse {
+        const reader = bytes instanceof pb_1.BinaryReader ? bytes : new pb_1.BinaryReader(bytes), message = new DeleteTenantDataResponse();
+        while (reader.nextField()) {
+            if (reader.isEndGroup())
+                break;
+            switch (reader.getFieldNumber()) {
+                case 1:
+                    message.deleted_comments = reader.readInt32();
+                    break;
+                default: reader.skipField();
+            }
+        }
+        return message;
+    }
+    serializeBinary(): Uint8Array {
+        return this.serialize();
+    }
+    static deserializeBinary(bytes: Uint8Array): DeleteTenantDataResponse {
+        return DeleteTenantDataResponse.deserialize(bytes);
+    }
+}
 export abstract class UnimplementedCommentServiceService {
     static definition = {
         CreateComment: {
@@ -1671,6 +2894,33 @@ export abstract class UnimplementedCommentServiceService {
             requestDeserialize: (bytes: Buffer) => DeleteReplyRequest.deserialize(new Uint8Array(bytes)),
             responseSerialize: (message: Empty) => Buffer.from(message.serialize()),
             responseDeserialize: (bytes: Buffer) => Empty.deserialize(new Uint8Array(bytes))
+        },
+        LikeComment: {
+            path: "/commentservice.CommentService/LikeComment",
+            requestStream: false,
+            responseStream: false,
+            requestSerialize: (message: LikeCommentRequest) => Buffer.from(message.serialize()),
+            requestDeserialize: (bytes: Buffer) => LikeCommentRequest.deserialize(new Uint8Array(bytes)),
+            responseSerialize: (message: CommentLikeStatus) => Buffer.from(message.serialize()),
+            responseDeserialize: (bytes: Buffer) => CommentLikeStatus.deserialize(new Uint8Array(bytes))
+        },
+        UnlikeComment: {
+            path: "/commentservice.CommentService/UnlikeComment",
+            requestStream: false,
+            responseStream: false,
+            requestSerialize: (message: UnlikeCommentRequest) => Buffer.from(message.serialize()),
+            requestDeserialize: (bytes: Buffer) => UnlikeCommentRequest.deserialize(new Uint8Array(bytes)),
+            responseSerialize: (message: CommentLikeStatus) => Buffer.from(message.serialize()),
+            responseDeserialize: (bytes: Buffer) => CommentLikeStatus.deserialize(new Uint8Array(bytes))
+        },
+        ListCommentLikes: {
+            path: "/commentservice.CommentService/ListCommentLikes",
+            requestStream: false,
+            responseStream: false,
+            requestSerialize: (message: ListCommentLikesRequest) => Buffer.from(message.serialize()),
+            requestDeserialize: (bytes: Buffer) => ListCommentLikesRequest.deserialize(new Uint8Array(bytes)),
+            responseSerialize: (message: ListCommentLikesResponse) => Buffer.from(message.serialize()),
+            responseDeserialize: (bytes: Buffer) => ListCommentLikesResponse.deserialize(new Uint8Array(bytes))
         }
     };
     [method: string]: grpc_1.UntypedHandleCall;
@@ -1683,6 +2933,9 @@ export abstract class UnimplementedCommentServiceService {
     abstract GetReplies(call: grpc_1.ServerUnaryCall<GetRepliesRequest, ListRepliesResponse>, callback: grpc_1.sendUnaryData<ListRepliesResponse>): void;
     abstract UpdateReply(call: grpc_1.ServerUnaryCall<UpdateReplyRequest, Reply>, callback: grpc_1.sendUnaryData<Reply>): void;
     abstract DeleteReply(call: grpc_1.ServerUnaryCall<DeleteReplyRequest, Empty>, callback: grpc_1.sendUnaryData<Empty>): void;
+    abstract LikeComment(call: grpc_1.ServerUnaryCall<LikeCommentRequest, CommentLikeStatus>, callback: grpc_1.sendUnaryData<CommentLikeStatus>): void;
+    abstract UnlikeComment(call: grpc_1.ServerUnaryCall<UnlikeCommentRequest, CommentLikeStatus>, callback: grpc_1.sendUnaryData<CommentLikeStatus>): void;
+    abstract ListCommentLikes(call: grpc_1.ServerUnaryCall<ListCommentLikesRequest, ListCommentLikesResponse>, callback: grpc_1.sendUnaryData<ListCommentLikesResponse>): void;
 }
 export class CommentServiceClient {
     private _address: string;
@@ -1730,4 +2983,46 @@ export class CommentServiceClient {
     DeleteReply(message: DeleteReplyRequest, metadata: grpc_web_1.Metadata | null) {
         return this._client.thenableCall<DeleteReplyRequest, Empty>(this._address + "/commentservice.CommentService/DeleteReply", message, metadata || {}, CommentServiceClient.DeleteReply);
     }
+    private static LikeComment = new grpc_web_1.MethodDescriptor<LikeCommentRequest, CommentLikeStatus>("/commentservice.CommentService/LikeComment", grpc_web_1.MethodType.UNARY, LikeCommentRequest, CommentLikeStatus, (message: LikeCommentRequest) => message.serialize(), CommentLikeStatus.deserialize);
+    LikeComment(message: LikeCommentRequest, metadata: grpc_web_1.Metadata | null) {
+        return this._client.thenableCall<LikeCommentRequest, CommentLikeStatus>(this._address + "/commentservice.CommentService/LikeComment", message, metadata || {}, CommentServiceClient.LikeComment);
+    }
+    private static UnlikeComment = new grpc_web_1.MethodDescriptor<UnlikeCommentRequest, CommentLikeStatus>("/commentservice.CommentService/UnlikeComment", grpc_web_1.MethodType.UNARY, UnlikeCommentRequest, CommentLikeStatus, (message: UnlikeCommentRequest) => message.serialize(), CommentLikeStatus.deserialize);
+    UnlikeComment(message: UnlikeCommentRequest, metadata: grpc_web_1.Metadata | null) {
+        return this._client.thenableCall<UnlikeCommentRequest, CommentLikeStatus>(this._address + "/commentservice.CommentService/UnlikeComment", message, metadata || {}, CommentServiceClient.UnlikeComment);
+    }
+    private static ListCommentLikes = new grpc_web_1.MethodDescriptor<ListCommentLikesRequest, ListCommentLikesResponse>("/commentservice.CommentService/ListCommentLikes", grpc_web_1.MethodType.UNARY, ListCommentLikesRequest, ListCommentLikesResponse, (message: ListCommentLikesRequest) => message.serialize(), ListCommentLikesResponse.deserialize);
+    ListCommentLikes(message: ListCommentLikesRequest, metadata: grpc_web_1.Metadata | null) {
+        return this._client.thenableCall<ListCommentLikesRequest, ListCommentLikesResponse>(this._address + "/commentservice.CommentService/ListCommentLikes", message, metadata || {}, CommentServiceClient.ListCommentLikes);
+    }
+}
+export abstract class UnimplementedTenantCleanupServiceService {
+    static definition = {
+        DeleteTenantData: {
+            path: "/commentservice.TenantCleanupService/DeleteTenantData",
+            requestStream: false,
+            responseStream: false,
+            requestSerialize: (message: DeleteTenantDataRequest) => Buffer.from(message.serialize()),
+            requestDeserialize: (bytes: Buffer) => DeleteTenantDataRequest.deserialize(new Uint8Array(bytes)),
+            responseSerialize: (message: DeleteTenantDataResponse) => Buffer.from(message.serialize()),
+            responseDeserialize: (bytes: Buffer) => DeleteTenantDataResponse.deserialize(new Uint8Array(bytes))
+        }
+    };
+    [method: string]: grpc_1.UntypedHandleCall;
+    abstract DeleteTenantData(call: grpc_1.ServerUnaryCall<DeleteTenantDataRequest, DeleteTenantDataResponse>, callback: grpc_1.sendUnaryData<DeleteTenantDataResponse>): void;
+}
+export class TenantCleanupServiceClient {
+    private _address: string;
+    private _client: grpc_web_1.GrpcWebClientBase;
+    constructor(address: string, credentials?: Object, options?: grpc_web_1.GrpcWebClientBaseOptions) {
+        if (!options)
+            options = {};
+        options.format = options.format || "text";
+        this._address = address;
+        this._client = new grpc_web_1.GrpcWebClientBase(options);
+    }
+    private static DeleteTenantData = new grpc_web_1.MethodDescriptor<DeleteTenantDataRequest, DeleteTenantDataResponse>("/commentservice.TenantCleanupService/DeleteTenantData", grpc_web_1.MethodType.UNARY, DeleteTenantDataRequest, DeleteTenantDataResponse, (message: DeleteTenantDataRequest) => message.serialize(), DeleteTenantDataResponse.deserialize);
+    DeleteTenantData(message: DeleteTenantDataRequest, metadata: grpc_web_1.Metadata | null) {
+        return this._client.thenableCall<DeleteTenantDataRequest, DeleteTenantDataResponse>(this._address + "/commentservice.TenantCleanupService/DeleteTenantData", message, metadata || {}, TenantCleanupServiceClient.DeleteTenantData);
+    }
 }
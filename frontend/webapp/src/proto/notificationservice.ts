@@ -0,0 +1,3629 @@
+// @ts-nocheck
+/**
+ * Generated by the protoc-gen-ts.  DO NOT EDIT!
+ * compiler version: 5.29.3
+ * source: notificationservice.proto
+ * git: https://github.com/thesayyn/protoc-gen-ts */
+import * as dependency_1 from "./google/protobuf/timestamp";
+import * as pb_1 from "google-protobuf";
+import * as grpc_1 from "grpc-web";
+import * as grpc_web_1 from "grpc-web";
+export enum NotificationType {
+    NOTIFICATION_TYPE_UNSPECIFIED = 0,
+    NOTIFICATION_TYPE_REPLY = 1,
+    NOTIFICATION_TYPE_MENTION = 2,
+    NOTIFICATION_TYPE_CHANNEL_VIDEO = 3,
+    NOTIFICATION_TYPE_CHANNEL_MEMBERSHIP = 4,
+    NOTIFICATION_TYPE_TENANT_INVITATION = 5
+}
+export enum EventType {
+    EVENT_TYPE_UNSPECIFIED = 0,
+    EVENT_TYPE_VIDEO_UPLOADED = 1,
+    EVENT_TYPE_VIDEO_DELETED = 2,
+    EVENT_TYPE_COMMENT_CREATED = 3,
+    EVENT_TYPE_WEBHOOK_TEST = 4,
+    EVENT_TYPE_VIDEO_STATUS_CHANGED = 5,
+    EVENT_TYPE_CHANNEL_MEMBERSHIP_CHANGED = 6
+}
+export enum WebhookDeliveryStatus {
+    WEBHOOK_DELIVERY_STATUS_PENDING = 0,
+    WEBHOOK_DELIVERY_STATUS_SUCCEEDED = 1,
+    WEBHOOK_DELIVERY_STATUS_FAILED = 2
+}
+export enum DigestFrequency {
+    DIGEST_FREQUENCY_WEEKLY = 0,
+    DIGEST_FREQUENCY_DAILY = 1,
+    DIGEST_FREQUENCY_OFF = 2
+}
+export class Notification extends pb_1.Message {
+    #one_of_decls: number[][] = [];
+    constructor(data?: any[] | {
+        id?: string;
+        type?: NotificationType;
+        actor_id?: string;
+        actor_name?: string;
+        message?: string;
+        video_id?: string;
+        channel_id?: string;
+        comment_id?: string;
+        is_read?: boolean;
+        created_at?: dependency_1.Timestamp;
+        read_at?: dependency_1.Timestamp;
+    }) {
+        super();
+        pb_1.Message.initialize(this, Array.isArray(data) ? data : [], 0, -1, [], this.#one_of_decls);
+        if (!Array.isArray(data) && typeof data == "object") {
+            if ("id" in data && data.id != undefined) {
+                this.id = data.id;
+            }
+            if ("type" in data && data.type != undefined) {
+                this.type = data.type;
+            }
+            if ("actor_id" in data && data.actor_id != undefined) {
+                this.actor_id = data.actor_id;
+            }
+            if ("actor_name" in data && data.actor_name != undefined) {
+                this.actor_name = data.actor_name;
+            }
+            if ("message" in data && data.message != undefined) {
+                this.message = data.message;
+            }
+            if ("video_id" in data && data.video_id != undefined) {
+                this.video_id = data.video_id;
+            }
+            if ("channel_id" in data && data.channel_id != undefined) {
+                this.channel_id = data.channel_id;
+            }
+            if ("comment_id" in data && data.comment_id != undefined) {
+                this.comment_id = data.comment_id;
+            }
+            if ("is_read" in data && data.is_read != undefined) {
+                this.is_read = data.is_read;
+            }
+            if ("created_at" in data && data.created_at != undefined) {
+                this.created_at = data.created_at;
+            }
+            if ("read_at" in data && data.read_at != undefined) {
+                this.read_at = data.read_at;
+            }
+        }
+    }
+    get id() {
+        return pb_1.Message.getFieldWithDefault(this, 1, "") as string;
+    }
+    set id(value: string) {
+        pb_1.Message.setField(this, 1, value);
+    }
+    get type() {
+        return pb_1.Message.getFieldWithDefault(this, 2, NotificationType.NOTIFICATION_TYPE_UNSPECIFIED) as NotificationType;
+    }
+    set type(value: NotificationType) {
+        pb_1.Message.setField(this, 2, value);
+    }
+    get actor_id() {
+        return pb_1.Message.getFieldWithDefault(this, 3, "") as string;
+    }
+    set actor_id(value: string) {
+        pb_1.Message.setField(this, 3, value);
+    }
+    get actor_name() {
+        return pb_1.Message.getFieldWithDefault(this, 4, "") as string;
+    }
+    set actor_name(value: string) {
+        pb_1.Message.setField(this, 4, value);
+    }
+    get message() {
+        return pb_1.Message.getFieldWithDefault(this, 5, "") as string;
+    }
+    set message(value: string) {
+        pb_1.Message.setField(this, 5, value);
+    }
+    get video_id() {
+        return pb_1.Message.getFieldWithDefault(this, 6, "") as string;
+    }
+    set video_id(value: string) {
+        pb_1.Message.setField(this, 6, value);
+    }
+    get channel_id() {
+        return pb_1.Message.getFieldWithDefault(this, 7, "") as string;
+    }
+    set channel_id(value: string) {
+        pb_1.Message.setField(this, 7, value);
+    }
+    get comment_id() {
+        return pb_1.Message.getFieldWithDefault(this, 8, "") as string;
+    }
+    set comment_id(value: string) {
+        pb_1.Message.setField(this, 8, value);
+    }
+    get is_read() {
+        return pb_1.Message.getFieldWithDefault(this, 9, false) as boolean;
+    }
+    set is_read(value: boolean) {
+        pb_1.Message.setField(this, 9, value);
+    }
+    get created_at() {
+        return pb_1.Message.getWrapperField(this, dependency_1.Timestamp, 10) as dependency_1.Timestamp;
+    }
+    set created_at(value: dependency_1.Timestamp) {
+        pb_1.Message.setWrapperField(this, 10, value);
+    }
+    get has_created_at() {
+        return pb_1.Message.getField(this, 10) != null;
+    }
+    get read_at() {
+        return pb_1.Message.getWrapperField(this, dependency_1.Timestamp, 11) as dependency_1.Timestamp;
+    }
+    set read_at(value: dependency_1.Timestamp) {
+        pb_1.Message.setWrapperField(this, 11, value);
+    }
+    get has_read_at() {
+        return pb_1.Message.getField(this, 11) != null;
+    }
+    static fromObject(data: {
+        id?: string;
+        type?: NotificationType;
+        actor_id?: string;
+        actor_name?: string;
+        message?: string;
+        video_id?: string;
+        channel_id?: string;
+        comment_id?: string;
+        is_read?: boolean;
+        created_at?: ReturnType<typeof dependency_1.Timestamp.prototype.toObject>;
+        read_at?: ReturnType<typeof dependency_1.Timestamp.prototype.toObject>;
+    }): Notification {
+        const message = new Notification({});
+        if (data.id != null) {
+            message.id = data.id;
+        }
+        if (data.type != null) {
+            message.type = data.type;
+        }
+        if (data.actor_id != null) {
+            message.actor_id = data.actor_id;
+        }
+        if (data.actor_name != null) {
+            message.actor_name = data.actor_name;
+        }
+        if (data.message != null) {
+            message.message = data.message;
+        }
+        if (data.video_id != null) {
+            message.video_id = data.video_id;
+        }
+        if (data.channel_id != null) {
+            message.channel_id = data.channel_id;
+        }
+        if (data.comment_id != null) {
+            message.comment_id = data.comment_id;
+        }
+        if (data.is_read != null) {
+            message.is_read = data.is_read;
+        }
+        if (data.created_at != null) {
+            message.created_at = dependency_1.Timestamp.fromObject(data.created_at);
+        }
+        if (data.read_at != null) {
+            message.read_at = dependency_1.Timestamp.fromObject(data.read_at);
+        }
+        return message;
+    }
+    toObject() {
+        const data: {
+            id?: string;
+            type?: NotificationType;
+            actor_id?: string;
+            actor_name?: string;
+            message?: string;
+            video_id?: string;
+            channel_id?: string;
+            comment_id?: string;
+            is_read?: boolean;
+            created_at?: ReturnType<typeof dependency_1.Timestamp.prototype.toObject>;
+            read_at?: ReturnType<typeof dependency_1.Timestamp.prototype.toObject>;
+        } = {};
+        if (this.id != null) {
+            data.id = this.id;
+        }
+        if (this.type != null) {
+            data.type = this.type;
+        }
+        if (this.actor_id != null) {
+            data.actor_id = this.actor_id;
+        }
+        if (this.actor_name != null) {
+            data.actor_name = this.actor_name;
+        }
+        if (this.message != null) {
+            data.message = this.message;
+        }
+        if (this.video_id != null) {
+            data.video_id = this.video_id;
+        }
+        if (this.channel_id != null) {
+            data.channel_id = this.channel_id;
+        }
+        if (this.comment_id != null) {
+            data.comment_id = this.comment_id;
+        }
+        if (this.is_read != null) {
+            data.is_read = this.is_read;
+        }
+        if (this.created_at != null) {
+            data.created_at = this.created_at.toObject();
+        }
+        if (this.read_at != null) {
+            data.read_at = this.read_at.toObject();
+        }
+        return data;
+    }
+    serialize(): Uint8Array;
+    serialize(w: pb_1.BinaryWriter): void;
+    serialize(w?: pb_1.BinaryWriter): Uint8Array | void {
+        const writer = w || new pb_1.BinaryWriter();
+        if (this.id.length)
+            writer.writeString(1, this.id);
+        if (this.type != NotificationType.NOTIFICATION_TYPE_UNSPECIFIED)
+            writer.writeEnum(2, this.type);
+        if (this.actor_id.length)
+            writer.writeString(3, this.actor_id);
+        if (this.actor_name.length)
+            writer.writeString(4, this.actor_name);
+        if (this.message.length)
+            writer.writeString(5, this.message);
+        if (this.video_id.length)
+            writer.writeString(6, this.video_id);
+        if (this.channel_id.length)
+            writer.writeString(7, this.channel_id);
+        if (this.comment_id.length)
+            writer.writeString(8, this.comment_id);
+        if (this.is_read != false)
+            writer.writeBool(9, this.is_read);
+        if (this.has_created_at)
+            writer.writeMessage(10, this.created_at, () => this.created_at.serialize(writer));
+        if (this.has_read_at)
+            writer.writeMessage(11, this.read_at, () => this.read_at.serialize(writer));
+        if (!w)
+            return writer.getResultBuffer();
+    }
+    static deserialize(bytes: Uint8Array | pb_1.BinaryReader): Notification {
+        const reader = bytes instanceof pb_1.BinaryReader ? bytes : new pb_1.BinaryReader(bytes), message = new Notification();
+        while (reader.nextField()) {
+            if (reader.isEndGroup())
+                break;
+            switch (reader.getFieldNumber()) {
+                case 1:
+                    message.id = reader.readString();
+                    break;
+                case 2:
+                    message.type = reader.readEnum();
+                    break;
+                case 3:
+                    message.actor_id = reader.readString();
+                    break;
+                case 4:
+                    message.actor_name = reader.readString();
+                    break;
+                case 5:
+                    message.message = reader.readString();
+                    break;
+                case 6:
+                    message.video_id = reader.readString();
+                    break;
+                case 7:
+                    message.channel_id = reader.readString();
+                    break;
+                case 8:
+                    message.comment_id = reader.readString();
+                    break;
+                case 9:
+                    message.is_read = reader.readBool();
+                    break;
+                case 10:
+                    reader.readMessage(message.created_at, () => message.created_at = dependency_1.Timestamp.deserialize(reader));
+                    break;
+                case 11:
+                    reader.readMessage(message.read_at, () => message.read_at = dependency_1.Timestamp.deserialize(reader));
+                    break;
+                default: reader.skipField();
+            }
+        }
+        return message;
+    }
+    serializeBinary(): Uint8Array {
+        return this.serialize();
+    }
+    static deserializeBinary(bytes: Uint8Array): Notification {
+        return Notification.deserialize(bytes);
+    }
+}
+export class ListNotificationsRequest extends pb_1.Message {
+    #one_of_decls: number[][] = [];
+    constructor(data?: any[] | {
+        page_number?: number;
+        page_size?: number;
+        unread_only?: boolean;
+    }) {
+        super();
+        pb_1.Message.initialize(this, Array.isArray(data) ? data : [], 0, -1, [], this.#one_of_decls);
+        if (!Array.isArray(data) && typeof data == "object") {
+            if ("page_number" in data && data.page_number != undefined) {
+                this.page_number = data.page_number;
+            }
+            if ("page_size" in data && data.page_size != undefined) {
+                this.page_size = data.page_size;
+            }
+            if ("unread_only" in data && data.unread_only != undefined) {
+                this.unread_only = data.unread_only;
+            }
+        }
+    }
+    get page_number() {
+        return pb_1.Message.getFieldWithDefault(this, 1, 0) as number;
+    }
+    set page_number(value: number) {
+        pb_1.Message.setField(this, 1, value);
+    }
+    get page_size() {
+        return pb_1.Message.getFieldWithDefault(this, 2, 0) as number;
+    }
+    set page_size(value: number) {
+        pb_1.Message.setField(this, 2, value);
+    }
+    get unread_only() {
+        return pb_1.Message.getFieldWithDefault(this, 3, false) as boolean;
+    }
+    set unread_only(value: boolean) {
+        pb_1.Message.setField(this, 3, value);
+    }
+    static fromObject(data: {
+        page_number?: number;
+        page_size?: number;
+        unread_only?: boolean;
+    }): ListNotificationsRequest {
+        const message = new ListNotificationsRequest({});
+        if (data.page_number != null) {
+            message.page_number = data.page_number;
+        }
+        if (data.page_size != null) {
+            message.page_size = data.page_size;
+        }
+        if (data.unread_only != null) {
+            message.unread_only = data.unread_only;
+        }
+        return message;
+    }
+    toObject() {
+        const data: {
+            page_number?: number;
+            page_size?: number;
+            unread_only?: boolean;
+        } = {};
+        if (this.page_number != null) {
+            data.page_number = this.page_number;
+        }
+        if (this.page_size != null) {
+            data.page_size = this.page_size;
+        }
+        if (this.unread_only != null) {
+            data.unread_only = this.unread_only;
+        }
+        return data;
+    }
+    serialize(): Uint8Array;
+    serialize(w: pb_1.BinaryWriter): void;
+    serialize(w?: pb_1.BinaryWriter): Uint8Array | void {
+        const writer = w || new pb_1.BinaryWriter();
+        if (this.page_number != 0)
+            writer.writeInt32(1, this.page_number);
+        if (this.page_size != 0)
+            writer.writeInt32(2, this.page_size);
+        if (this.unread_only != false)
+            writer.writeBool(3, this.unread_only);
+        if (!w)
+            return writer.getResultBuffer();
+    }
+    static deserialize(bytes: Uint8Array | pb_1.BinaryReader): ListNotificationsRequest {
+        const reader = bytes instanceof pb_1.BinaryReader ? bytes : new pb_1.BinaryReader(bytes), message = new ListNotificationsRequest();
+        while (reader.nextField()) {
+            if (reader.isEndGroup())
+                break;
+            switch (reader.getFieldNumber()) {
+                case 1:
+                    message.page_number = reader.readInt32();
+                    break;
+                case 2:
+                    message.page_size = reader.readInt32();
+                    break;
+                case 3:
+                    message.unread_only = reader.readBool();
+                    break;
+                default: reader.skipField();
+            }
+        }
+        return message;
+    }
+    serializeBinary(): Uint8Array {
+        return this.serialize();
+    }
+    static deserializeBinary(bytes: Uint8Array): ListNotificationsRequest {
+        return ListNotificationsRequest.deserialize(bytes);
+    }
+}
+export class ListNotificationsResponse extends pb_1.Message {
+    #one_of_decls: number[][] = [];
+    constructor(data?: any[] | {
+        notifications?: Notification[];
+        next_page_number?: number;
+        unread_count?: number;
+    }) {
+        super();
+        pb_1.Message.initialize(this, Array.isArray(data) ? data : [], 0, -1, [1], this.#one_of_decls);
+        if (!Array.isArray(data) && typeof data == "object") {
+            if ("notifications" in data && data.notifications != undefined) {
+                this.notifications = data.notifications;
+            }
+            if ("next_page_number" in data && data.next_page_number != undefined) {
+                this.next_page_number = data.next_page_number;
+            }
+            if ("unread_count" in data && data.unread_count != undefined) {
+                this.unread_count = data.unread_count;
+            }
+        }
+    }
+    get notifications() {
+        return pb_1.Message.getRepeatedWrapperField(this, Notification, 1) as Notification[];
+    }
+    set notifications(value: Notification[]) {
+        pb_1.Message.setRepeatedWrapperField(this, 1, value);
+    }
+    get next_page_number() {
+        return pb_1.Message.getFieldWithDefault(this, 2, 0) as number;
+    }
+    set next_page_number(value: number) {
+        pb_1.Message.setField(this, 2, value);
+    }
+    get unread_count() {
+        return pb_1.Message.getFieldWithDefault(this, 3, 0) as number;
+    }
+    set unread_count(value: number) {
+        pb_1.Message.setField(this, 3, value);
+    }
+    static fromObject(data: {
+        notifications?: ReturnType<typeof Notification.prototype.toObject>[];
+        next_page_number?: number;
+        unread_count?: number;
+    }): ListNotificationsResponse {
+        const message = new ListNotificationsResponse({});
+        if (data.notifications != null) {
+            message.notifications = data.notifications.map(item => Notification.fromObject(item));
+        }
+        if (data.next_page_number != null) {
+            message.next_page_number = data.next_page_number;
+        }
+        if (data.unread_count != null) {
+            message.unread_count = data.unread_count;
+        }
+        return message;
+    }
+    toObject() {
+        const data: {
+            notifications?: ReturnType<typeof Notification.prototype.toObject>[];
+            next_page_number?: number;
+            unread_count?: number;
+        } = {};
+        if (this.notifications != null) {
+            data.notifications = this.notifications.map((item: Notification) => item.toObject());
+        }
+        if (this.next_page_number != null) {
+            data.next_page_number = this.next_page_number;
+        }
+        if (this.unread_count != null) {
+            data.unread_count = this.unread_count;
+        }
+        return data;
+    }
+    serialize(): Uint8Array;
+    serialize(w: pb_1.BinaryWriter): void;
+    serialize(w?: pb_1.BinaryWriter): Uint8Array | void {
+        const writer = w || new pb_1.BinaryWriter();
+        if (this.notifications.length)
+            writer.writeRepeatedMessage(1, this.notifications, (item: Notification) => item.serialize(writer));
+        if (this.next_page_number != 0)
+            writer.writeInt32(2, this.next_page_number);
+        if (this.unread_count != 0)
+            writer.writeInt32(3, this.unread_count);
+        if (!w)
+            return writer.getResultBuffer();
+    }
+    static deserialize(bytes: Uint8Array | pb_1.BinaryReader): ListNotificationsResponse {
+        const reader = bytes instanceof pb_1.BinaryReader ? bytes : new pb_1.BinaryReader(bytes), message = new ListNotificationsResponse();
+        while (reader.nextField()) {
+            if (reader.isEndGroup())
+                break;
+            switch (reader.getFieldNumber()) {
+                case 1:
+                    reader.readMessage(message.notifications, () => pb_1.Message.addToRepeatedWrapperField(message, 1, Notification.deserialize(reader), Notification));
+                    break;
+                case 2:
+                    message.next_page_number = reader.readInt32();
+                    break;
+                case 3:
+                    message.unread_count = reader.readInt32();
+                    break;
+                default: reader.skipField();
+            }
+        }
+        return message;
+    }
+    serializeBinary(): Uint8Array {
+        return this.serialize();
+    }
+    static deserializeBinary(bytes: Uint8Array): ListNotificationsResponse {
+        return ListNotificationsResponse.deserialize(bytes);
+    }
+}
+export class MarkReadRequest extends pb_1.Message {
+    #one_of_decls: number[][] = [];
+    constructor(data?: any[] | {
+        notification_ids?: string[];
+    }) {
+        super();
+        pb_1.Message.initialize(this, Array.isArray(data) ? data : [], 0, -1, [1], this.#one_of_decls);
+        if (!Array.isArray(data) && typeof data == "object") {
+            if ("notification_ids" in data && data.notification_ids != undefined) {
+                this.notification_ids = data.notification_ids;
+            }
+        }
+    }
+    get notification_ids() {
+        return pb_1.Message.getFieldWithDefault(this, 1, []) as string[];
+    }
+    set notification_ids(value: string[]) {
+        pb_1.Message.setField(this, 1, value);
+    }
+    static fromObject(data: {
+        notification_ids?: string[];
+    }): MarkReadRequest {
+        const message = new MarkReadRequest({});
+        if (data.notification_ids != null) {
+            message.notification_ids = data.notification_ids;
+        }
+        return message;
+    }
+    toObject() {
+        const data: {
+            notification_ids?: string[];
+        } = {};
+        if (this.notification_ids != null) {
+            data.notification_ids = this.notification_ids;
+        }
+        return data;
+    }
+    serialize(): Uint8Array;
+    serialize(w: pb_1.BinaryWriter): void;
+    serialize(w?: pb_1.BinaryWriter): Uint8Array | void {
+        const writer = w || new pb_1.BinaryWriter();
+        if (this.notification_ids.length)
+            writer.writeRepeatedString(1, this.notification_ids);
+        if (!w)
+            return writer.getResultBuffer();
+    }
+    static deserialize(bytes: Uint8Array | pb_1.BinaryReader): MarkReadRequest {
+        const reader = bytes instanceof pb_1.BinaryReader ? bytes : new pb_1.BinaryReader(bytes), message = new MarkReadRequest();
+        while (reader.nextField()) {
+            if (reader.isEndGroup())
+                break;
+            switch (reader.getFieldNumber()) {
+                case 1:
+                    pb_1.Message.addToRepeatedField(message, 1, reader.readString());
+                    break;
+                default: reader.skipField();
+            }
+        }
+        return message;
+    }
+    serializeBinary(): Uint8Array {
+        return this.serialize();
+    }
+    static deserializeBinary(bytes: Uint8Array): MarkReadRequest {
+        return MarkReadRequest.deserialize(bytes);
+    }
+}
+export class MarkAllReadRequest extends pb_1.Message {
+    #one_of_decls: number[][] = [];
+    constructor(data?: any[] | {}) {
+        super();
+        pb_1.Message.initialize(this, Array.isArray(data) ? data : [], 0, -1, [], this.#one_of_decls);
+        if (!Array.isArray(data) && typeof data == "object") { }
+    }
+    static fromObject(data: {}): MarkAllReadRequest {
+        const message = new MarkAllReadRequest({});
+        return message;
+    }
+    toObject() {
+        const data: {} = {};
+        return data;
+    }
+    serialize(): Uint8Array;
+    serialize(w: pb_1.BinaryWriter): void;
+    serialize(w?: pb_1.BinaryWriter): Uint8Array | void {
+        const writer = w || new pb_1.BinaryWriter();
+        if (!w)
+            return writer.getResultBuffer();
+    }
+    static deserialize(bytes: Uint8Array | pb_1.BinaryReader): MarkAllReadRequest {
+        const reader = bytes instanceof pb_1.BinaryReader ? bytes : new pb_1.BinaryReader(bytes), message = new MarkAllReadRequest();
+        while (reader.nextField()) {
+            if (reader.isEndGroup())
+                break;
+            switch (reader.getFieldNumber()) {
+                default: reader.skipField();
+            }
+        }
+        return message;
+    }
+    serializeBinary(): Uint8Array {
+        return this.serialize();
+    }
+    static deserializeBinary(bytes: Uint8Array): MarkAllReadRequest {
+        return MarkAllReadRequest.deserialize(bytes);
+    }
+}
+export class MarkReadResponse extends pb_1.Message {
+    #one_of_decls: number[][] = [];
+    constructor(data?: any[] | {
+        unread_count?: number;
+    }) {
+        super();
+        pb_1.Message.initialize(this, Array.isArray(data) ? data : [], 0, -1, [], this.#one_of_decls);
+        if (!Array.isArray(data) && typeof data == "object") {
+            if ("unread_count" in data && data.unread_count != undefined) {
+                this.unread_count = data.unread_count;
+            }
+        }
+    }
+    get unread_count() {
+        return pb_1.Message.getFieldWithDefault(this, 1, 0) as number;
+    }
+    set unread_count(value: number) {
+        pb_1.Message.setField(this, 1, value);
+    }
+    static fromObject(data: {
+        unread_count?: number;
+    }): MarkReadResponse {
+        const message = new MarkReadResponse({});
+        if (data.unread_count != null) {
+            message.unread_count = data.unread_count;
+        }
+        return message;
+    }
+    toObject() {
+        const data: {
+            unread_count?: number;
+        } = {};
+        if (this.unread_count != null) {
+            data.unread_count = this.unread_count;
+        }
+        return data;
+    }
+    serialize(): Uint8Array;
+    serialize(w: pb_1.BinaryWriter): void;
+    serialize(w?: pb_1.BinaryWriter): Uint8Array | void {
+        const writer = w || new pb_1.BinaryWriter();
+        if (this.unread_count != 0)
+            writer.writeInt32(1, this.unread_count);
+        if (!w)
+            return writer.getResultBuffer();
+    }
+    static deserialize(bytes: Uint8Array | pb_1.BinaryReader): MarkReadResponse {
+        const reader = bytes instanceof pb_1.BinaryReader ? bytes : new pb_1.BinaryReader(bytes), message = new MarkReadResponse();
+        while (reader.nextField()) {
+            if (reader.isEndGroup())
+                break;
+            switch (reader.getFieldNumber()) {
+                case 1:
+                    message.unread_count = reader.readInt32();
+                    break;
+                default: reader.skipField();
+            }
+        }
+        return message;
+    }
+    serializeBinary(): Uint8Array {
+        return this.serialize();
+    }
+    static deserializeBinary(bytes: Uint8Array): MarkReadResponse {
+        return MarkReadResponse.deserialize(bytes);
+    }
+}
+export class PublishRequest extends pb_1.Message {
+    #one_of_decls: number[][] = [];
+    constructor(data?: any[] | {
+        tenant_id?: string;
+        recipient_ids?: string[];
+        type?: NotificationType;
+        actor_id?: string;
+        actor_name?: string;
+        message?: string;
+        video_id?: string;
+        channel_id?: string;
+        comment_id?: string;
+    }) {
+        super();
+        pb_1.Message.initialize(this, Array.isArray(data) ? data : [], 0, -1, [2], this.#one_of_decls);
+        if (!Array.isArray(data) && typeof data == "object") {
+            if ("tenant_id" in data && data.tenant_id != undefined) {
+                this.tenant_id = data.tenant_id;
+            }
+            if ("recipient_ids" in data && data.recipient_ids != undefined) {
+                this.recipient_ids = data.recipient_ids;
+            }
+            if ("type" in data && data.type != undefined) {
+                this.type = data.type;
+            }
+            if ("actor_id" in data && data.actor_id != undefined) {
+                this.actor_id = data.actor_id;
+            }
+            if ("actor_name" in data && data.actor_name != undefined) {
+                this.actor_name = data.actor_name;
+            }
+            if ("message" in data && data.message != undefined) {
+                this.message = data.message;
+            }
+            if ("video_id" in data && data.video_id != undefined) {
+                this.video_id = data.video_id;
+            }
+            if ("channel_id" in data && data.channel_id != undefined) {
+                this.channel_id = data.channel_id;
+            }
+            if ("comment_id" in data && data.comment_id != undefined) {
+                this.comment_id = data.comment_id;
+            }
+        }
+    }
+    get tenant_id() {
+        return pb_1.Message.getFieldWithDefault(this, 1, "") as string;
+    }
+    set tenant_id(value: string) {
+        pb_1.Message.setField(this, 1, value);
+    }
+    get recipient_ids() {
+        return pb_1.Message.getFieldWithDefault(this, 2, []) as string[];
+    }
+    set recipient_ids(value: string[]) {
+        pb_1.Message.setField(this, 2, value);
+    }
+    get type() {
+        return pb_1.Message.getFieldWithDefault(this, 3, NotificationType.NOTIFICATION_TYPE_UNSPECIFIED) as NotificationType;
+    }
+    set type(value: NotificationType) {
+        pb_1.Message.setField(this, 3, value);
+    }
+    get actor_id() {
+        return pb_1.Message.getFieldWithDefault(this, 4, "") as string;
+    }
+    set actor_id(value: string) {
+        pb_1.Message.setField(this, 4, value);
+    }
+    get actor_name() {
+        return pb_1.Message.getFieldWithDefault(this, 5, "") as string;
+    }
+    set actor_name(value: string) {
+        pb_1.Message.setField(this, 5, value);
+    }
+    get message() {
+        return pb_1.Message.getFieldWithDefault(this, 6, "") as string;
+    }
+    set message(value: string) {
+        pb_1.Message.setField(this, 6, value);
+    }
+    get video_id() {
+        return pb_1.Message.getFieldWithDefault(this, 7, "") as string;
+    }
+    set video_id(value: string) {
+        pb_1.Message.setField(this, 7, value);
+    }
+    get channel_id() {
+        return pb_1.Message.getFieldWithDefault(this, 8, "") as string;
+    }
+    set channel_id(value: string) {
+        pb_1.Message.setField(this, 8, value);
+    }
+    get comment_id() {
+        return pb_1.Message.getFieldWithDefault(this, 9, "") as string;
+    }
+    set comment_id(value: string) {
+        pb_1.Message.setField(this, 9, value);
+    }
+    static fromObject(data: {
+        tenant_id?: string;
+        recipient_ids?: string[];
+        type?: NotificationType;
+        actor_id?: string;
+        actor_name?: string;
+        message?: string;
+        video_id?: string;
+        channel_id?: string;
+        comment_id?: string;
+    }): PublishRequest {
+        const message = new PublishRequest({});
+        if (data.tenant_id != null) {
+            message.tenant_id = data.tenant_id;
+        }
+        if (data.recipient_ids != null) {
+            message.recipient_ids = data.recipient_ids;
+        }
+        if (data.type != null) {
+            message.type = data.type;
+        }
+        if (data.actor_id != null) {
+            message.actor_id = data.actor_id;
+        }
+        if (data.actor_name != null) {
+            message.actor_name = data.actor_name;
+        }
+        if (data.message != null) {
+            message.message = data.message;
+        }
+        if (data.video_id != null) {
+            message.video_id = data.video_id;
+        }
+        if (data.channel_id != null) {
+            message.channel_id = data.channel_id;
+        }
+        if (data.comment_id != null) {
+            message.comment_id = data.comment_id;
+        }
+        return message;
+    }
+    toObject() {
+        const data: {
+            tenant_id?: string;
+            recipient_ids?: string[];
+            type?: NotificationType;
+            actor_id?: string;
+            actor_name?: string;
+            message?: string;
+            video_id?: string;
+            channel_id?: string;
+            comment_id?: string;
+        } = {};
+        if (this.tenant_id != null) {
+            data.tenant_id = this.tenant_id;
+        }
+        if (this.recipient_ids != null) {
+            data.recipient_ids = this.recipient_ids;
+        }
+        if (this.type != null) {
+            data.type = this.type;
+        }
+        if (this.actor_id != null) {
+            data.actor_id = this.actor_id;
+        }
+        if (this.actor_name != null) {
+            data.actor_name = this.actor_name;
+        }
+        if (this.message != null) {
+            data.message = this.message;
+        }
+        if (this.video_id != null) {
+            data.video_id = this.video_id;
+        }
+        if (this.channel_id != null) {
+            data.channel_id = this.channel_id;
+        }
+        if (this.comment_id != null) {
+            data.comment_id = this.comment_id;
+        }
+        return data;
+    }
+    serialize(): Uint8Array;
+    serialize(w: pb_1.BinaryWriter): void;
+    serialize(w?: pb_1.BinaryWriter): Uint8Array | void {
+        const writer = w || new pb_1.BinaryWriter();
+        if (this.tenant_id.length)
+            writer.writeString(1, this.tenant_id);
+        if (this.recipient_ids.length)
+            writer.writeRepeatedString(2, this.recipient_ids);
+        if (this.type != NotificationType.NOTIFICATION_TYPE_UNSPECIFIED)
+            writer.writeEnum(3, this.type);
+        if (this.actor_id.length)
+            writer.writeString(4, this.actor_id);
+        if (this.actor_name.length)
+            writer.writeString(5, this.actor_name);
+        if (this.message.length)
+            writer.writeString(6, this.message);
+        if (this.video_id.length)
+            writer.writeString(7, this.video_id);
+        if (this.channel_id.length)
+            writer.writeString(8, this.channel_id);
+        if (this.comment_id.length)
+            writer.writeString(9, this.comment_id);
+        if (!w)
+            return writer.getResultBuffer();
+    }
+    static deserialize(bytes: Uint8Array | pb_1.BinaryReader): PublishRequest {
+        const reader = bytes instanceof pb_1.BinaryReader ? bytes : new pb_1.BinaryReader(bytes), message = new PublishRequest();
+        while (reader.nextField()) {
+            if (reader.isEndGroup())
+                break;
+            switch (reader.getFieldNumber()) {
+                case 1:
+                    message.tenant_id = reader.readString();
+                    break;
+                case 2:
+                    pb_1.Message.addToRepeatedField(message, 2, reader.readString());
+                    break;
+                case 3:
+                    message.type = reader.readEnum();
+                    break;
+                case 4:
+                    message.actor_id = reader.readString();
+                    break;
+                case 5:
+                    message.actor_name = reader.readString();
+                    break;
+                case 6:
+                    message.message = reader.readString();
+                    break;
+                case 7:
+                    message.video_id = reader.readString();
+                    break;
+                case 8:
+                    message.channel_id = reader.readString();
+                    break;
+                case 9:
+                    message.comment_id = reader.readString();
+                    break;
+                default: reader.skipField();
+            }
+        }
+        return message;
+    }
+    serializeBinary(): Uint8Array {
+        return this.serialize();
+    }
+    static deserializeBinary(bytes: Uint8Array): PublishRequest {
+        return PublishRequest.deserialize(bytes);
+    }
+}
+export class PublishResponse extends pb_1.Message {
+    #one_of_decls: number[][] = [];
+    constructor(data?: any[] | {
+        delivered_count?: number;
+    }) {
+        super();
+        pb_1.Message.initialize(this, Array.isArray(data) ? data : [], 0, -1, [], this.#one_of_decls);
+        if (!Array.isArray(data) && typeof data == "object") {
+            if ("delivered_count" in data && data.delivered_count != undefined) {
+                this.delivered_count = data.delivered_count;
+            }
+        }
+    }
+    get delivered_count() {
+        return pb_1.Message.getFieldWithDefault(this, 1, 0) as number;
+    }
+    set delivered_count(value: number) {
+        pb_1.Message.setField(this, 1, value);
+    }
+    static fromObject(data: {
+        delivered_count?: number;
+    }): PublishResponse {
+        const message = new PublishResponse({});
+        if (data.delivered_count != null) {
+            message.delivered_count = data.delivered_count;
+        }
+        return message;
+    }
+    toObject() {
+        const data: {
+            delivered_count?: number;
+        } = {};
+        if (this.delivered_count != null) {
+            data.delivered_count = this.delivered_count;
+        }
+        return data;
+    }
+    serialize(): Uint8Array;
+    serialize(w: pb_1.BinaryWriter): void;
+    serialize(w?: pb_1.BinaryWriter): Uint8Array | void {
+        const writer = w || new pb_1.BinaryWriter();
+        if (this.delivered_count != 0)
+            writer.writeInt32(1, this.delivered_count);
+        if (!w)
+            return writer.getResultBuffer();
+    }
+    static deserialize(bytes: Uint8Array | pb_1.BinaryReader): PublishResponse {
+        const reader = bytes instanceof pb_1.BinaryReader ? bytes : new pb_1.BinaryReader(bytes), message = new PublishResponse();
+        while (reader.nextField()) {
+            if (reader.isEndGroup())
+                break;
+            switch (reader.getFieldNumber()) {
+                case 1:
+                    message.delivered_count = reader.readInt32();
+                    break;
+                default: reader.skipField();
+            }
+        }
+        return message;
+    }
+    serializeBinary(): Uint8Array {
+        return this.serialize();
+    }
+    static deserializeBinary(bytes: Uint8Array): PublishResponse {
+        return PublishResponse.deserialize(bytes);
+    }
+}
+export class SendInvitationRequest extends pb_1.Message {
+    #one_of_decls: number[][] = [];
+    constructor(data?: any[] | {
+        tenant_id?: string;
+        email?: string;
+        tenant_name?: string;
+        actor_name?: string;
+        token?: string;
+    }) {
+        super();
+        pb_1.Message.initialize(this, Array.isArray(data) ? data : [], 0, -1, [], this.#one_of_decls);
+        if (!Array.isArray(data) && typeof data == "object") {
+            if ("tenant_id" in data && data.tenant_id != undefined) {
+                this.tenant_id = data.tenant_id;
+            }
+            if ("email" in data && data.email != undefined) {
+                this.email = data.email;
+            }
+            if ("tenant_name" in data && data.tenant_name != undefined) {
+                this.tenant_name = data.tenant_name;
+            }
+            if ("actor_name" in data && data.actor_name != undefined) {
+                this.actor_name = data.actor_name;
+            }
+            if ("token" in data && data.token != undefined) {
+                this.token = data.token;
+            }
+        }
+    }
+    get tenant_id() {
+        return pb_1.Message.getFieldWithDefault(this, 1, "") as string;
+    }
+    set tenant_id(value: string) {
+        pb_1.Message.setField(this, 1, value);
+    }
+    get email() {
+        return pb_1.Message.getFieldWithDefault(this, 2, "") as string;
+    }
+    set email(value: string) {
+        pb_1.Message.setField(this, 2, value);
+    }
+    get tenant_name() {
+        return pb_1.Message.getFieldWithDefault(this, 3, "") as string;
+    }
+    set tenant_name(value: string) {
+        pb_1.Message.setField(this, 3, value);
+    }
+    get actor_name() {
+        return pb_1.Message.getFieldWithDefault(this, 4, "") as string;
+    }
+    set actor_name(value: string) {
+        pb_1.Message.setField(this, 4, value);
+    }
+    get token() {
+        return pb_1.Message.getFieldWithDefault(this, 5, "") as string;
+    }
+    set token(value: string) {
+        pb_1.Message.setField(this, 5, value);
+    }
+    static fromObject(data: {
+        tenant_id?: string;
+        email?: string;
+        tenant_name?: string;
+        actor_name?: string;
+        token?: string;
+    }): SendInvitationRequest {
+        const message = new SendInvitationRequest({});
+        if (data.tenant_id != null) {
+            message.tenant_id = data.tenant_id;
+        }
+        if (data.email != null) {
+            message.email = data.email;
+        }
+        if (data.tenant_name != null) {
+            message.tenant_name = data.tenant_name;
+        }
+        if (data.actor_name != null) {
+            message.actor_name = data.actor_name;
+        }
+        if (data.token != null) {
+            message.token = data.token;
+        }
+        return message;
+    }
+    toObject() {
+        const data: {
+            tenant_id?: string;
+            email?: string;
+            tenant_name?: string;
+            actor_name?: string;
+            token?: string;
+        } = {};
+        if (this.tenant_id != null) {
+            data.tenant_id = this.tenant_id;
+        }
+        if (this.email != null) {
+            data.email = this.email;
+        }
+        if (this.tenant_name != null) {
+            data.tenant_name = this.tenant_name;
+        }
+        if (this.actor_name != null) {
+            data.actor_name = this.actor_name;
+        }
+        if (this.token != null) {
+            data.token = this.token;
+        }
+        return data;
+    }
+    serialize(): Uint8Array;
+    serialize(w: pb_1.BinaryWriter): void;
+    serialize(w?: pb_1.BinaryWriter): Uint8Array | void {
+        const writer = w || new pb_1.BinaryWriter();
+        if (this.tenant_id.length)
+            writer.writeString(1, this.tenant_id);
+        if (this.email.length)
+            writer.writeString(2, this.email);
+        if (this.tenant_name.length)
+            writer.writeString(3, this.tenant_name);
+        if (this.actor_name.length)
+            writer.writeString(4, this.actor_name);
+        if (this.token.length)
+            writer.writeString(5, this.token);
+        if (!w)
+            return writer.getResultBuffer();
+    }
+    static deserialize(bytes: Uint8Array | pb_1.BinaryReader): SendInvitationRequest {
+        const reader = bytes instanceof pb_1.BinaryReader ? bytes : new pb_1.BinaryReader(bytes), message = new SendInvitationRequest();
+        while (reader.nextField()) {
+            if (reader.isEndGroup())
+                break;
+            switch (reader.getFieldNumber()) {
+                case 1:
+                    message.tenant_id = reader.readString();
+                    break;
+                case 2:
+                    message.email = reader.readString();
+                    break;
+                case 3:
+                    message.tenant_name = reader.readString();
+                    break;
+                case 4:
+                    message.actor_name = reader.readString();
+                    break;
+                case 5:
+                    message.token = reader.readString();
+                    break;
+                default: reader.skipField();
+            }
+        }
+        return message;
+    }
+    serializeBinary(): Uint8Array {
+        return this.serialize();
+    }
+    static deserializeBinary(bytes: Uint8Array): SendInvitationRequest {
+        return SendInvitationRequest.deserialize(bytes);
+    }
+}
+export class SendInvitationResponse extends pb_1.Message {
+    #one_of_decls: number[][] = [];
+    constructor(data?: any[] | {
+        queued?: boolean;
+    }) {
+        super();
+        pb_1.Message.initialize(this, Array.isArray(data) ? data : [], 0, -1, [], this.#one_of_decls);
+        if (!Array.isArray(data) && typeof data == "object") {
+            if ("queued" in data && data.queued != undefined) {
+                this.queued = data.queued;
+            }
+        }
+    }
+    get queued() {
+        return pb_1.Message.getFieldWithDefault(this, 1, false) as boolean;
+    }
+    set queued(value: boolean) {
+        pb_1.Message.setField(this, 1, value);
+    }
+    static fromObject(data: {
+        queued?: boolean;
+    }): SendInvitationResponse {
+        const message = new SendInvitationResponse({});
+        if (data.queued != null) {
+            message.queued = data.queued;
+        }
+        return message;
+    }
+    toObject() {
+        const data: {
+            queued?: boolean;
+        } = {};
+        if (this.queued != null) {
+            data.queued = this.queued;
+        }
+        return data;
+    }
+    serialize(): Uint8Array;
+    serialize(w: pb_1.BinaryWriter): void;
+    serialize(w?: pb_1.BinaryWriter): Uint8Array | void {
+        const writer = w || new pb_1.BinaryWriter();
+        if (this.queued != false)
+            writer.writeBool(1, this.queued);
+        if (!w)
+            return writer.getResultBuffer();
+    }
+    static deserialize(bytes: Uint8Array | pb_1.BinaryReader): SendInvitationResponse {
+        const reader = bytes instanceof pb_1.BinaryReader ? bytes : new pb_1.BinaryReader(bytes), message = new SendInvitationResponse();
+        while (reader.nextField()) {
+            if (reader.isEndGroup())
+                break;
+            switch (reader.getFieldNumber()) {
+                case 1:
+                    message.queued = reader.readBool();
+                    break;
+                default: reader.skipField();
+            }
+        }
+        return message;
+    }
+    serializeBinary(): Uint8Array {
+        return this.serialize();
+    }
+    static deserializeBinary(bytes: Uint8Array): SendInvitationResponse {
+        return SendInvitationResponse.deserialize(bytes);
+    }
+}
+export class GetEmailPreferencesRequest extends pb_1.Message {
+    #one_of_decls: number[][] = [];
+    constructor(data?: any[] | {}) {
+        super();
+        pb_1.Message.initialize(this, Array.isArray(data) ? data : [], 0, -1, [], this.#one_of_decls);
+        if (!Array.isArray(data) && typeof data == "object") { }
+    }
+    static fromObject(data: {}): GetEmailPreferencesRequest {
+        const message = new GetEmailPreferencesRequest({});
+        return message;
+    }
+    toObject() {
+        const data: {} = {};
+        return data;
+    }
+    serialize(): Uint8Array;
+    serialize(w: pb_1.BinaryWriter): void;
+    serialize(w?: pb_1.BinaryWriter): Uint8Array | void {
+        const writer = w || new pb_1.BinaryWriter();
+        if (!w)
+            return writer.getResultBuffer();
+    }
+    static deserialize(bytes: Uint8Array | pb_1.BinaryReader): GetEmailPreferencesRequest {
+        const reader = bytes instanceof pb_1.BinaryReader ? bytes : new pb_1.BinaryReader(bytes), message = new GetEmailPreferencesRequest();
+        while (reader.nextField()) {
+            if (reader.isEndGroup())
+                break;
+            switch (reader.getFieldNumber()) {
+                default: reader.skipField();
+            }
+        }
+        return message;
+    }
+    serializeBinary(): Uint8Array {
+        return this.serialize();
+    }
+    static deserializeBinary(bytes: Uint8Array): GetEmailPreferencesRequest {
+        return GetEmailPreferencesRequest.deserialize(bytes);
+    }
+}
+export class EmailPreferences extends pb_1.Message {
+    #one_of_decls: number[][] = [];
+    constructor(data?: any[] | {
+        replies?: boolean;
+        mentions?: boolean;
+        invitations?: boolean;
+        digest_frequency?: DigestFrequency;
+    }) {
+        super();
+        pb_1.Message.initialize(this, Array.isArray(data) ? data : [], 0, -1, [], this.#one_of_decls);
+        if (!Array.isArray(data) && typeof data == "object") {
+            if ("replies" in data && data.replies != undefined) {
+                this.replies = data.replies;
+            }
+            if ("mentions" in data && data.mentions != undefined) {
+                this.mentions = data.mentions;
+            }
+            if ("invitations" in data && data.invitations != undefined) {
+                this.invitations = data.invitations;
+            }
+            if ("digest_frequency" in data && data.digest_frequency != undefined) {
+                this.digest_frequency = data.digest_frequency;
+            }
+        }
+    }
+    get replies() {
+        return pb_1.Message.getFieldWithDefault(this, 1, false) as boolean;
+    }
+    set replies(value: boolean) {
+        pb_1.Message.setField(this, 1, value);
+    }
+    get mentions() {
+        return pb_1.Message.getFieldWithDefault(this, 2, false) as boolean;
+    }
+    set mentions(value: boolean) {
+        pb_1.Message.setField(this, 2, value);
+    }
+    get invitations() {
+        return pb_1.Message.getFieldWithDefault(this, 3, false) as boolean;
+    }
+    set invitations(value: boolean) {
+        pb_1.Message.setField(this, 3, value);
+    }
+    get digest_frequency() {
+        return pb_1.Message.getFieldWithDefault(this, 4, DigestFrequency.DIGEST_FREQUENCY_WEEKLY) as DigestFrequency;
+    }
+    set digest_frequency(value: DigestFrequency) {
+        pb_1.Message.setField(this, 4, value);
+    }
+    static fromObject(data: {
+        replies?: boolean;
+        mentions?: boolean;
+        invitations?: boolean;
+        digest_frequency?: DigestFrequency;
+    }): EmailPreferences {
+        const message = new EmailPreferences({});
+        if (data.replies != null) {
+            message.replies = data.replies;
+        }
+        if (data.mentions != null) {
+            message.mentions = data.mentions;
+        }
+        if (data.invitations != null) {
+            message.invitations = data.invitations;
+        }
+        if (data.digest_frequency != null) {
+            message.digest_frequency = data.digest_frequency;
+        }
+        return message;
+    }
+    toObject() {
+        const data: {
+            replies?: boolean;
+            mentions?: boolean;
+            invitations?: boolean;
+            digest_frequency?: DigestFrequency;
+        } = {};
+        if (this.replies != null) {
+            data.replies = this.replies;
+        }
+        if (this.mentions != null) {
+            data.mentions = this.mentions;
+        }
+        if (this.invitations != null) {
+            data.invitations = this.invitations;
+        }
+        if (this.digest_frequency != null) {
+            data.digest_frequency = this.digest_frequency;
+        }
+        return data;
+    }
+    serialize(): Uint8Array;
+    serialize(w: pb_1.BinaryWriter): void;
+    serialize(w?: pb_1.BinaryWriter): Uint8Array | void {
+        const writer = w || new pb_1.BinaryWriter();
+        if (this.replies != false)
+            writer.writeBool(1, this.replies);
+        if (this.mentions != false)
+            writer.writeBool(2, this.mentions);
+        if (this.invitations != false)
+            writer.writeBool(3, this.invitations);
+        if (this.digest_frequency != DigestFrequency.DIGEST_FREQUENCY_WEEKLY)
+            writer.writeEnum(4, this.digest_frequency);
+        if (!w)
+            return writer.getResultBuffer();
+    }
+    static deserialize(bytes: Uint8Array | pb_1.BinaryReader): EmailPreferences {
+        const reader = bytes instanceof pb_1.BinaryReader ? bytes : new pb_1.BinaryReader(bytes), message = new EmailPreferences();
+        while (reader.nextField()) {
+            if (reader.isEndGroup())
+                break;
+            switch (reader.getFieldNumber()) {
+                case 1:
+                    message.replies = reader.readBool();
+                    break;
+                case 2:
+                    message.mentions = reader.readBool();
+                    break;
+                case 3:
+                    message.invitations = reader.readBool();
+                    break;
+                case 4:
+                    message.digest_frequency = reader.readEnum();
+                    break;
+                default: reader.skipField();
+            }
+        }
+        return message;
+    }
+    serializeBinary(): Uint8Array {
+        return this.serialize();
+    }
+    static deserializeBinary(bytes: Uint8Array): EmailPreferences {
+        return EmailPreferences.deserialize(bytes);
+    }
+}
+export class UpdateEmailPreferencesRequest extends pb_1.Message {
+    #one_of_decls: number[][] = [];
+    constructor(data?: any[] | {
+        preferences?: EmailPreferences;
+    }) {
+        super();
+        pb_1.Message.initialize(this, Array.isArray(data) ? data : [], 0, -1, [], this.#one_of_decls);
+        if (!Array.isArray(data) && typeof data == "object") {
+            if ("preferences" in data && data.preferences != undefined) {
+                this.preferences = data.preferences;
+            }
+        }
+    }
+    get preferences() {
+        return pb_1.Message.getWrapperField(this, EmailPreferences, 1) as EmailPreferences;
+    }
+    set preferences(value: EmailPreferences) {
+        pb_1.Message.setWrapperField(this, 1, value);
+    }
+    get has_preferences() {
+        return pb_1.Message.getField(this, 1) != null;
+    }
+    static fromObject(data: {
+        preferences?: ReturnType<typeof EmailPreferences.prototype.toObject>;
+    }): UpdateEmailPreferencesRequest {
+        const message = new UpdateEmailPreferencesRequest({});
+        if (data.preferences != null) {
+            message.preferences = EmailPreferences.fromObject(data.preferences);
+        }
+        return message;
+    }
+    toObject() {
+        const data: {
+            preferences?: ReturnType<typeof EmailPreferences.prototype.toObject>;
+        } = {};
+        if (this.preferences != null) {
+            data.preferences = this.preferences.toObject();
+        }
+        return data;
+    }
+    serialize(): Uint8Array;
+    serialize(w: pb_1.BinaryWriter): void;
+    serialize(w?: pb_1.BinaryWriter): Uint8Array | void {
+        const writer = w || new pb_1.BinaryWriter();
+        if (this.has_preferences)
+            writer.writeMessage(1, this.preferences, () => this.preferences.serialize(writer));
+        if (!w)
+            return writer.getResultBuffer();
+    }
+    static deserialize(bytes: Uint8Array | pb_1.BinaryReader): UpdateEmailPreferencesRequest {
+        const reader = bytes instanceof pb_1.BinaryReader ? bytes : new pb_1.BinaryReader(bytes), message = new UpdateEmailPreferencesRequest();
+        while (reader.nextField()) {
+            if (reader.isEndGroup())
+                break;
+            switch (reader.getFieldNumber()) {
+                case 1:
+                    reader.readMessage(message.preferences, () => message.preferences = EmailPreferences.deserialize(reader));
+                    break;
+                default: reader.skipField();
+            }
+        }
+        return message;
+    }
+    serializeBinary(): Uint8Array {
+        return this.serialize();
+    }
+    static deserializeBinary(bytes: Uint8Array): UpdateEmailPreferencesRequest {
+        return UpdateEmailPreferencesRequest.deserialize(bytes);
+    }
+}
+export class PublishEventRequest extends pb_1.Message {
+    #one_of_decls: number[][] = [];
+    constructor(data?: any[] | {
+        tenant_id?: string;
+        type?: EventType;
+        actor_id?: string;
+        data?: Map<string, string>;
+        audience_user_ids?: string[];
+    }) {
+        super();
+        pb_1.Message.initialize(this, Array.isArray(data) ? data : [], 0, -1, [5], this.#one_of_decls);
+        if (!Array.isArray(data) && typeof data == "object") {
+            if ("tenant_id" in data && data.tenant_id != undefined) {
+                this.tenant_id = data.tenant_id;
+            }
+            if ("type" in data && data.type != undefined) {
+                this.type = data.type;
+            }
+            if ("actor_id" in data && data.actor_id != undefined) {
+                this.actor_id = data.actor_id;
+            }
+            if ("data" in data && data.data != undefined) {
+                this.data = data.data;
+            }
+            if ("audience_user_ids" in data && data.audience_user_ids != undefined) {
+                this.audience_user_ids = data.audience_user_ids;
+            }
+        }
+        if (!this.data)
+            this.data = new Map();
+    }
+    get tenant_id() {
+        return pb_1.Message.getFieldWithDefault(this, 1, "") as string;
+    }
+    set tenant_id(value: string) {
+        pb_1.Message.setField(this, 1, value);
+    }
+    get type() {
+        return pb_1.Message.getFieldWithDefault(this, 2, EventType.EVENT_TYPE_UNSPECIFIED) as EventType;
+    }
+    set type(value: EventType) {
+        pb_1.Message.setField(this, 2, value);
+    }
+    get actor_id() {
+        return pb_1.Message.getFieldWithDefault(this, 3, "") as string;
+    }
+    set actor_id(value: string) {
+        pb_1.Message.setField(this, 3, value);
+    }
+    get data() {
+        return pb_1.Message.getField(this, 4) as any as Map<string, string>;
+    }
+    set data(value: Map<string, string>) {
+        pb_1.Message.setField(this, 4, value as any);
+    }
+    get audience_user_ids() {
+        return pb_1.Message.getFieldWithDefault(this, 5, []) as string[];
+    }
+    set audience_user_ids(value: string[]) {
+        pb_1.Message.setField(this, 5, value);
+    }
+    static fromObject(data: {
+        tenant_id?: string;
+        type?: EventType;
+        actor_id?: string;
+        data?: {
+            [key: string]: string;
+        };
+        audience_user_ids?: string[];
+    }): PublishEventRequest {
+        const message = new PublishEventRequest({});
+        if (data.tenant_id != null) {
+            message.tenant_id = data.tenant_id;
+        }
+        if (data.type != null) {
+            message.type = data.type;
+        }
+        if (data.actor_id != null) {
+            message.actor_id = data.actor_id;
+        }
+        if (typeof data.data == "object") {
+            message.data = new Map(Object.entries(data.data));
+        }
+        if (data.audience_user_ids != null) {
+            message.audience_user_ids = data.audience_user_ids;
+        }
+        return message;
+    }
+    toObject() {
+        const data: {
+            tenant_id?: string;
+            type?: EventType;
+            actor_id?: string;
+            data?: {
+                [key: string]: string;
+            };
+            audience_user_ids?: string[];
+        } = {};
+        if (this.tenant_id != null) {
+            data.tenant_id = this.tenant_id;
+        }
+        if (this.type != null) {
+            data.type = this.type;
+        }
+        if (this.actor_id != null) {
+            data.actor_id = this.actor_id;
+        }
+        if (this.data != null) {
+            data.data = (Object.fromEntries)(this.data);
+        }
+        if (this.audience_user_ids != null) {
+            data.audience_user_ids = this.audience_user_ids;
+        }
+        return data;
+    }
+    serialize(): Uint8Array;
+    serialize(w: pb_1.BinaryWriter): void;
+    serialize(w?: pb_1.BinaryWriter): Uint8Array | void {
+        const writer = w || new pb_1.BinaryWriter();
+        if (this.tenant_id.length)
+            writer.writeString(1, this.tenant_id);
+        if (this.type != EventType.EVENT_TYPE_UNSPECIFIED)
+            writer.writeEnum(2, this.type);
+        if (this.actor_id.length)
+            writer.writeString(3, this.actor_id);
+        for (const [key, value] of this.data) {
+            writer.writeMessage(4, this.data, () => {
+                writer.writeString(1, key);
+                writer.writeString(2, value);
+            });
+        }
+        if (this.audience_user_ids.length)
+            writer.writeRepeatedString(5, this.audience_user_ids);
+        if (!w)
+            return writer.getResultBuffer();
+    }
+    static deserialize(bytes: Uint8Array | pb_1.BinaryReader): PublishEventRequest {
+        const reader = bytes instanceof pb_1.BinaryReader ? bytes : new pb_1.BinaryReader(bytes), message = new PublishEventRequest();
+        while (reader.nextField()) {
+            if (reader.isEndGroup())
+                break;
+            switch (reader.getFieldNumber()) {
+                case 1:
+                    message.tenant_id = reader.readString();
+                    break;
+                case 2:
+                    message.type = reader.readEnum();
+                    break;
+                case 3:
+                    message.actor_id = reader.readString();
+                    break;
+                case 4:
+                    reader.readMessage(message, () => pb_1.Map.deserializeBinary(message.data as any, reader, reader.readString, reader.readString));
+                    break;
+                case 5:
+                    pb_1.Message.addToRepeatedField(message, 5, reader.readString());
+                    break;
+                default: reader.skipField();
+            }
+        }
+        return message;
+    }
+    serializeBinary(): Uint8Array {
+        return this.serialize();
+    }
+    static deserializeBinary(bytes: Uint8Array): PublishEventRequest {
+        return PublishEventRequest.deserialize(bytes);
+    }
+}
+export class PublishEventResponse extends pb_1.Message {
+    #one_of_decls: number[][] = [];
+    constructor(data?: any[] | {
+        queued_deliveries?: number;
+    }) {
+        super();
+        pb_1.Message.initialize(this, Array.isArray(data) ? data : [], 0, -1, [], this.#one_of_decls);
+        if (!Array.isArray(data) && typeof data == "object") {
+            if ("queued_deliveries" in data && data.queued_deliveries != undefined) {
+                this.queued_deliveries = data.queued_deliveries;
+            }
+        }
+    }
+    get queued_deliveries() {
+        return pb_1.Message.getFieldWithDefault(this, 1, 0) as number;
+    }
+    set queued_deliveries(value: number) {
+        pb_1.Message.setField(this, 1, value);
+    }
+    static fromObject(data: {
+        queued_deliveries?: number;
+    }): PublishEventResponse {
+        const message = new PublishEventResponse({});
+        if (data.queued_deliveries != null) {
+            message.queued_deliveries = data.queued_deliveries;
+        }
+        return message;
+    }
+    toObject() {
+        const data: {
+            queued_deliveries?: number;
+        } = {};
+        if (this.queued_deliveries != null) {
+            data.queued_deliveries = this.queued_deliveries;
+        }
+        return data;
+    }
+    serialize(): Uint8Array;
+    serialize(w: pb_1.BinaryWriter): void;
+    serialize(w?: pb_1.BinaryWriter): Uint8Array | void {
+        const writer = w || new pb_1.BinaryWriter();
+        if (this.queued_deliveries != 0)
+            writer.writeInt32(1, this.queued_deliveries);
+        if (!w)
+            return writer.getResultBuffer();
+    }
+    static deserialize(bytes: Uint8Array | pb_1.BinaryReader): PublishEventResponse {
+        const reader = bytes instanceof pb_1.BinaryReader ? bytes : new pb_1.BinaryReader(bytes), message = new PublishEventResponse();
+        while (reader.nextField()) {
+            if (reader.isEndGroup())
+                break;
+            switch (reader.getFieldNumber()) {
+                case 1:
+                    message.queued_deliveries = reader.readInt32();
+                    break;
+                default: reader.skipField();
+            }
+        }
+        return message;
+    }
+    serializeBinary(): Uint8Array {
+        return this.serialize();
+    }
+    static deserializeBinary(bytes: Uint8Array): PublishEventResponse {
+        return PublishEventResponse.deserialize(bytes);
+    }
+}
+export class Webhook extends pb_1.Message {
+    #one_of_decls: number[][] = [];
+    constructor(data?: any[] | {
+        id?: string;
+        url?: string;
+        event_types?: EventType[];
+        active?: boolean;
+        created_by?: string;
+        created_at?: dependency_1.Timestamp;
+        updated_at?: dependency_1.Timestamp;
+    }) {
+        super();
+        pb_1.Message.initialize(this, Array.isArray(data) ? data : [], 0, -1, [3], this.#one_of_decls);
+        if (!Array.isArray(data) && typeof data == "object") {
+            if ("id" in data && data.id != undefined) {
+                this.id = data.id;
+            }
+            if ("url" in data && data.url != undefined) {
+                this.url = data.url;
+            }
+            if ("event_types" in data && data.event_types != undefined) {
+                this.event_types = data.event_types;
+            }
+            if ("active" in data && data.active != undefined) {
+                this.active = data.active;
+            }
+            if ("created_by" in data && data.created_by != undefined) {
+                this.created_by = data.created_by;
+            }
+            if ("created_at" in data && data.created_at != undefined) {
+                this.created_at = data.created_at;
+            }
+            if ("updated_at" in data && data.updated_at != undefined) {
+                this.updated_at = data.updated_at;
+            }
+        }
+    }
+    get id() {
+        return pb_1.Message.getFieldWithDefault(this, 1, "") as string;
+    }
+    set id(value: string) {
+        pb_1.Message.setField(this, 1, value);
+    }
+    get url() {
+        return pb_1.Message.getFieldWithDefault(this, 2, "") as string;
+    }
+    set url(value: string) {
+        pb_1.Message.setField(this, 2, value);
+    }
+    get event_types() {
+        return pb_1.Message.getFieldWithDefault(this, 3, []) as EventType[];
+    }
+    set event_types(value: EventType[]) {
+        pb_1.Message.setField(this, 3, value);
+    }
+    get active() {
+        return pb_1.Message.getFieldWithDefault(this, 4, false) as boolean;
+    }
+    set active(value: boolean) {
+        pb_1.Message.setField(this, 4, value);
+    }
+    get created_by() {
+        return pb_1.Message.getFieldWithDefault(this, 5, "") as string;
+    }
+    set created_by(value: string) {
+        pb_1.Message.setField(this, 5, value);
+    }
+    get created_at() {
+        return pb_1.Message.getWrapperField(this, dependency_1.Timestamp, 6) as dependency_1.Timestamp;
+    }
+    set created_at(value: dependency_1.Timestamp) {
+        pb_1.Message.setWrapperField(this, 6, value);
+    }
+    get has_created_at() {
+        return pb_1.Message.getField(this, 6) != null;
+    }
+    get updated_at() {
+        return pb_1.Message.getWrapperField(this, dependency_1.Timestamp, 7) as dependency_1.Timestamp;
+    }
+    set updated_at(value: dependency_1.Timestamp) {
+        pb_1.Message.setWrapperField(this, 7, value);
+    }
+    get has_updated_at() {
+        return pb_1.Message.getField(this, 7) != null;
+    }
+    static fromObject(data: {
+        id?: string;
+        url?: string;
+        event_types?: EventType[];
+        active?: boolean;
+        created_by?: string;
+        created_at?: ReturnType<typeof dependency_1.Timestamp.prototype.toObject>;
+        updated_at?: ReturnType<typeof dependency_1.Timestamp.prototype.toObject>;
+    }): Webhook {
+        const message = new Webhook({});
+        if (data.id != null) {
+            message.id = data.id;
+        }
+        if (data.url != null) {
+            message.url = data.url;
+        }
+        if (data.event_types != null) {
+            message.event_types = data.event_types;
+        }
+        if (data.active != null) {
+            message.active = data.active;
+        }
+        if (data.created_by != null) {
+            message.created_by = data.created_by;
+        }
+        if (data.created_at != null) {
+            message.created_at = dependency_1.Timestamp.fromObject(data.created_at);
+        }
+        if (data.updated_at != null) {
+            message.updated_at = dependency_1.Timestamp.fromObject(data.updated_at);
+        }
+        return message;
+    }
+    toObject() {
+        const data: {
+            id?: string;
+            url?: string;
+            event_types?: EventType[];
+            active?: boolean;
+            created_by?: string;
+            created_at?: ReturnType<typeof dependency_1.Timestamp.prototype.toObject>;
+            updated_at?: ReturnType<typeof dependency_1.Timestamp.prototype.toObject>;
+        } = {};
+        if (this.id != null) {
+            data.id = this.id;
+        }
+        if (this.url != null) {
+            data.url = this.url;
+        }
+        if (this.event_types != null) {
+            data.event_types = this.event_types;
+        }
+        if (this.active != null) {
+            data.active = this.active;
+        }
+        if (this.created_by != null) {
+            data.created_by = this.created_by;
+        }
+        if (this.created_at != null) {
+            data.created_at = this.created_at.toObject();
+        }
+        if (this.updated_at != null) {
+            data.updated_at = this.updated_at.toObject();
+        }
+        return data;
+    }
+    serialize(): Uint8Array;
+    serialize(w: pb_1.BinaryWriter): void;
+    serialize(w?: pb_1.BinaryWriter): Uint8Array | void {
+        const writer = w || new pb_1.BinaryWriter();
+        if (this.id.length)
+            writer.writeString(1, this.id);
+        if (this.url.length)
+            writer.writeString(2, this.url);
+        if (this.event_types.length)
+            writer.writePackedEnum(3, this.event_types);
+        if (this.active != false)
+            writer.writeBool(4, this.active);
+        if (this.created_by.length)
+            writer.writeString(5, this.created_by);
+        if (this.has_created_at)
+            writer.writeMessage(6, this.created_at, () => this.created_at.serialize(writer));
+        if (this.has_updated_at)
+            writer.writeMessage(7, this.updated_at, () => this.updated_at.serialize(writer));
+        if (!w)
+            return writer.getResultBuffer();
+    }
+    static deserialize(bytes: Uint8Array | pb_1.BinaryReader): Webhook {
+        const reader = bytes instanceof pb_1.BinaryReader ? bytes : new pb_1.BinaryReader(bytes), message = new Webhook();
+        while (reader.nextField()) {
+            if (reader.isEndGroup())
+                break;
+            switch (reader.getFieldNumber()) {
+                case 1:
+                    message.id = reader.readString();
+                    break;
+                case 2:
+                    message.url = reader.readString();
+                    break;
+                case 3:
+                    message.event_types = reader.readPackedEnum();
+                    break;
+                case 4:
+                    message.active = reader.readBool();
+                    break;
+                case 5:
+                    message.created_by = reader.readString();
+                    break;
+                case 6:
+                    reader.readMessage(message.created_at, () => message.created_at = dependency_1.Timestamp.deserialize(reader));
+                    break;
+                case 7:
+                    reader.readMessage(message.updated_at, () => message.updated_at = dependency_1.Timestamp.deserialize(reader));
+                    break;
+                default: reader.skipField();
+            }
+        }
+        return message;
+    }
+    serializeBinary(): Uint8Array {
+        return this.serialize();
+    }
+    static deserializeBinary(bytes: Uint8Array): Webhook {
+        return Webhook.deserialize(bytes);
+    }
+}
+export class CreateWebhookRequest extends pb_1.Message {
+    #one_of_decls: number[][] = [];
+    constructor(data?: any[] | {
+        url?: string;
+        event_types?: EventType[];
+        secret?: string;
+    }) {
+        super();
+        pb_1.Message.initialize(this, Array.isArray(data) ? data : [], 0, -1, [2], this.#one_of_decls);
+        if (!Array.isArray(data) && typeof data == "object") {
+            if ("url" in data && data.url != undefined) {
+                this.url = data.url;
+            }
+            if ("event_types" in data && data.event_types != undefined) {
+                this.event_types = data.event_types;
+            }
+            if ("secret" in data && data.secret != undefined) {
+                this.secret = data.secret;
+            }
+        }
+    }
+    get url() {
+        return pb_1.Message.getFieldWithDefault(this, 1, "") as string;
+    }
+    set url(value: string) {
+        pb_1.Message.setField(this, 1, value);
+    }
+    get event_types() {
+        return pb_1.Message.getFieldWithDefault(this, 2, []) as EventType[];
+    }
+    set event_types(value: EventType[]) {
+        pb_1.Message.setField(this, 2, value);
+    }
+    get secret() {
+        return pb_1.Message.getFieldWithDefault(this, 3, "") as string;
+    }
+    set secret(value: string) {
+        pb_1.Message.setField(this, 3, value);
+    }
+    static fromObject(data: {
+        url?: string;
+        event_types?: EventType[];
+        secret?: string;
+    }): CreateWebhookRequest {
+        const message = new CreateWebhookRequest({});
+        if (data.url != null) {
+            message.url = data.url;
+        }
+        if (data.event_types != null) {
+            message.event_types = data.event_types;
+        }
+        if (data.secret != null) {
+            message.secret = data.secret;
+        }
+        return message;
+    }
+    toObject() {
+        const data: {
+            url?: string;
+            event_types?: EventType[];
+            secret?: string;
+        } = {};
+        if (this.url != null) {
+            data.url = this.url;
+        }
+        if (this.event_types != null) {
+            data.event_types = this.event_types;
+        }
+        if (this.secret != null) {
+            data.secret = this.secret;
+        }
+        return data;
+    }
+    serialize(): Uint8Array;
+    serialize(w: pb_1.BinaryWriter): void;
+    serialize(w?: pb_1.BinaryWriter): Uint8Array | void {
+        const writer = w || new pb_1.BinaryWriter();
+        if (this.url.length)
+            writer.writeString(1, this.url);
+        if (this.event_types.length)
+            writer.writePackedEnum(2, this.event_types);
+        if (this.secret.length)
+            writer.writeString(3, this.secret);
+        if (!w)
+            return writer.getResultBuffer();
+    }
+    static deserialize(bytes: Uint8Array | pb_1.BinaryReader): CreateWebhookRequest {
+        const reader = bytes instanceof pb_1.BinaryReader ? bytes : new pb_1.BinaryReader(bytes), message = new CreateWebhookRequest();
+        while (reader.nextField()) {
+            if (reader.isEndGroup())
+                break;
+            switch (reader.getFieldNumber()) {
+                case 1:
+                    message.url = reader.readString();
+                    break;
+                case 2:
+                    message.event_types = reader.readPackedEnum();
+                    break;
+                case 3:
+                    message.secret = reader.readString();
+                    break;
+                default: reader.skipField();
+            }
+        }
+        return message;
+    }
+    serializeBinary(): Uint8Array {
+        return this.serialize();
+    }
+    static deserializeBinary(bytes: Uint8Array): CreateWebhookRequest {
+        return CreateWebhookRequest.deserialize(bytes);
+    }
+}
+export class CreateWebhookResponse extends pb_1.Message {
+    #one_of_decls: number[][] = [];
+    constructor(data?: any[] | {
+        webhook?: Webhook;
+        secret?: string;
+    }) {
+        super();
+        pb_1.Message.initialize(this, Array.isArray(data) ? data : [], 0, -1, [], this.#one_of_decls);
+        if (!Array.isArray(data) && typeof data == "object") {
+            if ("webhook" in data && data.webhook != undefined) {
+                this.webhook = data.webhook;
+            }
+            if ("secret" in data && data.secret != undefined) {
+                this.secret = data.secret;
+            }
+        }
+    }
+    get webhook() {
+        return pb_1.Message.getWrapperField(this, Webhook, 1) as Webhook;
+    }
+    set webhook(value: Webhook) {
+        pb_1.Message.setWrapperField(this, 1, value);
+    }
+    get has_webhook() {
+        return pb_1.Message.getField(this, 1) != null;
+    }
+    get secret() {
+        return pb_1.Message.getFieldWithDefault(this, 2, "") as string;
+    }
+    set secret(value: string) {
+        pb_1.Message.setField(this, 2, value);
+    }
+    static fromObject(data: {
+        webhook?: ReturnType<typeof Webhook.prototype.toObject>;
+        secret?: string;
+    }): CreateWebhookResponse {
+        const message = new CreateWebhookResponse({});
+        if (data.webhook != null) {
+            message.webhook = Webhook.fromObject(data.webhook);
+        }
+        if (data.secret != null) {
+            message.secret = data.secret;
+        }
+        return message;
+    }
+    toObject() {
+        const data: {
+            webhook?: ReturnType<typeof Webhook.prototype.toObject>;
+            secret?: string;
+        } = {};
+        if (this.webhook != null) {
+            data.webhook = this.webhook.toObject();
+        }
+        if (this.secret != null) {
+            data.secret = this.secret;
+        }
+        return data;
+    }
+    serialize(): Uint8Array;
+    serialize(w: pb_1.BinaryWriter): void;
+    serialize(w?: pb_1.BinaryWriter): Uint8Array | void {
+        const writer = w || new pb_1.BinaryWriter();
+        if (this.has_webhook)
+            writer.writeMessage(1, this.webhook, () => this.webhook.serialize(writer));
+        if (this.secret.length)
+            writer.writeString(2, this.secret);
+        if (!w)
+            return writer.getResultBuffer();
+    }
+    static deserialize(bytes: Uint8Array | pb_1.BinaryReader): CreateWebhookResponse {
+        const reader = bytes instanceof pb_1.BinaryReader ? bytes : new pb_1.BinaryReader(bytes), message = new CreateWebhookResponse();
+        while (reader.nextField()) {
+            if (reader.isEndGroup())
+                break;
+            switch (reader.getFieldNumber()) {
+                case 1:
+                    reader.readMessage(message.webhook, () => message.webhook = Webhook.deserialize(reader));
+                    break;
+                case 2:
+                    message.secret = reader.readString();
+                    break;
+                default: reader.skipField();
+            }
+        }
+        return message;
+    }
+    serializeBinary(): Uint8Array {
+        return this.serialize();
+    }
+    static deserializeBinary(bytes: Uint8Array): CreateWebhookResponse {
+        return CreateWebhookResponse.deserialize(bytes);
+    }
+}
+export class ListWebhooksRequest extends pb_1.Message {
+    #one_of_decls: number[][] = [];
+    constructor(data?: any[] | {}) {
+        super();
+        pb_1.Message.initialize(this, Array.isArray(data) ? data : [], 0, -1, [], this.#one_of_decls);
+        if (!Array.isArray(data) && typeof data == "object") { }
+    }
+    static fromObject(data: {}): ListWebhooksRequest {
+        const message = new ListWebhooksRequest({});
+        return message;
+    }
+    toObject() {
+        const data: {} = {};
+        return data;
+    }
+    serialize(): Uint8Array;
+    serialize(w: pb_1.BinaryWriter): void;
+    serialize(w?: pb_1.BinaryWriter): Uint8Array | void {
+        const writer = w || new pb_1.BinaryWriter();
+        if (!w)
+            return writer.getResultBuffer();
+    }
+    static deserialize(bytes: Uint8Array | pb_1.BinaryReader): ListWebhooksRequest {
+        const reader = bytes instanceof pb_1.BinaryReader ? bytes : new pb_1.BinaryReader(bytes), message = new ListWebhooksRequest();
+        while (reader.nextField()) {
+            if (reader.isEndGroup())
+                break;
+            switch (reader.getFieldNumber()) {
+                default: reader.skipField();
+            }
+        }
+        return message;
+    }
+    serializeBinary(): Uint8Array {
+        return this.serialize();
+    }
+    static deserializeBinary(bytes: Uint8Array): ListWebhooksRequest {
+        return ListWebhooksRequest.deserialize(bytes);
+    }
+}
+export class ListWebhooksResponse extends pb_1.Message {
+    #one_of_decls: number[][] = [];
+    constructor(data?: any[] | {
+        webhooks?: Webhook[];
+    }) {
+        super();
+        pb_1.Message.initialize(this, Array.isArray(data) ? data : [], 0, -1, [1], this.#one_of_decls);
+        if (!Array.isArray(data) && typeof data == "object") {
+            if ("webhooks" in data && data.webhooks != undefined) {
+                this.webhooks = data.webhooks;
+            }
+        }
+    }
+    get webhooks() {
+        return pb_1.Message.getRepeatedWrapperField(this, Webhook, 1) as Webhook[];
+    }
+    set webhooks(value: Webhook[]) {
+        pb_1.Message.setRepeatedWrapperField(this, 1, value);
+    }
+    static fromObject(data: {
+        webhooks?: ReturnType<typeof Webhook.prototype.toObject>[];
+    }): ListWebhooksResponse {
+        const message = new ListWebhooksResponse({});
+        if (data.webhooks != null) {
+            message.webhooks = data.webhooks.map(item => Webhook.fromObject(item));
+        }
+        return message;
+    }
+    toObject() {
+        const data: {
+            webhooks?: ReturnType<typeof Webhook.prototype.toObject>[];
+        } = {};
+        if (this.webhooks != null) {
+            data.webhooks = this.webhooks.map((item: Webhook) => item.toObject());
+        }
+        return data;
+    }
+    serialize(): Uint8Array;
+    serialize(w: pb_1.BinaryWriter): void;
+    serialize(w?: pb_1.BinaryWriter): Uint8Array | void {
+        const writer = w || new pb_1.BinaryWriter();
+        if (this.webhooks.length)
+            writer.writeRepeatedMessage(1, this.webhooks, (item: Webhook) => item.serialize(writer));
+        if (!w)
+            return writer.getResultBuffer();
+    }
+    static deserialize(bytes: Uint8Array | pb_1.BinaryReader): ListWebhooksResponse {
+        const reader = bytes instanceof pb_1.BinaryReader ? bytes : new pb_1.BinaryReader(bytes), message = new ListWebhooksResponse();
+        while (reader.nextField()) {
+            if (reader.isEndGroup())
+                break;
+            switch (reader.getFieldNumber()) {
+                case 1:
+                    reader.readMessage(message.webhooks, () => pb_1.Message.addToRepeatedWrapperField(message, 1, Webhook.deserialize(reader), Webhook));
+                    break;
+                default: reader.skipField();
+            }
+        }
+        return message;
+    }
+    serializeBinary(): Uint8Array {
+        return this.serialize();
+    }
+    static deserializeBinary(bytes: Uint8Array): ListWebhooksResponse {
+        return ListWebhooksResponse.deserialize(bytes);
+    }
+}
+export class UpdateWebhookRequest extends pb_1.Message {
+    #one_of_decls: number[][] = [];
+    constructor(data?: any[] | {
+        webhook_id?: string;
+        url?: string;
+        event_types?: EventType[];
+        active?: boolean;
+    }) {
+        super();
+        pb_1.Message.initialize(this, Array.isArray(data) ? data : [], 0, -1, [3], this.#one_of_decls);
+        if (!Array.isArray(data) && typeof data == "object") {
+            if ("webhook_id" in data && data.webhook_id != undefined) {
+                this.webhook_id = data.webhook_id;
+            }
+            if ("url" in data && data.url != undefined) {
+                this.url = data.url;
+            }
+            if ("event_types" in data && data.event_types != undefined) {
+                this.event_types = data.event_types;
+            }
+            if ("active" in data && data.active != undefined) {
+                this.active = data.active;
+            }
+        }
+    }
+    get webhook_id() {
+        return pb_1.Message.getFieldWithDefault(this, 1, "") as string;
+    }
+    set webhook_id(value: string) {
+        pb_1.Message.setField(this, 1, value);
+    }
+    get url() {
+        return pb_1.Message.getFieldWithDefault(this, 2, "") as string;
+    }
+    set url(value: string) {
+        pb_1.Message.setField(this, 2, value);
+    }
+    get event_types() {
+        return pb_1.Message.getFieldWithDefault(this, 3, []) as EventType[];
+    }
+    set event_types(value: EventType[]) {
+        pb_1.Message.setField(this, 3, value);
+    }
+    get active() {
+        return pb_1.Message.getFieldWithDefault(this, 4, false) as boolean;
+    }
+    set active(value: boolean) {
+        pb_1.Message.setField(this, 4, value);
+    }
+    static fromObject(data: {
+        webhook_id?: string;
+        url?: string;
+        event_types?: EventType[];
+        active?: boolean;
+    }): UpdateWebhookRequest {
+        const message = new UpdateWebhookRequest({});
+        if (data.webhook_id != null) {
+            message.webhook_id = data.webhook_id;
+        }
+        if (data.url != null) {
+            message.url = data.url;
+        }
+        if (data.event_types != null) {
+            message.event_types = data.event_types;
+        }
+        if (data.active != null) {
+            message.active = data.active;
+        }
+        return message;
+    }
+    toObject() {
+        const data: {
+            webhook_id?: string;
+            url?: string;
+            event_types?: EventType[];
+            active?: boolean;
+        } = {};
+        if (this.webhook_id != null) {
+            data.webhook_id = this.webhook_id;
+        }
+        if (this.url != null) {
+            data.url = this.url;
+        }
+        if (this.event_types != null) {
+            data.event_types = this.event_types;
+        }
+        if (this.active != null) {
+            data.active = this.active;
+        }
+        return data;
+    }
+    serialize(): Uint8Array;
+    serialize(w: pb_1.BinaryWriter): void;
+    serialize(w?: pb_1.BinaryWriter): Uint8Array | void {
+        const writer = w || new pb_1.BinaryWriter();
+        if (this.webhook_id.length)
+            writer.writeString(1, this.webhook_id);
+        if (this.url.length)
+            writer.writeString(2, this.url);
+        if (this.event_types.length)
+            writer.writePackedEnum(3, this.event_types);
+        if (this.active != false)
+            writer.writeBool(4, this.active);
+        if (!w)
+            return writer.getResultBuffer();
+    }
+    static deserialize(bytes: Uint8Array | pb_1.BinaryReader): UpdateWebhookRequest {
+        const reader = bytes instanceof pb_1.BinaryReader ? bytes : new pb_1.BinaryReader(bytes), message = new UpdateWebhookRequest();
+        while (reader.nextField()) {
+            if (reader.isEndGroup())
+                break;
+            switch (reader.getFieldNumber()) {
+                case 1:
+                    message.webhook_id = reader.readString();
+                    break;
+                case 2:
+                    message.url = reader.readString();
+                    break;
+                case 3:
+                    message.event_types = reader.readPackedEnum();
+                    break;
+                case 4:
+                    message.active = reader.readBool();
+                    break;
+                default: reader.skipField();
+            }
+        }
+        return message;
+    }
+    serializeBinary(): Uint8Array {
+        return this.serialize();
+    }
+    static deserializeBinary(bytes: Uint8Array): UpdateWebhookRequest {
+        return UpdateWebhookRequest.deserialize(bytes);
+    }
+}
+export class DeleteWebhookRequest extends pb_1.Message {
+    #one_of_decls: number[][] = [];
+    constructor(data?: any[] | {
+        webhook_id?: string;
+    }) {
+        super();
+        pb_1.Message.initialize(this, Array.isArray(data) ? data : [], 0, -1, [], this.#one_of_decls);
+        if (!Array.isArray(data) && typeof data == "object") {
+            if ("webhook_id" in data && data.webhook_id != undefined) {
+                this.webhook_id = data.webhook_id;
+            }
+        }
+    }
+    get webhook_id() {
+        return pb_1.Message.getFieldWithDefault(this, 1, "") as string;
+    }
+    set webhook_id(value: string) {
+        pb_1.Message.setField(this, 1, value);
+    }
+    static fromObject(data: {
+        webhook_id?: string;
+    }): DeleteWebhookRequest {
+        const message = new DeleteWebhookRequest({});
+        if (data.webhook_id != null) {
+            message.webhook_id = data.webhook_id;
+        }
+        return message;
+    }
+    toObject() {
+        const data: {
+            webhook_id?: string;
+        } = {};
+        if (this.webhook_id != null) {
+            data.webhook_id = this.webhook_id;
+        }
+        return data;
+    }
+    serialize(): Uint8Array;
+    serialize(w: pb_1.BinaryWriter): void;
+    serialize(w?: pb_1.BinaryWriter): Uint8Array | void {
+        const writer = w || new pb_1.BinaryWriter();
+        if (this.webhook_id.length)
+            writer.writeString(1, this.webhook_id);
+        if (!w)
+            return writer.getResultBuffer();
+    }
+    static deserialize(bytes: Uint8Array | pb_1.BinaryReader): DeleteWebhookRequest {
+        const reader = bytes instanceof pb_1.BinaryReader ? bytes : new pb_1.BinaryReader(bytes), message = new DeleteWebhookRequest();
+        while (reader.nextField()) {
+            if (reader.isEndGroup())
+                break;
+            switch (reader.getFieldNumber()) {
+                case 1:
+                    message.webhook_id = reader.readString();
+                    break;
+                default: reader.skipField();
+            }
+        }
+        return message;
+    }
+    serializeBinary(): Uint8Array {
+        return this.serialize();
+    }
+    static deserializeBinary(bytes: Uint8Array): DeleteWebhookRequest {
+        return DeleteWebhookRequest.deserialize(bytes);
+    }
+}
+export class DeleteWebhookResponse extends pb_1.Message {
+    #one_of_decls: number[][] = [];
+    constructor(data?: any[] | {}) {
+        super();
+        pb_1.Message.initialize(this, Array.isArray(data) ? data : [], 0, -1, [], this.#one_of_decls);
+        if (!Array.isArray(data) && typeof data == "object") { }
+    }
+    static fromObject(data: {}): DeleteWebhookResponse {
+        const message = new DeleteWebhookResponse({});
+        return message;
+    }
+    toObject() {
+        const data: {} = {};
+        return data;
+    }
+    serialize(): Uint8Array;
+    serialize(w: pb_1.BinaryWriter): void;
+    serialize(w?: pb_1.BinaryWriter): Uint8Array | void {
+        const writer = w || new pb_1.BinaryWriter();
+        if (!w)
+            return writer.getResultBuffer();
+    }
+    static deserialize(bytes: Uint8Array | pb_1.BinaryReader): DeleteWebhookResponse {
+        const reader = bytes instanceof pb_1.BinaryReader ? bytes : new pb_1.BinaryReader(bytes), message = new DeleteWebhookResponse();
+        while (reader.nextField()) {
+            if (reader.isEndGroup())
+                break;
+            switch (reader.getFieldNumber()) {
+                default: reader.skipField();
+            }
+        }
+        return message;
+    }
+    serializeBinary(): Uint8Array {
+        return this.serialize();
+    }
+    static deserializeBinary(bytes: Uint8Array): DeleteWebhookResponse {
+        return DeleteWebhookResponse.deserialize(bytes);
+    }
+}
+export class WebhookDelivery extends pb_1.Message {
+    #one_of_decls: number[][] = [];
+    constructor(data?: any[] | {
+        id?: string;
+        webhook_id?: string;
+        event_id?: string;
+        event_type?: EventType;
+        payload?: string;
+        status?: WebhookDeliveryStatus;
+        attempts?: number;
+        response_status?: number;
+        last_error?: string;
+        created_at?: dependency_1.Timestamp;
+        next_attempt_at?: dependency_1.Timestamp;
+        delivered_at?: dependency_1.Timestamp;
+    }) {
+        super();
+        pb_1.Message.initialize(this, Array.isArray(data) ? data : [], 0, -1, [], this.#one_of_decls);
+        if (!Array.isArray(data) && typeof data == "object") {
+            if ("id" in data && data.id != undefined) {
+                this.id = data.id;
+            }
+            if ("webhook_id" in data && data.webhook_id != undefined) {
+                this.webhook_id = data.webhook_id;
+            }
+            if ("event_id" in data && data.event_id != undefined) {
+                this.event_id = data.event_id;
+            }
+            if ("event_type" in data && data.event_type != undefined) {
+                this.event_type = data.event_type;
+            }
+            if ("payload" in data && data.payload != undefined) {
+                this.payload = data.payload;
+            }
+            if ("status" in data && data.status != undefined) {
+                this.status = data.status;
+            }
+            if ("attempts" in data && data.attempts != undefined) {
+                this.attempts = data.attempts;
+            }
+            if ("response_status" in data && data.response_status != undefined) {
+                this.response_status = data.response_status;
+            }
+            if ("last_error" in data && data.last_error != undefined) {
+                this.last_error = data.last_error;
+            }
+            if ("created_at" in data && data.created_at != undefined) {
+                this.created_at = data.created_at;
+            }
+            if ("next_attempt_at" in data && data.next_attempt_at != undefined) {
+                this.next_attempt_at = data.next_attempt_at;
+            }
+            if ("delivered_at" in data && data.delivered_at != undefined) {
+                this.delivered_at = data.delivered_at;
+            }
+        }
+    }
+    get id() {
+        return pb_1.Message.getFieldWithDefault(this, 1, "") as string;
+    }
+    set id(value: string) {
+        pb_1.Message.setField(this, 1, value);
+    }
+    get webhook_id() {
+        return pb_1.Message.getFieldWithDefault(this, 2, "") as string;
+    }
+    set webhook_id(value: string) {
+        pb_1.Message.setField(this, 2, value);
+    }
+    get event_id() {
+        return pb_1.Message.getFieldWithDefault(this, 3, "") as string;
+    }
+    set event_id(value: string) {
+        pb_1.Message.setField(this, 3, value);
+    }
+    get event_type() {
+        return pb_1.Message.getFieldWithDefault(this, 4, EventType.EVENT_TYPE_UNSPECIFIED) as EventType;
+    }
+    set event_type(value: EventType) {
+        pb_1.Message.setField(this, 4, value);
+    }
+    get payload() {
+        return pb_1.Message.getFieldWithDefault(this, 5, "") as string;
+    }
+    set payload(value: string) {
+        pb_1.Message.setField(this, 5, value);
+    }
+    get status() {
+        return pb_1.Message.getFieldWithDefault(this, 6, WebhookDeliveryStatus.WEBHOOK_DELIVERY_STATUS_PENDING) as WebhookDeliveryStatus;
+    }
+    set status(value: WebhookDeliveryStatus) {
+        pb_1.Message.setField(this, 6, value);
+    }
+    get attempts() {
+        return pb_1.Message.getFieldWithDefault(this, 7, 0) as number;
+    }
+    set attempts(value: number) {
+        pb_1.Message.setField(this, 7, value);
+    }
+    get response_status() {
+        return pb_1.Message.getFieldWithDefault(this, 8, 0) as number;
+    }
+    set response_status(value: number) {
+        pb_1.Message.setField(this, 8, value);
+    }
+    get last_error() {
+        return pb_1.Message.getFieldWithDefault(this, 9, "") as string;
+    }
+    set last_error(value: string) {
+        pb_1.Message.setField(this, 9, value);
+    }
+    get created_at() {
+        return pb_1.Message.getWrapperField(this, dependency_1.Timestamp, 10) as dependency_1.Timestamp;
+    }
+    set created_at(value: dependency_1.Timestamp) {
+        pb_1.Message.setWrapperField(this, 10, value);
+    }
+    get has_created_at() {
+        return pb_1.Message.getField(this, 10) != null;
+    }
+    get next_attempt_at() {
+        return pb_1.Message.getWrapperField(this, dependency_1.Timestamp, 11) as dependency_1.Timestamp;
+    }
+    set next_attempt_at(value: dependency_1.Timestamp) {
+        pb_1.Message.setWrapperField(this, 11, value);
+    }
+    get has_next_attempt_at() {
+        return pb_1.Message.getField(this, 11) != null;
+    }
+    get delivered_at() {
+        return pb_1.Message.getWrapperField(this, dependency_1.Timestamp, 12) as dependency_1.Timestamp;
+    }
+    set delivered_at(value: dependency_1.Timestamp) {
+        pb_1.Message.setWrapperField(this, 12, value);
+    }
+    get has_delivered_at() {
+        return pb_1.Message.getField(this, 12) != null;
+    }
+    static fromObject(data: {
+        id?: string;
+        webhook_id?: string;
+        event_id?: string;
+        event_type?: EventType;
+        payload?: string;
+        status?: WebhookDeliveryStatus;
+        attempts?: number;
+        response_status?: number;
+        last_error?: string;
+        created_at?: ReturnType<typeof dependency_1.Timestamp.prototype.toObject>;
+        next_attempt_at?: ReturnType<typeof dependency_1.Timestamp.prototype.toObject>;
+        delivered_at?: ReturnType<typeof dependency_1.Timestamp.prototype.toObject>;
+    }): WebhookDelivery {
+        const message = new WebhookDelivery({});
+        if (data.id != null) {
+            message.id = data.id;
+        }
+        if (data.webhook_id != null) {
+            message.webhook_id = data.webhook_id;
+        }
+        if (data.event_id != null) {
+            message.event_id = data.event_id;
+        }
+        if (data.event_type != null) {
+            message.event_type = data.event_type;
+        }
+        if (data.payload != null) {
+            message.payload = data.payload;
+        }
+        if (data.status != null) {
+            message.status = data.status;
+        }
+        if (data.attempts != null) {
+            message.attempts = data.attempts;
+        }
+        if (data.response_status != null) {
+            message.response_status = data.response_status;
+        }
+        if (data.last_error != null) {
+            message.last_error = data.last_error;
+        }
+        if (data.created_at != null) {
+            message.created_at = dependency_1.Timestamp.fromObject(data.created_at);
+        }
+        if (data.next_attempt_at != null) {
+            message.next_attempt_at = dependency_1.Timestamp.fromObject(data.next_attempt_at);
+        }
+        if (data.delivered_at != null) {
+            message.delivered_at = dependency_1.Timestamp.fromObject(data.delivered_at);
+        }
+        return message;
+    }
+    toObject() {
+        const data: {
+            id?: string;
+            webhook_id?: string;
+            event_id?: string;
+            event_type?: EventType;
+            payload?: string;
+            status?: WebhookDeliveryStatus;
+            attempts?: number;
+            response_status?: number;
+            last_error?: string;
+            created_at?: ReturnType<typeof dependency_1.Timestamp.prototype.toObject>;
+            next_attempt_at?: ReturnType<typeof dependency_1.Timestamp.prototype.toObject>;
+            delivered_at?: ReturnType<typeof dependency_1.Timestamp.prototype.toObject>;
+        } = {};
+        if (this.id != null) {
+            data.id = this.id;
+        }
+        if (this.webhook_id != null) {
+            data.webhook_id = this.webhook_id;
+        }
+        if (this.event_id != null) {
+            data.event_id = this.event_id;
+        }
+        if (this.event_type != null) {
+            data.event_type = this.event_type;
+        }
+        if (this.payload != null) {
+            data.payload = this.payload;
+        }
+        if (this.status != null) {
+            data.status = this.status;
+        }
+        if (this.attempts != null) {
+            data.attempts = this.attempts;
+        }
+        if (this.response_status != null) {
+            data.response_status = this.response_status;
+        }
+        if (this.last_error != null) {
+            data.last_error = this.last_error;
+        }
+        if (this.created_at != null) {
+            data.created_at = this.created_at.toObject();
+        }
+        if (this.next_attempt_at != null) {
+            data.next_attempt_at = this.next_attempt_at.toObject();
+        }
+        if (this.delivered_at != null) {
+            data.delivered_at = this.delivered_at.toObject();
+        }
+        return data;
+    }
+    serialize(): Uint8Array;
+    serialize(w: pb_1.BinaryWriter): void;
+    serialize(w?: pb_1.BinaryWriter): Uint8Array | void {
+        const writer = w || new pb_1.BinaryWriter();
+        if (this.id.length)
+            writer.writeString(1, this.id);
+        if (this.webhook_id.length)
+            writer.writeString(2, this.webhook_id);
+        if (this.event_id.length)
+            writer.writeString(3, this.event_id);
+        if (this.event_type != EventType.EVENT_TYPE_UNSPECIFIED)
+            writer.writeEnum(4, this.event_type);
+        if (this.payload.length)
+            writer.writeString(5, this.payload);
+        if (this.status != WebhookDeliveryStatus.WEBHOOK_DELIVERY_STATUS_PENDING)
+            writer.writeEnum(6, this.status);
+        if (this.attempts != 0)
+            writer.writeInt32(7, this.attempts);
+        if (this.response_status != 0)
+            writer.writeInt32(8, this.response_status);
+        if (this.last_error.length)
+            writer.writeString(9, this.last_error);
+        if (this.has_created_at)
+            writer.writeMessage(10, this.created_at, () => this.created_at.serialize(writer));
+        if (this.has_next_attempt_at)
+            writer.writeMessage(11, this.next_attempt_at, () => this.next_attempt_at.serialize(writer));
+        if (this.has_delivered_at)
+            writer.writeMessage(12, this.delivered_at, () => this.delivered_at.serialize(writer));
+        if (!w)
+            return writer.getResultBuffer();
+    }
+    static deserialize(bytes: Uint8Array | pb_1.BinaryReader): WebhookDelivery {
+        const reader = bytes instanceof pb_1.BinaryReader ? bytes : new pb_1.BinaryReader(bytes), message = new WebhookDelivery();
+        while (reader.nextField()) {
+            if (reader.isEndGroup())
+                break;
+            switch (reader.getFieldNumber()) {
+                case 1:
+                    message.id = reader.readString();
+                    break;
+                case 2:
+                    message.webhook_id = reader.readString();
+                    break;
+                case 3:
+                    message.event_id = reader.readString();
+                    break;
+                case 4:
+                    message.event_type = reader.readEnum();
+                    break;
+                case 5:
+                    message.payload = reader.readString();
+                    break;
+                case 6:
+                    message.status = reader.readEnum();
+                    break;
+                case 7:
+                    message.attempts = reader.readInt32();
+                    break;
+                case 8:
+                    message.response_status = reader.readInt32();
+                    break;
+                case 9:
+                    message.last_error = reader.readString();
+                    break;
+                case 10:
+                    reader.readMessage(message.created_at, () => message.created_at = dependency_1.Timestamp.deserialize(reader));
+                    break;
+                case 11:
+                    reader.readMessage(message.next_attempt_at, () => message.next_attempt_at = dependency_1.Timestamp.deserialize(reader));
+                    break;
+                case 12:
+                    reader.readMessage(message.delivered_at, () => message.delivered_at = dependency_1.Timestamp.deserialize(reader));
+                    break;
+                default: reader.skipField();
+            }
+        }
+        return message;
+    }
+    serializeBinary(): Uint8Array {
+        return this.serialize();
+    }
+    static deserializeBinary(bytes: Uint8Array): WebhookDelivery {
+        return WebhookDelivery.deserialize(bytes);
+    }
+}
+export class ListWebhookDeliveriesRequest extends pb_1.Message {
+    #one_of_decls: number[][] = [];
+    constructor(data?: any[] | {
+        webhook_id?: string;
+        page_number?: number;
+        page_size?: number;
+    }) {
+        super();
+        pb_1.Message.initialize(this, Array.isArray(data) ? data : [], 0, -1, [], this.#one_of_decls);
+        if (!Array.isArray(data) && typeof data == "object") {
+            if ("webhook_id" in data && data.webhook_id != undefined) {
+                this.webhook_id = data.webhook_id;
+            }
+            if ("page_number" in data && data.page_number != undefined) {
+                this.page_number = data.page_number;
+            }
+            if ("page_size" in data && data.page_size != undefined) {
+                this.page_size = data.page_size;
+            }
+        }
+    }
+    get webhook_id() {
+        return pb_1.Message.getFieldWithDefault(this, 1, "") as string;
+    }
+    set webhook_id(value: string) {
+        pb_1.Message.setField(this, 1, value);
+    }
+    get page_number() {
+        return pb_1.Message.getFieldWithDefault(this, 2, 0) as number;
+    }
+    set page_number(value: number) {
+        pb_1.Message.setField(this, 2, value);
+    }
+    get page_size() {
+        return pb_1.Message.getFieldWithDefault(this, 3, 0) as number;
+    }
+    set page_size(value: number) {
+        pb_1.Message.setField(this, 3, value);
+    }
+    static fromObject(data: {
+        webhook_id?: string;
+        page_number?: number;
+        page_size?: number;
+    }): ListWebhookDeliveriesRequest {
+        const message = new ListWebhookDeliveriesRequest({});
+        if (data.webhook_id != null) {
+            message.webhook_id = data.webhook_id;
+        }
+        if (data.page_number != null) {
+            message.page_number = data.page_number;
+        }
+        if (data.page_size != null) {
+            message.page_size = data.page_size;
+        }
+        return message;
+    }
+    toObject() {
+        const data: {
+            webhook_id?: string;
+            page_number?: number;
+            page_size?: number;
+        } = {};
+        if (this.webhook_id != null) {
+            data.webhook_id = this.webhook_id;
+        }
+        if (this.page_number != null) {
+            data.page_number = this.page_number;
+        }
+        if (this.page_size != null) {
+            data.page_size = this.page_size;
+        }
+        return data;
+    }
+    serialize(): Uint8Array;
+    serialize(w: pb_1.BinaryWriter): void;
+    serialize(w?: pb_1.BinaryWriter): Uint8Array | void {
+        const writer = w || new pb_1.BinaryWriter();
+        if (this.webhook_id.length)
+            writer.writeString(1, this.webhook_id);
+        if (this.page_number != 0)
+            writer.writeInt32(2, this.page_number);
+        if (this.page_size != 0)
+            writer.writeInt32(3, this.page_size);
+        if (!w)
+            return writer.getResultBuffer();
+    }
+    static deserialize(bytes: Uint8Array | pb_1.BinaryReader): ListWebhookDeliveriesRequest {
+        const reader = bytes instanceof pb_1.BinaryReader ? bytes : new pb_1.BinaryReader(bytes), message = new ListWebhookDeliveriesRequest();
+        while (reader.nextField()) {
+            if (reader.isEndGroup())
+                break;
+            switch (reader.getFieldNumber()) {
+                case 1:
+                    message.webhook_id = reader.readString();
+                    break;
+                case 2:
+                    message.page_number = reader.readInt32();
+                    break;
+                case 3:
+                    message.page_size = reader.readInt32();
+                    break;
+                default: reader.skipField();
+            }
+        }
+        return message;
+    }
+    serializeBinary(): Uint8Array {
+        return this.serialize();
+    }
+    static deserializeBinary(bytes: Uint8Array): ListWebhookDeliveriesRequest {
+        return ListWebhookDeliveriesRequest.deserialize(bytes);
+    }
+}
+export class ListWebhookDeliveriesResponse extends pb_1.Message {
+    #one_of_decls: number[][] = [];
+    constructor(data?: any[] | {
+        deliveries?: WebhookDelivery[];
+        next_page_number?: number;
+    }) {
+        super();
+        pb_1.Message.initialize(this, Array.isArray(data) ? data : [], 0, -1, [1], this.#one_of_decls);
+        if (!Array.isArray(data) && typeof data == "object") {
+            if ("deliveries" in data && data.deliveries != undefined) {
+                this.deliveries = data.deliveries;
+            }
+            if ("next_page_number" in data && data.next_page_number != undefined) {
+                this.next_page_number = data.next_page_number;
+            }
+        }
+    }
+    get deliveries() {
+        return pb_1.Message.getRepeatedWrapperField(this, WebhookDelivery, 1) as WebhookDelivery[];
+    }
+    set deliveries(value: WebhookDelivery[]) {
+        pb_1.Message.setRepeatedWrapperField(this, 1, value);
+    }
+    get next_page_number() {
+        return pb_1.Message.getFieldWithDefault(this, 2, 0) as number;
+    }
+    set next_page_number(value: number) {
+        pb_1.Message.setField(this, 2, value);
+    }
+    static fromObject(data: {
+        deliveries?: ReturnType<typeof WebhookDelivery.prototype.toObject>[];
+        next_page_number?: number;
+    }): ListWebhookDeliveriesResponse {
+        const message = new ListWebhookDeliveriesResponse({});
+        if (data.deliveries != null) {
+            message.deliveries = data.deliveries.map(item => WebhookDelivery.fromObject(item));
+        }
+        if (data.next_page_number != null) {
+            message.next_page_number = data.next_page_number;
+        }
+        return message;
+    }
+    toObject() {
+        const data: {
+            deliveries?: ReturnType<typeof WebhookDelivery.prototype.toObject>[];
+            next_page_number?: number;
+        } = {};
+        if (this.deliveries != null) {
+            data.deliveries = this.deliveries.map((item: WebhookDelivery) => item.toObject());
+        }
+        if (this.next_page_number != null) {
+            data.next_page_number = this.next_page_number;
+        }
+        return data;
+    }
+    serialize(): Uint8Array;
+    serialize(w: pb_1.BinaryWriter): void;
+    serialize(w?: pb_1.BinaryWriter): Uint8Array | void {
+        const writer = w || new pb_1.BinaryWriter();
+        if (this.deliveries.length)
+            writer.writeRepeatedMessage(1, this.deliveries, (item: WebhookDelivery) => item.serialize(writer));
+        if (this.next_page_number != 0)
+            writer.writeInt32(2, this.next_page_number);
+        if (!w)
+            return writer.getResultBuffer();
+    }
+    static deserialize(bytes: Uint8Array | pb_1.BinaryReader): ListWebhookDeliveriesResponse {
+        const reader = bytes instanceof pb_1.BinaryReader ? bytes : new pb_1.BinaryReader(bytes), message = new ListWebhookDeliveriesResponse();
+        while (reader.nextField()) {
+            if (reader.isEndGroup())
+                break;
+            switch (reader.getFieldNumber()) {
+                case 1:
+                    reader.readMessage(message.deliveries, () => pb_1.Message.addToRepeatedWrapperField(message, 1, WebhookDelivery.deserialize(reader), WebhookDelivery));
+                    break;
+                case 2:
+                    message.next_page_number = reader.readInt32();
+                    break;
+                default: reader.skipField();
+            }
+        }
+        return message;
+    }
+    serializeBinary(): Uint8Array {
+        return this.serialize();
+    }
+    static deserializeBinary(bytes: Uint8Array): ListWebhookDeliveriesResponse {
+        return ListWebhookDeliveriesResponse.deserialize(bytes);
+    }
+}
+export class SendTestEventRequest extends pb_1.Message {
+    #one_of_decls: number[][] = [];
+    constructor(data?: any[] | {
+        webhook_id?: string;
+    }) {
+        super();
+        pb_1.Message.initialize(this, Array.isArray(data) ? data : [], 0, -1, [], this.#one_of_decls);
+        if (!Array.isArray(data) && typeof data == "object") {
+            if ("webhook_id" in data && data.webhook_id != undefined) {
+                this.webhook_id = data.webhook_id;
+            }
+        }
+    }
+    get webhook_id() {
+        return pb_1.Message.getFieldWithDefault(this, 1, "") as string;
+    }
+    set webhook_id(value: string) {
+        pb_1.Message.setField(this, 1, value);
+    }
+    static fromObject(data: {
+        webhook_id?: string;
+    }): SendTestEventRequest {
+        const message = new SendTestEventRequest({});
+        if (data.webhook_id != null) {
+            message.webhook_id = data.webhook_id;
+        }
+        return message;
+    }
+    toObject() {
+        const data: {
+            webhook_id?: string;
+        } = {};
+        if (this.webhook_id != null) {
+            data.webhook_id = this.webhook_id;
+        }
+        return data;
+    }
+    serialize(): Uint8Array;
+    serialize(w: pb_1.BinaryWriter): void;
+    serialize(w?: pb_1.BinaryWriter): Uint8Array | void {
+        const writer = w || new pb_1.BinaryWriter();
+        if (this.webhook_id.length)
+            writer.writeString(1, this.webhook_id);
+        if (!w)
+            return writer.getResultBuffer();
+    }
+    static deserialize(bytes: Uint8Array | pb_1.BinaryReader): SendTestEventRequest {
+        const reader = bytes instanceof pb_1.BinaryReader ? bytes : new pb_1.BinaryReader(bytes), message = new SendTestEventRequest();
+        while (reader.nextField()) {
+            if (reader.isEndGroup())
+                break;
+            switch (reader.getFieldNumber()) {
+                case 1:
+                    message.webhook_id = reader.readString();
+                    break;
+                default: reader.skipField();
+            }
+        }
+        return message;
+    }
+    serializeBinary(): Uint8Array {
+        return this.serialize();
+    }
+    static deserializeBinary(bytes: Uint8Array): SendTestEventRequest {
+        return SendTestEventRequest.deserialize(bytes);
+    }
+}
+export class SubscribeRequest extends pb_1.Message {
+    #one_of_decls: number[][] = [];
+    constructor(data?: any[] | {
+        video_ids?: string[];
+    }) {
+        super();
+        pb_1.Message.initialize(this, Array.isArray(data) ? data : [], 0, -1, [1], this.#one_of_decls);
+        if (!Array.isArray(data) && typeof data == "object") {
+            if ("video_ids" in data && data.video_ids != undefined) {
+                this.video_ids = data.video_ids;
+            }
+        }
+    }
+    get video_ids() {
+        return pb_1.Message.getFieldWithDefault(this, 1, []) as string[];
+    }
+    set video_ids(value: string[]) {
+        pb_1.Message.setField(this, 1, value);
+    }
+    static fromObject(data: {
+        video_ids?: string[];
+    }): SubscribeRequest {
+        const message = new SubscribeRequest({});
+        if (data.video_ids != null) {
+            message.video_ids = data.video_ids;
+        }
+        return message;
+    }
+    toObject() {
+        const data: {
+            video_ids?: string[];
+        } = {};
+        if (this.video_ids != null) {
+            data.video_ids = this.video_ids;
+        }
+        return data;
+    }
+    serialize(): Uint8Array;
+    serialize(w: pb_1.BinaryWriter): void;
+    serialize(w?: pb_1.BinaryWriter): Uint8Array | void {
+        const writer = w || new pb_1.BinaryWriter();
+        if (this.video_ids.length)
+            writer.writeRepeatedString(1, this.video_ids);
+        if (!w)
+            return writer.getResultBuffer();
+    }
+    static deserialize(bytes: Uint8Array | pb_1.BinaryReader): SubscribeRequest {
+        const reader = bytes instanceof pb_1.BinaryReader ? bytes : new pb_1.BinaryReader(bytes), message = new SubscribeRequest();
+        while (reader.nextField()) {
+            if (reader.isEndGroup())
+                break;
+            switch (reader.getFieldNumber()) {
+                case 1:
+                    pb_1.Message.addToRepeatedField(message, 1, reader.readString());
+                    break;
+                default: reader.skipField();
+            }
+        }
+        return message;
+    }
+    serializeBinary(): Uint8Array {
+        return this.serialize();
+    }
+    static deserializeBinary(bytes: Uint8Array): SubscribeRequest {
+        return SubscribeRequest.deserialize(bytes);
+    }
+}
+export class LiveEvent extends pb_1.Message {
+    #one_of_decls: number[][] = [];
+    constructor(data?: any[] | {
+        id?: string;
+        type?: EventType;
+        actor_id?: string;
+        data?: Map<string, string>;
+        created_at?: dependency_1.Timestamp;
+    }) {
+        super();
+        pb_1.Message.initialize(this, Array.isArray(data) ? data : [], 0, -1, [], this.#one_of_decls);
+        if (!Array.isArray(data) && typeof data == "object") {
+            if ("id" in data && data.id != undefined) {
+                this.id = data.id;
+            }
+            if ("type" in data && data.type != undefined) {
+                this.type = data.type;
+            }
+            if ("actor_id" in data && data.actor_id != undefined) {
+                this.actor_id = data.actor_id;
+            }
+            if ("data" in data && data.data != undefined) {
+                this.data = data.data;
+            }
+            if ("created_at" in data && data.created_at != undefined) {
+                this.created_at = data.created_at;
+            }
+        }
+        if (!this.data)
+            this.data = new Map();
+    }
+    get id() {
+        return pb_1.Message.getFieldWithDefault(this, 1, "") as string;
+    }
+    set id(value: string) {
+        pb_1.Message.setField(this, 1, value);
+    }
+    get type() {
+        return pb_1.Message.getFieldWithDefault(this, 2, EventType.EVENT_TYPE_UNSPECIFIED) as EventType;
+    }
+    set type(value: EventType) {
+        pb_1.Message.setField(this, 2, value);
+    }
+    get actor_id() {
+        return pb_1.Message.getFieldWithDefault(this, 3, "") as string;
+    }
+    set actor_id(value: string) {
+        pb_1.Message.setField(this, 3, value);
+    }
+    get data() {
+        return pb_1.Message.getField(this, 4) as any as Map<string, string>;
+    }
+    set data(value: Map<string, string>) {
+        pb_1.Message.setField(this, 4, value as any);
+    }
+    get created_at() {
+        return pb_1.Message.getWrapperField(this, dependency_1.Timestamp, 5) as dependency_1.Timestamp;
+    }
+    set created_at(value: dependency_1.Timestamp) {
+        pb_1.Message.setWrapperField(this, 5, value);
+    }
+    get has_created_at() {
+        return pb_1.Message.getField(this, 5) != null;
+    }
+    static fromObject(data: {
+        id?: string;
+        type?: EventType;
+        actor_id?: string;
+        data?: {
+            [key: string]: string;
+        };
+        created_at?: ReturnType<typeof dependency_1.Timestamp.prototype.toObject>;
+    }): LiveEvent {
+        const message = new LiveEvent({});
+        if (data.id != null) {
+            message.id = data.id;
+        }
+        if (data.type != null) {
+            message.type = data.type;
+        }
+        if (data.actor_id != null) {
+            message.actor_id = data.actor_id;
+        }
+        if (typeof data.data == "object") {
+            message.data = new Map(Object.entries(data.data));
+        }
+        if (data.created_at != null) {
+            message.created_at = dependency_1.Timestamp.fromObject(data.created_at);
+        }
+        return message;
+    }
+    toObject() {
+        const data: {
+            id?: string;
+            type?: EventType;
+            actor_id?: string;
+            data?: {
+                [key: string]: string;
+            };
+            created_at?: ReturnType<typeof dependency_1.Timestamp.prototype.toObject>;
+        } = {};
+        if (this.id != null) {
+            data.id = this.id;
+        }
+        if (this.type != null) {
+            data.type = this.type;
+        }
+        if (this.actor_id != null) {
+            data.actor_id = this.actor_id;
+        }
+        if (this.data != null) {
+            data.data = (Object.fromEntries)(this.data);
+        }
+        if (this.created_at != null) {
+            data.created_at = this.created_at.toObject();
+        }
+        return data;
+    }
+    serialize(): Uint8Array;
+    serialize(w: pb_1.BinaryWriter): void;
+    serialize(w?: pb_1.BinaryWriter): Uint8Array | void {
+        const writer = w || new pb_1.BinaryWriter();
+        if (this.id.length)
+            writer.writeString(1, this.id);
+        if (this.type != EventType.EVENT_TYPE_UNSPECIFIED)
+            writer.writeEnum(2, this.type);
+        if (this.actor_id.length)
+            writer.writeString(3, this.actor_id);
+        for (const [key, value] of this.data) {
+            writer.writeMessage(4, this.data, () => {
+                writer.writeString(1, key);
+                writer.writeString(2, value);
+            });
+        }
+        if (this.has_created_at)
+            writer.writeMessage(5, this.created_at, () => this.created_at.serialize(writer));
+        if (!w)
+            return writer.getResultBuffer();
+    }
+    static deserialize(bytes: Uint8Array | pb_1.BinaryReader): LiveEvent {
+        const reader = bytes instanceof pb_1.BinaryReader ? bytes : new pb_1.BinaryReader(bytes), message = new LiveEvent();
+        while (reader.nextField()) {
+            if (reader.isEndGroup())
+                break;
+            switch (reader.getFieldNumber()) {
+                case 1:
+                    message.id = reader.readString();
+                    break;
+                case 2:
+                    message.type = reader.readEnum();
+                    break;
+                case 3:
+                    message.actor_id = reader.readString();
+                    break;
+                case 4:
+                    reader.readMessage(message, () => pb_1.Map.deserializeBinary(message.data as any, reader, reader.readString, reader.readString));
+                    break;
+                case 5:
+                    reader.readMessage(message.created_at, () => message.created_at = dependency_1.Timestamp.deserialize(reader));
+                    break;
+                default: reader.skipField();
+            }
+        }
+        return message;
+    }
+    serializeBinary(): Uint8Array {
+        return this.serialize();
+    }
+    static deserializeBinary(bytes: Uint8Array): LiveEvent {
+        return LiveEvent.deserialize(bytes);
+    }
+}
+export abstract class UnimplementedNotificationServiceService {
+    static definition = {
+        ListNotifications: {
+            path: "/notificationservice.NotificationService/ListNotifications",
+            requestStream: false,
+            responseStream: false,
+            requestSerialize: (message: ListNotificationsRequest) => Buffer.from(message.serialize()),
+            requestDeserialize: (bytes: Buffer) => ListNotificationsRequest.deserialize(new Uint8Array(bytes)),
+            responseSerialize: (message: ListNotificationsResponse) => Buffer.from(message.serialize()),
+            responseDeserialize: (bytes: Buffer) => ListNotificationsResponse.deserialize(new Uint8Array(bytes))
+        },
+        MarkRead: {
+            path: "/notificationservice.NotificationService/MarkRead",
+            requestStream: false,
+            responseStream: false,
+            requestSerialize: (message: MarkReadRequest) => Buffer.from(message.serialize()),
+            requestDeserialize: (bytes: Buffer) => MarkReadRequest.deserialize(new Uint8Array(bytes)),
+            responseSerialize: (message: MarkReadResponse) => Buffer.from(message.serialize()),
+            responseDeserialize: (bytes: Buffer) => MarkReadResponse.deserialize(new Uint8Array(bytes))
+        },
+        MarkAllRead: {
+            path: "/notificationservice.NotificationService/MarkAllRead",
+            requestStream: false,
+            responseStream: false,
+            requestSerialize: (message: MarkAllReadRequest) => Buffer.from(message.serialize()),
+            requestDeserialize: (bytes: Buffer) => MarkAllReadRequest.deserialize(new Uint8Array(bytes)),
+            responseSerialize: (message: MarkReadResponse) => Buffer.from(message.serialize()),
+            responseDeserialize: (bytes: Buffer) => MarkReadResponse.deserialize(new Uint8Array(bytes))
+        },
+        GetEmailPreferences: {
+            path: "/notificationservice.NotificationService/GetEmailPreferences",
+            requestStream: false,
+            responseStream: false,
+            requestSerialize: (message: GetEmailPreferencesRequest) => Buffer.from(message.serialize()),
+            requestDeserialize: (bytes: Buffer) => GetEmailPreferencesRequest.deserialize(new Uint8Array(bytes)),
+            responseSerialize: (message: EmailPreferences) => Buffer.from(message.serialize()),
+            responseDeserialize: (bytes: Buffer) => EmailPreferences.deserialize(new Uint8Array(bytes))
+        },
+        UpdateEmailPreferences: {
+            path: "/notificationservice.NotificationService/UpdateEmailPreferences",
+            requestStream: false,
+            responseStream: false,
+            requestSerialize: (message: UpdateEmailPreferencesRequest) => Buffer.from(message.serialize()),
+            requestDeserialize: (bytes: Buffer) => UpdateEmailPreferencesRequest.deserialize(new Uint8Array(bytes)),
+            responseSerialize: (message: EmailPreferences) => Buffer.from(message.serialize()),
+            responseDeserialize: (bytes: Buffer) => EmailPreferences.deserialize(new Uint8Array(bytes))
+        },
+        Subscribe: {
+            path: "/notificationservice.NotificationService/Subscribe",
+            requestStream: false,
+            responseStream: true,
+            requestSerialize: (message: SubscribeRequest) => Buffer.from(message.serialize()),
+            requestDeserialize: (bytes: Buffer) => SubscribeRequest.deserialize(new Uint8Array(bytes)),
+            responseSerialize: (message: LiveEvent) => Buffer.from(message.serialize()),
+            responseDeserialize: (bytes: Buffer) => LiveEvent.deserialize(new Uint8Array(bytes))
+        }
+    };
+    [method: string]: grpc_1.UntypedHandleCall;
+    abstract ListNotifications(call: grpc_1.ServerUnaryCall<ListNotificationsRequest, ListNotificationsResponse>, callback: grpc_1.sendUnaryData<ListNotificationsResponse>): void;
+    abstract MarkRead(call: grpc_1.ServerUnaryCall<MarkReadRequest, MarkReadResponse>, callback: grpc_1.sendUnaryData<MarkReadResponse>): void;
+    abstract MarkAllRead(call: grpc_1.ServerUnaryCall<MarkAllReadRequest, MarkReadResponse>, callback: grpc_1.sendUnaryData<MarkReadResponse>): void;
+    abstract GetEmailPreferences(call: grpc_1.ServerUnaryCall<GetEmailPreferencesRequest, EmailPreferences>, callback: grpc_1.sendUnaryData<EmailPreferences>): void;
+    abstract UpdateEmailPreferences(call: grpc_1.ServerUnaryCall<UpdateEmailPreferencesRequest, EmailPreferences>, callback: grpc_1.sendUnaryData<EmailPreferences>): void;
+    abstract Subscribe(call: grpc_1.ServerWritableStream<SubscribeRequest, LiveEvent>): void;
+}
+export class NotificationServiceClient {
+    private _address: string;
+    private _client: grpc_web_1.GrpcWebClientBase;
+    constructor(address: string, credentials?: Object, options?: grpc_web_1.GrpcWebClientBaseOptions) {
+        if (!options)
+            options = {};
+        options.format = options.format || "text";
+        this._address = address;
+        this._client = new grpc_web_1.GrpcWebClientBase(options);
+    }
+    private static ListNotifications = new grpc_web_1.MethodDescriptor<ListNotificationsRequest, ListNotificationsResponse>("/notificationservice.NotificationService/ListNotifications", grpc_web_1.MethodType.UNARY, ListNotificationsRequest, ListNotificationsResponse, (message: ListNotificationsRequest) => message.serialize(), ListNotificationsResponse.deserialize);
+    ListNotifications(message: ListNotificationsRequest, metadata: grpc_web_1.Metadata | null) {
+        return this._client.thenableCall<ListNotificationsRequest, ListNotificationsResponse>(this._address + "/notificationservice.NotificationService/ListNotifications", message, metadata || {}, NotificationServiceClient.ListNotifications);
+    }
+    private static MarkRead = new grpc_web_1.MethodDescriptor<MarkReadRequest, MarkReadResponse>("/notificationservice.NotificationService/MarkRead", grpc_web_1.MethodType.UNARY, MarkReadRequest, MarkReadResponse, (message: MarkReadRequest) => message.serialize(), MarkReadResponse.deserialize);
+    MarkRead(message: MarkReadRequest, metadata: grpc_web_1.Metadata | null) {
+        return this._client.thenableCall<MarkReadRequest, MarkReadResponse>(this._address + "/notificationservice.NotificationService/MarkRead", message, metadata || {}, NotificationServiceClient.MarkRead);
+    }
+    private static MarkAllRead = new grpc_web_1.MethodDescriptor<MarkAllReadRequest, MarkReadResponse>("/notificationservice.NotificationService/MarkAllRead", grpc_web_1.MethodType.UNARY, MarkAllReadRequest, MarkReadResponse, (message: MarkAllReadRequest) => message.serialize(), MarkReadResponse.deserialize);
+    MarkAllRead(message: MarkAllReadRequest, metadata: grpc_web_1.Metadata | null) {
+        return this._client.thenableCall<MarkAllReadRequest, MarkReadResponse>(this._address + "/notificationservice.NotificationService/MarkAllRead", message, metadata || {}, NotificationServiceClient.MarkAllRead);
+    }
+    private static GetEmailPreferences = new grpc_web_1.MethodDescriptor<GetEmailPreferencesRequest, EmailPreferences>("/notificationservice.NotificationService/GetEmailPreferences", grpc_web_1.MethodType.UNARY, GetEmailPreferencesRequest, EmailPreferences, (message: GetEmailPreferencesRequest) => message.serialize(), EmailPreferences.deserialize);
+    GetEmailPreferences(message: GetEmailPreferencesRequest, metadata: grpc_web_1.Metadata | null) {
+        return this._client.thenableCall<GetEmailPreferencesRequest, EmailPreferences>(this._address + "/notificationservice.NotificationService/GetEmailPreferences", message, metadata || {}, NotificationServiceClient.GetEmailPreferences);
+    }
+    private static UpdateEmailPreferences = new grpc_web_1.MethodDescriptor<UpdateEmailPreferencesRequest, EmailPreferences>("/notificationservice.NotificationService/UpdateEmailPreferences", grpc_web_1.MethodType.UNARY, UpdateEmailPreferencesRequest, EmailPreferences, (message: UpdateEmailPreferencesRequest) => message.serialize(), EmailPreferences.deserialize);
+    UpdateEmailPreferences(message: UpdateEmailPreferencesRequest, metadata: grpc_web_1.Metadata | null) {
+        return this._client.thenableCall<UpdateEmailPreferencesRequest, EmailPreferences>(this._address + "/notificationservice.NotificationService/UpdateEmailPreferences", message, metadata || {}, NotificationServiceClient.UpdateEmailPreferences);
+    }
+    private static Subscribe = new grpc_web_1.MethodDescriptor<SubscribeRequest, LiveEvent>("/notificationservice.NotificationService/Subscribe", grpc_web_1.MethodType.SERVER_STREAMING, SubscribeRequest, LiveEvent, (message: SubscribeRequest) => message.serialize(), LiveEvent.deserialize);
+    Subscribe(message: SubscribeRequest, metadata?: grpc_web_1.Metadata) {
+        return this._client.serverStreaming(this._address + "/notificationservice.NotificationService/Subscribe", message, metadata || {}, NotificationServiceClient.Subscribe);
+    }
+}
+export abstract class UnimplementedNotificationPublisherServiceService {
+    static definition = {
+        Publish: {
+            path: "/notificationservice.NotificationPublisherService/Publish",
+            requestStream: false,
+            responseStream: false,
+            requestSerialize: (message: PublishRequest) => Buffer.from(message.serialize()),
+            requestDeserialize: (bytes: Buffer) => PublishRequest.deserialize(new Uint8Array(bytes)),
+            responseSerialize: (message: PublishResponse) => Buffer.from(message.serialize()),
+            responseDeserialize: (bytes: Buffer) => PublishResponse.deserialize(new Uint8Array(bytes))
+        },
+        PublishEvent: {
+            path: "/notificationservice.NotificationPublisherService/PublishEvent",
+            requestStream: false,
+            responseStream: false,
+            requestSerialize: (message: PublishEventRequest) => Buffer.from(message.serialize()),
+            requestDeserialize: (bytes: Buffer) => PublishEventRequest.deserialize(new Uint8Array(bytes)),
+            responseSerialize: (message: PublishEventResponse) => Buffer.from(message.serialize()),
+            responseDeserialize: (bytes: Buffer) => PublishEventResponse.deserialize(new Uint8Array(bytes))
+        },
+        SendInvitation: {
+            path: "/notificationservice.NotificationPublisherService/SendInvitation",
+            requestStream: false,
+            responseStream: false,
+            requestSerialize: (message: SendInvitationRequest) => Buffer.from(message.serialize()),
+            requestDeserialize: (bytes: Buffer) => SendInvitationRequest.deserialize(new Uint8Array(bytes)),
+            responseSerialize: (message: SendInvitationResponse) => Buffer.from(message.serialize()),
+            responseDeserialize: (bytes: Buffer) => SendInvitationResponse.deserialize(new Uint8Array(bytes))
+        }
+    };
+    [method: string]: grpc_1.UntypedHandleCall;
+    abstract Publish(call: grpc_1.ServerUnaryCall<PublishRequest, PublishResponse>, callback: grpc_1.sendUnaryData<PublishResponse>): void;
+    abstract PublishEvent(call: grpc_1.ServerUnaryCall<PublishEventRequest, PublishEventResponse>, callback: grpc_1.sendUnaryData<PublishEventResponse>): void;
+    abstract SendInvitation(call: grpc_1.ServerUnaryCall<SendInvitationRequest, SendInvitationResponse>, callback: grpc_1.sendUnaryData<SendInvitationResponse>): void;
+}
+export class NotificationPublisherServiceClient {
+    private _address: string;
+    private _client: grpc_web_1.GrpcWebClientBase;
+    constructor(address: string, credentials?: Object, options?: grpc_web_1.GrpcWebClientBaseOptions) {
+        if (!options)
+            options = {};
+        options.format = options.format || "text";
+        this._address = address;
+        this._client = new grpc_web_1.GrpcWebClientBase(options);
+    }
+    private static Publish = new grpc_web_1.MethodDescriptor<PublishRequest, PublishResponse>("/notificationservice.NotificationPublisherService/Publish", grpc_web_1.MethodType.UNARY, PublishRequest, PublishResponse, (message: PublishRequest) => message.serialize(), PublishResponse.deserialize);
+    Publish(message: PublishRequest, metadata: grpc_web_1.Metadata | null) {
+        return this._client.thenableCall<PublishRequest, PublishResponse>(this._address + "/notificationservice.NotificationPublisherService/Publish", message, metadata || {}, NotificationPublisherServiceClient.Publish);
+    }
+    private static PublishEvent = new grpc_web_1.MethodDescriptor<PublishEventRequest, PublishEventResponse>("/notificationservice.NotificationPublisherService/PublishEvent", grpc_web_1.MethodType.UNARY, PublishEventRequest, PublishEventResponse, (message: PublishEventRequest) => message.serialize(), PublishEventResponse.deserialize);
+    PublishEvent(message: PublishEventRequest, metadata: grpc_web_1.Metadata | null) {
+        return this._client.thenableCall<PublishEventRequest, PublishEventResponse>(this._address + "/notificationservice.NotificationPublisherService/PublishEvent", message, metadata || {}, NotificationPublisherServiceClient.PublishEvent);
+    }
+    private static SendInvitation = new grpc_web_1.MethodDescriptor<SendInvitationRequest, SendInvitationResponse>("/notificationservice.NotificationPublisherService/SendInvitation", grpc_web_1.MethodType.UNARY, SendInvitationRequest, SendInvitationResponse, (message: SendInvitationRequest) => message.serialize(), SendInvitationResponse.deserialize);
+    SendInvitation(message: SendInvitationRequest, metadata: grpc_web_1.Metadata | null) {
+        return this._client.thenableCall<SendInvitationRequest, SendInvitationResponse>(this._address + "/notificationservice.NotificationPublisherService/SendInvitation", message, metadata || {}, NotificationPublisherServiceClient.SendInvitation);
+    }
+}
+export abstract class UnimplementedWebhookServiceService {
+    static definition = {
+        CreateWebhook: {
+            path: "/notificationservice.WebhookService/CreateWebhook",
+            requestStream: false,
+            responseStream: false,
+            requestSerialize: (message: CreateWebhookRequest) => Buffer.from(message.serialize()),
+            requestDeserialize: (bytes: Buffer) => CreateWebhookRequest.deserialize(new Uint8Array(bytes)),
+            responseSerialize: (message: CreateWebhookResponse) => Buffer.from(message.serialize()),
+            responseDeserialize: (bytes: Buffer) => CreateWebhookResponse.deserialize(new Uint8Array(bytes))
+        },
+        ListWebhooks: {
+            path: "/notificationservice.WebhookService/ListWebhooks",
+            requestStream: false,
+            responseStream: false,
+            requestSerialize: (message: ListWebhooksRequest) => Buffer.from(message.serialize()),
+            requestDeserialize: (bytes: Buffer) => ListWebhooksRequest.deserialize(new Uint8Array(bytes)),
+            responseSerialize: (message: ListWebhooksResponse) => Buffer.from(message.serialize()),
+            responseDeserialize: (bytes: Buffer) => ListWebhooksResponse.deserialize(new Uint8Array(bytes))
+        },
+        UpdateWebhook: {
+            path: "/notificationservice.WebhookService/UpdateWebhook",
+            requestStream: false,
+            responseStream: false,
+            requestSerialize: (message: UpdateWebhookRequest) => Buffer.from(message.serialize()),
+            requestDeserialize: (bytes: Buffer) => UpdateWebhookRequest.deserialize(new Uint8Array(bytes)),
+            responseSerialize: (message: Webhook) => Buffer.from(message.serialize()),
+            responseDeserialize: (bytes: Buffer) => Webhook.deserialize(new Uint8Array(bytes))
+        },
+        DeleteWebhook: {
+            path: "/notificationservice.WebhookService/DeleteWebhook",
+            requestStream: false,
+            responseStream: false,
+            requestSerialize: (message: DeleteWebhookRequest) => Buffer.from(message.serialize()),
+            requestDeserialize: (bytes: Buffer) => DeleteWebhookRequest.deserialize(new Uint8Array(bytes)),
+            responseSerialize: (message: DeleteWebhookResponse) => Buffer.from(message.serialize()),
+            responseDeserialize: (bytes: Buffer) => DeleteWebhookResponse.deserialize(new Uint8Array(bytes))
+        },
+        ListWebhookDeliveries: {
+            path: "/notificationservice.WebhookService/ListWebhookDeliveries",
+            requestStream: false,
+            responseStream: false,
+            requestSerialize: (message: ListWebhookDeliveriesRequest) => Buffer.from(message.serialize()),
+            requestDeserialize: (bytes: Buffer) => ListWebhookDeliveriesRequest.deserialize(new Uint8Array(bytes)),
+            responseSerialize: (message: ListWebhookDeliveriesResponse) => Buffer.from(message.serialize()),
+            responseDeserialize: (bytes: Buffer) => ListWebhookDeliveriesResponse.deserialize(new Uint8Array(bytes))
+        },
+        SendTestEvent: {
+            path: "/notificationservice.WebhookService/SendTestEvent",
+            requestStream: false,
+            responseStream: false,
+            requestSerialize: (message: SendTestEventRequest) => Buffer.from(message.serialize()),
+            requestDeserialize: (bytes: Buffer) => SendTestEventRequest.deserialize(new Uint8Array(bytes)),
+            responseSerialize: (message: WebhookDelivery) => Buffer.from(message.serialize()),
+            responseDeserialize: (bytes: Buffer) => WebhookDelivery.deserialize(new Uint8Array(bytes))
+        }
+    };
+    [method: string]: grpc_1.UntypedHandleCall;
+    abstract CreateWebhook(call: grpc_1.ServerUnaryCall<CreateWebhookRequest, CreateWebhookResponse>, callback: grpc_1.sendUnaryData<CreateWebhookResponse>): void;
+    abstract ListWebhooks(call: grpc_1.ServerUnaryCall<ListWebhooksRequest, ListWebhooksResponse>, callback: grpc_1.sendUnaryData<ListWebhooksResponse>): void;
+    abstract UpdateWebhook(call: grpc_1.ServerUnaryCall<UpdateWebhookRequest, Webhook>, callback: grpc_1.sendUnaryData<Webhook>): void;
+    abstract DeleteWebhook(call: grpc_1.ServerUnaryCall<DeleteWebhookRequest, DeleteWebhookResponse>, callback: grpc_1.sendUnaryData<DeleteWebhookResponse>): void;
+    abstract ListWebhookDeliveries(call: grpc_1.ServerUnaryCall<ListWebhookDeliveriesRequest, ListWebhookDeliveriesResponse>, callback: grpc_1.sendUnaryData<ListWebhookDeliveriesResponse>): void;
+    abstract SendTestEvent(call: grpc_1.ServerUnaryCall<SendTestEventRequest, WebhookDelivery>, callback: grpc_1.sendUnaryData<WebhookDelivery>): void;
+}
+export class WebhookServiceClient {
+    private _address: string;
+    private _client: grpc_web_1.GrpcWebClientBase;
+    constructor(address: string, credentials?: Object, options?: grpc_web_1.GrpcWebClientBaseOptions) {
+        if (!options)
+            options = {};
+        options.format = options.format || "text";
+        this._address = address;
+        this._client = new grpc_web_1.GrpcWebClientBase(options);
+    }
+    private static CreateWebhook = new grpc_web_1.MethodDescriptor<CreateWebhookRequest, CreateWebhookResponse>("/notificationservice.WebhookService/CreateWebhook", grpc_web_1.MethodType.UNARY, CreateWebhookRequest, CreateWebhookResponse, (message: CreateWebhookRequest) => message.serialize(), CreateWebhookResponse.deserialize);
+    CreateWebhook(message: CreateWebhookRequest, metadata: grpc_web_1.Metadata | null) {
+        return this._client.thenableCall<CreateWebhookRequest, CreateWebhookResponse>(this._address + "/notificationservice.WebhookService/CreateWebhook", message, metadata || {}, WebhookServiceClient.CreateWebhook);
+    }
+    private static ListWebhooks = new grpc_web_1.MethodDescriptor<ListWebhooksRequest, ListWebhooksResponse>("/notificationservice.WebhookService/ListWebhooks", grpc_web_1.MethodType.UNARY, ListWebhooksRequest, ListWebhooksResponse, (message: ListWebhooksRequest) => message.serialize(), ListWebhooksResponse.deserialize);
+    ListWebhooks(message: ListWebhooksRequest, metadata: grpc_web_1.Metadata | null) {
+        return this._client.thenableCall<ListWebhooksRequest, ListWebhooksResponse>(this._address + "/notificationservice.WebhookService/ListWebhooks", message, metadata || {}, WebhookServiceClient.ListWebhooks);
+    }
+    private static UpdateWebhook = new grpc_web_1.MethodDescriptor<UpdateWebhookRequest, Webhook>("/notificationservice.WebhookService/UpdateWebhook", grpc_web_1.MethodType.UNARY, UpdateWebhookRequest, Webhook, (message: UpdateWebhookRequest) => message.serialize(), Webhook.deserialize);
+    UpdateWebhook(message: UpdateWebhookRequest, metadata: grpc_web_1.Metadata | null) {
+        return this._client.thenableCall<UpdateWebhookRequest, Webhook>(this._address + "/notificationservice.WebhookService/UpdateWebhook", message, metadata || {}, WebhookServiceClient.UpdateWebhook);
+    }
+    private static DeleteWebhook = new grpc_web_1.MethodDescriptor<DeleteWebhookRequest, DeleteWebhookResponse>("/notificationservice.WebhookService/DeleteWebhook", grpc_web_1.MethodType.UNARY, DeleteWebhookRequest, DeleteWebhookResponse, (message: DeleteWebhookRequest) => message.serialize(), DeleteWebhookResponse.deserialize);
+    DeleteWebhook(message: DeleteWebhookRequest, metadata: grpc_web_1.Metadata | null) {
+        return this._client.thenableCall<DeleteWebhookRequest, DeleteWebhookResponse>(this._address + "/notificationservice.WebhookService/DeleteWebhook", message, metadata || {}, WebhookServiceClient.DeleteWebhook);
+    }
+    private static ListWebhookDeliveries = new grpc_web_1.MethodDescriptor<ListWebhookDeliveriesRequest, ListWebhookDeliveriesResponse>("/notificationservice.WebhookService/ListWebhookDeliveries", grpc_web_1.MethodType.UNARY, ListWebhookDeliveriesRequest, ListWebhookDeliveriesResponse, (message: ListWebhookDeliveriesRequest) => message.serialize(), ListWebhookDeliveriesResponse.deserialize);
+    ListWebhookDeliveries(message: ListWebhookDeliveriesRequest, metadata: grpc_web_1.Metadata | null) {
+        return this._client.thenableCall<ListWebhookDeliveriesRequest, ListWebhookDeliveriesResponse>(this._address + "/notificationservice.WebhookService/ListWebhookDeliveries", message, metadata || {}, WebhookServiceClient.ListWebhookDeliveries);
+    }
+    private static SendTestEvent = new grpc_web_1.MethodDescriptor<SendTestEventRequest, WebhookDelivery>("/notificationservice.WebhookService/SendTestEvent", grpc_web_1.MethodType.UNARY, SendTestEventRequest, WebhookDelivery, (message: SendTestEventRequest) => message.serialize(), WebhookDelivery.deserialize);
+    SendTestEvent(message: SendTestEventRequest, metadata: grpc_web_1.Metadata | null) {
+        return this._client.thenableCall<SendTestEventRequest, WebhookDelivery>(this._address + "/notificationservice.WebhookService/SendTestEvent", message, metadata || {}, WebhookServiceClient.SendTestEvent);
+    }
+}
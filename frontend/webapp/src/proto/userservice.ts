@@ -356,6 +356,7 @@ export class Tenant extends pb_1.Message {
         is_personal?: boolean;
         created_at?: dependency_1.Timestamp;
         created_by?: string;
+        purge_after?: dependency_1.Timestamp;
     }) {
         super();
         pb_1.Message.initialize(this, Array.isArray(data) ? data : [], 0, -1, [], this.#one_of_decls);
@@ -378,6 +379,9 @@ export class Tenant extends pb_1.Message {
             if ("created_by" in data && data.created_by != undefined) {
                 this.created_by = data.created_by;
             }
+            if ("purge_after" in data && data.purge_after != undefined) {
+                this.purge_after = data.purge_after;
+            }
         }
     }
     get id() {
@@ -419,6 +423,15 @@ export class Tenant extends pb_1.Message {
     set created_by(value: string) {
         pb_1.Message.setField(this, 6, value);
     }
+    get purge_after() {
+        return pb_1.Message.getWrapperField(this, dependency_1.Timestamp, 7) as dependency_1.Timestamp;
+    }
+    set purge_after(value: dependency_1.Timestamp) {
+        pb_1.Message.setWrapperField(this, 7, value);
+    }
+    get has_purge_after() {
+        return pb_1.Message.getField(this, 7) != null;
+    }
     static fromObject(data: {
         id?: string;
         name?: string;
@@ -426,6 +439,7 @@ export class Tenant extends pb_1.Message {
         is_personal?: boolean;
         created_at?: ReturnType<typeof dependency_1.Timestamp.prototype.toObject>;
         created_by?: string;
+        purge_after?: ReturnType<typeof dependency_1.Timestamp.prototype.toObject>;
     }): Tenant {
         const message = new Tenant({});
         if (data.id != null) {
@@ -446,6 +460,9 @@ export class Tenant extends pb_1.Message {
         if (data.created_by != null) {
             message.created_by = data.created_by;
         }
+        if (data.purge_after != null) {
+            message.purge_after = dependency_1.Timestamp.fromObject(data.purge_after);
+        }
         return message;
     }
     toObject() {
@@ -456,6 +473,7 @@ export class Tenant extends pb_1.Message {
             is_personal?: boolean;
             created_at?: ReturnType<typeof dependency_1.Timestamp.prototype.toObject>;
             created_by?: string;
+            purge_after?: ReturnType<typeof dependency_1.Timestamp.prototype.toObject>;
         } = {};
         if (this.id != null) {
             data.id = this.id;
@@ -475,6 +493,9 @@ export class Tenant extends pb_1.Message {
         if (this.created_by != null) {
             data.created_by = this.created_by;
         }
+        if (this.purge_after != null) {
+            data.purge_after = this.purge_after.toObject();
+        }
         return data;
     }
     serialize(): Uint8Array;
@@ -493,6 +514,8 @@ export class Tenant extends pb_1.Message {
             writer.writeMessage(5, this.created_at, () => this.created_at.serialize(writer));
         if (this.created_by.length)
             writer.writeString(6, this.created_by);
+        if (this.has_purge_after)
+            writer.writeMessage(7, this.purge_after, () => this.purge_after.serialize(writer));
         if (!w)
             return writer.getResultBuffer();
     }
@@ -520,6 +543,9 @@ export class Tenant extends pb_1.Message {
                 case 6:
                     message.created_by = reader.readString();
                     break;
+                case 7:
+                    reader.readMessage(message.purge_after, () => message.purge_after = dependency_1.Timestamp.deserialize(reader));
+                    break;
                 default: reader.skipField();
             }
         }
@@ -862,23 +888,47 @@ export class CreateTenantResponse extends pb_1.Message {
 }
 export class GetTenantsRequest extends pb_1.Message {
     #one_of_decls: number[][] = [];
-    constructor(data?: any[] | {}) {
+    constructor(data?: any[] | {
+        include_pending_deletion?: boolean;
+    }) {
         super();
         pb_1.Message.initialize(this, Array.isArray(data) ? data : [], 0, -1, [], this.#one_of_decls);
-        if (!Array.isArray(data) && typeof data == "object") { }
+        if (!Array.isArray(data) && typeof data == "object") {
+            if ("include_pending_deletion" in data && data.include_pending_deletion != undefined) {
+                this.include_pending_deletion = data.include_pending_deletion;
+            }
+        }
+    }
+    get include_pending_deletion() {
+        return pb_1.Message.getFieldWithDefault(this, 1, false) as boolean;
+    }
+    set include_pending_deletion(value: boolean) {
+        pb_1.Message.setField(this, 1, value);
     }
-    static fromObject(data: {}): GetTenantsRequest {
+    static fromObject(data: {
+        include_pending_deletion?: boolean;
+    }): GetTenantsRequest {
         const message = new GetTenantsRequest({});
+        if (data.include_pending_deletion != null) {
+            message.include_pending_deletion = data.include_pending_deletion;
+        }
         return message;
     }
     toObject() {
-        const data: {} = {};
+        const data: {
+            include_pending_deletion?: boolean;
+        } = {};
+        if (this.include_pending_deletion != null) {
+            data.include_pending_deletion = this.include_pending_deletion;
+        }
         return data;
     }
     serialize(): Uint8Array;
     serialize(w: pb_1.BinaryWriter): void;
     serialize(w?: pb_1.BinaryWriter): Uint8Array | void {
         const writer = w || new pb_1.BinaryWriter();
+        if (this.include_pending_deletion != false)
+            writer.writeBool(1, this.include_pending_deletion);
         if (!w)
             return writer.getResultBuffer();
     }
@@ -888,6 +938,9 @@ export class GetTenantsRequest extends pb_1.Message {
             if (reader.isEndGroup())
                 break;
             switch (reader.getFieldNumber()) {
+                case 1:
+                    message.include_pending_deletion = reader.readBool();
+                    break;
                 default: reader.skipField();
             }
         }
@@ -1170,61 +1223,205 @@ export class AddUserResponse extends pb_1.Message {
         return AddUserResponse.deserialize(bytes);
     }
 }
-export class GetUsersRequest extends pb_1.Message {
+export class Invitation extends pb_1.Message {
     #one_of_decls: number[][] = [];
     constructor(data?: any[] | {
+        id?: string;
         tenant_id?: string;
+        email?: string;
+        role?: string;
+        invited_by?: string;
+        created_at?: dependency_1.Timestamp;
+        expires_at?: dependency_1.Timestamp;
     }) {
         super();
         pb_1.Message.initialize(this, Array.isArray(data) ? data : [], 0, -1, [], this.#one_of_decls);
         if (!Array.isArray(data) && typeof data == "object") {
+            if ("id" in data && data.id != undefined) {
+                this.id = data.id;
+            }
             if ("tenant_id" in data && data.tenant_id != undefined) {
                 this.tenant_id = data.tenant_id;
             }
+            if ("email" in data && data.email != undefined) {
+                this.email = data.email;
+            }
+            if ("role" in data && data.role != undefined) {
+                this.role = data.role;
+            }
+            if ("invited_by" in data && data.invited_by != undefined) {
+                this.invited_by = data.invited_by;
+            }
+            if ("created_at" in data && data.created_at != undefined) {
+                this.created_at = data.created_at;
+            }
+            if ("expires_at" in data && data.expires_at != undefined) {
+                this.expires_at = data.expires_at;
+            }
         }
     }
-    get tenant_id() {
+    get id() {
         return pb_1.Message.getFieldWithDefault(this, 1, "") as string;
     }
-    set tenant_id(value: string) {
+    set id(value: string) {
         pb_1.Message.setField(this, 1, value);
     }
+    get tenant_id() {
+        return pb_1.Message.getFieldWithDefault(this, 2, "") as string;
+    }
+    set tenant_id(value: string) {
+        pb_1.Message.setField(this, 2, value);
+    }
+    get email() {
+        return pb_1.Message.getFieldWithDefault(this, 3, "") as string;
+    }
+    set email(value: string) {
+        pb_1.Message.setField(this, 3, value);
+    }
+    get role() {
+        return pb_1.Message.getFieldWithDefault(this, 4, "") as string;
+    }
+    set role(value: string) {
+        pb_1.Message.setField(this, 4, value);
+    }
+    get invited_by() {
+        return pb_1.Message.getFieldWithDefault(this, 5, "") as string;
+    }
+    set invited_by(value: string) {
+        pb_1.Message.setField(this, 5, value);
+    }
+    get created_at() {
+        return pb_1.Message.getWrapperField(this, dependency_1.Timestamp, 6) as dependency_1.Timestamp;
+    }
+    set created_at(value: dependency_1.Timestamp) {
+        pb_1.Message.setWrapperField(this, 6, value);
+    }
+    get has_created_at() {
+        return pb_1.Message.getField(this, 6) != null;
+    }
+    get expires_at() {
+        return pb_1.Message.getWrapperField(this, dependency_1.Timestamp, 7) as dependency_1.Timestamp;
+    }
+    set expires_at(value: dependency_1.Timestamp) {
+        pb_1.Message.setWrapperField(this, 7, value);
+    }
+    get has_expires_at() {
+        return pb_1.Message.getField(this, 7) != null;
+    }
     static fromObject(data: {
+        id?: string;
         tenant_id?: string;
-    }): GetUsersRequest {
-        const message = new GetUsersRequest({});
+        email?: string;
+        role?: string;
+        invited_by?: string;
+        created_at?: ReturnType<typeof dependency_1.Timestamp.prototype.toObject>;
+        expires_at?: ReturnType<typeof dependency_1.Timestamp.prototype.toObject>;
+    }): Invitation {
+        const message = new Invitation({});
+        if (data.id != null) {
+            message.id = data.id;
+        }
         if (data.tenant_id != null) {
             message.tenant_id = data.tenant_id;
         }
+        if (data.email != null) {
+            message.email = data.email;
+        }
+        if (data.role != null) {
+            message.role = data.role;
+        }
+        if (data.invited_by != null) {
+            message.invited_by = data.invited_by;
+        }
+        if (data.created_at != null) {
+            message.created_at = dependency_1.Timestamp.fromObject(data.created_at);
+        }
+        if (data.expires_at != null) {
+            message.expires_at = dependency_1.Timestamp.fromObject(data.expires_at);
+        }
         return message;
     }
     toObject() {
         const data: {
+            id?: string;
             tenant_id?: string;
+            email?: string;
+            role?: string;
+            invited_by?: string;
+            created_at?: ReturnType<typeof dependency_1.Timestamp.prototype.toObject>;
+            expires_at?: ReturnType<typeof dependency_1.Timestamp.prototype.toObject>;
         } = {};
+        if (this.id != null) {
+            data.id = this.id;
+        }
         if (this.tenant_id != null) {
             data.tenant_id = this.tenant_id;
         }
+        if (this.email != null) {
+            data.email = this.email;
+        }
+        if (this.role != null) {
+            data.role = this.role;
+        }
+        if (this.invited_by != null) {
+            data.invited_by = this.invited_by;
+        }
+        if (this.created_at != null) {
+            data.created_at = this.created_at.toObject();
+        }
+        if (this.expires_at != null) {
+            data.expires_at = this.expires_at.toObject();
+        }
         return data;
     }
     serialize(): Uint8Array;
     serialize(w: pb_1.BinaryWriter): void;
     serialize(w?: pb_1.BinaryWriter): Uint8Array | void {
         const writer = w || new pb_1.BinaryWriter();
+        if (this.id.length)
+            writer.writeString(1, this.id);
         if (this.tenant_id.length)
-            writer.writeString(1, this.tenant_id);
+            writer.writeString(2, this.tenant_id);
+        if (this.email.length)
+            writer.writeString(3, this.email);
+        if (this.role.length)
+            writer.writeString(4, this.role);
+        if (this.invited_by.length)
+            writer.writeString(5, this.invited_by);
+        if (this.has_created_at)
+            writer.writeMessage(6, this.created_at, () => this.created_at.serialize(writer));
+        if (this.has_expires_at)
+            writer.writeMessage(7, this.expires_at, () => this.expires_at.serialize(writer));
         if (!w)
             return writer.getResultBuffer();
     }
-    static deserialize(bytes: Uint8Array | pb_1.BinaryReader): GetUsersRequest {
-        const reader = bytes instanceof pb_1.BinaryReader ? bytes : new pb_1.BinaryReader(bytes), message = new GetUsersRequest();
+    static deserialize(bytes: Uint8Array | pb_1.BinaryReader): Invitation {
+        const reader = bytes instanceof pb_1.BinaryReader ? bytes : new pb_1.BinaryReader(bytes), message = new Invitation();
         while (reader.nextField()) {
             if (reader.isEndGroup())
                 break;
             switch (reader.getFieldNumber()) {
                 case 1:
+                    message.id = reader.readString();
+                    break;
+                case 2:
                     message.tenant_id = reader.readString();
                     break;
+                case 3:
+                    message.email = reader.readString();
+                    break;
+                case 4:
+                    message.role = reader.readString();
+                    break;
+                case 5:
+                    message.invited_by = reader.readString();
+                    break;
+                case 6:
+                    reader.readMessage(message.created_at, () => message.created_at = dependency_1.Timestamp.deserialize(reader));
+                    break;
+                case 7:
+                    reader.readMessage(message.expires_at, () => message.expires_at = dependency_1.Timestamp.deserialize(reader));
+                    break;
                 default: reader.skipField();
             }
         }
@@ -1233,62 +1430,80 @@ export class GetUsersRequest extends pb_1.Message {
     serializeBinary(): Uint8Array {
         return this.serialize();
     }
-    static deserializeBinary(bytes: Uint8Array): GetUsersRequest {
-        return GetUsersRequest.deserialize(bytes);
+    static deserializeBinary(bytes: Uint8Array): Invitation {
+        return Invitation.deserialize(bytes);
     }
 }
-export class GetUsersResponse extends pb_1.Message {
+export class InviteUserRequest extends pb_1.Message {
     #one_of_decls: number[][] = [];
     constructor(data?: any[] | {
-        message?: string;
-        tenant_users?: TenantUser[];
+        tenant_id?: string;
+        email?: string;
+        role?: string;
     }) {
         super();
-        pb_1.Message.initialize(this, Array.isArray(data) ? data : [], 0, -1, [2], this.#one_of_decls);
+        pb_1.Message.initialize(this, Array.isArray(data) ? data : [], 0, -1, [], this.#one_of_decls);
         if (!Array.isArray(data) && typeof data == "object") {
-            if ("message" in data && data.message != undefined) {
-                this.message = data.message;
+            if ("tenant_id" in data && data.tenant_id != undefined) {
+                this.tenant_id = data.tenant_id;
             }
-            if ("tenant_users" in data && data.tenant_users != undefined) {
-                this.tenant_users = data.tenant_users;
+            if ("email" in data && data.email != undefined) {
+                this.email = data.email;
+            }
+            if ("role" in data && data.role != undefined) {
+                this.role = data.role;
             }
         }
     }
-    get message() {
+    get tenant_id() {
         return pb_1.Message.getFieldWithDefault(this, 1, "") as string;
     }
-    set message(value: string) {
+    set tenant_id(value: string) {
         pb_1.Message.setField(this, 1, value);
     }
-    get tenant_users() {
-        return pb_1.Message.getRepeatedWrapperField(this, TenantUser, 2) as TenantUser[];
+    get email() {
+        return pb_1.Message.getFieldWithDefault(this, 2, "") as string;
     }
-    set tenant_users(value: TenantUser[]) {
-        pb_1.Message.setRepeatedWrapperField(this, 2, value);
+    set email(value: string) {
+        pb_1.Message.setField(this, 2, value);
+    }
+    get role() {
+        return pb_1.Message.getFieldWithDefault(this, 3, "") as string;
+    }
+    set role(value: string) {
+        pb_1.Message.setField(this, 3, value);
     }
     static fromObject(data: {
-        message?: string;
-        tenant_users?: ReturnType<typeof TenantUser.prototype.toObject>[];
-    }): GetUsersResponse {
-        const message = new GetUsersResponse({});
-        if (data.message != null) {
-            message.message = data.message;
+        tenant_id?: string;
+        email?: string;
+        role?: string;
+    }): InviteUserRequest {
+        const message = new InviteUserRequest({});
+        if (data.tenant_id != null) {
+            message.tenant_id = data.tenant_id;
         }
-        if (data.tenant_users != null) {
-            message.tenant_users = data.tenant_users.map(item => TenantUser.fromObject(item));
+        if (data.email != null) {
+            message.email = data.email;
+        }
+        if (data.role != null) {
+            message.role = data.role;
         }
         return message;
     }
     toObject() {
         const data: {
-            message?: string;
-            tenant_users?: ReturnType<typeof TenantUser.prototype.toObject>[];
+            tenant_id?: string;
+            email?: string;
+            role?: string;
         } = {};
-        if (this.message != null) {
-            data.message = this.message;
+        if (this.tenant_id != null) {
+            data.tenant_id = this.tenant_id;
         }
-        if (this.tenant_users != null) {
-            data.tenant_users = this.tenant_users.map((item: TenantUser) => item.toObject());
+        if (this.email != null) {
+            data.email = this.email;
+        }
+        if (this.role != null) {
+            data.role = this.role;
         }
         return data;
     }
@@ -1296,24 +1511,29 @@ export class GetUsersResponse extends pb_1.Message {
     serialize(w: pb_1.BinaryWriter): void;
     serialize(w?: pb_1.BinaryWriter): Uint8Array | void {
         const writer = w || new pb_1.BinaryWriter();
-        if (this.message.length)
-            writer.writeString(1, this.message);
-        if (this.tenant_users.length)
-            writer.writeRepeatedMessage(2, this.tenant_users, (item: TenantUser) => item.serialize(writer));
+        if (this.tenant_id.length)
+            writer.writeString(1, this.tenant_id);
+        if (this.email.length)
+            writer.writeString(2, this.email);
+        if (this.role.length)
+            writer.writeString(3, this.role);
         if (!w)
             return writer.getResultBuffer();
     }
-    static deserialize(bytes: Uint8Array | pb_1.BinaryReader): GetUsersResponse {
-        const reader = bytes instanceof pb_1.BinaryReader ? bytes : new pb_1.BinaryReader(bytes), message = new GetUsersResponse();
+    static deserialize(bytes: Uint8Array | pb_1.BinaryReader): InviteUserRequest {
+        const reader = bytes instanceof pb_1.BinaryReader ? bytes : new pb_1.BinaryReader(bytes), message = new InviteUserRequest();
         while (reader.nextField()) {
             if (reader.isEndGroup())
                 break;
             switch (reader.getFieldNumber()) {
                 case 1:
-                    message.message = reader.readString();
+                    message.tenant_id = reader.readString();
                     break;
                 case 2:
-                    reader.readMessage(message.tenant_users, () => pb_1.Message.addToRepeatedWrapperField(message, 2, TenantUser.deserialize(reader), TenantUser));
+                    message.email = reader.readString();
+                    break;
+                case 3:
+                    message.role = reader.readString();
                     break;
                 default: reader.skipField();
             }
@@ -1323,35 +1543,4302 @@ export class GetUsersResponse extends pb_1.Message {
     serializeBinary(): Uint8Array {
         return this.serialize();
     }
-    static deserializeBinary(bytes: Uint8Array): GetUsersResponse {
-        return GetUsersResponse.deserialize(bytes);
+    static deserializeBinary(bytes: Uint8Array): InviteUserRequest {
+        return InviteUserRequest.deserialize(bytes);
     }
 }
-export abstract class UnimplementedUserServiceService {
-    static definition = {
-        CreateUserIfNotExists: {
-            path: "/userservice.UserService/CreateUserIfNotExists",
-            requestStream: false,
-            responseStream: false,
-            requestSerialize: (message: CreateUserRequest) => Buffer.from(message.serialize()),
-            requestDeserialize: (bytes: Buffer) => CreateUserRequest.deserialize(new Uint8Array(bytes)),
-            responseSerialize: (message: CreateUserResponse) => Buffer.from(message.serialize()),
-            responseDeserialize: (bytes: Buffer) => CreateUserResponse.deserialize(new Uint8Array(bytes))
-        },
-        GetTenants: {
-            path: "/userservice.UserService/GetTenants",
-            requestStream: false,
-            responseStream: false,
-            requestSerialize: (message: GetTenantsRequest) => Buffer.from(message.serialize()),
-            requestDeserialize: (bytes: Buffer) => GetTenantsRequest.deserialize(new Uint8Array(bytes)),
-            responseSerialize: (message: GetTenantsResponse) => Buffer.from(message.serialize()),
-            responseDeserialize: (bytes: Buffer) => GetTenantsResponse.deserialize(new Uint8Array(bytes))
-        }
-    };
-    [method: string]: grpc_1.UntypedHandleCall;
-    abstract CreateUserIfNotExists(call: grpc_1.ServerUnaryCall<CreateUserRequest, CreateUserResponse>, callback: grpc_1.sendUnaryData<CreateUserResponse>): void;
-    abstract GetTenants(call: grpc_1.ServerUnaryCall<GetTenantsRequest, GetTenantsResponse>, callback: grpc_1.sendUnaryData<GetTenantsResponse>): void;
-}
+export class InviteUserResponse extends pb_1.Message {
+    #one_of_decls: number[][] = [];
+    constructor(data?: any[] | {
+        invitation?: Invitation;
+        email_queued?: boolean;
+    }) {
+        super();
+        pb_1.Message.initialize(this, Array.isArray(data) ? data : [], 0, -1, [], this.#one_of_decls);
+        if (!Array.isArray(data) && typeof data == "object") {
+            if ("invitation" in data && data.invitation != undefined) {
+                this.invitation = data.invitation;
+            }
+            if ("email_queued" in data && data.email_queued != undefined) {
+                this.email_queued = data.email_queued;
+            }
+        }
+    }
+    get invitation() {
+        return pb_1.Message.getWrapperField(this, Invitation, 1) as Invitation;
+    }
+    set invitation(value: Invitation) {
+        pb_1.Message.setWrapperField(this, 1, value);
+    }
+    get has_invitation() {
+        return pb_1.Message.getField(this, 1) != null;
+    }
+    get email_queued() {
+        return pb_1.Message.getFieldWithDefault(this, 2, false) as boolean;
+    }
+    set email_queued(value: boolean) {
+        pb_1.Message.setField(this, 2, value);
+    }
+    static fromObject(data: {
+        invitation?: ReturnType<typeof Invitation.prototype.toObject>;
+        email_queued?: boolean;
+    }): InviteUserResponse {
+        const message = new InviteUserResponse({});
+        if (data.invitation != null) {
+            message.invitation = Invitation.fromObject(data.invitation);
+        }
+        if (data.email_queued != null) {
+            message.email_queued = data.email_queued;
+        }
+        return message;
+    }
+    toObject() {
+        const data: {
+            invitation?: ReturnType<typeof Invitation.prototype.toObject>;
+            email_queued?: boolean;
+        } = {};
+        if (this.invitation != null) {
+            data.invitation = this.invitation.toObject();
+        }
+        if (this.email_queued != null) {
+            data.email_queued = this.email_queued;
+        }
+        return data;
+    }
+    serialize(): Uint8Array;
+    serialize(w: pb_1.BinaryWriter): void;
+    serialize(w?: pb_1.BinaryWriter): Uint8Array | void {
+        const writer = w || new pb_1.BinaryWriter();
+        if (this.has_invitation)
+            writer.writeMessage(1, this.invitation, () => this.invitation.serialize(writer));
+        if (this.email_queued != false)
+            writer.writeBool(2, this.email_queued);
+        if (!w)
+            return writer.getResultBuffer();
+    }
+    static deserialize(bytes: Uint8Array | pb_1.BinaryReader): InviteUserResponse {
+        const reader = bytes instanceof pb_1.BinaryReader ? bytes : new pb_1.BinaryReader(bytes), message = new InviteUserResponse();
+        while (reader.nextField()) {
+            if (reader.isEndGroup())
+                break;
+            switch (reader.getFieldNumber()) {
+                case 1:
+                    reader.readMessage(message.invitation, () => message.invitation = Invitation.deserialize(reader));
+                    break;
+                case 2:
+                    message.email_queued = reader.readBool();
+                    break;
+                default: reader.skipField();
+            }
+        }
+        return message;
+    }
+    serializeBinary(): Uint8Array {
+        return this.serialize();
+    }
+    static deserializeBinary(bytes: Uint8Array): InviteUserResponse {
+        return InviteUserResponse.deserialize(bytes);
+    }
+}
+export class ListInvitationsRequest extends pb_1.Message {
+    #one_of_decls: number[][] = [];
+    constructor(data?: any[] | {
+        tenant_id?: string;
+    }) {
+        super();
+        pb_1.Message.initialize(this, Array.isArray(data) ? data : [], 0, -1, [], this.#one_of_decls);
+        if (!Array.isArray(data) && typeof data == "object") {
+            if ("tenant_id" in data && data.tenant_id != undefined) {
+                this.tenant_id = data.tenant_id;
+            }
+        }
+    }
+    get tenant_id() {
+        return pb_1.Message.getFieldWithDefault(this, 1, "") as string;
+    }
+    set tenant_id(value: string) {
+        pb_1.Message.setField(this, 1, value);
+    }
+    static fromObject(data: {
+        tenant_id?: string;
+    }): ListInvitationsRequest {
+        const message = new ListInvitationsRequest({});
+        if (data.tenant_id != null) {
+            message.tenant_id = data.tenant_id;
+        }
+        return message;
+    }
+    toObject() {
+        const data: {
+            tenant_id?: string;
+        } = {};
+        if (this.tenant_id != null) {
+            data.tenant_id = this.tenant_id;
+        }
+        return data;
+    }
+    serialize(): Uint8Array;
+    serialize(w: pb_1.BinaryWriter): void;
+    serialize(w?: pb_1.BinaryWriter): Uint8Array | void {
+        const writer = w || new pb_1.BinaryWriter();
+        if (this.tenant_id.length)
+            writer.writeString(1, this.tenant_id);
+        if (!w)
+            return writer.getResultBuffer();
+    }
+    static deserialize(bytes: Uint8Array | pb_1.BinaryReader): ListInvitationsRequest {
+        const reader = bytes instanceof pb_1.BinaryReader ? bytes : new pb_1.BinaryReader(bytes), message = new ListInvitationsRequest();
+        while (reader.nextField()) {
+            if (reader.isEndGroup())
+                break;
+            switch (reader.getFieldNumber()) {
+                case 1:
+                    message.tenant_id = reader.readString();
+                    break;
+                default: reader.skipField();
+            }
+        }
+        return message;
+    }
+    serializeBinary(): Uint8Array {
+        return this.serialize();
+    }
+    static deserializeBinary(bytes: Uint8Array): ListInvitationsRequest {
+        return ListInvitationsRequest.deserialize(bytes);
+    }
+}
+export class ListInvitationsResponse extends pb_1.Message {
+    #one_of_decls: number[][] = [];
+    constructor(data?: any[] | {
+        invitations?: Invitation[];
+    }) {
+        super();
+        pb_1.Message.initialize(this, Array.isArray(data) ? data : [], 0, -1, [1], this.#one_of_decls);
+        if (!Array.isArray(data) && typeof data == "object") {
+            if ("invitations" in data && data.invitations != undefined) {
+                this.invitations = data.invitations;
+            }
+        }
+    }
+    get invitations() {
+        return pb_1.Message.getRepeatedWrapperField(this, Invitation, 1) as Invitation[];
+    }
+    set invitations(value: Invitation[]) {
+        pb_1.Message.setRepeatedWrapperField(this, 1, value);
+    }
+    static fromObject(data: {
+        invitations?: ReturnType<typeof Invitation.prototype.toObject>[];
+    }): ListInvitationsResponse {
+        const message = new ListInvitationsResponse({});
+        if (data.invitations != null) {
+            message.invitations = data.invitations.map(item => Invitation.fromObject(item));
+        }
+        return message;
+    }
+    toObject() {
+        const data: {
+            invitations?: ReturnType<typeof Invitation.prototype.toObject>[];
+        } = {};
+        if (this.invitations != null) {
+            data.invitations = this.invitations.map((item: Invitation) => item.toObject());
+        }
+        return data;
+    }
+    serialize(): Uint8Array;
+    serialize(w: pb_1.BinaryWriter): void;
+    serialize(w?: pb_1.BinaryWriter): Uint8Array | void {
+        const writer = w || new pb_1.BinaryWriter();
+        if (this.invitations.length)
+            writer.writeRepeatedMessage(1, this.invitations, (item: Invitation) => item.serialize(writer));
+        if (!w)
+            return writer.getResultBuffer();
+    }
+    static deserialize(bytes: Uint8Array | pb_1.BinaryReader): ListInvitationsResponse {
+        const reader = bytes instanceof pb_1.BinaryReader ? bytes : new pb_1.BinaryReader(bytes), message = new ListInvitationsResponse();
+        while (reader.nextField()) {
+            if (reader.isEndGroup())
+                break;
+            switch (reader.getFieldNumber()) {
+                case 1:
+                    reader.readMessage(message.invitations, () => pb_1.Message.addToRepeatedWrapperField(message, 1, Invitation.deserialize(reader), Invitation));
+                    break;
+                default: reader.skipField();
+            }
+        }
+        return message;
+    }
+    serializeBinary(): Uint8Array {
+        return this.serialize();
+    }
+    static deserializeBinary(bytes: Uint8Array): ListInvitationsResponse {
+        return ListInvitationsResponse.deserialize(bytes);
+    }
+}
+export class RevokeInvitationRequest extends pb_1.Message {
+    #one_of_decls: number[][] = [];
+    constructor(data?: any[] | {
+        tenant_id?: string;
+        id?: string;
+    }) {
+        super();
+        pb_1.Message.initialize(this, Array.isArray(data) ? data : [], 0, -1, [], this.#one_of_decls);
+        if (!Array.isArray(data) && typeof data == "object") {
+            if ("tenant_id" in data && data.tenant_id != undefined) {
+                this.tenant_id = data.tenant_id;
+            }
+            if ("id" in data && data.id != undefined) {
+                this.id = data.id;
+            }
+        }
+    }
+    get tenant_id() {
+        return pb_1.Message.getFieldWithDefault(this, 1, "") as string;
+    }
+    set tenant_id(value: string) {
+        pb_1.Message.setField(this, 1, value);
+    }
+    get id() {
+        return pb_1.Message.getFieldWithDefault(this, 2, "") as string;
+    }
+    set id(value: string) {
+        pb_1.Message.setField(this, 2, value);
+    }
+    static fromObject(data: {
+        tenant_id?: string;
+        id?: string;
+    }): RevokeInvitationRequest {
+        const message = new RevokeInvitationRequest({});
+        if (data.tenant_id != null) {
+            message.tenant_id = data.tenant_id;
+        }
+        if (data.id != null) {
+            message.id = data.id;
+        }
+        return message;
+    }
+    toObject() {
+        const data: {
+            tenant_id?: string;
+            id?: string;
+        } = {};
+        if (this.tenant_id != null) {
+            data.tenant_id = this.tenant_id;
+        }
+        if (this.id != null) {
+            data.id = this.id;
+        }
+        return data;
+    }
+    serialize(): Uint8Array;
+    serialize(w: pb_1.BinaryWriter): void;
+    serialize(w?: pb_1.BinaryWriter): Uint8Array | void {
+        const writer = w || new pb_1.BinaryWriter();
+        if (this.tenant_id.length)
+            writer.writeString(1, this.tenant_id);
+        if (this.id.length)
+            writer.writeString(2, this.id);
+        if (!w)
+            return writer.getResultBuffer();
+    }
+    static deserialize(bytes: Uint8Array | pb_1.BinaryReader): RevokeInvitationRequest {
+        const reader = bytes instanceof pb_1.BinaryReader ? bytes : new pb_1.BinaryReader(bytes), message = new RevokeInvitationRequest();
+        while (reader.nextField()) {
+            if (reader.isEndGroup())
+                break;
+            switch (reader.getFieldNumber()) {
+                case 1:
+                    message.tenant_id = reader.readString();
+                    break;
+                case 2:
+                    message.id = reader.readString();
+                    break;
+                default: reader.skipField();
+            }
+        }
+        return message;
+    }
+    serializeBinary(): Uint8Array {
+        return this.serialize();
+    }
+    static deserializeBinary(bytes: Uint8Array): RevokeInvitationRequest {
+        return RevokeInvitationRequest.deserialize(bytes);
+    }
+}
+export class RevokeInvitationResponse extends pb_1.Message {
+    #one_of_decls: number[][] = [];
+    constructor(data?: any[] | {
+        message?: string;
+    }) {
+        super();
+        pb_1.Message.initialize(this, Array.isArray(data) ? data : [], 0, -1, [], this.#one_of_decls);
+        if (!Array.isArray(data) && typeof data == "object") {
+            if ("message" in data && data.message != undefined) {
+                this.message = data.message;
+            }
+        }
+    }
+    get message() {
+        return pb_1.Message.getFieldWithDefault(this, 1, "") as string;
+    }
+    set message(value: string) {
+        pb_1.Message.setField(this, 1, value);
+    }
+    static fromObject(data: {
+        message?: string;
+    }): RevokeInvitationResponse {
+        const message = new RevokeInvitationResponse({});
+        if (data.message != null) {
+            message.message = data.message;
+        }
+        return message;
+    }
+    toObject() {
+        const data: {
+            message?: string;
+        } = {};
+        if (this.message != null) {
+            data.message = this.message;
+        }
+        return data;
+    }
+    serialize(): Uint8Array;
+    serialize(w: pb_1.BinaryWriter): void;
+    serialize(w?: pb_1.BinaryWriter): Uint8Array | void {
+        const writer = w || new pb_1.BinaryWriter();
+        if (this.message.length)
+            writer.writeString(1, this.message);
+        if (!w)
+            return writer.getResultBuffer();
+    }
+    static deserialize(bytes: Uint8Array | pb_1.BinaryReader): RevokeInvitationResponse {
+        const reader = bytes instanceof pb_1.BinaryReader ? bytes : new pb_1.BinaryReader(bytes), message = new RevokeInvitationResponse();
+        while (reader.nextField()) {
+            if (reader.isEndGroup())
+                break;
+            switch (reader.getFieldNumber()) {
+                case 1:
+                    message.message = reader.readString();
+                    break;
+                default: reader.skipField();
+            }
+        }
+        return message;
+    }
+    serializeBinary(): Uint8Array {
+        return this.serialize();
+    }
+    static deserializeBinary(bytes: Uint8Array): RevokeInvitationResponse {
+        return RevokeInvitationResponse.deserialize(bytes);
+    }
+}
+export class AcceptInvitationRequest extends pb_1.Message {
+    #one_of_decls: number[][] = [];
+    constructor(data?: any[] | {
+        token?: string;
+    }) {
+        super();
+        pb_1.Message.initialize(this, Array.isArray(data) ? data : [], 0, -1, [], this.#one_of_decls);
+        if (!Array.isArray(data) && typeof data == "object") {
+            if ("token" in data && data.token != undefined) {
+                this.token = data.token;
+            }
+        }
+    }
+    get token() {
+        return pb_1.Message.getFieldWithDefault(this, 1, "") as string;
+    }
+    set token(value: string) {
+        pb_1.Message.setField(this, 1, value);
+    }
+    static fromObject(data: {
+        token?: string;
+    }): AcceptInvitationRequest {
+        const message = new AcceptInvitationRequest({});
+        if (data.token != null) {
+            message.token = data.token;
+        }
+        return message;
+    }
+    toObject() {
+        const data: {
+            token?: string;
+        } = {};
+        if (this.token != null) {
+            data.token = this.token;
+        }
+        return data;
+    }
+    serialize(): Uint8Array;
+    serialize(w: pb_1.BinaryWriter): void;
+    serialize(w?: pb_1.BinaryWriter): Uint8Array | void {
+        const writer = w || new pb_1.BinaryWriter();
+        if (this.token.length)
+            writer.writeString(1, this.token);
+        if (!w)
+            return writer.getResultBuffer();
+    }
+    static deserialize(bytes: Uint8Array | pb_1.BinaryReader): AcceptInvitationRequest {
+        const reader = bytes instanceof pb_1.BinaryReader ? bytes : new pb_1.BinaryReader(bytes), message = new AcceptInvitationRequest();
+        while (reader.nextField()) {
+            if (reader.isEndGroup())
+                break;
+            switch (reader.getFieldNumber()) {
+                case 1:
+                    message.token = reader.readString();
+                    break;
+                default: reader.skipField();
+            }
+        }
+        return message;
+    }
+    serializeBinary(): Uint8Array {
+        return this.serialize();
+    }
+    static deserializeBinary(bytes: Uint8Array): AcceptInvitationRequest {
+        return AcceptInvitationRequest.deserialize(bytes);
+    }
+}
+export class AcceptInvitationResponse extends pb_1.Message {
+    #one_of_decls: number[][] = [];
+    constructor(data?: any[] | {
+        tenant_user?: TenantUser;
+    }) {
+        super();
+        pb_1.Message.initialize(this, Array.isArray(data) ? data : [], 0, -1, [], this.#one_of_decls);
+        if (!Array.isArray(data) && typeof data == "object") {
+            if ("tenant_user" in data && data.tenant_user != undefined) {
+                this.tenant_user = data.tenant_user;
+            }
+        }
+    }
+    get tenant_user() {
+        return pb_1.Message.getWrapperField(this, TenantUser, 1) as TenantUser;
+    }
+    set tenant_user(value: TenantUser) {
+        pb_1.Message.setWrapperField(this, 1, value);
+    }
+    get has_tenant_user() {
+        return pb_1.Message.getField(this, 1) != null;
+    }
+    static fromObject(data: {
+        tenant_user?: ReturnType<typeof TenantUser.prototype.toObject>;
+    }): AcceptInvitationResponse {
+        const message = new AcceptInvitationResponse({});
+        if (data.tenant_user != null) {
+            message.tenant_user = TenantUser.fromObject(data.tenant_user);
+        }
+        return message;
+    }
+    toObject() {
+        const data: {
+            tenant_user?: ReturnType<typeof TenantUser.prototype.toObject>;
+        } = {};
+        if (this.tenant_user != null) {
+            data.tenant_user = this.tenant_user.toObject();
+        }
+        return data;
+    }
+    serialize(): Uint8Array;
+    serialize(w: pb_1.BinaryWriter): void;
+    serialize(w?: pb_1.BinaryWriter): Uint8Array | void {
+        const writer = w || new pb_1.BinaryWriter();
+        if (this.has_tenant_user)
+            writer.writeMessage(1, this.tenant_user, () => this.tenant_user.serialize(writer));
+        if (!w)
+            return writer.getResultBuffer();
+    }
+    static deserialize(bytes: Uint8Array | pb_1.BinaryReader): AcceptInvitationResponse {
+        const reader = bytes instanceof pb_1.BinaryReader ? bytes : new pb_1.BinaryReader(bytes), message = new AcceptInvitationResponse();
+        while (reader.nextField()) {
+            if (reader.isEndGroup())
+                break;
+            switch (reader.getFieldNumber()) {
+                case 1:
+                    reader.readMessage(message.tenant_user, () => message.tenant_user = TenantUser.deserialize(reader));
+                    break;
+                default: reader.skipField();
+            }
+        }
+        return message;
+    }
+    serializeBinary(): Uint8Array {
+        return this.serialize();
+    }
+    static deserializeBinary(bytes: Uint8Array): AcceptInvitationResponse {
+        return AcceptInvitationResponse.deserialize(bytes);
+    }
+}
+export class DeclineInvitationRequest extends pb_1.Message {
+    #one_of_decls: number[][] = [];
+    constructor(data?: any[] | {
+        token?: string;
+    }) {
+        super();
+        pb_1.Message.initialize(this, Array.isArray(data) ? data : [], 0, -1, [], this.#one_of_decls);
+        if (!Array.isArray(data) && typeof data == "object") {
+            if ("token" in data && data.token != undefined) {
+                this.token = data.token;
+            }
+        }
+    }
+    get token() {
+        return pb_1.Message.getFieldWithDefault(this, 1, "") as string;
+    }
+    set token(value: string) {
+        pb_1.Message.setField(this, 1, value);
+    }
+    static fromObject(data: {
+        token?: string;
+    }): DeclineInvitationRequest {
+        const message = new DeclineInvitationRequest({});
+        if (data.token != null) {
+            message.token = data.token;
+        }
+        return message;
+    }
+    toObject() {
+        const data: {
+            token?: string;
+        } = {};
+        if (this.token != null) {
+            data.token = this.token;
+        }
+        return data;
+    }
+    serialize(): Uint8Array;
+    serialize(w: pb_1.BinaryWriter): void;
+    serialize(w?: pb_1.BinaryWriter): Uint8Array | void {
+        const writer = w || new pb_1.BinaryWriter();
+        if (this.token.length)
+            writer.writeString(1, this.token);
+        if (!w)
+            return writer.getResultBuffer();
+    }
+    static deserialize(bytes: Uint8Array | pb_1.BinaryReader): DeclineInvitationRequest {
+        const reader = bytes instanceof pb_1.BinaryReader ? bytes : new pb_1.BinaryReader(bytes), message = new DeclineInvitationRequest();
+        while (reader.nextField()) {
+            if (reader.isEndGroup())
+                break;
+            switch (reader.getFieldNumber()) {
+                case 1:
+                    message.token = reader.readString();
+                    break;
+                default: reader.skipField();
+            }
+        }
+        return message;
+    }
+    serializeBinary(): Uint8Array {
+        return this.serialize();
+    }
+    static deserializeBinary(bytes: Uint8Array): DeclineInvitationRequest {
+        return DeclineInvitationRequest.deserialize(bytes);
+    }
+}
+export class DeclineInvitationResponse extends pb_1.Message {
+    #one_of_decls: number[][] = [];
+    constructor(data?: any[] | {
+        message?: string;
+    }) {
+        super();
+        pb_1.Message.initialize(this, Array.isArray(data) ? data : [], 0, -1, [], this.#one_of_decls);
+        if (!Array.isArray(data) && typeof data == "object") {
+            if ("message" in data && data.message != undefined) {
+                this.message = data.message;
+            }
+        }
+    }
+    get message() {
+        return pb_1.Message.getFieldWithDefault(this, 1, "") as string;
+    }
+    set message(value: string) {
+        pb_1.Message.setField(this, 1, value);
+    }
+    static fromObject(data: {
+        message?: string;
+    }): DeclineInvitationResponse {
+        const message = new DeclineInvitationResponse({});
+        if (data.message != null) {
+            message.message = data.message;
+        }
+        return message;
+    }
+    toObject() {
+        const data: {
+            message?: string;
+        } = {};
+        if (this.message != null) {
+            data.message = this.message;
+        }
+        return data;
+    }
+    serialize(): Uint8Array;
+    serialize(w: pb_1.BinaryWriter): void;
+    serialize(w?: pb_1.BinaryWriter): Uint8Array | void {
+        const writer = w || new pb_1.BinaryWriter();
+        if (this.message.length)
+            writer.writeString(1, this.message);
+        if (!w)
+            return writer.getResultBuffer();
+    }
+    static deserialize(bytes: Uint8Array | pb_1.BinaryReader): DeclineInvitationResponse {
+        const reader = bytes instanceof pb_1.BinaryReader ? bytes : new pb_1.BinaryReader(bytes), message = new DeclineInvitationResponse();
+        while (reader.nextField()) {
+            if (reader.isEndGroup())
+                break;
+            switch (reader.getFieldNumber()) {
+                case 1:
+                    message.message = reader.readString();
+                    break;
+                default: reader.skipField();
+            }
+        }
+        return message;
+    }
+    serializeBinary(): Uint8Array {
+        return this.serialize();
+    }
+    static deserializeBinary(bytes: Uint8Array): DeclineInvitationResponse {
+        return DeclineInvitationResponse.deserialize(bytes);
+    }
+}
+export class UpdateUserRoleRequest extends pb_1.Message {
+    #one_of_decls: number[][] = [];
+    constructor(data?: any[] | {
+        tenant_id?: string;
+        user_id?: string;
+        role?: string;
+    }) {
+        super();
+        pb_1.Message.initialize(this, Array.isArray(data) ? data : [], 0, -1, [], this.#one_of_decls);
+        if (!Array.isArray(data) && typeof data == "object") {
+            if ("tenant_id" in data && data.tenant_id != undefined) {
+                this.tenant_id = data.tenant_id;
+            }
+            if ("user_id" in data && data.user_id != undefined) {
+                this.user_id = data.user_id;
+            }
+            if ("role" in data && data.role != undefined) {
+                this.role = data.role;
+            }
+        }
+    }
+    get tenant_id() {
+        return pb_1.Message.getFieldWithDefault(this, 1, "") as string;
+    }
+    set tenant_id(value: string) {
+        pb_1.Message.setField(this, 1, value);
+    }
+    get user_id() {
+        return pb_1.Message.getFieldWithDefault(this, 2, "") as string;
+    }
+    set user_id(value: string) {
+        pb_1.Message.setField(this, 2, value);
+    }
+    get role() {
+        return pb_1.Message.getFieldWithDefault(this, 3, "") as string;
+    }
+    set role(value: string) {
+        pb_1.Message.setField(this, 3, value);
+    }
+    static fromObject(data: {
+        tenant_id?: string;
+        user_id?: string;
+        role?: string;
+    }): UpdateUserRoleRequest {
+        const message = new UpdateUserRoleRequest({});
+        if (data.tenant_id != null) {
+            message.tenant_id = data.tenant_id;
+        }
+        if (data.user_id != null) {
+            message.user_id = data.user_id;
+        }
+        if (data.role != null) {
+            message.role = data.role;
+        }
+        return message;
+    }
+    toObject() {
+        const data: {
+            tenant_id?: string;
+            user_id?: string;
+            role?: string;
+        } = {};
+        if (this.tenant_id != null) {
+            data.tenant_id = this.tenant_id;
+        }
+        if (this.user_id != null) {
+            data.user_id = this.user_id;
+        }
+        if (this.role != null) {
+            data.role = this.role;
+        }
+        return data;
+    }
+    serialize(): Uint8Array;
+    serialize(w: pb_1.BinaryWriter): void;
+    serialize(w?: pb_1.BinaryWriter): Uint8Array | void {
+        const writer = w || new pb_1.BinaryWriter();
+        if (this.tenant_id.length)
+            writer.writeString(1, this.tenant_id);
+        if (this.user_id.length)
+            writer.writeString(2, this.user_id);
+        if (this.role.length)
+            writer.writeString(3, this.role);
+        if (!w)
+            return writer.getResultBuffer();
+    }
+    static deserialize(bytes: Uint8Array | pb_1.BinaryReader): UpdateUserRoleRequest {
+        const reader = bytes instanceof pb_1.BinaryReader ? bytes : new pb_1.BinaryReader(bytes), message = new UpdateUserRoleRequest();
+        while (reader.nextField()) {
+            if (reader.isEndGroup())
+                break;
+            switch (reader.getFieldNumber()) {
+                case 1:
+                    message.tenant_id = reader.readString();
+                    break;
+                case 2:
+                    message.user_id = reader.readString();
+                    break;
+                case 3:
+                    message.role = reader.readString();
+                    break;
+                default: reader.skipField();
+            }
+        }
+        return message;
+    }
+    serializeBinary(): Uint8Array {
+        return this.serialize();
+    }
+    static deserializeBinary(bytes: Uint8Array): UpdateUserRoleRequest {
+        return UpdateUserRoleRequest.deserialize(bytes);
+    }
+}
+export class UpdateUserRoleResponse extends pb_1.Message {
+    #one_of_decls: number[][] = [];
+    constructor(data?: any[] | {
+        message?: string;
+    }) {
+        super();
+        pb_1.Message.initialize(this, Array.isArray(data) ? data : [], 0, -1, [], this.#one_of_decls);
+        if (!Array.isArray(data) && typeof data == "object") {
+            if ("message" in data && data.message != undefined) {
+                this.message = data.message;
+            }
+        }
+    }
+    get message() {
+        return pb_1.Message.getFieldWithDefault(this, 1, "") as string;
+    }
+    set message(value: string) {
+        pb_1.Message.setField(this, 1, value);
+    }
+    static fromObject(data: {
+        message?: string;
+    }): UpdateUserRoleResponse {
+        const message = new UpdateUserRoleResponse({});
+        if (data.message != null) {
+            message.message = data.message;
+        }
+        return message;
+    }
+    toObject() {
+        const data: {
+            message?: string;
+        } = {};
+        if (this.message != null) {
+            data.message = this.message;
+        }
+        return data;
+    }
+    serialize(): Uint8Array;
+    serialize(w: pb_1.BinaryWriter): void;
+    serialize(w?: pb_1.BinaryWriter): Uint8Array | void {
+        const writer = w || new pb_1.BinaryWriter();
+        if (this.message.length)
+            writer.writeString(1, this.message);
+        if (!w)
+            return writer.getResultBuffer();
+    }
+    static deserialize(bytes: Uint8Array | pb_1.BinaryReader): UpdateUserRoleResponse {
+        const reader = bytes instanceof pb_1.BinaryReader ? bytes : new pb_1.BinaryReader(bytes), message = new UpdateUserRoleResponse();
+        while (reader.nextField()) {
+            if (reader.isEndGroup())
+                break;
+            switch (reader.getFieldNumber()) {
+                case 1:
+                    message.message = reader.readString();
+                    break;
+                default: reader.skipField();
+            }
+        }
+        return message;
+    }
+    serializeBinary(): Uint8Array {
+        return this.serialize();
+    }
+    static deserializeBinary(bytes: Uint8Array): UpdateUserRoleResponse {
+        return UpdateUserRoleResponse.deserialize(bytes);
+    }
+}
+export class RemoveUserRequest extends pb_1.Message {
+    #one_of_decls: number[][] = [];
+    constructor(data?: any[] | {
+        tenant_id?: string;
+        user_id?: string;
+    }) {
+        super();
+        pb_1.Message.initialize(this, Array.isArray(data) ? data : [], 0, -1, [], this.#one_of_decls);
+        if (!Array.isArray(data) && typeof data == "object") {
+            if ("tenant_id" in data && data.tenant_id != undefined) {
+                this.tenant_id = data.tenant_id;
+            }
+            if ("user_id" in data && data.user_id != undefined) {
+                this.user_id = data.user_id;
+            }
+        }
+    }
+    get tenant_id() {
+        return pb_1.Message.getFieldWithDefault(this, 1, "") as string;
+    }
+    set tenant_id(value: string) {
+        pb_1.Message.setField(this, 1, value);
+    }
+    get user_id() {
+        return pb_1.Message.getFieldWithDefault(this, 2, "") as string;
+    }
+    set user_id(value: string) {
+        pb_1.Message.setField(this, 2, value);
+    }
+    static fromObject(data: {
+        tenant_id?: string;
+        user_id?: string;
+    }): RemoveUserRequest {
+        const message = new RemoveUserRequest({});
+        if (data.tenant_id != null) {
+            message.tenant_id = data.tenant_id;
+        }
+        if (data.user_id != null) {
+            message.user_id = data.user_id;
+        }
+        return message;
+    }
+    toObject() {
+        const data: {
+            tenant_id?: string;
+            user_id?: string;
+        } = {};
+        if (this.tenant_id != null) {
+            data.tenant_id = this.tenant_id;
+        }
+        if (this.user_id != null) {
+            data.user_id = this.user_id;
+        }
+        return data;
+    }
+    serialize(): Uint8Array;
+    serialize(w: pb_1.BinaryWriter): void;
+    serialize(w?: pb_1.BinaryWriter): Uint8Array | void {
+        const writer = w || new pb_1.BinaryWriter();
+        if (this.tenant_id.length)
+            writer.writeString(1, this.tenant_id);
+        if (this.user_id.length)
+            writer.writeString(2, this.user_id);
+        if (!w)
+            return writer.getResultBuffer();
+    }
+    static deserialize(bytes: Uint8Array | pb_1.BinaryReader): RemoveUserRequest {
+        const reader = bytes instanceof pb_1.BinaryReader ? bytes : new pb_1.BinaryReader(bytes), message = new RemoveUserRequest();
+        while (reader.nextField()) {
+            if (reader.isEndGroup())
+                break;
+            switch (reader.getFieldNumber()) {
+                case 1:
+                    message.tenant_id = reader.readString();
+                    break;
+                case 2:
+                    message.user_id = reader.readString();
+                    break;
+                default: reader.skipField();
+            }
+        }
+        return message;
+    }
+    serializeBinary(): Uint8Array {
+        return this.serialize();
+    }
+    static deserializeBinary(bytes: Uint8Array): RemoveUserRequest {
+        return RemoveUserRequest.deserialize(bytes);
+    }
+}
+export class RemoveUserResponse extends pb_1.Message {
+    #one_of_decls: number[][] = [];
+    constructor(data?: any[] | {
+        message?: string;
+    }) {
+        super();
+        pb_1.Message.initialize(this, Array.isArray(data) ? data : [], 0, -1, [], this.#one_of_decls);
+        if (!Array.isArray(data) && typeof data == "object") {
+            if ("message" in data && data.message != undefined) {
+                this.message = data.message;
+            }
+        }
+    }
+    get message() {
+        return pb_1.Message.getFieldWithDefault(this, 1, "") as string;
+    }
+    set message(value: string) {
+        pb_1.Message.setField(this, 1, value);
+    }
+    static fromObject(data: {
+        message?: string;
+    }): RemoveUserResponse {
+        const message = new RemoveUserResponse({});
+        if (data.message != null) {
+            message.message = data.message;
+        }
+        return message;
+    }
+    toObject() {
+        const data: {
+            message?: string;
+        } = {};
+        if (this.message != null) {
+            data.message = this.message;
+        }
+        return data;
+    }
+    serialize(): Uint8Array;
+    serialize(w: pb_1.BinaryWriter): void;
+    serialize(w?: pb_1.BinaryWriter): Uint8Array | void {
+        const writer = w || new pb_1.BinaryWriter();
+        if (this.message.length)
+            writer.writeString(1, this.message);
+        if (!w)
+            return writer.getResultBuffer();
+    }
+    static deserialize(bytes: Uint8Array | pb_1.BinaryReader): RemoveUserResponse {
+        const reader = bytes instanceof pb_1.BinaryReader ? bytes : new pb_1.BinaryReader(bytes), message = new RemoveUserResponse();
+        while (reader.nextField()) {
+            if (reader.isEndGroup())
+                break;
+            switch (reader.getFieldNumber()) {
+                case 1:
+                    message.message = reader.readString();
+                    break;
+                default: reader.skipField();
+            }
+        }
+        return message;
+    }
+    serializeBinary(): Uint8Array {
+        return this.serialize();
+    }
+    static deserializeBinary(bytes: Uint8Array): RemoveUserResponse {
+        return RemoveUserResponse.deserialize(bytes);
+    }
+}
+export class LeaveTenantRequest extends pb_1.Message {
+    #one_of_decls: number[][] = [];
+    constructor(data?: any[] | {
+        tenant_id?: string;
+    }) {
+        super();
+        pb_1.Message.initialize(this, Array.isArray(data) ? data : [], 0, -1, [], this.#one_of_decls);
+        if (!Array.isArray(data) && typeof data == "object") {
+            if ("tenant_id" in data && data.tenant_id != undefined) {
+                this.tenant_id = data.tenant_id;
+            }
+        }
+    }
+    get tenant_id() {
+        return pb_1.Message.getFieldWithDefault(this, 1, "") as string;
+    }
+    set tenant_id(value: string) {
+        pb_1.Message.setField(this, 1, value);
+    }
+    static fromObject(data: {
+        tenant_id?: string;
+    }): LeaveTenantRequest {
+        const message = new LeaveTenantRequest({});
+        if (data.tenant_id != null) {
+            message.tenant_id = data.tenant_id;
+        }
+        return message;
+    }
+    toObject() {
+        const data: {
+            tenant_id?: string;
+        } = {};
+        if (this.tenant_id != null) {
+            data.tenant_id = this.tenant_id;
+        }
+        return data;
+    }
+    serialize(): Uint8Array;
+    serialize(w: pb_1.BinaryWriter): void;
+    serialize(w?: pb_1.BinaryWriter): Uint8Array | void {
+        const writer = w || new pb_1.BinaryWriter();
+        if (this.tenant_id.length)
+            writer.writeString(1, this.tenant_id);
+        if (!w)
+            return writer.getResultBuffer();
+    }
+    static deserialize(bytes: Uint8Array | pb_1.BinaryReader): LeaveTenantRequest {
+        const reader = bytes instanceof pb_1.BinaryReader ? bytes : new pb_1.BinaryReader(bytes), message = new LeaveTenantRequest();
+        while (reader.nextField()) {
+            if (reader.isEndGroup())
+                break;
+            switch (reader.getFieldNumber()) {
+                case 1:
+                    message.tenant_id = reader.readString();
+                    break;
+                default: reader.skipField();
+            }
+        }
+        return message;
+    }
+    serializeBinary(): Uint8Array {
+        return this.serialize();
+    }
+    static deserializeBinary(bytes: Uint8Array): LeaveTenantRequest {
+        return LeaveTenantRequest.deserialize(bytes);
+    }
+}
+export class LeaveTenantResponse extends pb_1.Message {
+    #one_of_decls: number[][] = [];
+    constructor(data?: any[] | {
+        message?: string;
+    }) {
+        super();
+        pb_1.Message.initialize(this, Array.isArray(data) ? data : [], 0, -1, [], this.#one_of_decls);
+        if (!Array.isArray(data) && typeof data == "object") {
+            if ("message" in data && data.message != undefined) {
+                this.message = data.message;
+            }
+        }
+    }
+    get message() {
+        return pb_1.Message.getFieldWithDefault(this, 1, "") as string;
+    }
+    set message(value: string) {
+        pb_1.Message.setField(this, 1, value);
+    }
+    static fromObject(data: {
+        message?: string;
+    }): LeaveTenantResponse {
+        const message = new LeaveTenantResponse({});
+        if (data.message != null) {
+            message.message = data.message;
+        }
+        return message;
+    }
+    toObject() {
+        const data: {
+            message?: string;
+        } = {};
+        if (this.message != null) {
+            data.message = this.message;
+        }
+        return data;
+    }
+    serialize(): Uint8Array;
+    serialize(w: pb_1.BinaryWriter): void;
+    serialize(w?: pb_1.BinaryWriter): Uint8Array | void {
+        const writer = w || new pb_1.BinaryWriter();
+        if (this.message.length)
+            writer.writeString(1, this.message);
+        if (!w)
+            return writer.getResultBuffer();
+    }
+    static deserialize(bytes: Uint8Array | pb_1.BinaryReader): LeaveTenantResponse {
+        const reader = bytes instanceof pb_1.BinaryReader ? bytes : new pb_1.BinaryReader(bytes), message = new LeaveTenantResponse();
+        while (reader.nextField()) {
+            if (reader.isEndGroup())
+                break;
+            switch (reader.getFieldNumber()) {
+                case 1:
+                    message.message = reader.readString();
+                    break;
+                default: reader.skipField();
+            }
+        }
+        return message;
+    }
+    serializeBinary(): Uint8Array {
+        return this.serialize();
+    }
+    static deserializeBinary(bytes: Uint8Array): LeaveTenantResponse {
+        return LeaveTenantResponse.deserialize(bytes);
+    }
+}
+export class TransferOwnershipRequest extends pb_1.Message {
+    #one_of_decls: number[][] = [];
+    constructor(data?: any[] | {
+        tenant_id?: string;
+        user_id?: string;
+    }) {
+        super();
+        pb_1.Message.initialize(this, Array.isArray(data) ? data : [], 0, -1, [], this.#one_of_decls);
+        if (!Array.isArray(data) && typeof data == "object") {
+            if ("tenant_id" in data && data.tenant_id != undefined) {
+                this.tenant_id = data.tenant_id;
+            }
+            if ("user_id" in data && data.user_id != undefined) {
+                this.user_id = data.user_id;
+            }
+        }
+    }
+    get tenant_id() {
+        return pb_1.Message.getFieldWithDefault(this, 1, "") as string;
+    }
+    set tenant_id(value: string) {
+        pb_1.Message.setField(this, 1, value);
+    }
+    get user_id() {
+        return pb_1.Message.getFieldWithDefault(this, 2, "") as string;
+    }
+    set user_id(value: string) {
+        pb_1.Message.setField(this, 2, value);
+    }
+    static fromObject(data: {
+        tenant_id?: string;
+        user_id?: string;
+    }): TransferOwnershipRequest {
+        const message = new TransferOwnershipRequest({});
+        if (data.tenant_id != null) {
+            message.tenant_id = data.tenant_id;
+        }
+        if (data.user_id != null) {
+            message.user_id = data.user_id;
+        }
+        return message;
+    }
+    toObject() {
+        const data: {
+            tenant_id?: string;
+            user_id?: string;
+        } = {};
+        if (this.tenant_id != null) {
+            data.tenant_id = this.tenant_id;
+        }
+        if (this.user_id != null) {
+            data.user_id = this.user_id;
+        }
+        return data;
+    }
+    serialize(): Uint8Array;
+    serialize(w: pb_1.BinaryWriter): void;
+    serialize(w?: pb_1.BinaryWriter): Uint8Array | void {
+        const writer = w || new pb_1.BinaryWriter();
+        if (this.tenant_id.length)
+            writer.writeString(1, this.tenant_id);
+        if (this.user_id.length)
+            writer.writeString(2, this.user_id);
+        if (!w)
+            return writer.getResultBuffer();
+    }
+    static deserialize(bytes: Uint8Array | pb_1.BinaryReader): TransferOwnershipRequest {
+        const reader = bytes instanceof pb_1.BinaryReader ? bytes : new pb_1.BinaryReader(bytes), message = new TransferOwnershipRequest();
+        while (reader.nextField()) {
+            if (reader.isEndGroup())
+                break;
+            switch (reader.getFieldNumber()) {
+                case 1:
+                    message.tenant_id = reader.readString();
+                    break;
+                case 2:
+                    message.user_id = reader.readString();
+                    break;
+                default: reader.skipField();
+            }
+        }
+        return message;
+    }
+    serializeBinary(): Uint8Array {
+        return this.serialize();
+    }
+    static deserializeBinary(bytes: Uint8Array): TransferOwnershipRequest {
+        return TransferOwnershipRequest.deserialize(bytes);
+    }
+}
+export class TransferOwnershipResponse extends pb_1.Message {
+    #one_of_decls: number[][] = [];
+    constructor(data?: any[] | {
+        message?: string;
+    }) {
+        super();
+        pb_1.Message.initialize(this, Array.isArray(data) ? data : [], 0, -1, [], this.#one_of_decls);
+        if (!Array.isArray(data) && typeof data == "object") {
+            if ("message" in data && data.message != undefined) {
+                this.message = data.message;
+            }
+        }
+    }
+    get message() {
+        return pb_1.Message.getFieldWithDefault(this, 1, "") as string;
+    }
+    set message(value: string) {
+        pb_1.Message.setField(this, 1, value);
+    }
+    static fromObject(data: {
+        message?: string;
+    }): TransferOwnershipResponse {
+        const message = new TransferOwnershipResponse({});
+        if (data.message != null) {
+            message.message = data.message;
+        }
+        return message;
+    }
+    toObject() {
+        const data: {
+            message?: string;
+        } = {};
+        if (this.message != null) {
+            data.message = this.message;
+        }
+        return data;
+    }
+    serialize(): Uint8Array;
+    serialize(w: pb_1.BinaryWriter): void;
+    serialize(w?: pb_1.BinaryWriter): Uint8Array | void {
+        const writer = w || new pb_1.BinaryWriter();
+        if (this.message.length)
+            writer.writeString(1, this.message);
+        if (!w)
+            return writer.getResultBuffer();
+    }
+    static deserialize(bytes: Uint8Array | pb_1.BinaryReader): TransferOwnershipResponse {
+        const reader = bytes instanceof pb_1.BinaryReader ? bytes : new pb_1.BinaryReader(bytes), message = new TransferOwnershipResponse();
+        while (reader.nextField()) {
+            if (reader.isEndGroup())
+                break;
+            switch (reader.getFieldNumber()) {
+                case 1:
+                    message.message = reader.readString();
+                    break;
+                default: reader.skipField();
+            }
+        }
+        return message;
+    }
+    serializeBinary(): Uint8Array {
+        return this.serialize();
+    }
+    static deserializeBinary(bytes: Uint8Array): TransferOwnershipResponse {
+        return TransferOwnershipResponse.deserialize(bytes);
+    }
+}
+export class UpdateTenantRequest extends pb_1.Message {
+    #one_of_decls: number[][] = [];
+    constructor(data?: any[] | {
+        tenant_id?: string;
+        name?: string;
+        description?: string;
+    }) {
+        super();
+        pb_1.Message.initialize(this, Array.isArray(data) ? data : [], 0, -1, [], this.#one_of_decls);
+        if (!Array.isArray(data) && typeof data == "object") {
+            if ("tenant_id" in data && data.tenant_id != undefined) {
+                this.tenant_id = data.tenant_id;
+            }
+            if ("name" in data && data.name != undefined) {
+                this.name = data.name;
+            }
+            if ("description" in data && data.description != undefined) {
+                this.description = data.description;
+            }
+        }
+    }
+    get tenant_id() {
+        return pb_1.Message.getFieldWithDefault(this, 1, "") as string;
+    }
+    set tenant_id(value: string) {
+        pb_1.Message.setField(this, 1, value);
+    }
+    get name() {
+        return pb_1.Message.getFieldWithDefault(this, 2, "") as string;
+    }
+    set name(value: string) {
+        pb_1.Message.setField(this, 2, value);
+    }
+    get description() {
+        return pb_1.Message.getFieldWithDefault(this, 3, "") as string;
+    }
+    set description(value: string) {
+        pb_1.Message.setField(this, 3, value);
+    }
+    static fromObject(data: {
+        tenant_id?: string;
+        name?: string;
+        description?: string;
+    }): UpdateTenantRequest {
+        const message = new UpdateTenantRequest({});
+        if (data.tenant_id != null) {
+            message.tenant_id = data.tenant_id;
+        }
+        if (data.name != null) {
+            message.name = data.name;
+        }
+        if (data.description != null) {
+            message.description = data.description;
+        }
+        return message;
+    }
+    toObject() {
+        const data: {
+            tenant_id?: string;
+            name?: string;
+            description?: string;
+        } = {};
+        if (this.tenant_id != null) {
+            data.tenant_id = this.tenant_id;
+        }
+        if (this.name != null) {
+            data.name = this.name;
+        }
+        if (this.description != null) {
+            data.description = this.description;
+        }
+        return data;
+    }
+    serialize(): Uint8Array;
+    serialize(w: pb_1.BinaryWriter): void;
+    serialize(w?: pb_1.BinaryWriter): Uint8Array | void {
+        const writer = w || new pb_1.BinaryWriter();
+        if (this.tenant_id.length)
+            writer.writeString(1, this.tenant_id);
+        if (this.name.length)
+            writer.writeString(2, this.name);
+        if (this.description.length)
+            writer.writeString(3, this.description);
+        if (!w)
+            return writer.getResultBuffer();
+    }
+    static deserialize(bytes: Uint8Array | pb_1.BinaryReader): UpdateTenantRequest {
+        const reader = bytes instanceof pb_1.BinaryReader ? bytes : new pb_1.BinaryReader(bytes), message = new UpdateTenantRequest();
+        while (reader.nextField()) {
+            if (reader.isEndGroup())
+                break;
+            switch (reader.getFieldNumber()) {
+                case 1:
+                    message.tenant_id = reader.readString();
+                    break;
+                case 2:
+                    message.name = reader.readString();
+                    break;
+                case 3:
+                    message.description = reader.readString();
+                    break;
+                default: reader.skipField();
+            }
+        }
+        return message;
+    }
+    serializeBinary(): Uint8Array {
+        return this.serialize();
+    }
+    static deserializeBinary(bytes: Uint8Array): UpdateTenantRequest {
+        return UpdateTenantRequest.deserialize(bytes);
+    }
+}
+export class UpdateTenantResponse extends pb_1.Message {
+    #one_of_decls: number[][] = [];
+    constructor(data?: any[] | {
+        message?: string;
+        tenant?: Tenant;
+    }) {
+        super();
+        pb_1.Message.initialize(this, Array.isArray(data) ? data : [], 0, -1, [], this.#one_of_decls);
+        if (!Array.isArray(data) && typeof data == "object") {
+            if ("message" in data && data.message != undefined) {
+                this.message = data.message;
+            }
+            if ("tenant" in data && data.tenant != undefined) {
+                this.tenant = data.tenant;
+            }
+        }
+    }
+    get message() {
+        return pb_1.Message.getFieldWithDefault(this, 1, "") as string;
+    }
+    set message(value: string) {
+        pb_1.Message.setField(this, 1, value);
+    }
+    get tenant() {
+        return pb_1.Message.getWrapperField(this, Tenant, 2) as Tenant;
+    }
+    set tenant(value: Tenant) {
+        pb_1.Message.setWrapperField(this, 2, value);
+    }
+    get has_tenant() {
+        return pb_1.Message.getField(this, 2) != null;
+    }
+    static fromObject(data: {
+        message?: string;
+        tenant?: ReturnType<typeof Tenant.prototype.toObject>;
+    }): UpdateTenantResponse {
+        const message = new UpdateTenantResponse({});
+        if (data.message != null) {
+            message.message = data.message;
+        }
+        if (data.tenant != null) {
+            message.tenant = Tenant.fromObject(data.tenant);
+        }
+        return message;
+    }
+    toObject() {
+        const data: {
+            message?: string;
+            tenant?: ReturnType<typeof Tenant.prototype.toObject>;
+        } = {};
+        if (this.message != null) {
+            data.message = this.message;
+        }
+        if (this.tenant != null) {
+            data.tenant = this.tenant.toObject();
+        }
+        return data;
+    }
+    serialize(): Uint8Array;
+    serialize(w: pb_1.BinaryWriter): void;
+    serialize(w?: pb_1.BinaryWriter): Uint8Array | void {
+        const writer = w || new pb_1.BinaryWriter();
+        if (this.message.length)
+            writer.writeString(1, this.message);
+        if (this.has_tenant)
+            writer.writeMessage(2, this.tenant, () => this.tenant.serialize(writer));
+        if (!w)
+            return writer.getResultBuffer();
+    }
+    static deserialize(bytes: Uint8Array | pb_1.BinaryReader): UpdateTenantResponse {
+        const reader = bytes instanceof pb_1.BinaryReader ? bytes : new pb_1.BinaryReader(bytes), message = new UpdateTenantResponse();
+        while (reader.nextField()) {
+            if (reader.isEndGroup())
+                break;
+            switch (reader.getFieldNumber()) {
+                case 1:
+                    message.message = reader.readString();
+                    break;
+                case 2:
+                    reader.readMessage(message.tenant, () => message.tenant = Tenant.deserialize(reader));
+                    break;
+                default: reader.skipField();
+            }
+        }
+        return message;
+    }
+    serializeBinary(): Uint8Array {
+        return this.serialize();
+    }
+    static deserializeBinary(bytes: Uint8Array): UpdateTenantResponse {
+        return UpdateTenantResponse.deserialize(bytes);
+    }
+}
+export class DeleteTenantRequest extends pb_1.Message {
+    #one_of_decls: number[][] = [];
+    constructor(data?: any[] | {
+        tenant_id?: string;
+        confirm_name?: string;
+    }) {
+        super();
+        pb_1.Message.initialize(this, Array.isArray(data) ? data : [], 0, -1, [], this.#one_of_decls);
+        if (!Array.isArray(data) && typeof data == "object") {
+            if ("tenant_id" in data && data.tenant_id != undefined) {
+                this.tenant_id = data.tenant_id;
+            }
+            if ("confirm_name" in data && data.confirm_name != undefined) {
+                this.confirm_name = data.confirm_name;
+            }
+        }
+    }
+    get tenant_id() {
+        return pb_1.Message.getFieldWithDefault(this, 1, "") as string;
+    }
+    set tenant_id(value: string) {
+        pb_1.Message.setField(this, 1, value);
+    }
+    get confirm_name() {
+        return pb_1.Message.getFieldWithDefault(this, 2, "") as string;
+    }
+    set confirm_name(value: string) {
+        pb_1.Message.setField(this, 2, value);
+    }
+    static fromObject(data: {
+        tenant_id?: string;
+        confirm_name?: string;
+    }): DeleteTenantRequest {
+        const message = new DeleteTenantRequest({});
+        if (data.tenant_id != null) {
+            message.tenant_id = data.tenant_id;
+        }
+        if (data.confirm_name != null) {
+            message.confirm_name = data.confirm_name;
+        }
+        return message;
+    }
+    toObject() {
+        const data: {
+            tenant_id?: string;
+            confirm_name?: string;
+        } = {};
+        if (this.tenant_id != null) {
+            data.tenant_id = this.tenant_id;
+        }
+        if (this.confirm_name != null) {
+            data.confirm_name = this.confirm_name;
+        }
+        return data;
+    }
+    serialize(): Uint8Array;
+    serialize(w: pb_1.BinaryWriter): void;
+    serialize(w?: pb_1.BinaryWriter): Uint8Array | void {
+        const writer = w || new pb_1.BinaryWriter();
+        if (this.tenant_id.length)
+            writer.writeString(1, this.tenant_id);
+        if (this.confirm_name.length)
+            writer.writeString(2, this.confirm_name);
+        if (!w)
+            return writer.getResultBuffer();
+    }
+    static deserialize(bytes: Uint8Array | pb_1.BinaryReader): DeleteTenantRequest {
+        const reader = bytes instanceof pb_1.BinaryReader ? bytes : new pb_1.BinaryReader(bytes), message = new DeleteTenantRequest();
+        while (reader.nextField()) {
+            if (reader.isEndGroup())
+                break;
+            switch (reader.getFieldNumber()) {
+                case 1:
+                    message.tenant_id = reader.readString();
+                    break;
+                case 2:
+                    message.confirm_name = reader.readString();
+                    break;
+                default: reader.skipField();
+            }
+        }
+        return message;
+    }
+    serializeBinary(): Uint8Array {
+        return this.serialize();
+    }
+    static deserializeBinary(bytes: Uint8Array): DeleteTenantRequest {
+        return DeleteTenantRequest.deserialize(bytes);
+    }
+}
+export class DeleteTenantResponse extends pb_1.Message {
+    #one_of_decls: number[][] = [];
+    constructor(data?: any[] | {
+        message?: string;
+        purge_after?: dependency_1.Timestamp;
+    }) {
+        super();
+        pb_1.Message.initialize(this, Array.isArray(data) ? data : [], 0, -1, [], this.#one_of_decls);
+        if (!Array.isArray(data) && typeof data == "object") {
+            if ("message" in data && data.message != undefined) {
+                this.message = data.message;
+            }
+            if ("purge_after" in data && data.purge_after != undefined) {
+                this.purge_after = data.purge_after;
+            }
+        }
+    }
+    get message() {
+        return pb_1.Message.getFieldWithDefault(this, 1, "") as string;
+    }
+    set message(value: string) {
+        pb_1.Message.setField(this, 1, value);
+    }
+    get purge_after() {
+        return pb_1.Message.getWrapperField(this, dependency_1.Timestamp, 2) as dependency_1.Timestamp;
+    }
+    set purge_after(value: dependency_1.Timestamp) {
+        pb_1.Message.setWrapperField(this, 2, value);
+    }
+    get has_purge_after() {
+        return pb_1.Message.getField(this, 2) != null;
+    }
+    static fromObject(data: {
+        message?: string;
+        purge_after?: ReturnType<typeof dependency_1.Timestamp.prototype.toObject>;
+    }): DeleteTenantResponse {
+        const message = new DeleteTenantResponse({});
+        if (data.message != null) {
+            message.message = data.message;
+        }
+        if (data.purge_after != null) {
+            message.purge_after = dependency_1.Timestamp.fromObject(data.purge_after);
+        }
+        return message;
+    }
+    toObject() {
+        const data: {
+            message?: string;
+            purge_after?: ReturnType<typeof dependency_1.Timestamp.prototype.toObject>;
+        } = {};
+        if (this.message != null) {
+            data.message = this.message;
+        }
+        if (this.purge_after != null) {
+            data.purge_after = this.purge_after.toObject();
+        }
+        return data;
+    }
+    serialize(): Uint8Array;
+    serialize(w: pb_1.BinaryWriter): void;
+    serialize(w?: pb_1.BinaryWriter): Uint8Array | void {
+        const writer = w || new pb_1.BinaryWriter();
+        if (this.message.length)
+            writer.writeString(1, this.message);
+        if (this.has_purge_after)
+            writer.writeMessage(2, this.purge_after, () => this.purge_after.serialize(writer));
+        if (!w)
+            return writer.getResultBuffer();
+    }
+    static deserialize(bytes: Uint8Array | pb_1.BinaryReader): DeleteTenantResponse {
+        const reader = bytes instanceof pb_1.BinaryReader ? bytes : new pb_1.BinaryReader(bytes), message = new DeleteTenantResponse();
+        while (reader.nextField()) {
+            if (reader.isEndGroup())
+                break;
+            switch (reader.getFieldNumber()) {
+                case 1:
+                    message.message = reader.readString();
+                    break;
+                case 2:
+                    reader.readMessage(message.purge_after, () => message.purge_after = dependency_1.Timestamp.deserialize(reader));
+                    break;
+                default: reader.skipField();
+            }
+        }
+        return message;
+    }
+    serializeBinary(): Uint8Array {
+        return this.serialize();
+    }
+    static deserializeBinary(bytes: Uint8Array): DeleteTenantResponse {
+        return DeleteTenantResponse.deserialize(bytes);
+    }
+}
+export class RestoreTenantRequest extends pb_1.Message {
+    #one_of_decls: number[][] = [];
+    constructor(data?: any[] | {
+        tenant_id?: string;
+    }) {
+        super();
+        pb_1.Message.initialize(this, Array.isArray(data) ? data : [], 0, -1, [], this.#one_of_decls);
+        if (!Array.isArray(data) && typeof data == "object") {
+            if ("tenant_id" in data && data.tenant_id != undefined) {
+                this.tenant_id = data.tenant_id;
+            }
+        }
+    }
+    get tenant_id() {
+        return pb_1.Message.getFieldWithDefault(this, 1, "") as string;
+    }
+    set tenant_id(value: string) {
+        pb_1.Message.setField(this, 1, value);
+    }
+    static fromObject(data: {
+        tenant_id?: string;
+    }): RestoreTenantRequest {
+        const message = new RestoreTenantRequest({});
+        if (data.tenant_id != null) {
+            message.tenant_id = data.tenant_id;
+        }
+        return message;
+    }
+    toObject() {
+        const data: {
+            tenant_id?: string;
+        } = {};
+        if (this.tenant_id != null) {
+            data.tenant_id = this.tenant_id;
+        }
+        return data;
+    }
+    serialize(): Uint8Array;
+    serialize(w: pb_1.BinaryWriter): void;
+    serialize(w?: pb_1.BinaryWriter): Uint8Array | void {
+        const writer = w || new pb_1.BinaryWriter();
+        if (this.tenant_id.length)
+            writer.writeString(1, this.tenant_id);
+        if (!w)
+            return writer.getResultBuffer();
+    }
+    static deserialize(bytes: Uint8Array | pb_1.BinaryReader): RestoreTenantRequest {
+        const reader = bytes instanceof pb_1.BinaryReader ? bytes : new pb_1.BinaryReader(bytes), message = new RestoreTenantRequest();
+        while (reader.nextField()) {
+            if (reader.isEndGroup())
+                break;
+            switch (reader.getFieldNumber()) {
+                case 1:
+                    message.tenant_id = reader.readString();
+                    break;
+                default: reader.skipField();
+            }
+        }
+        return message;
+    }
+    serializeBinary(): Uint8Array {
+        return this.serialize();
+    }
+    static deserializeBinary(bytes: Uint8Array): RestoreTenantRequest {
+        return RestoreTenantRequest.deserialize(bytes);
+    }
+}
+export class RestoreTenantResponse extends pb_1.Message {
+    #one_of_decls: number[][] = [];
+    constructor(data?: any[] | {
+        message?: string;
+        tenant?: Tenant;
+    }) {
+        super();
+        pb_1.Message.initialize(this, Array.isArray(data) ? data : [], 0, -1, [], this.#one_of_decls);
+        if (!Array.isArray(data) && typeof data == "object") {
+            if ("message" in data && data.message != undefined) {
+                this.message = data.message;
+            }
+            if ("tenant" in data && data.tenant != undefined) {
+                this.tenant = data.tenant;
+            }
+        }
+    }
+    get message() {
+        return pb_1.Message.getFieldWithDefault(this, 1, "") as string;
+    }
+    set message(value: string) {
+        pb_1.Message.setField(this, 1, value);
+    }
+    get tenant() {
+        return pb_1.Message.getWrapperField(this, Tenant, 2) as Tenant;
+    }
+    set tenant(value: Tenant) {
+        pb_1.Message.setWrapperField(this, 2, value);
+    }
+    get has_tenant() {
+        return pb_1.Message.getField(this, 2) != null;
+    }
+    static fromObject(data: {
+        message?: string;
+        tenant?: ReturnType<typeof Tenant.prototype.toObject>;
+    }): RestoreTenantResponse {
+        const message = new RestoreTenantResponse({});
+        if (data.message != null) {
+            message.message = data.message;
+        }
+        if (data.tenant != null) {
+            message.tenant = Tenant.fromObject(data.tenant);
+        }
+        return message;
+    }
+    toObject() {
+        const data: {
+            message?: string;
+            tenant?: ReturnType<typeof Tenant.prototype.toObject>;
+        } = {};
+        if (this.message != null) {
+            data.message = this.message;
+        }
+        if (this.tenant != null) {
+            data.tenant = this.tenant.toObject();
+        }
+        return data;
+    }
+    serialize(): Uint8Array;
+    serialize(w: pb_1.BinaryWriter): void;
+    serialize(w?: pb_1.BinaryWriter): Uint8Array | void {
+        const writer = w || new pb_1.BinaryWriter();
+        if (this.message.length)
+            writer.writeString(1, this.message);
+        if (this.has_tenant)
+            writer.writeMessage(2, this.tenant, () => this.tenant.serialize(writer));
+        if (!w)
+            return writer.getResultBuffer();
+    }
+    static deserialize(bytes: Uint8Array | pb_1.BinaryReader): RestoreTenantResponse {
+        const reader = bytes instanceof pb_1.BinaryReader ? bytes : new pb_1.BinaryReader(bytes), message = new RestoreTenantResponse();
+        while (reader.nextField()) {
+            if (reader.isEndGroup())
+                break;
+            switch (reader.getFieldNumber()) {
+                case 1:
+                    message.message = reader.readString();
+                    break;
+                case 2:
+                    reader.readMessage(message.tenant, () => message.tenant = Tenant.deserialize(reader));
+                    break;
+                default: reader.skipField();
+            }
+        }
+        return message;
+    }
+    serializeBinary(): Uint8Array {
+        return this.serialize();
+    }
+    static deserializeBinary(bytes: Uint8Array): RestoreTenantResponse {
+        return RestoreTenantResponse.deserialize(bytes);
+    }
+}
+export class GetUsersRequest extends pb_1.Message {
+    #one_of_decls: number[][] = [];
+    constructor(data?: any[] | {
+        tenant_id?: string;
+    }) {
+        super();
+        pb_1.Message.initialize(this, Array.isArray(data) ? data : [], 0, -1, [], this.#one_of_decls);
+        if (!Array.isArray(data) && typeof data == "object") {
+            if ("tenant_id" in data && data.tenant_id != undefined) {
+                this.tenant_id = data.tenant_id;
+            }
+        }
+    }
+    get tenant_id() {
+        return pb_1.Message.getFieldWithDefault(this, 1, "") as string;
+    }
+    set tenant_id(value: string) {
+        pb_1.Message.setField(this, 1, value);
+    }
+    static fromObject(data: {
+        tenant_id?: string;
+    }): GetUsersRequest {
+        const message = new GetUsersRequest({});
+        if (data.tenant_id != null) {
+            message.tenant_id = data.tenant_id;
+        }
+        return message;
+    }
+    toObject() {
+        const data: {
+            tenant_id?: string;
+        } = {};
+        if (this.tenant_id != null) {
+            data.tenant_id = this.tenant_id;
+        }
+        return data;
+    }
+    serialize(): Uint8Array;
+    serialize(w: pb_1.BinaryWriter): void;
+    serialize(w?: pb_1.BinaryWriter): Uint8Array | void {
+        const writer = w || new pb_1.BinaryWriter();
+        if (this.tenant_id.length)
+            writer.writeString(1, this.tenant_id);
+        if (!w)
+            return writer.getResultBuffer();
+    }
+    static deserialize(bytes: Uint8Array | pb_1.BinaryReader): GetUsersRequest {
+        const reader = bytes instanceof pb_1.BinaryReader ? bytes : new pb_1.BinaryReader(bytes), message = new GetUsersRequest();
+        while (reader.nextField()) {
+            if (reader.isEndGroup())
+                break;
+            switch (reader.getFieldNumber()) {
+                case 1:
+                    message.tenant_id = reader.readString();
+                    break;
+                default: reader.skipField();
+            }
+        }
+        return message;
+    }
+    serializeBinary(): Uint8Array {
+        return this.serialize();
+    }
+    static deserializeBinary(bytes: Uint8Array): GetUsersRequest {
+        return GetUsersRequest.deserialize(bytes);
+    }
+}
+export class GetUsersResponse extends pb_1.Message {
+    #one_of_decls: number[][] = [];
+    constructor(data?: any[] | {
+        message?: string;
+        tenant_users?: TenantUser[];
+    }) {
+        super();
+        pb_1.Message.initialize(this, Array.isArray(data) ? data : [], 0, -1, [2], this.#one_of_decls);
+        if (!Array.isArray(data) && typeof data == "object") {
+            if ("message" in data && data.message != undefined) {
+                this.message = data.message;
+            }
+            if ("tenant_users" in data && data.tenant_users != undefined) {
+                this.tenant_users = data.tenant_users;
+            }
+        }
+    }
+    get message() {
+        return pb_1.Message.getFieldWithDefault(this, 1, "") as string;
+    }
+    set message(value: string) {
+        pb_1.Message.setField(this, 1, value);
+    }
+    get tenant_users() {
+        return pb_1.Message.getRepeatedWrapperField(this, TenantUser, 2) as TenantUser[];
+    }
+    set tenant_users(value: TenantUser[]) {
+        pb_1.Message.setRepeatedWrapperField(this, 2, value);
+    }
+    static fromObject(data: {
+        message?: string;
+        tenant_users?: ReturnType<typeof TenantUser.prototype.toObject>[];
+    }): GetUsersResponse {
+        const message = new GetUsersResponse({});
+        if (data.message != null) {
+            message.message = data.message;
+        }
+        if (data.tenant_users != null) {
+            message.tenant_users = data.tenant_users.map(item => TenantUser.fromObject(item));
+        }
+        return message;
+    }
+    toObject() {
+        const data: {
+            message?: string;
+            tenant_users?: ReturnType<typeof TenantUser.prototype.toObject>[];
+        } = {};
+        if (this.message != null) {
+            data.message = this.message;
+        }
+        if (this.tenant_users != null) {
+            data.tenant_users = this.tenant_users.map((item: TenantUser) => item.toObject());
+        }
+        return data;
+    }
+    serialize(): Uint8Array;
+    serialize(w: pb_1.BinaryWriter): void;
+    serialize(w?: pb_1.BinaryWriter): Uint8Array | void {
+        const writer = w || new pb_1.BinaryWriter();
+        if (this.message.length)
+            writer.writeString(1, this.message);
+        if (this.tenant_users.length)
+            writer.writeRepeatedMessage(2, this.tenant_users, (item: TenantUser) => item.serialize(writer));
+        if (!w)
+            return writer.getResultBuffer();
+    }
+    static deserialize(bytes: Uint8Array | pb_1.BinaryReader): GetUsersResponse {
+        const reader = bytes instanceof pb_1.BinaryReader ? bytes : new pb_1.BinaryReader(bytes), message = new GetUsersResponse();
+        while (reader.nextField()) {
+            if (reader.isEndGroup())
+                break;
+            switch (reader.getFieldNumber()) {
+                case 1:
+                    message.message = reader.readString();
+                    break;
+                case 2:
+                    reader.readMessage(message.tenant_users, () => pb_1.Message.addToRepeatedWrapperField(message, 2, TenantUser.deserialize(reader), TenantUser));
+                    break;
+                default: reader.skipField();
+            }
+        }
+        return message;
+    }
+    serializeBinary(): Uint8Array {
+        return this.serialize();
+    }
+    static deserializeBinary(bytes: Uint8Array): GetUsersResponse {
+        return GetUsersResponse.deserialize(bytes);
+    }
+}
+export class LookupUsersRequest extends pb_1.Message {
+    #one_of_decls: number[][] = [];
+    constructor(data?: any[] | {
+        tenant_id?: string;
+        handles?: string[];
+    }) {
+        super();
+        pb_1.Message.initialize(this, Array.isArray(data) ? data : [], 0, -1, [2], this.#one_of_decls);
+        if (!Array.isArray(data) && typeof data == "object") {
+            if ("tenant_id" in data && data.tenant_id != undefined) {
+                this.tenant_id = data.tenant_id;
+            }
+            if ("handles" in data && data.handles != undefined) {
+                this.handles = data.handles;
+            }
+        }
+    }
+    get tenant_id() {
+        return pb_1.Message.getFieldWithDefault(this, 1, "") as string;
+    }
+    set tenant_id(value: string) {
+        pb_1.Message.setField(this, 1, value);
+    }
+    get handles() {
+        return pb_1.Message.getFieldWithDefault(this, 2, []) as string[];
+    }
+    set handles(value: string[]) {
+        pb_1.Message.setField(this, 2, value);
+    }
+    static fromObject(data: {
+        tenant_id?: string;
+        handles?: string[];
+    }): LookupUsersRequest {
+        const message = new LookupUsersRequest({});
+        if (data.tenant_id != null) {
+            message.tenant_id = data.tenant_id;
+        }
+        if (data.handles != null) {
+            message.handles = data.handles;
+        }
+        return message;
+    }
+    toObject() {
+        const data: {
+            tenant_id?: string;
+            handles?: string[];
+        } = {};
+        if (this.tenant_id != null) {
+            data.tenant_id = this.tenant_id;
+        }
+        if (this.handles != null) {
+            data.handles = this.handles;
+        }
+        return data;
+    }
+    serialize(): Uint8Array;
+    serialize(w: pb_1.BinaryWriter): void;
+    serialize(w?: pb_1.BinaryWriter): Uint8Array | void {
+        const writer = w || new pb_1.BinaryWriter();
+        if (this.tenant_id.length)
+            writer.writeString(1, this.tenant_id);
+        if (this.handles.length)
+            writer.writeRepeatedString(2, this.handles);
+        if (!w)
+            return writer.getResultBuffer();
+    }
+    static deserialize(bytes: Uint8Array | pb_1.BinaryReader): LookupUsersRequest {
+        const reader = bytes instanceof pb_1.BinaryReader ? bytes : new pb_1.BinaryReader(bytes), message = new LookupUsersRequest();
+        while (reader.nextField()) {
+            if (reader.isEndGroup())
+                break;
+            switch (reader.getFieldNumber()) {
+                case 1:
+                    message.tenant_id = reader.readString();
+                    break;
+                case 2:
+                    pb_1.Message.addToRepeatedField(message, 2, reader.readString());
+                    break;
+                default: reader.skipField();
+            }
+        }
+        return message;
+    }
+    serializeBinary(): Uint8Array {
+        return this.serialize();
+    }
+    static deserializeBinary(bytes: Uint8Array): LookupUsersRequest {
+        return LookupUsersRequest.deserialize(bytes);
+    }
+}
+export class ResolvedUser extends pb_1.Message {
+    #one_of_decls: number[][] = [];
+    constructor(data?: any[] | {
+        handle?: string;
+        user?: User;
+    }) {
+        super();
+        pb_1.Message.initialize(this, Array.isArray(data) ? data : [], 0, -1, [], this.#one_of_decls);
+        if (!Array.isArray(data) && typeof data == "object") {
+            if ("handle" in data && data.handle != undefined) {
+                this.handle = data.handle;
+            }
+            if ("user" in data && data.user != undefined) {
+                this.user = data.user;
+            }
+        }
+    }
+    get handle() {
+        return pb_1.Message.getFieldWithDefault(this, 1, "") as string;
+    }
+    set handle(value: string) {
+        pb_1.Message.setField(this, 1, value);
+    }
+    get user() {
+        return pb_1.Message.getWrapperField(this, User, 2) as User;
+    }
+    set user(value: User) {
+        pb_1.Message.setWrapperField(this, 2, value);
+    }
+    get has_user() {
+        return pb_1.Message.getField(this, 2) != null;
+    }
+    static fromObject(data: {
+        handle?: string;
+        user?: ReturnType<typeof User.prototype.toObject>;
+    }): ResolvedUser {
+        const message = new ResolvedUser({});
+        if (data.handle != null) {
+            message.handle = data.handle;
+        }
+        if (data.user != null) {
+            message.user = User.fromObject(data.user);
+        }
+        return message;
+    }
+    toObject() {
+        const data: {
+            handle?: string;
+            user?: ReturnType<typeof User.prototype.toObject>;
+        } = {};
+        if (this.handle != null) {
+            data.handle = this.handle;
+        }
+        if (this.user != null) {
+            data.user = this.user.toObject();
+        }
+        return data;
+    }
+    serialize(): Uint8Array;
+    serialize(w: pb_1.BinaryWriter): void;
+    serialize(w?: pb_1.BinaryWriter): Uint8Array | void {
+        const writer = w || new pb_1.BinaryWriter();
+        if (this.handle.length)
+            writer.writeString(1, this.handle);
+        if (this.has_user)
+            writer.writeMessage(2, this.user, () => this.user.serialize(writer));
+        if (!w)
+            return writer.getResultBuffer();
+    }
+    static deserialize(bytes: Uint8Array | pb_1.BinaryReader): ResolvedUser {
+        const reader = bytes instanceof pb_1.BinaryReader ? bytes : new pb_1.BinaryReader(bytes), message = new ResolvedUser();
+        while (reader.nextField()) {
+            if (reader.isEndGroup())
+                break;
+            switch (reader.getFieldNumber()) {
+                case 1:
+                    message.handle = reader.readString();
+                    break;
+                case 2:
+                    reader.readMessage(message.user, () => message.user = User.deserialize(reader));
+                    break;
+                default: reader.skipField();
+            }
+        }
+        return message;
+    }
+    serializeBinary(): Uint8Array {
+        return this.serialize();
+    }
+    static deserializeBinary(bytes: Uint8Array): ResolvedUser {
+        return ResolvedUser.deserialize(bytes);
+    }
+}
+export class LookupUsersResponse extends pb_1.Message {
+    #one_of_decls: number[][] = [];
+    constructor(data?: any[] | {
+        users?: ResolvedUser[];
+    }) {
+        super();
+        pb_1.Message.initialize(this, Array.isArray(data) ? data : [], 0, -1, [1], this.#one_of_decls);
+        if (!Array.isArray(data) && typeof data == "object") {
+            if ("users" in data && data.users != undefined) {
+                this.users = data.users;
+            }
+        }
+    }
+    get users() {
+        return pb_1.Message.getRepeatedWrapperField(this, ResolvedUser, 1) as ResolvedUser[];
+    }
+    set users(value: ResolvedUser[]) {
+        pb_1.Message.setRepeatedWrapperField(this, 1, value);
+    }
+    static fromObject(data: {
+        users?: ReturnType<typeof ResolvedUser.prototype.toObject>[];
+    }): LookupUsersResponse {
+        const message = new LookupUsersResponse({});
+        if (data.users != null) {
+            message.users = data.users.map(item => ResolvedUser.fromObject(item));
+        }
+        return message;
+    }
+    toObject() {
+        const data: {
+            users?: ReturnType<typeof ResolvedUser.prototype.toObject>[];
+        } = {};
+        if (this.users != null) {
+            data.users = this.users.map((item: ResolvedUser) => item.toObject());
+        }
+        return data;
+    }
+    serialize(): Uint8Array;
+    serialize(w: pb_1.BinaryWriter): void;
+    serialize(w?: pb_1.BinaryWriter): Uint8Array | void {
+        const writer = w || new pb_1.BinaryWriter();
+        if (this.users.length)
+            writer.writeRepeatedMessage(1, this.users, (item: ResolvedUser) => item.serialize(writer));
+        if (!w)
+            return writer.getResultBuffer();
+    }
+    static deserialize(bytes: Uint8Array | pb_1.BinaryReader): LookupUsersResponse {
+        const reader = bytes instanceof pb_1.BinaryReader ? bytes : new pb_1.BinaryReader(bytes), message = new LookupUsersResponse();
+        while (reader.nextField()) {
+            if (reader.isEndGroup())
+                break;
+            switch (reader.getFieldNumber()) {
+                case 1:
+                    reader.readMessage(message.users, () => pb_1.Message.addToRepeatedWrapperField(message, 1, ResolvedUser.deserialize(reader), ResolvedUser));
+                    break;
+                default: reader.skipField();
+            }
+        }
+        return message;
+    }
+    serializeBinary(): Uint8Array {
+        return this.serialize();
+    }
+    static deserializeBinary(bytes: Uint8Array): LookupUsersResponse {
+        return LookupUsersResponse.deserialize(bytes);
+    }
+}
+export class GetUsersByIDsRequest extends pb_1.Message {
+    #one_of_decls: number[][] = [];
+    constructor(data?: any[] | {
+        user_ids?: string[];
+    }) {
+        super();
+        pb_1.Message.initialize(this, Array.isArray(data) ? data : [], 0, -1, [1], this.#one_of_decls);
+        if (!Array.isArray(data) && typeof data == "object") {
+            if ("user_ids" in data && data.user_ids != undefined) {
+                this.user_ids = data.user_ids;
+            }
+        }
+    }
+    get user_ids() {
+        return pb_1.Message.getFieldWithDefault(this, 1, []) as string[];
+    }
+    set user_ids(value: string[]) {
+        pb_1.Message.setField(this, 1, value);
+    }
+    static fromObject(data: {
+        user_ids?: string[];
+    }): GetUsersByIDsRequest {
+        const message = new GetUsersByIDsRequest({});
+        if (data.user_ids != null) {
+            message.user_ids = data.user_ids;
+        }
+        return message;
+    }
+    toObject() {
+        const data: {
+            user_ids?: string[];
+        } = {};
+        if (this.user_ids != null) {
+            data.user_ids = this.user_ids;
+        }
+        return data;
+    }
+    serialize(): Uint8Array;
+    serialize(w: pb_1.BinaryWriter): void;
+    serialize(w?: pb_1.BinaryWriter): Uint8Array | void {
+        const writer = w || new pb_1.BinaryWriter();
+        if (this.user_ids.length)
+            writer.writeRepeatedString(1, this.user_ids);
+        if (!w)
+            return writer.getResultBuffer();
+    }
+    static deserialize(bytes: Uint8Array | pb_1.BinaryReader): GetUsersByIDsRequest {
+        const reader = bytes instanceof pb_1.BinaryReader ? bytes : new pb_1.BinaryReader(bytes), message = new GetUsersByIDsRequest();
+        while (reader.nextField()) {
+            if (reader.isEndGroup())
+                break;
+            switch (reader.getFieldNumber()) {
+                case 1:
+                    pb_1.Message.addToRepeatedField(message, 1, reader.readString());
+                    break;
+                default: reader.skipField();
+            }
+        }
+        return message;
+    }
+    serializeBinary(): Uint8Array {
+        return this.serialize();
+    }
+    static deserializeBinary(bytes: Uint8Array): GetUsersByIDsRequest {
+        return GetUsersByIDsRequest.deserialize(bytes);
+    }
+}
+export class GetUsersByIDsResponse extends pb_1.Message {
+    #one_of_decls: number[][] = [];
+    constructor(data?: any[] | {
+        users?: User[];
+    }) {
+        super();
+        pb_1.Message.initialize(this, Array.isArray(data) ? data : [], 0, -1, [1], this.#one_of_decls);
+        if (!Array.isArray(data) && typeof data == "object") {
+            if ("users" in data && data.users != undefined) {
+                this.users = data.users;
+            }
+        }
+    }
+    get users() {
+        return pb_1.Message.getRepeatedWrapperField(this, User, 1) as User[];
+    }
+    set users(value: User[]) {
+        pb_1.Message.setRepeatedWrapperField(this, 1, value);
+    }
+    static fromObject(data: {
+        users?: ReturnType<typeof User.prototype.toObject>[];
+    }): GetUsersByIDsResponse {
+        const message = new GetUsersByIDsResponse({});
+        if (data.users != null) {
+            message.users = data.users.map(item => User.fromObject(item));
+        }
+        return message;
+    }
+    toObject() {
+        const data: {
+            users?: ReturnType<typeof User.prototype.toObject>[];
+        } = {};
+        if (this.users != null) {
+            data.users = this.users.map((item: User) => item.toObject());
+        }
+        return data;
+    }
+    serialize(): Uint8Array;
+    serialize(w: pb_1.BinaryWriter): void;
+    serialize(w?: pb_1.BinaryWriter): Uint8Array | void {
+        const writer = w || new pb_1.BinaryWriter();
+        if (this.users.length)
+            writer.writeRepeatedMessage(1, this.users, (item: User) => item.serialize(writer));
+        if (!w)
+            return writer.getResultBuffer();
+    }
+    static deserialize(bytes: Uint8Array | pb_1.BinaryReader): GetUsersByIDsResponse {
+        const reader = bytes instanceof pb_1.BinaryReader ? bytes : new pb_1.BinaryReader(bytes), message = new GetUsersByIDsResponse();
+        while (reader.nextField()) {
+            if (reader.isEndGroup())
+                break;
+            switch (reader.getFieldNumber()) {
+                case 1:
+                    reader.readMessage(message.users, () => pb_1.Message.addToRepeatedWrapperField(message, 1, User.deserialize(reader), User));
+                    break;
+                default: reader.skipField();
+            }
+        }
+        return message;
+    }
+    serializeBinary(): Uint8Array {
+        return this.serialize();
+    }
+    static deserializeBinary(bytes: Uint8Array): GetUsersByIDsResponse {
+        return GetUsersByIDsResponse.deserialize(bytes);
+    }
+}
+export class SignupRequest extends pb_1.Message {
+    #one_of_decls: number[][] = [];
+    constructor(data?: any[] | {
+        email?: string;
+        password?: string;
+        name?: string;
+    }) {
+        super();
+        pb_1.Message.initialize(this, Array.isArray(data) ? data : [], 0, -1, [], this.#one_of_decls);
+        if (!Array.isArray(data) && typeof data == "object") {
+            if ("email" in data && data.email != undefined) {
+                this.email = data.email;
+            }
+            if ("password" in data && data.password != undefined) {
+                this.password = data.password;
+            }
+            if ("name" in data && data.name != undefined) {
+                this.name = data.name;
+            }
+        }
+    }
+    get email() {
+        return pb_1.Message.getFieldWithDefault(this, 1, "") as string;
+    }
+    set email(value: string) {
+        pb_1.Message.setField(this, 1, value);
+    }
+    get password() {
+        return pb_1.Message.getFieldWithDefault(this, 2, "") as string;
+    }
+    set password(value: string) {
+        pb_1.Message.setField(this, 2, value);
+    }
+    get name() {
+        return pb_1.Message.getFieldWithDefault(this, 3, "") as string;
+    }
+    set name(value: string) {
+        pb_1.Message.setField(this, 3, value);
+    }
+    static fromObject(data: {
+        email?: string;
+        password?: string;
+        name?: string;
+    }): SignupRequest {
+        const message = new SignupRequest({});
+        if (data.email != null) {
+            message.email = data.email;
+        }
+        if (data.password != null) {
+            message.password = data.password;
+        }
+        if (data.name != null) {
+            message.name = data.name;
+        }
+        return message;
+    }
+    toObject() {
+        const data: {
+            email?: string;
+            password?: string;
+            name?: string;
+        } = {};
+        if (this.email != null) {
+            data.email = this.email;
+        }
+        if (this.password != null) {
+            data.password = this.password;
+        }
+        if (this.name != null) {
+            data.name = this.name;
+        }
+        return data;
+    }
+    serialize(): Uint8Array;
+    serialize(w: pb_1.BinaryWriter): void;
+    serialize(w?: pb_1.BinaryWriter): Uint8Array | void {
+        const writer = w || new pb_1.BinaryWriter();
+        if (this.email.length)
+            writer.writeString(1, this.email);
+        if (this.password.length)
+            writer.writeString(2, this.password);
+        if (this.name.length)
+            writer.writeString(3, this.name);
+        if (!w)
+            return writer.getResultBuffer();
+    }
+    static deserialize(bytes: Uint8Array | pb_1.BinaryReader): SignupRequest {
+        const reader = bytes instanceof pb_1.BinaryReader ? bytes : new pb_1.BinaryReader(bytes), message = new SignupRequest();
+        while (reader.nextField()) {
+            if (reader.isEndGroup())
+                break;
+            switch (reader.getFieldNumber()) {
+                case 1:
+                    message.email = reader.readString();
+                    break;
+                case 2:
+                    message.password = reader.readString();
+                    break;
+                case 3:
+                    message.name = reader.readString();
+                    break;
+                default: reader.skipField();
+            }
+        }
+        return message;
+    }
+    serializeBinary(): Uint8Array {
+        return this.serialize();
+    }
+    static deserializeBinary(bytes: Uint8Array): SignupRequest {
+        return SignupRequest.deserialize(bytes);
+    }
+}
+export class LoginRequest extends pb_1.Message {
+    #one_of_decls: number[][] = [];
+    constructor(data?: any[] | {
+        email?: string;
+        password?: string;
+    }) {
+        super();
+        pb_1.Message.initialize(this, Array.isArray(data) ? data : [], 0, -1, [], this.#one_of_decls);
+        if (!Array.isArray(data) && typeof data == "object") {
+            if ("email" in data && data.email != undefined) {
+                this.email = data.email;
+            }
+            if ("password" in data && data.password != undefined) {
+                this.password = data.password;
+            }
+        }
+    }
+    get email() {
+        return pb_1.Message.getFieldWithDefault(this, 1, "") as string;
+    }
+    set email(value: string) {
+        pb_1.Message.setField(this, 1, value);
+    }
+    get password() {
+        return pb_1.Message.getFieldWithDefault(this, 2, "") as string;
+    }
+    set password(value: string) {
+        pb_1.Message.setField(this, 2, value);
+    }
+    static fromObject(data: {
+        email?: string;
+        password?: string;
+    }): LoginRequest {
+        const message = new LoginRequest({});
+        if (data.email != null) {
+            message.email = data.email;
+        }
+        if (data.password != null) {
+            message.password = data.password;
+        }
+        return message;
+    }
+    toObject() {
+        const data: {
+            email?: string;
+            password?: string;
+        } = {};
+        if (this.email != null) {
+            data.email = this.email;
+        }
+        if (this.password != null) {
+            data.password = this.password;
+        }
+        return data;
+    }
+    serialize(): Uint8Array;
+    serialize(w: pb_1.BinaryWriter): void;
+    serialize(w?: pb_1.BinaryWriter): Uint8Array | void {
+        const writer = w || new pb_1.BinaryWriter();
+        if (this.email.length)
+            writer.writeString(1, this.email);
+        if (this.password.length)
+            writer.writeString(2, this.password);
+        if (!w)
+            return writer.getResultBuffer();
+    }
+    static deserialize(bytes: Uint8Array | pb_1.BinaryReader): LoginRequest {
+        const reader = bytes instanceof pb_1.BinaryReader ? bytes : new pb_1.BinaryReader(bytes), message = new LoginRequest();
+        while (reader.nextField()) {
+            if (reader.isEndGroup())
+                break;
+            switch (reader.getFieldNumber()) {
+                case 1:
+                    message.email = reader.readString();
+                    break;
+                case 2:
+                    message.password = reader.readString();
+                    break;
+                default: reader.skipField();
+            }
+        }
+        return message;
+    }
+    serializeBinary(): Uint8Array {
+        return this.serialize();
+    }
+    static deserializeBinary(bytes: Uint8Array): LoginRequest {
+        return LoginRequest.deserialize(bytes);
+    }
+}
+export class LoginResponse extends pb_1.Message {
+    #one_of_decls: number[][] = [];
+    constructor(data?: any[] | {
+        token?: string;
+        expires_at?: dependency_1.Timestamp;
+        user?: User;
+    }) {
+        super();
+        pb_1.Message.initialize(this, Array.isArray(data) ? data : [], 0, -1, [], this.#one_of_decls);
+        if (!Array.isArray(data) && typeof data == "object") {
+            if ("token" in data && data.token != undefined) {
+                this.token = data.token;
+            }
+            if ("expires_at" in data && data.expires_at != undefined) {
+                this.expires_at = data.expires_at;
+            }
+            if ("user" in data && data.user != undefined) {
+                this.user = data.user;
+            }
+        }
+    }
+    get token() {
+        return pb_1.Message.getFieldWithDefault(this, 1, "") as string;
+    }
+    set token(value: string) {
+        pb_1.Message.setField(this, 1, value);
+    }
+    get expires_at() {
+        return pb_1.Message.getWrapperField(this, dependency_1.Timestamp, 2) as dependency_1.Timestamp;
+    }
+    set expires_at(value: dependency_1.Timestamp) {
+        pb_1.Message.setWrapperField(this, 2, value);
+    }
+    get has_expires_at() {
+        return pb_1.Message.getField(this, 2) != null;
+    }
+    get user() {
+        return pb_1.Message.getWrapperField(this, User, 3) as User;
+    }
+    set user(value: User) {
+        pb_1.Message.setWrapperField(this, 3, value);
+    }
+    get has_user() {
+        return pb_1.Message.getField(this, 3) != null;
+    }
+    static fromObject(data: {
+        token?: string;
+        expires_at?: ReturnType<typeof dependency_1.Timestamp.prototype.toObject>;
+        user?: ReturnType<typeof User.prototype.toObject>;
+    }): LoginResponse {
+        const message = new LoginResponse({});
+        if (data.token != null) {
+            message.token = data.token;
+        }
+        if (data.expires_at != null) {
+            message.expires_at = dependency_1.Timestamp.fromObject(data.expires_at);
+        }
+        if (data.user != null) {
+            message.user = User.fromObject(data.user);
+        }
+        return message;
+    }
+    toObject() {
+        const data: {
+            token?: string;
+            expires_at?: ReturnType<typeof dependency_1.Timestamp.prototype.toObject>;
+            user?: ReturnType<typeof User.prototype.toObject>;
+        } = {};
+        if (this.token != null) {
+            data.token = this.token;
+        }
+        if (this.expires_at != null) {
+            data.expires_at = this.expires_at.toObject();
+        }
+        if (this.user != null) {
+            data.user = this.user.toObject();
+        }
+        return data;
+    }
+    serialize(): Uint8Array;
+    serialize(w: pb_1.BinaryWriter): void;
+    serialize(w?: pb_1.BinaryWriter): Uint8Array | void {
+        const writer = w || new pb_1.BinaryWriter();
+        if (this.token.length)
+            writer.writeString(1, this.token);
+        if (this.has_expires_at)
+            writer.writeMessage(2, this.expires_at, () => this.expires_at.serialize(writer));
+        if (this.has_user)
+            writer.writeMessage(3, this.user, () => this.user.serialize(writer));
+        if (!w)
+            return writer.getResultBuffer();
+    }
+    static deserialize(bytes: Uint8Array | pb_1.BinaryReader): LoginResponse {
+        const reader = bytes instanceof pb_1.BinaryReader ? bytes : new pb_1.BinaryReader(bytes), message = new LoginResponse();
+        while (reader.nextField()) {
+            if (reader.isEndGroup())
+                break;
+            switch (reader.getFieldNumber()) {
+                case 1:
+                    message.token = reader.readString();
+                    break;
+                case 2:
+                    reader.readMessage(message.expires_at, () => message.expires_at = dependency_1.Timestamp.deserialize(reader));
+                    break;
+                case 3:
+                    reader.readMessage(message.user, () => message.user = User.deserialize(reader));
+                    break;
+                default: reader.skipField();
+            }
+        }
+        return message;
+    }
+    serializeBinary(): Uint8Array {
+        return this.serialize();
+    }
+    static deserializeBinary(bytes: Uint8Array): LoginResponse {
+        return LoginResponse.deserialize(bytes);
+    }
+}
+export class ChangePasswordRequest extends pb_1.Message {
+    #one_of_decls: number[][] = [];
+    constructor(data?: any[] | {
+        current_password?: string;
+        new_password?: string;
+    }) {
+        super();
+        pb_1.Message.initialize(this, Array.isArray(data) ? data : [], 0, -1, [], this.#one_of_decls);
+        if (!Array.isArray(data) && typeof data == "object") {
+            if ("current_password" in data && data.current_password != undefined) {
+                this.current_password = data.current_password;
+            }
+            if ("new_password" in data && data.new_password != undefined) {
+                this.new_password = data.new_password;
+            }
+        }
+    }
+    get current_password() {
+        return pb_1.Message.getFieldWithDefault(this, 1, "") as string;
+    }
+    set current_password(value: string) {
+        pb_1.Message.setField(this, 1, value);
+    }
+    get new_password() {
+        return pb_1.Message.getFieldWithDefault(this, 2, "") as string;
+    }
+    set new_password(value: string) {
+        pb_1.Message.setField(this, 2, value);
+    }
+    static fromObject(data: {
+        current_password?: string;
+        new_password?: string;
+    }): ChangePasswordRequest {
+        const message = new ChangePasswordRequest({});
+        if (data.current_password != null) {
+            message.current_password = data.current_password;
+        }
+        if (data.new_password != null) {
+            message.new_password = data.new_password;
+        }
+        return message;
+    }
+    toObject() {
+        const data: {
+            current_password?: string;
+            new_password?: string;
+        } = {};
+        if (this.current_password != null) {
+            data.current_password = this.current_password;
+        }
+        if (this.new_password != null) {
+            data.new_password = this.new_password;
+        }
+        return data;
+    }
+    serialize(): Uint8Array;
+    serialize(w: pb_1.BinaryWriter): void;
+    serialize(w?: pb_1.BinaryWriter): Uint8Array | void {
+        const writer = w || new pb_1.BinaryWriter();
+        if (this.current_password.length)
+            writer.writeString(1, this.current_password);
+        if (this.new_password.length)
+            writer.writeString(2, this.new_password);
+        if (!w)
+            return writer.getResultBuffer();
+    }
+    static deserialize(bytes: Uint8Array | pb_1.BinaryReader): ChangePasswordRequest {
+        const reader = bytes instanceof pb_1.BinaryReader ? bytes : new pb_1.BinaryReader(bytes), message = new ChangePasswordRequest();
+        while (reader.nextField()) {
+            if (reader.isEndGroup())
+                break;
+            switch (reader.getFieldNumber()) {
+                case 1:
+                    message.current_password = reader.readString();
+                    break;
+                case 2:
+                    message.new_password = reader.readString();
+                    break;
+                default: reader.skipField();
+            }
+        }
+        return message;
+    }
+    serializeBinary(): Uint8Array {
+        return this.serialize();
+    }
+    static deserializeBinary(bytes: Uint8Array): ChangePasswordRequest {
+        return ChangePasswordRequest.deserialize(bytes);
+    }
+}
+export class ChangePasswordResponse extends pb_1.Message {
+    #one_of_decls: number[][] = [];
+    constructor(data?: any[] | {
+        message?: string;
+        token?: string;
+        expires_at?: dependency_1.Timestamp;
+    }) {
+        super();
+        pb_1.Message.initialize(this, Array.isArray(data) ? data : [], 0, -1, [], this.#one_of_decls);
+        if (!Array.isArray(data) && typeof data == "object") {
+            if ("message" in data && data.message != undefined) {
+                this.message = data.message;
+            }
+            if ("token" in data && data.token != undefined) {
+                this.token = data.token;
+            }
+            if ("expires_at" in data && data.expires_at != undefined) {
+                this.expires_at = data.expires_at;
+            }
+        }
+    }
+    get message() {
+        return pb_1.Message.getFieldWithDefault(this, 1, "") as string;
+    }
+    set message(value: string) {
+        pb_1.Message.setField(this, 1, value);
+    }
+    get token() {
+        return pb_1.Message.getFieldWithDefault(this, 2, "") as string;
+    }
+    set token(value: string) {
+        pb_1.Message.setField(this, 2, value);
+    }
+    get expires_at() {
+        return pb_1.Message.getWrapperField(this, dependency_1.Timestamp, 3) as dependency_1.Timestamp;
+    }
+    set expires_at(value: dependency_1.Timestamp) {
+        pb_1.Message.setWrapperField(this, 3, value);
+    }
+    get has_expires_at() {
+        return pb_1.Message.getField(this, 3) != null;
+    }
+    static fromObject(data: {
+        message?: string;
+        token?: string;
+        expires_at?: ReturnType<typeof dependency_1.Timestamp.prototype.toObject>;
+    }): ChangePasswordResponse {
+        const message = new ChangePasswordResponse({});
+        if (data.message != null) {
+            message.message = data.message;
+        }
+        if (data.token != null) {
+            message.token = data.token;
+        }
+        if (data.expires_at != null) {
+            message.expires_at = dependency_1.Timestamp.fromObject(data.expires_at);
+        }
+        return message;
+    }
+    toObject() {
+        const data: {
+            message?: string;
+            token?: string;
+            expires_at?: ReturnType<typeof dependency_1.Timestamp.prototype.toObject>;
+        } = {};
+        if (this.message != null) {
+            data.message = this.message;
+        }
+        if (this.token != null) {
+            data.token = this.token;
+        }
+        if (this.expires_at != null) {
+            data.expires_at = this.expires_at.toObject();
+        }
+        return data;
+    }
+    serialize(): Uint8Array;
+    serialize(w: pb_1.BinaryWriter): void;
+    serialize(w?: pb_1.BinaryWriter): Uint8Array | void {
+        const writer = w || new pb_1.BinaryWriter();
+        if (this.message.length)
+            writer.writeString(1, this.message);
+        if (this.token.length)
+            writer.writeString(2, this.token);
+        if (this.has_expires_at)
+            writer.writeMessage(3, this.expires_at, () => this.expires_at.serialize(writer));
+        if (!w)
+            return writer.getResultBuffer();
+    }
+    static deserialize(bytes: Uint8Array | pb_1.BinaryReader): ChangePasswordResponse {
+        const reader = bytes instanceof pb_1.BinaryReader ? bytes : new pb_1.BinaryReader(bytes), message = new ChangePasswordResponse();
+        while (reader.nextField()) {
+            if (reader.isEndGroup())
+                break;
+            switch (reader.getFieldNumber()) {
+                case 1:
+                    message.message = reader.readString();
+                    break;
+                case 2:
+                    message.token = reader.readString();
+                    break;
+                case 3:
+                    reader.readMessage(message.expires_at, () => message.expires_at = dependency_1.Timestamp.deserialize(reader));
+                    break;
+                default: reader.skipField();
+            }
+        }
+        return message;
+    }
+    serializeBinary(): Uint8Array {
+        return this.serialize();
+    }
+    static deserializeBinary(bytes: Uint8Array): ChangePasswordResponse {
+        return ChangePasswordResponse.deserialize(bytes);
+    }
+}
+export class ResetPasswordRequest extends pb_1.Message {
+    #one_of_decls: number[][] = [];
+    constructor(data?: any[] | {
+        email?: string;
+    }) {
+        super();
+        pb_1.Message.initialize(this, Array.isArray(data) ? data : [], 0, -1, [], this.#one_of_decls);
+        if (!Array.isArray(data) && typeof data == "object") {
+            if ("email" in data && data.email != undefined) {
+                this.email = data.email;
+            }
+        }
+    }
+    get email() {
+        return pb_1.Message.getFieldWithDefault(this, 1, "") as string;
+    }
+    set email(value: string) {
+        pb_1.Message.setField(this, 1, value);
+    }
+    static fromObject(data: {
+        email?: string;
+    }): ResetPasswordRequest {
+        const message = new ResetPasswordRequest({});
+        if (data.email != null) {
+            message.email = data.email;
+        }
+        return message;
+    }
+    toObject() {
+        const data: {
+            email?: string;
+        } = {};
+        if (this.email != null) {
+            data.email = this.email;
+        }
+        return data;
+    }
+    serialize(): Uint8Array;
+    serialize(w: pb_1.BinaryWriter): void;
+    serialize(w?: pb_1.BinaryWriter): Uint8Array | void {
+        const writer = w || new pb_1.BinaryWriter();
+        if (this.email.length)
+            writer.writeString(1, this.email);
+        if (!w)
+            return writer.getResultBuffer();
+    }
+    static deserialize(bytes: Uint8Array | pb_1.BinaryReader): ResetPasswordRequest {
+        const reader = bytes instanceof pb_1.BinaryReader ? bytes : new pb_1.BinaryReader(bytes), message = new ResetPasswordRequest();
+        while (reader.nextField()) {
+            if (reader.isEndGroup())
+                break;
+            switch (reader.getFieldNumber()) {
+                case 1:
+                    message.email = reader.readString();
+                    break;
+                default: reader.skipField();
+            }
+        }
+        return message;
+    }
+    serializeBinary(): Uint8Array {
+        return this.serialize();
+    }
+    static deserializeBinary(bytes: Uint8Array): ResetPasswordRequest {
+        return ResetPasswordRequest.deserialize(bytes);
+    }
+}
+export class ResetPasswordResponse extends pb_1.Message {
+    #one_of_decls: number[][] = [];
+    constructor(data?: any[] | {
+        reset_token?: string;
+        expires_at?: dependency_1.Timestamp;
+    }) {
+        super();
+        pb_1.Message.initialize(this, Array.isArray(data) ? data : [], 0, -1, [], this.#one_of_decls);
+        if (!Array.isArray(data) && typeof data == "object") {
+            if ("reset_token" in data && data.reset_token != undefined) {
+                this.reset_token = data.reset_token;
+            }
+            if ("expires_at" in data && data.expires_at != undefined) {
+                this.expires_at = data.expires_at;
+            }
+        }
+    }
+    get reset_token() {
+        return pb_1.Message.getFieldWithDefault(this, 1, "") as string;
+    }
+    set reset_token(value: string) {
+        pb_1.Message.setField(this, 1, value);
+    }
+    get expires_at() {
+        return pb_1.Message.getWrapperField(this, dependency_1.Timestamp, 2) as dependency_1.Timestamp;
+    }
+    set expires_at(value: dependency_1.Timestamp) {
+        pb_1.Message.setWrapperField(this, 2, value);
+    }
+    get has_expires_at() {
+        return pb_1.Message.getField(this, 2) != null;
+    }
+    static fromObject(data: {
+        reset_token?: string;
+        expires_at?: ReturnType<typeof dependency_1.Timestamp.prototype.toObject>;
+    }): ResetPasswordResponse {
+        const message = new ResetPasswordResponse({});
+        if (data.reset_token != null) {
+            message.reset_token = data.reset_token;
+        }
+        if (data.expires_at != null) {
+            message.expires_at = dependency_1.Timestamp.fromObject(data.expires_at);
+        }
+        return message;
+    }
+    toObject() {
+        const data: {
+            reset_token?: string;
+            expires_at?: ReturnType<typeof dependency_1.Timestamp.prototype.toObject>;
+        } = {};
+        if (this.reset_token != null) {
+            data.reset_token = this.reset_token;
+        }
+        if (this.expires_at != null) {
+            data.expires_at = this.expires_at.toObject();
+        }
+        return data;
+    }
+    serialize(): Uint8Array;
+    serialize(w: pb_1.BinaryWriter): void;
+    serialize(w?: pb_1.BinaryWriter): Uint8Array | void {
+        const writer = w || new pb_1.BinaryWriter();
+        if (this.reset_token.length)
+            writer.writeString(1, this.reset_token);
+        if (this.has_expires_at)
+            writer.writeMessage(2, this.expires_at, () => this.expires_at.serialize(writer));
+        if (!w)
+            return writer.getResultBuffer();
+    }
+    static deserialize(bytes: Uint8Array | pb_1.BinaryReader): ResetPasswordResponse {
+        const reader = bytes instanceof pb_1.BinaryReader ? bytes : new pb_1.BinaryReader(bytes), message = new ResetPasswordResponse();
+        while (reader.nextField()) {
+            if (reader.isEndGroup())
+                break;
+            switch (reader.getFieldNumber()) {
+                case 1:
+                    message.reset_token = reader.readString();
+                    break;
+                case 2:
+                    reader.readMessage(message.expires_at, () => message.expires_at = dependency_1.Timestamp.deserialize(reader));
+                    break;
+                default: reader.skipField();
+            }
+        }
+        return message;
+    }
+    serializeBinary(): Uint8Array {
+        return this.serialize();
+    }
+    static deserializeBinary(bytes: Uint8Array): ResetPasswordResponse {
+        return ResetPasswordResponse.deserialize(bytes);
+    }
+}
+export class CompletePasswordResetRequest extends pb_1.Message {
+    #one_of_decls: number[][] = [];
+    constructor(data?: any[] | {
+        reset_token?: string;
+        new_password?: string;
+    }) {
+        super();
+        pb_1.Message.initialize(this, Array.isArray(data) ? data : [], 0, -1, [], this.#one_of_decls);
+        if (!Array.isArray(data) && typeof data == "object") {
+            if ("reset_token" in data && data.reset_token != undefined) {
+                this.reset_token = data.reset_token;
+            }
+            if ("new_password" in data && data.new_password != undefined) {
+                this.new_password = data.new_password;
+            }
+        }
+    }
+    get reset_token() {
+        return pb_1.Message.getFieldWithDefault(this, 1, "") as string;
+    }
+    set reset_token(value: string) {
+        pb_1.Message.setField(this, 1, value);
+    }
+    get new_password() {
+        return pb_1.Message.getFieldWithDefault(this, 2, "") as string;
+    }
+    set new_password(value: string) {
+        pb_1.Message.setField(this, 2, value);
+    }
+    static fromObject(data: {
+        reset_token?: string;
+        new_password?: string;
+    }): CompletePasswordResetRequest {
+        const message = new CompletePasswordResetRequest({});
+        if (data.reset_token != null) {
+            message.reset_token = data.reset_token;
+        }
+        if (data.new_password != null) {
+            message.new_password = data.new_password;
+        }
+        return message;
+    }
+    toObject() {
+        const data: {
+            reset_token?: string;
+            new_password?: string;
+        } = {};
+        if (this.reset_token != null) {
+            data.reset_token = this.reset_token;
+        }
+        if (this.new_password != null) {
+            data.new_password = this.new_password;
+        }
+        return data;
+    }
+    serialize(): Uint8Array;
+    serialize(w: pb_1.BinaryWriter): void;
+    serialize(w?: pb_1.BinaryWriter): Uint8Array | void {
+        const writer = w || new pb_1.BinaryWriter();
+        if (this.reset_token.length)
+            writer.writeString(1, this.reset_token);
+        if (this.new_password.length)
+            writer.writeString(2, this.new_password);
+        if (!w)
+            return writer.getResultBuffer();
+    }
+    static deserialize(bytes: Uint8Array | pb_1.BinaryReader): CompletePasswordResetRequest {
+        const reader = bytes instanceof pb_1.BinaryReader ? bytes : new pb_1.BinaryReader(bytes), message = new CompletePasswordResetRequest();
+        while (reader.nextField()) {
+            if (reader.isEndGroup())
+                break;
+            switch (reader.getFieldNumber()) {
+                case 1:
+                    message.reset_token = reader.readString();
+                    break;
+                case 2:
+                    message.new_password = reader.readString();
+                    break;
+                default: reader.skipField();
+            }
+        }
+        return message;
+    }
+    serializeBinary(): Uint8Array {
+        return this.serialize();
+    }
+    static deserializeBinary(bytes: Uint8Array): CompletePasswordResetRequest {
+        return CompletePasswordResetRequest.deserialize(bytes);
+    }
+}
+export class AccessToken extends pb_1.Message {
+    #one_of_decls: number[][] = [];
+    constructor(data?: any[] | {
+        id?: string;
+        name?: string;
+        tenant_id?: string;
+        scopes?: string[];
+        token_prefix?: string;
+        expires_at?: dependency_1.Timestamp;
+        last_used_at?: dependency_1.Timestamp;
+        created_at?: dependency_1.Timestamp;
+    }) {
+        super();
+        pb_1.Message.initialize(this, Array.isArray(data) ? data : [], 0, -1, [4], this.#one_of_decls);
+        if (!Array.isArray(data) && typeof data == "object") {
+            if ("id" in data && data.id != undefined) {
+                this.id = data.id;
+            }
+            if ("name" in data && data.name != undefined) {
+                this.name = data.name;
+            }
+            if ("tenant_id" in data && data.tenant_id != undefined) {
+                this.tenant_id = data.tenant_id;
+            }
+            if ("scopes" in data && data.scopes != undefined) {
+                this.scopes = data.scopes;
+            }
+            if ("token_prefix" in data && data.token_prefix != undefined) {
+                this.token_prefix = data.token_prefix;
+            }
+            if ("expires_at" in data && data.expires_at != undefined) {
+                this.expires_at = data.expires_at;
+            }
+            if ("last_used_at" in data && data.last_used_at != undefined) {
+                this.last_used_at = data.last_used_at;
+            }
+            if ("created_at" in data && data.created_at != undefined) {
+                this.created_at = data.created_at;
+            }
+        }
+    }
+    get id() {
+        return pb_1.Message.getFieldWithDefault(this, 1, "") as string;
+    }
+    set id(value: string) {
+        pb_1.Message.setField(this, 1, value);
+    }
+    get name() {
+        return pb_1.Message.getFieldWithDefault(this, 2, "") as string;
+    }
+    set name(value: string) {
+        pb_1.Message.setField(this, 2, value);
+    }
+    get tenant_id() {
+        return pb_1.Message.getFieldWithDefault(this, 3, "") as string;
+    }
+    set tenant_id(value: string) {
+        pb_1.Message.setField(this, 3, value);
+    }
+    get scopes() {
+        return pb_1.Message.getFieldWithDefault(this, 4, []) as string[];
+    }
+    set scopes(value: string[]) {
+        pb_1.Message.setField(this, 4, value);
+    }
+    get token_prefix() {
+        return pb_1.Message.getFieldWithDefault(this, 5, "") as string;
+    }
+    set token_prefix(value: string) {
+        pb_1.Message.setField(this, 5, value);
+    }
+    get expires_at() {
+        return pb_1.Message.getWrapperField(this, dependency_1.Timestamp, 6) as dependency_1.Timestamp;
+    }
+    set expires_at(value: dependency_1.Timestamp) {
+        pb_1.Message.setWrapperField(this, 6, value);
+    }
+    get has_expires_at() {
+        return pb_1.Message.getField(this, 6) != null;
+    }
+    get last_used_at() {
+        return pb_1.Message.getWrapperField(this, dependency_1.Timestamp, 7) as dependency_1.Timestamp;
+    }
+    set last_used_at(value: dependency_1.Timestamp) {
+        pb_1.Message.setWrapperField(this, 7, value);
+    }
+    get has_last_used_at() {
+        return pb_1.Message.getField(this, 7) != null;
+    }
+    get created_at() {
+        return pb_1.Message.getWrapperField(this, dependency_1.Timestamp, 8) as dependency_1.Timestamp;
+    }
+    set created_at(value: dependency_1.Timestamp) {
+        pb_1.Message.setWrapperField(this, 8, value);
+    }
+    get has_created_at() {
+        return pb_1.Message.getField(this, 8) != null;
+    }
+    static fromObject(data: {
+        id?: string;
+        name?: string;
+        tenant_id?: string;
+        scopes?: string[];
+        token_prefix?: string;
+        expires_at?: ReturnType<typeof dependency_1.Timestamp.prototype.toObject>;
+        last_used_at?: ReturnType<typeof dependency_1.Timestamp.prototype.toObject>;
+        created_at?: ReturnType<typeof dependency_1.Timestamp.prototype.toObject>;
+    }): AccessToken {
+        const message = new AccessToken({});
+        if (data.id != null) {
+            message.id = data.id;
+        }
+        if (data.name != null) {
+            message.name = data.name;
+        }
+        if (data.tenant_id != null) {
+            message.tenant_id = data.tenant_id;
+        }
+        if (data.scopes != null) {
+            message.scopes = data.scopes;
+        }
+        if (data.token_prefix != null) {
+            message.token_prefix = data.token_prefix;
+        }
+        if (data.expires_at != null) {
+            message.expires_at = dependency_1.Timestamp.fromObject(data.expires_at);
+        }
+        if (data.last_used_at != null) {
+            message.last_used_at = dependency_1.Timestamp.fromObject(data.last_used_at);
+        }
+        if (data.created_at != null) {
+            message.created_at = dependency_1.Timestamp.fromObject(data.created_at);
+        }
+        return message;
+    }
+    toObject() {
+        const data: {
+            id?: string;
+            name?: string;
+            tenant_id?: string;
+            scopes?: string[];
+            token_prefix?: string;
+            expires_at?: ReturnType<typeof dependency_1.Timestamp.prototype.toObject>;
+            last_used_at?: ReturnType<typeof dependency_1.Timestamp.prototype.toObject>;
+            created_at?: ReturnType<typeof dependency_1.Timestamp.prototype.toObject>;
+        } = {};
+        if (this.id != null) {
+            data.id = this.id;
+        }
+        if (this.name != null) {
+            data.name = this.name;
+        }
+        if (this.tenant_id != null) {
+            data.tenant_id = this.tenant_id;
+        }
+        if (this.scopes != null) {
+            data.scopes = this.scopes;
+        }
+        if (this.token_prefix != null) {
+            data.token_prefix = this.token_prefix;
+        }
+        if (this.expires_at != null) {
+            data.expires_at = this.expires_at.toObject();
+        }
+        if (this.last_used_at != null) {
+            data.last_used_at = this.last_used_at.toObject();
+        }
+        if (this.created_at != null) {
+            data.created_at = this.created_at.toObject();
+        }
+        return data;
+    }
+    serialize(): Uint8Array;
+    serialize(w: pb_1.BinaryWriter): void;
+    serialize(w?: pb_1.BinaryWriter): Uint8Array | void {
+        const writer = w || new pb_1.BinaryWriter();
+        if (this.id.length)
+            writer.writeString(1, this.id);
+        if (this.name.length)
+            writer.writeString(2, this.name);
+        if (this.tenant_id.length)
+            writer.writeString(3, this.tenant_id);
+        if (this.scopes.length)
+            writer.writeRepeatedString(4, this.scopes);
+        if (this.token_prefix.length)
+            writer.writeString(5, this.token_prefix);
+        if (this.has_expires_at)
+            writer.writeMessage(6, this.expires_at, () => this.expires_at.serialize(writer));
+        if (this.has_last_used_at)
+            writer.writeMessage(7, this.last_used_at, () => this.last_used_at.serialize(writer));
+        if (this.has_created_at)
+            writer.writeMessage(8, this.created_at, () => this.created_at.serialize(writer));
+        if (!w)
+            return writer.getResultBuffer();
+    }
+    static deserialize(bytes: Uint8Array | pb_1.BinaryReader): AccessToken {
+        const reader = bytes instanceof pb_1.BinaryReader ? bytes : new pb_1.BinaryReader(bytes), message = new AccessToken();
+        while (reader.nextField()) {
+            if (reader.isEndGroup())
+                break;
+            switch (reader.getFieldNumber()) {
+                case 1:
+                    message.id = reader.readString();
+                    break;
+                case 2:
+                    message.name = reader.readString();
+                    break;
+                case 3:
+                    message.tenant_id = reader.readString();
+                    break;
+                case 4:
+                    pb_1.Message.addToRepeatedField(message, 4, reader.readString());
+                    break;
+                case 5:
+                    message.token_prefix = reader.readString();
+                    break;
+                case 6:
+                    reader.readMessage(message.expires_at, () => message.expires_at = dependency_1.Timestamp.deserialize(reader));
+                    break;
+                case 7:
+                    reader.readMessage(message.last_used_at, () => message.last_used_at = dependency_1.Timestamp.deserialize(reader));
+                    break;
+                case 8:
+                    reader.readMessage(message.created_at, () => message.created_at = dependency_1.Timestamp.deserialize(reader));
+                    break;
+                default: reader.skipField();
+            }
+        }
+        return message;
+    }
+    serializeBinary(): Uint8Array {
+        return this.serialize();
+    }
+    static deserializeBinary(bytes: Uint8Array): AccessToken {
+        return AccessToken.deserialize(bytes);
+    }
+}
+export class CreateAccessTokenRequest extends pb_1.Message {
+    #one_of_decls: number[][] = [];
+    constructor(data?: any[] | {
+        name?: string;
+        tenant_id?: string;
+        scopes?: string[];
+        expires_in_days?: number;
+    }) {
+        super();
+        pb_1.Message.initialize(this, Array.isArray(data) ? data : [], 0, -1, [3], this.#one_of_decls);
+        if (!Array.isArray(data) && typeof data == "object") {
+            if ("name" in data && data.name != undefined) {
+                this.name = data.name;
+            }
+            if ("tenant_id" in data && data.tenant_id != undefined) {
+                this.tenant_id = data.tenant_id;
+            }
+            if ("scopes" in data && data.scopes != undefined) {
+                this.scopes = data.scopes;
+            }
+            if ("expires_in_days" in data && data.expires_in_days != undefined) {
+                this.expires_in_days = data.expires_in_days;
+            }
+        }
+    }
+    get name() {
+        return pb_1.Message.getFieldWithDefault(this, 1, "") as string;
+    }
+    set name(value: string) {
+        pb_1.Message.setField(this, 1, value);
+    }
+    get tenant_id() {
+        return pb_1.Message.getFieldWithDefault(this, 2, "") as string;
+    }
+    set tenant_id(value: string) {
+        pb_1.Message.setField(this, 2, value);
+    }
+    get scopes() {
+        return pb_1.Message.getFieldWithDefault(this, 3, []) as string[];
+    }
+    set scopes(value: string[]) {
+        pb_1.Message.setField(this, 3, value);
+    }
+    get expires_in_days() {
+        return pb_1.Message.getFieldWithDefault(this, 4, 0) as number;
+    }
+    set expires_in_days(value: number) {
+        pb_1.Message.setField(this, 4, value);
+    }
+    static fromObject(data: {
+        name?: string;
+        tenant_id?: string;
+        scopes?: string[];
+        expires_in_days?: number;
+    }): CreateAccessTokenRequest {
+        const message = new CreateAccessTokenRequest({});
+        if (data.name != null) {
+            message.name = data.name;
+        }
+        if (data.tenant_id != null) {
+            message.tenant_id = data.tenant_id;
+        }
+        if (data.scopes != null) {
+            message.scopes = data.scopes;
+        }
+        if (data.expires_in_days != null) {
+            message.expires_in_days = data.expires_in_days;
+        }
+        return message;
+    }
+    toObject() {
+        const data: {
+            name?: string;
+            tenant_id?: string;
+            scopes?: string[];
+            expires_in_days?: number;
+        } = {};
+        if (this.name != null) {
+            data.name = this.name;
+        }
+        if (this.tenant_id != null) {
+            data.tenant_id = this.tenant_id;
+        }
+        if (this.scopes != null) {
+            data.scopes = this.scopes;
+        }
+        if (this.expires_in_days != null) {
+            data.expires_in_days = this.expires_in_days;
+        }
+        return data;
+    }
+    serialize(): Uint8Array;
+    serialize(w: pb_1.BinaryWriter): void;
+    serialize(w?: pb_1.BinaryWriter): Uint8Array | void {
+        const writer = w || new pb_1.BinaryWriter();
+        if (this.name.length)
+            writer.writeString(1, this.name);
+        if (this.tenant_id.length)
+            writer.writeString(2, this.tenant_id);
+        if (this.scopes.length)
+            writer.writeRepeatedString(3, this.scopes);
+        if (this.expires_in_days != 0)
+            writer.writeInt32(4, this.expires_in_days);
+        if (!w)
+            return writer.getResultBuffer();
+    }
+    static deserialize(bytes: Uint8Array | pb_1.BinaryReader): CreateAccessTokenRequest {
+        const reader = bytes instanceof pb_1.BinaryReader ? bytes : new pb_1.BinaryReader(bytes), message = new CreateAccessTokenRequest();
+        while (reader.nextField()) {
+            if (reader.isEndGroup())
+                break;
+            switch (reader.getFieldNumber()) {
+                case 1:
+                    message.name = reader.readString();
+                    break;
+                case 2:
+                    message.tenant_id = reader.readString();
+                    break;
+                case 3:
+                    pb_1.Message.addToRepeatedField(message, 3, reader.readString());
+                    break;
+                case 4:
+                    message.expires_in_days = reader.readInt32();
+                    break;
+                default: reader.skipField();
+            }
+        }
+        return message;
+    }
+    serializeBinary(): Uint8Array {
+        return this.serialize();
+    }
+    static deserializeBinary(bytes: Uint8Array): CreateAccessTokenRequest {
+        return CreateAccessTokenRequest.deserialize(bytes);
+    }
+}
+export class CreateAccessTokenResponse extends pb_1.Message {
+    #one_of_decls: number[][] = [];
+    constructor(data?: any[] | {
+        token?: string;
+        access_token?: AccessToken;
+    }) {
+        super();
+        pb_1.Message.initialize(this, Array.isArray(data) ? data : [], 0, -1, [], this.#one_of_decls);
+        if (!Array.isArray(data) && typeof data == "object") {
+            if ("token" in data && data.token != undefined) {
+                this.token = data.token;
+            }
+            if ("access_token" in data && data.access_token != undefined) {
+                this.access_token = data.access_token;
+            }
+        }
+    }
+    get token() {
+        return pb_1.Message.getFieldWithDefault(this, 1, "") as string;
+    }
+    set token(value: string) {
+        pb_1.Message.setField(this, 1, value);
+    }
+    get access_token() {
+        return pb_1.Message.getWrapperField(this, AccessToken, 2) as AccessToken;
+    }
+    set access_token(value: AccessToken) {
+        pb_1.Message.setWrapperField(this, 2, value);
+    }
+    get has_access_token() {
+        return pb_1.Message.getField(this, 2) != null;
+    }
+    static fromObject(data: {
+        token?: string;
+        access_token?: ReturnType<typeof AccessToken.prototype.toObject>;
+    }): CreateAccessTokenResponse {
+        const message = new CreateAccessTokenResponse({});
+        if (data.token != null) {
+            message.token = data.token;
+        }
+        if (data.access_token != null) {
+            message.access_token = AccessToken.fromObject(data.access_token);
+        }
+        return message;
+    }
+    toObject() {
+        const data: {
+            token?: string;
+            access_token?: ReturnType<typeof AccessToken.prototype.toObject>;
+        } = {};
+        if (this.token != null) {
+            data.token = this.token;
+        }
+        if (this.access_token != null) {
+            data.access_token = this.access_token.toObject();
+        }
+        return data;
+    }
+    serialize(): Uint8Array;
+    serialize(w: pb_1.BinaryWriter): void;
+    serialize(w?: pb_1.BinaryWriter): Uint8Array | void {
+        const writer = w || new pb_1.BinaryWriter();
+        if (this.token.length)
+            writer.writeString(1, this.token);
+        if (this.has_access_token)
+            writer.writeMessage(2, this.access_token, () => this.access_token.serialize(writer));
+        if (!w)
+            return writer.getResultBuffer();
+    }
+    static deserialize(bytes: Uint8Array | pb_1.BinaryReader): CreateAccessTokenResponse {
+        const reader = bytes instanceof pb_1.BinaryReader ? bytes : new pb_1.BinaryReader(bytes), message = new CreateAccessTokenResponse();
+        while (reader.nextField()) {
+            if (reader.isEndGroup())
+                break;
+            switch (reader.getFieldNumber()) {
+                case 1:
+                    message.token = reader.readString();
+                    break;
+                case 2:
+                    reader.readMessage(message.access_token, () => message.access_token = AccessToken.deserialize(reader));
+                    break;
+                default: reader.skipField();
+            }
+        }
+        return message;
+    }
+    serializeBinary(): Uint8Array {
+        return this.serialize();
+    }
+    static deserializeBinary(bytes: Uint8Array): CreateAccessTokenResponse {
+        return CreateAccessTokenResponse.deserialize(bytes);
+    }
+}
+export class ListAccessTokensRequest extends pb_1.Message {
+    #one_of_decls: number[][] = [];
+    constructor(data?: any[] | {}) {
+        super();
+        pb_1.Message.initialize(this, Array.isArray(data) ? data : [], 0, -1, [], this.#one_of_decls);
+        if (!Array.isArray(data) && typeof data == "object") { }
+    }
+    static fromObject(data: {}): ListAccessTokensRequest {
+        const message = new ListAccessTokensRequest({});
+        return message;
+    }
+    toObject() {
+        const data: {} = {};
+        return data;
+    }
+    serialize(): Uint8Array;
+    serialize(w: pb_1.BinaryWriter): void;
+    serialize(w?: pb_1.BinaryWriter): Uint8Array | void {
+        const writer = w || new pb_1.BinaryWriter();
+        if (!w)
+            return writer.getResultBuffer();
+    }
+    static deserialize(bytes: Uint8Array | pb_1.BinaryReader): ListAccessTokensRequest {
+        const reader = bytes instanceof pb_1.BinaryReader ? bytes : new pb_1.BinaryReader(bytes), message = new ListAccessTokensRequest();
+        while (reader.nextField()) {
+            if (reader.isEndGroup())
+                break;
+            switch (reader.getFieldNumber()) {
+                default: reader.skipField();
+            }
+        }
+        return message;
+    }
+    serializeBinary(): Uint8Array {
+        return this.serialize();
+    }
+    static deserializeBinary(bytes: Uint8Array): ListAccessTokensRequest {
+        return ListAccessTokensRequest.deserialize(bytes);
+    }
+}
+export class ListAccessTokensResponse extends pb_1.Message {
+    #one_of_decls: number[][] = [];
+    constructor(data?: any[] | {
+        access_tokens?: AccessToken[];
+    }) {
+        super();
+        pb_1.Message.initialize(this, Array.isArray(data) ? data : [], 0, -1, [1], this.#one_of_decls);
+        if (!Array.isArray(data) && typeof data == "object") {
+            if ("access_tokens" in data && data.access_tokens != undefined) {
+                this.access_tokens = data.access_tokens;
+            }
+        }
+    }
+    get access_tokens() {
+        return pb_1.Message.getRepeatedWrapperField(this, AccessToken, 1) as AccessToken[];
+    }
+    set access_tokens(value: AccessToken[]) {
+        pb_1.Message.setRepeatedWrapperField(this, 1, value);
+    }
+    static fromObject(data: {
+        access_tokens?: ReturnType<typeof AccessToken.prototype.toObject>[];
+    }): ListAccessTokensResponse {
+        const message = new ListAccessTokensResponse({});
+        if (data.access_tokens != null) {
+            message.access_tokens = data.access_tokens.map(item => AccessToken.fromObject(item));
+        }
+        return message;
+    }
+    toObject() {
+        const data: {
+            access_tokens?: ReturnType<typeof AccessToken.prototype.toObject>[];
+        } = {};
+        if (this.access_tokens != null) {
+            data.access_tokens = this.access_tokens.map((item: AccessToken) => item.toObject());
+        }
+        return data;
+    }
+    serialize(): Uint8Array;
+    serialize(w: pb_1.BinaryWriter): void;
+    serialize(w?: pb_1.BinaryWriter): Uint8Array | void {
+        const writer = w || new pb_1.BinaryWriter();
+        if (this.access_tokens.length)
+            writer.writeRepeatedMessage(1, this.access_tokens, (item: AccessToken) => item.serialize(writer));
+        if (!w)
+            return writer.getResultBuffer();
+    }
+    static deserialize(bytes: Uint8Array | pb_1.BinaryReader): ListAccessTokensResponse {
+        const reader = bytes instanceof pb_1.BinaryReader ? bytes : new pb_1.BinaryReader(bytes), message = new ListAccessTokensResponse();
+        while (reader.nextField()) {
+            if (reader.isEndGroup())
+                break;
+            switch (reader.getFieldNumber()) {
+                case 1:
+                    reader.readMessage(message.access_tokens, () => pb_1.Message.addToRepeatedWrapperField(message, 1, AccessToken.deserialize(reader), AccessToken));
+                    break;
+                default: reader.skipField();
+            }
+        }
+        return message;
+    }
+    serializeBinary(): Uint8Array {
+        return this.serialize();
+    }
+    static deserializeBinary(bytes: Uint8Array): ListAccessTokensResponse {
+        return ListAccessTokensResponse.deserialize(bytes);
+    }
+}
+export class RevokeAccessTokenRequest extends pb_1.Message {
+    #one_of_decls: number[][] = [];
+    constructor(data?: any[] | {
+        id?: string;
+    }) {
+        super();
+        pb_1.Message.initialize(this, Array.isArray(data) ? data : [], 0, -1, [], this.#one_of_decls);
+        if (!Array.isArray(data) && typeof data == "object") {
+            if ("id" in data && data.id != undefined) {
+                this.id = data.id;
+            }
+        }
+    }
+    get id() {
+        return pb_1.Message.getFieldWithDefault(this, 1, "") as string;
+    }
+    set id(value: string) {
+        pb_1.Message.setField(this, 1, value);
+    }
+    static fromObject(data: {
+        id?: string;
+    }): RevokeAccessTokenRequest {
+        const message = new RevokeAccessTokenRequest({});
+        if (data.id != null) {
+            message.id = data.id;
+        }
+        return message;
+    }
+    toObject() {
+        const data: {
+            id?: string;
+        } = {};
+        if (this.id != null) {
+            data.id = this.id;
+        }
+        return data;
+    }
+    serialize(): Uint8Array;
+    serialize(w: pb_1.BinaryWriter): void;
+    serialize(w?: pb_1.BinaryWriter): Uint8Array | void {
+        const writer = w || new pb_1.BinaryWriter();
+        if (this.id.length)
+            writer.writeString(1, this.id);
+        if (!w)
+            return writer.getResultBuffer();
+    }
+    static deserialize(bytes: Uint8Array | pb_1.BinaryReader): RevokeAccessTokenRequest {
+        const reader = bytes instanceof pb_1.BinaryReader ? bytes : new pb_1.BinaryReader(bytes), message = new RevokeAccessTokenRequest();
+        while (reader.nextField()) {
+            if (reader.isEndGroup())
+                break;
+            switch (reader.getFieldNumber()) {
+                case 1:
+                    message.id = reader.readString();
+                    break;
+                default: reader.skipField();
+            }
+        }
+        return message;
+    }
+    serializeBinary(): Uint8Array {
+        return this.serialize();
+    }
+    static deserializeBinary(bytes: Uint8Array): RevokeAccessTokenRequest {
+        return RevokeAccessTokenRequest.deserialize(bytes);
+    }
+}
+export class RevokeAccessTokenResponse extends pb_1.Message {
+    #one_of_decls: number[][] = [];
+    constructor(data?: any[] | {
+        message?: string;
+    }) {
+        super();
+        pb_1.Message.initialize(this, Array.isArray(data) ? data : [], 0, -1, [], this.#one_of_decls);
+        if (!Array.isArray(data) && typeof data == "object") {
+            if ("message" in data && data.message != undefined) {
+                this.message = data.message;
+            }
+        }
+    }
+    get message() {
+        return pb_1.Message.getFieldWithDefault(this, 1, "") as string;
+    }
+    set message(value: string) {
+        pb_1.Message.setField(this, 1, value);
+    }
+    static fromObject(data: {
+        message?: string;
+    }): RevokeAccessTokenResponse {
+        const message = new RevokeAccessTokenResponse({});
+        if (data.message != null) {
+            message.message = data.message;
+        }
+        return message;
+    }
+    toObject() {
+        const data: {
+            message?: string;
+        } = {};
+        if (this.message != null) {
+            data.message = this.message;
+        }
+        return data;
+    }
+    serialize(): Uint8Array;
+    serialize(w: pb_1.BinaryWriter): void;
+    serialize(w?: pb_1.BinaryWriter): Uint8Array | void {
+        const writer = w || new pb_1.BinaryWriter();
+        if (this.message.length)
+            writer.writeString(1, this.message);
+        if (!w)
+            return writer.getResultBuffer();
+    }
+    static deserialize(bytes: Uint8Array | pb_1.BinaryReader): RevokeAccessTokenResponse {
+        const reader = bytes instanceof pb_1.BinaryReader ? bytes : new pb_1.BinaryReader(bytes), message = new RevokeAccessTokenResponse();
+        while (reader.nextField()) {
+            if (reader.isEndGroup())
+                break;
+            switch (reader.getFieldNumber()) {
+                case 1:
+                    message.message = reader.readString();
+                    break;
+                default: reader.skipField();
+            }
+        }
+        return message;
+    }
+    serializeBinary(): Uint8Array {
+        return this.serialize();
+    }
+    static deserializeBinary(bytes: Uint8Array): RevokeAccessTokenResponse {
+        return RevokeAccessTokenResponse.deserialize(bytes);
+    }
+}
+export class Session extends pb_1.Message {
+    #one_of_decls: number[][] = [];
+    constructor(data?: any[] | {
+        id?: string;
+        user_agent?: string;
+        created_at?: dependency_1.Timestamp;
+        last_seen_at?: dependency_1.Timestamp;
+        expires_at?: dependency_1.Timestamp;
+    }) {
+        super();
+        pb_1.Message.initialize(this, Array.isArray(data) ? data : [], 0, -1, [], this.#one_of_decls);
+        if (!Array.isArray(data) && typeof data == "object") {
+            if ("id" in data && data.id != undefined) {
+                this.id = data.id;
+            }
+            if ("user_agent" in data && data.user_agent != undefined) {
+                this.user_agent = data.user_agent;
+            }
+            if ("created_at" in data && data.created_at != undefined) {
+                this.created_at = data.created_at;
+            }
+            if ("last_seen_at" in data && data.last_seen_at != undefined) {
+                this.last_seen_at = data.last_seen_at;
+            }
+            if ("expires_at" in data && data.expires_at != undefined) {
+                this.expires_at = data.expires_at;
+            }
+        }
+    }
+    get id() {
+        return pb_1.Message.getFieldWithDefault(this, 1, "") as string;
+    }
+    set id(value: string) {
+        pb_1.Message.setField(this, 1, value);
+    }
+    get user_agent() {
+        return pb_1.Message.getFieldWithDefault(this, 2, "") as string;
+    }
+    set user_agent(value: string) {
+        pb_1.Message.setField(this, 2, value);
+    }
+    get created_at() {
+        return pb_1.Message.getWrapperField(this, dependency_1.Timestamp, 3) as dependency_1.Timestamp;
+    }
+    set created_at(value: dependency_1.Timestamp) {
+        pb_1.Message.setWrapperField(this, 3, value);
+    }
+    get has_created_at() {
+        return pb_1.Message.getField(this, 3) != null;
+    }
+    get last_seen_at() {
+        return pb_1.Message.getWrapperField(this, dependency_1.Timestamp, 4) as dependency_1.Timestamp;
+    }
+    set last_seen_at(value: dependency_1.Timestamp) {
+        pb_1.Message.setWrapperField(this, 4, value);
+    }
+    get has_last_seen_at() {
+        return pb_1.Message.getField(this, 4) != null;
+    }
+    get expires_at() {
+        return pb_1.Message.getWrapperField(this, dependency_1.Timestamp, 5) as dependency_1.Timestamp;
+    }
+    set expires_at(value: dependency_1.Timestamp) {
+        pb_1.Message.setWrapperField(this, 5, value);
+    }
+    get has_expires_at() {
+        return pb_1.Message.getField(this, 5) != null;
+    }
+    static fromObject(data: {
+        id?: string;
+        user_agent?: string;
+        created_at?: ReturnType<typeof dependency_1.Timestamp.prototype.toObject>;
+        last_seen_at?: ReturnType<typeof dependency_1.Timestamp.prototype.toObject>;
+        expires_at?: ReturnType<typeof dependency_1.Timestamp.prototype.toObject>;
+    }): Session {
+        const message = new Session({});
+        if (data.id != null) {
+            message.id = data.id;
+        }
+        if (data.user_agent != null) {
+            message.user_agent = data.user_agent;
+        }
+        if (data.created_at != null) {
+            message.created_at = dependency_1.Timestamp.fromObject(data.created_at);
+        }
+        if (data.last_seen_at != null) {
+            message.last_seen_at = dependency_1.Timestamp.fromObject(data.last_seen_at);
+        }
+        if (data.expires_at != null) {
+            message.expires_at = dependency_1.Timestamp.fromObject(data.expires_at);
+        }
+        return message;
+    }
+    toObject() {
+        const data: {
+            id?: string;
+            user_agent?: string;
+            created_at?: ReturnType<typeof dependency_1.Timestamp.prototype.toObject>;
+            last_seen_at?: ReturnType<typeof dependency_1.Timestamp.prototype.toObject>;
+            expires_at?: ReturnType<typeof dependency_1.Timestamp.prototype.toObject>;
+        } = {};
+        if (this.id != null) {
+            data.id = this.id;
+        }
+        if (this.user_agent != null) {
+            data.user_agent = this.user_agent;
+        }
+        if (this.created_at != null) {
+            data.created_at = this.created_at.toObject();
+        }
+        if (this.last_seen_at != null) {
+            data.last_seen_at = this.last_seen_at.toObject();
+        }
+        if (this.expires_at != null) {
+            data.expires_at = this.expires_at.toObject();
+        }
+        return data;
+    }
+    serialize(): Uint8Array;
+    serialize(w: pb_1.BinaryWriter): void;
+    serialize(w?: pb_1.BinaryWriter): Uint8Array | void {
+        const writer = w || new pb_1.BinaryWriter();
+        if (this.id.length)
+            writer.writeString(1, this.id);
+        if (this.user_agent.length)
+            writer.writeString(2, this.user_agent);
+        if (this.has_created_at)
+            writer.writeMessage(3, this.created_at, () => this.created_at.serialize(writer));
+        if (this.has_last_seen_at)
+            writer.writeMessage(4, this.last_seen_at, () => this.last_seen_at.serialize(writer));
+        if (this.has_expires_at)
+            writer.writeMessage(5, this.expires_at, () => this.expires_at.serialize(writer));
+        if (!w)
+            return writer.getResultBuffer();
+    }
+    static deserialize(bytes: Uint8Array | pb_1.BinaryReader): Session {
+        const reader = bytes instanceof pb_1.BinaryReader ? bytes : new pb_1.BinaryReader(bytes), message = new Session();
+        while (reader.nextField()) {
+            if (reader.isEndGroup())
+                break;
+            switch (reader.getFieldNumber()) {
+                case 1:
+                    message.id = reader.readString();
+                    break;
+                case 2:
+                    message.user_agent = reader.readString();
+                    break;
+                case 3:
+                    reader.readMessage(message.created_at, () => message.created_at = dependency_1.Timestamp.deserialize(reader));
+                    break;
+                case 4:
+                    reader.readMessage(message.last_seen_at, () => message.last_seen_at = dependency_1.Timestamp.deserialize(reader));
+                    break;
+                case 5:
+                    reader.readMessage(message.expires_at, () => message.expires_at = dependency_1.Timestamp.deserialize(reader));
+                    break;
+                default: reader.skipField();
+            }
+        }
+        return message;
+    }
+    serializeBinary(): Uint8Array {
+        return this.serialize();
+    }
+    static deserializeBinary(bytes: Uint8Array): Session {
+        return Session.deserialize(bytes);
+    }
+}
+export class ListSessionsRequest extends pb_1.Message {
+    #one_of_decls: number[][] = [];
+    constructor(data?: any[] | {}) {
+        super();
+        pb_1.Message.initialize(this, Array.isArray(data) ? data : [], 0, -1, [], this.#one_of_decls);
+        if (!Array.isArray(data) && typeof data == "object") { }
+    }
+    static fromObject(data: {}): ListSessionsRequest {
+        const message = new ListSessionsRequest({});
+        return message;
+    }
+    toObject() {
+        const data: {} = {};
+        return data;
+    }
+    serialize(): Uint8Array;
+    serialize(w: pb_1.BinaryWriter): void;
+    serialize(w?: pb_1.BinaryWriter): Uint8Array | void {
+        const writer = w || new pb_1.BinaryWriter();
+        if (!w)
+            return writer.getResultBuffer();
+    }
+    static deserialize(bytes: Uint8Array | pb_1.BinaryReader): ListSessionsRequest {
+        const reader = bytes instanceof pb_1.BinaryReader ? bytes : new pb_1.BinaryReader(bytes), message = new ListSessionsRequest();
+        while (reader.nextField()) {
+            if (reader.isEndGroup())
+                break;
+            switch (reader.getFieldNumber()) {
+                default: reader.skipField();
+            }
+        }
+        return message;
+    }
+    serializeBinary(): Uint8Array {
+        return this.serialize();
+    }
+    static deserializeBinary(bytes: Uint8Array): ListSessionsRequest {
+        return ListSessionsRequest.deserialize(bytes);
+    }
+}
+export class ListSessionsResponse extends pb_1.Message {
+    #one_of_decls: number[][] = [];
+    constructor(data?: any[] | {
+        sessions?: Session[];
+    }) {
+        super();
+        pb_1.Message.initialize(this, Array.isArray(data) ? data : [], 0, -1, [1], this.#one_of_decls);
+        if (!Array.isArray(data) && typeof data == "object") {
+            if ("sessions" in data && data.sessions != undefined) {
+                this.sessions = data.sessions;
+            }
+        }
+    }
+    get sessions() {
+        return pb_1.Message.getRepeatedWrapperField(this, Session, 1) as Session[];
+    }
+    set sessions(value: Session[]) {
+        pb_1.Message.setRepeatedWrapperField(this, 1, value);
+    }
+    static fromObject(data: {
+        sessions?: ReturnType<typeof Session.prototype.toObject>[];
+    }): ListSessionsResponse {
+        const message = new ListSessionsResponse({});
+        if (data.sessions != null) {
+            message.sessions = data.sessions.map(item => Session.fromObject(item));
+        }
+        return message;
+    }
+    toObject() {
+        const data: {
+            sessions?: ReturnType<typeof Session.prototype.toObject>[];
+        } = {};
+        if (this.sessions != null) {
+            data.sessions = this.sessions.map((item: Session) => item.toObject());
+        }
+        return data;
+    }
+    serialize(): Uint8Array;
+    serialize(w: pb_1.BinaryWriter): void;
+    serialize(w?: pb_1.BinaryWriter): Uint8Array | void {
+        const writer = w || new pb_1.BinaryWriter();
+        if (this.sessions.length)
+            writer.writeRepeatedMessage(1, this.sessions, (item: Session) => item.serialize(writer));
+        if (!w)
+            return writer.getResultBuffer();
+    }
+    static deserialize(bytes: Uint8Array | pb_1.BinaryReader): ListSessionsResponse {
+        const reader = bytes instanceof pb_1.BinaryReader ? bytes : new pb_1.BinaryReader(bytes), message = new ListSessionsResponse();
+        while (reader.nextField()) {
+            if (reader.isEndGroup())
+                break;
+            switch (reader.getFieldNumber()) {
+                case 1:
+                    reader.readMessage(message.sessions, () => pb_1.Message.addToRepeatedWrapperField(message, 1, Session.deserialize(reader), Session));
+                    break;
+                default: reader.skipField();
+            }
+        }
+        return message;
+    }
+    serializeBinary(): Uint8Array {
+        return this.serialize();
+    }
+    static deserializeBinary(bytes: Uint8Array): ListSessionsResponse {
+        return ListSessionsResponse.deserialize(bytes);
+    }
+}
+export class RevokeSessionRequest extends pb_1.Message {
+    #one_of_decls: number[][] = [];
+    constructor(data?: any[] | {
+        id?: string;
+    }) {
+        super();
+        pb_1.Message.initialize(this, Array.isArray(data) ? data : [], 0, -1, [], this.#one_of_decls);
+        if (!Array.isArray(data) && typeof data == "object") {
+            if ("id" in data && data.id != undefined) {
+                this.id = data.id;
+            }
+        }
+    }
+    get id() {
+        return pb_1.Message.getFieldWithDefault(this, 1, "") as string;
+    }
+    set id(value: string) {
+        pb_1.Message.setField(this, 1, value);
+    }
+    static fromObject(data: {
+        id?: string;
+    }): RevokeSessionRequest {
+        const message = new RevokeSessionRequest({});
+        if (data.id != null) {
+            message.id = data.id;
+        }
+        return message;
+    }
+    toObject() {
+        const data: {
+            id?: string;
+        } = {};
+        if (this.id != null) {
+            data.id = this.id;
+        }
+        return data;
+    }
+    serialize(): Uint8Array;
+    serialize(w: pb_1.BinaryWriter): void;
+    serialize(w?: pb_1.BinaryWriter): Uint8Array | void {
+        const writer = w || new pb_1.BinaryWriter();
+        if (this.id.length)
+            writer.writeString(1, this.id);
+        if (!w)
+            return writer.getResultBuffer();
+    }
+    static deserialize(bytes: Uint8Array | pb_1.BinaryReader): RevokeSessionRequest {
+        const reader = bytes instanceof pb_1.BinaryReader ? bytes : new pb_1.BinaryReader(bytes), message = new RevokeSessionRequest();
+        while (reader.nextField()) {
+            if (reader.isEndGroup())
+                break;
+            switch (reader.getFieldNumber()) {
+                case 1:
+                    message.id = reader.readString();
+                    break;
+                default: reader.skipField();
+            }
+        }
+        return message;
+    }
+    serializeBinary(): Uint8Array {
+        return this.serialize();
+    }
+    static deserializeBinary(bytes: Uint8Array): RevokeSessionRequest {
+        return RevokeSessionRequest.deserialize(bytes);
+    }
+}
+export class RevokeSessionResponse extends pb_1.Message {
+    #one_of_decls: number[][] = [];
+    constructor(data?: any[] | {
+        message?: string;
+    }) {
+        super();
+        pb_1.Message.initialize(this, Array.isArray(data) ? data : [], 0, -1, [], this.#one_of_decls);
+        if (!Array.isArray(data) && typeof data == "object") {
+            if ("message" in data && data.message != undefined) {
+                this.message = data.message;
+            }
+        }
+    }
+    get message() {
+        return pb_1.Message.getFieldWithDefault(this, 1, "") as string;
+    }
+    set message(value: string) {
+        pb_1.Message.setField(this, 1, value);
+    }
+    static fromObject(data: {
+        message?: string;
+    }): RevokeSessionResponse {
+        const message = new RevokeSessionResponse({});
+        if (data.message != null) {
+            message.message = data.message;
+        }
+        return message;
+    }
+    toObject() {
+        const data: {
+            message?: string;
+        } = {};
+        if (this.message != null) {
+            data.message = this.message;
+        }
+        return data;
+    }
+    serialize(): Uint8Array;
+    serialize(w: pb_1.BinaryWriter): void;
+    serialize(w?: pb_1.BinaryWriter): Uint8Array | void {
+        const writer = w || new pb_1.BinaryWriter();
+        if (this.message.length)
+            writer.writeString(1, this.message);
+        if (!w)
+            return writer.getResultBuffer();
+    }
+    static deserialize(bytes: Uint8Array | pb_1.BinaryReader): RevokeSessionResponse {
+        const reader = bytes instanceof pb_1.BinaryReader ? bytes : new pb_1.BinaryReader(bytes), message = new RevokeSessionResponse();
+        while (reader.nextField()) {
+            if (reader.isEndGroup())
+                break;
+            switch (reader.getFieldNumber()) {
+                case 1:
+                    message.message = reader.readString();
+                    break;
+                default: reader.skipField();
+            }
+        }
+        return message;
+    }
+    serializeBinary(): Uint8Array {
+        return this.serialize();
+    }
+    static deserializeBinary(bytes: Uint8Array): RevokeSessionResponse {
+        return RevokeSessionResponse.deserialize(bytes);
+    }
+}
+export abstract class UnimplementedUserServiceService {
+    static definition = {
+        CreateUserIfNotExists: {
+            path: "/userservice.UserService/CreateUserIfNotExists",
+            requestStream: false,
+            responseStream: false,
+            requestSerialize: (message: CreateUserRequest) => Buffer.from(message.serialize()),
+            requestDeserialize: (bytes: Buffer) => CreateUserRequest.deserialize(new Uint8Array(bytes)),
+            responseSerialize: (message: CreateUserResponse) => Buffer.from(message.serialize()),
+            responseDeserialize: (bytes: Buffer) => CreateUserResponse.deserialize(new Uint8Array(bytes))
+        },
+        GetTenants: {
+            path: "/userservice.UserService/GetTenants",
+            requestStream: false,
+            responseStream: false,
+            requestSerialize: (message: GetTenantsRequest) => Buffer.from(message.serialize()),
+            requestDeserialize: (bytes: Buffer) => GetTenantsRequest.deserialize(new Uint8Array(bytes)),
+            responseSerialize: (message: GetTenantsResponse) => Buffer.from(message.serialize()),
+            responseDeserialize: (bytes: Buffer) => GetTenantsResponse.deserialize(new Uint8Array(bytes))
+        }
+    };
+    [method: string]: grpc_1.UntypedHandleCall;
+    abstract CreateUserIfNotExists(call: grpc_1.ServerUnaryCall<CreateUserRequest, CreateUserResponse>, callback: grpc_1.sendUnaryData<CreateUserResponse>): void;
+    abstract GetTenants(call: grpc_1.ServerUnaryCall<GetTenantsRequest, GetTenantsResponse>, callback: grpc_1.sendUnaryData<GetTenantsResponse>): void;
+}
 export class UserServiceClient {
     private _address: string;
     private _client: grpc_web_1.GrpcWebClientBase;
@@ -1399,12 +5886,142 @@ export abstract class UnimplementedTenantServiceService {
             requestDeserialize: (bytes: Buffer) => GetUsersRequest.deserialize(new Uint8Array(bytes)),
             responseSerialize: (message: GetUsersResponse) => Buffer.from(message.serialize()),
             responseDeserialize: (bytes: Buffer) => GetUsersResponse.deserialize(new Uint8Array(bytes))
+        },
+        LookupUsers: {
+            path: "/userservice.TenantService/LookupUsers",
+            requestStream: false,
+            responseStream: false,
+            requestSerialize: (message: LookupUsersRequest) => Buffer.from(message.serialize()),
+            requestDeserialize: (bytes: Buffer) => LookupUsersRequest.deserialize(new Uint8Array(bytes)),
+            responseSerialize: (message: LookupUsersResponse) => Buffer.from(message.serialize()),
+            responseDeserialize: (bytes: Buffer) => LookupUsersResponse.deserialize(new Uint8Array(bytes))
+        },
+        InviteUser: {
+            path: "/userservice.TenantService/InviteUser",
+            requestStream: false,
+            responseStream: false,
+            requestSerialize: (message: InviteUserRequest) => Buffer.from(message.serialize()),
+            requestDeserialize: (bytes: Buffer) => InviteUserRequest.deserialize(new Uint8Array(bytes)),
+            responseSerialize: (message: InviteUserResponse) => Buffer.from(message.serialize()),
+            responseDeserialize: (bytes: Buffer) => InviteUserResponse.deserialize(new Uint8Array(bytes))
+        },
+        ListInvitations: {
+            path: "/userservice.TenantService/ListInvitations",
+            requestStream: false,
+            responseStream: false,
+            requestSerialize: (message: ListInvitationsRequest) => Buffer.from(message.serialize()),
+            requestDeserialize: (bytes: Buffer) => ListInvitationsRequest.deserialize(new Uint8Array(bytes)),
+            responseSerialize: (message: ListInvitationsResponse) => Buffer.from(message.serialize()),
+            responseDeserialize: (bytes: Buffer) => ListInvitationsResponse.deserialize(new Uint8Array(bytes))
+        },
+        RevokeInvitation: {
+            path: "/userservice.TenantService/RevokeInvitation",
+            requestStream: false,
+            responseStream: false,
+            requestSerialize: (message: RevokeInvitationRequest) => Buffer.from(message.serialize()),
+            requestDeserialize: (bytes: Buffer) => RevokeInvitationRequest.deserialize(new Uint8Array(bytes)),
+            responseSerialize: (message: RevokeInvitationResponse) => Buffer.from(message.serialize()),
+            responseDeserialize: (bytes: Buffer) => RevokeInvitationResponse.deserialize(new Uint8Array(bytes))
+        },
+        AcceptInvitation: {
+            path: "/userservice.TenantService/AcceptInvitation",
+            requestStream: false,
+            responseStream: false,
+            requestSerialize: (message: AcceptInvitationRequest) => Buffer.from(message.serialize()),
+            requestDeserialize: (bytes: Buffer) => AcceptInvitationRequest.deserialize(new Uint8Array(bytes)),
+            responseSerialize: (message: AcceptInvitationResponse) => Buffer.from(message.serialize()),
+            responseDeserialize: (bytes: Buffer) => AcceptInvitationResponse.deserialize(new Uint8Array(bytes))
+        },
+        DeclineInvitation: {
+            path: "/userservice.TenantService/DeclineInvitation",
+            requestStream: false,
+            responseStream: false,
+            requestSerialize: (message: DeclineInvitationRequest) => Buffer.from(message.serialize()),
+            requestDeserialize: (bytes: Buffer) => DeclineInvitationRequest.deserialize(new Uint8Array(bytes)),
+            responseSerialize: (message: DeclineInvitationResponse) => Buffer.from(message.serialize()),
+            responseDeserialize: (bytes: Buffer) => DeclineInvitationResponse.deserialize(new Uint8Array(bytes))
+        },
+        UpdateUserRole: {
+            path: "/userservice.TenantService/UpdateUserRole",
+            requestStream: false,
+            responseStream: false,
+            requestSerialize: (message: UpdateUserRoleRequest) => Buffer.from(message.serialize()),
+            requestDeserialize: (bytes: Buffer) => UpdateUserRoleRequest.deserialize(new Uint8Array(bytes)),
+            responseSerialize: (message: UpdateUserRoleResponse) => Buffer.from(message.serialize()),
+            responseDeserialize: (bytes: Buffer) => UpdateUserRoleResponse.deserialize(new Uint8Array(bytes))
+        },
+        RemoveUser: {
+            path: "/userservice.TenantService/RemoveUser",
+            requestStream: false,
+            responseStream: false,
+            requestSerialize: (message: RemoveUserRequest) => Buffer.from(message.serialize()),
+            requestDeserialize: (bytes: Buffer) => RemoveUserRequest.deserialize(new Uint8Array(bytes)),
+            responseSerialize: (message: RemoveUserResponse) => Buffer.from(message.serialize()),
+            responseDeserialize: (bytes: Buffer) => RemoveUserResponse.deserialize(new Uint8Array(bytes))
+        },
+        LeaveTenant: {
+            path: "/userservice.TenantService/LeaveTenant",
+            requestStream: false,
+            responseStream: false,
+            requestSerialize: (message: LeaveTenantRequest) => Buffer.from(message.serialize()),
+            requestDeserialize: (bytes: Buffer) => LeaveTenantRequest.deserialize(new Uint8Array(bytes)),
+            responseSerialize: (message: LeaveTenantResponse) => Buffer.from(message.serialize()),
+            responseDeserialize: (bytes: Buffer) => LeaveTenantResponse.deserialize(new Uint8Array(bytes))
+        },
+        TransferOwnership: {
+            path: "/userservice.TenantService/TransferOwnership",
+            requestStream: false,
+            responseStream: false,
+            requestSerialize: (message: TransferOwnershipRequest) => Buffer.from(message.serialize()),
+            requestDeserialize: (bytes: Buffer) => TransferOwnershipRequest.deserialize(new Uint8Array(bytes)),
+            responseSerialize: (message: TransferOwnershipResponse) => Buffer.from(message.serialize()),
+            responseDeserialize: (bytes: Buffer) => TransferOwnershipResponse.deserialize(new Uint8Array(bytes))
+        },
+        UpdateTenant: {
+            path: "/userservice.TenantService/UpdateTenant",
+            requestStream: false,
+            responseStream: false,
+            requestSerialize: (message: UpdateTenantRequest) => Buffer.from(message.serialize()),
+            requestDeserialize: (bytes: Buffer) => UpdateTenantRequest.deserialize(new Uint8Array(bytes)),
+            responseSerialize: (message: UpdateTenantResponse) => Buffer.from(message.serialize()),
+            responseDeserialize: (bytes: Buffer) => UpdateTenantResponse.deserialize(new Uint8Array(bytes))
+        },
+        DeleteTenant: {
+            path: "/userservice.TenantService/DeleteTenant",
+            requestStream: false,
+            responseStream: false,
+            requestSerialize: (message: DeleteTenantRequest) => Buffer.from(message.serialize()),
+            requestDeserialize: (bytes: Buffer) => DeleteTenantRequest.deserialize(new Uint8Array(bytes)),
+            responseSerialize: (message: DeleteTenantResponse) => Buffer.from(message.serialize()),
+            responseDeserialize: (bytes: Buffer) => DeleteTenantResponse.deserialize(new Uint8Array(bytes))
+        },
+        RestoreTenant: {
+            path: "/userservice.TenantService/RestoreTenant",
+            requestStream: false,
+            responseStream: false,
+            requestSerialize: (message: RestoreTenantRequest) => Buffer.from(message.serialize()),
+            requestDeserialize: (bytes: Buffer) => RestoreTenantRequest.deserialize(new Uint8Array(bytes)),
+            responseSerialize: (message: RestoreTenantResponse) => Buffer.from(message.serialize()),
+            responseDeserialize: (bytes: Buffer) => RestoreTenantResponse.deserialize(new Uint8Array(bytes))
         }
     };
     [method: string]: grpc_1.UntypedHandleCall;
     abstract CreateTenant(call: grpc_1.ServerUnaryCall<CreateTenantRequest, CreateTenantResponse>, callback: grpc_1.sendUnaryData<CreateTenantResponse>): void;
     abstract AddUser(call: grpc_1.ServerUnaryCall<AddUserRequest, AddUserResponse>, callback: grpc_1.sendUnaryData<AddUserResponse>): void;
     abstract GetUsers(call: grpc_1.ServerUnaryCall<GetUsersRequest, GetUsersResponse>, callback: grpc_1.sendUnaryData<GetUsersResponse>): void;
+    abstract LookupUsers(call: grpc_1.ServerUnaryCall<LookupUsersRequest, LookupUsersResponse>, callback: grpc_1.sendUnaryData<LookupUsersResponse>): void;
+    abstract InviteUser(call: grpc_1.ServerUnaryCall<InviteUserRequest, InviteUserResponse>, callback: grpc_1.sendUnaryData<InviteUserResponse>): void;
+    abstract ListInvitations(call: grpc_1.ServerUnaryCall<ListInvitationsRequest, ListInvitationsResponse>, callback: grpc_1.sendUnaryData<ListInvitationsResponse>): void;
+    abstract RevokeInvitation(call: grpc_1.ServerUnaryCall<RevokeInvitationRequest, RevokeInvitationResponse>, callback: grpc_1.sendUnaryData<RevokeInvitationResponse>): void;
+    abstract AcceptInvitation(call: grpc_1.ServerUnaryCall<AcceptInvitationRequest, AcceptInvitationResponse>, callback: grpc_1.sendUnaryData<AcceptInvitationResponse>): void;
+    abstract DeclineInvitation(call: grpc_1.ServerUnaryCall<DeclineInvitationRequest, DeclineInvitationResponse>, callback: grpc_1.sendUnaryData<DeclineInvitationResponse>): void;
+    abstract UpdateUserRole(call: grpc_1.ServerUnaryCall<UpdateUserRoleRequest, UpdateUserRoleResponse>, callback: grpc_1.sendUnaryData<UpdateUserRoleResponse>): void;
+    abstract RemoveUser(call: grpc_1.ServerUnaryCall<RemoveUserRequest, RemoveUserResponse>, callback: grpc_1.sendUnaryData<RemoveUserResponse>): void;
+    abstract LeaveTenant(call: grpc_1.ServerUnaryCall<LeaveTenantRequest, LeaveTenantResponse>, callback: grpc_1.sendUnaryData<LeaveTenantResponse>): void;
+    abstract TransferOwnership(call: grpc_1.ServerUnaryCall<TransferOwnershipRequest, TransferOwnershipResponse>, callback: grpc_1.sendUnaryData<TransferOwnershipResponse>): void;
+    abstract UpdateTenant(call: grpc_1.ServerUnaryCall<UpdateTenantRequest, UpdateTenantResponse>, callback: grpc_1.sendUnaryData<UpdateTenantResponse>): void;
+    abstract DeleteTenant(call: grpc_1.ServerUnaryCall<DeleteTenantRequest, DeleteTenantResponse>, callback: grpc_1.sendUnaryData<DeleteTenantResponse>): void;
+    abstract RestoreTenant(call: grpc_1.ServerUnaryCall<RestoreTenantRequest, RestoreTenantResponse>, callback: grpc_1.sendUnaryData<RestoreTenantResponse>): void;
 }
 export class TenantServiceClient {
     private _address: string;
@@ -1428,4 +6045,274 @@ export class TenantServiceClient {
     GetUsers(message: GetUsersRequest, metadata: grpc_web_1.Metadata | null) {
         return this._client.thenableCall<GetUsersRequest, GetUsersResponse>(this._address + "/userservice.TenantService/GetUsers", message, metadata || {}, TenantServiceClient.GetUsers);
     }
+    private static LookupUsers = new grpc_web_1.MethodDescriptor<LookupUsersRequest, LookupUsersResponse>("/userservice.TenantService/LookupUsers", grpc_web_1.MethodType.UNARY, LookupUsersRequest, LookupUsersResponse, (message: LookupUsersRequest) => message.serialize(), LookupUsersResponse.deserialize);
+    LookupUsers(message: LookupUsersRequest, metadata: grpc_web_1.Metadata | null) {
+        return this._client.thenableCall<LookupUsersRequest, LookupUsersResponse>(this._address + "/userservice.TenantService/LookupUsers", message, metadata || {}, TenantServiceClient.LookupUsers);
+    }
+    private static InviteUser = new grpc_web_1.MethodDescriptor<InviteUserRequest, InviteUserResponse>("/userservice.TenantService/InviteUser", grpc_web_1.MethodType.UNARY, InviteUserRequest, InviteUserResponse, (message: InviteUserRequest) => message.serialize(), InviteUserResponse.deserialize);
+    InviteUser(message: InviteUserRequest, metadata: grpc_web_1.Metadata | null) {
+        return this._client.thenableCall<InviteUserRequest, InviteUserResponse>(this._address + "/userservice.TenantService/InviteUser", message, metadata || {}, TenantServiceClient.InviteUser);
+    }
+    private static ListInvitations = new grpc_web_1.MethodDescriptor<ListInvitationsRequest, ListInvitationsResponse>("/userservice.TenantService/ListInvitations", grpc_web_1.MethodType.UNARY, ListInvitationsRequest, ListInvitationsResponse, (message: ListInvitationsRequest) => message.serialize(), ListInvitationsResponse.deserialize);
+    ListInvitations(message: ListInvitationsRequest, metadata: grpc_web_1.Metadata | null) {
+        return this._client.thenableCall<ListInvitationsRequest, ListInvitationsResponse>(this._address + "/userservice.TenantService/ListInvitations", message, metadata || {}, TenantServiceClient.ListInvitations);
+    }
+    private static RevokeInvitation = new grpc_web_1.MethodDescriptor<RevokeInvitationRequest, RevokeInvitationResponse>("/userservice.TenantService/RevokeInvitation", grpc_web_1.MethodType.UNARY, RevokeInvitationRequest, RevokeInvitationResponse, (message: RevokeInvitationRequest) => message.serialize(), RevokeInvitationResponse.deserialize);
+    RevokeInvitation(message: RevokeInvitationRequest, metadata: grpc_web_1.Metadata | null) {
+        return this._client.thenableCall<RevokeInvitationRequest, RevokeInvitationResponse>(this._address + "/userservice.TenantService/RevokeInvitation", message, metadata || {}, TenantServiceClient.RevokeInvitation);
+    }
+    private static AcceptInvitation = new grpc_web_1.MethodDescriptor<AcceptInvitationRequest, AcceptInvitationResponse>("/userservice.TenantService/AcceptInvitation", grpc_web_1.MethodType.UNARY, AcceptInvitationRequest, AcceptInvitationResponse, (message: AcceptInvitationRequest) => message.serialize(), AcceptInvitationResponse.deserialize);
+    AcceptInvitation(message: AcceptInvitationRequest, metadata: grpc_web_1.Metadata | null) {
+        return this._client.thenableCall<AcceptInvitationRequest, AcceptInvitationResponse>(this._address + "/userservice.TenantService/AcceptInvitation", message, metadata || {}, TenantServiceClient.AcceptInvitation);
+    }
+    private static DeclineInvitation = new grpc_web_1.MethodDescriptor<DeclineInvitationRequest, DeclineInvitationResponse>("/userservice.TenantService/DeclineInvitation", grpc_web_1.MethodType.UNARY, DeclineInvitationRequest, DeclineInvitationResponse, (message: DeclineInvitationRequest) => message.serialize(), DeclineInvitationResponse.deserialize);
+    DeclineInvitation(message: DeclineInvitationRequest, metadata: grpc_web_1.Metadata | null) {
+        return this._client.thenableCall<DeclineInvitationRequest, DeclineInvitationResponse>(this._address + "/userservice.TenantService/DeclineInvitation", message, metadata || {}, TenantServiceClient.DeclineInvitation);
+    }
+    private static UpdateUserRole = new grpc_web_1.MethodDescriptor<UpdateUserRoleRequest, UpdateUserRoleResponse>("/userservice.TenantService/UpdateUserRole", grpc_web_1.MethodType.UNARY, UpdateUserRoleRequest, UpdateUserRoleResponse, (message: UpdateUserRoleRequest) => message.serialize(), UpdateUserRoleResponse.deserialize);
+    UpdateUserRole(message: UpdateUserRoleRequest, metadata: grpc_web_1.Metadata | null) {
+        return this._client.thenableCall<UpdateUserRoleRequest, UpdateUserRoleResponse>(this._address + "/userservice.TenantService/UpdateUserRole", message, metadata || {}, TenantServiceClient.UpdateUserRole);
+    }
+    private static RemoveUser = new grpc_web_1.MethodDescriptor<RemoveUserRequest, RemoveUserResponse>("/userservice.TenantService/RemoveUser", grpc_web_1.MethodType.UNARY, RemoveUserRequest, RemoveUserResponse, (message: RemoveUserRequest) => message.serialize(), RemoveUserResponse.deserialize);
+    RemoveUser(message: RemoveUserRequest, metadata: grpc_web_1.Metadata | null) {
+        return this._client.thenableCall<RemoveUserRequest, RemoveUserResponse>(this._address + "/userservice.TenantService/RemoveUser", message, metadata || {}, TenantServiceClient.RemoveUser);
+    }
+    private static LeaveTenant = new grpc_web_1.MethodDescriptor<LeaveTenantRequest, LeaveTenantResponse>("/userservice.TenantService/LeaveTenant", grpc_web_1.MethodType.UNARY, LeaveTenantRequest, LeaveTenantResponse, (message: LeaveTenantRequest) => message.serialize(), LeaveTenantResponse.deserialize);
+    LeaveTenant(message: LeaveTenantRequest, metadata: grpc_web_1.Metadata | null) {
+        return this._client.thenableCall<LeaveTenantRequest, LeaveTenantResponse>(this._address + "/userservice.TenantService/LeaveTenant", message, metadata || {}, TenantServiceClient.LeaveTenant);
+    }
+    private static TransferOwnership = new grpc_web_1.MethodDescriptor<TransferOwnershipRequest, TransferOwnershipResponse>("/userservice.TenantService/TransferOwnership", grpc_web_1.MethodType.UNARY, TransferOwnershipRequest, TransferOwnershipResponse, (message: TransferOwnershipRequest) => message.serialize(), TransferOwnershipResponse.deserialize);
+    TransferOwnership(message: TransferOwnershipRequest, metadata: grpc_web_1.Metadata | null) {
+        return this._client.thenableCall<TransferOwnershipRequest, TransferOwnershipResponse>(this._address + "/userservice.TenantService/TransferOwnership", message, metadata || {}, TenantServiceClient.TransferOwnership);
+    }
+    private static UpdateTenant = new grpc_web_1.MethodDescriptor<UpdateTenantRequest, UpdateTenantResponse>("/userservice.TenantService/UpdateTenant", grpc_web_1.MethodType.UNARY, UpdateTenantRequest, UpdateTenantResponse, (message: UpdateTenantRequest) => message.serialize(), UpdateTenantResponse.deserialize);
+    UpdateTenant(message: UpdateTenantRequest, metadata: grpc_web_1.Metadata | null) {
+        return this._client.thenableCall<UpdateTenantRequest, UpdateTenantResponse>(this._address + "/userservice.TenantService/UpdateTenant", message, metadata || {}, TenantServiceClient.UpdateTenant);
+    }
+    private static DeleteTenant = new grpc_web_1.MethodDescriptor<DeleteTenantRequest, DeleteTenantResponse>("/userservice.TenantService/DeleteTenant", grpc_web_1.MethodType.UNARY, DeleteTenantRequest, DeleteTenantResponse, (message: DeleteTenantRequest) => message.serialize(), DeleteTenantResponse.deserialize);
+    DeleteTenant(message: DeleteTenantRequest, metadata: grpc_web_1.Metadata | null) {
+        return this._client.thenableCall<DeleteTenantRequest, DeleteTenantResponse>(this._address + "/userservice.TenantService/DeleteTenant", message, metadata || {}, TenantServiceClient.DeleteTenant);
+    }
+    private static RestoreTenant = new grpc_web_1.MethodDescriptor<RestoreTenantRequest, RestoreTenantResponse>("/userservice.TenantService/RestoreTenant", grpc_web_1.MethodType.UNARY, RestoreTenantRequest, RestoreTenantResponse, (message: RestoreTenantRequest) => message.serialize(), RestoreTenantResponse.deserialize);
+    RestoreTenant(message: RestoreTenantRequest, metadata: grpc_web_1.Metadata | null) {
+        return this._client.thenableCall<RestoreTenantRequest, RestoreTenantResponse>(this._address + "/userservice.TenantService/RestoreTenant", message, metadata || {}, TenantServiceClient.RestoreTenant);
+    }
+}
+export abstract class UnimplementedLocalAuthServiceService {
+    static definition = {
+        Signup: {
+            path: "/userservice.LocalAuthService/Signup",
+            requestStream: false,
+            responseStream: false,
+            requestSerialize: (message: SignupRequest) => Buffer.from(message.serialize()),
+            requestDeserialize: (bytes: Buffer) => SignupRequest.deserialize(new Uint8Array(bytes)),
+            responseSerialize: (message: LoginResponse) => Buffer.from(message.serialize()),
+            responseDeserialize: (bytes: Buffer) => LoginResponse.deserialize(new Uint8Array(bytes))
+        },
+        Login: {
+            path: "/userservice.LocalAuthService/Login",
+            requestStream: false,
+            responseStream: false,
+            requestSerialize: (message: LoginRequest) => Buffer.from(message.serialize()),
+            requestDeserialize: (bytes: Buffer) => LoginRequest.deserialize(new Uint8Array(bytes)),
+            responseSerialize: (message: LoginResponse) => Buffer.from(message.serialize()),
+            responseDeserialize: (bytes: Buffer) => LoginResponse.deserialize(new Uint8Array(bytes))
+        },
+        ChangePassword: {
+            path: "/userservice.LocalAuthService/ChangePassword",
+            requestStream: false,
+            responseStream: false,
+            requestSerialize: (message: ChangePasswordRequest) => Buffer.from(message.serialize()),
+            requestDeserialize: (bytes: Buffer) => ChangePasswordRequest.deserialize(new Uint8Array(bytes)),
+            responseSerialize: (message: ChangePasswordResponse) => Buffer.from(message.serialize()),
+            responseDeserialize: (bytes: Buffer) => ChangePasswordResponse.deserialize(new Uint8Array(bytes))
+        },
+        ResetPassword: {
+            path: "/userservice.LocalAuthService/ResetPassword",
+            requestStream: false,
+            responseStream: false,
+            requestSerialize: (message: ResetPasswordRequest) => Buffer.from(message.serialize()),
+            requestDeserialize: (bytes: Buffer) => ResetPasswordRequest.deserialize(new Uint8Array(bytes)),
+            responseSerialize: (message: ResetPasswordResponse) => Buffer.from(message.serialize()),
+            responseDeserialize: (bytes: Buffer) => ResetPasswordResponse.deserialize(new Uint8Array(bytes))
+        },
+        CompletePasswordReset: {
+            path: "/userservice.LocalAuthService/CompletePasswordReset",
+            requestStream: false,
+            responseStream: false,
+            requestSerialize: (message: CompletePasswordResetRequest) => Buffer.from(message.serialize()),
+            requestDeserialize: (bytes: Buffer) => CompletePasswordResetRequest.deserialize(new Uint8Array(bytes)),
+            responseSerialize: (message: LoginResponse) => Buffer.from(message.serialize()),
+            responseDeserialize: (bytes: Buffer) => LoginResponse.deserialize(new Uint8Array(bytes))
+        }
+    };
+    [method: string]: grpc_1.UntypedHandleCall;
+    abstract Signup(call: grpc_1.ServerUnaryCall<SignupRequest, LoginResponse>, callback: grpc_1.sendUnaryData<LoginResponse>): void;
+    abstract Login(call: grpc_1.ServerUnaryCall<LoginRequest, LoginResponse>, callback: grpc_1.sendUnaryData<LoginResponse>): void;
+    abstract ChangePassword(call: grpc_1.ServerUnaryCall<ChangePasswordRequest, ChangePasswordResponse>, callback: grpc_1.sendUnaryData<ChangePasswordResponse>): void;
+    abstract ResetPassword(call: grpc_1.ServerUnaryCall<ResetPasswordRequest, ResetPasswordResponse>, callback: grpc_1.sendUnaryData<ResetPasswordResponse>): void;
+    abstract CompletePasswordReset(call: grpc_1.ServerUnaryCall<CompletePasswordResetRequest, LoginResponse>, callback: grpc_1.sendUnaryData<LoginResponse>): void;
+}
+export class LocalAuthServiceClient {
+    private _address: string;
+    private _client: grpc_web_1.GrpcWebClientBase;
+    constructor(address: string, credentials?: Object, options?: grpc_web_1.GrpcWebClientBaseOptions) {
+        if (!options)
+            options = {};
+        options.format = options.format || "text";
+        this._address = address;
+        this._client = new grpc_web_1.GrpcWebClientBase(options);
+    }
+    private static Signup = new grpc_web_1.MethodDescriptor<SignupRequest, LoginResponse>("/userservice.LocalAuthService/Signup", grpc_web_1.MethodType.UNARY, SignupRequest, LoginResponse, (message: SignupRequest) => message.serialize(), LoginResponse.deserialize);
+    Signup(message: SignupRequest, metadata: grpc_web_1.Metadata | null) {
+        return this._client.thenableCall<SignupRequest, LoginResponse>(this._address + "/userservice.LocalAuthService/Signup", message, metadata || {}, LocalAuthServiceClient.Signup);
+    }
+    private static Login = new grpc_web_1.MethodDescriptor<LoginRequest, LoginResponse>("/userservice.LocalAuthService/Login", grpc_web_1.MethodType.UNARY, LoginRequest, LoginResponse, (message: LoginRequest) => message.serialize(), LoginResponse.deserialize);
+    Login(message: LoginRequest, metadata: grpc_web_1.Metadata | null) {
+        return this._client.thenableCall<LoginRequest, LoginResponse>(this._address + "/userservice.LocalAuthService/Login", message, metadata || {}, LocalAuthServiceClient.Login);
+    }
+    private static ChangePassword = new grpc_web_1.MethodDescriptor<ChangePasswordRequest, ChangePasswordResponse>("/userservice.LocalAuthService/ChangePassword", grpc_web_1.MethodType.UNARY, ChangePasswordRequest, ChangePasswordResponse, (message: ChangePasswordRequest) => message.serialize(), ChangePasswordResponse.deserialize);
+    ChangePassword(message: ChangePasswordRequest, metadata: grpc_web_1.Metadata | null) {
+        return this._client.thenableCall<ChangePasswordRequest, ChangePasswordResponse>(this._address + "/userservice.LocalAuthService/ChangePassword", message, metadata || {}, LocalAuthServiceClient.ChangePassword);
+    }
+    private static ResetPassword = new grpc_web_1.MethodDescriptor<ResetPasswordRequest, ResetPasswordResponse>("/userservice.LocalAuthService/ResetPassword", grpc_web_1.MethodType.UNARY, ResetPasswordRequest, ResetPasswordResponse, (message: ResetPasswordRequest) => message.serialize(), ResetPasswordResponse.deserialize);
+    ResetPassword(message: ResetPasswordRequest, metadata: grpc_web_1.Metadata | null) {
+        return this._client.thenableCall<ResetPasswordRequest, ResetPasswordResponse>(this._address + "/userservice.LocalAuthService/ResetPassword", message, metadata || {}, LocalAuthServiceClient.ResetPassword);
+    }
+    private static CompletePasswordReset = new grpc_web_1.MethodDescriptor<CompletePasswordResetRequest, LoginResponse>("/userservice.LocalAuthService/CompletePasswordReset", grpc_web_1.MethodType.UNARY, CompletePasswordResetRequest, LoginResponse, (message: CompletePasswordResetRequest) => message.serialize(), LoginResponse.deserialize);
+    CompletePasswordReset(message: CompletePasswordResetRequest, metadata: grpc_web_1.Metadata | null) {
+        return this._client.thenableCall<CompletePasswordResetRequest, LoginResponse>(this._address + "/userservice.LocalAuthService/CompletePasswordReset", message, metadata || {}, LocalAuthServiceClient.CompletePasswordReset);
+    }
+}
+export abstract class UnimplementedAccessTokenServiceService {
+    static definition = {
+        CreateAccessToken: {
+            path: "/userservice.AccessTokenService/CreateAccessToken",
+            requestStream: false,
+            responseStream: false,
+            requestSerialize: (message: CreateAccessTokenRequest) => Buffer.from(message.serialize()),
+            requestDeserialize: (bytes: Buffer) => CreateAccessTokenRequest.deserialize(new Uint8Array(bytes)),
+            responseSerialize: (message: CreateAccessTokenResponse) => Buffer.from(message.serialize()),
+            responseDeserialize: (bytes: Buffer) => CreateAccessTokenResponse.deserialize(new Uint8Array(bytes))
+        },
+        ListAccessTokens: {
+            path: "/userservice.AccessTokenService/ListAccessTokens",
+            requestStream: false,
+            responseStream: false,
+            requestSerialize: (message: ListAccessTokensRequest) => Buffer.from(message.serialize()),
+            requestDeserialize: (bytes: Buffer) => ListAccessTokensRequest.deserialize(new Uint8Array(bytes)),
+            responseSerialize: (message: ListAccessTokensResponse) => Buffer.from(message.serialize()),
+            responseDeserialize: (bytes: Buffer) => ListAccessTokensResponse.deserialize(new Uint8Array(bytes))
+        },
+        RevokeAccessToken: {
+            path: "/userservice.AccessTokenService/RevokeAccessToken",
+            requestStream: false,
+            responseStream: false,
+            requestSerialize: (message: RevokeAccessTokenRequest) => Buffer.from(message.serialize()),
+            requestDeserialize: (bytes: Buffer) => RevokeAccessTokenRequest.deserialize(new Uint8Array(bytes)),
+            responseSerialize: (message: RevokeAccessTokenResponse) => Buffer.from(message.serialize()),
+            responseDeserialize: (bytes: Buffer) => RevokeAccessTokenResponse.deserialize(new Uint8Array(bytes))
+        }
+    };
+    [method: string]: grpc_1.UntypedHandleCall;
+    abstract CreateAccessToken(call: grpc_1.ServerUnaryCall<CreateAccessTokenRequest, CreateAccessTokenResponse>, callback: grpc_1.sendUnaryData<CreateAccessTokenResponse>): void;
+    abstract ListAccessTokens(call: grpc_1.ServerUnaryCall<ListAccessTokensRequest, ListAccessTokensResponse>, callback: grpc_1.sendUnaryData<ListAccessTokensResponse>): void;
+    abstract RevokeAccessToken(call: grpc_1.ServerUnaryCall<RevokeAccessTokenRequest, RevokeAccessTokenResponse>, callback: grpc_1.sendUnaryData<RevokeAccessTokenResponse>): void;
+}
+export class AccessTokenServiceClient {
+    private _address: string;
+    private _client: grpc_web_1.GrpcWebClientBase;
+    constructor(address: string, credentials?: Object, options?: grpc_web_1.GrpcWebClientBaseOptions) {
+        if (!options)
+            options = {};
+        options.format = options.format || "text";
+        this._address = address;
+        this._client = new grpc_web_1.GrpcWebClientBase(options);
+    }
+    private static CreateAccessToken = new grpc_web_1.MethodDescriptor<CreateAccessTokenRequest, CreateAccessTokenResponse>("/userservice.AccessTokenService/CreateAccessToken", grpc_web_1.MethodType.UNARY, CreateAccessTokenRequest, CreateAccessTokenResponse, (message: CreateAccessTokenRequest) => message.serialize(), CreateAccessTokenResponse.deserialize);
+    CreateAccessToken(message: CreateAccessTokenRequest, metadata: grpc_web_1.Metadata | null) {
+        return this._client.thenableCall<CreateAccessTokenRequest, CreateAccessTokenResponse>(this._address + "/userservice.AccessTokenService/CreateAccessToken", message, metadata || {}, AccessTokenServiceClient.CreateAccessToken);
+    }
+    private static ListAccessTokens = new grpc_web_1.MethodDescriptor<ListAccessTokensRequest, ListAccessTokensResponse>("/userservice.AccessTokenService/ListAccessTokens", grpc_web_1.MethodType.UNARY, ListAccessTokensRequest, ListAccessTokensResponse, (message: ListAccessTokensRequest) => message.serialize(), ListAccessTokensResponse.deserialize);
+    ListAccessTokens(message: ListAccessTokensRequest, metadata: grpc_web_1.Metadata | null) {
+        return this._client.thenableCall<ListAccessTokensRequest, ListAccessTokensResponse>(this._address + "/userservice.AccessTokenService/ListAccessTokens", message, metadata || {}, AccessTokenServiceClient.ListAccessTokens);
+    }
+    private static RevokeAccessToken = new grpc_web_1.MethodDescriptor<RevokeAccessTokenRequest, RevokeAccessTokenResponse>("/userservice.AccessTokenService/RevokeAccessToken", grpc_web_1.MethodType.UNARY, RevokeAccessTokenRequest, RevokeAccessTokenResponse, (message: RevokeAccessTokenRequest) => message.serialize(), RevokeAccessTokenResponse.deserialize);
+    RevokeAccessToken(message: RevokeAccessTokenRequest, metadata: grpc_web_1.Metadata | null) {
+        return this._client.thenableCall<RevokeAccessTokenRequest, RevokeAccessTokenResponse>(this._address + "/userservice.AccessTokenService/RevokeAccessToken", message, metadata || {}, AccessTokenServiceClient.RevokeAccessToken);
+    }
+}
+export abstract class UnimplementedSessionServiceService {
+    static definition = {
+        ListSessions: {
+            path: "/userservice.SessionService/ListSessions",
+            requestStream: false,
+            responseStream: false,
+            requestSerialize: (message: ListSessionsRequest) => Buffer.from(message.serialize()),
+            requestDeserialize: (bytes: Buffer) => ListSessionsRequest.deserialize(new Uint8Array(bytes)),
+            responseSerialize: (message: ListSessionsResponse) => Buffer.from(message.serialize()),
+            responseDeserialize: (bytes: Buffer) => ListSessionsResponse.deserialize(new Uint8Array(bytes))
+        },
+        RevokeSession: {
+            path: "/userservice.SessionService/RevokeSession",
+            requestStream: false,
+            responseStream: false,
+            requestSerialize: (message: RevokeSessionRequest) => Buffer.from(message.serialize()),
+            requestDeserialize: (bytes: Buffer) => RevokeSessionRequest.deserialize(new Uint8Array(bytes)),
+            responseSerialize: (message: RevokeSessionResponse) => Buffer.from(message.serialize()),
+            responseDeserialize: (bytes: Buffer) => RevokeSessionResponse.deserialize(new Uint8Array(bytes))
+        }
+    };
+    [method: string]: grpc_1.UntypedHandleCall;
+    abstract ListSessions(call: grpc_1.ServerUnaryCall<ListSessionsRequest, ListSessionsResponse>, callback: grpc_1.sendUnaryData<ListSessionsResponse>): void;
+    abstract RevokeSession(call: grpc_1.ServerUnaryCall<RevokeSessionRequest, RevokeSessionResponse>, callback: grpc_1.sendUnaryData<RevokeSessionResponse>): void;
+}
+export class SessionServiceClient {
+    private _address: string;
+    private _client: grpc_web_1.GrpcWebClientBase;
+    constructor(address: string, credentials?: Object, options?: grpc_web_1.GrpcWebClientBaseOptions) {
+        if (!options)
+            options = {};
+        options.format = options.format || "text";
+        this._address = address;
+        this._client = new grpc_web_1.GrpcWebClientBase(options);
+    }
+    private static ListSessions = new grpc_web_1.MethodDescriptor<ListSessionsRequest, ListSessionsResponse>("/userservice.SessionService/ListSessions", grpc_web_1.MethodType.UNARY, ListSessionsRequest, ListSessionsResponse, (message: ListSessionsRequest) => message.serialize(), ListSessionsResponse.deserialize);
+    ListSessions(message: ListSessionsRequest, metadata: grpc_web_1.Metadata | null) {
+        return this._client.thenableCall<ListSessionsRequest, ListSessionsResponse>(this._address + "/userservice.SessionService/ListSessions", message, metadata || {}, SessionServiceClient.ListSessions);
+    }
+    private static RevokeSession = new grpc_web_1.MethodDescriptor<RevokeSessionRequest, RevokeSessionResponse>("/userservice.SessionService/RevokeSession", grpc_web_1.MethodType.UNARY, RevokeSessionRequest, RevokeSessionResponse, (message: RevokeSessionRequest) => message.serialize(), RevokeSessionResponse.deserialize);
+    RevokeSession(message: RevokeSessionRequest, metadata: grpc_web_1.Metadata | null) {
+        return this._client.thenableCall<RevokeSessionRequest, RevokeSessionResponse>(this._address + "/userservice.SessionService/RevokeSession", message, metadata || {}, SessionServiceClient.RevokeSession);
+    }
+}
+export abstract class UnimplementedUserDirectoryServiceService {
+    static definition = {
+        GetUsersByIDs: {
+            path: "/userservice.UserDirectoryService/GetUsersByIDs",
+            requestStream: false,
+            responseStream: false,
+            requestSerialize: (message: GetUsersByIDsRequest) => Buffer.from(message.serialize()),
+            requestDeserialize: (bytes: Buffer) => GetUsersByIDsRequest.deserialize(new Uint8Array(bytes)),
+            responseSerialize: (message: GetUsersByIDsResponse) => Buffer.from(message.serialize()),
+            responseDeserialize: (bytes: Buffer) => GetUsersByIDsResponse.deserialize(new Uint8Array(bytes))
+        }
+    };
+    [method: string]: grpc_1.UntypedHandleCall;
+    abstract GetUsersByIDs(call: grpc_1.ServerUnaryCall<GetUsersByIDsRequest, GetUsersByIDsResponse>, callback: grpc_1.sendUnaryData<GetUsersByIDsResponse>): void;
+}
+export class UserDirectoryServiceClient {
+    private _address: string;
+    private _client: grpc_web_1.GrpcWebClientBase;
+    constructor(address: string, credentials?: Object, options?: grpc_web_1.GrpcWebClientBaseOptions) {
+        if (!options)
+            options = {};
+        options.format = options.format || "text";
+        this._address = address;
+        this._client = new grpc_web_1.GrpcWebClientBase(options);
+    }
+    private static GetUsersByIDs = new grpc_web_1.MethodDescriptor<GetUsersByIDsRequest, GetUsersByIDsResponse>("/userservice.UserDirectoryService/GetUsersByIDs", grpc_web_1.MethodType.UNARY, GetUsersByIDsRequest, GetUsersByIDsResponse, (message: GetUsersByIDsRequest) => message.serialize(), GetUsersByIDsResponse.deserialize);
+    GetUsersByIDs(message: GetUsersByIDsRequest, metadata: grpc_web_1.Metadata | null) {
+        return this._client.thenableCall<GetUsersByIDsRequest, GetUsersByIDsResponse>(this._address + "/userservice.UserDirectoryService/GetUsersByIDs", message, metadata || {}, UserDirectoryServiceClient.GetUsersByIDs);
+    }
 }
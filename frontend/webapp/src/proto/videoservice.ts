@@ -20,6 +20,31 @@ export enum Visibility {
     VISIBILITY_SHARED = 1,
     VISIBILITY_PUBLIC = 2
 }
+export enum VideoSortField {
+    VIDEO_SORT_CREATED_AT = 0,
+    VIDEO_SORT_TITLE = 1,
+    VIDEO_SORT_DURATION = 2
+}
+export enum SortDirection {
+    SORT_DIRECTION_DEFAULT = 0,
+    SORT_DIRECTION_ASC = 1,
+    SORT_DIRECTION_DESC = 2
+}
+export enum ChannelPrivacy {
+    CHANNEL_PRIVACY_CLOSED = 0,
+    CHANNEL_PRIVACY_OPEN = 1
+}
+export enum ChannelAccessRequestStatus {
+    CHANNEL_ACCESS_REQUEST_STATUS_PENDING = 0,
+    CHANNEL_ACCESS_REQUEST_STATUS_APPROVED = 1,
+    CHANNEL_ACCESS_REQUEST_STATUS_DENIED = 2
+}
+export enum ChannelVideoDisposition {
+    CHANNEL_VIDEO_DISPOSITION_UNSPECIFIED = 0,
+    CHANNEL_VIDEO_DISPOSITION_MOVE_TO_CHANNEL = 1,
+    CHANNEL_VIDEO_DISPOSITION_RETURN_TO_UPLOADERS = 2,
+    CHANNEL_VIDEO_DISPOSITION_TRASH = 3
+}
 export class Video extends pb_1.Message {
     #one_of_decls: number[][] = [];
     constructor(data?: any[] | {
@@ -33,6 +58,10 @@ export class Video extends pb_1.Message {
         visibility?: Visibility;
         created_at?: dependency_1.Timestamp;
         channel_id?: string;
+        duration_seconds?: number;
+        watch_progress?: WatchProgress;
+        playback_url?: string;
+        playback_url_expires_at?: dependency_1.Timestamp;
     }) {
         super();
         pb_1.Message.initialize(this, Array.isArray(data) ? data : [], 0, -1, [], this.#one_of_decls);
@@ -67,6 +96,18 @@ export class Video extends pb_1.Message {
             if ("channel_id" in data && data.channel_id != undefined) {
                 this.channel_id = data.channel_id;
             }
+            if ("duration_seconds" in data && data.duration_seconds != undefined) {
+                this.duration_seconds = data.duration_seconds;
+            }
+            if ("watch_progress" in data && data.watch_progress != undefined) {
+                this.watch_progress = data.watch_progress;
+            }
+            if ("playback_url" in data && data.playback_url != undefined) {
+                this.playback_url = data.playback_url;
+            }
+            if ("playback_url_expires_at" in data && data.playback_url_expires_at != undefined) {
+                this.playback_url_expires_at = data.playback_url_expires_at;
+            }
         }
     }
     get id() {
@@ -132,6 +173,36 @@ export class Video extends pb_1.Message {
     set channel_id(value: string) {
         pb_1.Message.setField(this, 10, value);
     }
+    get duration_seconds() {
+        return pb_1.Message.getFieldWithDefault(this, 11, 0) as number;
+    }
+    set duration_seconds(value: number) {
+        pb_1.Message.setField(this, 11, value);
+    }
+    get watch_progress() {
+        return pb_1.Message.getWrapperField(this, WatchProgress, 12) as WatchProgress;
+    }
+    set watch_progress(value: WatchProgress) {
+        pb_1.Message.setWrapperField(this, 12, value);
+    }
+    get has_watch_progress() {
+        return pb_1.Message.getField(this, 12) != null;
+    }
+    get playback_url() {
+        return pb_1.Message.getFieldWithDefault(this, 13, "") as string;
+    }
+    set playback_url(value: string) {
+        pb_1.Message.setField(this, 13, value);
+    }
+    get playback_url_expires_at() {
+        return pb_1.Message.getWrapperField(this, dependency_1.Timestamp, 14) as dependency_1.Timestamp;
+    }
+    set playback_url_expires_at(value: dependency_1.Timestamp) {
+        pb_1.Message.setWrapperField(this, 14, value);
+    }
+    get has_playback_url_expires_at() {
+        return pb_1.Message.getField(this, 14) != null;
+    }
     static fromObject(data: {
         id?: string;
         title?: string;
@@ -143,6 +214,10 @@ export class Video extends pb_1.Message {
         visibility?: Visibility;
         created_at?: ReturnType<typeof dependency_1.Timestamp.prototype.toObject>;
         channel_id?: string;
+        duration_seconds?: number;
+        watch_progress?: ReturnType<typeof WatchProgress.prototype.toObject>;
+        playback_url?: string;
+        playback_url_expires_at?: ReturnType<typeof dependency_1.Timestamp.prototype.toObject>;
     }): Video {
         const message = new Video({});
         if (data.id != null) {
@@ -175,6 +250,18 @@ export class Video extends pb_1.Message {
         if (data.channel_id != null) {
             message.channel_id = data.channel_id;
         }
+        if (data.duration_seconds != null) {
+            message.duration_seconds = data.duration_seconds;
+        }
+        if (data.watch_progress != null) {
+            message.watch_progress = WatchProgress.fromObject(data.watch_progress);
+        }
+        if (data.playback_url != null) {
+            message.playback_url = data.playback_url;
+        }
+        if (data.playback_url_expires_at != null) {
+            message.playback_url_expires_at = dependency_1.Timestamp.fromObject(data.playback_url_expires_at);
+        }
         return message;
     }
     toObject() {
@@ -189,6 +276,10 @@ export class Video extends pb_1.Message {
             visibility?: Visibility;
             created_at?: ReturnType<typeof dependency_1.Timestamp.prototype.toObject>;
             channel_id?: string;
+            duration_seconds?: number;
+            watch_progress?: ReturnType<typeof WatchProgress.prototype.toObject>;
+            playback_url?: string;
+            playback_url_expires_at?: ReturnType<typeof dependency_1.Timestamp.prototype.toObject>;
         } = {};
         if (this.id != null) {
             data.id = this.id;
@@ -220,6 +311,18 @@ export class Video extends pb_1.Message {
         if (this.channel_id != null) {
             data.channel_id = this.channel_id;
         }
+        if (this.duration_seconds != null) {
+            data.duration_seconds = this.duration_seconds;
+        }
+        if (this.watch_progress != null) {
+            data.watch_progress = this.watch_progress.toObject();
+        }
+        if (this.playback_url != null) {
+            data.playback_url = this.playback_url;
+        }
+        if (this.playback_url_expires_at != null) {
+            data.playback_url_expires_at = this.playback_url_expires_at.toObject();
+        }
         return data;
     }
     serialize(): Uint8Array;
@@ -246,6 +349,14 @@ export class Video extends pb_1.Message {
             writer.writeMessage(9, this.created_at, () => this.created_at.serialize(writer));
         if (this.channel_id.length)
             writer.writeString(10, this.channel_id);
+        if (this.duration_seconds != 0)
+            writer.writeInt64(11, this.duration_seconds);
+        if (this.has_watch_progress)
+            writer.writeMessage(12, this.watch_progress, () => this.watch_progress.serialize(writer));
+        if (this.playback_url.length)
+            writer.writeString(13, this.playback_url);
+        if (this.has_playback_url_expires_at)
+            writer.writeMessage(14, this.playback_url_expires_at, () => this.playback_url_expires_at.serialize(writer));
         if (!w)
             return writer.getResultBuffer();
     }
@@ -285,6 +396,18 @@ export class Video extends pb_1.Message {
                 case 10:
                     message.channel_id = reader.readString();
                     break;
+                case 11:
+                    message.duration_seconds = reader.readInt64();
+                    break;
+                case 12:
+                    reader.readMessage(message.watch_progress, () => message.watch_progress = WatchProgress.deserialize(reader));
+                    break;
+                case 13:
+                    message.playback_url = reader.readString();
+                    break;
+                case 14:
+                    reader.readMessage(message.playback_url_expires_at, () => message.playback_url_expires_at = dependency_1.Timestamp.deserialize(reader));
+                    break;
                 default: reader.skipField();
             }
         }
@@ -503,12 +626,23 @@ export class GetVideoRequest extends pb_1.Message {
 export class ListVideosRequest extends pb_1.Message {
     #one_of_decls: number[][] = [];
     constructor(data?: any[] | {
+        /** @deprecated*/
         page_number?: number;
         page_size?: number;
         channel_id?: string;
+        page_token?: string;
+        uploader_id?: string;
+        created_after?: dependency_1.Timestamp;
+        created_before?: dependency_1.Timestamp;
+        channel_ids?: string[];
+        status?: VideoStatus;
+        min_duration_seconds?: number;
+        max_duration_seconds?: number;
+        sort_by?: VideoSortField;
+        sort_direction?: SortDirection;
     }) {
         super();
-        pb_1.Message.initialize(this, Array.isArray(data) ? data : [], 0, -1, [], this.#one_of_decls);
+        pb_1.Message.initialize(this, Array.isArray(data) ? data : [], 0, -1, [8], this.#one_of_decls);
         if (!Array.isArray(data) && typeof data == "object") {
             if ("page_number" in data && data.page_number != undefined) {
                 this.page_number = data.page_number;
@@ -519,8 +653,39 @@ export class ListVideosRequest extends pb_1.Message {
             if ("channel_id" in data && data.channel_id != undefined) {
                 this.channel_id = data.channel_id;
             }
+            if ("page_token" in data && data.page_token != undefined) {
+                this.page_token = data.page_token;
+            }
+            if ("uploader_id" in data && data.uploader_id != undefined) {
+                this.uploader_id = data.uploader_id;
+            }
+            if ("created_after" in data && data.created_after != undefined) {
+                this.created_after = data.created_after;
+            }
+            if ("created_before" in data && data.created_before != undefined) {
+                this.created_before = data.created_before;
+            }
+            if ("channel_ids" in data && data.channel_ids != undefined) {
+                this.channel_ids = data.channel_ids;
+            }
+            if ("status" in data && data.status != undefined) {
+                this.status = data.status;
+            }
+            if ("min_duration_seconds" in data && data.min_duration_seconds != undefined) {
+                this.min_duration_seconds = data.min_duration_seconds;
+            }
+            if ("max_duration_seconds" in data && data.max_duration_seconds != undefined) {
+                this.max_duration_seconds = data.max_duration_seconds;
+            }
+            if ("sort_by" in data && data.sort_by != undefined) {
+                this.sort_by = data.sort_by;
+            }
+            if ("sort_direction" in data && data.sort_direction != undefined) {
+                this.sort_direction = data.sort_direction;
+            }
         }
     }
+    /** @deprecated*/
     get page_number() {
         return pb_1.Message.getFieldWithDefault(this, 1, 0) as number;
     }
@@ -539,10 +704,87 @@ export class ListVideosRequest extends pb_1.Message {
     set channel_id(value: string) {
         pb_1.Message.setField(this, 3, value);
     }
+    get page_token() {
+        return pb_1.Message.getFieldWithDefault(this, 4, "") as string;
+    }
+    set page_token(value: string) {
+        pb_1.Message.setField(this, 4, value);
+    }
+    get uploader_id() {
+        return pb_1.Message.getFieldWithDefault(this, 5, "") as string;
+    }
+    set uploader_id(value: string) {
+        pb_1.Message.setField(this, 5, value);
+    }
+    get created_after() {
+        return pb_1.Message.getWrapperField(this, dependency_1.Timestamp, 6) as dependency_1.Timestamp;
+    }
+    set created_after(value: dependency_1.Timestamp) {
+        pb_1.Message.setWrapperField(this, 6, value);
+    }
+    get has_created_after() {
+        return pb_1.Message.getField(this, 6) != null;
+    }
+    get created_before() {
+        return pb_1.Message.getWrapperField(this, dependency_1.Timestamp, 7) as dependency_1.Timestamp;
+    }
+    set created_before(value: dependency_1.Timestamp) {
+        pb_1.Message.setWrapperField(this, 7, value);
+    }
+    get has_created_before() {
+        return pb_1.Message.getField(this, 7) != null;
+    }
+    get channel_ids() {
+        return pb_1.Message.getFieldWithDefault(this, 8, []) as string[];
+    }
+    set channel_ids(value: string[]) {
+        pb_1.Message.setField(this, 8, value);
+    }
+    get status() {
+        return pb_1.Message.getFieldWithDefault(this, 9, VideoStatus.STATUS_UNSPECIFIED) as VideoStatus;
+    }
+    set status(value: VideoStatus) {
+        pb_1.Message.setField(this, 9, value);
+    }
+    get min_duration_seconds() {
+        return pb_1.Message.getFieldWithDefault(this, 10, 0) as number;
+    }
+    set min_duration_seconds(value: number) {
+        pb_1.Message.setField(this, 10, value);
+    }
+    get max_duration_seconds() {
+        return pb_1.Message.getFieldWithDefault(this, 11, 0) as number;
+    }
+    set max_duration_seconds(value: number) {
+        pb_1.Message.setField(this, 11, value);
+    }
+    get sort_by() {
+        return pb_1.Message.getFieldWithDefault(this, 12, VideoSortField.VIDEO_SORT_CREATED_AT) as VideoSortField;
+    }
+    set sort_by(value: VideoSortField) {
+        pb_1.Message.setField(this, 12, value);
+    }
+    get sort_direction() {
+        return pb_1.Message.getFieldWithDefault(this, 13, SortDirection.SORT_DIRECTION_DEFAULT) as SortDirection;
+    }
+    set sort_direction(value: SortDirection) {
+        pb_1.Message.setField(this, 13, value);
+    }
     static fromObject(data: {
+        /** @deprecated*/
         page_number?: number;
         page_size?: number;
         channel_id?: string;
+        page_token?: string;
+        uploader_id?: string;
+        created_after?: ReturnType<typeof dependency_1.Timestamp.prototype.toObject>;
+        created_before?: ReturnType<typeof dependency_1.Timestamp.prototype.toObject>;
+        channel_ids?: string[];
+        status?: VideoStatus;
+        min_duration_seconds?: number;
+        max_duration_seconds?: number;
+        sort_by?: VideoSortField;
+        sort_direction?: SortDirection;
     }): ListVideosRequest {
         const message = new ListVideosRequest({});
         if (data.page_number != null) {
@@ -554,13 +796,54 @@ export class ListVideosRequest extends pb_1.Message {
         if (data.channel_id != null) {
             message.channel_id = data.channel_id;
         }
+        if (data.page_token != null) {
+            message.page_token = data.page_token;
+        }
+        if (data.uploader_id != null) {
+            message.uploader_id = data.uploader_id;
+        }
+        if (data.created_after != null) {
+            message.created_after = dependency_1.Timestamp.fromObject(data.created_after);
+        }
+        if (data.created_before != null) {
+            message.created_before = dependency_1.Timestamp.fromObject(data.created_before);
+        }
+        if (data.channel_ids != null) {
+            message.channel_ids = data.channel_ids;
+        }
+        if (data.status != null) {
+            message.status = data.status;
+        }
+        if (data.min_duration_seconds != null) {
+            message.min_duration_seconds = data.min_duration_seconds;
+        }
+        if (data.max_duration_seconds != null) {
+            message.max_duration_seconds = data.max_duration_seconds;
+        }
+        if (data.sort_by != null) {
+            message.sort_by = data.sort_by;
+        }
+        if (data.sort_direction != null) {
+            message.sort_direction = data.sort_direction;
+        }
         return message;
     }
     toObject() {
         const data: {
+            /** @deprecated*/
             page_number?: number;
             page_size?: number;
             channel_id?: string;
+            page_token?: string;
+            uploader_id?: string;
+            created_after?: ReturnType<typeof dependency_1.Timestamp.prototype.toObject>;
+            created_before?: ReturnType<typeof dependency_1.Timestamp.prototype.toObject>;
+            channel_ids?: string[];
+            status?: VideoStatus;
+            min_duration_seconds?: number;
+            max_duration_seconds?: number;
+            sort_by?: VideoSortField;
+            sort_direction?: SortDirection;
         } = {};
         if (this.page_number != null) {
             data.page_number = this.page_number;
@@ -571,6 +854,36 @@ export class ListVideosRequest extends pb_1.Message {
         if (this.channel_id != null) {
             data.channel_id = this.channel_id;
         }
+        if (this.page_token != null) {
+            data.page_token = this.page_token;
+        }
+        if (this.uploader_id != null) {
+            data.uploader_id = this.uploader_id;
+        }
+        if (this.created_after != null) {
+            data.created_after = this.created_after.toObject();
+        }
+        if (this.created_before != null) {
+            data.created_before = this.created_before.toObject();
+        }
+        if (this.channel_ids != null) {
+            data.channel_ids = this.channel_ids;
+        }
+        if (this.status != null) {
+            data.status = this.status;
+        }
+        if (this.min_duration_seconds != null) {
+            data.min_duration_seconds = this.min_duration_seconds;
+        }
+        if (this.max_duration_seconds != null) {
+            data.max_duration_seconds = this.max_duration_seconds;
+        }
+        if (this.sort_by != null) {
+            data.sort_by = this.sort_by;
+        }
+        if (this.sort_direction != null) {
+            data.sort_direction = this.sort_direction;
+        }
         return data;
     }
     serialize(): Uint8Array;
@@ -583,6 +896,26 @@ export class ListVideosRequest extends pb_1.Message {
             writer.writeInt32(2, this.page_size);
         if (this.channel_id.length)
             writer.writeString(3, this.channel_id);
+        if (this.page_token.length)
+            writer.writeString(4, this.page_token);
+        if (this.uploader_id.length)
+            writer.writeString(5, this.uploader_id);
+        if (this.has_created_after)
+            writer.writeMessage(6, this.created_after, () => this.created_after.serialize(writer));
+        if (this.has_created_before)
+            writer.writeMessage(7, this.created_before, () => this.created_before.serialize(writer));
+        if (this.channel_ids.length)
+            writer.writeRepeatedString(8, this.channel_ids);
+        if (this.status != VideoStatus.STATUS_UNSPECIFIED)
+            writer.writeEnum(9, this.status);
+        if (this.min_duration_seconds != 0)
+            writer.writeInt64(10, this.min_duration_seconds);
+        if (this.max_duration_seconds != 0)
+            writer.writeInt64(11, this.max_duration_seconds);
+        if (this.sort_by != VideoSortField.VIDEO_SORT_CREATED_AT)
+            writer.writeEnum(12, this.sort_by);
+        if (this.sort_direction != SortDirection.SORT_DIRECTION_DEFAULT)
+            writer.writeEnum(13, this.sort_direction);
         if (!w)
             return writer.getResultBuffer();
     }
@@ -601,6 +934,36 @@ export class ListVideosRequest extends pb_1.Message {
                 case 3:
                     message.channel_id = reader.readString();
                     break;
+                case 4:
+                    message.page_token = reader.readString();
+                    break;
+                case 5:
+                    message.uploader_id = reader.readString();
+                    break;
+                case 6:
+                    reader.readMessage(message.created_after, () => message.created_after = dependency_1.Timestamp.deserialize(reader));
+                    break;
+                case 7:
+                    reader.readMessage(message.created_before, () => message.created_before = dependency_1.Timestamp.deserialize(reader));
+                    break;
+                case 8:
+                    pb_1.Message.addToRepeatedField(message, 8, reader.readString());
+                    break;
+                case 9:
+                    message.status = reader.readEnum();
+                    break;
+                case 10:
+                    message.min_duration_seconds = reader.readInt64();
+                    break;
+                case 11:
+                    message.max_duration_seconds = reader.readInt64();
+                    break;
+                case 12:
+                    message.sort_by = reader.readEnum();
+                    break;
+                case 13:
+                    message.sort_direction = reader.readEnum();
+                    break;
                 default: reader.skipField();
             }
         }
@@ -617,6 +980,8 @@ export class ListVideosResponse extends pb_1.Message {
     #one_of_decls: number[][] = [];
     constructor(data?: any[] | {
         videos?: Video[];
+        next_page_token?: string;
+        total_count?: number;
     }) {
         super();
         pb_1.Message.initialize(this, Array.isArray(data) ? data : [], 0, -1, [1], this.#one_of_decls);
@@ -624,6 +989,12 @@ export class ListVideosResponse extends pb_1.Message {
             if ("videos" in data && data.videos != undefined) {
                 this.videos = data.videos;
             }
+            if ("next_page_token" in data && data.next_page_token != undefined) {
+                this.next_page_token = data.next_page_token;
+            }
+            if ("total_count" in data && data.total_count != undefined) {
+                this.total_count = data.total_count;
+            }
         }
     }
     get videos() {
@@ -632,22 +1003,50 @@ export class ListVideosResponse extends pb_1.Message {
     set videos(value: Video[]) {
         pb_1.Message.setRepeatedWrapperField(this, 1, value);
     }
+    get next_page_token() {
+        return pb_1.Message.getFieldWithDefault(this, 2, "") as string;
+    }
+    set next_page_token(value: string) {
+        pb_1.Message.setField(this, 2, value);
+    }
+    get total_count() {
+        return pb_1.Message.getFieldWithDefault(this, 3, 0) as number;
+    }
+    set total_count(value: number) {
+        pb_1.Message.setField(this, 3, value);
+    }
     static fromObject(data: {
         videos?: ReturnType<typeof Video.prototype.toObject>[];
+        next_page_token?: string;
+        total_count?: number;
     }): ListVideosResponse {
         const message = new ListVideosResponse({});
         if (data.videos != null) {
             message.videos = data.videos.map(item => Video.fromObject(item));
         }
+        if (data.next_page_token != null) {
+            message.next_page_token = data.next_page_token;
+        }
+        if (data.total_count != null) {
+            message.total_count = data.total_count;
+        }
         return message;
     }
     toObject() {
         const data: {
             videos?: ReturnType<typeof Video.prototype.toObject>[];
+            next_page_token?: string;
+            total_count?: number;
         } = {};
         if (this.videos != null) {
             data.videos = this.videos.map((item: Video) => item.toObject());
         }
+        if (this.next_page_token != null) {
+            data.next_page_token = this.next_page_token;
+        }
+        if (this.total_count != null) {
+            data.total_count = this.total_count;
+        }
         return data;
     }
     serialize(): Uint8Array;
@@ -656,6 +1055,10 @@ export class ListVideosResponse extends pb_1.Message {
         const writer = w || new pb_1.BinaryWriter();
         if (this.videos.length)
             writer.writeRepeatedMessage(1, this.videos, (item: Video) => item.serialize(writer));
+        if (this.next_page_token.length)
+            writer.writeString(2, this.next_page_token);
+        if (this.total_count != 0)
+            writer.writeInt32(3, this.total_count);
         if (!w)
             return writer.getResultBuffer();
     }
@@ -668,6 +1071,12 @@ export class ListVideosResponse extends pb_1.Message {
                 case 1:
                     reader.readMessage(message.videos, () => pb_1.Message.addToRepeatedWrapperField(message, 1, Video.deserialize(reader), Video));
                     break;
+                case 2:
+                    message.next_page_token = reader.readString();
+                    break;
+                case 3:
+                    message.total_count = reader.readInt32();
+                    break;
                 default: reader.skipField();
             }
         }
@@ -1147,208 +1556,4288 @@ export class Empty extends pb_1.Message {
         return Empty.deserialize(bytes);
     }
 }
-export class Channel extends pb_1.Message {
+export class WatchProgress extends pb_1.Message {
     #one_of_decls: number[][] = [];
     constructor(data?: any[] | {
-        id?: string;
-        tenant_id?: string;
-        name?: string;
-        description?: string;
-        created_by?: string;
-        created_at?: dependency_1.Timestamp;
+        video_id?: string;
+        position_seconds?: number;
+        completed?: boolean;
         updated_at?: dependency_1.Timestamp;
-        user_role?: string;
-        member_count?: number;
-        video_count?: number;
     }) {
         super();
         pb_1.Message.initialize(this, Array.isArray(data) ? data : [], 0, -1, [], this.#one_of_decls);
         if (!Array.isArray(data) && typeof data == "object") {
-            if ("id" in data && data.id != undefined) {
-                this.id = data.id;
-            }
-            if ("tenant_id" in data && data.tenant_id != undefined) {
-                this.tenant_id = data.tenant_id;
-            }
-            if ("name" in data && data.name != undefined) {
-                this.name = data.name;
-            }
-            if ("description" in data && data.description != undefined) {
-                this.description = data.description;
+            if ("video_id" in data && data.video_id != undefined) {
+                this.video_id = data.video_id;
             }
-            if ("created_by" in data && data.created_by != undefined) {
-                this.created_by = data.created_by;
+            if ("position_seconds" in data && data.position_seconds != undefined) {
+                this.position_seconds = data.position_seconds;
             }
-            if ("created_at" in data && data.created_at != undefined) {
-                this.created_at = data.created_at;
+            if ("completed" in data && data.completed != undefined) {
+                this.completed = data.completed;
             }
             if ("updated_at" in data && data.updated_at != undefined) {
                 this.updated_at = data.updated_at;
             }
-            if ("user_role" in data && data.user_role != undefined) {
-                this.user_role = data.user_role;
-            }
-            if ("member_count" in data && data.member_count != undefined) {
-                this.member_count = data.member_count;
-            }
-            if ("video_count" in data && data.video_count != undefined) {
-                this.video_count = data.video_count;
-            }
         }
     }
-    get id() {
+    get video_id() {
         return pb_1.Message.getFieldWithDefault(this, 1, "") as string;
     }
-    set id(value: string) {
+    set video_id(value: string) {
         pb_1.Message.setField(this, 1, value);
     }
-    get tenant_id() {
-        return pb_1.Message.getFieldWithDefault(this, 2, "") as string;
+    get position_seconds() {
+        return pb_1.Message.getFieldWithDefault(this, 2, 0) as number;
     }
-    set tenant_id(value: string) {
+    set position_seconds(value: number) {
         pb_1.Message.setField(this, 2, value);
     }
-    get name() {
-        return pb_1.Message.getFieldWithDefault(this, 3, "") as string;
+    get completed() {
+        return pb_1.Message.getFieldWithDefault(this, 3, false) as boolean;
     }
-    set name(value: string) {
+    set completed(value: boolean) {
         pb_1.Message.setField(this, 3, value);
     }
-    get description() {
-        return pb_1.Message.getFieldWithDefault(this, 4, "") as string;
-    }
-    set description(value: string) {
-        pb_1.Message.setField(this, 4, value);
-    }
-    get created_by() {
-        return pb_1.Message.getFieldWithDefault(this, 6, "") as string;
-    }
-    set created_by(value: string) {
-        pb_1.Message.setField(this, 6, value);
-    }
-    get created_at() {
-        return pb_1.Message.getWrapperField(this, dependency_1.Timestamp, 7) as dependency_1.Timestamp;
-    }
-    set created_at(value: dependency_1.Timestamp) {
-        pb_1.Message.setWrapperField(this, 7, value);
-    }
-    get has_created_at() {
-        return pb_1.Message.getField(this, 7) != null;
-    }
     get updated_at() {
-        return pb_1.Message.getWrapperField(this, dependency_1.Timestamp, 8) as dependency_1.Timestamp;
+        return pb_1.Message.getWrapperField(this, dependency_1.Timestamp, 4) as dependency_1.Timestamp;
     }
     set updated_at(value: dependency_1.Timestamp) {
-        pb_1.Message.setWrapperField(this, 8, value);
+        pb_1.Message.setWrapperField(this, 4, value);
     }
     get has_updated_at() {
-        return pb_1.Message.getField(this, 8) != null;
-    }
-    get user_role() {
-        return pb_1.Message.getFieldWithDefault(this, 9, "") as string;
-    }
-    set user_role(value: string) {
-        pb_1.Message.setField(this, 9, value);
-    }
-    get member_count() {
-        return pb_1.Message.getFieldWithDefault(this, 10, 0) as number;
-    }
-    set member_count(value: number) {
-        pb_1.Message.setField(this, 10, value);
-    }
-    get video_count() {
-        return pb_1.Message.getFieldWithDefault(this, 11, 0) as number;
-    }
-    set video_count(value: number) {
-        pb_1.Message.setField(this, 11, value);
+        return pb_1.Message.getField(this, 4) != null;
     }
     static fromObject(data: {
-        id?: string;
-        tenant_id?: string;
-        name?: string;
-        description?: string;
-        created_by?: string;
-        created_at?: ReturnType<typeof dependency_1.Timestamp.prototype.toObject>;
+        video_id?: string;
+        position_seconds?: number;
+        completed?: boolean;
         updated_at?: ReturnType<typeof dependency_1.Timestamp.prototype.toObject>;
-        user_role?: string;
-        member_count?: number;
-        video_count?: number;
-    }): Channel {
-        const message = new Channel({});
-        if (data.id != null) {
-            message.id = data.id;
-        }
+    }): WatchProgress {
+        const message = new WatchProgress({});
+        if (data.video_id != null) {
+            message.video_id = data.video_id;
+        }
+        if (data.position_seconds != null) {
+            message.position_seconds = data.position_seconds;
+        }
+        if (data.completed != null) {
+            message.completed = data.completed;
+        }
+        if (data.updated_at != null) {
+            message.updated_at = dependency_1.Timestamp.fromObject(data.updated_at);
+        }
+        return message;
+    }
+    toObject() {
+        const data: {
+            video_id?: string;
+            position_seconds?: number;
+            completed?: boolean;
+            updated_at?: ReturnType<typeof dependency_1.Timestamp.prototype.toObject>;
+        } = {};
+        if (this.video_id != null) {
+            data.video_id = this.video_id;
+        }
+        if (this.position_seconds != null) {
+            data.position_seconds = this.position_seconds;
+        }
+        if (this.completed != null) {
+            data.completed = this.completed;
+        }
+        if (this.updated_at != null) {
+            data.updated_at = this.updated_at.toObject();
+        }
+        return data;
+    }
+    serialize(): Uint8Array;
+    serialize(w: pb_1.BinaryWriter): void;
+    serialize(w?: pb_1.BinaryWriter): Uint8Array | void {
+        const writer = w || new pb_1.BinaryWriter();
+        if (this.video_id.length)
+            writer.writeString(1, this.video_id);
+        if (this.position_seconds != 0)
+            writer.writeDouble(2, this.position_seconds);
+        if (this.completed != false)
+            writer.writeBool(3, this.completed);
+        if (this.has_updated_at)
+            writer.writeMessage(4, this.updated_at, () => this.updated_at.serialize(writer));
+        if (!w)
+            return writer.getResultBuffer();
+    }
+    static deserialize(bytes: Uint8Array | pb_1.BinaryReader): WatchProgress {
+        const reader = bytes instanceof pb_1.BinaryReader ? bytes : new pb_1.BinaryReader(bytes), message = new WatchProgress();
+        while (reader.nextField()) {
+            if (reader.isEndGroup())
+                break;
+            switch (reader.getFieldNumber()) {
+                case 1:
+                    message.video_id = reader.readString();
+                    break;
+                case 2:
+                    message.position_seconds = reader.readDouble();
+                    break;
+                case 3:
+                    message.completed = reader.readBool();
+                    break;
+                case 4:
+                    reader.readMessage(message.updated_at, () => message.updated_at = dependency_1.Timestamp.deserialize(reader));
+                    break;
+                default: reader.skipField();
+            }
+        }
+        return message;
+    }
+    serializeBinary(): Uint8Array {
+        return this.serialize();
+    }
+    static deserializeBinary(bytes: Uint8Array): WatchProgress {
+        return WatchProgress.deserialize(bytes);
+    }
+}
+export class SaveWatchProgressRequest extends pb_1.Message {
+    #one_of_decls: number[][] = [];
+    constructor(data?: any[] | {
+        video_id?: string;
+        position_seconds?: number;
+        completed?: boolean;
+    }) {
+        super();
+        pb_1.Message.initialize(this, Array.isArray(data) ? data : [], 0, -1, [], this.#one_of_decls);
+        if (!Array.isArray(data) && typeof data == "object") {
+            if ("video_id" in data && data.video_id != undefined) {
+                this.video_id = data.video_id;
+            }
+            if ("position_seconds" in data && data.position_seconds != undefined) {
+                this.position_seconds = data.position_seconds;
+            }
+            if ("completed" in data && data.completed != undefined) {
+                this.completed = data.completed;
+            }
+        }
+    }
+    get video_id() {
+        return pb_1.Message.getFieldWithDefault(this, 1, "") as string;
+    }
+    set video_id(value: string) {
+        pb_1.Message.setField(this, 1, value);
+    }
+    get position_seconds() {
+        return pb_1.Message.getFieldWithDefault(this, 2, 0) as number;
+    }
+    set position_seconds(value: number) {
+        pb_1.Message.setField(this, 2, value);
+    }
+    get completed() {
+        return pb_1.Message.getFieldWithDefault(this, 3, false) as boolean;
+    }
+    set completed(value: boolean) {
+        pb_1.Message.setField(this, 3, value);
+    }
+    static fromObject(data: {
+        video_id?: string;
+        position_seconds?: number;
+        completed?: boolean;
+    }): SaveWatchProgressRequest {
+        const message = new SaveWatchProgressRequest({});
+        if (data.video_id != null) {
+            message.video_id = data.video_id;
+        }
+        if (data.position_seconds != null) {
+            message.position_seconds = data.position_seconds;
+        }
+        if (data.completed != null) {
+            message.completed = data.completed;
+        }
+        return message;
+    }
+    toObject() {
+        const data: {
+            video_id?: string;
+            position_seconds?: number;
+            completed?: boolean;
+        } = {};
+        if (this.video_id != null) {
+            data.video_id = this.video_id;
+        }
+        if (this.position_seconds != null) {
+            data.position_seconds = this.position_seconds;
+        }
+        if (this.completed != null) {
+            data.completed = this.completed;
+        }
+        return data;
+    }
+    serialize(): Uint8Array;
+    serialize(w: pb_1.BinaryWriter): void;
+    serialize(w?: pb_1.BinaryWriter): Uint8Array | void {
+        const writer = w || new pb_1.BinaryWriter();
+        if (this.video_id.length)
+            writer.writeString(1, this.video_id);
+        if (this.position_seconds != 0)
+            writer.writeDouble(2, this.position_seconds);
+        if (this.completed != false)
+            writer.writeBool(3, this.completed);
+        if (!w)
+            return writer.getResultBuffer();
+    }
+    static deserialize(bytes: Uint8Array | pb_1.BinaryReader): SaveWatchProgressRequest {
+        const reader = bytes instanceof pb_1.BinaryReader ? bytes : new pb_1.BinaryReader(bytes), message = new SaveWatchProgressRequest();
+        while (reader.nextField()) {
+            if (reader.isEndGroup())
+                break;
+            switch (reader.getFieldNumber()) {
+                case 1:
+                    message.video_id = reader.readString();
+                    break;
+                case 2:
+                    message.position_seconds = reader.readDouble();
+                    break;
+                case 3:
+                    message.completed = reader.readBool();
+                    break;
+                default: reader.skipField();
+            }
+        }
+        return message;
+    }
+    serializeBinary(): Uint8Array {
+        return this.serialize();
+    }
+    static deserializeBinary(bytes: Uint8Array): SaveWatchProgressRequest {
+        return SaveWatchProgressRequest.deserialize(bytes);
+    }
+}
+export class GetWatchProgressRequest extends pb_1.Message {
+    #one_of_decls: number[][] = [];
+    constructor(data?: any[] | {
+        video_id?: string;
+    }) {
+        super();
+        pb_1.Message.initialize(this, Array.isArray(data) ? data : [], 0, -1, [], this.#one_of_decls);
+        if (!Array.isArray(data) && typeof data == "object") {
+            if ("video_id" in data && data.video_id != undefined) {
+                this.video_id = data.video_id;
+            }
+        }
+    }
+    get video_id() {
+        return pb_1.Message.getFieldWithDefault(this, 1, "") as string;
+    }
+    set video_id(value: string) {
+        pb_1.Message.setField(this, 1, value);
+    }
+    static fromObject(data: {
+        video_id?: string;
+    }): GetWatchProgressRequest {
+        const message = new GetWatchProgressRequest({});
+        if (data.video_id != null) {
+            message.video_id = data.video_id;
+        }
+        return message;
+    }
+    toObject() {
+        const data: {
+            video_id?: string;
+        } = {};
+        if (this.video_id != null) {
+            data.video_id = this.video_id;
+        }
+        return data;
+    }
+    serialize(): Uint8Array;
+    serialize(w: pb_1.BinaryWriter): void;
+    serialize(w?: pb_1.BinaryWriter): Uint8Array | void {
+        const writer = w || new pb_1.BinaryWriter();
+        if (this.video_id.length)
+            writer.writeString(1, this.video_id);
+        if (!w)
+            return writer.getResultBuffer();
+    }
+    static deserialize(bytes: Uint8Array | pb_1.BinaryReader): GetWatchProgressRequest {
+        const reader = bytes instanceof pb_1.BinaryReader ? bytes : new pb_1.BinaryReader(bytes), message = new GetWatchProgressRequest();
+        while (reader.nextField()) {
+            if (reader.isEndGroup())
+                break;
+            switch (reader.getFieldNumber()) {
+                case 1:
+                    message.video_id = reader.readString();
+                    break;
+                default: reader.skipField();
+            }
+        }
+        return message;
+    }
+    serializeBinary(): Uint8Array {
+        return this.serialize();
+    }
+    static deserializeBinary(bytes: Uint8Array): GetWatchProgressRequest {
+        return GetWatchProgressRequest.deserialize(bytes);
+    }
+}
+export class Reaction extends pb_1.Message {
+    #one_of_decls: number[][] = [];
+    constructor(data?: any[] | {
+        id?: string;
+        video_id?: string;
+        user_id?: string;
+        emoji?: string;
+        timestamp_seconds?: number;
+        created_at?: dependency_1.Timestamp;
+    }) {
+        super();
+        pb_1.Message.initialize(this, Array.isArray(data) ? data : [], 0, -1, [], this.#one_of_decls);
+        if (!Array.isArray(data) && typeof data == "object") {
+            if ("id" in data && data.id != undefined) {
+                this.id = data.id;
+            }
+            if ("video_id" in data && data.video_id != undefined) {
+                this.video_id = data.video_id;
+            }
+            if ("user_id" in data && data.user_id != undefined) {
+                this.user_id = data.user_id;
+            }
+            if ("emoji" in data && data.emoji != undefined) {
+                this.emoji = data.emoji;
+            }
+            if ("timestamp_seconds" in data && data.timestamp_seconds != undefined) {
+                this.timestamp_seconds = data.timestamp_seconds;
+            }
+            if ("created_at" in data && data.created_at != undefined) {
+                this.created_at = data.created_at;
+            }
+        }
+    }
+    get id() {
+        return pb_1.Message.getFieldWithDefault(this, 1, "") as string;
+    }
+    set id(value: string) {
+        pb_1.Message.setField(this, 1, value);
+    }
+    get video_id() {
+        return pb_1.Message.getFieldWithDefault(this, 2, "") as string;
+    }
+    set video_id(value: string) {
+        pb_1.Message.setField(this, 2, value);
+    }
+    get user_id() {
+        return pb_1.Message.getFieldWithDefault(this, 3, "") as string;
+    }
+    set user_id(value: string) {
+        pb_1.Message.setField(this, 3, value);
+    }
+    get emoji() {
+        return pb_1.Message.getFieldWithDefault(this, 4, "") as string;
+    }
+    set emoji(value: string) {
+        pb_1.Message.setField(this, 4, value);
+    }
+    get timestamp_seconds() {
+        return pb_1.Message.getFieldWithDefault(this, 5, 0) as number;
+    }
+    set timestamp_seconds(value: number) {
+        pb_1.Message.setField(this, 5, value);
+    }
+    get created_at() {
+        return pb_1.Message.getWrapperField(this, dependency_1.Timestamp, 6) as dependency_1.Timestamp;
+    }
+    set created_at(value: dependency_1.Timestamp) {
+        pb_1.Message.setWrapperField(this, 6, value);
+    }
+    get has_created_at() {
+        return pb_1.Message.getField(this, 6) != null;
+    }
+    static fromObject(data: {
+        id?: string;
+        video_id?: string;
+        user_id?: string;
+        emoji?: string;
+        timestamp_seconds?: number;
+        created_at?: ReturnType<typeof dependency_1.Timestamp.prototype.toObject>;
+    }): Reaction {
+        const message = new Reaction({});
+        if (data.id != null) {
+            message.id = data.id;
+        }
+        if (data.video_id != null) {
+            message.video_id = data.video_id;
+        }
+        if (data.user_id != null) {
+            message.user_id = data.user_id;
+        }
+        if (data.emoji != null) {
+            message.emoji = data.emoji;
+        }
+        if (data.timestamp_seconds != null) {
+            message.timestamp_seconds = data.timestamp_seconds;
+        }
+        if (data.created_at != null) {
+            message.created_at = dependency_1.Timestamp.fromObject(data.created_at);
+        }
+        return message;
+    }
+    toObject() {
+        const data: {
+            id?: string;
+            video_id?: string;
+            user_id?: string;
+            emoji?: string;
+            timestamp_seconds?: number;
+            created_at?: ReturnType<typeof dependency_1.Timestamp.prototype.toObject>;
+        } = {};
+        if (this.id != null) {
+            data.id = this.id;
+        }
+        if (this.video_id != null) {
+            data.video_id = this.video_id;
+        }
+        if (this.user_id != null) {
+            data.user_id = this.user_id;
+        }
+        if (this.emoji != null) {
+            data.emoji = this.emoji;
+        }
+        if (this.timestamp_seconds != null) {
+            data.timestamp_seconds = this.timestamp_seconds;
+        }
+        if (this.created_at != null) {
+            data.created_at = this.created_at.toObject();
+        }
+        return data;
+    }
+    serialize(): Uint8Array;
+    serialize(w: pb_1.BinaryWriter): void;
+    serialize(w?: pb_1.BinaryWriter): Uint8Array | void {
+        const writer = w || new pb_1.BinaryWriter();
+        if (this.id.length)
+            writer.writeString(1, this.id);
+        if (this.video_id.length)
+            writer.writeString(2, this.video_id);
+        if (this.user_id.length)
+            writer.writeString(3, this.user_id);
+        if (this.emoji.length)
+            writer.writeString(4, this.emoji);
+        if (this.timestamp_seconds != 0)
+            writer.writeDouble(5, this.timestamp_seconds);
+        if (this.has_created_at)
+            writer.writeMessage(6, this.created_at, () => this.created_at.serialize(writer));
+        if (!w)
+            return writer.getResultBuffer();
+    }
+    static deserialize(bytes: Uint8Array | pb_1.BinaryReader): Reaction {
+        const reader = bytes instanceof pb_1.BinaryReader ? bytes : new pb_1.BinaryReader(bytes), message = new Reaction();
+        while (reader.nextField()) {
+            if (reader.isEndGroup())
+                break;
+            switch (reader.getFieldNumber()) {
+                case 1:
+                    message.id = reader.readString();
+                    break;
+                case 2:
+                    message.video_id = reader.readString();
+                    break;
+                case 3:
+                    message.user_id = reader.readString();
+                    break;
+                case 4:
+                    message.emoji = reader.readString();
+                    break;
+                case 5:
+                    message.timestamp_seconds = reader.readDouble();
+                    break;
+                case 6:
+                    reader.readMessage(message.created_at, () => message.created_at = dependency_1.Timestamp.deserialize(reader));
+                    break;
+                default: reader.skipField();
+            }
+        }
+        return message;
+    }
+    serializeBinary(): Uint8Array {
+        return this.serialize();
+    }
+    static deserializeBinary(bytes: Uint8Array): Reaction {
+        return Reaction.deserialize(bytes);
+    }
+}
+export class ReactionCount extends pb_1.Message {
+    #one_of_decls: number[][] = [];
+    constructor(data?: any[] | {
+        emoji?: string;
+        count?: number;
+        reacted_by_me?: boolean;
+    }) {
+        super();
+        pb_1.Message.initialize(this, Array.isArray(data) ? data : [], 0, -1, [], this.#one_of_decls);
+        if (!Array.isArray(data) && typeof data == "object") {
+            if ("emoji" in data && data.emoji != undefined) {
+                this.emoji = data.emoji;
+            }
+            if ("count" in data && data.count != undefined) {
+                this.count = data.count;
+            }
+            if ("reacted_by_me" in data && data.reacted_by_me != undefined) {
+                this.reacted_by_me = data.reacted_by_me;
+            }
+        }
+    }
+    get emoji() {
+        return pb_1.Message.getFieldWithDefault(this, 1, "") as string;
+    }
+    set emoji(value: string) {
+        pb_1.Message.setField(this, 1, value);
+    }
+    get count() {
+        return pb_1.Message.getFieldWithDefault(this, 2, 0) as number;
+    }
+    set count(value: number) {
+        pb_1.Message.setField(this, 2, value);
+    }
+    get reacted_by_me() {
+        return pb_1.Message.getFieldWithDefault(this, 3, false) as boolean;
+    }
+    set reacted_by_me(value: boolean) {
+        pb_1.Message.setField(this, 3, value);
+    }
+    static fromObject(data: {
+        emoji?: string;
+        count?: number;
+        reacted_by_me?: boolean;
+    }): ReactionCount {
+        const message = new ReactionCount({});
+        if (data.emoji != null) {
+            message.emoji = data.emoji;
+        }
+        if (data.count != null) {
+            message.count = data.count;
+        }
+        if (data.reacted_by_me != null) {
+            message.reacted_by_me = data.reacted_by_me;
+        }
+        return message;
+    }
+    toObject() {
+        const data: {
+            emoji?: string;
+            count?: number;
+            reacted_by_me?: boolean;
+        } = {};
+        if (this.emoji != null) {
+            data.emoji = this.emoji;
+        }
+        if (this.count != null) {
+            data.count = this.count;
+        }
+        if (this.reacted_by_me != null) {
+            data.reacted_by_me = this.reacted_by_me;
+        }
+        return data;
+    }
+    serialize(): Uint8Array;
+    serialize(w: pb_1.BinaryWriter): void;
+    serialize(w?: pb_1.BinaryWriter): Uint8Array | void {
+        const writer = w || new pb_1.BinaryWriter();
+        if (this.emoji.length)
+            writer.writeString(1, this.emoji);
+        if (this.count != 0)
+            writer.writeInt32(2, this.count);
+        if (this.reacted_by_me != false)
+            writer.writeBool(3, this.reacted_by_me);
+        if (!w)
+            return writer.getResultBuffer();
+    }
+    static deserialize(bytes: Uint8Array | pb_1.BinaryReader): ReactionCount {
+        const reader = bytes instanceof pb_1.BinaryReader ? bytes : new pb_1.BinaryReader(bytes), message = new ReactionCount();
+        while (reader.nextField()) {
+            if (reader.isEndGroup())
+                break;
+            switch (reader.getFieldNumber()) {
+                case 1:
+                    message.emoji = reader.readString();
+                    break;
+                case 2:
+                    message.count = reader.readInt32();
+                    break;
+                case 3:
+                    message.reacted_by_me = reader.readBool();
+                    break;
+                default: reader.skipField();
+            }
+        }
+        return message;
+    }
+    serializeBinary(): Uint8Array {
+        return this.serialize();
+    }
+    static deserializeBinary(bytes: Uint8Array): ReactionCount {
+        return ReactionCount.deserialize(bytes);
+    }
+}
+export class AddReactionRequest extends pb_1.Message {
+    #one_of_decls: number[][] = [];
+    constructor(data?: any[] | {
+        video_id?: string;
+        emoji?: string;
+        timestamp_seconds?: number;
+    }) {
+        super();
+        pb_1.Message.initialize(this, Array.isArray(data) ? data : [], 0, -1, [], this.#one_of_decls);
+        if (!Array.isArray(data) && typeof data == "object") {
+            if ("video_id" in data && data.video_id != undefined) {
+                this.video_id = data.video_id;
+            }
+            if ("emoji" in data && data.emoji != undefined) {
+                this.emoji = data.emoji;
+            }
+            if ("timestamp_seconds" in data && data.timestamp_seconds != undefined) {
+                this.timestamp_seconds = data.timestamp_seconds;
+            }
+        }
+    }
+    get video_id() {
+        return pb_1.Message.getFieldWithDefault(this, 1, "") as string;
+    }
+    set video_id(value: string) {
+        pb_1.Message.setField(this, 1, value);
+    }
+    get emoji() {
+        return pb_1.Message.getFieldWithDefault(this, 2, "") as string;
+    }
+    set emoji(value: string) {
+        pb_1.Message.setField(this, 2, value);
+    }
+    get timestamp_seconds() {
+        return pb_1.Message.getFieldWithDefault(this, 3, 0) as number;
+    }
+    set timestamp_seconds(value: number) {
+        pb_1.Message.setField(this, 3, value);
+    }
+    static fromObject(data: {
+        video_id?: string;
+        emoji?: string;
+        timestamp_seconds?: number;
+    }): AddReactionRequest {
+        const message = new AddReactionRequest({});
+        if (data.video_id != null) {
+            message.video_id = data.video_id;
+        }
+        if (data.emoji != null) {
+            message.emoji = data.emoji;
+        }
+        if (data.timestamp_seconds != null) {
+            message.timestamp_seconds = data.timestamp_seconds;
+        }
+        return message;
+    }
+    toObject() {
+        const data: {
+            video_id?: string;
+            emoji?: string;
+            timestamp_seconds?: number;
+        } = {};
+        if (this.video_id != null) {
+            data.video_id = this.video_id;
+        }
+        if (this.emoji != null) {
+            data.emoji = this.emoji;
+        }
+        if (this.timestamp_seconds != null) {
+            data.timestamp_seconds = this.timestamp_seconds;
+        }
+        return data;
+    }
+    serialize(): Uint8Array;
+    serialize(w: pb_1.BinaryWriter): void;
+    serialize(w?: pb_1.BinaryWriter): Uint8Array | void {
+        const writer = w || new pb_1.BinaryWriter();
+        if (this.video_id.length)
+            writer.writeString(1, this.video_id);
+        if (this.emoji.length)
+            writer.writeString(2, this.emoji);
+        if (this.timestamp_seconds != 0)
+            writer.writeDouble(3, this.timestamp_seconds);
+        if (!w)
+            return writer.getResultBuffer();
+    }
+    static deserialize(bytes: Uint8Array | pb_1.BinaryReader): AddReactionRequest {
+        const reader = bytes instanceof pb_1.BinaryReader ? bytes : new pb_1.BinaryReader(bytes), message = new AddReactionRequest();
+        while (reader.nextField()) {
+            if (reader.isEndGroup())
+                break;
+            switch (reader.getFieldNumber()) {
+                case 1:
+                    message.video_id = reader.readString();
+                    break;
+                case 2:
+                    message.emoji = reader.readString();
+                    break;
+                case 3:
+                    message.timestamp_seconds = reader.readDouble();
+                    break;
+                default: reader.skipField();
+            }
+        }
+        return message;
+    }
+    serializeBinary(): Uint8Array {
+        return this.serialize();
+    }
+    static deserializeBinary(bytes: Uint8Array): AddReactionRequest {
+        return AddReactionRequest.deserialize(bytes);
+    }
+}
+export class RemoveReactionRequest extends pb_1.Message {
+    #one_of_decls: number[][] = [];
+    constructor(data?: any[] | {
+        reaction_id?: string;
+    }) {
+        super();
+        pb_1.Message.initialize(this, Array.isArray(data) ? data : [], 0, -1, [], this.#one_of_decls);
+        if (!Array.isArray(data) && typeof data == "object") {
+            if ("reaction_id" in data && data.reaction_id != undefined) {
+                this.reaction_id = data.reaction_id;
+            }
+        }
+    }
+    get reaction_id() {
+        return pb_1.Message.getFieldWithDefault(this, 1, "") as string;
+    }
+    set reaction_id(value: string) {
+        pb_1.Message.setField(this, 1, value);
+    }
+    static fromObject(data: {
+        reaction_id?: string;
+    }): RemoveReactionRequest {
+        const message = new RemoveReactionRequest({});
+        if (data.reaction_id != null) {
+            message.reaction_id = data.reaction_id;
+        }
+        return message;
+    }
+    toObject() {
+        const data: {
+            reaction_id?: string;
+        } = {};
+        if (this.reaction_id != null) {
+            data.reaction_id = this.reaction_id;
+        }
+        return data;
+    }
+    serialize(): Uint8Array;
+    serialize(w: pb_1.BinaryWriter): void;
+    serialize(w?: pb_1.BinaryWriter): Uint8Array | void {
+        const writer = w || new pb_1.BinaryWriter();
+        if (this.reaction_id.length)
+            writer.writeString(1, this.reaction_id);
+        if (!w)
+            return writer.getResultBuffer();
+    }
+    static deserialize(bytes: Uint8Array | pb_1.BinaryReader): RemoveReactionRequest {
+        const reader = bytes instanceof pb_1.BinaryReader ? bytes : new pb_1.BinaryReader(bytes), message = new RemoveReactionRequest();
+        while (reader.nextField()) {
+            if (reader.isEndGroup())
+                break;
+            switch (reader.getFieldNumber()) {
+                case 1:
+                    message.reaction_id = reader.readString();
+                    break;
+                default: reader.skipField();
+            }
+        }
+        return message;
+    }
+    serializeBinary(): Uint8Array {
+        return this.serialize();
+    }
+    static deserializeBinary(bytes: Uint8Array): RemoveReactionRequest {
+        return RemoveReactionRequest.deserialize(bytes);
+    }
+}
+export class RemoveReactionResponse extends pb_1.Message {
+    #one_of_decls: number[][] = [];
+    constructor(data?: any[] | {
+        message?: string;
+    }) {
+        super();
+        pb_1.Message.initialize(this, Array.isArray(data) ? data : [], 0, -1, [], this.#one_of_decls);
+        if (!Array.isArray(data) && typeof data == "object") {
+            if ("message" in data && data.message != undefined) {
+                this.message = data.message;
+            }
+        }
+    }
+    get message() {
+        return pb_1.Message.getFieldWithDefault(this, 1, "") as string;
+    }
+    set message(value: string) {
+        pb_1.Message.setField(this, 1, value);
+    }
+    static fromObject(data: {
+        message?: string;
+    }): RemoveReactionResponse {
+        const message = new RemoveReactionResponse({});
+        if (data.message != null) {
+            message.message = data.message;
+        }
+        return message;
+    }
+    toObject() {
+        const data: {
+            message?: string;
+        } = {};
+        if (this.message != null) {
+            data.message = this.message;
+        }
+        return data;
+    }
+    serialize(): Uint8Array;
+    serialize(w: pb_1.BinaryWriter): void;
+    serialize(w?: pb_1.BinaryWriter): Uint8Array | void {
+        const writer = w || new pb_1.BinaryWriter();
+        if (this.message.length)
+            writer.writeString(1, this.message);
+        if (!w)
+            return writer.getResultBuffer();
+    }
+    static deserialize(bytes: Uint8Array | pb_1.BinaryReader): RemoveReactionResponse {
+        const reader = bytes instanceof pb_1.BinaryReader ? bytes : new pb_1.BinaryReader(bytes), message = new RemoveReactionResponse();
+        while (reader.nextField()) {
+            if (reader.isEndGroup())
+                break;
+            switch (reader.getFieldNumber()) {
+                case 1:
+                    message.message = reader.readString();
+                    break;
+                default: reader.skipField();
+            }
+        }
+        return message;
+    }
+    serializeBinary(): Uint8Array {
+        return this.serialize();
+    }
+    static deserializeBinary(bytes: Uint8Array): RemoveReactionResponse {
+        return RemoveReactionResponse.deserialize(bytes);
+    }
+}
+export class ListReactionsRequest extends pb_1.Message {
+    #one_of_decls: number[][] = [];
+    constructor(data?: any[] | {
+        video_id?: string;
+    }) {
+        super();
+        pb_1.Message.initialize(this, Array.isArray(data) ? data : [], 0, -1, [], this.#one_of_decls);
+        if (!Array.isArray(data) && typeof data == "object") {
+            if ("video_id" in data && data.video_id != undefined) {
+                this.video_id = data.video_id;
+            }
+        }
+    }
+    get video_id() {
+        return pb_1.Message.getFieldWithDefault(this, 1, "") as string;
+    }
+    set video_id(value: string) {
+        pb_1.Message.setField(this, 1, value);
+    }
+    static fromObject(data: {
+        video_id?: string;
+    }): ListReactionsRequest {
+        const message = new ListReactionsRequest({});
+        if (data.video_id != null) {
+            message.video_id = data.video_id;
+        }
+        return message;
+    }
+    toObject() {
+        const data: {
+            video_id?: string;
+        } = {};
+        if (this.video_id != null) {
+            data.video_id = this.video_id;
+        }
+        return data;
+    }
+    serialize(): Uint8Array;
+    serialize(w: pb_1.BinaryWriter): void;
+    serialize(w?: pb_1.BinaryWriter): Uint8Array | void {
+        const writer = w || new pb_1.BinaryWriter();
+        if (this.video_id.length)
+            writer.writeString(1, this.video_id);
+        if (!w)
+            return writer.getResultBuffer();
+    }
+    static deserialize(bytes: Uint8Array | pb_1.BinaryReader): ListReactionsRequest {
+        const reader = bytes instanceof pb_1.BinaryReader ? bytes : new pb_1.BinaryReader(bytes), message = new ListReactionsRequest();
+        while (reader.nextField()) {
+            if (reader.isEndGroup())
+                break;
+            switch (reader.getFieldNumber()) {
+                case 1:
+                    message.video_id = reader.readString();
+                    break;
+                default: reader.skipField();
+            }
+        }
+        return message;
+    }
+    serializeBinary(): Uint8Array {
+        return this.serialize();
+    }
+    static deserializeBinary(bytes: Uint8Array): ListReactionsRequest {
+        return ListReactionsRequest.deserialize(bytes);
+    }
+}
+export class ListReactionsResponse extends pb_1.Message {
+    #one_of_decls: number[][] = [];
+    constructor(data?: any[] | {
+        reactions?: Reaction[];
+        counts?: ReactionCount[];
+    }) {
+        super();
+        pb_1.Message.initialize(this, Array.isArray(data) ? data : [], 0, -1, [1, 2], this.#one_of_decls);
+        if (!Array.isArray(data) && typeof data == "object") {
+            if ("reactions" in data && data.reactions != undefined) {
+                this.reactions = data.reactions;
+            }
+            if ("counts" in data && data.counts != undefined) {
+                this.counts = data.counts;
+            }
+        }
+    }
+    get reactions() {
+        return pb_1.Message.getRepeatedWrapperField(this, Reaction, 1) as Reaction[];
+    }
+    set reactions(value: Reaction[]) {
+        pb_1.Message.setRepeatedWrapperField(this, 1, value);
+    }
+    get counts() {
+        return pb_1.Message.getRepeatedWrapperField(this, ReactionCount, 2) as ReactionCount[];
+    }
+    set counts(value: ReactionCount[]) {
+        pb_1.Message.setRepeatedWrapperField(this, 2, value);
+    }
+    static fromObject(data: {
+        reactions?: ReturnType<typeof Reaction.prototype.toObject>[];
+        counts?: ReturnType<typeof ReactionCount.prototype.toObject>[];
+    }): ListReactionsResponse {
+        const message = new ListReactionsResponse({});
+        if (data.reactions != null) {
+            message.reactions = data.reactions.map(item => Reaction.fromObject(item));
+        }
+        if (data.counts != null) {
+            message.counts = data.counts.map(item => ReactionCount.fromObject(item));
+        }
+        return message;
+    }
+    toObject() {
+        const data: {
+            reactions?: ReturnType<typeof Reaction.prototype.toObject>[];
+            counts?: ReturnType<typeof ReactionCount.prototype.toObject>[];
+        } = {};
+        if (this.reactions != null) {
+            data.reactions = this.reactions.map((item: Reaction) => item.toObject());
+        }
+        if (this.counts != null) {
+            data.counts = this.counts.map((item: ReactionCount) => item.toObject());
+        }
+        return data;
+    }
+    serialize(): Uint8Array;
+    serialize(w: pb_1.BinaryWriter): void;
+    serialize(w?: pb_1.BinaryWriter): Uint8Array | void {
+        const writer = w || new pb_1.BinaryWriter();
+        if (this.reactions.length)
+            writer.writeRepeatedMessage(1, this.reactions, (item: Reaction) => item.serialize(writer));
+        if (this.counts.length)
+            writer.writeRepeatedMessage(2, this.counts, (item: ReactionCount) => item.serialize(writer));
+        if (!w)
+            return writer.getResultBuffer();
+    }
+    static deserialize(bytes: Uint8Array | pb_1.BinaryReader): ListReactionsResponse {
+        const reader = bytes instanceof pb_1.BinaryReader ? bytes : new pb_1.BinaryReader(bytes), message = new ListReactionsResponse();
+        while (reader.nextField()) {
+            if (reader.isEndGroup())
+                break;
+            switch (reader.getFieldNumber()) {
+                case 1:
+                    reader.readMessage(message.reactions, () => pb_1.Message.addToRepeatedWrapperField(message, 1, Reaction.deserialize(reader), Reaction));
+                    break;
+                case 2:
+                    reader.readMessage(message.counts, () => pb_1.Message.addToRepeatedWrapperField(message, 2, ReactionCount.deserialize(reader), ReactionCount));
+                    break;
+                default: reader.skipField();
+            }
+        }
+        return message;
+    }
+    serializeBinary(): Uint8Array {
+        return this.serialize();
+    }
+    static deserializeBinary(bytes: Uint8Array): ListReactionsResponse {
+        return ListReactionsResponse.deserialize(bytes);
+    }
+}
+export class FilterVideoViewersRequest extends pb_1.Message {
+    #one_of_decls: number[][] = [];
+    constructor(data?: any[] | {
+        video_id?: string;
+        user_ids?: string[];
+    }) {
+        super();
+        pb_1.Message.initialize(this, Array.isArray(data) ? data : [], 0, -1, [2], this.#one_of_decls);
+        if (!Array.isArray(data) && typeof data == "object") {
+            if ("video_id" in data && data.video_id != undefined) {
+                this.video_id = data.video_id;
+            }
+            if ("user_ids" in data && data.user_ids != undefined) {
+                this.user_ids = data.user_ids;
+            }
+        }
+    }
+    get video_id() {
+        return pb_1.Message.getFieldWithDefault(this, 1, "") as string;
+    }
+    set video_id(value: string) {
+        pb_1.Message.setField(this, 1, value);
+    }
+    get user_ids() {
+        return pb_1.Message.getFieldWithDefault(this, 2, []) as string[];
+    }
+    set user_ids(value: string[]) {
+        pb_1.Message.setField(this, 2, value);
+    }
+    static fromObject(data: {
+        video_id?: string;
+        user_ids?: string[];
+    }): FilterVideoViewersRequest {
+        const message = new FilterVideoViewersRequest({});
+        if (data.video_id != null) {
+            message.video_id = data.video_id;
+        }
+        if (data.user_ids != null) {
+            message.user_ids = data.user_ids;
+        }
+        return message;
+    }
+    toObject() {
+        const data: {
+            video_id?: string;
+            user_ids?: string[];
+        } = {};
+        if (this.video_id != null) {
+            data.video_id = this.video_id;
+        }
+        if (this.user_ids != null) {
+            data.user_ids = this.user_ids;
+        }
+        return data;
+    }
+    serialize(): Uint8Array;
+    serialize(w: pb_1.BinaryWriter): void;
+    serialize(w?: pb_1.BinaryWriter): Uint8Array | void {
+        const writer = w || new pb_1.BinaryWriter();
+        if (this.video_id.length)
+            writer.writeString(1, this.video_id);
+        if (this.user_ids.length)
+            writer.writeRepeatedString(2, this.user_ids);
+        if (!w)
+            return writer.getResultBuffer();
+    }
+    static deserialize(bytes: Uint8Array | pb_1.BinaryReader): FilterVideoViewersRequest {
+        const reader = bytes instanceof pb_1.BinaryReader ? bytes : new pb_1.BinaryReader(bytes), message = new FilterVideoViewersRequest();
+        while (reader.nextField()) {
+            if (reader.isEndGroup())
+                break;
+            switch (reader.getFieldNumber()) {
+                case 1:
+                    message.video_id = reader.readString();
+                    break;
+                case 2:
+                    pb_1.Message.addToRepeatedField(message, 2, reader.readString());
+                    break;
+                default: reader.skipField();
+            }
+        }
+        return message;
+    }
+    serializeBinary(): Uint8Array {
+        return this.serialize();
+    }
+    static deserializeBinary(bytes: Uint8Array): FilterVideoViewersRequest {
+        return FilterVideoViewersRequest.deserialize(bytes);
+    }
+}
+export class FilterVideoViewersResponse extends pb_1.Message {
+    #one_of_decls: number[][] = [];
+    constructor(data?: any[] | {
+        user_ids?: string[];
+    }) {
+        super();
+        pb_1.Message.initialize(this, Array.isArray(data) ? data : [], 0, -1, [1], this.#one_of_decls);
+        if (!Array.isArray(data) && typeof data == "object") {
+            if ("user_ids" in data && data.user_ids != undefined) {
+                this.user_ids = data.user_ids;
+            }
+        }
+    }
+    get user_ids() {
+        return pb_1.Message.getFieldWithDefault(this, 1, []) as string[];
+    }
+    set user_ids(value: string[]) {
+        pb_1.Message.setField(this, 1, value);
+    }
+    static fromObject(data: {
+        user_ids?: string[];
+    }): FilterVideoViewersResponse {
+        const message = new FilterVideoViewersResponse({});
+        if (data.user_ids != null) {
+            message.user_ids = data.user_ids;
+        }
+        return message;
+    }
+    toObject() {
+        const data: {
+            user_ids?: string[];
+        } = {};
+        if (this.user_ids != null) {
+            data.user_ids = this.user_ids;
+        }
+        return data;
+    }
+    serialize(): Uint8Array;
+    serialize(w: pb_1.BinaryWriter): void;
+    serialize(w?: pb_1.BinaryWriter): Uint8Array | void {
+        const writer = w || new pb_1.BinaryWriter();
+        if (this.user_ids.length)
+            writer.writeRepeatedString(1, this.user_ids);
+        if (!w)
+            return writer.getResultBuffer();
+    }
+    static deserialize(bytes: Uint8Array | pb_1.BinaryReader): FilterVideoViewersResponse {
+        const reader = bytes instanceof pb_1.BinaryReader ? bytes : new pb_1.BinaryReader(bytes), message = new FilterVideoViewersResponse();
+        while (reader.nextField()) {
+            if (reader.isEndGroup())
+                break;
+            switch (reader.getFieldNumber()) {
+                case 1:
+                    pb_1.Message.addToRepeatedField(message, 1, reader.readString());
+                    break;
+                default: reader.skipField();
+            }
+        }
+        return message;
+    }
+    serializeBinary(): Uint8Array {
+        return this.serialize();
+    }
+    static deserializeBinary(bytes: Uint8Array): FilterVideoViewersResponse {
+        return FilterVideoViewersResponse.deserialize(bytes);
+    }
+}
+export class Channel extends pb_1.Message {
+    #one_of_decls: number[][] = [];
+    constructor(data?: any[] | {
+        id?: string;
+        tenant_id?: string;
+        name?: string;
+        description?: string;
+        created_by?: string;
+        created_at?: dependency_1.Timestamp;
+        updated_at?: dependency_1.Timestamp;
+        user_role?: string;
+        member_count?: number;
+        video_count?: number;
+        archived_at?: dependency_1.Timestamp;
+        privacy?: ChannelPrivacy;
+        default_role?: string;
+        access_requested?: boolean;
+    }) {
+        super();
+        pb_1.Message.initialize(this, Array.isArray(data) ? data : [], 0, -1, [], this.#one_of_decls);
+        if (!Array.isArray(data) && typeof data == "object") {
+            if ("id" in data && data.id != undefined) {
+                this.id = data.id;
+            }
+            if ("tenant_id" in data && data.tenant_id != undefined) {
+                this.tenant_id = data.tenant_id;
+            }
+            if ("name" in data && data.name != undefined) {
+                this.name = data.name;
+            }
+            if ("description" in data && data.description != undefined) {
+                this.description = data.description;
+            }
+            if ("created_by" in data && data.created_by != undefined) {
+                this.created_by = data.created_by;
+            }
+            if ("created_at" in data && data.created_at != undefined) {
+                this.created_at = data.created_at;
+            }
+            if ("updated_at" in data && data.updated_at != undefined) {
+                this.updated_at = data.updated_at;
+            }
+            if ("user_role" in data && data.user_role != undefined) {
+                this.user_role = data.user_role;
+            }
+            if ("member_count" in data && data.member_count != undefined) {
+                this.member_count = data.member_count;
+            }
+            if ("video_count" in data && data.video_count != undefined) {
+                this.video_count = data.video_count;
+            }
+            if ("archived_at" in data && data.archived_at != undefined) {
+                this.archived_at = data.archived_at;
+            }
+            if ("privacy" in data && data.privacy != undefined) {
+                this.privacy = data.privacy;
+            }
+            if ("default_role" in data && data.default_role != undefined) {
+                this.default_role = data.default_role;
+            }
+            if ("access_requested" in data && data.access_requested != undefined) {
+                this.access_requested = data.access_requested;
+            }
+        }
+    }
+    get id() {
+        return pb_1.Message.getFieldWithDefault(this, 1, "") as string;
+    }
+    set id(value: string) {
+        pb_1.Message.setField(this, 1, value);
+    }
+    get tenant_id() {
+        return pb_1.Message.getFieldWithDefault(this, 2, "") as string;
+    }
+    set tenant_id(value: string) {
+        pb_1.Message.setField(this, 2, value);
+    }
+    get name() {
+        return pb_1.Message.getFieldWithDefault(this, 3, "") as string;
+    }
+    set name(value: string) {
+        pb_1.Message.setField(this, 3, value);
+    }
+    get description() {
+        return pb_1.Message.getFieldWithDefault(this, 4, "") as string;
+    }
+    set description(value: string) {
+        pb_1.Message.setField(this, 4, value);
+    }
+    get created_by() {
+        return pb_1.Message.getFieldWithDefault(this, 6, "") as string;
+    }
+    set created_by(value: string) {
+        pb_1.Message.setField(this, 6, value);
+    }
+    get created_at() {
+        return pb_1.Message.getWrapperField(this, dependency_1.Timestamp, 7) as dependency_1.Timestamp;
+    }
+    set created_at(value: dependency_1.Timestamp) {
+        pb_1.Message.setWrapperField(this, 7, value);
+    }
+    get has_created_at() {
+        return pb_1.Message.getField(this, 7) != null;
+    }
+    get updated_at() {
+        return pb_1.Message.getWrapperField(this, dependency_1.Timestamp, 8) as dependency_1.Timestamp;
+    }
+    set updated_at(value: dependency_1.Timestamp) {
+        pb_1.Message.setWrapperField(this, 8, value);
+    }
+    get has_updated_at() {
+        return pb_1.Message.getField(this, 8) != null;
+    }
+    get user_role() {
+        return pb_1.Message.getFieldWithDefault(this, 9, "") as string;
+    }
+    set user_role(value: string) {
+        pb_1.Message.setField(this, 9, value);
+    }
+    get member_count() {
+        return pb_1.Message.getFieldWithDefault(this, 10, 0) as number;
+    }
+    set member_count(value: number) {
+        pb_1.Message.setField(this, 10, value);
+    }
+    get video_count() {
+        return pb_1.Message.getFieldWithDefault(this, 11, 0) as number;
+    }
+    set video_count(value: number) {
+        pb_1.Message.setField(this, 11, value);
+    }
+    get archived_at() {
+        return pb_1.Message.getWrapperField(this, dependency_1.Timestamp, 12) as dependency_1.Timestamp;
+    }
+    set archived_at(value: dependency_1.Timestamp) {
+        pb_1.Message.setWrapperField(this, 12, value);
+    }
+    get has_archived_at() {
+        return pb_1.Message.getField(this, 12) != null;
+    }
+    get privacy() {
+        return pb_1.Message.getFieldWithDefault(this, 13, ChannelPrivacy.CHANNEL_PRIVACY_CLOSED) as ChannelPrivacy;
+    }
+    set privacy(value: ChannelPrivacy) {
+        pb_1.Message.setField(this, 13, value);
+    }
+    get default_role() {
+        return pb_1.Message.getFieldWithDefault(this, 14, "") as string;
+    }
+    set default_role(value: string) {
+        pb_1.Message.setField(this, 14, value);
+    }
+    get access_requested() {
+        return pb_1.Message.getFieldWithDefault(this, 15, false) as boolean;
+    }
+    set access_requested(value: boolean) {
+        pb_1.Message.setField(this, 15, value);
+    }
+    static fromObject(data: {
+        id?: string;
+        tenant_id?: string;
+        name?: string;
+        description?: string;
+        created_by?: string;
+        created_at?: ReturnType<typeof dependency_1.Timestamp.prototype.toObject>;
+        updated_at?: ReturnType<typeof dependency_1.Timestamp.prototype.toObject>;
+        user_role?: string;
+        member_count?: number;
+        video_count?: number;
+        archived_at?: ReturnType<typeof dependency_1.Timestamp.prototype.toObject>;
+        privacy?: ChannelPrivacy;
+        default_role?: string;
+        access_requested?: boolean;
+    }): Channel {
+        const message = new Channel({});
+        if (data.id != null) {
+            message.id = data.id;
+        }
         if (data.tenant_id != null) {
             message.tenant_id = data.tenant_id;
         }
-        if (data.name != null) {
-            message.name = data.name;
+        if (data.name != null) {
+            message.name = data.name;
+        }
+        if (data.description != null) {
+            message.description = data.description;
+        }
+        if (data.created_by != null) {
+            message.created_by = data.created_by;
+        }
+        if (data.created_at != null) {
+            message.created_at = dependency_1.Timestamp.fromObject(data.created_at);
+        }
+        if (data.updated_at != null) {
+            message.updated_at = dependency_1.Timestamp.fromObject(data.updated_at);
+        }
+        if (data.user_role != null) {
+            message.user_role = data.user_role;
+        }
+        if (data.member_count != null) {
+            message.member_count = data.member_count;
+        }
+        if (data.video_count != null) {
+            message.video_count = data.video_count;
+        }
+        if (data.archived_at != null) {
+            message.archived_at = dependency_1.Timestamp.fromObject(data.archived_at);
+        }
+        if (data.privacy != null) {
+            message.privacy = data.privacy;
+        }
+        if (data.default_role != null) {
+            message.default_role = data.default_role;
+        }
+        if (data.access_requested != null) {
+            message.access_requested = data.access_requested;
+        }
+        return message;
+    }
+    toObject() {
+        const data: {
+            id?: string;
+            tenant_id?: string;
+            name?: string;
+            description?: string;
+            created_by?: string;
+            created_at?: ReturnType<typeof dependency_1.Timestamp.prototype.toObject>;
+            updated_at?: ReturnType<typeof dependency_1.Timestamp.prototype.toObject>;
+            user_role?: string;
+            member_count?: number;
+            video_count?: number;
+            archived_at?: ReturnType<typeof dependency_1.Timestamp.prototype.toObject>;
+            privacy?: ChannelPrivacy;
+            default_role?: string;
+            access_requested?: boolean;
+        } = {};
+        if (this.id != null) {
+            data.id = this.id;
+        }
+        if (this.tenant_id != null) {
+            data.tenant_id = this.tenant_id;
+        }
+        if (this.name != null) {
+            data.name = this.name;
+        }
+        if (this.description != null) {
+            data.description = this.description;
+        }
+        if (this.created_by != null) {
+            data.created_by = this.created_by;
+        }
+        if (this.created_at != null) {
+            data.created_at = this.created_at.toObject();
+        }
+        if (this.updated_at != null) {
+            data.updated_at = this.updated_at.toObject();
+        }
+        if (this.user_role != null) {
+            data.user_role = this.user_role;
+        }
+        if (this.member_count != null) {
+            data.member_count = this.member_count;
+        }
+        if (this.video_count != null) {
+            data.video_count = this.video_count;
+        }
+        if (this.archived_at != null) {
+            data.archived_at = this.archived_at.toObject();
+        }
+        if (this.privacy != null) {
+            data.privacy = this.privacy;
+        }
+        if (this.default_role != null) {
+            data.default_role = this.default_role;
+        }
+        if (this.access_requested != null) {
+            data.access_requested = this.access_requested;
+        }
+        return data;
+    }
+    serialize(): Uint8Array;
+    serialize(w: pb_1.BinaryWriter): void;
+    serialize(w?: pb_1.BinaryWriter): Uint8Array | void {
+        const writer = w || new pb_1.BinaryWriter();
+        if (this.id.length)
+            writer.writeString(1, this.id);
+        if (this.tenant_id.length)
+            writer.writeString(2, this.tenant_id);
+        if (this.name.length)
+            writer.writeString(3, this.name);
+        if (this.description.length)
+            writer.writeString(4, this.description);
+        if (this.created_by.length)
+            writer.writeString(6, this.created_by);
+        if (this.has_created_at)
+            writer.writeMessage(7, this.created_at, () => this.created_at.serialize(writer));
+        if (this.has_updated_at)
+            writer.writeMessage(8, this.updated_at, () => this.updated_at.serialize(writer));
+        if (this.user_role.length)
+            writer.writeString(9, this.user_role);
+        if (this.member_count != 0)
+            writer.writeInt32(10, this.member_count);
+        if (this.video_count != 0)
+            writer.writeInt32(11, this.video_count);
+        if (this.has_archived_at)
+            writer.writeMessage(12, this.archived_at, () => this.archived_at.serialize(writer));
+        if (this.privacy != ChannelPrivacy.CHANNEL_PRIVACY_CLOSED)
+            writer.writeEnum(13, this.privacy);
+        if (this.default_role.length)
+            writer.writeString(14, this.default_role);
+        if (this.access_requested != false)
+            writer.writeBool(15, this.access_requested);
+        if (!w)
+            return writer.getResultBuffer();
+    }
+    static deserialize(bytes: Uint8Array | pb_1.BinaryReader): Channel {
+        const reader = bytes instanceof pb_1.BinaryReader ? bytes : new pb_1.BinaryReader(bytes), message = new Channel();
+        while (reader.nextField()) {
+            if (reader.isEndGroup())
+                break;
+            switch (reader.getFieldNumber()) {
+                case 1:
+                    message.id = reader.readString();
+                    break;
+                case 2:
+                    message.tenant_id = reader.readString();
+                    break;
+                case 3:
+                    message.name = reader.readString();
+                    break;
+                case 4:
+                    message.description = reader.readString();
+                    break;
+                case 6:
+                    message.created_by = reader.readString();
+                    break;
+                case 7:
+                    reader.readMessage(message.created_at, () => message.created_at = dependency_1.Timestamp.deserialize(reader));
+                    break;
+                case 8:
+                    reader.readMessage(message.updated_at, () => message.updated_at = dependency_1.Timestamp.deserialize(reader));
+                    break;
+                case 9:
+                    message.user_role = reader.readString();
+                    break;
+                case 10:
+                    message.member_count = reader.readInt32();
+                    break;
+                case 11:
+                    message.video_count = reader.readInt32();
+                    break;
+                case 12:
+                    reader.readMessage(message.archived_at, () => message.archived_at = dependency_1.Timestamp.deserialize(reader));
+                    break;
+                case 13:
+                    message.privacy = reader.readEnum();
+                    break;
+                case 14:
+                    message.default_role = reader.readString();
+                    break;
+                case 15:
+                    message.access_requested = reader.readBool();
+                    break;
+                default: reader.skipField();
+            }
+        }
+        return message;
+    }
+    serializeBinary(): Uint8Array {
+        return this.serialize();
+    }
+    static deserializeBinary(bytes: Uint8Array): Channel {
+        return Channel.deserialize(bytes);
+    }
+}
+export class ChannelMember extends pb_1.Message {
+    #one_of_decls: number[][] = [];
+    constructor(data?: any[] | {
+        user?: dependency_2.User;
+        role?: string;
+        added_by?: string;
+        created_at?: dependency_1.Timestamp;
+        role_updated_by?: string;
+        role_updated_at?: dependency_1.Timestamp;
+    }) {
+        super();
+        pb_1.Message.initialize(this, Array.isArray(data) ? data : [], 0, -1, [], this.#one_of_decls);
+        if (!Array.isArray(data) && typeof data == "object") {
+            if ("user" in data && data.user != undefined) {
+                this.user = data.user;
+            }
+            if ("role" in data && data.role != undefined) {
+                this.role = data.role;
+            }
+            if ("added_by" in data && data.added_by != undefined) {
+                this.added_by = data.added_by;
+            }
+            if ("created_at" in data && data.created_at != undefined) {
+                this.created_at = data.created_at;
+            }
+            if ("role_updated_by" in data && data.role_updated_by != undefined) {
+                this.role_updated_by = data.role_updated_by;
+            }
+            if ("role_updated_at" in data && data.role_updated_at != undefined) {
+                this.role_updated_at = data.role_updated_at;
+            }
+        }
+    }
+    get user() {
+        return pb_1.Message.getWrapperField(this, dependency_2.User, 1) as dependency_2.User;
+    }
+    set user(value: dependency_2.User) {
+        pb_1.Message.setWrapperField(this, 1, value);
+    }
+    get has_user() {
+        return pb_1.Message.getField(this, 1) != null;
+    }
+    get role() {
+        return pb_1.Message.getFieldWithDefault(this, 2, "") as string;
+    }
+    set role(value: string) {
+        pb_1.Message.setField(this, 2, value);
+    }
+    get added_by() {
+        return pb_1.Message.getFieldWithDefault(this, 3, "") as string;
+    }
+    set added_by(value: string) {
+        pb_1.Message.setField(this, 3, value);
+    }
+    get created_at() {
+        return pb_1.Message.getWrapperField(this, dependency_1.Timestamp, 4) as dependency_1.Timestamp;
+    }
+    set created_at(value: dependency_1.Timestamp) {
+        pb_1.Message.setWrapperField(this, 4, value);
+    }
+    get has_created_at() {
+        return pb_1.Message.getField(this, 4) != null;
+    }
+    get role_updated_by() {
+        return pb_1.Message.getFieldWithDefault(this, 5, "") as string;
+    }
+    set role_updated_by(value: string) {
+        pb_1.Message.setField(this, 5, value);
+    }
+    get role_updated_at() {
+        return pb_1.Message.getWrapperField(this, dependency_1.Timestamp, 6) as dependency_1.Timestamp;
+    }
+    set role_updated_at(value: dependency_1.Timestamp) {
+        pb_1.Message.setWrapperField(this, 6, value);
+    }
+    get has_role_updated_at() {
+        return pb_1.Message.getField(this, 6) != null;
+    }
+    static fromObject(data: {
+        user?: ReturnType<typeof dependency_2.User.prototype.toObject>;
+        role?: string;
+        added_by?: string;
+        created_at?: ReturnType<typeof dependency_1.Timestamp.prototype.toObject>;
+        role_updated_by?: string;
+        role_updated_at?: ReturnType<typeof dependency_1.Timestamp.prototype.toObject>;
+    }): ChannelMember {
+        const message = new ChannelMember({});
+        if (data.user != null) {
+            message.user = dependency_2.User.fromObject(data.user);
+        }
+        if (data.role != null) {
+            message.role = data.role;
+        }
+        if (data.added_by != null) {
+            message.added_by = data.added_by;
+        }
+        if (data.created_at != null) {
+            message.created_at = dependency_1.Timestamp.fromObject(data.created_at);
+        }
+        if (data.role_updated_by != null) {
+            message.role_updated_by = data.role_updated_by;
+        }
+        if (data.role_updated_at != null) {
+            message.role_updated_at = dependency_1.Timestamp.fromObject(data.role_updated_at);
+        }
+        return message;
+    }
+    toObject() {
+        const data: {
+            user?: ReturnType<typeof dependency_2.User.prototype.toObject>;
+            role?: string;
+            added_by?: string;
+            created_at?: ReturnType<typeof dependency_1.Timestamp.prototype.toObject>;
+            role_updated_by?: string;
+            role_updated_at?: ReturnType<typeof dependency_1.Timestamp.prototype.toObject>;
+        } = {};
+        if (this.user != null) {
+            data.user = this.user.toObject();
+        }
+        if (this.role != null) {
+            data.role = this.role;
+        }
+        if (this.added_by != null) {
+            data.added_by = this.added_by;
+        }
+        if (this.created_at != null) {
+            data.created_at = this.created_at.toObject();
+        }
+        if (this.role_updated_by != null) {
+            data.role_updated_by = this.role_updated_by;
+        }
+        if (this.role_updated_at != null) {
+            data.role_updated_at = this.role_updated_at.toObject();
+        }
+        return data;
+    }
+    serialize(): Uint8Array;
+    serialize(w: pb_1.BinaryWriter): void;
+    serialize(w?: pb_1.BinaryWriter): Uint8Array | void {
+        const writer = w || new pb_1.BinaryWriter();
+        if (this.has_user)
+            writer.writeMessage(1, this.user, () => this.user.serialize(writer));
+        if (this.role.length)
+            writer.writeString(2, this.role);
+        if (this.added_by.length)
+            writer.writeString(3, this.added_by);
+        if (this.has_created_at)
+            writer.writeMessage(4, this.created_at, () => this.created_at.serialize(writer));
+        if (this.role_updated_by.length)
+            writer.writeString(5, this.role_updated_by);
+        if (this.has_role_updated_at)
+            writer.writeMessage(6, this.role_updated_at, () => this.role_updated_at.serialize(writer));
+        if (!w)
+            return writer.getResultBuffer();
+    }
+    static deserialize(bytes: Uint8Array | pb_1.BinaryReader): ChannelMember {
+        const reader = bytes instanceof pb_1.BinaryReader ? bytes : new pb_1.BinaryReader(bytes), message = new ChannelMember();
+        while (reader.nextField()) {
+            if (reader.isEndGroup())
+                break;
+            switch (reader.getFieldNumber()) {
+                case 1:
+                    reader.readMessage(message.user, () => message.user = dependency_2.User.deserialize(reader));
+                    break;
+                case 2:
+                    message.role = reader.readString();
+                    break;
+                case 3:
+                    message.added_by = reader.readString();
+                    break;
+                case 4:
+                    reader.readMessage(message.created_at, () => message.created_at = dependency_1.Timestamp.deserialize(reader));
+                    break;
+                case 5:
+                    message.role_updated_by = reader.readString();
+                    break;
+                case 6:
+                    reader.readMessage(message.role_updated_at, () => message.role_updated_at = dependency_1.Timestamp.deserialize(reader));
+                    break;
+                default: reader.skipField();
+            }
+        }
+        return message;
+    }
+    serializeBinary(): Uint8Array {
+        return this.serialize();
+    }
+    static deserializeBinary(bytes: Uint8Array): ChannelMember {
+        return ChannelMember.deserialize(bytes);
+    }
+}
+export class CreateChannelRequest extends pb_1.Message {
+    #one_of_decls: number[][] = [];
+    constructor(data?: any[] | {
+        name?: string;
+        description?: string;
+        privacy?: ChannelPrivacy;
+        default_role?: string;
+    }) {
+        super();
+        pb_1.Message.initialize(this, Array.isArray(data) ? data : [], 0, -1, [], this.#one_of_decls);
+        if (!Array.isArray(data) && typeof data == "object") {
+            if ("name" in data && data.name != undefined) {
+                this.name = data.name;
+            }
+            if ("description" in data && data.description != undefined) {
+                this.description = data.description;
+            }
+            if ("privacy" in data && data.privacy != undefined) {
+                this.privacy = data.privacy;
+            }
+            if ("default_role" in data && data.default_role != undefined) {
+                this.default_role = data.default_role;
+            }
+        }
+    }
+    get name() {
+        return pb_1.Message.getFieldWithDefault(this, 2, "") as string;
+    }
+    set name(value: string) {
+        pb_1.Message.setField(this, 2, value);
+    }
+    get description() {
+        return pb_1.Message.getFieldWithDefault(this, 3, "") as string;
+    }
+    set description(value: string) {
+        pb_1.Message.setField(this, 3, value);
+    }
+    get privacy() {
+        return pb_1.Message.getFieldWithDefault(this, 4, ChannelPrivacy.CHANNEL_PRIVACY_CLOSED) as ChannelPrivacy;
+    }
+    set privacy(value: ChannelPrivacy) {
+        pb_1.Message.setField(this, 4, value);
+    }
+    get default_role() {
+        return pb_1.Message.getFieldWithDefault(this, 5, "") as string;
+    }
+    set default_role(value: string) {
+        pb_1.Message.setField(this, 5, value);
+    }
+    static fromObject(data: {
+        name?: string;
+        description?: string;
+        privacy?: ChannelPrivacy;
+        default_role?: string;
+    }): CreateChannelRequest {
+        const message = new CreateChannelRequest({});
+        if (data.name != null) {
+            message.name = data.name;
+        }
+        if (data.description != null) {
+            message.description = data.description;
+        }
+        if (data.privacy != null) {
+            message.privacy = data.privacy;
+        }
+        if (data.default_role != null) {
+            message.default_role = data.default_role;
+        }
+        return message;
+    }
+    toObject() {
+        const data: {
+            name?: string;
+            description?: string;
+            privacy?: ChannelPrivacy;
+            default_role?: string;
+        } = {};
+        if (this.name != null) {
+            data.name = this.name;
+        }
+        if (this.description != null) {
+            data.description = this.description;
+        }
+        if (this.privacy != null) {
+            data.privacy = this.privacy;
+        }
+        if (this.default_role != null) {
+            data.default_role = this.default_role;
+        }
+        return data;
+    }
+    serialize(): Uint8Array;
+    serialize(w: pb_1.BinaryWriter): void;
+    serialize(w?: pb_1.BinaryWriter): Uint8Array | void {
+        const writer = w || new pb_1.BinaryWriter();
+        if (this.name.length)
+            writer.writeString(2, this.name);
+        if (this.description.length)
+            writer.writeString(3, this.description);
+        if (this.privacy != ChannelPrivacy.CHANNEL_PRIVACY_CLOSED)
+            writer.writeEnum(4, this.privacy);
+        if (this.default_role.length)
+            writer.writeString(5, this.default_role);
+        if (!w)
+            return writer.getResultBuffer();
+    }
+    static deserialize(bytes: Uint8Array | pb_1.BinaryReader): CreateChannelRequest {
+        const reader = bytes instanceof pb_1.BinaryReader ? bytes : new pb_1.BinaryReader(bytes), message = new CreateChannelRequest();
+        while (reader.nextField()) {
+            if (reader.isEndGroup())
+                break;
+            switch (reader.getFieldNumber()) {
+                case 2:
+                    message.name = reader.readString();
+                    break;
+                case 3:
+                    message.description = reader.readString();
+                    break;
+                case 4:
+                    message.privacy = reader.readEnum();
+                    break;
+                case 5:
+                    message.default_role = reader.readString();
+                    break;
+                default: reader.skipField();
+            }
+        }
+        return message;
+    }
+    serializeBinary(): Uint8Array {
+        return this.serialize();
+    }
+    static deserializeBinary(bytes: Uint8Array): CreateChannelRequest {
+        return CreateChannelRequest.deserialize(bytes);
+    }
+}
+export class CreateChannelResponse extends pb_1.Message {
+    #one_of_decls: number[][] = [];
+    constructor(data?: any[] | {
+        message?: string;
+        channel?: Channel;
+    }) {
+        super();
+        pb_1.Message.initialize(this, Array.isArray(data) ? data : [], 0, -1, [], this.#one_of_decls);
+        if (!Array.isArray(data) && typeof data == "object") {
+            if ("message" in data && data.message != undefined) {
+                this.message = data.message;
+            }
+            if ("channel" in data && data.channel != undefined) {
+                this.channel = data.channel;
+            }
+        }
+    }
+    get message() {
+        return pb_1.Message.getFieldWithDefault(this, 1, "") as string;
+    }
+    set message(value: string) {
+        pb_1.Message.setField(this, 1, value);
+    }
+    get channel() {
+        return pb_1.Message.getWrapperField(this, Channel, 2) as Channel;
+    }
+    set channel(value: Channel) {
+        pb_1.Message.setWrapperField(this, 2, value);
+    }
+    get has_channel() {
+        return pb_1.Message.getField(this, 2) != null;
+    }
+    static fromObject(data: {
+        message?: string;
+        channel?: ReturnType<typeof Channel.prototype.toObject>;
+    }): CreateChannelResponse {
+        const message = new CreateChannelResponse({});
+        if (data.message != null) {
+            message.message = data.message;
+        }
+        if (data.channel != null) {
+            message.channel = Channel.fromObject(data.channel);
+        }
+        return message;
+    }
+    toObject() {
+        const data: {
+            message?: string;
+            channel?: ReturnType<typeof Channel.prototype.toObject>;
+        } = {};
+        if (this.message != null) {
+            data.message = this.message;
+        }
+        if (this.channel != null) {
+            data.channel = this.channel.toObject();
+        }
+        return data;
+    }
+    serialize(): Uint8Array;
+    serialize(w: pb_1.BinaryWriter): void;
+    serialize(w?: pb_1.BinaryWriter): Uint8Array | void {
+        const writer = w || new pb_1.BinaryWriter();
+        if (this.message.length)
+            writer.writeString(1, this.message);
+        if (this.has_channel)
+            writer.writeMessage(2, this.channel, () => this.channel.serialize(writer));
+        if (!w)
+            return writer.getResultBuffer();
+    }
+    static deserialize(bytes: Uint8Array | pb_1.BinaryReader): CreateChannelResponse {
+        const reader = bytes instanceof pb_1.BinaryReader ? bytes : new pb_1.BinaryReader(bytes), message = new CreateChannelResponse();
+        while (reader.nextField()) {
+            if (reader.isEndGroup())
+                break;
+            switch (reader.getFieldNumber()) {
+                case 1:
+                    message.message = reader.readString();
+                    break;
+                case 2:
+                    reader.readMessage(message.channel, () => message.channel = Channel.deserialize(reader));
+                    break;
+                default: reader.skipField();
+            }
+        }
+        return message;
+    }
+    serializeBinary(): Uint8Array {
+        return this.serialize();
+    }
+    static deserializeBinary(bytes: Uint8Array): CreateChannelResponse {
+        return CreateChannelResponse.deserialize(bytes);
+    }
+}
+export class UpdateChannelRequest extends pb_1.Message {
+    #one_of_decls: number[][] = [[5], [6]];
+    constructor(data?: any[] | ({
+        channel_id?: string;
+        name?: string;
+        description?: string;
+    } & (({
+        privacy?: ChannelPrivacy;
+    })) & (({
+        default_role?: string;
+    })))) {
+        super();
+        pb_1.Message.initialize(this, Array.isArray(data) ? data : [], 0, -1, [], this.#one_of_decls);
+        if (!Array.isArray(data) && typeof data == "object") {
+            if ("channel_id" in data && data.channel_id != undefined) {
+                this.channel_id = data.channel_id;
+            }
+            if ("name" in data && data.name != undefined) {
+                this.name = data.name;
+            }
+            if ("description" in data && data.description != undefined) {
+                this.description = data.description;
+            }
+            if ("privacy" in data && data.privacy != undefined) {
+                this.privacy = data.privacy;
+            }
+            if ("default_role" in data && data.default_role != undefined) {
+                this.default_role = data.default_role;
+            }
+        }
+    }
+    get channel_id() {
+        return pb_1.Message.getFieldWithDefault(this, 2, "") as string;
+    }
+    set channel_id(value: string) {
+        pb_1.Message.setField(this, 2, value);
+    }
+    get name() {
+        return pb_1.Message.getFieldWithDefault(this, 3, "") as string;
+    }
+    set name(value: string) {
+        pb_1.Message.setField(this, 3, value);
+    }
+    get description() {
+        return pb_1.Message.getFieldWithDefault(this, 4, "") as string;
+    }
+    set description(value: string) {
+        pb_1.Message.setField(this, 4, value);
+    }
+    get privacy() {
+        return pb_1.Message.getFieldWithDefault(this, 5, ChannelPrivacy.CHANNEL_PRIVACY_CLOSED) as ChannelPrivacy;
+    }
+    set privacy(value: ChannelPrivacy) {
+        pb_1.Message.setOneofField(this, 5, this.#one_of_decls[0], value);
+    }
+    get has_privacy() {
+        return pb_1.Message.getField(this, 5) != null;
+    }
+    get default_role() {
+        return pb_1.Message.getFieldWithDefault(this, 6, "") as string;
+    }
+    set default_role(value: string) {
+        pb_1.Message.setOneofField(this, 6, this.#one_of_decls[1], value);
+    }
+    get has_default_role() {
+        return pb_1.Message.getField(this, 6) != null;
+    }
+    get _privacy() {
+        const cases: {
+            [index: number]: "none" | "privacy";
+        } = {
+            0: "none",
+            5: "privacy"
+        };
+        return cases[pb_1.Message.computeOneofCase(this, [5])];
+    }
+    get _default_role() {
+        const cases: {
+            [index: number]: "none" | "default_role";
+        } = {
+            0: "none",
+            6: "default_role"
+        };
+        return cases[pb_1.Message.computeOneofCase(this, [6])];
+    }
+    static fromObject(data: {
+        channel_id?: string;
+        name?: string;
+        description?: string;
+        privacy?: ChannelPrivacy;
+        default_role?: string;
+    }): UpdateChannelRequest {
+        const message = new UpdateChannelRequest({});
+        if (data.channel_id != null) {
+            message.channel_id = data.channel_id;
+        }
+        if (data.name != null) {
+            message.name = data.name;
+        }
+        if (data.description != null) {
+            message.description = data.description;
+        }
+        if (data.privacy != null) {
+            message.privacy = data.privacy;
+        }
+        if (data.default_role != null) {
+            message.default_role = data.default_role;
+        }
+        return message;
+    }
+    toObject() {
+        const data: {
+            channel_id?: string;
+            name?: string;
+            description?: string;
+            privacy?: ChannelPrivacy;
+            default_role?: string;
+        } = {};
+        if (this.channel_id != null) {
+            data.channel_id = this.channel_id;
+        }
+        if (this.name != null) {
+            data.name = this.name;
+        }
+        if (this.description != null) {
+            data.description = this.description;
+        }
+        if (this.privacy != null) {
+            data.privacy = this.privacy;
+        }
+        if (this.default_role != null) {
+            data.default_role = this.default_role;
+        }
+        return data;
+    }
+    serialize(): Uint8Array;
+    serialize(w: pb_1.BinaryWriter): void;
+    serialize(w?: pb_1.BinaryWriter): Uint8Array | void {
+        const writer = w || new pb_1.BinaryWriter();
+        if (this.channel_id.length)
+            writer.writeString(2, this.channel_id);
+        if (this.name.length)
+            writer.writeString(3, this.name);
+        if (this.description.length)
+            writer.writeString(4, this.description);
+        if (this.has_privacy)
+            writer.writeEnum(5, this.privacy);
+        if (this.has_default_role)
+            writer.writeString(6, this.default_role);
+        if (!w)
+            return writer.getResultBuffer();
+    }
+    static deserialize(bytes: Uint8Array | pb_1.BinaryReader): UpdateChannelRequest {
+        const reader = bytes instanceof pb_1.BinaryReader ? bytes : new pb_1.BinaryReader(bytes), message = new UpdateChannelRequest();
+        while (reader.nextField()) {
+            if (reader.isEndGroup())
+                break;
+            switch (reader.getFieldNumber()) {
+                case 2:
+                    message.channel_id = reader.readString();
+                    break;
+                case 3:
+                    message.name = reader.readString();
+                    break;
+                case 4:
+                    message.description = reader.readString();
+                    break;
+                case 5:
+                    message.privacy = reader.readEnum();
+                    break;
+                case 6:
+                    message.default_role = reader.readString();
+                    break;
+                default: reader.skipField();
+            }
+        }
+        return message;
+    }
+    serializeBinary(): Uint8Array {
+        return this.serialize();
+    }
+    static deserializeBinary(bytes: Uint8Array): UpdateChannelRequest {
+        return UpdateChannelRequest.deserialize(bytes);
+    }
+}
+export class UpdateChannelResponse extends pb_1.Message {
+    #one_of_decls: number[][] = [];
+    constructor(data?: any[] | {
+        message?: string;
+        channel?: Channel;
+    }) {
+        super();
+        pb_1.Message.initialize(this, Array.isArray(data) ? data : [], 0, -1, [], this.#one_of_decls);
+        if (!Array.isArray(data) && typeof data == "object") {
+            if ("message" in data && data.message != undefined) {
+                this.message = data.message;
+            }
+            if ("channel" in data && data.channel != undefined) {
+                this.channel = data.channel;
+            }
+        }
+    }
+    get message() {
+        return pb_1.Message.getFieldWithDefault(this, 1, "") as string;
+    }
+    set message(value: string) {
+        pb_1.Message.setField(this, 1, value);
+    }
+    get channel() {
+        return pb_1.Message.getWrapperField(this, Channel, 2) as Channel;
+    }
+    set channel(value: Channel) {
+        pb_1.Message.setWrapperField(this, 2, value);
+    }
+    get has_channel() {
+        return pb_1.Message.getField(this, 2) != null;
+    }
+    static fromObject(data: {
+        message?: string;
+        channel?: ReturnType<typeof Channel.prototype.toObject>;
+    }): UpdateChannelResponse {
+        const message = new UpdateChannelResponse({});
+        if (data.message != null) {
+            message.message = data.message;
+        }
+        if (data.channel != null) {
+            message.channel = Channel.fromObject(data.channel);
+        }
+        return message;
+    }
+    toObject() {
+        const data: {
+            message?: string;
+            channel?: ReturnType<typeof Channel.prototype.toObject>;
+        } = {};
+        if (this.message != null) {
+            data.message = this.message;
+        }
+        if (this.channel != null) {
+            data.channel = this.channel.toObject();
+        }
+        return data;
+    }
+    serialize(): Uint8Array;
+    serialize(w: pb_1.BinaryWriter): void;
+    serialize(w?: pb_1.BinaryWriter): Uint8Array | void {
+        const writer = w || new pb_1.BinaryWriter();
+        if (this.message.length)
+            writer.writeString(1, this.message);
+        if (this.has_channel)
+            writer.writeMessage(2, this.channel, () => this.channel.serialize(writer));
+        if (!w)
+            return writer.getResultBuffer();
+    }
+    static deserialize(bytes: Uint8Array | pb_1.BinaryReader): UpdateChannelResponse {
+        const reader = bytes instanceof pb_1.BinaryReader ? bytes : new pb_1.BinaryReader(bytes), message = new UpdateChannelResponse();
+        while (reader.nextField()) {
+            if (reader.isEndGroup())
+                break;
+            switch (reader.getFieldNumber()) {
+                case 1:
+                    message.message = reader.readString();
+                    break;
+                case 2:
+                    reader.readMessage(message.channel, () => message.channel = Channel.deserialize(reader));
+                    break;
+                default: reader.skipField();
+            }
+        }
+        return message;
+    }
+    serializeBinary(): Uint8Array {
+        return this.serialize();
+    }
+    static deserializeBinary(bytes: Uint8Array): UpdateChannelResponse {
+        return UpdateChannelResponse.deserialize(bytes);
+    }
+}
+export class GetChannelsRequest extends pb_1.Message {
+    #one_of_decls: number[][] = [];
+    constructor(data?: any[] | {
+        page_size?: number;
+        page_token?: string;
+        include_archived?: boolean;
+    }) {
+        super();
+        pb_1.Message.initialize(this, Array.isArray(data) ? data : [], 0, -1, [], this.#one_of_decls);
+        if (!Array.isArray(data) && typeof data == "object") {
+            if ("page_size" in data && data.page_size != undefined) {
+                this.page_size = data.page_size;
+            }
+            if ("page_token" in data && data.page_token != undefined) {
+                this.page_token = data.page_token;
+            }
+            if ("include_archived" in data && data.include_archived != undefined) {
+                this.include_archived = data.include_archived;
+            }
+        }
+    }
+    get page_size() {
+        return pb_1.Message.getFieldWithDefault(this, 1, 0) as number;
+    }
+    set page_size(value: number) {
+        pb_1.Message.setField(this, 1, value);
+    }
+    get page_token() {
+        return pb_1.Message.getFieldWithDefault(this, 2, "") as string;
+    }
+    set page_token(value: string) {
+        pb_1.Message.setField(this, 2, value);
+    }
+    get include_archived() {
+        return pb_1.Message.getFieldWithDefault(this, 3, false) as boolean;
+    }
+    set include_archived(value: boolean) {
+        pb_1.Message.setField(this, 3, value);
+    }
+    static fromObject(data: {
+        page_size?: number;
+        page_token?: string;
+        include_archived?: boolean;
+    }): GetChannelsRequest {
+        const message = new GetChannelsRequest({});
+        if (data.page_size != null) {
+            message.page_size = data.page_size;
+        }
+        if (data.page_token != null) {
+            message.page_token = data.page_token;
+        }
+        if (data.include_archived != null) {
+            message.include_archived = data.include_archived;
+        }
+        return message;
+    }
+    toObject() {
+        const data: {
+            page_size?: number;
+            page_token?: string;
+            include_archived?: boolean;
+        } = {};
+        if (this.page_size != null) {
+            data.page_size = this.page_size;
+        }
+        if (this.page_token != null) {
+            data.page_token = this.page_token;
+        }
+        if (this.include_archived != null) {
+            data.include_archived = this.include_archived;
+        }
+        return data;
+    }
+    serialize(): Uint8Array;
+    serialize(w: pb_1.BinaryWriter): void;
+    serialize(w?: pb_1.BinaryWriter): Uint8Array | void {
+        const writer = w || new pb_1.BinaryWriter();
+        if (this.page_size != 0)
+            writer.writeInt32(1, this.page_size);
+        if (this.page_token.length)
+            writer.writeString(2, this.page_token);
+        if (this.include_archived != false)
+            writer.writeBool(3, this.include_archived);
+        if (!w)
+            return writer.getResultBuffer();
+    }
+    static deserialize(bytes: Uint8Array | pb_1.BinaryReader): GetChannelsRequest {
+        const reader = bytes instanceof pb_1.BinaryReader ? bytes : new pb_1.BinaryReader(bytes), message = new GetChannelsRequest();
+        while (reader.nextField()) {
+            if (reader.isEndGroup())
+                break;
+            switch (reader.getFieldNumber()) {
+                case 1:
+                    message.page_size = reader.readInt32();
+                    break;
+                case 2:
+                    message.page_token = reader.readString();
+                    break;
+                case 3:
+                    message.include_archived = reader.readBool();
+                    break;
+                default: reader.skipField();
+            }
+        }
+        return message;
+    }
+    serializeBinary(): Uint8Array {
+        return this.serialize();
+    }
+    static deserializeBinary(bytes: Uint8Array): GetChannelsRequest {
+        return GetChannelsRequest.deserialize(bytes);
+    }
+}
+export class GetChannelsResponse extends pb_1.Message {
+    #one_of_decls: number[][] = [];
+    constructor(data?: any[] | {
+        message?: string;
+        channels?: Channel[];
+        next_page_token?: string;
+        total_count?: number;
+    }) {
+        super();
+        pb_1.Message.initialize(this, Array.isArray(data) ? data : [], 0, -1, [2], this.#one_of_decls);
+        if (!Array.isArray(data) && typeof data == "object") {
+            if ("message" in data && data.message != undefined) {
+                this.message = data.message;
+            }
+            if ("channels" in data && data.channels != undefined) {
+                this.channels = data.channels;
+            }
+            if ("next_page_token" in data && data.next_page_token != undefined) {
+                this.next_page_token = data.next_page_token;
+            }
+            if ("total_count" in data && data.total_count != undefined) {
+                this.total_count = data.total_count;
+            }
+        }
+    }
+    get message() {
+        return pb_1.Message.getFieldWithDefault(this, 1, "") as string;
+    }
+    set message(value: string) {
+        pb_1.Message.setField(this, 1, value);
+    }
+    get channels() {
+        return pb_1.Message.getRepeatedWrapperField(this, Channel, 2) as Channel[];
+    }
+    set channels(value: Channel[]) {
+        pb_1.Message.setRepeatedWrapperField(this, 2, value);
+    }
+    get next_page_token() {
+        return pb_1.Message.getFieldWithDefault(this, 3, "") as string;
+    }
+    set next_page_token(value: string) {
+        pb_1.Message.setField(this, 3, value);
+    }
+    get total_count() {
+        return pb_1.Message.getFieldWithDefault(this, 4, 0) as number;
+    }
+    set total_count(value: number) {
+        pb_1.Message.setField(this, 4, value);
+    }
+    static fromObject(data: {
+        message?: string;
+        channels?: ReturnType<typeof Channel.prototype.toObject>[];
+        next_page_token?: string;
+        total_count?: number;
+    }): GetChannelsResponse {
+        const message = new GetChannelsResponse({});
+        if (data.message != null) {
+            message.message = data.message;
+        }
+        if (data.channels != null) {
+            message.channels = data.channels.map(item => Channel.fromObject(item));
+        }
+        if (data.next_page_token != null) {
+            message.next_page_token = data.next_page_token;
+        }
+        if (data.total_count != null) {
+            message.total_count = data.total_count;
+        }
+        return message;
+    }
+    toObject() {
+        const data: {
+            message?: string;
+            channels?: ReturnType<typeof Channel.prototype.toObject>[];
+            next_page_token?: string;
+            total_count?: number;
+        } = {};
+        if (this.message != null) {
+            data.message = this.message;
+        }
+        if (this.channels != null) {
+            data.channels = this.channels.map((item: Channel) => item.toObject());
+        }
+        if (this.next_page_token != null) {
+            data.next_page_token = this.next_page_token;
+        }
+        if (this.total_count != null) {
+            data.total_count = this.total_count;
+        }
+        return data;
+    }
+    serialize(): Uint8Array;
+    serialize(w: pb_1.BinaryWriter): void;
+    serialize(w?: pb_1.BinaryWriter): Uint8Array | void {
+        const writer = w || new pb_1.BinaryWriter();
+        if (this.message.length)
+            writer.writeString(1, this.message);
+        if (this.channels.length)
+            writer.writeRepeatedMessage(2, this.channels, (item: Channel) => item.serialize(writer));
+        if (this.next_page_token.length)
+            writer.writeString(3, this.next_page_token);
+        if (this.total_count != 0)
+            writer.writeInt32(4, this.total_count);
+        if (!w)
+            return writer.getResultBuffer();
+    }
+    static deserialize(bytes: Uint8Array | pb_1.BinaryReader): GetChannelsResponse {
+        const reader = bytes instanceof pb_1.BinaryReader ? bytes : new pb_1.BinaryReader(bytes), message = new GetChannelsResponse();
+        while (reader.nextField()) {
+            if (reader.isEndGroup())
+                break;
+            switch (reader.getFieldNumber()) {
+                case 1:
+                    message.message = reader.readString();
+                    break;
+                case 2:
+                    reader.readMessage(message.channels, () => pb_1.Message.addToRepeatedWrapperField(message, 2, Channel.deserialize(reader), Channel));
+                    break;
+                case 3:
+                    message.next_page_token = reader.readString();
+                    break;
+                case 4:
+                    message.total_count = reader.readInt32();
+                    break;
+                default: reader.skipField();
+            }
+        }
+        return message;
+    }
+    serializeBinary(): Uint8Array {
+        return this.serialize();
+    }
+    static deserializeBinary(bytes: Uint8Array): GetChannelsResponse {
+        return GetChannelsResponse.deserialize(bytes);
+    }
+}
+export class GetChannelRequest extends pb_1.Message {
+    #one_of_decls: number[][] = [];
+    constructor(data?: any[] | {
+        channel_id?: string;
+    }) {
+        super();
+        pb_1.Message.initialize(this, Array.isArray(data) ? data : [], 0, -1, [], this.#one_of_decls);
+        if (!Array.isArray(data) && typeof data == "object") {
+            if ("channel_id" in data && data.channel_id != undefined) {
+                this.channel_id = data.channel_id;
+            }
+        }
+    }
+    get channel_id() {
+        return pb_1.Message.getFieldWithDefault(this, 1, "") as string;
+    }
+    set channel_id(value: string) {
+        pb_1.Message.setField(this, 1, value);
+    }
+    static fromObject(data: {
+        channel_id?: string;
+    }): GetChannelRequest {
+        const message = new GetChannelRequest({});
+        if (data.channel_id != null) {
+            message.channel_id = data.channel_id;
+        }
+        return message;
+    }
+    toObject() {
+        const data: {
+            channel_id?: string;
+        } = {};
+        if (this.channel_id != null) {
+            data.channel_id = this.channel_id;
+        }
+        return data;
+    }
+    serialize(): Uint8Array;
+    serialize(w: pb_1.BinaryWriter): void;
+    serialize(w?: pb_1.BinaryWriter): Uint8Array | void {
+        const writer = w || new pb_1.BinaryWriter();
+        if (this.channel_id.length)
+            writer.writeString(1, this.channel_id);
+        if (!w)
+            return writer.getResultBuffer();
+    }
+    static deserialize(bytes: Uint8Array | pb_1.BinaryReader): GetChannelRequest {
+        const reader = bytes instanceof pb_1.BinaryReader ? bytes : new pb_1.BinaryReader(bytes), message = new GetChannelRequest();
+        while (reader.nextField()) {
+            if (reader.isEndGroup())
+                break;
+            switch (reader.getFieldNumber()) {
+                case 1:
+                    message.channel_id = reader.readString();
+                    break;
+                default: reader.skipField();
+            }
+        }
+        return message;
+    }
+    serializeBinary(): Uint8Array {
+        return this.serialize();
+    }
+    static deserializeBinary(bytes: Uint8Array): GetChannelRequest {
+        return GetChannelRequest.deserialize(bytes);
+    }
+}
+export class GetChannelResponse extends pb_1.Message {
+    #one_of_decls: number[][] = [];
+    constructor(data?: any[] | {
+        message?: string;
+        channel?: Channel;
+    }) {
+        super();
+        pb_1.Message.initialize(this, Array.isArray(data) ? data : [], 0, -1, [], this.#one_of_decls);
+        if (!Array.isArray(data) && typeof data == "object") {
+            if ("message" in data && data.message != undefined) {
+                this.message = data.message;
+            }
+            if ("channel" in data && data.channel != undefined) {
+                this.channel = data.channel;
+            }
+        }
+    }
+    get message() {
+        return pb_1.Message.getFieldWithDefault(this, 1, "") as string;
+    }
+    set message(value: string) {
+        pb_1.Message.setField(this, 1, value);
+    }
+    get channel() {
+        return pb_1.Message.getWrapperField(this, Channel, 2) as Channel;
+    }
+    set channel(value: Channel) {
+        pb_1.Message.setWrapperField(this, 2, value);
+    }
+    get has_channel() {
+        return pb_1.Message.getField(this, 2) != null;
+    }
+    static fromObject(data: {
+        message?: string;
+        channel?: ReturnType<typeof Channel.prototype.toObject>;
+    }): GetChannelResponse {
+        const message = new GetChannelResponse({});
+        if (data.message != null) {
+            message.message = data.message;
+        }
+        if (data.channel != null) {
+            message.channel = Channel.fromObject(data.channel);
+        }
+        return message;
+    }
+    toObject() {
+        const data: {
+            message?: string;
+            channel?: ReturnType<typeof Channel.prototype.toObject>;
+        } = {};
+        if (this.message != null) {
+            data.message = this.message;
+        }
+        if (this.channel != null) {
+            data.channel = this.channel.toObject();
+        }
+        return data;
+    }
+    serialize(): Uint8Array;
+    serialize(w: pb_1.BinaryWriter): void;
+    serialize(w?: pb_1.BinaryWriter): Uint8Array | void {
+        const writer = w || new pb_1.BinaryWriter();
+        if (this.message.length)
+            writer.writeString(1, this.message);
+        if (this.has_channel)
+            writer.writeMessage(2, this.channel, () => this.channel.serialize(writer));
+        if (!w)
+            return writer.getResultBuffer();
+    }
+    static deserialize(bytes: Uint8Array | pb_1.BinaryReader): GetChannelResponse {
+        const reader = bytes instanceof pb_1.BinaryReader ? bytes : new pb_1.BinaryReader(bytes), message = new GetChannelResponse();
+        while (reader.nextField()) {
+            if (reader.isEndGroup())
+                break;
+            switch (reader.getFieldNumber()) {
+                case 1:
+                    message.message = reader.readString();
+                    break;
+                case 2:
+                    reader.readMessage(message.channel, () => message.channel = Channel.deserialize(reader));
+                    break;
+                default: reader.skipField();
+            }
+        }
+        return message;
+    }
+    serializeBinary(): Uint8Array {
+        return this.serialize();
+    }
+    static deserializeBinary(bytes: Uint8Array): GetChannelResponse {
+        return GetChannelResponse.deserialize(bytes);
+    }
+}
+export class GetChannelMembersRequest extends pb_1.Message {
+    #one_of_decls: number[][] = [];
+    constructor(data?: any[] | {
+        channel_id?: string;
+    }) {
+        super();
+        pb_1.Message.initialize(this, Array.isArray(data) ? data : [], 0, -1, [], this.#one_of_decls);
+        if (!Array.isArray(data) && typeof data == "object") {
+            if ("channel_id" in data && data.channel_id != undefined) {
+                this.channel_id = data.channel_id;
+            }
+        }
+    }
+    get channel_id() {
+        return pb_1.Message.getFieldWithDefault(this, 2, "") as string;
+    }
+    set channel_id(value: string) {
+        pb_1.Message.setField(this, 2, value);
+    }
+    static fromObject(data: {
+        channel_id?: string;
+    }): GetChannelMembersRequest {
+        const message = new GetChannelMembersRequest({});
+        if (data.channel_id != null) {
+            message.channel_id = data.channel_id;
+        }
+        return message;
+    }
+    toObject() {
+        const data: {
+            channel_id?: string;
+        } = {};
+        if (this.channel_id != null) {
+            data.channel_id = this.channel_id;
+        }
+        return data;
+    }
+    serialize(): Uint8Array;
+    serialize(w: pb_1.BinaryWriter): void;
+    serialize(w?: pb_1.BinaryWriter): Uint8Array | void {
+        const writer = w || new pb_1.BinaryWriter();
+        if (this.channel_id.length)
+            writer.writeString(2, this.channel_id);
+        if (!w)
+            return writer.getResultBuffer();
+    }
+    static deserialize(bytes: Uint8Array | pb_1.BinaryReader): GetChannelMembersRequest {
+        const reader = bytes instanceof pb_1.BinaryReader ? bytes : new pb_1.BinaryReader(bytes), message = new GetChannelMembersRequest();
+        while (reader.nextField()) {
+            if (reader.isEndGroup())
+                break;
+            switch (reader.getFieldNumber()) {
+                case 2:
+                    message.channel_id = reader.readString();
+                    break;
+                default: reader.skipField();
+            }
+        }
+        return message;
+    }
+    serializeBinary(): Uint8Array {
+        return this.serialize();
+    }
+    static deserializeBinary(bytes: Uint8Array): GetChannelMembersRequest {
+        return GetChannelMembersRequest.deserialize(bytes);
+    }
+}
+export class GetChannelMembersResponse extends pb_1.Message {
+    #one_of_decls: number[][] = [];
+    constructor(data?: any[] | {
+        message?: string;
+        channel_members?: ChannelMember[];
+    }) {
+        super();
+        pb_1.Message.initialize(this, Array.isArray(data) ? data : [], 0, -1, [2], this.#one_of_decls);
+        if (!Array.isArray(data) && typeof data == "object") {
+            if ("message" in data && data.message != undefined) {
+                this.message = data.message;
+            }
+            if ("channel_members" in data && data.channel_members != undefined) {
+                this.channel_members = data.channel_members;
+            }
+        }
+    }
+    get message() {
+        return pb_1.Message.getFieldWithDefault(this, 1, "") as string;
+    }
+    set message(value: string) {
+        pb_1.Message.setField(this, 1, value);
+    }
+    get channel_members() {
+        return pb_1.Message.getRepeatedWrapperField(this, ChannelMember, 2) as ChannelMember[];
+    }
+    set channel_members(value: ChannelMember[]) {
+        pb_1.Message.setRepeatedWrapperField(this, 2, value);
+    }
+    static fromObject(data: {
+        message?: string;
+        channel_members?: ReturnType<typeof ChannelMember.prototype.toObject>[];
+    }): GetChannelMembersResponse {
+        const message = new GetChannelMembersResponse({});
+        if (data.message != null) {
+            message.message = data.message;
+        }
+        if (data.channel_members != null) {
+            message.channel_members = data.channel_members.map(item => ChannelMember.fromObject(item));
+        }
+        return message;
+    }
+    toObject() {
+        const data: {
+            message?: string;
+            channel_members?: ReturnType<typeof ChannelMember.prototype.toObject>[];
+        } = {};
+        if (this.message != null) {
+            data.message = this.message;
+        }
+        if (this.channel_members != null) {
+            data.channel_members = this.channel_members.map((item: ChannelMember) => item.toObject());
+        }
+        return data;
+    }
+    serialize(): Uint8Array;
+    serialize(w: pb_1.BinaryWriter): void;
+    serialize(w?: pb_1.BinaryWriter): Uint8Array | void {
+        const writer = w || new pb_1.BinaryWriter();
+        if (this.message.length)
+            writer.writeString(1, this.message);
+        if (this.channel_members.length)
+            writer.writeRepeatedMessage(2, this.channel_members, (item: ChannelMember) => item.serialize(writer));
+        if (!w)
+            return writer.getResultBuffer();
+    }
+    static deserialize(bytes: Uint8Array | pb_1.BinaryReader): GetChannelMembersResponse {
+        const reader = bytes instanceof pb_1.BinaryReader ? bytes : new pb_1.BinaryReader(bytes), message = new GetChannelMembersResponse();
+        while (reader.nextField()) {
+            if (reader.isEndGroup())
+                break;
+            switch (reader.getFieldNumber()) {
+                case 1:
+                    message.message = reader.readString();
+                    break;
+                case 2:
+                    reader.readMessage(message.channel_members, () => pb_1.Message.addToRepeatedWrapperField(message, 2, ChannelMember.deserialize(reader), ChannelMember));
+                    break;
+                default: reader.skipField();
+            }
+        }
+        return message;
+    }
+    serializeBinary(): Uint8Array {
+        return this.serialize();
+    }
+    static deserializeBinary(bytes: Uint8Array): GetChannelMembersResponse {
+        return GetChannelMembersResponse.deserialize(bytes);
+    }
+}
+export class AddChannelMemberRequest extends pb_1.Message {
+    #one_of_decls: number[][] = [];
+    constructor(data?: any[] | {
+        channel_id?: string;
+        user_id?: string;
+        role?: string;
+    }) {
+        super();
+        pb_1.Message.initialize(this, Array.isArray(data) ? data : [], 0, -1, [], this.#one_of_decls);
+        if (!Array.isArray(data) && typeof data == "object") {
+            if ("channel_id" in data && data.channel_id != undefined) {
+                this.channel_id = data.channel_id;
+            }
+            if ("user_id" in data && data.user_id != undefined) {
+                this.user_id = data.user_id;
+            }
+            if ("role" in data && data.role != undefined) {
+                this.role = data.role;
+            }
+        }
+    }
+    get channel_id() {
+        return pb_1.Message.getFieldWithDefault(this, 2, "") as string;
+    }
+    set channel_id(value: string) {
+        pb_1.Message.setField(this, 2, value);
+    }
+    get user_id() {
+        return pb_1.Message.getFieldWithDefault(this, 3, "") as string;
+    }
+    set user_id(value: string) {
+        pb_1.Message.setField(this, 3, value);
+    }
+    get role() {
+        return pb_1.Message.getFieldWithDefault(this, 4, "") as string;
+    }
+    set role(value: string) {
+        pb_1.Message.setField(this, 4, value);
+    }
+    static fromObject(data: {
+        channel_id?: string;
+        user_id?: string;
+        role?: string;
+    }): AddChannelMemberRequest {
+        const message = new AddChannelMemberRequest({});
+        if (data.channel_id != null) {
+            message.channel_id = data.channel_id;
+        }
+        if (data.user_id != null) {
+            message.user_id = data.user_id;
+        }
+        if (data.role != null) {
+            message.role = data.role;
+        }
+        return message;
+    }
+    toObject() {
+        const data: {
+            channel_id?: string;
+            user_id?: string;
+            role?: string;
+        } = {};
+        if (this.channel_id != null) {
+            data.channel_id = this.channel_id;
+        }
+        if (this.user_id != null) {
+            data.user_id = this.user_id;
+        }
+        if (this.role != null) {
+            data.role = this.role;
+        }
+        return data;
+    }
+    serialize(): Uint8Array;
+    serialize(w: pb_1.BinaryWriter): void;
+    serialize(w?: pb_1.BinaryWriter): Uint8Array | void {
+        const writer = w || new pb_1.BinaryWriter();
+        if (this.channel_id.length)
+            writer.writeString(2, this.channel_id);
+        if (this.user_id.length)
+            writer.writeString(3, this.user_id);
+        if (this.role.length)
+            writer.writeString(4, this.role);
+        if (!w)
+            return writer.getResultBuffer();
+    }
+    static deserialize(bytes: Uint8Array | pb_1.BinaryReader): AddChannelMemberRequest {
+        const reader = bytes instanceof pb_1.BinaryReader ? bytes : new pb_1.BinaryReader(bytes), message = new AddChannelMemberRequest();
+        while (reader.nextField()) {
+            if (reader.isEndGroup())
+                break;
+            switch (reader.getFieldNumber()) {
+                case 2:
+                    message.channel_id = reader.readString();
+                    break;
+                case 3:
+                    message.user_id = reader.readString();
+                    break;
+                case 4:
+                    message.role = reader.readString();
+                    break;
+                default: reader.skipField();
+            }
+        }
+        return message;
+    }
+    serializeBinary(): Uint8Array {
+        return this.serialize();
+    }
+    static deserializeBinary(bytes: Uint8Array): AddChannelMemberRequest {
+        return AddChannelMemberRequest.deserialize(bytes);
+    }
+}
+export class AddChannelMemberResponse extends pb_1.Message {
+    #one_of_decls: number[][] = [];
+    constructor(data?: any[] | {
+        message?: string;
+    }) {
+        super();
+        pb_1.Message.initialize(this, Array.isArray(data) ? data : [], 0, -1, [], this.#one_of_decls);
+        if (!Array.isArray(data) && typeof data == "object") {
+            if ("message" in data && data.message != undefined) {
+                this.message = data.message;
+            }
+        }
+    }
+    get message() {
+        return pb_1.Message.getFieldWithDefault(this, 1, "") as string;
+    }
+    set message(value: string) {
+        pb_1.Message.setField(this, 1, value);
+    }
+    static fromObject(data: {
+        message?: string;
+    }): AddChannelMemberResponse {
+        const message = new AddChannelMemberResponse({});
+        if (data.message != null) {
+            message.message = data.message;
+        }
+        return message;
+    }
+    toObject() {
+        const data: {
+            message?: string;
+        } = {};
+        if (this.message != null) {
+            data.message = this.message;
+        }
+        return data;
+    }
+    serialize(): Uint8Array;
+    serialize(w: pb_1.BinaryWriter): void;
+    serialize(w?: pb_1.BinaryWriter): Uint8Array | void {
+        const writer = w || new pb_1.BinaryWriter();
+        if (this.message.length)
+            writer.writeString(1, this.message);
+        if (!w)
+            return writer.getResultBuffer();
+    }
+    static deserialize(bytes: Uint8Array | pb_1.BinaryReader): AddChannelMemberResponse {
+        const reader = bytes instanceof pb_1.BinaryReader ? bytes : new pb_1.BinaryReader(bytes), message = new AddChannelMemberResponse();
+        while (reader.nextField()) {
+            if (reader.isEndGroup())
+                break;
+            switch (reader.getFieldNumber()) {
+                case 1:
+                    message.message = reader.readString();
+                    break;
+                default: reader.skipField();
+            }
+        }
+        return message;
+    }
+    serializeBinary(): Uint8Array {
+        return this.serialize();
+    }
+    static deserializeBinary(bytes: Uint8Array): AddChannelMemberResponse {
+        return AddChannelMemberResponse.deserialize(bytes);
+    }
+}
+export class RemoveChannelMemberRequest extends pb_1.Message {
+    #one_of_decls: number[][] = [];
+    constructor(data?: any[] | {
+        channel_id?: string;
+        user_id?: string;
+    }) {
+        super();
+        pb_1.Message.initialize(this, Array.isArray(data) ? data : [], 0, -1, [], this.#one_of_decls);
+        if (!Array.isArray(data) && typeof data == "object") {
+            if ("channel_id" in data && data.channel_id != undefined) {
+                this.channel_id = data.channel_id;
+            }
+            if ("user_id" in data && data.user_id != undefined) {
+                this.user_id = data.user_id;
+            }
+        }
+    }
+    get channel_id() {
+        return pb_1.Message.getFieldWithDefault(this, 2, "") as string;
+    }
+    set channel_id(value: string) {
+        pb_1.Message.setField(this, 2, value);
+    }
+    get user_id() {
+        return pb_1.Message.getFieldWithDefault(this, 3, "") as string;
+    }
+    set user_id(value: string) {
+        pb_1.Message.setField(this, 3, value);
+    }
+    static fromObject(data: {
+        channel_id?: string;
+        user_id?: string;
+    }): RemoveChannelMemberRequest {
+        const message = new RemoveChannelMemberRequest({});
+        if (data.channel_id != null) {
+            message.channel_id = data.channel_id;
+        }
+        if (data.user_id != null) {
+            message.user_id = data.user_id;
+        }
+        return message;
+    }
+    toObject() {
+        const data: {
+            channel_id?: string;
+            user_id?: string;
+        } = {};
+        if (this.channel_id != null) {
+            data.channel_id = this.channel_id;
+        }
+        if (this.user_id != null) {
+            data.user_id = this.user_id;
+        }
+        return data;
+    }
+    serialize(): Uint8Array;
+    serialize(w: pb_1.BinaryWriter): void;
+    serialize(w?: pb_1.BinaryWriter): Uint8Array | void {
+        const writer = w || new pb_1.BinaryWriter();
+        if (this.channel_id.length)
+            writer.writeString(2, this.channel_id);
+        if (this.user_id.length)
+            writer.writeString(3, this.user_id);
+        if (!w)
+            return writer.getResultBuffer();
+    }
+    static deserialize(bytes: Uint8Array | pb_1.BinaryReader): RemoveChannelMemberRequest {
+        const reader = bytes instanceof pb_1.BinaryReader ? bytes : new pb_1.BinaryReader(bytes), message = new RemoveChannelMemberRequest();
+        while (reader.nextField()) {
+            if (reader.isEndGroup())
+                break;
+            switch (reader.getFieldNumber()) {
+                case 2:
+                    message.channel_id = reader.readString();
+                    break;
+                case 3:
+                    message.user_id = reader.readString();
+                    break;
+                default: reader.skipField();
+            }
+        }
+        return message;
+    }
+    serializeBinary(): Uint8Array {
+        return this.serialize();
+    }
+    static deserializeBinary(bytes: Uint8Array): RemoveChannelMemberRequest {
+        return RemoveChannelMemberRequest.deserialize(bytes);
+    }
+}
+export class RemoveChannelMemberResponse extends pb_1.Message {
+    #one_of_decls: number[][] = [];
+    constructor(data?: any[] | {
+        message?: string;
+    }) {
+        super();
+        pb_1.Message.initialize(this, Array.isArray(data) ? data : [], 0, -1, [], this.#one_of_decls);
+        if (!Array.isArray(data) && typeof data == "object") {
+            if ("message" in data && data.message != undefined) {
+                this.message = data.message;
+            }
+        }
+    }
+    get message() {
+        return pb_1.Message.getFieldWithDefault(this, 1, "") as string;
+    }
+    set message(value: string) {
+        pb_1.Message.setField(this, 1, value);
+    }
+    static fromObject(data: {
+        message?: string;
+    }): RemoveChannelMemberResponse {
+        const message = new RemoveChannelMemberResponse({});
+        if (data.message != null) {
+            message.message = data.message;
+        }
+        return message;
+    }
+    toObject() {
+        const data: {
+            message?: string;
+        } = {};
+        if (this.message != null) {
+            data.message = this.message;
+        }
+        return data;
+    }
+    serialize(): Uint8Array;
+    serialize(w: pb_1.BinaryWriter): void;
+    serialize(w?: pb_1.BinaryWriter): Uint8Array | void {
+        const writer = w || new pb_1.BinaryWriter();
+        if (this.message.length)
+            writer.writeString(1, this.message);
+        if (!w)
+            return writer.getResultBuffer();
+    }
+    static deserialize(bytes: Uint8Array | pb_1.BinaryReader): RemoveChannelMemberResponse {
+        const reader = bytes instanceof pb_1.BinaryReader ? bytes : new pb_1.BinaryReader(bytes), message = new RemoveChannelMemberResponse();
+        while (reader.nextField()) {
+            if (reader.isEndGroup())
+                break;
+            switch (reader.getFieldNumber()) {
+                case 1:
+                    message.message = reader.readString();
+                    break;
+                default: reader.skipField();
+            }
+        }
+        return message;
+    }
+    serializeBinary(): Uint8Array {
+        return this.serialize();
+    }
+    static deserializeBinary(bytes: Uint8Array): RemoveChannelMemberResponse {
+        return RemoveChannelMemberResponse.deserialize(bytes);
+    }
+}
+export class UpdateChannelMemberRoleRequest extends pb_1.Message {
+    #one_of_decls: number[][] = [];
+    constructor(data?: any[] | {
+        channel_id?: string;
+        user_id?: string;
+        role?: string;
+    }) {
+        super();
+        pb_1.Message.initialize(this, Array.isArray(data) ? data : [], 0, -1, [], this.#one_of_decls);
+        if (!Array.isArray(data) && typeof data == "object") {
+            if ("channel_id" in data && data.channel_id != undefined) {
+                this.channel_id = data.channel_id;
+            }
+            if ("user_id" in data && data.user_id != undefined) {
+                this.user_id = data.user_id;
+            }
+            if ("role" in data && data.role != undefined) {
+                this.role = data.role;
+            }
+        }
+    }
+    get channel_id() {
+        return pb_1.Message.getFieldWithDefault(this, 1, "") as string;
+    }
+    set channel_id(value: string) {
+        pb_1.Message.setField(this, 1, value);
+    }
+    get user_id() {
+        return pb_1.Message.getFieldWithDefault(this, 2, "") as string;
+    }
+    set user_id(value: string) {
+        pb_1.Message.setField(this, 2, value);
+    }
+    get role() {
+        return pb_1.Message.getFieldWithDefault(this, 3, "") as string;
+    }
+    set role(value: string) {
+        pb_1.Message.setField(this, 3, value);
+    }
+    static fromObject(data: {
+        channel_id?: string;
+        user_id?: string;
+        role?: string;
+    }): UpdateChannelMemberRoleRequest {
+        const message = new UpdateChannelMemberRoleRequest({});
+        if (data.channel_id != null) {
+            message.channel_id = data.channel_id;
+        }
+        if (data.user_id != null) {
+            message.user_id = data.user_id;
+        }
+        if (data.role != null) {
+            message.role = data.role;
+        }
+        return message;
+    }
+    toObject() {
+        const data: {
+            channel_id?: string;
+            user_id?: string;
+            role?: string;
+        } = {};
+        if (this.channel_id != null) {
+            data.channel_id = this.channel_id;
+        }
+        if (this.user_id != null) {
+            data.user_id = this.user_id;
+        }
+        if (this.role != null) {
+            data.role = this.role;
+        }
+        return data;
+    }
+    serialize(): Uint8Array;
+    serialize(w: pb_1.BinaryWriter): void;
+    serialize(w?: pb_1.BinaryWriter): Uint8Array | void {
+        const writer = w || new pb_1.BinaryWriter();
+        if (this.channel_id.length)
+            writer.writeString(1, this.channel_id);
+        if (this.user_id.length)
+            writer.writeString(2, this.user_id);
+        if (this.role.length)
+            writer.writeString(3, this.role);
+        if (!w)
+            return writer.getResultBuffer();
+    }
+    static deserialize(bytes: Uint8Array | pb_1.BinaryReader): UpdateChannelMemberRoleRequest {
+        const reader = bytes instanceof pb_1.BinaryReader ? bytes : new pb_1.BinaryReader(bytes), message = new UpdateChannelMemberRoleRequest();
+        while (reader.nextField()) {
+            if (reader.isEndGroup())
+                break;
+            switch (reader.getFieldNumber()) {
+                case 1:
+                    message.channel_id = reader.readString();
+                    break;
+                case 2:
+                    message.user_id = reader.readString();
+                    break;
+                case 3:
+                    message.role = reader.readString();
+                    break;
+                default: reader.skipField();
+            }
+        }
+        return message;
+    }
+    serializeBinary(): Uint8Array {
+        return this.serialize();
+    }
+    static deserializeBinary(bytes: Uint8Array): UpdateChannelMemberRoleRequest {
+        return UpdateChannelMemberRoleRequest.deserialize(bytes);
+    }
+}
+export class UpdateChannelMemberRoleResponse extends pb_1.Message {
+    #one_of_decls: number[][] = [];
+    constructor(data?: any[] | {
+        message?: string;
+    }) {
+        super();
+        pb_1.Message.initialize(this, Array.isArray(data) ? data : [], 0, -1, [], this.#one_of_decls);
+        if (!Array.isArray(data) && typeof data == "object") {
+            if ("message" in data && data.message != undefined) {
+                this.message = data.message;
+            }
+        }
+    }
+    get message() {
+        return pb_1.Message.getFieldWithDefault(this, 1, "") as string;
+    }
+    set message(value: string) {
+        pb_1.Message.setField(this, 1, value);
+    }
+    static fromObject(data: {
+        message?: string;
+    }): UpdateChannelMemberRoleResponse {
+        const message = new UpdateChannelMemberRoleResponse({});
+        if (data.message != null) {
+            message.message = data.message;
+        }
+        return message;
+    }
+    toObject() {
+        const data: {
+            message?: string;
+        } = {};
+        if (this.message != null) {
+            data.message = this.message;
+        }
+        return data;
+    }
+    serialize(): Uint8Array;
+    serialize(w: pb_1.BinaryWriter): void;
+    serialize(w?: pb_1.BinaryWriter): Uint8Array | void {
+        const writer = w || new pb_1.BinaryWriter();
+        if (this.message.length)
+            writer.writeString(1, this.message);
+        if (!w)
+            return writer.getResultBuffer();
+    }
+    static deserialize(bytes: Uint8Array | pb_1.BinaryReader): UpdateChannelMemberRoleResponse {
+        const reader = bytes instanceof pb_1.BinaryReader ? bytes : new pb_1.BinaryReader(bytes), message = new UpdateChannelMemberRoleResponse();
+        while (reader.nextField()) {
+            if (reader.isEndGroup())
+                break;
+            switch (reader.getFieldNumber()) {
+                case 1:
+                    message.message = reader.readString();
+                    break;
+                default: reader.skipField();
+            }
+        }
+        return message;
+    }
+    serializeBinary(): Uint8Array {
+        return this.serialize();
+    }
+    static deserializeBinary(bytes: Uint8Array): UpdateChannelMemberRoleResponse {
+        return UpdateChannelMemberRoleResponse.deserialize(bytes);
+    }
+}
+export class DiscoverChannelsRequest extends pb_1.Message {
+    #one_of_decls: number[][] = [];
+    constructor(data?: any[] | {
+        page_size?: number;
+        page_token?: string;
+    }) {
+        super();
+        pb_1.Message.initialize(this, Array.isArray(data) ? data : [], 0, -1, [], this.#one_of_decls);
+        if (!Array.isArray(data) && typeof data == "object") {
+            if ("page_size" in data && data.page_size != undefined) {
+                this.page_size = data.page_size;
+            }
+            if ("page_token" in data && data.page_token != undefined) {
+                this.page_token = data.page_token;
+            }
+        }
+    }
+    get page_size() {
+        return pb_1.Message.getFieldWithDefault(this, 1, 0) as number;
+    }
+    set page_size(value: number) {
+        pb_1.Message.setField(this, 1, value);
+    }
+    get page_token() {
+        return pb_1.Message.getFieldWithDefault(this, 2, "") as string;
+    }
+    set page_token(value: string) {
+        pb_1.Message.setField(this, 2, value);
+    }
+    static fromObject(data: {
+        page_size?: number;
+        page_token?: string;
+    }): DiscoverChannelsRequest {
+        const message = new DiscoverChannelsRequest({});
+        if (data.page_size != null) {
+            message.page_size = data.page_size;
+        }
+        if (data.page_token != null) {
+            message.page_token = data.page_token;
+        }
+        return message;
+    }
+    toObject() {
+        const data: {
+            page_size?: number;
+            page_token?: string;
+        } = {};
+        if (this.page_size != null) {
+            data.page_size = this.page_size;
+        }
+        if (this.page_token != null) {
+            data.page_token = this.page_token;
+        }
+        return data;
+    }
+    serialize(): Uint8Array;
+    serialize(w: pb_1.BinaryWriter): void;
+    serialize(w?: pb_1.BinaryWriter): Uint8Array | void {
+        const writer = w || new pb_1.BinaryWriter();
+        if (this.page_size != 0)
+            writer.writeInt32(1, this.page_size);
+        if (this.page_token.length)
+            writer.writeString(2, this.page_token);
+        if (!w)
+            return writer.getResultBuffer();
+    }
+    static deserialize(bytes: Uint8Array | pb_1.BinaryReader): DiscoverChannelsRequest {
+        const reader = bytes instanceof pb_1.BinaryReader ? bytes : new pb_1.BinaryReader(bytes), message = new DiscoverChannelsRequest();
+        while (reader.nextField()) {
+            if (reader.isEndGroup())
+                break;
+            switch (reader.getFieldNumber()) {
+                case 1:
+                    message.page_size = reader.readInt32();
+                    break;
+                case 2:
+                    message.page_token = reader.readString();
+                    break;
+                default: reader.skipField();
+            }
+        }
+        return message;
+    }
+    serializeBinary(): Uint8Array {
+        return this.serialize();
+    }
+    static deserializeBinary(bytes: Uint8Array): DiscoverChannelsRequest {
+        return DiscoverChannelsRequest.deserialize(bytes);
+    }
+}
+export class DiscoverChannelsResponse extends pb_1.Message {
+    #one_of_decls: number[][] = [];
+    constructor(data?: any[] | {
+        message?: string;
+        channels?: Channel[];
+        next_page_token?: string;
+        total_count?: number;
+    }) {
+        super();
+        pb_1.Message.initialize(this, Array.isArray(data) ? data : [], 0, -1, [2], this.#one_of_decls);
+        if (!Array.isArray(data) && typeof data == "object") {
+            if ("message" in data && data.message != undefined) {
+                this.message = data.message;
+            }
+            if ("channels" in data && data.channels != undefined) {
+                this.channels = data.channels;
+            }
+            if ("next_page_token" in data && data.next_page_token != undefined) {
+                this.next_page_token = data.next_page_token;
+            }
+            if ("total_count" in data && data.total_count != undefined) {
+                this.total_count = data.total_count;
+            }
+        }
+    }
+    get message() {
+        return pb_1.Message.getFieldWithDefault(this, 1, "") as string;
+    }
+    set message(value: string) {
+        pb_1.Message.setField(this, 1, value);
+    }
+    get channels() {
+        return pb_1.Message.getRepeatedWrapperField(this, Channel, 2) as Channel[];
+    }
+    set channels(value: Channel[]) {
+        pb_1.Message.setRepeatedWrapperField(this, 2, value);
+    }
+    get next_page_token() {
+        return pb_1.Message.getFieldWithDefault(this, 3, "") as string;
+    }
+    set next_page_token(value: string) {
+        pb_1.Message.setField(this, 3, value);
+    }
+    get total_count() {
+        return pb_1.Message.getFieldWithDefault(this, 4, 0) as number;
+    }
+    set total_count(value: number) {
+        pb_1.Message.setField(this, 4, value);
+    }
+    static fromObject(data: {
+        message?: string;
+        channels?: ReturnType<typeof Channel.prototype.toObject>[];
+        next_page_token?: string;
+        total_count?: number;
+    }): DiscoverChannelsResponse {
+        const message = new DiscoverChannelsResponse({});
+        if (data.message != null) {
+            message.message = data.message;
+        }
+        if (data.channels != null) {
+            message.channels = data.channels.map(item => Channel.fromObject(item));
+        }
+        if (data.next_page_token != null) {
+            message.next_page_token = data.next_page_token;
+        }
+        if (data.total_count != null) {
+            message.total_count = data.total_count;
+        }
+        return message;
+    }
+    toObject() {
+        const data: {
+            message?: string;
+            channels?: ReturnType<typeof Channel.prototype.toObject>[];
+            next_page_token?: string;
+            total_count?: number;
+        } = {};
+        if (this.message != null) {
+            data.message = this.message;
+        }
+        if (this.channels != null) {
+            data.channels = this.channels.map((item: Channel) => item.toObject());
+        }
+        if (this.next_page_token != null) {
+            data.next_page_token = this.next_page_token;
+        }
+        if (this.total_count != null) {
+            data.total_count = this.total_count;
+        }
+        return data;
+    }
+    serialize(): Uint8Array;
+    serialize(w: pb_1.BinaryWriter): void;
+    serialize(w?: pb_1.BinaryWriter): Uint8Array | void {
+        const writer = w || new pb_1.BinaryWriter();
+        if (this.message.length)
+            writer.writeString(1, this.message);
+        if (this.channels.length)
+            writer.writeRepeatedMessage(2, this.channels, (item: Channel) => item.serialize(writer));
+        if (this.next_page_token.length)
+            writer.writeString(3, this.next_page_token);
+        if (this.total_count != 0)
+            writer.writeInt32(4, this.total_count);
+        if (!w)
+            return writer.getResultBuffer();
+    }
+    static deserialize(bytes: Uint8Array | pb_1.BinaryReader): DiscoverChannelsResponse {
+        const reader = bytes instanceof pb_1.BinaryReader ? bytes : new pb_1.BinaryReader(bytes), message = new DiscoverChannelsResponse();
+        while (reader.nextField()) {
+            if (reader.isEndGroup())
+                break;
+            switch (reader.getFieldNumber()) {
+                case 1:
+                    message.message = reader.readString();
+                    break;
+                case 2:
+                    reader.readMessage(message.channels, () => pb_1.Message.addToRepeatedWrapperField(message, 2, Channel.deserialize(reader), Channel));
+                    break;
+                case 3:
+                    message.next_page_token = reader.readString();
+                    break;
+                case 4:
+                    message.total_count = reader.readInt32();
+                    break;
+                default: reader.skipField();
+            }
+        }
+        return message;
+    }
+    serializeBinary(): Uint8Array {
+        return this.serialize();
+    }
+    static deserializeBinary(bytes: Uint8Array): DiscoverChannelsResponse {
+        return DiscoverChannelsResponse.deserialize(bytes);
+    }
+}
+export class JoinChannelRequest extends pb_1.Message {
+    #one_of_decls: number[][] = [];
+    constructor(data?: any[] | {
+        channel_id?: string;
+    }) {
+        super();
+        pb_1.Message.initialize(this, Array.isArray(data) ? data : [], 0, -1, [], this.#one_of_decls);
+        if (!Array.isArray(data) && typeof data == "object") {
+            if ("channel_id" in data && data.channel_id != undefined) {
+                this.channel_id = data.channel_id;
+            }
+        }
+    }
+    get channel_id() {
+        return pb_1.Message.getFieldWithDefault(this, 1, "") as string;
+    }
+    set channel_id(value: string) {
+        pb_1.Message.setField(this, 1, value);
+    }
+    static fromObject(data: {
+        channel_id?: string;
+    }): JoinChannelRequest {
+        const message = new JoinChannelRequest({});
+        if (data.channel_id != null) {
+            message.channel_id = data.channel_id;
+        }
+        return message;
+    }
+    toObject() {
+        const data: {
+            channel_id?: string;
+        } = {};
+        if (this.channel_id != null) {
+            data.channel_id = this.channel_id;
+        }
+        return data;
+    }
+    serialize(): Uint8Array;
+    serialize(w: pb_1.BinaryWriter): void;
+    serialize(w?: pb_1.BinaryWriter): Uint8Array | void {
+        const writer = w || new pb_1.BinaryWriter();
+        if (this.channel_id.length)
+            writer.writeString(1, this.channel_id);
+        if (!w)
+            return writer.getResultBuffer();
+    }
+    static deserialize(bytes: Uint8Array | pb_1.BinaryReader): JoinChannelRequest {
+        const reader = bytes instanceof pb_1.BinaryReader ? bytes : new pb_1.BinaryReader(bytes), message = new JoinChannelRequest();
+        while (reader.nextField()) {
+            if (reader.isEndGroup())
+                break;
+            switch (reader.getFieldNumber()) {
+                case 1:
+                    message.channel_id = reader.readString();
+                    break;
+                default: reader.skipField();
+            }
+        }
+        return message;
+    }
+    serializeBinary(): Uint8Array {
+        return this.serialize();
+    }
+    static deserializeBinary(bytes: Uint8Array): JoinChannelRequest {
+        return JoinChannelRequest.deserialize(bytes);
+    }
+}
+export class JoinChannelResponse extends pb_1.Message {
+    #one_of_decls: number[][] = [];
+    constructor(data?: any[] | {
+        message?: string;
+        channel?: Channel;
+    }) {
+        super();
+        pb_1.Message.initialize(this, Array.isArray(data) ? data : [], 0, -1, [], this.#one_of_decls);
+        if (!Array.isArray(data) && typeof data == "object") {
+            if ("message" in data && data.message != undefined) {
+                this.message = data.message;
+            }
+            if ("channel" in data && data.channel != undefined) {
+                this.channel = data.channel;
+            }
+        }
+    }
+    get message() {
+        return pb_1.Message.getFieldWithDefault(this, 1, "") as string;
+    }
+    set message(value: string) {
+        pb_1.Message.setField(this, 1, value);
+    }
+    get channel() {
+        return pb_1.Message.getWrapperField(this, Channel, 2) as Channel;
+    }
+    set channel(value: Channel) {
+        pb_1.Message.setWrapperField(this, 2, value);
+    }
+    get has_channel() {
+        return pb_1.Message.getField(this, 2) != null;
+    }
+    static fromObject(data: {
+        message?: string;
+        channel?: ReturnType<typeof Channel.prototype.toObject>;
+    }): JoinChannelResponse {
+        const message = new JoinChannelResponse({});
+        if (data.message != null) {
+            message.message = data.message;
+        }
+        if (data.channel != null) {
+            message.channel = Channel.fromObject(data.channel);
+        }
+        return message;
+    }
+    toObject() {
+        const data: {
+            message?: string;
+            channel?: ReturnType<typeof Channel.prototype.toObject>;
+        } = {};
+        if (this.message != null) {
+            data.message = this.message;
+        }
+        if (this.channel != null) {
+            data.channel = this.channel.toObject();
+        }
+        return data;
+    }
+    serialize(): Uint8Array;
+    serialize(w: pb_1.BinaryWriter): void;
+    serialize(w?: pb_1.BinaryWriter): Uint8Array | void {
+        const writer = w || new pb_1.BinaryWriter();
+        if (this.message.length)
+            writer.writeString(1, this.message);
+        if (this.has_channel)
+            writer.writeMessage(2, this.channel, () => this.channel.serialize(writer));
+        if (!w)
+            return writer.getResultBuffer();
+    }
+    static deserialize(bytes: Uint8Array | pb_1.BinaryReader): JoinChannelResponse {
+        const reader = bytes instanceof pb_1.BinaryReader ? bytes : new pb_1.BinaryReader(bytes), message = new JoinChannelResponse();
+        while (reader.nextField()) {
+            if (reader.isEndGroup())
+                break;
+            switch (reader.getFieldNumber()) {
+                case 1:
+                    message.message = reader.readString();
+                    break;
+                case 2:
+                    reader.readMessage(message.channel, () => message.channel = Channel.deserialize(reader));
+                    break;
+                default: reader.skipField();
+            }
+        }
+        return message;
+    }
+    serializeBinary(): Uint8Array {
+        return this.serialize();
+    }
+    static deserializeBinary(bytes: Uint8Array): JoinChannelResponse {
+        return JoinChannelResponse.deserialize(bytes);
+    }
+}
+export class ChannelAccessRequest extends pb_1.Message {
+    #one_of_decls: number[][] = [];
+    constructor(data?: any[] | {
+        id?: string;
+        channel_id?: string;
+        user?: dependency_2.User;
+        user_id?: string;
+        message?: string;
+        status?: ChannelAccessRequestStatus;
+        created_at?: dependency_1.Timestamp;
+        decided_by?: string;
+        decided_at?: dependency_1.Timestamp;
+    }) {
+        super();
+        pb_1.Message.initialize(this, Array.isArray(data) ? data : [], 0, -1, [], this.#one_of_decls);
+        if (!Array.isArray(data) && typeof data == "object") {
+            if ("id" in data && data.id != undefined) {
+                this.id = data.id;
+            }
+            if ("channel_id" in data && data.channel_id != undefined) {
+                this.channel_id = data.channel_id;
+            }
+            if ("user" in data && data.user != undefined) {
+                this.user = data.user;
+            }
+            if ("user_id" in data && data.user_id != undefined) {
+                this.user_id = data.user_id;
+            }
+            if ("message" in data && data.message != undefined) {
+                this.message = data.message;
+            }
+            if ("status" in data && data.status != undefined) {
+                this.status = data.status;
+            }
+            if ("created_at" in data && data.created_at != undefined) {
+                this.created_at = data.created_at;
+            }
+            if ("decided_by" in data && data.decided_by != undefined) {
+                this.decided_by = data.decided_by;
+            }
+            if ("decided_at" in data && data.decided_at != undefined) {
+                this.decided_at = data.decided_at;
+            }
+        }
+    }
+    get id() {
+        return pb_1.Message.getFieldWithDefault(this, 1, "") as string;
+    }
+    set id(value: string) {
+        pb_1.Message.setField(this, 1, value);
+    }
+    get channel_id() {
+        return pb_1.Message.getFieldWithDefault(this, 2, "") as string;
+    }
+    set channel_id(value: string) {
+        pb_1.Message.setField(this, 2, value);
+    }
+    get user() {
+        return pb_1.Message.getWrapperField(this, dependency_2.User, 3) as dependency_2.User;
+    }
+    set user(value: dependency_2.User) {
+        pb_1.Message.setWrapperField(this, 3, value);
+    }
+    get has_user() {
+        return pb_1.Message.getField(this, 3) != null;
+    }
+    get user_id() {
+        return pb_1.Message.getFieldWithDefault(this, 4, "") as string;
+    }
+    set user_id(value: string) {
+        pb_1.Message.setField(this, 4, value);
+    }
+    get message() {
+        return pb_1.Message.getFieldWithDefault(this, 5, "") as string;
+    }
+    set message(value: string) {
+        pb_1.Message.setField(this, 5, value);
+    }
+    get status() {
+        return pb_1.Message.getFieldWithDefault(this, 6, ChannelAccessRequestStatus.CHANNEL_ACCESS_REQUEST_STATUS_PENDING) as ChannelAccessRequestStatus;
+    }
+    set status(value: ChannelAccessRequestStatus) {
+        pb_1.Message.setField(this, 6, value);
+    }
+    get created_at() {
+        return pb_1.Message.getWrapperField(this, dependency_1.Timestamp, 7) as dependency_1.Timestamp;
+    }
+    set created_at(value: dependency_1.Timestamp) {
+        pb_1.Message.setWrapperField(this, 7, value);
+    }
+    get has_created_at() {
+        return pb_1.Message.getField(this, 7) != null;
+    }
+    get decided_by() {
+        return pb_1.Message.getFieldWithDefault(this, 8, "") as string;
+    }
+    set decided_by(value: string) {
+        pb_1.Message.setField(this, 8, value);
+    }
+    get decided_at() {
+        return pb_1.Message.getWrapperField(this, dependency_1.Timestamp, 9) as dependency_1.Timestamp;
+    }
+    set decided_at(value: dependency_1.Timestamp) {
+        pb_1.Message.setWrapperField(this, 9, value);
+    }
+    get has_decided_at() {
+        return pb_1.Message.getField(this, 9) != null;
+    }
+    static fromObject(data: {
+        id?: string;
+        channel_id?: string;
+        user?: ReturnType<typeof dependency_2.User.prototype.toObject>;
+        user_id?: string;
+        message?: string;
+        status?: ChannelAccessRequestStatus;
+        created_at?: ReturnType<typeof dependency_1.Timestamp.prototype.toObject>;
+        decided_by?: string;
+        decided_at?: ReturnType<typeof dependency_1.Timestamp.prototype.toObject>;
+    }): ChannelAccessRequest {
+        const message = new ChannelAccessRequest({});
+        if (data.id != null) {
+            message.id = data.id;
+        }
+        if (data.channel_id != null) {
+            message.channel_id = data.channel_id;
+        }
+        if (data.user != null) {
+            message.user = dependency_2.User.fromObject(data.user);
+        }
+        if (data.user_id != null) {
+            message.user_id = data.user_id;
+        }
+        if (data.message != null) {
+            message.message = data.message;
+        }
+        if (data.status != null) {
+            message.status = data.status;
+        }
+        if (data.created_at != null) {
+            message.created_at = dependency_1.Timestamp.fromObject(data.created_at);
+        }
+        if (data.decided_by != null) {
+            message.decided_by = data.decided_by;
+        }
+        if (data.decided_at != null) {
+            message.decided_at = dependency_1.Timestamp.fromObject(data.decided_at);
+        }
+        return message;
+    }
+    toObject() {
+        const data: {
+            id?: string;
+            channel_id?: string;
+            user?: ReturnType<typeof dependency_2.User.prototype.toObject>;
+            user_id?: string;
+            message?: string;
+            status?: ChannelAccessRequestStatus;
+            created_at?: ReturnType<typeof dependency_1.Timestamp.prototype.toObject>;
+            decided_by?: string;
+            decided_at?: ReturnType<typeof dependency_1.Timestamp.prototype.toObject>;
+        } = {};
+        if (this.id != null) {
+            data.id = this.id;
+        }
+        if (this.channel_id != null) {
+            data.channel_id = this.channel_id;
+        }
+        if (this.user != null) {
+            data.user = this.user.toObject();
+        }
+        if (this.user_id != null) {
+            data.user_id = this.user_id;
+        }
+        if (this.message != null) {
+            data.message = this.message;
+        }
+        if (this.status != null) {
+            data.status = this.status;
+        }
+        if (this.created_at != null) {
+            data.created_at = this.created_at.toObject();
+        }
+        if (this.decided_by != null) {
+            data.decided_by = this.decided_by;
+        }
+        if (this.decided_at != null) {
+            data.decided_at = this.decided_at.toObject();
+        }
+        return data;
+    }
+    serialize(): Uint8Array;
+    serialize(w: pb_1.BinaryWriter): void;
+    serialize(w?: pb_1.BinaryWriter): Uint8Array | void {
+        const writer = w || new pb_1.BinaryWriter();
+        if (this.id.length)
+            writer.writeString(1, this.id);
+        if (this.channel_id.length)
+            writer.writeString(2, this.channel_id);
+        if (this.has_user)
+            writer.writeMessage(3, this.user, () => this.user.serialize(writer));
+        if (this.user_id.length)
+            writer.writeString(4, this.user_id);
+        if (this.message.length)
+            writer.writeString(5, this.message);
+        if (this.status != ChannelAccessRequestStatus.CHANNEL_ACCESS_REQUEST_STATUS_PENDING)
+            writer.writeEnum(6, this.status);
+        if (this.has_created_at)
+            writer.writeMessage(7, this.created_at, () => this.created_at.serialize(writer));
+        if (this.decided_by.length)
+            writer.writeString(8, this.decided_by);
+        if (this.has_decided_at)
+            writer.writeMessage(9, this.decided_at, () => this.decided_at.serialize(writer));
+        if (!w)
+            return writer.getResultBuffer();
+    }
+    static deserialize(bytes: Uint8Array | pb_1.BinaryReader): ChannelAccessRequest {
+        const reader = bytes instanceof pb_1.BinaryReader ? bytes : new pb_1.BinaryReader(bytes), message = new ChannelAccessRequest();
+        while (reader.nextField()) {
+            if (reader.isEndGroup())
+                break;
+            switch (reader.getFieldNumber()) {
+                case 1:
+                    message.id = reader.readString();
+                    break;
+                case 2:
+                    message.channel_id = reader.readString();
+                    break;
+                case 3:
+                    reader.readMessage(message.user, () => message.user = dependency_2.User.deserialize(reader));
+                    break;
+                case 4:
+                    message.user_id = reader.readString();
+                    break;
+                case 5:
+                    message.message = reader.readString();
+                    break;
+                case 6:
+                    message.status = reader.readEnum();
+                    break;
+                case 7:
+                    reader.readMessage(message.created_at, () => message.created_at = dependency_1.Timestamp.deserialize(reader));
+                    break;
+                case 8:
+                    message.decided_by = reader.readString();
+                    break;
+                case 9:
+                    reader.readMessage(message.decided_at, () => message.decided_at = dependency_1.Timestamp.deserialize(reader));
+                    break;
+                default: reader.skipField();
+            }
+        }
+        return message;
+    }
+    serializeBinary(): Uint8Array {
+        return this.serialize();
+    }
+    static deserializeBinary(bytes: Uint8Array): ChannelAccessRequest {
+        return ChannelAccessRequest.deserialize(bytes);
+    }
+}
+export class RequestChannelAccessRequest extends pb_1.Message {
+    #one_of_decls: number[][] = [];
+    constructor(data?: any[] | {
+        channel_id?: string;
+        message?: string;
+    }) {
+        super();
+        pb_1.Message.initialize(this, Array.isArray(data) ? data : [], 0, -1, [], this.#one_of_decls);
+        if (!Array.isArray(data) && typeof data == "object") {
+            if ("channel_id" in data && data.channel_id != undefined) {
+                this.channel_id = data.channel_id;
+            }
+            if ("message" in data && data.message != undefined) {
+                this.message = data.message;
+            }
+        }
+    }
+    get channel_id() {
+        return pb_1.Message.getFieldWithDefault(this, 1, "") as string;
+    }
+    set channel_id(value: string) {
+        pb_1.Message.setField(this, 1, value);
+    }
+    get message() {
+        return pb_1.Message.getFieldWithDefault(this, 2, "") as string;
+    }
+    set message(value: string) {
+        pb_1.Message.setField(this, 2, value);
+    }
+    static fromObject(data: {
+        channel_id?: string;
+        message?: string;
+    }): RequestChannelAccessRequest {
+        const message = new RequestChannelAccessRequest({});
+        if (data.channel_id != null) {
+            message.channel_id = data.channel_id;
         }
-        if (data.description != null) {
-            message.description = data.description;
+        if (data.message != null) {
+            message.message = data.message;
         }
-        if (data.created_by != null) {
-            message.created_by = data.created_by;
+        return message;
+    }
+    toObject() {
+        const data: {
+            channel_id?: string;
+            message?: string;
+        } = {};
+        if (this.channel_id != null) {
+            data.channel_id = this.channel_id;
         }
-        if (data.created_at != null) {
-            message.created_at = dependency_1.Timestamp.fromObject(data.created_at);
+        if (this.message != null) {
+            data.message = this.message;
         }
-        if (data.updated_at != null) {
-            message.updated_at = dependency_1.Timestamp.fromObject(data.updated_at);
+        return data;
+    }
+    serialize(): Uint8Array;
+    serialize(w: pb_1.BinaryWriter): void;
+    serialize(w?: pb_1.BinaryWriter): Uint8Array | void {
+        const writer = w || new pb_1.BinaryWriter();
+        if (this.channel_id.length)
+            writer.writeString(1, this.channel_id);
+        if (this.message.length)
+            writer.writeString(2, this.message);
+        if (!w)
+            return writer.getResultBuffer();
+    }
+    static deserialize(bytes: Uint8Array | pb_1.BinaryReader): RequestChannelAccessRequest {
+        const reader = bytes instanceof pb_1.BinaryReader ? bytes : new pb_1.BinaryReader(bytes), message = new RequestChannelAccessRequest();
+        while (reader.nextField()) {
+            if (reader.isEndGroup())
+                break;
+            switch (reader.getFieldNumber()) {
+                case 1:
+                    message.channel_id = reader.readString();
+                    break;
+                case 2:
+                    message.message = reader.readString();
+                    break;
+                default: reader.skipField();
+            }
         }
-        if (data.user_role != null) {
-            message.user_role = data.user_role;
+        return message;
+    }
+    serializeBinary(): Uint8Array {
+        return this.serialize();
+    }
+    static deserializeBinary(bytes: Uint8Array): RequestChannelAccessRequest {
+        return RequestChannelAccessRequest.deserialize(bytes);
+    }
+}
+export class RequestChannelAccessResponse extends pb_1.Message {
+    #one_of_decls: number[][] = [];
+    constructor(data?: any[] | {
+        message?: string;
+        access_request?: ChannelAccessRequest;
+    }) {
+        super();
+        pb_1.Message.initialize(this, Array.isArray(data) ? data : [], 0, -1, [], this.#one_of_decls);
+        if (!Array.isArray(data) && typeof data == "object") {
+            if ("message" in data && data.message != undefined) {
+                this.message = data.message;
+            }
+            if ("access_request" in data && data.access_request != undefined) {
+                this.access_request = data.access_request;
+            }
         }
-        if (data.member_count != null) {
-            message.member_count = data.member_count;
+    }
+    get message() {
+        return pb_1.Message.getFieldWithDefault(this, 1, "") as string;
+    }
+    set message(value: string) {
+        pb_1.Message.setField(this, 1, value);
+    }
+    get access_request() {
+        return pb_1.Message.getWrapperField(this, ChannelAccessRequest, 2) as ChannelAccessRequest;
+    }
+    set access_request(value: ChannelAccessRequest) {
+        pb_1.Message.setWrapperField(this, 2, value);
+    }
+    get has_access_request() {
+        return pb_1.Message.getField(this, 2) != null;
+    }
+    static fromObject(data: {
+        message?: string;
+        access_request?: ReturnType<typeof ChannelAccessRequest.prototype.toObject>;
+    }): RequestChannelAccessResponse {
+        const message = new RequestChannelAccessResponse({});
+        if (data.message != null) {
+            message.message = data.message;
         }
-        if (data.video_count != null) {
-            message.video_count = data.video_count;
+        if (data.access_request != null) {
+            message.access_request = ChannelAccessRequest.fromObject(data.access_request);
         }
         return message;
     }
     toObject() {
         const data: {
-            id?: string;
-            tenant_id?: string;
-            name?: string;
-            description?: string;
-            created_by?: string;
-            created_at?: ReturnType<typeof dependency_1.Timestamp.prototype.toObject>;
-            updated_at?: ReturnType<typeof dependency_1.Timestamp.prototype.toObject>;
-            user_role?: string;
-            member_count?: number;
-            video_count?: number;
+            message?: string;
+            access_request?: ReturnType<typeof ChannelAccessRequest.prototype.toObject>;
         } = {};
-        if (this.id != null) {
-            data.id = this.id;
+        if (this.message != null) {
+            data.message = this.message;
         }
-        if (this.tenant_id != null) {
-            data.tenant_id = this.tenant_id;
+        if (this.access_request != null) {
+            data.access_request = this.access_request.toObject();
         }
-        if (this.name != null) {
-            data.name = this.name;
+        return data;
+    }
+    serialize(): Uint8Array;
+    serialize(w: pb_1.BinaryWriter): void;
+    serialize(w?: pb_1.BinaryWriter): Uint8Array | void {
+        const writer = w || new pb_1.BinaryWriter();
+        if (this.message.length)
+            writer.writeString(1, this.message);
+        if (this.has_access_request)
+            writer.writeMessage(2, this.access_request, () => this.access_request.serialize(writer));
+        if (!w)
+            return writer.getResultBuffer();
+    }
+    static deserialize(bytes: Uint8Array | pb_1.BinaryReader): RequestChannelAccessResponse {
+        const reader = bytes instanceof pb_1.BinaryReader ? bytes : new pb_1.BinaryReader(bytes), message = new RequestChannelAccessResponse();
+        while (reader.nextField()) {
+            if (reader.isEndGroup())
+                break;
+            switch (reader.getFieldNumber()) {
+                case 1:
+                    message.message = reader.readString();
+                    break;
+                case 2:
+                    reader.readMessage(message.access_request, () => message.access_request = ChannelAccessRequest.deserialize(reader));
+                    break;
+                default: reader.skipField();
+            }
         }
-        if (this.description != null) {
-            data.description = this.description;
+        return message;
+    }
+    serializeBinary(): Uint8Array {
+        return this.serialize();
+    }
+    static deserializeBinary(bytes: Uint8Array): RequestChannelAccessResponse {
+        return RequestChannelAccessResponse.deserialize(bytes);
+    }
+}
+export class GetChannelAccessRequestsRequest extends pb_1.Message {
+    #one_of_decls: number[][] = [];
+    constructor(data?: any[] | {
+        channel_id?: string;
+    }) {
+        super();
+        pb_1.Message.initialize(this, Array.isArray(data) ? data : [], 0, -1, [], this.#one_of_decls);
+        if (!Array.isArray(data) && typeof data == "object") {
+            if ("channel_id" in data && data.channel_id != undefined) {
+                this.channel_id = data.channel_id;
+            }
         }
-        if (this.created_by != null) {
-            data.created_by = this.created_by;
+    }
+    get channel_id() {
+        return pb_1.Message.getFieldWithDefault(this, 1, "") as string;
+    }
+    set channel_id(value: string) {
+        pb_1.Message.setField(this, 1, value);
+    }
+    static fromObject(data: {
+        channel_id?: string;
+    }): GetChannelAccessRequestsRequest {
+        const message = new GetChannelAccessRequestsRequest({});
+        if (data.channel_id != null) {
+            message.channel_id = data.channel_id;
         }
-        if (this.created_at != null) {
-            data.created_at = this.created_at.toObject();
+        return message;
+    }
+    toObject() {
+        const data: {
+            channel_id?: string;
+        } = {};
+        if (this.channel_id != null) {
+            data.channel_id = this.channel_id;
         }
-        if (this.updated_at != null) {
-            data.updated_at = this.updated_at.toObject();
+        return data;
+    }
+    serialize(): Uint8Array;
+    serialize(w: pb_1.BinaryWriter): void;
+    serialize(w?: pb_1.BinaryWriter): Uint8Array | void {
+        const writer = w || new pb_1.BinaryWriter();
+        if (this.channel_id.length)
+            writer.writeString(1, this.channel_id);
+        if (!w)
+            return writer.getResultBuffer();
+    }
+    static deserialize(bytes: Uint8Array | pb_1.BinaryReader): GetChannelAccessRequestsRequest {
+        const reader = bytes instanceof pb_1.BinaryReader ? bytes : new pb_1.BinaryReader(bytes), message = new GetChannelAccessRequestsRequest();
+        while (reader.nextField()) {
+            if (reader.isEndGroup())
+                break;
+            switch (reader.getFieldNumber()) {
+                case 1:
+                    message.channel_id = reader.readString();
+                    break;
+                default: reader.skipField();
+            }
         }
-        if (this.user_role != null) {
-            data.user_role = this.user_role;
+        return message;
+    }
+    serializeBinary(): Uint8Array {
+        return this.serialize();
+    }
+    static deserializeBinary(bytes: Uint8Array): GetChannelAccessRequestsRequest {
+        return GetChannelAccessRequestsRequest.deserialize(bytes);
+    }
+}
+export class GetChannelAccessRequestsResponse extends pb_1.Message {
+    #one_of_decls: number[][] = [];
+    constructor(data?: any[] | {
+        message?: string;
+        access_requests?: ChannelAccessRequest[];
+    }) {
+        super();
+        pb_1.Message.initialize(this, Array.isArray(data) ? data : [], 0, -1, [2], this.#one_of_decls);
+        if (!Array.isArray(data) && typeof data == "object") {
+            if ("message" in data && data.message != undefined) {
+                this.message = data.message;
+            }
+            if ("access_requests" in data && data.access_requests != undefined) {
+                this.access_requests = data.access_requests;
+            }
         }
-        if (this.member_count != null) {
-            data.member_count = this.member_count;
+    }
+    get message() {
+        return pb_1.Message.getFieldWithDefault(this, 1, "") as string;
+    }
+    set message(value: string) {
+        pb_1.Message.setField(this, 1, value);
+    }
+    get access_requests() {
+        return pb_1.Message.getRepeatedWrapperField(this, ChannelAccessRequest, 2) as ChannelAccessRequest[];
+    }
+    set access_requests(value: ChannelAccessRequest[]) {
+        pb_1.Message.setRepeatedWrapperField(this, 2, value);
+    }
+    static fromObject(data: {
+        message?: string;
+        access_requests?: ReturnType<typeof ChannelAccessRequest.prototype.toObject>[];
+    }): GetChannelAccessRequestsResponse {
+        const message = new GetChannelAccessRequestsResponse({});
+        if (data.message != null) {
+            message.message = data.message;
+        }
+        if (data.access_requests != null) {
+            message.access_requests = data.access_requests.map(item => ChannelAccessRequest.fromObject(item));
+        }
+        return message;
+    }
+    toObject() {
+        const data: {
+            message?: string;
+            access_requests?: ReturnType<typeof ChannelAccessRequest.prototype.toObject>[];
+        } = {};
+        if (this.message != null) {
+            data.message = this.message;
         }
-        if (this.video_count != null) {
-            data.video_count = this.video_count;
+        if (this.access_requests != null) {
+            data.access_requests = this.access_requests.map((item: ChannelAccessRequest) => item.toObject());
         }
         return data;
     }
@@ -1356,64 +5845,24 @@ export class Channel extends pb_1.Message {
     serialize(w: pb_1.BinaryWriter): void;
     serialize(w?: pb_1.BinaryWriter): Uint8Array | void {
         const writer = w || new pb_1.BinaryWriter();
-        if (this.id.length)
-            writer.writeString(1, this.id);
-        if (this.tenant_id.length)
-            writer.writeString(2, this.tenant_id);
-        if (this.name.length)
-            writer.writeString(3, this.name);
-        if (this.description.length)
-            writer.writeString(4, this.description);
-        if (this.created_by.length)
-            writer.writeString(6, this.created_by);
-        if (this.has_created_at)
-            writer.writeMessage(7, this.created_at, () => this.created_at.serialize(writer));
-        if (this.has_updated_at)
-            writer.writeMessage(8, this.updated_at, () => this.updated_at.serialize(writer));
-        if (this.user_role.length)
-            writer.writeString(9, this.user_role);
-        if (this.member_count != 0)
-            writer.writeInt32(10, this.member_count);
-        if (this.video_count != 0)
-            writer.writeInt32(11, this.video_count);
+        if (this.message.length)
+            writer.writeString(1, this.message);
+        if (this.access_requests.length)
+            writer.writeRepeatedMessage(2, this.access_requests, (item: ChannelAccessRequest) => item.serialize(writer));
         if (!w)
             return writer.getResultBuffer();
     }
-    static deserialize(bytes: Uint8Array | pb_1.BinaryReader): Channel {
-        const reader = bytes instanceof pb_1.BinaryReader ? bytes : new pb_1.BinaryReader(bytes), message = new Channel();
+    static deserialize(bytes: Uint8Array | pb_1.BinaryReader): GetChannelAccessRequestsResponse {
+        const reader = bytes instanceof pb_1.BinaryReader ? bytes : new pb_1.BinaryReader(bytes), message = new GetChannelAccessRequestsResponse();
         while (reader.nextField()) {
             if (reader.isEndGroup())
                 break;
             switch (reader.getFieldNumber()) {
                 case 1:
-                    message.id = reader.readString();
+                    message.message = reader.readString();
                     break;
                 case 2:
-                    message.tenant_id = reader.readString();
-                    break;
-                case 3:
-                    message.name = reader.readString();
-                    break;
-                case 4:
-                    message.description = reader.readString();
-                    break;
-                case 6:
-                    message.created_by = reader.readString();
-                    break;
-                case 7:
-                    reader.readMessage(message.created_at, () => message.created_at = dependency_1.Timestamp.deserialize(reader));
-                    break;
-                case 8:
-                    reader.readMessage(message.updated_at, () => message.updated_at = dependency_1.Timestamp.deserialize(reader));
-                    break;
-                case 9:
-                    message.user_role = reader.readString();
-                    break;
-                case 10:
-                    message.member_count = reader.readInt32();
-                    break;
-                case 11:
-                    message.video_count = reader.readInt32();
+                    reader.readMessage(message.access_requests, () => pb_1.Message.addToRepeatedWrapperField(message, 2, ChannelAccessRequest.deserialize(reader), ChannelAccessRequest));
                     break;
                 default: reader.skipField();
             }
@@ -1423,43 +5872,32 @@ export class Channel extends pb_1.Message {
     serializeBinary(): Uint8Array {
         return this.serialize();
     }
-    static deserializeBinary(bytes: Uint8Array): Channel {
-        return Channel.deserialize(bytes);
+    static deserializeBinary(bytes: Uint8Array): GetChannelAccessRequestsResponse {
+        return GetChannelAccessRequestsResponse.deserialize(bytes);
     }
 }
-export class ChannelMember extends pb_1.Message {
+export class ApproveChannelAccessRequest extends pb_1.Message {
     #one_of_decls: number[][] = [];
     constructor(data?: any[] | {
-        user?: dependency_2.User;
+        request_id?: string;
         role?: string;
-        added_by?: string;
-        created_at?: dependency_1.Timestamp;
     }) {
         super();
         pb_1.Message.initialize(this, Array.isArray(data) ? data : [], 0, -1, [], this.#one_of_decls);
         if (!Array.isArray(data) && typeof data == "object") {
-            if ("user" in data && data.user != undefined) {
-                this.user = data.user;
+            if ("request_id" in data && data.request_id != undefined) {
+                this.request_id = data.request_id;
             }
             if ("role" in data && data.role != undefined) {
                 this.role = data.role;
             }
-            if ("added_by" in data && data.added_by != undefined) {
-                this.added_by = data.added_by;
-            }
-            if ("created_at" in data && data.created_at != undefined) {
-                this.created_at = data.created_at;
-            }
         }
     }
-    get user() {
-        return pb_1.Message.getWrapperField(this, dependency_2.User, 1) as dependency_2.User;
-    }
-    set user(value: dependency_2.User) {
-        pb_1.Message.setWrapperField(this, 1, value);
+    get request_id() {
+        return pb_1.Message.getFieldWithDefault(this, 1, "") as string;
     }
-    get has_user() {
-        return pb_1.Message.getField(this, 1) != null;
+    set request_id(value: string) {
+        pb_1.Message.setField(this, 1, value);
     }
     get role() {
         return pb_1.Message.getFieldWithDefault(this, 2, "") as string;
@@ -1467,96 +5905,55 @@ export class ChannelMember extends pb_1.Message {
     set role(value: string) {
         pb_1.Message.setField(this, 2, value);
     }
-    get added_by() {
-        return pb_1.Message.getFieldWithDefault(this, 3, "") as string;
-    }
-    set added_by(value: string) {
-        pb_1.Message.setField(this, 3, value);
-    }
-    get created_at() {
-        return pb_1.Message.getWrapperField(this, dependency_1.Timestamp, 4) as dependency_1.Timestamp;
-    }
-    set created_at(value: dependency_1.Timestamp) {
-        pb_1.Message.setWrapperField(this, 4, value);
-    }
-    get has_created_at() {
-        return pb_1.Message.getField(this, 4) != null;
-    }
     static fromObject(data: {
-        user?: ReturnType<typeof dependency_2.User.prototype.toObject>;
+        request_id?: string;
         role?: string;
-        added_by?: string;
-        created_at?: ReturnType<typeof dependency_1.Timestamp.prototype.toObject>;
-    }): ChannelMember {
-        const message = new ChannelMember({});
-        if (data.user != null) {
-            message.user = dependency_2.User.fromObject(data.user);
+    }): ApproveChannelAccessRequest {
+        const message = new ApproveChannelAccessRequest({});
+        if (data.request_id != null) {
+            message.request_id = data.request_id;
         }
         if (data.role != null) {
             message.role = data.role;
         }
-        if (data.added_by != null) {
-            message.added_by = data.added_by;
-        }
-        if (data.created_at != null) {
-            message.created_at = dependency_1.Timestamp.fromObject(data.created_at);
-        }
         return message;
     }
     toObject() {
         const data: {
-            user?: ReturnType<typeof dependency_2.User.prototype.toObject>;
+            request_id?: string;
             role?: string;
-            added_by?: string;
-            created_at?: ReturnType<typeof dependency_1.Timestamp.prototype.toObject>;
         } = {};
-        if (this.user != null) {
-            data.user = this.user.toObject();
+        if (this.request_id != null) {
+            data.request_id = this.request_id;
         }
         if (this.role != null) {
             data.role = this.role;
         }
-        if (this.added_by != null) {
-            data.added_by = this.added_by;
-        }
-        if (this.created_at != null) {
-            data.created_at = this.created_at.toObject();
-        }
         return data;
     }
     serialize(): Uint8Array;
     serialize(w: pb_1.BinaryWriter): void;
     serialize(w?: pb_1.BinaryWriter): Uint8Array | void {
         const writer = w || new pb_1.BinaryWriter();
-        if (this.has_user)
-            writer.writeMessage(1, this.user, () => this.user.serialize(writer));
+        if (this.request_id.length)
+            writer.writeString(1, this.request_id);
         if (this.role.length)
             writer.writeString(2, this.role);
-        if (this.added_by.length)
-            writer.writeString(3, this.added_by);
-        if (this.has_created_at)
-            writer.writeMessage(4, this.created_at, () => this.created_at.serialize(writer));
         if (!w)
             return writer.getResultBuffer();
     }
-    static deserialize(bytes: Uint8Array | pb_1.BinaryReader): ChannelMember {
-        const reader = bytes instanceof pb_1.BinaryReader ? bytes : new pb_1.BinaryReader(bytes), message = new ChannelMember();
+    static deserialize(bytes: Uint8Array | pb_1.BinaryReader): ApproveChannelAccessRequest {
+        const reader = bytes instanceof pb_1.BinaryReader ? bytes : new pb_1.BinaryReader(bytes), message = new ApproveChannelAccessRequest();
         while (reader.nextField()) {
             if (reader.isEndGroup())
                 break;
             switch (reader.getFieldNumber()) {
                 case 1:
-                    reader.readMessage(message.user, () => message.user = dependency_2.User.deserialize(reader));
+                    message.request_id = reader.readString();
                     break;
                 case 2:
                     message.role = reader.readString();
                     break;
-                case 3:
-                    message.added_by = reader.readString();
-                    break;
-                case 4:
-                    reader.readMessage(message.created_at, () => message.created_at = dependency_1.Timestamp.deserialize(reader));
-                    break;
                 default: reader.skipField();
             }
         }
@@ -1565,62 +5962,111 @@ export class ChannelMember extends pb_1.Message {
     serializeBinary(): Uint8Array {
         return this.serialize();
     }
-    static deserializeBinary(bytes: Uint8Array): ChannelMember {
-        return ChannelMember.deserialize(bytes);
+    static deserializeBinary(bytes: Uint8Array): ApproveChannelAccessRequest {
+        return ApproveChannelAccessRequest.deserialize(bytes);
     }
 }
-export class CreateChannelRequest extends pb_1.Message {
+export class ApproveChannelAccessResponse extends pb_1.Message {
     #one_of_decls: number[][] = [];
     constructor(data?: any[] | {
-        name?: string;
-        description?: string;
+        message?: string;
     }) {
         super();
         pb_1.Message.initialize(this, Array.isArray(data) ? data : [], 0, -1, [], this.#one_of_decls);
         if (!Array.isArray(data) && typeof data == "object") {
-            if ("name" in data && data.name != undefined) {
-                this.name = data.name;
+            if ("message" in data && data.message != undefined) {
+                this.message = data.message;
             }
-            if ("description" in data && data.description != undefined) {
-                this.description = data.description;
+        }
+    }
+    get message() {
+        return pb_1.Message.getFieldWithDefault(this, 1, "") as string;
+    }
+    set message(value: string) {
+        pb_1.Message.setField(this, 1, value);
+    }
+    static fromObject(data: {
+        message?: string;
+    }): ApproveChannelAccessResponse {
+        const message = new ApproveChannelAccessResponse({});
+        if (data.message != null) {
+            message.message = data.message;
+        }
+        return message;
+    }
+    toObject() {
+        const data: {
+            message?: string;
+        } = {};
+        if (this.message != null) {
+            data.message = this.message;
+        }
+        return data;
+    }
+    serialize(): Uint8Array;
+    serialize(w: pb_1.BinaryWriter): void;
+    serialize(w?: pb_1.BinaryWriter): Uint8Array | void {
+        const writer = w || new pb_1.BinaryWriter();
+        if (this.message.length)
+            writer.writeString(1, this.message);
+        if (!w)
+            return writer.getResultBuffer();
+    }
+    static deserialize(bytes: Uint8Array | pb_1.BinaryReader): ApproveChannelAccessResponse {
+        const reader = bytes instanceof pb_1.BinaryReader ? bytes : new pb_1.BinaryReader(bytes), message = new ApproveChannelAccessResponse();
+        while (reader.nextField()) {
+            if (reader.isEndGroup())
+                break;
+            switch (reader.getFieldNumber()) {
+                case 1:
+                    message.message = reader.readString();
+                    break;
+                default: reader.skipField();
             }
         }
+        return message;
     }
-    get name() {
-        return pb_1.Message.getFieldWithDefault(this, 2, "") as string;
+    serializeBinary(): Uint8Array {
+        return this.serialize();
     }
-    set name(value: string) {
-        pb_1.Message.setField(this, 2, value);
+    static deserializeBinary(bytes: Uint8Array): ApproveChannelAccessResponse {
+        return ApproveChannelAccessResponse.deserialize(bytes);
     }
-    get description() {
-        return pb_1.Message.getFieldWithDefault(this, 3, "") as string;
+}
+export class DenyChannelAccessRequest extends pb_1.Message {
+    #one_of_decls: number[][] = [];
+    constructor(data?: any[] | {
+        request_id?: string;
+    }) {
+        super();
+        pb_1.Message.initialize(this, Array.isArray(data) ? data : [], 0, -1, [], this.#one_of_decls);
+        if (!Array.isArray(data) && typeof data == "object") {
+            if ("request_id" in data && data.request_id != undefined) {
+                this.request_id = data.request_id;
+            }
+        }
     }
-    set description(value: string) {
-        pb_1.Message.setField(this, 3, value);
+    get request_id() {
+        return pb_1.Message.getFieldWithDefault(this, 1, "") as string;
+    }
+    set request_id(value: string) {
+        pb_1.Message.setField(this, 1, value);
     }
     static fromObject(data: {
-        name?: string;
-        description?: string;
-    }): CreateChannelRequest {
-        const message = new CreateChannelRequest({});
-        if (data.name != null) {
-            message.name = data.name;
-        }
-        if (data.description != null) {
-            message.description = data.description;
+        request_id?: string;
+    }): DenyChannelAccessRequest {
+        const message = new DenyChannelAccessRequest({});
+        if (data.request_id != null) {
+            message.request_id = data.request_id;
         }
         return message;
     }
     toObject() {
         const data: {
-            name?: string;
-            description?: string;
+            request_id?: string;
         } = {};
-        if (this.name != null) {
-            data.name = this.name;
-        }
-        if (this.description != null) {
-            data.description = this.description;
+        if (this.request_id != null) {
+            data.request_id = this.request_id;
         }
         return data;
     }
@@ -1628,24 +6074,19 @@ export class CreateChannelRequest extends pb_1.Message {
     serialize(w: pb_1.BinaryWriter): void;
     serialize(w?: pb_1.BinaryWriter): Uint8Array | void {
         const writer = w || new pb_1.BinaryWriter();
-        if (this.name.length)
-            writer.writeString(2, this.name);
-        if (this.description.length)
-            writer.writeString(3, this.description);
+        if (this.request_id.length)
+            writer.writeString(1, this.request_id);
         if (!w)
             return writer.getResultBuffer();
     }
-    static deserialize(bytes: Uint8Array | pb_1.BinaryReader): CreateChannelRequest {
-        const reader = bytes instanceof pb_1.BinaryReader ? bytes : new pb_1.BinaryReader(bytes), message = new CreateChannelRequest();
+    static deserialize(bytes: Uint8Array | pb_1.BinaryReader): DenyChannelAccessRequest {
+        const reader = bytes instanceof pb_1.BinaryReader ? bytes : new pb_1.BinaryReader(bytes), message = new DenyChannelAccessRequest();
         while (reader.nextField()) {
             if (reader.isEndGroup())
                 break;
             switch (reader.getFieldNumber()) {
-                case 2:
-                    message.name = reader.readString();
-                    break;
-                case 3:
-                    message.description = reader.readString();
+                case 1:
+                    message.request_id = reader.readString();
                     break;
                 default: reader.skipField();
             }
@@ -1655,15 +6096,14 @@ export class CreateChannelRequest extends pb_1.Message {
     serializeBinary(): Uint8Array {
         return this.serialize();
     }
-    static deserializeBinary(bytes: Uint8Array): CreateChannelRequest {
-        return CreateChannelRequest.deserialize(bytes);
+    static deserializeBinary(bytes: Uint8Array): DenyChannelAccessRequest {
+        return DenyChannelAccessRequest.deserialize(bytes);
     }
 }
-export class CreateChannelResponse extends pb_1.Message {
+export class DenyChannelAccessResponse extends pb_1.Message {
     #one_of_decls: number[][] = [];
     constructor(data?: any[] | {
         message?: string;
-        channel?: Channel;
     }) {
         super();
         pb_1.Message.initialize(this, Array.isArray(data) ? data : [], 0, -1, [], this.#one_of_decls);
@@ -1671,50 +6111,30 @@ export class CreateChannelResponse extends pb_1.Message {
             if ("message" in data && data.message != undefined) {
                 this.message = data.message;
             }
-            if ("channel" in data && data.channel != undefined) {
-                this.channel = data.channel;
-            }
         }
     }
     get message() {
         return pb_1.Message.getFieldWithDefault(this, 1, "") as string;
     }
-    set message(value: string) {
-        pb_1.Message.setField(this, 1, value);
-    }
-    get channel() {
-        return pb_1.Message.getWrapperField(this, Channel, 2) as Channel;
-    }
-    set channel(value: Channel) {
-        pb_1.Message.setWrapperField(this, 2, value);
-    }
-    get has_channel() {
-        return pb_1.Message.getField(this, 2) != null;
+    set message(value: string) {
+        pb_1.Message.setField(this, 1, value);
     }
     static fromObject(data: {
         message?: string;
-        channel?: ReturnType<typeof Channel.prototype.toObject>;
-    }): CreateChannelResponse {
-        const message = new CreateChannelResponse({});
+    }): DenyChannelAccessResponse {
+        const message = new DenyChannelAccessResponse({});
         if (data.message != null) {
             message.message = data.message;
         }
-        if (data.channel != null) {
-            message.channel = Channel.fromObject(data.channel);
-        }
         return message;
     }
     toObject() {
         const data: {
             message?: string;
-            channel?: ReturnType<typeof Channel.prototype.toObject>;
         } = {};
         if (this.message != null) {
             data.message = this.message;
         }
-        if (this.channel != null) {
-            data.channel = this.channel.toObject();
-        }
         return data;
     }
     serialize(): Uint8Array;
@@ -1723,13 +6143,11 @@ export class CreateChannelResponse extends pb_1.Message {
         const writer = w || new pb_1.BinaryWriter();
         if (this.message.length)
             writer.writeString(1, this.message);
-        if (this.has_channel)
-            writer.writeMessage(2, this.channel, () => this.channel.serialize(writer));
         if (!w)
             return writer.getResultBuffer();
     }
-    static deserialize(bytes: Uint8Array | pb_1.BinaryReader): CreateChannelResponse {
-        const reader = bytes instanceof pb_1.BinaryReader ? bytes : new pb_1.BinaryReader(bytes), message = new CreateChannelResponse();
+    static deserialize(bytes: Uint8Array | pb_1.BinaryReader): DenyChannelAccessResponse {
+        const reader = bytes instanceof pb_1.BinaryReader ? bytes : new pb_1.BinaryReader(bytes), message = new DenyChannelAccessResponse();
         while (reader.nextField()) {
             if (reader.isEndGroup())
                 break;
@@ -1737,9 +6155,6 @@ export class CreateChannelResponse extends pb_1.Message {
                 case 1:
                     message.message = reader.readString();
                     break;
-                case 2:
-                    reader.readMessage(message.channel, () => message.channel = Channel.deserialize(reader));
-                    break;
                 default: reader.skipField();
             }
         }
@@ -1748,16 +6163,14 @@ export class CreateChannelResponse extends pb_1.Message {
     serializeBinary(): Uint8Array {
         return this.serialize();
     }
-    static deserializeBinary(bytes: Uint8Array): CreateChannelResponse {
-        return CreateChannelResponse.deserialize(bytes);
+    static deserializeBinary(bytes: Uint8Array): DenyChannelAccessResponse {
+        return DenyChannelAccessResponse.deserialize(bytes);
     }
 }
-export class UpdateChannelRequest extends pb_1.Message {
+export class ArchiveChannelRequest extends pb_1.Message {
     #one_of_decls: number[][] = [];
     constructor(data?: any[] | {
         channel_id?: string;
-        name?: string;
-        description?: string;
     }) {
         super();
         pb_1.Message.initialize(this, Array.isArray(data) ? data : [], 0, -1, [], this.#one_of_decls);
@@ -1765,64 +6178,30 @@ export class UpdateChannelRequest extends pb_1.Message {
             if ("channel_id" in data && data.channel_id != undefined) {
                 this.channel_id = data.channel_id;
             }
-            if ("name" in data && data.name != undefined) {
-                this.name = data.name;
-            }
-            if ("description" in data && data.description != undefined) {
-                this.description = data.description;
-            }
         }
     }
     get channel_id() {
-        return pb_1.Message.getFieldWithDefault(this, 2, "") as string;
+        return pb_1.Message.getFieldWithDefault(this, 1, "") as string;
     }
     set channel_id(value: string) {
-        pb_1.Message.setField(this, 2, value);
-    }
-    get name() {
-        return pb_1.Message.getFieldWithDefault(this, 3, "") as string;
-    }
-    set name(value: string) {
-        pb_1.Message.setField(this, 3, value);
-    }
-    get description() {
-        return pb_1.Message.getFieldWithDefault(this, 4, "") as string;
-    }
-    set description(value: string) {
-        pb_1.Message.setField(this, 4, value);
+        pb_1.Message.setField(this, 1, value);
     }
     static fromObject(data: {
         channel_id?: string;
-        name?: string;
-        description?: string;
-    }): UpdateChannelRequest {
-        const message = new UpdateChannelRequest({});
+    }): ArchiveChannelRequest {
+        const message = new ArchiveChannelRequest({});
         if (data.channel_id != null) {
             message.channel_id = data.channel_id;
         }
-        if (data.name != null) {
-            message.name = data.name;
-        }
-        if (data.description != null) {
-            message.description = data.description;
-        }
         return message;
     }
     toObject() {
         const data: {
             channel_id?: string;
-            name?: string;
-            description?: string;
         } = {};
         if (this.channel_id != null) {
             data.channel_id = this.channel_id;
         }
-        if (this.name != null) {
-            data.name = this.name;
-        }
-        if (this.description != null) {
-            data.description = this.description;
-        }
         return data;
     }
     serialize(): Uint8Array;
@@ -1830,29 +6209,19 @@ export class UpdateChannelRequest extends pb_1.Message {
     serialize(w?: pb_1.BinaryWriter): Uint8Array | void {
         const writer = w || new pb_1.BinaryWriter();
         if (this.channel_id.length)
-            writer.writeString(2, this.channel_id);
-        if (this.name.length)
-            writer.writeString(3, this.name);
-        if (this.description.length)
-            writer.writeString(4, this.description);
+            writer.writeString(1, this.channel_id);
         if (!w)
             return writer.getResultBuffer();
     }
-    static deserialize(bytes: Uint8Array | pb_1.BinaryReader): UpdateChannelRequest {
-        const reader = bytes instanceof pb_1.BinaryReader ? bytes : new pb_1.BinaryReader(bytes), message = new UpdateChannelRequest();
+    static deserialize(bytes: Uint8Array | pb_1.BinaryReader): ArchiveChannelRequest {
+        const reader = bytes instanceof pb_1.BinaryReader ? bytes : new pb_1.BinaryReader(bytes), message = new ArchiveChannelRequest();
         while (reader.nextField()) {
             if (reader.isEndGroup())
                 break;
             switch (reader.getFieldNumber()) {
-                case 2:
+                case 1:
                     message.channel_id = reader.readString();
                     break;
-                case 3:
-                    message.name = reader.readString();
-                    break;
-                case 4:
-                    message.description = reader.readString();
-                    break;
                 default: reader.skipField();
             }
         }
@@ -1861,11 +6230,11 @@ export class UpdateChannelRequest extends pb_1.Message {
     serializeBinary(): Uint8Array {
         return this.serialize();
     }
-    static deserializeBinary(bytes: Uint8Array): UpdateChannelRequest {
-        return UpdateChannelRequest.deserialize(bytes);
+    static deserializeBinary(bytes: Uint8Array): ArchiveChannelRequest {
+        return ArchiveChannelRequest.deserialize(bytes);
     }
 }
-export class UpdateChannelResponse extends pb_1.Message {
+export class ArchiveChannelResponse extends pb_1.Message {
     #one_of_decls: number[][] = [];
     constructor(data?: any[] | {
         message?: string;
@@ -1900,8 +6269,8 @@ export class UpdateChannelResponse extends pb_1.Message {
     static fromObject(data: {
         message?: string;
         channel?: ReturnType<typeof Channel.prototype.toObject>;
-    }): UpdateChannelResponse {
-        const message = new UpdateChannelResponse({});
+    }): ArchiveChannelResponse {
+        const message = new ArchiveChannelResponse({});
         if (data.message != null) {
             message.message = data.message;
         }
@@ -1934,8 +6303,8 @@ export class UpdateChannelResponse extends pb_1.Message {
         if (!w)
             return writer.getResultBuffer();
     }
-    static deserialize(bytes: Uint8Array | pb_1.BinaryReader): UpdateChannelResponse {
-        const reader = bytes instanceof pb_1.BinaryReader ? bytes : new pb_1.BinaryReader(bytes), message = new UpdateChannelResponse();
+    static deserialize(bytes: Uint8Array | pb_1.BinaryReader): ArchiveChannelResponse {
+        const reader = bytes instanceof pb_1.BinaryReader ? bytes : new pb_1.BinaryReader(bytes), message = new ArchiveChannelResponse();
         while (reader.nextField()) {
             if (reader.isEndGroup())
                 break;
@@ -1954,38 +6323,65 @@ export class UpdateChannelResponse extends pb_1.Message {
     serializeBinary(): Uint8Array {
         return this.serialize();
     }
-    static deserializeBinary(bytes: Uint8Array): UpdateChannelResponse {
-        return UpdateChannelResponse.deserialize(bytes);
+    static deserializeBinary(bytes: Uint8Array): ArchiveChannelResponse {
+        return ArchiveChannelResponse.deserialize(bytes);
     }
 }
-export class GetChannelsRequest extends pb_1.Message {
+export class UnarchiveChannelRequest extends pb_1.Message {
     #one_of_decls: number[][] = [];
-    constructor(data?: any[] | {}) {
+    constructor(data?: any[] | {
+        channel_id?: string;
+    }) {
         super();
         pb_1.Message.initialize(this, Array.isArray(data) ? data : [], 0, -1, [], this.#one_of_decls);
-        if (!Array.isArray(data) && typeof data == "object") { }
+        if (!Array.isArray(data) && typeof data == "object") {
+            if ("channel_id" in data && data.channel_id != undefined) {
+                this.channel_id = data.channel_id;
+            }
+        }
     }
-    static fromObject(data: {}): GetChannelsRequest {
-        const message = new GetChannelsRequest({});
+    get channel_id() {
+        return pb_1.Message.getFieldWithDefault(this, 1, "") as string;
+    }
+    set channel_id(value: string) {
+        pb_1.Message.setField(this, 1, value);
+    }
+    static fromObject(data: {
+        channel_id?: string;
+    }): UnarchiveChannelRequest {
+        const message = new UnarchiveChannelRequest({});
+        if (data.channel_id != null) {
+            message.channel_id = data.channel_id;
+        }
         return message;
     }
     toObject() {
-        const data: {} = {};
+        const data: {
+            channel_id?: string;
+        } = {};
+        if (this.channel_id != null) {
+            data.channel_id = this.channel_id;
+        }
         return data;
     }
     serialize(): Uint8Array;
     serialize(w: pb_1.BinaryWriter): void;
     serialize(w?: pb_1.BinaryWriter): Uint8Array | void {
         const writer = w || new pb_1.BinaryWriter();
+        if (this.channel_id.length)
+            writer.writeString(1, this.channel_id);
         if (!w)
             return writer.getResultBuffer();
     }
-    static deserialize(bytes: Uint8Array | pb_1.BinaryReader): GetChannelsRequest {
-        const reader = bytes instanceof pb_1.BinaryReader ? bytes : new pb_1.BinaryReader(bytes), message = new GetChannelsRequest();
+    static deserialize(bytes: Uint8Array | pb_1.BinaryReader): UnarchiveChannelRequest {
+        const reader = bytes instanceof pb_1.BinaryReader ? bytes : new pb_1.BinaryReader(bytes), message = new UnarchiveChannelRequest();
         while (reader.nextField()) {
             if (reader.isEndGroup())
                 break;
             switch (reader.getFieldNumber()) {
+                case 1:
+                    message.channel_id = reader.readString();
+                    break;
                 default: reader.skipField();
             }
         }
@@ -1994,24 +6390,24 @@ export class GetChannelsRequest extends pb_1.Message {
     serializeBinary(): Uint8Array {
         return this.serialize();
     }
-    static deserializeBinary(bytes: Uint8Array): GetChannelsRequest {
-        return GetChannelsRequest.deserialize(bytes);
+    static deserializeBinary(bytes: Uint8Array): UnarchiveChannelRequest {
+        return UnarchiveChannelRequest.deserialize(bytes);
     }
 }
-export class GetChannelsResponse extends pb_1.Message {
+export class UnarchiveChannelResponse extends pb_1.Message {
     #one_of_decls: number[][] = [];
     constructor(data?: any[] | {
         message?: string;
-        channels?: Channel[];
+        channel?: Channel;
     }) {
         super();
-        pb_1.Message.initialize(this, Array.isArray(data) ? data : [], 0, -1, [2], this.#one_of_decls);
+        pb_1.Message.initialize(this, Array.isArray(data) ? data : [], 0, -1, [], this.#one_of_decls);
         if (!Array.isArray(data) && typeof data == "object") {
             if ("message" in data && data.message != undefined) {
                 this.message = data.message;
             }
-            if ("channels" in data && data.channels != undefined) {
-                this.channels = data.channels;
+            if ("channel" in data && data.channel != undefined) {
+                this.channel = data.channel;
             }
         }
     }
@@ -2021,35 +6417,38 @@ export class GetChannelsResponse extends pb_1.Message {
     set message(value: string) {
         pb_1.Message.setField(this, 1, value);
     }
-    get channels() {
-        return pb_1.Message.getRepeatedWrapperField(this, Channel, 2) as Channel[];
+    get channel() {
+        return pb_1.Message.getWrapperField(this, Channel, 2) as Channel;
     }
-    set channels(value: Channel[]) {
-        pb_1.Message.setRepeatedWrapperField(this, 2, value);
+    set channel(value: Channel) {
+        pb_1.Message.setWrapperField(this, 2, value);
+    }
+    get has_channel() {
+        return pb_1.Message.getField(this, 2) != null;
     }
     static fromObject(data: {
         message?: string;
-        channels?: ReturnType<typeof Channel.prototype.toObject>[];
-    }): GetChannelsResponse {
-        const message = new GetChannelsResponse({});
+        channel?: ReturnType<typeof Channel.prototype.toObject>;
+    }): UnarchiveChannelResponse {
+        const message = new UnarchiveChannelResponse({});
         if (data.message != null) {
             message.message = data.message;
         }
-        if (data.channels != null) {
-            message.channels = data.channels.map(item => Channel.fromObject(item));
+        if (data.channel != null) {
+            message.channel = Channel.fromObject(data.channel);
         }
         return message;
     }
     toObject() {
         const data: {
             message?: string;
-            channels?: ReturnType<typeof Channel.prototype.toObject>[];
+            channel?: ReturnType<typeof Channel.prototype.toObject>;
         } = {};
         if (this.message != null) {
             data.message = this.message;
         }
-        if (this.channels != null) {
-            data.channels = this.channels.map((item: Channel) => item.toObject());
+        if (this.channel != null) {
+            data.channel = this.channel.toObject();
         }
         return data;
     }
@@ -2059,13 +6458,13 @@ export class GetChannelsResponse extends pb_1.Message {
         const writer = w || new pb_1.BinaryWriter();
         if (this.message.length)
             writer.writeString(1, this.message);
-        if (this.channels.length)
-            writer.writeRepeatedMessage(2, this.channels, (item: Channel) => item.serialize(writer));
+        if (this.has_channel)
+            writer.writeMessage(2, this.channel, () => this.channel.serialize(writer));
         if (!w)
             return writer.getResultBuffer();
     }
-    static deserialize(bytes: Uint8Array | pb_1.BinaryReader): GetChannelsResponse {
-        const reader = bytes instanceof pb_1.BinaryReader ? bytes : new pb_1.BinaryReader(bytes), message = new GetChannelsResponse();
+    static deserialize(bytes: Uint8Array | pb_1.BinaryReader): UnarchiveChannelResponse {
+        const reader = bytes instanceof pb_1.BinaryReader ? bytes : new pb_1.BinaryReader(bytes), message = new UnarchiveChannelResponse();
         while (reader.nextField()) {
             if (reader.isEndGroup())
                 break;
@@ -2074,7 +6473,7 @@ export class GetChannelsResponse extends pb_1.Message {
                     message.message = reader.readString();
                     break;
                 case 2:
-                    reader.readMessage(message.channels, () => pb_1.Message.addToRepeatedWrapperField(message, 2, Channel.deserialize(reader), Channel));
+                    reader.readMessage(message.channel, () => message.channel = Channel.deserialize(reader));
                     break;
                 default: reader.skipField();
             }
@@ -2084,14 +6483,16 @@ export class GetChannelsResponse extends pb_1.Message {
     serializeBinary(): Uint8Array {
         return this.serialize();
     }
-    static deserializeBinary(bytes: Uint8Array): GetChannelsResponse {
-        return GetChannelsResponse.deserialize(bytes);
+    static deserializeBinary(bytes: Uint8Array): UnarchiveChannelResponse {
+        return UnarchiveChannelResponse.deserialize(bytes);
     }
 }
-export class GetChannelMembersRequest extends pb_1.Message {
+export class DeleteChannelRequest extends pb_1.Message {
     #one_of_decls: number[][] = [];
     constructor(data?: any[] | {
         channel_id?: string;
+        video_disposition?: ChannelVideoDisposition;
+        target_channel_id?: string;
     }) {
         super();
         pb_1.Message.initialize(this, Array.isArray(data) ? data : [], 0, -1, [], this.#one_of_decls);
@@ -2099,30 +6500,64 @@ export class GetChannelMembersRequest extends pb_1.Message {
             if ("channel_id" in data && data.channel_id != undefined) {
                 this.channel_id = data.channel_id;
             }
+            if ("video_disposition" in data && data.video_disposition != undefined) {
+                this.video_disposition = data.video_disposition;
+            }
+            if ("target_channel_id" in data && data.target_channel_id != undefined) {
+                this.target_channel_id = data.target_channel_id;
+            }
         }
     }
     get channel_id() {
-        return pb_1.Message.getFieldWithDefault(this, 2, "") as string;
+        return pb_1.Message.getFieldWithDefault(this, 1, "") as string;
     }
     set channel_id(value: string) {
+        pb_1.Message.setField(this, 1, value);
+    }
+    get video_disposition() {
+        return pb_1.Message.getFieldWithDefault(this, 2, ChannelVideoDisposition.CHANNEL_VIDEO_DISPOSITION_UNSPECIFIED) as ChannelVideoDisposition;
+    }
+    set video_disposition(value: ChannelVideoDisposition) {
         pb_1.Message.setField(this, 2, value);
     }
+    get target_channel_id() {
+        return pb_1.Message.getFieldWithDefault(this, 3, "") as string;
+    }
+    set target_channel_id(value: string) {
+        pb_1.Message.setField(this, 3, value);
+    }
     static fromObject(data: {
         channel_id?: string;
-    }): GetChannelMembersRequest {
-        const message = new GetChannelMembersRequest({});
+        video_disposition?: ChannelVideoDisposition;
+        target_channel_id?: string;
+    }): DeleteChannelRequest {
+        const message = new DeleteChannelRequest({});
         if (data.channel_id != null) {
             message.channel_id = data.channel_id;
         }
+        if (data.video_disposition != null) {
+            message.video_disposition = data.video_disposition;
+        }
+        if (data.target_channel_id != null) {
+            message.target_channel_id = data.target_channel_id;
+        }
         return message;
     }
     toObject() {
         const data: {
             channel_id?: string;
+            video_disposition?: ChannelVideoDisposition;
+            target_channel_id?: string;
         } = {};
         if (this.channel_id != null) {
             data.channel_id = this.channel_id;
         }
+        if (this.video_disposition != null) {
+            data.video_disposition = this.video_disposition;
+        }
+        if (this.target_channel_id != null) {
+            data.target_channel_id = this.target_channel_id;
+        }
         return data;
     }
     serialize(): Uint8Array;
@@ -2130,19 +6565,29 @@ export class GetChannelMembersRequest extends pb_1.Message {
     serialize(w?: pb_1.BinaryWriter): Uint8Array | void {
         const writer = w || new pb_1.BinaryWriter();
         if (this.channel_id.length)
-            writer.writeString(2, this.channel_id);
+            writer.writeString(1, this.channel_id);
+        if (this.video_disposition != ChannelVideoDisposition.CHANNEL_VIDEO_DISPOSITION_UNSPECIFIED)
+            writer.writeEnum(2, this.video_disposition);
+        if (this.target_channel_id.length)
+            writer.writeString(3, this.target_channel_id);
         if (!w)
             return writer.getResultBuffer();
     }
-    static deserialize(bytes: Uint8Array | pb_1.BinaryReader): GetChannelMembersRequest {
-        const reader = bytes instanceof pb_1.BinaryReader ? bytes : new pb_1.BinaryReader(bytes), message = new GetChannelMembersRequest();
+    static deserialize(bytes: Uint8Array | pb_1.BinaryReader): DeleteChannelRequest {
+        const reader = bytes instanceof pb_1.BinaryReader ? bytes : new pb_1.BinaryReader(bytes), message = new DeleteChannelRequest();
         while (reader.nextField()) {
             if (reader.isEndGroup())
                 break;
             switch (reader.getFieldNumber()) {
-                case 2:
+                case 1:
                     message.channel_id = reader.readString();
                     break;
+                case 2:
+                    message.video_disposition = reader.readEnum();
+                    break;
+                case 3:
+                    message.target_channel_id = reader.readString();
+                    break;
                 default: reader.skipField();
             }
         }
@@ -2151,24 +6596,24 @@ export class GetChannelMembersRequest extends pb_1.Message {
     serializeBinary(): Uint8Array {
         return this.serialize();
     }
-    static deserializeBinary(bytes: Uint8Array): GetChannelMembersRequest {
-        return GetChannelMembersRequest.deserialize(bytes);
+    static deserializeBinary(bytes: Uint8Array): DeleteChannelRequest {
+        return DeleteChannelRequest.deserialize(bytes);
     }
 }
-export class GetChannelMembersResponse extends pb_1.Message {
+export class DeleteChannelResponse extends pb_1.Message {
     #one_of_decls: number[][] = [];
     constructor(data?: any[] | {
         message?: string;
-        channel_members?: ChannelMember[];
+        affected_videos?: number;
     }) {
         super();
-        pb_1.Message.initialize(this, Array.isArray(data) ? data : [], 0, -1, [2], this.#one_of_decls);
+        pb_1.Message.initialize(this, Array.isArray(data) ? data : [], 0, -1, [], this.#one_of_decls);
         if (!Array.isArray(data) && typeof data == "object") {
             if ("message" in data && data.message != undefined) {
                 this.message = data.message;
             }
-            if ("channel_members" in data && data.channel_members != undefined) {
-                this.channel_members = data.channel_members;
+            if ("affected_videos" in data && data.affected_videos != undefined) {
+                this.affected_videos = data.affected_videos;
             }
         }
     }
@@ -2178,35 +6623,35 @@ export class GetChannelMembersResponse extends pb_1.Message {
     set message(value: string) {
         pb_1.Message.setField(this, 1, value);
     }
-    get channel_members() {
-        return pb_1.Message.getRepeatedWrapperField(this, ChannelMember, 2) as ChannelMember[];
+    get affected_videos() {
+        return pb_1.Message.getFieldWithDefault(this, 2, 0) as number;
     }
-    set channel_members(value: ChannelMember[]) {
-        pb_1.Message.setRepeatedWrapperField(this, 2, value);
+    set affected_videos(value: number) {
+        pb_1.Message.setField(this, 2, value);
     }
     static fromObject(data: {
         message?: string;
-        channel_members?: ReturnType<typeof ChannelMember.prototype.toObject>[];
-    }): GetChannelMembersResponse {
-        const message = new GetChannelMembersResponse({});
+        affected_videos?: number;
+    }): DeleteChannelResponse {
+        const message = new DeleteChannelResponse({});
         if (data.message != null) {
             message.message = data.message;
         }
-        if (data.channel_members != null) {
-            message.channel_members = data.channel_members.map(item => ChannelMember.fromObject(item));
+        if (data.affected_videos != null) {
+            message.affected_videos = data.affected_videos;
         }
         return message;
     }
     toObject() {
         const data: {
             message?: string;
-            channel_members?: ReturnType<typeof ChannelMember.prototype.toObject>[];
+            affected_videos?: number;
         } = {};
         if (this.message != null) {
             data.message = this.message;
         }
-        if (this.channel_members != null) {
-            data.channel_members = this.channel_members.map((item: ChannelMember) => item.toObject());
+        if (this.affected_videos != null) {
+            data.affected_videos = this.affected_videos;
         }
         return data;
     }
@@ -2216,13 +6661,13 @@ export class GetChannelMembersResponse extends pb_1.Message {
         const writer = w || new pb_1.BinaryWriter();
         if (this.message.length)
             writer.writeString(1, this.message);
-        if (this.channel_members.length)
-            writer.writeRepeatedMessage(2, this.channel_members, (item: ChannelMember) => item.serialize(writer));
+        if (this.affected_videos != 0)
+            writer.writeInt32(2, this.affected_videos);
         if (!w)
             return writer.getResultBuffer();
     }
-    static deserialize(bytes: Uint8Array | pb_1.BinaryReader): GetChannelMembersResponse {
-        const reader = bytes instanceof pb_1.BinaryReader ? bytes : new pb_1.BinaryReader(bytes), message = new GetChannelMembersResponse();
+    static deserialize(bytes: Uint8Array | pb_1.BinaryReader): DeleteChannelResponse {
+        const reader = bytes instanceof pb_1.BinaryReader ? bytes : new pb_1.BinaryReader(bytes), message = new DeleteChannelResponse();
         while (reader.nextField()) {
             if (reader.isEndGroup())
                 break;
@@ -2231,7 +6676,7 @@ export class GetChannelMembersResponse extends pb_1.Message {
                     message.message = reader.readString();
                     break;
                 case 2:
-                    reader.readMessage(message.channel_members, () => pb_1.Message.addToRepeatedWrapperField(message, 2, ChannelMember.deserialize(reader), ChannelMember));
+                    message.affected_videos = reader.readInt32();
                     break;
                 default: reader.skipField();
             }
@@ -2241,80 +6686,80 @@ export class GetChannelMembersResponse extends pb_1.Message {
     serializeBinary(): Uint8Array {
         return this.serialize();
     }
-    static deserializeBinary(bytes: Uint8Array): GetChannelMembersResponse {
-        return GetChannelMembersResponse.deserialize(bytes);
+    static deserializeBinary(bytes: Uint8Array): DeleteChannelResponse {
+        return DeleteChannelResponse.deserialize(bytes);
     }
 }
-export class AddChannelMemberRequest extends pb_1.Message {
+export class RemoveTenantMemberRequest extends pb_1.Message {
     #one_of_decls: number[][] = [];
     constructor(data?: any[] | {
-        channel_id?: string;
+        tenant_id?: string;
         user_id?: string;
-        role?: string;
+        successor_user_id?: string;
     }) {
         super();
         pb_1.Message.initialize(this, Array.isArray(data) ? data : [], 0, -1, [], this.#one_of_decls);
         if (!Array.isArray(data) && typeof data == "object") {
-            if ("channel_id" in data && data.channel_id != undefined) {
-                this.channel_id = data.channel_id;
+            if ("tenant_id" in data && data.tenant_id != undefined) {
+                this.tenant_id = data.tenant_id;
             }
             if ("user_id" in data && data.user_id != undefined) {
                 this.user_id = data.user_id;
             }
-            if ("role" in data && data.role != undefined) {
-                this.role = data.role;
+            if ("successor_user_id" in data && data.successor_user_id != undefined) {
+                this.successor_user_id = data.successor_user_id;
             }
         }
     }
-    get channel_id() {
-        return pb_1.Message.getFieldWithDefault(this, 2, "") as string;
+    get tenant_id() {
+        return pb_1.Message.getFieldWithDefault(this, 1, "") as string;
     }
-    set channel_id(value: string) {
-        pb_1.Message.setField(this, 2, value);
+    set tenant_id(value: string) {
+        pb_1.Message.setField(this, 1, value);
     }
     get user_id() {
-        return pb_1.Message.getFieldWithDefault(this, 3, "") as string;
+        return pb_1.Message.getFieldWithDefault(this, 2, "") as string;
     }
     set user_id(value: string) {
-        pb_1.Message.setField(this, 3, value);
+        pb_1.Message.setField(this, 2, value);
     }
-    get role() {
-        return pb_1.Message.getFieldWithDefault(this, 4, "") as string;
+    get successor_user_id() {
+        return pb_1.Message.getFieldWithDefault(this, 3, "") as string;
     }
-    set role(value: string) {
-        pb_1.Message.setField(this, 4, value);
+    set successor_user_id(value: string) {
+        pb_1.Message.setField(this, 3, value);
     }
     static fromObject(data: {
-        channel_id?: string;
+        tenant_id?: string;
         user_id?: string;
-        role?: string;
-    }): AddChannelMemberRequest {
-        const message = new AddChannelMemberRequest({});
-        if (data.channel_id != null) {
-            message.channel_id = data.channel_id;
+        successor_user_id?: string;
+    }): RemoveTenantMemberRequest {
+        const message = new RemoveTenantMemberRequest({});
+        if (data.tenant_id != null) {
+            message.tenant_id = data.tenant_id;
         }
         if (data.user_id != null) {
             message.user_id = data.user_id;
         }
-        if (data.role != null) {
-            message.role = data.role;
+        if (data.successor_user_id != null) {
+            message.successor_user_id = data.successor_user_id;
         }
         return message;
     }
     toObject() {
         const data: {
-            channel_id?: string;
+            tenant_id?: string;
             user_id?: string;
-            role?: string;
+            successor_user_id?: string;
         } = {};
-        if (this.channel_id != null) {
-            data.channel_id = this.channel_id;
+        if (this.tenant_id != null) {
+            data.tenant_id = this.tenant_id;
         }
         if (this.user_id != null) {
             data.user_id = this.user_id;
         }
-        if (this.role != null) {
-            data.role = this.role;
+        if (this.successor_user_id != null) {
+            data.successor_user_id = this.successor_user_id;
         }
         return data;
     }
@@ -2322,29 +6767,29 @@ export class AddChannelMemberRequest extends pb_1.Message {
     serialize(w: pb_1.BinaryWriter): void;
     serialize(w?: pb_1.BinaryWriter): Uint8Array | void {
         const writer = w || new pb_1.BinaryWriter();
-        if (this.channel_id.length)
-            writer.writeString(2, this.channel_id);
+        if (this.tenant_id.length)
+            writer.writeString(1, this.tenant_id);
         if (this.user_id.length)
-            writer.writeString(3, this.user_id);
-        if (this.role.length)
-            writer.writeString(4, this.role);
+            writer.writeString(2, this.user_id);
+        if (this.successor_user_id.length)
+            writer.writeString(3, this.successor_user_id);
         if (!w)
             return writer.getResultBuffer();
     }
-    static deserialize(bytes: Uint8Array | pb_1.BinaryReader): AddChannelMemberRequest {
-        const reader = bytes instanceof pb_1.BinaryReader ? bytes : new pb_1.BinaryReader(bytes), message = new AddChannelMemberRequest();
+    static deserialize(bytes: Uint8Array | pb_1.BinaryReader): RemoveTenantMemberRequest {
+        const reader = bytes instanceof pb_1.BinaryReader ? bytes : new pb_1.BinaryReader(bytes), message = new RemoveTenantMemberRequest();
         while (reader.nextField()) {
             if (reader.isEndGroup())
                 break;
             switch (reader.getFieldNumber()) {
-                case 2:
-                    message.channel_id = reader.readString();
+                case 1:
+                    message.tenant_id = reader.readString();
                     break;
-                case 3:
+                case 2:
                     message.user_id = reader.readString();
                     break;
-                case 4:
-                    message.role = reader.readString();
+                case 3:
+                    message.successor_user_id = reader.readString();
                     break;
                 default: reader.skipField();
             }
@@ -2354,44 +6799,62 @@ export class AddChannelMemberRequest extends pb_1.Message {
     serializeBinary(): Uint8Array {
         return this.serialize();
     }
-    static deserializeBinary(bytes: Uint8Array): AddChannelMemberRequest {
-        return AddChannelMemberRequest.deserialize(bytes);
+    static deserializeBinary(bytes: Uint8Array): RemoveTenantMemberRequest {
+        return RemoveTenantMemberRequest.deserialize(bytes);
     }
 }
-export class AddChannelMemberResponse extends pb_1.Message {
+export class RemoveTenantMemberResponse extends pb_1.Message {
     #one_of_decls: number[][] = [];
     constructor(data?: any[] | {
-        message?: string;
+        removed_memberships?: number;
+        transferred_channel_ids?: string[];
     }) {
         super();
-        pb_1.Message.initialize(this, Array.isArray(data) ? data : [], 0, -1, [], this.#one_of_decls);
+        pb_1.Message.initialize(this, Array.isArray(data) ? data : [], 0, -1, [2], this.#one_of_decls);
         if (!Array.isArray(data) && typeof data == "object") {
-            if ("message" in data && data.message != undefined) {
-                this.message = data.message;
+            if ("removed_memberships" in data && data.removed_memberships != undefined) {
+                this.removed_memberships = data.removed_memberships;
+            }
+            if ("transferred_channel_ids" in data && data.transferred_channel_ids != undefined) {
+                this.transferred_channel_ids = data.transferred_channel_ids;
             }
         }
     }
-    get message() {
-        return pb_1.Message.getFieldWithDefault(this, 1, "") as string;
+    get removed_memberships() {
+        return pb_1.Message.getFieldWithDefault(this, 1, 0) as number;
     }
-    set message(value: string) {
+    set removed_memberships(value: number) {
         pb_1.Message.setField(this, 1, value);
     }
+    get transferred_channel_ids() {
+        return pb_1.Message.getFieldWithDefault(this, 2, []) as string[];
+    }
+    set transferred_channel_ids(value: string[]) {
+        pb_1.Message.setField(this, 2, value);
+    }
     static fromObject(data: {
-        message?: string;
-    }): AddChannelMemberResponse {
-        const message = new AddChannelMemberResponse({});
-        if (data.message != null) {
-            message.message = data.message;
+        removed_memberships?: number;
+        transferred_channel_ids?: string[];
+    }): RemoveTenantMemberResponse {
+        const message = new RemoveTenantMemberResponse({});
+        if (data.removed_memberships != null) {
+            message.removed_memberships = data.removed_memberships;
+        }
+        if (data.transferred_channel_ids != null) {
+            message.transferred_channel_ids = data.transferred_channel_ids;
         }
         return message;
     }
     toObject() {
         const data: {
-            message?: string;
+            removed_memberships?: number;
+            transferred_channel_ids?: string[];
         } = {};
-        if (this.message != null) {
-            data.message = this.message;
+        if (this.removed_memberships != null) {
+            data.removed_memberships = this.removed_memberships;
+        }
+        if (this.transferred_channel_ids != null) {
+            data.transferred_channel_ids = this.transferred_channel_ids;
         }
         return data;
     }
@@ -2399,19 +6862,24 @@ export class AddChannelMemberResponse extends pb_1.Message {
     serialize(w: pb_1.BinaryWriter): void;
     serialize(w?: pb_1.BinaryWriter): Uint8Array | void {
         const writer = w || new pb_1.BinaryWriter();
-        if (this.message.length)
-            writer.writeString(1, this.message);
+        if (this.removed_memberships != 0)
+            writer.writeInt32(1, this.removed_memberships);
+        if (this.transferred_channel_ids.length)
+            writer.writeRepeatedString(2, this.transferred_channel_ids);
         if (!w)
             return writer.getResultBuffer();
     }
-    static deserialize(bytes: Uint8Array | pb_1.BinaryReader): AddChannelMemberResponse {
-        const reader = bytes instanceof pb_1.BinaryReader ? bytes : new pb_1.BinaryReader(bytes), message = new AddChannelMemberResponse();
+    static deserialize(bytes: Uint8Array | pb_1.BinaryReader): RemoveTenantMemberResponse {
+        const reader = bytes instanceof pb_1.BinaryReader ? bytes : new pb_1.BinaryReader(bytes), message = new RemoveTenantMemberResponse();
         while (reader.nextField()) {
             if (reader.isEndGroup())
                 break;
             switch (reader.getFieldNumber()) {
                 case 1:
-                    message.message = reader.readString();
+                    message.removed_memberships = reader.readInt32();
+                    break;
+                case 2:
+                    pb_1.Message.addToRepeatedField(message, 2, reader.readString());
                     break;
                 default: reader.skipField();
             }
@@ -2421,62 +6889,44 @@ export class AddChannelMemberResponse extends pb_1.Message {
     serializeBinary(): Uint8Array {
         return this.serialize();
     }
-    static deserializeBinary(bytes: Uint8Array): AddChannelMemberResponse {
-        return AddChannelMemberResponse.deserialize(bytes);
+    static deserializeBinary(bytes: Uint8Array): RemoveTenantMemberResponse {
+        return RemoveTenantMemberResponse.deserialize(bytes);
     }
 }
-export class RemoveChannelMemberRequest extends pb_1.Message {
+export class DeleteTenantDataRequest extends pb_1.Message {
     #one_of_decls: number[][] = [];
     constructor(data?: any[] | {
-        channel_id?: string;
-        user_id?: string;
+        tenant_id?: string;
     }) {
         super();
         pb_1.Message.initialize(this, Array.isArray(data) ? data : [], 0, -1, [], this.#one_of_decls);
         if (!Array.isArray(data) && typeof data == "object") {
-            if ("channel_id" in data && data.channel_id != undefined) {
-                this.channel_id = data.channel_id;
-            }
-            if ("user_id" in data && data.user_id != undefined) {
-                this.user_id = data.user_id;
+            if ("tenant_id" in data && data.tenant_id != undefined) {
+                this.tenant_id = data.tenant_id;
             }
         }
     }
-    get channel_id() {
-        return pb_1.Message.getFieldWithDefault(this, 2, "") as string;
-    }
-    set channel_id(value: string) {
-        pb_1.Message.setField(this, 2, value);
-    }
-    get user_id() {
-        return pb_1.Message.getFieldWithDefault(this, 3, "") as string;
+    get tenant_id() {
+        return pb_1.Message.getFieldWithDefault(this, 1, "") as string;
     }
-    set user_id(value: string) {
-        pb_1.Message.setField(this, 3, value);
+    set tenant_id(value: string) {
+        pb_1.Message.setField(this, 1, value);
     }
     static fromObject(data: {
-        channel_id?: string;
-        user_id?: string;
-    }): RemoveChannelMemberRequest {
-        const message = new RemoveChannelMemberRequest({});
-        if (data.channel_id != null) {
-            message.channel_id = data.channel_id;
-        }
-        if (data.user_id != null) {
-            message.user_id = data.user_id;
+        tenant_id?: string;
+    }): DeleteTenantDataRequest {
+        const message = new DeleteTenantDataRequest({});
+        if (data.tenant_id != null) {
+            message.tenant_id = data.tenant_id;
         }
         return message;
     }
     toObject() {
         const data: {
-            channel_id?: string;
-            user_id?: string;
+            tenant_id?: string;
         } = {};
-        if (this.channel_id != null) {
-            data.channel_id = this.channel_id;
-        }
-        if (this.user_id != null) {
-            data.user_id = this.user_id;
+        if (this.tenant_id != null) {
+            data.tenant_id = this.tenant_id;
         }
         return data;
     }
@@ -2484,24 +6934,19 @@ export class RemoveChannelMemberRequest extends pb_1.Message {
     serialize(w: pb_1.BinaryWriter): void;
     serialize(w?: pb_1.BinaryWriter): Uint8Array | void {
         const writer = w || new pb_1.BinaryWriter();
-        if (this.channel_id.length)
-            writer.writeString(2, this.channel_id);
-        if (this.user_id.length)
-            writer.writeString(3, this.user_id);
+        if (this.tenant_id.length)
+            writer.writeString(1, this.tenant_id);
         if (!w)
             return writer.getResultBuffer();
     }
-    static deserialize(bytes: Uint8Array | pb_1.BinaryReader): RemoveChannelMemberRequest {
-        const reader = bytes instanceof pb_1.BinaryReader ? bytes : new pb_1.BinaryReader(bytes), message = new RemoveChannelMemberRequest();
+    static deserialize(bytes: Uint8Array | pb_1.BinaryReader): DeleteTenantDataRequest {
+        const reader = bytes instanceof pb_1.BinaryReader ? bytes : new pb_1.BinaryReader(bytes), message = new DeleteTenantDataRequest();
         while (reader.nextField()) {
             if (reader.isEndGroup())
                 break;
             switch (reader.getFieldNumber()) {
-                case 2:
-                    message.channel_id = reader.readString();
-                    break;
-                case 3:
-                    message.user_id = reader.readString();
+                case 1:
+                    message.tenant_id = reader.readString();
                     break;
                 default: reader.skipField();
             }
@@ -2511,44 +6956,62 @@ export class RemoveChannelMemberRequest extends pb_1.Message {
     serializeBinary(): Uint8Array {
         return this.serialize();
     }
-    static deserializeBinary(bytes: Uint8Array): RemoveChannelMemberRequest {
-        return RemoveChannelMemberRequest.deserialize(bytes);
+    static deserializeBinary(bytes: Uint8Array): DeleteTenantDataRequest {
+        return DeleteTenantDataRequest.deserialize(bytes);
     }
 }
-export class RemoveChannelMemberResponse extends pb_1.Message {
+export class DeleteTenantDataResponse extends pb_1.Message {
     #one_of_decls: number[][] = [];
     constructor(data?: any[] | {
-        message?: string;
+        video_ids?: string[];
+        deleted_channels?: number;
     }) {
         super();
-        pb_1.Message.initialize(this, Array.isArray(data) ? data : [], 0, -1, [], this.#one_of_decls);
+        pb_1.Message.initialize(this, Array.isArray(data) ? data : [], 0, -1, [1], this.#one_of_decls);
         if (!Array.isArray(data) && typeof data == "object") {
-            if ("message" in data && data.message != undefined) {
-                this.message = data.message;
+            if ("video_ids" in data && data.video_ids != undefined) {
+                this.video_ids = data.video_ids;
+            }
+            if ("deleted_channels" in data && data.deleted_channels != undefined) {
+                this.deleted_channels = data.deleted_channels;
             }
         }
     }
-    get message() {
-        return pb_1.Message.getFieldWithDefault(this, 1, "") as string;
+    get video_ids() {
+        return pb_1.Message.getFieldWithDefault(this, 1, []) as string[];
     }
-    set message(value: string) {
+    set video_ids(value: string[]) {
         pb_1.Message.setField(this, 1, value);
     }
+    get deleted_channels() {
+        return pb_1.Message.getFieldWithDefault(this, 2, 0) as number;
+    }
+    set deleted_channels(value: number) {
+        pb_1.Message.setField(this, 2, value);
+    }
     static fromObject(data: {
-        message?: string;
-    }): RemoveChannelMemberResponse {
-        const message = new RemoveChannelMemberResponse({});
-        if (data.message != null) {
-            message.message = data.message;
+        video_ids?: string[];
+        deleted_channels?: number;
+    }): DeleteTenantDataResponse {
+        const message = new DeleteTenantDataResponse({});
+        if (data.video_ids != null) {
+            message.video_ids = data.video_ids;
+        }
+        if (data.deleted_channels != null) {
+            message.deleted_channels = data.deleted_channels;
         }
         return message;
     }
     toObject() {
         const data: {
-            message?: string;
+            video_ids?: string[];
+            deleted_channels?: number;
         } = {};
-        if (this.message != null) {
-            data.message = this.message;
+        if (this.video_ids != null) {
+            data.video_ids = this.video_ids;
+        }
+        if (this.deleted_channels != null) {
+            data.deleted_channels = this.deleted_channels;
         }
         return data;
     }
@@ -2556,19 +7019,24 @@ export class RemoveChannelMemberResponse extends pb_1.Message {
     serialize(w: pb_1.BinaryWriter): void;
     serialize(w?: pb_1.BinaryWriter): Uint8Array | void {
         const writer = w || new pb_1.BinaryWriter();
-        if (this.message.length)
-            writer.writeString(1, this.message);
+        if (this.video_ids.length)
+            writer.writeRepeatedString(1, this.video_ids);
+        if (this.deleted_channels != 0)
+            writer.writeInt32(2, this.deleted_channels);
         if (!w)
             return writer.getResultBuffer();
     }
-    static deserialize(bytes: Uint8Array | pb_1.BinaryReader): RemoveChannelMemberResponse {
-        const reader = bytes instanceof pb_1.BinaryReader ? bytes : new pb_1.BinaryReader(bytes), message = new RemoveChannelMemberResponse();
+    static deserialize(bytes: Uint8Array | pb_1.BinaryReader): DeleteTenantDataResponse {
+        const reader = bytes instanceof pb_1.BinaryReader ? bytes : new pb_1.BinaryReader(bytes), message = new DeleteTenantDataResponse();
         while (reader.nextField()) {
             if (reader.isEndGroup())
                 break;
             switch (reader.getFieldNumber()) {
                 case 1:
-                    message.message = reader.readString();
+                    pb_1.Message.addToRepeatedField(message, 1, reader.readString());
+                    break;
+                case 2:
+                    message.deleted_channels = reader.readInt32();
                     break;
                 default: reader.skipField();
             }
@@ -2578,8 +7046,8 @@ export class RemoveChannelMemberResponse extends pb_1.Message {
     serializeBinary(): Uint8Array {
         return this.serialize();
     }
-    static deserializeBinary(bytes: Uint8Array): RemoveChannelMemberResponse {
-        return RemoveChannelMemberResponse.deserialize(bytes);
+    static deserializeBinary(bytes: Uint8Array): DeleteTenantDataResponse {
+        return DeleteTenantDataResponse.deserialize(bytes);
     }
 }
 export class MoveVideoToChannelRequest extends pb_1.Message {
@@ -2998,6 +7466,60 @@ export abstract class UnimplementedVideoServiceService {
             requestDeserialize: (bytes: Buffer) => ShareVideoRequest.deserialize(new Uint8Array(bytes)),
             responseSerialize: (message: ShareLink) => Buffer.from(message.serialize()),
             responseDeserialize: (bytes: Buffer) => ShareLink.deserialize(new Uint8Array(bytes))
+        },
+        SaveWatchProgress: {
+            path: "/videoservice.VideoService/SaveWatchProgress",
+            requestStream: false,
+            responseStream: false,
+            requestSerialize: (message: SaveWatchProgressRequest) => Buffer.from(message.serialize()),
+            requestDeserialize: (bytes: Buffer) => SaveWatchProgressRequest.deserialize(new Uint8Array(bytes)),
+            responseSerialize: (message: WatchProgress) => Buffer.from(message.serialize()),
+            responseDeserialize: (bytes: Buffer) => WatchProgress.deserialize(new Uint8Array(bytes))
+        },
+        GetWatchProgress: {
+            path: "/videoservice.VideoService/GetWatchProgress",
+            requestStream: false,
+            responseStream: false,
+            requestSerialize: (message: GetWatchProgressRequest) => Buffer.from(message.serialize()),
+            requestDeserialize: (bytes: Buffer) => GetWatchProgressRequest.deserialize(new Uint8Array(bytes)),
+            responseSerialize: (message: WatchProgress) => Buffer.from(message.serialize()),
+            responseDeserialize: (bytes: Buffer) => WatchProgress.deserialize(new Uint8Array(bytes))
+        },
+        AddReaction: {
+            path: "/videoservice.VideoService/AddReaction",
+            requestStream: false,
+            responseStream: false,
+            requestSerialize: (message: AddReactionRequest) => Buffer.from(message.serialize()),
+            requestDeserialize: (bytes: Buffer) => AddReactionRequest.deserialize(new Uint8Array(bytes)),
+            responseSerialize: (message: Reaction) => Buffer.from(message.serialize()),
+            responseDeserialize: (bytes: Buffer) => Reaction.deserialize(new Uint8Array(bytes))
+        },
+        RemoveReaction: {
+            path: "/videoservice.VideoService/RemoveReaction",
+            requestStream: false,
+            responseStream: false,
+            requestSerialize: (message: RemoveReactionRequest) => Buffer.from(message.serialize()),
+            requestDeserialize: (bytes: Buffer) => RemoveReactionRequest.deserialize(new Uint8Array(bytes)),
+            responseSerialize: (message: RemoveReactionResponse) => Buffer.from(message.serialize()),
+            responseDeserialize: (bytes: Buffer) => RemoveReactionResponse.deserialize(new Uint8Array(bytes))
+        },
+        ListReactions: {
+            path: "/videoservice.VideoService/ListReactions",
+            requestStream: false,
+            responseStream: false,
+            requestSerialize: (message: ListReactionsRequest) => Buffer.from(message.serialize()),
+            requestDeserialize: (bytes: Buffer) => ListReactionsRequest.deserialize(new Uint8Array(bytes)),
+            responseSerialize: (message: ListReactionsResponse) => Buffer.from(message.serialize()),
+            responseDeserialize: (bytes: Buffer) => ListReactionsResponse.deserialize(new Uint8Array(bytes))
+        },
+        FilterVideoViewers: {
+            path: "/videoservice.VideoService/FilterVideoViewers",
+            requestStream: false,
+            responseStream: false,
+            requestSerialize: (message: FilterVideoViewersRequest) => Buffer.from(message.serialize()),
+            requestDeserialize: (bytes: Buffer) => FilterVideoViewersRequest.deserialize(new Uint8Array(bytes)),
+            responseSerialize: (message: FilterVideoViewersResponse) => Buffer.from(message.serialize()),
+            responseDeserialize: (bytes: Buffer) => FilterVideoViewersResponse.deserialize(new Uint8Array(bytes))
         }
     };
     [method: string]: grpc_1.UntypedHandleCall;
@@ -3009,6 +7531,12 @@ export abstract class UnimplementedVideoServiceService {
     abstract MoveVideoToChannel(call: grpc_1.ServerUnaryCall<MoveVideoToChannelRequest, MoveVideoToChannelResponse>, callback: grpc_1.sendUnaryData<MoveVideoToChannelResponse>): void;
     abstract RemoveVideoFromChannel(call: grpc_1.ServerUnaryCall<RemoveVideoFromChannelRequest, RemoveVideoFromChannelResponse>, callback: grpc_1.sendUnaryData<RemoveVideoFromChannelResponse>): void;
     abstract ShareVideo(call: grpc_1.ServerUnaryCall<ShareVideoRequest, ShareLink>, callback: grpc_1.sendUnaryData<ShareLink>): void;
+    abstract SaveWatchProgress(call: grpc_1.ServerUnaryCall<SaveWatchProgressRequest, WatchProgress>, callback: grpc_1.sendUnaryData<WatchProgress>): void;
+    abstract GetWatchProgress(call: grpc_1.ServerUnaryCall<GetWatchProgressRequest, WatchProgress>, callback: grpc_1.sendUnaryData<WatchProgress>): void;
+    abstract AddReaction(call: grpc_1.ServerUnaryCall<AddReactionRequest, Reaction>, callback: grpc_1.sendUnaryData<Reaction>): void;
+    abstract RemoveReaction(call: grpc_1.ServerUnaryCall<RemoveReactionRequest, RemoveReactionResponse>, callback: grpc_1.sendUnaryData<RemoveReactionResponse>): void;
+    abstract ListReactions(call: grpc_1.ServerUnaryCall<ListReactionsRequest, ListReactionsResponse>, callback: grpc_1.sendUnaryData<ListReactionsResponse>): void;
+    abstract FilterVideoViewers(call: grpc_1.ServerUnaryCall<FilterVideoViewersRequest, FilterVideoViewersResponse>, callback: grpc_1.sendUnaryData<FilterVideoViewersResponse>): void;
 }
 export class VideoServiceClient {
     private _address: string;
@@ -3052,6 +7580,30 @@ export class VideoServiceClient {
     ShareVideo(message: ShareVideoRequest, metadata: grpc_web_1.Metadata | null) {
         return this._client.thenableCall<ShareVideoRequest, ShareLink>(this._address + "/videoservice.VideoService/ShareVideo", message, metadata || {}, VideoServiceClient.ShareVideo);
     }
+    private static SaveWatchProgress = new grpc_web_1.MethodDescriptor<SaveWatchProgressRequest, WatchProgress>("/videoservice.VideoService/SaveWatchProgress", grpc_web_1.MethodType.UNARY, SaveWatchProgressRequest, WatchProgress, (message: SaveWatchProgressRequest) => message.serialize(), WatchProgress.deserialize);
+    SaveWatchProgress(message: SaveWatchProgressRequest, metadata: grpc_web_1.Metadata | null) {
+        return this._client.thenableCall<SaveWatchProgressRequest, WatchProgress>(this._address + "/videoservice.VideoService/SaveWatchProgress", message, metadata || {}, VideoServiceClient.SaveWatchProgress);
+    }
+    private static GetWatchProgress = new grpc_web_1.MethodDescriptor<GetWatchProgressRequest, WatchProgress>("/videoservice.VideoService/GetWatchProgress", grpc_web_1.MethodType.UNARY, GetWatchProgressRequest, WatchProgress, (message: GetWatchProgressRequest) => message.serialize(), WatchProgress.deserialize);
+    GetWatchProgress(message: GetWatchProgressRequest, metadata: grpc_web_1.Metadata | null) {
+        return this._client.thenableCall<GetWatchProgressRequest, WatchProgress>(this._address + "/videoservice.VideoService/GetWatchProgress", message, metadata || {}, VideoServiceClient.GetWatchProgress);
+    }
+    private static AddReaction = new grpc_web_1.MethodDescriptor<AddReactionRequest, Reaction>("/videoservice.VideoService/AddReaction", grpc_web_1.MethodType.UNARY, AddReactionRequest, Reaction, (message: AddReactionRequest) => message.serialize(), Reaction.deserialize);
+    AddReaction(message: AddReactionRequest, metadata: grpc_web_1.Metadata | null) {
+        return this._client.thenableCall<AddReactionRequest, Reaction>(this._address + "/videoservice.VideoService/AddReaction", message, metadata || {}, VideoServiceClient.AddReaction);
+    }
+    private static RemoveReaction = new grpc_web_1.MethodDescriptor<RemoveReactionRequest, RemoveReactionResponse>("/videoservice.VideoService/RemoveReaction", grpc_web_1.MethodType.UNARY, RemoveReactionRequest, RemoveReactionResponse, (message: RemoveReactionRequest) => message.serialize(), RemoveReactionResponse.deserialize);
+    RemoveReaction(message: RemoveReactionRequest, metadata: grpc_web_1.Metadata | null) {
+        return this._client.thenableCall<RemoveReactionRequest, RemoveReactionResponse>(this._address + "/videoservice.VideoService/RemoveReaction", message, metadata || {}, VideoServiceClient.RemoveReaction);
+    }
+    private static ListReactions = new grpc_web_1.MethodDescriptor<ListReactionsRequest, ListReactionsResponse>("/videoservice.VideoService/ListReactions", grpc_web_1.MethodType.UNARY, ListReactionsRequest, ListReactionsResponse, (message: ListReactionsRequest) => message.serialize(), ListReactionsResponse.deserialize);
+    ListReactions(message: ListReactionsRequest, metadata: grpc_web_1.Metadata | null) {
+        return this._client.thenableCall<ListReactionsRequest, ListReactionsResponse>(this._address + "/videoservice.VideoService/ListReactions", message, metadata || {}, VideoServiceClient.ListReactions);
+    }
+    private static FilterVideoViewers = new grpc_web_1.MethodDescriptor<FilterVideoViewersRequest, FilterVideoViewersResponse>("/videoservice.VideoService/FilterVideoViewers", grpc_web_1.MethodType.UNARY, FilterVideoViewersRequest, FilterVideoViewersResponse, (message: FilterVideoViewersRequest) => message.serialize(), FilterVideoViewersResponse.deserialize);
+    FilterVideoViewers(message: FilterVideoViewersRequest, metadata: grpc_web_1.Metadata | null) {
+        return this._client.thenableCall<FilterVideoViewersRequest, FilterVideoViewersResponse>(this._address + "/videoservice.VideoService/FilterVideoViewers", message, metadata || {}, VideoServiceClient.FilterVideoViewers);
+    }
 }
 export abstract class UnimplementedChannelServiceService {
     static definition = {
@@ -3082,6 +7634,15 @@ export abstract class UnimplementedChannelServiceService {
             responseSerialize: (message: GetChannelsResponse) => Buffer.from(message.serialize()),
             responseDeserialize: (bytes: Buffer) => GetChannelsResponse.deserialize(new Uint8Array(bytes))
         },
+        GetChannel: {
+            path: "/videoservice.ChannelService/GetChannel",
+            requestStream: false,
+            responseStream: false,
+            requestSerialize: (message: GetChannelRequest) => Buffer.from(message.serialize()),
+            requestDeserialize: (bytes: Buffer) => GetChannelRequest.deserialize(new Uint8Array(bytes)),
+            responseSerialize: (message: GetChannelResponse) => Buffer.from(message.serialize()),
+            responseDeserialize: (bytes: Buffer) => GetChannelResponse.deserialize(new Uint8Array(bytes))
+        },
         GetMembers: {
             path: "/videoservice.ChannelService/GetMembers",
             requestStream: false,
@@ -3108,15 +7669,116 @@ export abstract class UnimplementedChannelServiceService {
             requestDeserialize: (bytes: Buffer) => RemoveChannelMemberRequest.deserialize(new Uint8Array(bytes)),
             responseSerialize: (message: RemoveChannelMemberResponse) => Buffer.from(message.serialize()),
             responseDeserialize: (bytes: Buffer) => RemoveChannelMemberResponse.deserialize(new Uint8Array(bytes))
+        },
+        UpdateMemberRole: {
+            path: "/videoservice.ChannelService/UpdateMemberRole",
+            requestStream: false,
+            responseStream: false,
+            requestSerialize: (message: UpdateChannelMemberRoleRequest) => Buffer.from(message.serialize()),
+            requestDeserialize: (bytes: Buffer) => UpdateChannelMemberRoleRequest.deserialize(new Uint8Array(bytes)),
+            responseSerialize: (message: UpdateChannelMemberRoleResponse) => Buffer.from(message.serialize()),
+            responseDeserialize: (bytes: Buffer) => UpdateChannelMemberRoleResponse.deserialize(new Uint8Array(bytes))
+        },
+        ArchiveChannel: {
+            path: "/videoservice.ChannelService/ArchiveChannel",
+            requestStream: false,
+            responseStream: false,
+            requestSerialize: (message: ArchiveChannelRequest) => Buffer.from(message.serialize()),
+            requestDeserialize: (bytes: Buffer) => ArchiveChannelRequest.deserialize(new Uint8Array(bytes)),
+            responseSerialize: (message: ArchiveChannelResponse) => Buffer.from(message.serialize()),
+            responseDeserialize: (bytes: Buffer) => ArchiveChannelResponse.deserialize(new Uint8Array(bytes))
+        },
+        UnarchiveChannel: {
+            path: "/videoservice.ChannelService/UnarchiveChannel",
+            requestStream: false,
+            responseStream: false,
+            requestSerialize: (message: UnarchiveChannelRequest) => Buffer.from(message.serialize()),
+            requestDeserialize: (bytes: Buffer) => UnarchiveChannelRequest.deserialize(new Uint8Array(bytes)),
+            responseSerialize: (message: UnarchiveChannelResponse) => Buffer.from(message.serialize()),
+            responseDeserialize: (bytes: Buffer) => UnarchiveChannelResponse.deserialize(new Uint8Array(bytes))
+        },
+        DeleteChannel: {
+            path: "/videoservice.ChannelService/DeleteChannel",
+            requestStream: false,
+            responseStream: false,
+            requestSerialize: (message: DeleteChannelRequest) => Buffer.from(message.serialize()),
+            requestDeserialize: (bytes: Buffer) => DeleteChannelRequest.deserialize(new Uint8Array(bytes)),
+            responseSerialize: (message: DeleteChannelResponse) => Buffer.from(message.serialize()),
+            responseDeserialize: (bytes: Buffer) => DeleteChannelResponse.deserialize(new Uint8Array(bytes))
+        },
+        DiscoverChannels: {
+            path: "/videoservice.ChannelService/DiscoverChannels",
+            requestStream: false,
+            responseStream: false,
+            requestSerialize: (message: DiscoverChannelsRequest) => Buffer.from(message.serialize()),
+            requestDeserialize: (bytes: Buffer) => DiscoverChannelsRequest.deserialize(new Uint8Array(bytes)),
+            responseSerialize: (message: DiscoverChannelsResponse) => Buffer.from(message.serialize()),
+            responseDeserialize: (bytes: Buffer) => DiscoverChannelsResponse.deserialize(new Uint8Array(bytes))
+        },
+        JoinChannel: {
+            path: "/videoservice.ChannelService/JoinChannel",
+            requestStream: false,
+            responseStream: false,
+            requestSerialize: (message: JoinChannelRequest) => Buffer.from(message.serialize()),
+            requestDeserialize: (bytes: Buffer) => JoinChannelRequest.deserialize(new Uint8Array(bytes)),
+            responseSerialize: (message: JoinChannelResponse) => Buffer.from(message.serialize()),
+            responseDeserialize: (bytes: Buffer) => JoinChannelResponse.deserialize(new Uint8Array(bytes))
+        },
+        RequestAccess: {
+            path: "/videoservice.ChannelService/RequestAccess",
+            requestStream: false,
+            responseStream: false,
+            requestSerialize: (message: RequestChannelAccessRequest) => Buffer.from(message.serialize()),
+            requestDeserialize: (bytes: Buffer) => RequestChannelAccessRequest.deserialize(new Uint8Array(bytes)),
+            responseSerialize: (message: RequestChannelAccessResponse) => Buffer.from(message.serialize()),
+            responseDeserialize: (bytes: Buffer) => RequestChannelAccessResponse.deserialize(new Uint8Array(bytes))
+        },
+        GetAccessRequests: {
+            path: "/videoservice.ChannelService/GetAccessRequests",
+            requestStream: false,
+            responseStream: false,
+            requestSerialize: (message: GetChannelAccessRequestsRequest) => Buffer.from(message.serialize()),
+            requestDeserialize: (bytes: Buffer) => GetChannelAccessRequestsRequest.deserialize(new Uint8Array(bytes)),
+            responseSerialize: (message: GetChannelAccessRequestsResponse) => Buffer.from(message.serialize()),
+            responseDeserialize: (bytes: Buffer) => GetChannelAccessRequestsResponse.deserialize(new Uint8Array(bytes))
+        },
+        ApproveAccessRequest: {
+            path: "/videoservice.ChannelService/ApproveAccessRequest",
+            requestStream: false,
+            responseStream: false,
+            requestSerialize: (message: ApproveChannelAccessRequest) => Buffer.from(message.serialize()),
+            requestDeserialize: (bytes: Buffer) => ApproveChannelAccessRequest.deserialize(new Uint8Array(bytes)),
+            responseSerialize: (message: ApproveChannelAccessResponse) => Buffer.from(message.serialize()),
+            responseDeserialize: (bytes: Buffer) => ApproveChannelAccessResponse.deserialize(new Uint8Array(bytes))
+        },
+        DenyAccessRequest: {
+            path: "/videoservice.ChannelService/DenyAccessRequest",
+            requestStream: false,
+            responseStream: false,
+            requestSerialize: (message: DenyChannelAccessRequest) => Buffer.from(message.serialize()),
+            requestDeserialize: (bytes: Buffer) => DenyChannelAccessRequest.deserialize(new Uint8Array(bytes)),
+            responseSerialize: (message: DenyChannelAccessResponse) => Buffer.from(message.serialize()),
+            responseDeserialize: (bytes: Buffer) => DenyChannelAccessResponse.deserialize(new Uint8Array(bytes))
         }
     };
     [method: string]: grpc_1.UntypedHandleCall;
     abstract CreateChannel(call: grpc_1.ServerUnaryCall<CreateChannelRequest, CreateChannelResponse>, callback: grpc_1.sendUnaryData<CreateChannelResponse>): void;
     abstract UpdateChannel(call: grpc_1.ServerUnaryCall<UpdateChannelRequest, UpdateChannelResponse>, callback: grpc_1.sendUnaryData<UpdateChannelResponse>): void;
     abstract GetChannels(call: grpc_1.ServerUnaryCall<GetChannelsRequest, GetChannelsResponse>, callback: grpc_1.sendUnaryData<GetChannelsResponse>): void;
+    abstract GetChannel(call: grpc_1.ServerUnaryCall<GetChannelRequest, GetChannelResponse>, callback: grpc_1.sendUnaryData<GetChannelResponse>): void;
     abstract GetMembers(call: grpc_1.ServerUnaryCall<GetChannelMembersRequest, GetChannelMembersResponse>, callback: grpc_1.sendUnaryData<GetChannelMembersResponse>): void;
     abstract AddMember(call: grpc_1.ServerUnaryCall<AddChannelMemberRequest, AddChannelMemberResponse>, callback: grpc_1.sendUnaryData<AddChannelMemberResponse>): void;
     abstract RemoveMember(call: grpc_1.ServerUnaryCall<RemoveChannelMemberRequest, RemoveChannelMemberResponse>, callback: grpc_1.sendUnaryData<RemoveChannelMemberResponse>): void;
+    abstract UpdateMemberRole(call: grpc_1.ServerUnaryCall<UpdateChannelMemberRoleRequest, UpdateChannelMemberRoleResponse>, callback: grpc_1.sendUnaryData<UpdateChannelMemberRoleResponse>): void;
+    abstract ArchiveChannel(call: grpc_1.ServerUnaryCall<ArchiveChannelRequest, ArchiveChannelResponse>, callback: grpc_1.sendUnaryData<ArchiveChannelResponse>): void;
+    abstract UnarchiveChannel(call: grpc_1.ServerUnaryCall<UnarchiveChannelRequest, UnarchiveChannelResponse>, callback: grpc_1.sendUnaryData<UnarchiveChannelResponse>): void;
+    abstract DeleteChannel(call: grpc_1.ServerUnaryCall<DeleteChannelRequest, DeleteChannelResponse>, callback: grpc_1.sendUnaryData<DeleteChannelResponse>): void;
+    abstract DiscoverChannels(call: grpc_1.ServerUnaryCall<DiscoverChannelsRequest, DiscoverChannelsResponse>, callback: grpc_1.sendUnaryData<DiscoverChannelsResponse>): void;
+    abstract JoinChannel(call: grpc_1.ServerUnaryCall<JoinChannelRequest, JoinChannelResponse>, callback: grpc_1.sendUnaryData<JoinChannelResponse>): void;
+    abstract RequestAccess(call: grpc_1.ServerUnaryCall<RequestChannelAccessRequest, RequestChannelAccessResponse>, callback: grpc_1.sendUnaryData<RequestChannelAccessResponse>): void;
+    abstract GetAccessRequests(call: grpc_1.ServerUnaryCall<GetChannelAccessRequestsRequest, GetChannelAccessRequestsResponse>, callback: grpc_1.sendUnaryData<GetChannelAccessRequestsResponse>): void;
+    abstract ApproveAccessRequest(call: grpc_1.ServerUnaryCall<ApproveChannelAccessRequest, ApproveChannelAccessResponse>, callback: grpc_1.sendUnaryData<ApproveChannelAccessResponse>): void;
+    abstract DenyAccessRequest(call: grpc_1.ServerUnaryCall<DenyChannelAccessRequest, DenyChannelAccessResponse>, callback: grpc_1.sendUnaryData<DenyChannelAccessResponse>): void;
 }
 export class ChannelServiceClient {
     private _address: string;
@@ -3140,6 +7802,10 @@ export class ChannelServiceClient {
     GetChannels(message: GetChannelsRequest, metadata: grpc_web_1.Metadata | null) {
         return this._client.thenableCall<GetChannelsRequest, GetChannelsResponse>(this._address + "/videoservice.ChannelService/GetChannels", message, metadata || {}, ChannelServiceClient.GetChannels);
     }
+    private static GetChannel = new grpc_web_1.MethodDescriptor<GetChannelRequest, GetChannelResponse>("/videoservice.ChannelService/GetChannel", grpc_web_1.MethodType.UNARY, GetChannelRequest, GetChannelResponse, (message: GetChannelRequest) => message.serialize(), GetChannelResponse.deserialize);
+    GetChannel(message: GetChannelRequest, metadata: grpc_web_1.Metadata | null) {
+        return this._client.thenableCall<GetChannelRequest, GetChannelResponse>(this._address + "/videoservice.ChannelService/GetChannel", message, metadata || {}, ChannelServiceClient.GetChannel);
+    }
     private static GetMembers = new grpc_web_1.MethodDescriptor<GetChannelMembersRequest, GetChannelMembersResponse>("/videoservice.ChannelService/GetMembers", grpc_web_1.MethodType.UNARY, GetChannelMembersRequest, GetChannelMembersResponse, (message: GetChannelMembersRequest) => message.serialize(), GetChannelMembersResponse.deserialize);
     GetMembers(message: GetChannelMembersRequest, metadata: grpc_web_1.Metadata | null) {
         return this._client.thenableCall<GetChannelMembersRequest, GetChannelMembersResponse>(this._address + "/videoservice.ChannelService/GetMembers", message, metadata || {}, ChannelServiceClient.GetMembers);
@@ -3152,4 +7818,88 @@ export class ChannelServiceClient {
     RemoveMember(message: RemoveChannelMemberRequest, metadata: grpc_web_1.Metadata | null) {
         return this._client.thenableCall<RemoveChannelMemberRequest, RemoveChannelMemberResponse>(this._address + "/videoservice.ChannelService/RemoveMember", message, metadata || {}, ChannelServiceClient.RemoveMember);
     }
+    private static UpdateMemberRole = new grpc_web_1.MethodDescriptor<UpdateChannelMemberRoleRequest, UpdateChannelMemberRoleResponse>("/videoservice.ChannelService/UpdateMemberRole", grpc_web_1.MethodType.UNARY, UpdateChannelMemberRoleRequest, UpdateChannelMemberRoleResponse, (message: UpdateChannelMemberRoleRequest) => message.serialize(), UpdateChannelMemberRoleResponse.deserialize);
+    UpdateMemberRole(message: UpdateChannelMemberRoleRequest, metadata: grpc_web_1.Metadata | null) {
+        return this._client.thenableCall<UpdateChannelMemberRoleRequest, UpdateChannelMemberRoleResponse>(this._address + "/videoservice.ChannelService/UpdateMemberRole", message, metadata || {}, ChannelServiceClient.UpdateMemberRole);
+    }
+    private static ArchiveChannel = new grpc_web_1.MethodDescriptor<ArchiveChannelRequest, ArchiveChannelResponse>("/videoservice.ChannelService/ArchiveChannel", grpc_web_1.MethodType.UNARY, ArchiveChannelRequest, ArchiveChannelResponse, (message: ArchiveChannelRequest) => message.serialize(), ArchiveChannelResponse.deserialize);
+    ArchiveChannel(message: ArchiveChannelRequest, metadata: grpc_web_1.Metadata | null) {
+        return this._client.thenableCall<ArchiveChannelRequest, ArchiveChannelResponse>(this._address + "/videoservice.ChannelService/ArchiveChannel", message, metadata || {}, ChannelServiceClient.ArchiveChannel);
+    }
+    private static UnarchiveChannel = new grpc_web_1.MethodDescriptor<UnarchiveChannelRequest, UnarchiveChannelResponse>("/videoservice.ChannelService/UnarchiveChannel", grpc_web_1.MethodType.UNARY, UnarchiveChannelRequest, UnarchiveChannelResponse, (message: UnarchiveChannelRequest) => message.serialize(), UnarchiveChannelResponse.deserialize);
+    UnarchiveChannel(message: UnarchiveChannelRequest, metadata: grpc_web_1.Metadata | null) {
+        return this._client.thenableCall<UnarchiveChannelRequest, UnarchiveChannelResponse>(this._address + "/videoservice.ChannelService/UnarchiveChannel", message, metadata || {}, ChannelServiceClient.UnarchiveChannel);
+    }
+    private static DeleteChannel = new grpc_web_1.MethodDescriptor<DeleteChannelRequest, DeleteChannelResponse>("/videoservice.ChannelService/DeleteChannel", grpc_web_1.MethodType.UNARY, DeleteChannelRequest, DeleteChannelResponse, (message: DeleteChannelRequest) => message.serialize(), DeleteChannelResponse.deserialize);
+    DeleteChannel(message: DeleteChannelRequest, metadata: grpc_web_1.Metadata | null) {
+        return this._client.thenableCall<DeleteChannelRequest, DeleteChannelResponse>(this._address + "/videoservice.ChannelService/DeleteChannel", message, metadata || {}, ChannelServiceClient.DeleteChannel);
+    }
+    private static DiscoverChannels = new grpc_web_1.MethodDescriptor<DiscoverChannelsRequest, DiscoverChannelsResponse>("/videoservice.ChannelService/DiscoverChannels", grpc_web_1.MethodType.UNARY, DiscoverChannelsRequest, DiscoverChannelsResponse, (message: DiscoverChannelsRequest) => message.serialize(), DiscoverChannelsResponse.deserialize);
+    DiscoverChannels(message: DiscoverChannelsRequest, metadata: grpc_web_1.Metadata | null) {
+        return this._client.thenableCall<DiscoverChannelsRequest, DiscoverChannelsResponse>(this._address + "/videoservice.ChannelService/DiscoverChannels", message, metadata || {}, ChannelServiceClient.DiscoverChannels);
+    }
+    private static JoinChannel = new grpc_web_1.MethodDescriptor<JoinChannelRequest, JoinChannelResponse>("/videoservice.ChannelService/JoinChannel", grpc_web_1.MethodType.UNARY, JoinChannelRequest, JoinChannelResponse, (message: JoinChannelRequest) => message.serialize(), JoinChannelResponse.deserialize);
+    JoinChannel(message: JoinChannelRequest, metadata: grpc_web_1.Metadata | null) {
+        return this._client.thenableCall<JoinChannelRequest, JoinChannelResponse>(this._address + "/videoservice.ChannelService/JoinChannel", message, metadata || {}, ChannelServiceClient.JoinChannel);
+    }
+    private static RequestAccess = new grpc_web_1.MethodDescriptor<RequestChannelAccessRequest, RequestChannelAccessResponse>("/videoservice.ChannelService/RequestAccess", grpc_web_1.MethodType.UNARY, RequestChannelAccessRequest, RequestChannelAccessResponse, (message: RequestChannelAccessRequest) => message.serialize(), RequestChannelAccessResponse.deserialize);
+    RequestAccess(message: RequestChannelAccessRequest, metadata: grpc_web_1.Metadata | null) {
+        return this._client.thenableCall<RequestChannelAccessRequest, RequestChannelAccessResponse>(this._address + "/videoservice.ChannelService/RequestAccess", message, metadata || {}, ChannelServiceClient.RequestAccess);
+    }
+    private static GetAccessRequests = new grpc_web_1.MethodDescriptor<GetChannelAccessRequestsRequest, GetChannelAccessRequestsResponse>("/videoservice.ChannelService/GetAccessRequests", grpc_web_1.MethodType.UNARY, GetChannelAccessRequestsRequest, GetChannelAccessRequestsResponse, (message: GetChannelAccessRequestsRequest) => message.serialize(), GetChannelAccessRequestsResponse.deserialize);
+    GetAccessRequests(message: GetChannelAccessRequestsRequest, metadata: grpc_web_1.Metadata | null) {
+        return this._client.thenableCall<GetChannelAccessRequestsRequest, GetChannelAccessRequestsResponse>(this._address + "/videoservice.ChannelService/GetAccessRequests", message, metadata || {}, ChannelServiceClient.GetAccessRequests);
+    }
+    private static ApproveAccessRequest = new grpc_web_1.MethodDescriptor<ApproveChannelAccessRequest, ApproveChannelAccessResponse>("/videoservice.ChannelService/ApproveAccessRequest", grpc_web_1.MethodType.UNARY, ApproveChannelAccessRequest, ApproveChannelAccessResponse, (message: ApproveChannelAccessRequest) => message.serialize(), ApproveChannelAccessResponse.deserialize);
+    ApproveAccessRequest(message: ApproveChannelAccessRequest, metadata: grpc_web_1.Metadata | null) {
+        return this._client.thenableCall<ApproveChannelAccessRequest, ApproveChannelAccessResponse>(this._address + "/videoservice.ChannelService/ApproveAccessRequest", message, metadata || {}, ChannelServiceClient.ApproveAccessRequest);
+    }
+    private static DenyAccessRequest = new grpc_web_1.MethodDescriptor<DenyChannelAccessRequest, DenyChannelAccessResponse>("/videoservice.ChannelService/DenyAccessRequest", grpc_web_1.MethodType.UNARY, DenyChannelAccessRequest, DenyChannelAccessResponse, (message: DenyChannelAccessRequest) => message.serialize(), DenyChannelAccessResponse.deserialize);
+    DenyAccessRequest(message: DenyChannelAccessRequest, metadata: grpc_web_1.Metadata | null) {
+        return this._client.thenableCall<DenyChannelAccessRequest, DenyChannelAccessResponse>(this._address + "/videoservice.ChannelService/DenyAccessRequest", message, metadata || {}, ChannelServiceClient.DenyAccessRequest);
+    }
+}
+export abstract class UnimplementedTenantCleanupServiceService {
+    static definition = {
+        RemoveTenantMember: {
+            path: "/videoservice.TenantCleanupService/RemoveTenantMember",
+            requestStream: false,
+            responseStream: false,
+            requestSerialize: (message: RemoveTenantMemberRequest) => Buffer.from(message.serialize()),
+            requestDeserialize: (bytes: Buffer) => RemoveTenantMemberRequest.deserialize(new Uint8Array(bytes)),
+            responseSerialize: (message: RemoveTenantMemberResponse) => Buffer.from(message.serialize()),
+            responseDeserialize: (bytes: Buffer) => RemoveTenantMemberResponse.deserialize(new Uint8Array(bytes))
+        },
+        DeleteTenantData: {
+            path: "/videoservice.TenantCleanupService/DeleteTenantData",
+            requestStream: false,
+            responseStream: false,
+            requestSerialize: (message: DeleteTenantDataRequest) => Buffer.from(message.serialize()),
+            requestDeserialize: (bytes: Buffer) => DeleteTenantDataRequest.deserialize(new Uint8Array(bytes)),
+            responseSerialize: (message: DeleteTenantDataResponse) => Buffer.from(message.serialize()),
+            responseDeserialize: (bytes: Buffer) => DeleteTenantDataResponse.deserialize(new Uint8Array(bytes))
+        }
+    };
+    [method: string]: grpc_1.UntypedHandleCall;
+    abstract RemoveTenantMember(call: grpc_1.ServerUnaryCall<RemoveTenantMemberRequest, RemoveTenantMemberResponse>, callback: grpc_1.sendUnaryData<RemoveTenantMemberResponse>): void;
+    abstract DeleteTenantData(call: grpc_1.ServerUnaryCall<DeleteTenantDataRequest, DeleteTenantDataResponse>, callback: grpc_1.sendUnaryData<DeleteTenantDataResponse>): void;
+}
+export class TenantCleanupServiceClient {
+    private _address: string;
+    private _client: grpc_web_1.GrpcWebClientBase;
+    constructor(address: string, credentials?: Object, options?: grpc_web_1.GrpcWebClientBaseOptions) {
+        if (!options)
+            options = {};
+        options.format = options.format || "text";
+        this._address = address;
+        this._client = new grpc_web_1.GrpcWebClientBase(options);
+    }
+    private static RemoveTenantMember = new grpc_web_1.MethodDescriptor<RemoveTenantMemberRequest, RemoveTenantMemberResponse>("/videoservice.TenantCleanupService/RemoveTenantMember", grpc_web_1.MethodType.UNARY, RemoveTenantMemberRequest, RemoveTenantMemberResponse, (message: RemoveTenantMemberRequest) => message.serialize(), RemoveTenantMemberResponse.deserialize);
+    RemoveTenantMember(message: RemoveTenantMemberRequest, metadata: grpc_web_1.Metadata | null) {
+        return this._client.thenableCall<RemoveTenantMemberRequest, RemoveTenantMemberResponse>(this._address + "/videoservice.TenantCleanupService/RemoveTenantMember", message, metadata || {}, TenantCleanupServiceClient.RemoveTenantMember);
+    }
+    private static DeleteTenantData = new grpc_web_1.MethodDescriptor<DeleteTenantDataRequest, DeleteTenantDataResponse>("/videoservice.TenantCleanupService/DeleteTenantData", grpc_web_1.MethodType.UNARY, DeleteTenantDataRequest, DeleteTenantDataResponse, (message: DeleteTenantDataRequest) => message.serialize(), DeleteTenantDataResponse.deserialize);
+    DeleteTenantData(message: DeleteTenantDataRequest, metadata: grpc_web_1.Metadata | null) {
+        return this._client.thenableCall<DeleteTenantDataRequest, DeleteTenantDataResponse>(this._address + "/videoservice.TenantCleanupService/DeleteTenantData", message, metadata || {}, TenantCleanupServiceClient.DeleteTenantData);
+    }
 }
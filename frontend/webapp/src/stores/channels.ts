@@ -51,15 +51,17 @@ export const fetchChannels = async () => {
     $isLoadingChannels.set(true)
     $channelError.set(null)
     
-    const request = new GetChannelsRequest()
-    const response = await channelService.GetChannels(request, {})
-    
-    if (response.channels) {
-      $channels.set(response.channels)
-    } else {
-      $channelError.set(response.message || 'Failed to fetch channels')
-      $channels.set([])
-    }
+    // GetChannels is paginated, follow next_page_token until the last page
+    const channels: Channel[] = []
+    let pageToken = ''
+    do {
+      const request = new GetChannelsRequest({ page_size: 100, page_token: pageToken })
+      const response = await channelService.GetChannels(request, {})
+      channels.push(...response.channels)
+      pageToken = response.next_page_token
+    } while (pageToken)
+
+    $channels.set(channels)
   } catch (error) {
     console.error("Error fetching channels:", error)
     $channelError.set('Failed to fetch channels')
@@ -36,13 +36,22 @@ export const fetchComments = async (videoId: string) => {
   try {
       console.log("Fetching comments for video:", videoId);
 
-      const request = new ListCommentsRequest();
-      request.video_id = videoId;
-
-      const response = await commentService.ListComments(request, {});
-      
-      console.log("Comments fetched successfully:", response);
-      $comments.set(response.comments as CommentWithReplies[]);
+      // ListComments is paginated, follow next_page_token until the last page
+      const comments: Comment[] = [];
+      let pageToken = "";
+      do {
+        const request = new ListCommentsRequest();
+        request.video_id = videoId;
+        request.page_size = 100;
+        request.page_token = pageToken;
+
+        const response = await commentService.ListComments(request, {});
+        comments.push(...response.comments);
+        pageToken = response.next_page_token;
+      } while (pageToken);
+
+      console.log("Comments fetched successfully:", comments.length);
+      $comments.set(comments as CommentWithReplies[]);
   } catch (error: unknown) {
       if (error instanceof SyntaxError) {
           console.error("Invalid JSON response. Possible server error.");
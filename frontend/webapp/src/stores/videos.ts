@@ -45,14 +45,26 @@ export const videoService = new VideoServiceClient(
     }
 );
 
-export const fetchVideos = async () => {
-    try {
+// ListVideos is paginated, follow next_page_token until the last page
+const listAllVideos = async (): Promise<Video[]> => {
+    const videos: Video[] = []
+    let pageToken = ""
+    do {
         const response = await videoService.ListVideos(ListVideosRequest.fromObject({
-            page_number: 0,
-            page_size: 10,
+            page_size: 100,
+            page_token: pageToken,
         }),{})
+        videos.push(...response.videos)
+        pageToken = response.next_page_token
+    } while (pageToken)
+    return videos
+}
+
+export const fetchVideos = async () => {
+    try {
+        const videos = await listAllVideos()
 
-        $videos.set(response.videos)
+        $videos.set(videos)
     } catch (error) {
         console.error("Error fetching videos:", error)
         // Clear videos on error (especially auth errors)
@@ -78,13 +90,10 @@ export const fetchVideo = async (id: string) => {
 export const fetchTenantVideos = async () => {
     try {
         // Get all accessible videos, then filter for private videos (no channel)
-        const response = await videoService.ListVideos(ListVideosRequest.fromObject({
-            page_number: 0,
-            page_size: 100,
-        }),{})
+        const videos = await listAllVideos()
 
         // Filter for videos without channels (user's private videos)
-        const privateVideos = response.videos.filter(video => !video.channel_id || video.channel_id === '')
+        const privateVideos = videos.filter(video => !video.channel_id || video.channel_id === '')
         $tenantVideos.set(privateVideos)
         return privateVideos
     } catch (error) {